@@ -20,13 +20,18 @@ func NewGetByIDInput(id string) GetByIDInput {
 
 // Article is an Output DTO for GetById use-case.
 type Article struct {
-	id           string
-	title        string
-	body         string
-	thumbnailUrl string
-	createdAt    synchro.Time[tz.UTC]
-	updatedAt    synchro.Time[tz.UTC]
-	tags         []Tag
+	id                string
+	title             string
+	body              string
+	thumbnailUrl      string
+	thumbnailVariants []ImageVariant
+	createdAt         synchro.Time[tz.UTC]
+	updatedAt         synchro.Time[tz.UTC]
+	slug              string
+	createdBy         string
+	updatedBy         string
+	series            Series
+	tags              []Tag
 }
 
 // ID returns the id of the article
@@ -41,12 +46,27 @@ func (a Article) Body() string { return a.body }
 // ThumbnailUrl returns the thumbnail url of the article
 func (a Article) ThumbnailUrl() string { return a.thumbnailUrl }
 
+// ThumbnailVariants returns the variants of the thumbnail. It is empty if the thumbnail was given without them.
+func (a Article) ThumbnailVariants() []ImageVariant { return a.thumbnailVariants }
+
 // CreatedAt returns date the article was created
 func (a Article) CreatedAt() synchro.Time[tz.UTC] { return a.createdAt }
 
 // UpdatedAt returns the date the article was last updated.
 func (a Article) UpdatedAt() synchro.Time[tz.UTC] { return a.updatedAt }
 
+// Slug returns the current slug of the article. It is empty if the article has none.
+func (a Article) Slug() string { return a.slug }
+
+// CreatedBy returns the name of the user who created the article. It is empty if it is not known.
+func (a Article) CreatedBy() string { return a.createdBy }
+
+// UpdatedBy returns the name of the user who last updated the article. It is empty if it is not known.
+func (a Article) UpdatedBy() string { return a.updatedBy }
+
+// Series returns the series the article is a part of. Its ID is empty if the article is in none.
+func (a Article) Series() Series { return a.series }
+
 // Tags return the tags attached to the article
 func (a Article) Tags() []Tag { return a.tags }
 
@@ -56,18 +76,28 @@ func NewArticle(
 	title string,
 	body string,
 	thumbnailUrl string,
+	thumbnailVariants []ImageVariant,
 	createdAt synchro.Time[tz.UTC],
 	updatedAt synchro.Time[tz.UTC],
+	slug string,
+	createdBy string,
+	updatedBy string,
+	series Series,
 	tags ...Tag,
 ) Article {
 	return Article{
-		id:           id,
-		title:        title,
-		body:         body,
-		thumbnailUrl: thumbnailUrl,
-		createdAt:    createdAt,
-		updatedAt:    updatedAt,
-		tags:         tags,
+		id:                id,
+		title:             title,
+		body:              body,
+		thumbnailUrl:      thumbnailUrl,
+		thumbnailVariants: thumbnailVariants,
+		createdAt:         createdAt,
+		updatedAt:         updatedAt,
+		slug:              slug,
+		createdBy:         createdBy,
+		updatedBy:         updatedBy,
+		series:            series,
+		tags:              tags,
 	}
 }
 
@@ -88,6 +118,52 @@ func NewTag(id string, name string) Tag {
 	return Tag{id: id, name: name}
 }
 
+// ImageVariant is a DTO for a variant of the thumbnail of an article
+type ImageVariant struct {
+	url         string
+	width       int
+	height      int
+	contentType string
+}
+
+// URL returns the url of the variant
+func (v ImageVariant) URL() string { return v.url }
+
+// Width returns the width of the variant in pixels
+func (v ImageVariant) Width() int { return v.width }
+
+// Height returns the height of the variant in pixels
+func (v ImageVariant) Height() int { return v.height }
+
+// ContentType returns the content type of the variant
+func (v ImageVariant) ContentType() string { return v.contentType }
+
+// NewImageVariant constructs ImageVariant
+func NewImageVariant(url string, width, height int, contentType string) ImageVariant {
+	return ImageVariant{url: url, width: width, height: height, contentType: contentType}
+}
+
+// Series is a DTO for the series an article is a part of
+type Series struct {
+	id       string
+	title    string
+	position int
+}
+
+// ID returns the id of the series
+func (s Series) ID() string { return s.id }
+
+// Title returns the title of the series
+func (s Series) Title() string { return s.title }
+
+// Position returns the 1-based position of the article within the series
+func (s Series) Position() int { return s.position }
+
+// NewSeries constructs Series
+func NewSeries(id string, title string, position int) Series {
+	return Series{id: id, title: title, position: position}
+}
+
 // GetByIDOutput is an Output DTO for GetById use-case.
 type GetByIDOutput = Article
 
@@ -97,13 +173,35 @@ func NewGetByIDOutput(
 	title string,
 	body string,
 	thumbnailUrl string,
+	thumbnailVariants []ImageVariant,
 	createdAt synchro.Time[tz.UTC],
 	updatedAt synchro.Time[tz.UTC],
+	slug string,
+	createdBy string,
+	updatedBy string,
+	series Series,
 	tags ...Tag,
 ) GetByIDOutput {
-	return NewArticle(id, title, body, thumbnailUrl, createdAt, updatedAt, tags...)
+	return NewArticle(id, title, body, thumbnailUrl, thumbnailVariants, createdAt, updatedAt, slug, createdBy, updatedBy, series, tags...)
+}
+
+// GetBySlugInput is an Input DTO for GetBySlug use-case
+type GetBySlugInput struct {
+	slug string
+}
+
+// Slug returns the current or a former slug of the article to be got
+func (i GetBySlugInput) Slug() string { return i.slug }
+
+// NewGetBySlugInput constructs GetBySlugInput.
+func NewGetBySlugInput(slug string) GetBySlugInput {
+	return GetBySlugInput{slug: slug}
 }
 
+// GetBySlugOutput is an Output DTO for GetBySlug use-case.
+// Its Slug differs from the one asked for if the article was found by a former slug.
+type GetBySlugOutput = Article
+
 // ListAllOutput is an Output DTO for ListAll use-case.
 type ListAllOutput struct {
 	articles []Article
@@ -222,3 +320,29 @@ func (o *ListBeforeOutput) HasPrevious() bool { return o.hasPrev }
 func NewListBeforeOutput(hasPrev bool, articles ...Article) ListBeforeOutput {
 	return ListBeforeOutput{articles: articles, hasPrev: hasPrev}
 }
+
+// GetSeriesInput is an Input DTO for GetSeries use-case
+type GetSeriesInput struct {
+	id string
+}
+
+// ID returns the ID of the series to be got
+func (i GetSeriesInput) ID() string { return i.id }
+
+// NewGetSeriesInput constructs GetSeriesInput.
+func NewGetSeriesInput(id string) GetSeriesInput {
+	return GetSeriesInput{id: id}
+}
+
+// GetSeriesOutput is an Output DTO for GetSeries use-case.
+type GetSeriesOutput struct {
+	articles []Article
+}
+
+// NewGetSeriesOutput constructs GetSeriesOutput.
+func NewGetSeriesOutput(articles ...Article) GetSeriesOutput {
+	return GetSeriesOutput{articles: articles}
+}
+
+// Articles returns the articles of the series in the order of their position.
+func (o *GetSeriesOutput) Articles() []Article { return o.articles }
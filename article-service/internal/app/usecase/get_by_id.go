@@ -33,8 +33,13 @@ func (u *GetByID) Execute(ctx context.Context, in dto.GetByIDInput) (*dto.GetByI
 		row.Title,
 		row.Body,
 		row.Thumbnail,
+		imageVariantDtoFromQueryModel(row.ThumbnailVariants),
 		row.CreatedAt,
 		row.UpdatedAt,
+		row.Slug,
+		row.CreatedBy,
+		row.UpdatedBy,
+		dto.NewSeries(row.SeriesID, row.SeriesTitle, int(row.SeriesPosition)),
 		tagDtoFromQueryModel(row.Tags)...,
 	)
 	return &result, nil
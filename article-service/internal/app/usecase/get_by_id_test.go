@@ -60,8 +60,13 @@ func (s *GetByIdTestSuite) TestGetById_Execute() {
 					"happy_path",
 					"## happy_path",
 					"thumbnail",
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.Series{},
 					dto.NewTag("1", "tag1"),
 					dto.NewTag("2", "tag2"),
 				), *out,
@@ -94,8 +99,58 @@ func (s *GetByIdTestSuite) TestGetById_Execute() {
 					"happy_path",
 					"## happy_path",
 					"thumbnail",
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.Series{},
+				), *out,
+			)
+		},
+	)
+	s.Run(
+		"happy_path/thumbnail_variants", func() {
+			ctrl := NewMockController(s.T())
+			queries := Mock[query.Queries](ctrl)
+			WhenDouble(queries.GetByID(AnyContext(), Exact("1"))).
+				ThenReturn(
+					sqlc.GetByIDRow{
+						ID:        "1",
+						Title:     "happy_path",
+						Body:      "## happy_path",
+						Thumbnail: "thumbnail",
+						ThumbnailVariants: types.ImageVariants{
+							{
+								URL:         "thumbnail-640w.webp",
+								Width:       640,
+								Height:      480,
+								ContentType: "image/webp",
+							},
+						},
+						CreatedAt: synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						UpdatedAt: synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					}, nil,
+				)
+
+			u := NewGetByID(queries)
+			out, err := u.Execute(s.T().Context(), dto.NewGetByIDInput("1"))
+			s.Require().NoError(err)
+			s.Require().NotNil(out)
+			s.Require().Equal(
+				dto.NewGetByIDOutput(
+					"1",
+					"happy_path",
+					"## happy_path",
+					"thumbnail",
+					[]dto.ImageVariant{dto.NewImageVariant("thumbnail-640w.webp", 640, 480, "image/webp")},
+					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.Series{},
 				), *out,
 			)
 		},
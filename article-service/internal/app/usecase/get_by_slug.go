@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	"blogapi.miyamo.today/article-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/article-service/internal/app/usecase/query"
+	"github.com/cockroachdb/errors"
+)
+
+// GetBySlug implements usecase.GetBySlug
+type GetBySlug struct {
+	queries query.Queries
+}
+
+func (u *GetBySlug) Execute(ctx context.Context, in dto.GetBySlugInput) (*dto.GetBySlugOutput, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	row, err := u.queries.GetBySlug(ctx, in.Slug())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.WithMessage(err, "article not found")
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	result := dto.NewArticle(
+		row.ID,
+		row.Title,
+		row.Body,
+		row.Thumbnail,
+		imageVariantDtoFromQueryModel(row.ThumbnailVariants),
+		row.CreatedAt,
+		row.UpdatedAt,
+		row.Slug,
+		row.CreatedBy,
+		row.UpdatedBy,
+		dto.NewSeries(row.SeriesID, row.SeriesTitle, int(row.SeriesPosition)),
+		tagDtoFromQueryModel(row.Tags)...,
+	)
+	return &result, nil
+}
+
+// NewGetBySlug constructs GetBySlug
+func NewGetBySlug(queries query.Queries) *GetBySlug {
+	return &GetBySlug{queries: queries}
+}
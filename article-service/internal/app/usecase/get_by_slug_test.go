@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"database/sql"
+	"testing"
+
+	"blogapi.miyamo.today/article-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/article-service/internal/infra/rdb/sqlc"
+	"blogapi.miyamo.today/article-service/internal/infra/rdb/types"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/stretchr/testify/suite"
+
+	"blogapi.miyamo.today/article-service/internal/app/usecase/dto"
+	. "github.com/ovechkin-dm/mockio/v2/mock"
+)
+
+type GetBySlugTestSuite struct {
+	suite.Suite
+}
+
+func TestGetBySlugTestSuite(t *testing.T) {
+	suite.Run(t, new(GetBySlugTestSuite))
+}
+
+func (s *GetBySlugTestSuite) TestGetBySlug_Execute() {
+	s.Run(
+		"happy_path", func() {
+			ctrl := NewMockController(s.T())
+			queries := Mock[query.Queries](ctrl)
+			WhenDouble(queries.GetBySlug(AnyContext(), Exact("hello-world"))).
+				ThenReturn(
+					sqlc.GetBySlugRow{
+						ID:        "1",
+						Title:     "happy_path",
+						Body:      "## happy_path",
+						Thumbnail: "thumbnail",
+						CreatedAt: synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						UpdatedAt: synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						Slug:      "hello-world",
+						Tags: []types.Tag{
+							{
+								ID:   "1",
+								Name: "tag1",
+							},
+							{
+								ID:   "2",
+								Name: "tag2",
+							},
+						},
+					}, nil,
+				)
+
+			u := NewGetBySlug(queries)
+			out, err := u.Execute(s.T().Context(), dto.NewGetBySlugInput("hello-world"))
+			s.Require().NoError(err)
+			s.Require().NotNil(out)
+			s.Require().Equal(
+				dto.NewArticle(
+					"1",
+					"happy_path",
+					"## happy_path",
+					"thumbnail",
+					nil,
+					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"hello-world",
+					"",
+					"",
+					dto.Series{},
+					dto.NewTag("1", "tag1"),
+					dto.NewTag("2", "tag2"),
+				), *out,
+			)
+		},
+	)
+	s.Run(
+		"happy_path/article_has_no_tags", func() {
+			ctrl := NewMockController(s.T())
+			queries := Mock[query.Queries](ctrl)
+			WhenDouble(queries.GetBySlug(AnyContext(), Exact("hello-world"))).
+				ThenReturn(
+					sqlc.GetBySlugRow{
+						ID:        "1",
+						Title:     "happy_path",
+						Body:      "## happy_path",
+						Thumbnail: "thumbnail",
+						CreatedAt: synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						UpdatedAt: synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					}, nil,
+				)
+
+			u := NewGetBySlug(queries)
+			out, err := u.Execute(s.T().Context(), dto.NewGetBySlugInput("hello-world"))
+			s.Require().NoError(err)
+			s.Require().NotNil(out)
+			s.Require().Equal(
+				dto.NewArticle(
+					"1",
+					"happy_path",
+					"## happy_path",
+					"thumbnail",
+					nil,
+					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.Series{},
+				), *out,
+			)
+		},
+	)
+	s.Run(
+		"happy_path/former_slug", func() {
+			ctrl := NewMockController(s.T())
+			queries := Mock[query.Queries](ctrl)
+			WhenDouble(queries.GetBySlug(AnyContext(), Exact("old-slug"))).
+				ThenReturn(
+					sqlc.GetBySlugRow{
+						ID:        "1",
+						Title:     "happy_path",
+						Body:      "## happy_path",
+						Thumbnail: "thumbnail",
+						CreatedAt: synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						UpdatedAt: synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						Slug:      "hello-world",
+					}, nil,
+				)
+
+			u := NewGetBySlug(queries)
+			out, err := u.Execute(s.T().Context(), dto.NewGetBySlugInput("old-slug"))
+			s.Require().NoError(err)
+			s.Require().NotNil(out)
+			s.Require().Equal(
+				dto.NewArticle(
+					"1",
+					"happy_path",
+					"## happy_path",
+					"thumbnail",
+					nil,
+					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"hello-world",
+					"",
+					"",
+					dto.Series{},
+				), *out,
+			)
+		},
+	)
+	s.Run(
+		"unhappy_path/query_returns_error", func() {
+			ctrl := NewMockController(s.T())
+			queries := Mock[query.Queries](ctrl)
+			WhenDouble(queries.GetBySlug(AnyContext(), Exact("hello-world"))).
+				ThenReturn(sqlc.GetBySlugRow{}, sql.ErrNoRows)
+
+			u := NewGetBySlug(queries)
+			out, err := u.Execute(s.T().Context(), dto.NewGetBySlugInput("hello-world"))
+			s.Require().Error(err)
+			s.Require().Nil(out)
+			s.Require().ErrorIs(err, sql.ErrNoRows)
+		},
+	)
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+
+	"blogapi.miyamo.today/article-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/article-service/internal/app/usecase/query"
+	"github.com/cockroachdb/errors"
+)
+
+// GetSeries implements usecase.GetSeries
+type GetSeries struct {
+	queries query.Queries
+}
+
+func (u *GetSeries) Execute(ctx context.Context, in dto.GetSeriesInput) (*dto.GetSeriesOutput, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	rows, err := u.queries.GetSeries(ctx, in.ID())
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	// A series exists only as long as one of its articles has been published.
+	if len(rows) == 0 {
+		return nil, errors.WithMessage(sql.ErrNoRows, "series not found")
+	}
+	articles := make([]dto.Article, 0, len(rows))
+	for _, row := range rows {
+		articles = append(
+			articles, dto.NewArticle(
+				row.ID,
+				row.Title,
+				row.Body,
+				row.Thumbnail,
+				imageVariantDtoFromQueryModel(row.ThumbnailVariants),
+				row.CreatedAt,
+				row.UpdatedAt,
+				row.Slug,
+				row.CreatedBy,
+				row.UpdatedBy,
+				dto.NewSeries(row.SeriesID, row.SeriesTitle, int(row.SeriesPosition)),
+				tagDtoFromQueryModel(row.Tags)...,
+			),
+		)
+	}
+	result := dto.NewGetSeriesOutput(articles...)
+	return &result, nil
+}
+
+// NewGetSeries constructs GetSeries
+func NewGetSeries(queries query.Queries) *GetSeries {
+	return &GetSeries{queries: queries}
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"database/sql"
+	"testing"
+
+	"blogapi.miyamo.today/article-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/article-service/internal/infra/rdb/sqlc"
+	"blogapi.miyamo.today/article-service/internal/infra/rdb/types"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/stretchr/testify/suite"
+
+	"blogapi.miyamo.today/article-service/internal/app/usecase/dto"
+	. "github.com/ovechkin-dm/mockio/v2/mock"
+)
+
+type GetSeriesTestSuite struct {
+	suite.Suite
+}
+
+func TestGetSeriesTestSuite(t *testing.T) {
+	suite.Run(t, new(GetSeriesTestSuite))
+}
+
+func (s *GetSeriesTestSuite) TestGetSeries_Execute() {
+	s.Run(
+		"happy_path", func() {
+			ctrl := NewMockController(s.T())
+			queries := Mock[query.Queries](ctrl)
+			WhenDouble(queries.GetSeries(AnyContext(), Exact("series1"))).
+				ThenReturn(
+					[]sqlc.GetSeriesRow{
+						{
+							ID:             "1",
+							Title:          "part1",
+							Body:           "## part1",
+							Thumbnail:      "thumbnail",
+							CreatedAt:      synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							UpdatedAt:      synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							SeriesID:       "series1",
+							SeriesTitle:    "Go in Practice",
+							SeriesPosition: 1,
+							Tags: []types.Tag{
+								{
+									ID:   "1",
+									Name: "tag1",
+								},
+							},
+						},
+						{
+							ID:             "2",
+							Title:          "part2",
+							Body:           "## part2",
+							Thumbnail:      "thumbnail",
+							CreatedAt:      synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0),
+							UpdatedAt:      synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0),
+							SeriesID:       "series1",
+							SeriesTitle:    "Go in Practice",
+							SeriesPosition: 2,
+						},
+					}, nil,
+				)
+
+			u := NewGetSeries(queries)
+			out, err := u.Execute(s.T().Context(), dto.NewGetSeriesInput("series1"))
+			s.Require().NoError(err)
+			s.Require().NotNil(out)
+			s.Require().Equal(
+				dto.NewGetSeriesOutput(
+					dto.NewArticle(
+						"1",
+						"part1",
+						"## part1",
+						"thumbnail",
+						nil,
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.NewSeries("series1", "Go in Practice", 1),
+						dto.NewTag("1", "tag1"),
+					),
+					dto.NewArticle(
+						"2",
+						"part2",
+						"## part2",
+						"thumbnail",
+						nil,
+						synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0),
+						synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.NewSeries("series1", "Go in Practice", 2),
+					),
+				), *out,
+			)
+		},
+	)
+	s.Run(
+		"unhappy_path/series_has_no_published_articles", func() {
+			ctrl := NewMockController(s.T())
+			queries := Mock[query.Queries](ctrl)
+			WhenDouble(queries.GetSeries(AnyContext(), Exact("series1"))).
+				ThenReturn(nil, nil)
+
+			u := NewGetSeries(queries)
+			out, err := u.Execute(s.T().Context(), dto.NewGetSeriesInput("series1"))
+			s.Require().Error(err)
+			s.Require().Nil(out)
+			s.Require().ErrorIs(err, sql.ErrNoRows)
+		},
+	)
+	s.Run(
+		"unhappy_path/query_returns_error", func() {
+			ctrl := NewMockController(s.T())
+			queries := Mock[query.Queries](ctrl)
+			WhenDouble(queries.GetSeries(AnyContext(), Exact("series1"))).
+				ThenReturn(nil, sql.ErrConnDone)
+
+			u := NewGetSeries(queries)
+			out, err := u.Execute(s.T().Context(), dto.NewGetSeriesInput("series1"))
+			s.Require().Error(err)
+			s.Require().Nil(out)
+			s.Require().ErrorIs(err, sql.ErrConnDone)
+		},
+	)
+}
@@ -42,8 +42,13 @@ func (u *ListAfter) Execute(ctx context.Context, in dto.ListAfterInput) (*dto.Li
 					row.Title,
 					row.Body,
 					row.Thumbnail,
+					imageVariantDtoFromQueryModel(row.ThumbnailVariants),
 					row.CreatedAt,
 					row.UpdatedAt,
+					row.Slug,
+					row.CreatedBy,
+					row.UpdatedBy,
+					dto.NewSeries(row.SeriesID, row.SeriesTitle, int(row.SeriesPosition)),
 					tagDtoFromQueryModel(row.Tags)...,
 				),
 			)
@@ -61,8 +66,13 @@ func (u *ListAfter) Execute(ctx context.Context, in dto.ListAfterInput) (*dto.Li
 					row.Title,
 					row.Body,
 					row.Thumbnail,
+					imageVariantDtoFromQueryModel(row.ThumbnailVariants),
 					row.CreatedAt,
 					row.UpdatedAt,
+					row.Slug,
+					row.CreatedBy,
+					row.UpdatedBy,
+					dto.NewSeries(row.SeriesID, row.SeriesTitle, int(row.SeriesPosition)),
 					tagDtoFromQueryModel(row.Tags)...,
 				),
 			)
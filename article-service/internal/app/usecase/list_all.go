@@ -30,8 +30,13 @@ func (u *ListAll) Execute(ctx context.Context) (*dto.ListAllOutput, error) {
 				row.Title,
 				row.Body,
 				row.Thumbnail,
+				imageVariantDtoFromQueryModel(row.ThumbnailVariants),
 				row.CreatedAt,
 				row.UpdatedAt,
+				row.Slug,
+				row.CreatedBy,
+				row.UpdatedBy,
+				dto.NewSeries(row.SeriesID, row.SeriesTitle, int(row.SeriesPosition)),
 				tagDtoFromQueryModel(row.Tags)...,
 			),
 		)
@@ -42,8 +42,13 @@ func (u *ListBefore) Execute(ctx context.Context, in dto.ListBeforeInput) (*dto.
 					row.Title,
 					row.Body,
 					row.Thumbnail,
+					imageVariantDtoFromQueryModel(row.ThumbnailVariants),
 					row.CreatedAt,
 					row.UpdatedAt,
+					row.Slug,
+					row.CreatedBy,
+					row.UpdatedBy,
+					dto.NewSeries(row.SeriesID, row.SeriesTitle, int(row.SeriesPosition)),
 					tagDtoFromQueryModel(row.Tags)...,
 				),
 			)
@@ -61,8 +66,13 @@ func (u *ListBefore) Execute(ctx context.Context, in dto.ListBeforeInput) (*dto.
 					row.Title,
 					row.Body,
 					row.Thumbnail,
+					imageVariantDtoFromQueryModel(row.ThumbnailVariants),
 					row.CreatedAt,
 					row.UpdatedAt,
+					row.Slug,
+					row.CreatedBy,
+					row.UpdatedBy,
+					dto.NewSeries(row.SeriesID, row.SeriesTitle, int(row.SeriesPosition)),
 					tagDtoFromQueryModel(row.Tags)...,
 				),
 			)
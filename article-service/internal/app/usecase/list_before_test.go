@@ -82,8 +82,13 @@ func (s *ListBeforeTestSuite) TestListBefore_Execute() {
 						"happy_path2",
 						"## happy_path",
 						"thumbnail",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("1", "tag1"),
 						dto.NewTag("2", "tag2"),
 					),
@@ -132,8 +137,13 @@ func (s *ListBeforeTestSuite) TestListBefore_Execute() {
 						"happy_path1",
 						"## happy_path",
 						"thumbnail",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("1", "tag1"),
 						dto.NewTag("2", "tag2"),
 					),
@@ -218,8 +228,13 @@ func (s *ListBeforeTestSuite) TestListBefore_Execute() {
 						"happy_path3",
 						"## happy_path",
 						"thumbnail",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("1", "tag1"),
 						dto.NewTag("2", "tag2"),
 					),
@@ -228,8 +243,13 @@ func (s *ListBeforeTestSuite) TestListBefore_Execute() {
 						"happy_path2",
 						"## happy_path",
 						"thumbnail",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("1", "tag1"),
 						dto.NewTag("2", "tag2"),
 					),
@@ -296,8 +316,13 @@ func (s *ListBeforeTestSuite) TestListBefore_Execute() {
 						"happy_path2",
 						"## happy_path",
 						"thumbnail",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("1", "tag1"),
 						dto.NewTag("2", "tag2"),
 					),
@@ -306,8 +331,13 @@ func (s *ListBeforeTestSuite) TestListBefore_Execute() {
 						"happy_path1",
 						"## happy_path",
 						"thumbnail",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("1", "tag1"),
 						dto.NewTag("2", "tag2"),
 					),
@@ -395,8 +425,13 @@ func (s *ListBeforeTestSuite) TestListBefore_Execute() {
 						"happy_path2",
 						"## happy_path",
 						"thumbnail",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("1", "tag1"),
 						dto.NewTag("2", "tag2"),
 					),
@@ -450,8 +485,13 @@ func (s *ListBeforeTestSuite) TestListBefore_Execute() {
 						"happy_path1",
 						"## happy_path",
 						"thumbnail",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("1", "tag1"),
 						dto.NewTag("2", "tag2"),
 					),
@@ -541,8 +581,13 @@ func (s *ListBeforeTestSuite) TestListBefore_Execute() {
 						"happy_path3",
 						"## happy_path",
 						"thumbnail",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("1", "tag1"),
 						dto.NewTag("2", "tag2"),
 					),
@@ -551,8 +596,13 @@ func (s *ListBeforeTestSuite) TestListBefore_Execute() {
 						"happy_path2",
 						"## happy_path",
 						"thumbnail",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("1", "tag1"),
 						dto.NewTag("2", "tag2"),
 					),
@@ -624,8 +674,13 @@ func (s *ListBeforeTestSuite) TestListBefore_Execute() {
 						"happy_path2",
 						"## happy_path",
 						"thumbnail",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("1", "tag1"),
 						dto.NewTag("2", "tag2"),
 					),
@@ -634,8 +689,13 @@ func (s *ListBeforeTestSuite) TestListBefore_Execute() {
 						"happy_path1",
 						"## happy_path",
 						"thumbnail",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("1", "tag1"),
 						dto.NewTag("2", "tag2"),
 					),
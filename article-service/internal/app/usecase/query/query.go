@@ -8,6 +8,8 @@ import (
 
 type Queries interface {
 	GetByID(ctx context.Context, id string) (sqlc.GetByIDRow, error)
+	GetBySlug(ctx context.Context, slug string) (sqlc.GetBySlugRow, error)
+	GetSeries(ctx context.Context, seriesID string) ([]sqlc.GetSeriesRow, error)
 	ListAfter(ctx context.Context) ([]sqlc.ListAfterRow, error)
 	ListAfterWithLimit(ctx context.Context, limit int32) ([]sqlc.ListAfterWithLimitRow, error)
 	ListAfterWithLimitAndCursor(
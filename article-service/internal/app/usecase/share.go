@@ -8,6 +8,19 @@ import (
 	"blogapi.miyamo.today/article-service/internal/infra/rdb/types"
 )
 
+// imageVariantDtoFromQueryModel converts types.ImageVariant to dto.ImageVariant
+func imageVariantDtoFromQueryModel(variants []types.ImageVariant) []dto.ImageVariant {
+	return slices.Collect(
+		func(yield func(dto.ImageVariant) bool) {
+			for _, v := range variants {
+				if !yield(dto.NewImageVariant(v.URL, v.Width, v.Height, v.ContentType)) {
+					return
+				}
+			}
+		},
+	)
+}
+
 // tagDtoFromQueryModel converts type.Tag to dto.Tag
 func tagDtoFromQueryModel(tags []types.Tag) []dto.Tag {
 	return slices.Collect(
@@ -18,18 +18,24 @@ var ArticleServiceSet = wire.NewSet(
 
 func ArticleServiceServer(
 	getByIDUsecase usecase.GetByID,
+	getBySlugUsecase usecase.GetBySlug,
 	listAllUsecase usecase.ListAll,
 	listAfterUsecase usecase.ListAfter,
 	listBeforeUsecase usecase.ListBefore,
+	getSeriesUsecase usecase.GetSeries,
 	getByIDConverter convert.GetByID,
+	getBySlugConverter convert.GetBySlug,
 	listAllConverter convert.ListAll,
 	listAfterConverter convert.ListAfter,
 	listBeforeConverter convert.ListBefore,
+	getSeriesConverter convert.GetSeries,
 ) *pb.ArticleServiceServer {
 	return pb.NewArticleServiceServer(
 		pb.WithGetByID(getByIDUsecase, getByIDConverter),
+		pb.WithGetBySlug(getBySlugUsecase, getBySlugConverter),
 		pb.WithListAll(listAllUsecase, listAllConverter),
 		pb.WithListAfter(listAfterUsecase, listAfterConverter),
 		pb.WithListBefore(listBeforeUsecase, listBeforeConverter),
+		pb.WithGetSeries(getSeriesUsecase, getSeriesConverter),
 	)
 }
@@ -10,7 +10,9 @@ import (
 var _ convert.ListAfter = (*impl.ListAfter)(nil)
 var _ convert.ListAll = (*impl.ListAll)(nil)
 var _ convert.GetByID = (*impl.GetByID)(nil)
+var _ convert.GetBySlug = (*impl.GetBySlug)(nil)
 var _ convert.ListBefore = (*impl.ListBefore)(nil)
+var _ convert.GetSeries = (*impl.GetSeries)(nil)
 
 var PresenterSet = wire.NewSet(
 	impl.NewListAfter,
@@ -19,6 +21,10 @@ var PresenterSet = wire.NewSet(
 	wire.Bind(new(convert.ListAll), new(*impl.ListAll)),
 	impl.NewGetByID,
 	wire.Bind(new(convert.GetByID), new(*impl.GetByID)),
+	impl.NewGetBySlug,
+	wire.Bind(new(convert.GetBySlug), new(*impl.GetBySlug)),
 	impl.NewListBefore,
 	wire.Bind(new(convert.ListBefore), new(*impl.ListBefore)),
+	impl.NewGetSeries,
+	wire.Bind(new(convert.GetSeries), new(*impl.GetSeries)),
 )
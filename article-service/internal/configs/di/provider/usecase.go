@@ -9,18 +9,24 @@ import (
 // compatibility check
 var (
 	_ usecase.GetByID    = (*impl.GetByID)(nil)
+	_ usecase.GetBySlug  = (*impl.GetBySlug)(nil)
 	_ usecase.ListAll    = (*impl.ListAll)(nil)
 	_ usecase.ListAfter  = (*impl.ListAfter)(nil)
 	_ usecase.ListBefore = (*impl.ListBefore)(nil)
+	_ usecase.GetSeries  = (*impl.GetSeries)(nil)
 )
 
 var UsecaseSet = wire.NewSet(
 	impl.NewGetByID,
 	wire.Bind(new(usecase.GetByID), new(*impl.GetByID)),
+	impl.NewGetBySlug,
+	wire.Bind(new(usecase.GetBySlug), new(*impl.GetBySlug)),
 	impl.NewListAll,
 	wire.Bind(new(usecase.ListAll), new(*impl.ListAll)),
 	impl.NewListAfter,
 	wire.Bind(new(usecase.ListAfter), new(*impl.ListAfter)),
 	impl.NewListBefore,
 	wire.Bind(new(usecase.ListBefore), new(*impl.ListBefore)),
+	impl.NewGetSeries,
+	wire.Bind(new(usecase.GetSeries), new(*impl.GetSeries)),
 )
@@ -19,14 +19,18 @@ func GetEchoApp() *echo.Echo {
 	db := provider.SQLDB()
 	queries := provider.QueryService(db)
 	getByID := usecase.NewGetByID(queries)
+	getBySlug := usecase.NewGetBySlug(queries)
 	listAll := usecase.NewListAll(queries)
 	listAfter := usecase.NewListAfter(queries)
 	listBefore := usecase.NewListBefore(queries)
+	getSeries := usecase.NewGetSeries(queries)
 	convertGetByID := convert.NewGetByID()
+	convertGetBySlug := convert.NewGetBySlug()
 	convertListAll := convert.NewListAll()
 	convertListAfter := convert.NewListAfter()
 	convertListBefore := convert.NewListBefore()
-	articleServiceServer := provider.ArticleServiceServer(getByID, listAll, listAfter, listBefore, convertGetByID, convertListAll, convertListAfter, convertListBefore)
+	convertGetSeries := convert.NewGetSeries()
+	articleServiceServer := provider.ArticleServiceServer(getByID, getBySlug, listAll, listAfter, listBefore, getSeries, convertGetByID, convertGetBySlug, convertListAll, convertListAfter, convertListBefore, convertGetSeries)
 	application := provider.NewRelic()
 	echoEcho := Echo(articleServiceServer, application)
 	return echoEcho
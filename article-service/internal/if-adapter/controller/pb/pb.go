@@ -22,20 +22,26 @@ var _ grpcconnect.ArticleServiceHandler = (*ArticleServiceServer)(nil)
 // ArticleServiceServer implements grpc.ArticleServiceServer
 type ArticleServiceServer struct {
 	getByIDUsecase      usecase.GetByID
+	getBySlugUsecase    usecase.GetBySlug
 	listAllUsecase      usecase.ListAll
 	listAfterUsecase    usecase.ListAfter
 	listBeforeUsecase   usecase.ListBefore
+	getSeriesUsecase    usecase.GetSeries
 	listAfterConverter  convert.ListAfter
 	listAllConverter    convert.ListAll
 	getByIDConverter    convert.GetByID
+	getBySlugConverter  convert.GetBySlug
 	listBeforeConverter convert.ListBefore
+	getSeriesConverter  convert.GetSeries
 }
 
 var (
-	ErrConversionToListNextFailed = errors.New("conversion to get_next_articles_response failed")
-	ErrConversionToListAllFailed  = errors.New("conversion to get_all_articles_response failed")
-	ErrConversionToGetByIDFailed  = errors.New("conversion to get_article_by_id_response failed")
-	ErrConversionToListPrevFailed = errors.New("conversion to get_prev_articles_response failed")
+	ErrConversionToListNextFailed  = errors.New("conversion to get_next_articles_response failed")
+	ErrConversionToListAllFailed   = errors.New("conversion to get_all_articles_response failed")
+	ErrConversionToGetByIDFailed   = errors.New("conversion to get_article_by_id_response failed")
+	ErrConversionToGetBySlugFailed = errors.New("conversion to get_article_by_slug_response failed")
+	ErrConversionToListPrevFailed  = errors.New("conversion to get_prev_articles_response failed")
+	ErrConversionToGetSeriesFailed = errors.New("conversion to get_series_response failed")
 )
 
 // GetAllArticles implements grpc.ArticleServiceServer.GetAllArticles
@@ -108,6 +114,28 @@ func (s *ArticleServiceServer) GetArticleById(
 	return connect.NewResponse(res), nil
 }
 
+// GetArticleBySlug implements grpc.ArticleServiceServer.GetArticleBySlug
+// A former slug gets the article as well. Its response has the current slug, so that the caller can redirect to it.
+func (s *ArticleServiceServer) GetArticleBySlug(
+	ctx context.Context, in *connect.Request[grpc.GetArticleBySlugRequest],
+) (*connect.Response[grpc.GetArticleBySlugResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("GetArticleBySlug").End()
+
+	oDto, err := s.getBySlugUsecase.Execute(ctx, dto.NewGetBySlugInput(in.Msg.GetSlug()))
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, err
+	}
+	res, ok := s.getBySlugConverter.ToResponse(ctx, oDto)
+	if !ok {
+		nrtx.NoticeError(nrpkgerrors.Wrap(ErrConversionToGetBySlugFailed))
+		return nil, ErrConversionToGetBySlugFailed
+	}
+	return connect.NewResponse(res), nil
+}
+
 // GetPrevArticles implements grpc.ArticleServiceServer.GetPrevArticles
 func (s *ArticleServiceServer) GetPrevArticles(
 	ctx context.Context, in *connect.Request[grpc.GetPrevArticlesRequest],
@@ -134,6 +162,27 @@ func (s *ArticleServiceServer) GetPrevArticles(
 	return connect.NewResponse(res), nil
 }
 
+// GetSeries implements grpc.ArticleServiceServer.GetSeries
+func (s *ArticleServiceServer) GetSeries(
+	ctx context.Context, in *connect.Request[grpc.GetSeriesRequest],
+) (*connect.Response[grpc.GetSeriesResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("GetSeries").End()
+
+	oDto, err := s.getSeriesUsecase.Execute(ctx, dto.NewGetSeriesInput(in.Msg.GetId()))
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, err
+	}
+	res, ok := s.getSeriesConverter.ToResponse(ctx, oDto)
+	if !ok {
+		nrtx.NoticeError(nrpkgerrors.Wrap(ErrConversionToGetSeriesFailed))
+		return nil, ErrConversionToGetSeriesFailed
+	}
+	return connect.NewResponse(res), nil
+}
+
 // NewArticleServiceServerOption sets options for NewArticleServiceServer
 type NewArticleServiceServerOption func(server *ArticleServiceServer)
 
@@ -145,6 +194,14 @@ func WithGetByID(u usecase.GetByID, conv convert.GetByID) NewArticleServiceServe
 	}
 }
 
+// WithGetBySlug sets GetBySlug usecase and converter
+func WithGetBySlug(u usecase.GetBySlug, conv convert.GetBySlug) NewArticleServiceServerOption {
+	return func(s *ArticleServiceServer) {
+		s.getBySlugUsecase = u
+		s.getBySlugConverter = conv
+	}
+}
+
 // WithListAll sets ListAll usecase and converter
 func WithListAll(u usecase.ListAll, conv convert.ListAll) NewArticleServiceServerOption {
 	return func(s *ArticleServiceServer) {
@@ -169,6 +226,14 @@ func WithListBefore(u usecase.ListBefore, conv convert.ListBefore) NewArticleSer
 	}
 }
 
+// WithGetSeries sets GetSeries usecase and converter
+func WithGetSeries(u usecase.GetSeries, conv convert.GetSeries) NewArticleServiceServerOption {
+	return func(s *ArticleServiceServer) {
+		s.getSeriesUsecase = u
+		s.getSeriesConverter = conv
+	}
+}
+
 // NewArticleServiceServer constructs ArticleServiceServer
 func NewArticleServiceServer(options ...NewArticleServiceServerOption) *ArticleServiceServer {
 	var s ArticleServiceServer
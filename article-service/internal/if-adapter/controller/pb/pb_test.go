@@ -36,8 +36,13 @@ func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetArticleById(
 				"happy_path/article_has_tag",
 				"## happy_path/article_has_tag",
 				"1234567890",
+				nil,
 				synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 				synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+				"",
+				"",
+				"",
+				dto.Series{},
 				dto.NewTag("1", "happy_path"),
 			)
 
@@ -113,8 +118,13 @@ func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetArticleById(
 				"happy_path/article_has_tag",
 				"## happy_path/article_has_tag",
 				"1234567890",
+				nil,
 				synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 				synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+				"",
+				"",
+				"",
+				dto.Series{},
 				dto.NewTag("1", "happy_path"),
 			)
 
@@ -141,6 +151,133 @@ func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetArticleById(
 	)
 }
 
+func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetArticleBySlug() {
+	s.Run(
+		"happy_path", func() {
+			ctrl := NewMockController(s.T())
+			uc := Mock[usecase.GetBySlug](ctrl)
+
+			getBySlugOutput := dto.NewArticle(
+				"1",
+				"happy_path/article_has_tag",
+				"## happy_path/article_has_tag",
+				"1234567890",
+				nil,
+				synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+				synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+				"hello-world",
+				"",
+				"",
+				dto.Series{},
+				dto.NewTag("1", "happy_path"),
+			)
+
+			WhenDouble(uc.Execute(AnyContext(), Equal(dto.NewGetBySlugInput("hello-world")))).
+				ThenReturn(&getBySlugOutput, nil)
+
+			res := &grpc.GetArticleBySlugResponse{
+				Article: &grpc.Article{
+					Id:           "1",
+					Title:        "happy_path/article_has_tag",
+					Body:         "## happy_path/article_has_tag",
+					ThumbnailUrl: "1234567890",
+					CreatedAt:    timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+					UpdatedAt:    timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+					Tags: []*grpc.Tag{
+						{
+							Id:   "1",
+							Name: "happy_path",
+						},
+					},
+					Slug: "hello-world",
+				},
+			}
+
+			conv := Mock[convert.GetBySlug](ctrl)
+			WhenDouble(conv.ToResponse(AnyContext(), Equal(&getBySlugOutput))).
+				ThenReturn(res, true)
+
+			sut := NewArticleServiceServer(WithGetBySlug(uc, conv))
+			got, err := sut.GetArticleBySlug(
+				s.T().Context(),
+				connect.NewRequest(
+					&grpc.GetArticleBySlugRequest{
+						Slug: "hello-world",
+					},
+				),
+			)
+			s.Require().NoError(err)
+			s.Require().NotNil(got)
+			s.Require().Equal(res, got.Msg)
+		},
+	)
+	s.Run(
+		"unhappy_path/usecase_returns_error", func() {
+			errGetArticleBySlug := errors.New("error get article by slug")
+
+			ctrl := NewMockController(s.T())
+			uc := Mock[usecase.GetBySlug](ctrl)
+
+			WhenDouble(uc.Execute(AnyContext(), Equal(dto.NewGetBySlugInput("hello-world")))).
+				ThenReturn(nil, errGetArticleBySlug)
+
+			sut := NewArticleServiceServer(WithGetBySlug(uc, nil))
+			got, err := sut.GetArticleBySlug(
+				s.T().Context(),
+				connect.NewRequest(
+					&grpc.GetArticleBySlugRequest{
+						Slug: "hello-world",
+					},
+				),
+			)
+			s.Require().Error(err)
+			s.Require().ErrorIs(err, errGetArticleBySlug)
+			s.Require().Nil(got)
+		},
+	)
+	s.Run(
+		"unhappy_path/failed_to_convert", func() {
+			ctrl := NewMockController(s.T())
+			uc := Mock[usecase.GetBySlug](ctrl)
+
+			getBySlugOutput := dto.NewArticle(
+				"1",
+				"happy_path/article_has_tag",
+				"## happy_path/article_has_tag",
+				"1234567890",
+				nil,
+				synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+				synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+				"hello-world",
+				"",
+				"",
+				dto.Series{},
+				dto.NewTag("1", "happy_path"),
+			)
+
+			WhenDouble(uc.Execute(AnyContext(), Equal(dto.NewGetBySlugInput("hello-world")))).
+				ThenReturn(&getBySlugOutput, nil)
+
+			conv := Mock[convert.GetBySlug](ctrl)
+			WhenDouble(conv.ToResponse(AnyContext(), Equal(&getBySlugOutput))).
+				ThenReturn(nil, false)
+
+			sut := NewArticleServiceServer(WithGetBySlug(uc, conv))
+			got, err := sut.GetArticleBySlug(
+				s.T().Context(),
+				connect.NewRequest(
+					&grpc.GetArticleBySlugRequest{
+						Slug: "hello-world",
+					},
+				),
+			)
+			s.Require().Error(err)
+			s.Require().ErrorIs(err, ErrConversionToGetBySlugFailed)
+			s.Require().Nil(got)
+		},
+	)
+}
+
 func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetAllArticles() {
 	s.Run(
 		"happy_path", func() {
@@ -153,8 +290,13 @@ func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetAllArticles(
 					"happy_path1",
 					"## happy_path1",
 					"1234567890",
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.Series{},
 					dto.NewTag("tag1", "1"),
 					dto.NewTag("tag2", "2"),
 				),
@@ -230,8 +372,13 @@ func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetAllArticles(
 					"happy_path1",
 					"## happy_path1",
 					"1234567890",
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.Series{},
 					dto.NewTag("tag1", "1"),
 					dto.NewTag("tag2", "2"),
 				),
@@ -268,8 +415,13 @@ func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetNextArticles
 					"happy_path1",
 					"## happy_path1",
 					"1234567890",
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.Series{},
 					dto.NewTag("tag1", "1"),
 					dto.NewTag("tag2", "2"),
 				),
@@ -336,8 +488,13 @@ func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetNextArticles
 					"happy_path1",
 					"## happy_path1",
 					"1234567890",
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.Series{},
 					dto.NewTag("tag1", "1"),
 					dto.NewTag("tag2", "2"),
 				),
@@ -437,8 +594,13 @@ func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetNextArticles
 					"happy_path1",
 					"## happy_path1",
 					"1234567890",
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.Series{},
 					dto.NewTag("tag1", "1"),
 					dto.NewTag("tag2", "2"),
 				),
@@ -484,8 +646,13 @@ func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetPrevArticles
 					"happy_path1",
 					"## happy_path1",
 					"1234567890",
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.Series{},
 					dto.NewTag("tag1", "1"),
 					dto.NewTag("tag2", "2"),
 				),
@@ -552,8 +719,13 @@ func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetPrevArticles
 					"happy_path1",
 					"## happy_path1",
 					"1234567890",
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.Series{},
 					dto.NewTag("tag1", "1"),
 					dto.NewTag("tag2", "2"),
 				),
@@ -653,8 +825,13 @@ func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetPrevArticles
 					"happy_path1",
 					"## happy_path1",
 					"1234567890",
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.Series{},
 					dto.NewTag("tag1", "1"),
 					dto.NewTag("tag2", "2"),
 				),
@@ -686,3 +863,116 @@ func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetPrevArticles
 		},
 	)
 }
+
+func (s *ArticleServiceServerTestSuite) TestArticleServiceServer_GetSeries() {
+	s.Run(
+		"happy_path", func() {
+			ctrl := NewMockController(s.T())
+			uc := Mock[usecase.GetSeries](ctrl)
+
+			getSeriesOutput := dto.NewGetSeriesOutput(
+				dto.NewArticle(
+					"1",
+					"happy_path/part1",
+					"## happy_path/part1",
+					"1234567890",
+					nil,
+					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+					"",
+					"",
+					"",
+					dto.NewSeries("series1", "Go in Practice", 1),
+				),
+			)
+
+			WhenDouble(uc.Execute(AnyContext(), Equal(dto.NewGetSeriesInput("series1")))).
+				ThenReturn(&getSeriesOutput, nil)
+
+			res := &grpc.GetSeriesResponse{
+				Articles: []*grpc.Article{
+					{
+						Id:             "1",
+						Title:          "happy_path/part1",
+						Body:           "## happy_path/part1",
+						ThumbnailUrl:   "1234567890",
+						CreatedAt:      timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+						UpdatedAt:      timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+						SeriesId:       "series1",
+						SeriesTitle:    "Go in Practice",
+						SeriesPosition: 1,
+					},
+				},
+			}
+
+			conv := Mock[convert.GetSeries](ctrl)
+			WhenDouble(conv.ToResponse(AnyContext(), Equal(&getSeriesOutput))).
+				ThenReturn(res, true)
+
+			sut := NewArticleServiceServer(WithGetSeries(uc, conv))
+			got, err := sut.GetSeries(
+				s.T().Context(),
+				connect.NewRequest(
+					&grpc.GetSeriesRequest{
+						Id: "series1",
+					},
+				),
+			)
+			s.Require().NoError(err)
+			s.Require().NotNil(got)
+			s.Require().Equal(res, got.Msg)
+		},
+	)
+	s.Run(
+		"unhappy_path/usecase_returns_error", func() {
+			errGetSeries := errors.New("error get series")
+
+			ctrl := NewMockController(s.T())
+			uc := Mock[usecase.GetSeries](ctrl)
+
+			WhenDouble(uc.Execute(AnyContext(), Equal(dto.NewGetSeriesInput("series1")))).
+				ThenReturn(nil, errGetSeries)
+
+			sut := NewArticleServiceServer(WithGetSeries(uc, nil))
+			got, err := sut.GetSeries(
+				s.T().Context(),
+				connect.NewRequest(
+					&grpc.GetSeriesRequest{
+						Id: "series1",
+					},
+				),
+			)
+			s.Require().Error(err)
+			s.Require().ErrorIs(err, errGetSeries)
+			s.Require().Nil(got)
+		},
+	)
+	s.Run(
+		"unhappy_path/failed_to_convert", func() {
+			ctrl := NewMockController(s.T())
+			uc := Mock[usecase.GetSeries](ctrl)
+
+			getSeriesOutput := dto.NewGetSeriesOutput()
+
+			WhenDouble(uc.Execute(AnyContext(), Equal(dto.NewGetSeriesInput("series1")))).
+				ThenReturn(&getSeriesOutput, nil)
+
+			conv := Mock[convert.GetSeries](ctrl)
+			WhenDouble(conv.ToResponse(AnyContext(), Equal(&getSeriesOutput))).
+				ThenReturn(nil, false)
+
+			sut := NewArticleServiceServer(WithGetSeries(uc, conv))
+			got, err := sut.GetSeries(
+				s.T().Context(),
+				connect.NewRequest(
+					&grpc.GetSeriesRequest{
+						Id: "series1",
+					},
+				),
+			)
+			s.Require().Error(err)
+			s.Require().ErrorIs(err, ErrConversionToGetSeriesFailed)
+			s.Require().Nil(got)
+		},
+	)
+}
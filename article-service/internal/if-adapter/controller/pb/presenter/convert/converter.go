@@ -14,6 +14,12 @@ type GetByID interface {
 	)
 }
 
+type GetBySlug interface {
+	ToResponse(ctx context.Context, from *dto.GetBySlugOutput) (
+		response *grpc.GetArticleBySlugResponse, ok bool,
+	)
+}
+
 type ListAll interface {
 	ToResponse(ctx context.Context, from *dto.ListAllOutput) (
 		response *grpc.GetAllArticlesResponse, ok bool,
@@ -31,3 +37,9 @@ type ListBefore interface {
 		response *grpc.GetPrevArticlesResponse, ok bool,
 	)
 }
+
+type GetSeries interface {
+	ToResponse(ctx context.Context, from *dto.GetSeriesOutput) (
+		response *grpc.GetSeriesResponse, ok bool,
+	)
+}
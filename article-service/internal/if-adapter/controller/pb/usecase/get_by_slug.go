@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"blogapi.miyamo.today/article-service/internal/app/usecase/dto"
+)
+
+// GetBySlug provides the feature to get an article by its current or a former slug.
+type GetBySlug interface {
+	// Execute gets an article by slug.
+	Execute(ctx context.Context, in dto.GetBySlugInput) (*dto.GetBySlugOutput, error)
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"blogapi.miyamo.today/article-service/internal/app/usecase/dto"
+)
+
+// GetSeries provides the feature to get the published articles of a series.
+type GetSeries interface {
+	// Execute gets the articles of a series in the order of their position.
+	Execute(ctx context.Context, in dto.GetSeriesInput) (*dto.GetSeriesOutput, error)
+}
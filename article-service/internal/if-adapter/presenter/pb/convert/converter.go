@@ -32,13 +32,20 @@ func (c *ListAfter) ToResponse(
 		}
 		articlePBs = append(
 			articlePBs, &grpc.Article{
-				Id:           a.ID(),
-				Title:        a.Title(),
-				Body:         a.Body(),
-				ThumbnailUrl: a.ThumbnailUrl(),
-				CreatedAt:    timestamppb.New(a.CreatedAt().StdTime()),
-				UpdatedAt:    timestamppb.New(a.UpdatedAt().StdTime()),
-				Tags:         tagPBs,
+				Id:                a.ID(),
+				Title:             a.Title(),
+				Body:              a.Body(),
+				ThumbnailUrl:      a.ThumbnailUrl(),
+				ThumbnailVariants: imageVariantPBs(a.ThumbnailVariants()),
+				CreatedAt:         timestamppb.New(a.CreatedAt().StdTime()),
+				UpdatedAt:         timestamppb.New(a.UpdatedAt().StdTime()),
+				Tags:              tagPBs,
+				Slug:              a.Slug(),
+				CreatedBy:         a.CreatedBy(),
+				UpdatedBy:         a.UpdatedBy(),
+				SeriesId:          a.Series().ID(),
+				SeriesTitle:       a.Series().Title(),
+				SeriesPosition:    int32(a.Series().Position()),
 			},
 		)
 	}
@@ -77,13 +84,20 @@ func (c *ListAll) ToResponse(
 		}
 		articlePBs = append(
 			articlePBs, &grpc.Article{
-				Id:           a.ID(),
-				Title:        a.Title(),
-				Body:         a.Body(),
-				ThumbnailUrl: a.ThumbnailUrl(),
-				CreatedAt:    timestamppb.New(a.CreatedAt().StdTime()),
-				UpdatedAt:    timestamppb.New(a.UpdatedAt().StdTime()),
-				Tags:         tagPBs,
+				Id:                a.ID(),
+				Title:             a.Title(),
+				Body:              a.Body(),
+				ThumbnailUrl:      a.ThumbnailUrl(),
+				ThumbnailVariants: imageVariantPBs(a.ThumbnailVariants()),
+				CreatedAt:         timestamppb.New(a.CreatedAt().StdTime()),
+				UpdatedAt:         timestamppb.New(a.UpdatedAt().StdTime()),
+				Tags:              tagPBs,
+				Slug:              a.Slug(),
+				CreatedBy:         a.CreatedBy(),
+				UpdatedBy:         a.UpdatedBy(),
+				SeriesId:          a.Series().ID(),
+				SeriesTitle:       a.Series().Title(),
+				SeriesPosition:    int32(a.Series().Position()),
 			},
 		)
 	}
@@ -117,13 +131,20 @@ func (c *GetByID) ToResponse(
 		)
 	}
 	articlePB := &grpc.Article{
-		Id:           from.ID(),
-		Title:        from.Title(),
-		Body:         from.Body(),
-		ThumbnailUrl: from.ThumbnailUrl(),
-		CreatedAt:    timestamppb.New(from.CreatedAt().StdTime()),
-		UpdatedAt:    timestamppb.New(from.UpdatedAt().StdTime()),
-		Tags:         tagPBs,
+		Id:                from.ID(),
+		Title:             from.Title(),
+		Body:              from.Body(),
+		ThumbnailUrl:      from.ThumbnailUrl(),
+		ThumbnailVariants: imageVariantPBs(from.ThumbnailVariants()),
+		CreatedAt:         timestamppb.New(from.CreatedAt().StdTime()),
+		UpdatedAt:         timestamppb.New(from.UpdatedAt().StdTime()),
+		Tags:              tagPBs,
+		Slug:              from.Slug(),
+		CreatedBy:         from.CreatedBy(),
+		UpdatedBy:         from.UpdatedBy(),
+		SeriesId:          from.Series().ID(),
+		SeriesTitle:       from.Series().Title(),
+		SeriesPosition:    int32(from.Series().Position()),
 	}
 	response = &grpc.GetArticleByIdResponse{
 		Article: articlePB,
@@ -136,6 +157,51 @@ func NewGetByID() *GetByID {
 	return &GetByID{}
 }
 
+type GetBySlug struct{}
+
+func (c *GetBySlug) ToResponse(
+	ctx context.Context, from *dto.GetBySlugOutput,
+) (response *grpc.GetArticleBySlugResponse, ok bool) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToGetBySlugArticleResponse").End()
+
+	tagDTOs := from.Tags()
+	tagPBs := make([]*grpc.Tag, 0, len(tagDTOs))
+	for _, t := range tagDTOs {
+		tagPBs = append(
+			tagPBs, &grpc.Tag{
+				Id:   t.ID(),
+				Name: t.Name(),
+			},
+		)
+	}
+	articlePB := &grpc.Article{
+		Id:                from.ID(),
+		Title:             from.Title(),
+		Body:              from.Body(),
+		ThumbnailUrl:      from.ThumbnailUrl(),
+		ThumbnailVariants: imageVariantPBs(from.ThumbnailVariants()),
+		CreatedAt:         timestamppb.New(from.CreatedAt().StdTime()),
+		UpdatedAt:         timestamppb.New(from.UpdatedAt().StdTime()),
+		Tags:              tagPBs,
+		Slug:              from.Slug(),
+		CreatedBy:         from.CreatedBy(),
+		UpdatedBy:         from.UpdatedBy(),
+		SeriesId:          from.Series().ID(),
+		SeriesTitle:       from.Series().Title(),
+		SeriesPosition:    int32(from.Series().Position()),
+	}
+	response = &grpc.GetArticleBySlugResponse{
+		Article: articlePB,
+	}
+	ok = true
+	return
+}
+
+func NewGetBySlug() *GetBySlug {
+	return &GetBySlug{}
+}
+
 type ListBefore struct{}
 
 func (c *ListBefore) ToResponse(
@@ -159,13 +225,20 @@ func (c *ListBefore) ToResponse(
 		}
 		articlePBs = append(
 			articlePBs, &grpc.Article{
-				Id:           a.ID(),
-				Title:        a.Title(),
-				Body:         a.Body(),
-				ThumbnailUrl: a.ThumbnailUrl(),
-				CreatedAt:    timestamppb.New(a.CreatedAt().StdTime()),
-				UpdatedAt:    timestamppb.New(a.UpdatedAt().StdTime()),
-				Tags:         tagPBs,
+				Id:                a.ID(),
+				Title:             a.Title(),
+				Body:              a.Body(),
+				ThumbnailUrl:      a.ThumbnailUrl(),
+				ThumbnailVariants: imageVariantPBs(a.ThumbnailVariants()),
+				CreatedAt:         timestamppb.New(a.CreatedAt().StdTime()),
+				UpdatedAt:         timestamppb.New(a.UpdatedAt().StdTime()),
+				Tags:              tagPBs,
+				Slug:              a.Slug(),
+				CreatedBy:         a.CreatedBy(),
+				UpdatedBy:         a.UpdatedBy(),
+				SeriesId:          a.Series().ID(),
+				SeriesTitle:       a.Series().Title(),
+				SeriesPosition:    int32(a.Series().Position()),
 			},
 		)
 	}
@@ -180,3 +253,70 @@ func (c *ListBefore) ToResponse(
 func NewListBefore() *ListBefore {
 	return &ListBefore{}
 }
+
+type GetSeries struct{}
+
+func (c *GetSeries) ToResponse(
+	ctx context.Context, from *dto.GetSeriesOutput,
+) (response *grpc.GetSeriesResponse, ok bool) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToGetSeriesResponse").End()
+
+	articleDTOs := from.Articles()
+	articlePBs := make([]*grpc.Article, 0, len(articleDTOs))
+	for _, a := range articleDTOs {
+		tagDTOs := a.Tags()
+		tagPBs := make([]*grpc.Tag, 0, len(tagDTOs))
+		for _, t := range tagDTOs {
+			tagPBs = append(
+				tagPBs, &grpc.Tag{
+					Id:   t.ID(),
+					Name: t.Name(),
+				},
+			)
+		}
+		articlePBs = append(
+			articlePBs, &grpc.Article{
+				Id:                a.ID(),
+				Title:             a.Title(),
+				Body:              a.Body(),
+				ThumbnailUrl:      a.ThumbnailUrl(),
+				ThumbnailVariants: imageVariantPBs(a.ThumbnailVariants()),
+				CreatedAt:         timestamppb.New(a.CreatedAt().StdTime()),
+				UpdatedAt:         timestamppb.New(a.UpdatedAt().StdTime()),
+				Tags:              tagPBs,
+				Slug:              a.Slug(),
+				CreatedBy:         a.CreatedBy(),
+				UpdatedBy:         a.UpdatedBy(),
+				SeriesId:          a.Series().ID(),
+				SeriesTitle:       a.Series().Title(),
+				SeriesPosition:    int32(a.Series().Position()),
+			},
+		)
+	}
+	response = &grpc.GetSeriesResponse{
+		Articles: articlePBs,
+	}
+	ok = true
+	return
+}
+
+func NewGetSeries() *GetSeries {
+	return &GetSeries{}
+}
+
+// imageVariantPBs converts the variants of the thumbnail to the protobuf messages.
+func imageVariantPBs(variants []dto.ImageVariant) []*grpc.ImageVariant {
+	result := make([]*grpc.ImageVariant, 0, len(variants))
+	for _, v := range variants {
+		result = append(
+			result, &grpc.ImageVariant{
+				Url:         v.URL(),
+				Width:       int32(v.Width()),
+				Height:      int32(v.Height()),
+				ContentType: v.ContentType(),
+			},
+		)
+	}
+	return result
+}
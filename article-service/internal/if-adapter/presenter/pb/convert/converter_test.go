@@ -39,8 +39,13 @@ func TestListAfter_ToResponse(t *testing.T) {
 							"happy_path/multiple/still_exists1",
 							"## happy_path/multiple/still_exists1",
 							"1234567890",
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"",
+							"",
+							"",
+							dto.Series{},
 							dto.NewTag("tag1", "1"),
 							dto.NewTag("tag2", "2"),
 						),
@@ -49,8 +54,13 @@ func TestListAfter_ToResponse(t *testing.T) {
 							"happy_path/multiple/still_exists2",
 							"## happy_path/multiple_/still_exists2",
 							"1234567890",
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"",
+							"",
+							"",
+							dto.Series{},
 							dto.NewTag("tag1", "1"),
 							dto.NewTag("tag2", "2"),
 						),
@@ -114,8 +124,13 @@ func TestListAfter_ToResponse(t *testing.T) {
 							"happy_path/multiple/still_exists1",
 							"## happy_path/multiple/still_exists1",
 							"1234567890",
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"",
+							"",
+							"",
+							dto.Series{},
 							dto.NewTag("tag1", "1"),
 							dto.NewTag("tag2", "2"),
 						),
@@ -124,8 +139,13 @@ func TestListAfter_ToResponse(t *testing.T) {
 							"happy_path/multiple/still_exists2",
 							"## happy_path/multiple_/still_exists2",
 							"1234567890",
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"",
+							"",
+							"",
+							dto.Series{},
 							dto.NewTag("tag1", "1"),
 							dto.NewTag("tag2", "2"),
 						),
@@ -189,8 +209,13 @@ func TestListAfter_ToResponse(t *testing.T) {
 							"happy_path/multiple/still_exists1",
 							"## happy_path/multiple/still_exists1",
 							"1234567890",
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"",
+							"",
+							"",
+							dto.Series{},
 							dto.NewTag("tag1", "1"),
 							dto.NewTag("tag2", "2"),
 						),
@@ -236,8 +261,13 @@ func TestListAfter_ToResponse(t *testing.T) {
 							"happy_path/multiple/still_exists1",
 							"## happy_path/multiple/still_exists1",
 							"1234567890",
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"",
+							"",
+							"",
+							dto.Series{},
 							dto.NewTag("tag1", "1"),
 							dto.NewTag("tag2", "2"),
 						),
@@ -313,8 +343,13 @@ func TestListAll_ToResponse(t *testing.T) {
 							"happy_path/multiple/still_exists1",
 							"## happy_path/multiple/still_exists1",
 							"1234567890",
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"",
+							"",
+							"",
+							dto.Series{},
 							dto.NewTag("tag1", "1"),
 							dto.NewTag("tag2", "2"),
 						),
@@ -323,8 +358,13 @@ func TestListAll_ToResponse(t *testing.T) {
 							"happy_path/multiple/still_exists2",
 							"## happy_path/multiple_/still_exists2",
 							"1234567890",
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"",
+							"",
+							"",
+							dto.Series{},
 							dto.NewTag("tag1", "1"),
 							dto.NewTag("tag2", "2"),
 						),
@@ -386,8 +426,13 @@ func TestListAll_ToResponse(t *testing.T) {
 							"happy_path/multiple/still_exists1",
 							"## happy_path/multiple/still_exists1",
 							"1234567890",
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"",
+							"",
+							"",
+							dto.Series{},
 							dto.NewTag("tag1", "1"),
 							dto.NewTag("tag2", "2"),
 						),
@@ -396,8 +441,13 @@ func TestListAll_ToResponse(t *testing.T) {
 							"happy_path/multiple/still_exists2",
 							"## happy_path/multiple_/still_exists2",
 							"1234567890",
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"",
+							"",
+							"",
+							dto.Series{},
 							dto.NewTag("tag1", "1"),
 							dto.NewTag("tag2", "2"),
 						),
@@ -459,8 +509,13 @@ func TestListAll_ToResponse(t *testing.T) {
 							"happy_path/multiple/still_exists1",
 							"## happy_path/multiple/still_exists1",
 							"1234567890",
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"",
+							"",
+							"",
+							dto.Series{},
 							dto.NewTag("tag1", "1"),
 							dto.NewTag("tag2", "2"),
 						),
@@ -504,8 +559,13 @@ func TestListAll_ToResponse(t *testing.T) {
 							"happy_path/multiple/still_exists1",
 							"## happy_path/multiple/still_exists1",
 							"1234567890",
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"",
+							"",
+							"",
+							dto.Series{},
 							dto.NewTag("tag1", "1"),
 							dto.NewTag("tag2", "2"),
 						),
@@ -579,8 +639,13 @@ func TestGetByID_ToResponse(t *testing.T) {
 						"happy_path/multiple/still_exists1",
 						"## happy_path/multiple/still_exists1",
 						"1234567890",
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
 						dto.NewTag("tag1", "1"),
 						dto.NewTag("tag2", "2"),
 					)
@@ -611,6 +676,85 @@ func TestGetByID_ToResponse(t *testing.T) {
 				ok: true,
 			},
 		},
+		"happy_path/with-actors": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.GetByIDOutput {
+					o := dto.NewGetByIDOutput(
+						"1",
+						"happy_path/with-actors",
+						"## happy_path/with-actors",
+						"1234567890",
+						nil,
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0),
+						"",
+						"author",
+						"editor",
+						dto.Series{},
+					)
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.GetArticleByIdResponse{
+					Article: &grpc.Article{
+						Id:           "1",
+						Title:        "happy_path/with-actors",
+						Body:         "## happy_path/with-actors",
+						ThumbnailUrl: "1234567890",
+						CreatedAt:    timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+						UpdatedAt:    timestamppb.New(synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0).StdTime()),
+						Tags:         []*grpc.Tag{},
+						CreatedBy:    "author",
+						UpdatedBy:    "editor",
+					},
+				},
+				ok: true,
+			},
+		},
+		"happy_path/with-thumbnail-variants": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.GetByIDOutput {
+					o := dto.NewGetByIDOutput(
+						"1",
+						"happy_path/with-thumbnail-variants",
+						"## happy_path/with-thumbnail-variants",
+						"1234567890",
+						[]dto.ImageVariant{
+							dto.NewImageVariant("1234567890-320w.webp", 320, 240, "image/webp"),
+							dto.NewImageVariant("1234567890-640w.webp", 640, 480, "image/webp"),
+						},
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"",
+						"",
+						"",
+						dto.Series{},
+					)
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.GetArticleByIdResponse{
+					Article: &grpc.Article{
+						Id:           "1",
+						Title:        "happy_path/with-thumbnail-variants",
+						Body:         "## happy_path/with-thumbnail-variants",
+						ThumbnailUrl: "1234567890",
+						ThumbnailVariants: []*grpc.ImageVariant{
+							{Url: "1234567890-320w.webp", Width: 320, Height: 240, ContentType: "image/webp"},
+							{Url: "1234567890-640w.webp", Width: 640, Height: 480, ContentType: "image/webp"},
+						},
+						CreatedAt: timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+						UpdatedAt: timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+						Tags:      []*grpc.Tag{},
+					},
+				},
+				ok: true,
+			},
+		},
 	}
 	for name, tt := range tests {
 		t.Run(
@@ -627,3 +771,187 @@ func TestGetByID_ToResponse(t *testing.T) {
 		)
 	}
 }
+
+func TestGetBySlug_ToResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.GetBySlugOutput
+	}
+	type want struct {
+		result *grpc.GetArticleBySlugResponse
+		ok     bool
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.GetBySlugOutput {
+					o := dto.NewArticle(
+						"1",
+						"happy_path/multiple/still_exists1",
+						"## happy_path/multiple/still_exists1",
+						"1234567890",
+						nil,
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						"hello-world",
+						"",
+						"",
+						dto.Series{},
+						dto.NewTag("tag1", "1"),
+						dto.NewTag("tag2", "2"),
+					)
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.GetArticleBySlugResponse{
+					Article: &grpc.Article{
+						Id:           "1",
+						Title:        "happy_path/multiple/still_exists1",
+						Body:         "## happy_path/multiple/still_exists1",
+						ThumbnailUrl: "1234567890",
+						CreatedAt:    timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+						UpdatedAt:    timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+						Tags: []*grpc.Tag{
+							{
+								Id:   "tag1",
+								Name: "1",
+							},
+							{
+								Id:   "tag2",
+								Name: "2",
+							},
+						},
+						Slug: "hello-world",
+					},
+				},
+				ok: true,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(
+			name, func(t *testing.T) {
+				c := NewGetBySlug()
+				got, ok := c.ToResponse(tt.args.ctx, tt.args.from())
+				if tt.want.ok != ok {
+					t.Errorf("ToResponse() ok = %v, want %v", ok, tt.want.ok)
+				}
+				if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+					t.Errorf("ToResponse() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestGetSeries_ToResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.GetSeriesOutput
+	}
+	type want struct {
+		result *grpc.GetSeriesResponse
+		ok     bool
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.GetSeriesOutput {
+					o := dto.NewGetSeriesOutput(
+						dto.NewArticle(
+							"1",
+							"happy_path/part1",
+							"## happy_path/part1",
+							"1234567890",
+							nil,
+							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+							"part-1",
+							"",
+							"",
+							dto.NewSeries("series1", "Go in Practice", 1),
+							dto.NewTag("tag1", "1"),
+						),
+						dto.NewArticle(
+							"2",
+							"happy_path/part2",
+							"## happy_path/part2",
+							"1234567890",
+							nil,
+							synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0),
+							synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0),
+							"part-2",
+							"",
+							"",
+							dto.NewSeries("series1", "Go in Practice", 2),
+						),
+					)
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.GetSeriesResponse{
+					Articles: []*grpc.Article{
+						{
+							Id:           "1",
+							Title:        "happy_path/part1",
+							Body:         "## happy_path/part1",
+							ThumbnailUrl: "1234567890",
+							CreatedAt:    timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+							UpdatedAt:    timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+							Tags: []*grpc.Tag{
+								{
+									Id:   "tag1",
+									Name: "1",
+								},
+							},
+							Slug:           "part-1",
+							SeriesId:       "series1",
+							SeriesTitle:    "Go in Practice",
+							SeriesPosition: 1,
+						},
+						{
+							Id:             "2",
+							Title:          "happy_path/part2",
+							Body:           "## happy_path/part2",
+							ThumbnailUrl:   "1234567890",
+							CreatedAt:      timestamppb.New(synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0).StdTime()),
+							UpdatedAt:      timestamppb.New(synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0).StdTime()),
+							Tags:           []*grpc.Tag{},
+							Slug:           "part-2",
+							SeriesId:       "series1",
+							SeriesTitle:    "Go in Practice",
+							SeriesPosition: 2,
+						},
+					},
+				},
+				ok: true,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(
+			name, func(t *testing.T) {
+				c := NewGetSeries()
+				got, ok := c.ToResponse(tt.args.ctx, tt.args.from())
+				if tt.want.ok != ok {
+					t.Errorf("ToResponse() ok = %v, want %v", ok, tt.want.ok)
+				}
+				if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+					t.Errorf("ToResponse() = %v, want %v", got, tt.want)
+				}
+			},
+		)
+	}
+}
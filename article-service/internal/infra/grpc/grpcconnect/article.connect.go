@@ -37,6 +37,9 @@ const (
 	// ArticleServiceGetArticleByIdProcedure is the fully-qualified name of the ArticleService's
 	// GetArticleById RPC.
 	ArticleServiceGetArticleByIdProcedure = "/article.ArticleService/GetArticleById"
+	// ArticleServiceGetArticleBySlugProcedure is the fully-qualified name of the ArticleService's
+	// GetArticleBySlug RPC.
+	ArticleServiceGetArticleBySlugProcedure = "/article.ArticleService/GetArticleBySlug"
 	// ArticleServiceGetAllArticlesProcedure is the fully-qualified name of the ArticleService's
 	// GetAllArticles RPC.
 	ArticleServiceGetAllArticlesProcedure = "/article.ArticleService/GetAllArticles"
@@ -46,14 +49,19 @@ const (
 	// ArticleServiceGetPrevArticlesProcedure is the fully-qualified name of the ArticleService's
 	// GetPrevArticles RPC.
 	ArticleServiceGetPrevArticlesProcedure = "/article.ArticleService/GetPrevArticles"
+	// ArticleServiceGetSeriesProcedure is the fully-qualified name of the ArticleService's GetSeries
+	// RPC.
+	ArticleServiceGetSeriesProcedure = "/article.ArticleService/GetSeries"
 )
 
 // ArticleServiceClient is a client for the article.ArticleService service.
 type ArticleServiceClient interface {
 	GetArticleById(context.Context, *connect.Request[grpc.GetArticleByIdRequest]) (*connect.Response[grpc.GetArticleByIdResponse], error)
+	GetArticleBySlug(context.Context, *connect.Request[grpc.GetArticleBySlugRequest]) (*connect.Response[grpc.GetArticleBySlugResponse], error)
 	GetAllArticles(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[grpc.GetAllArticlesResponse], error)
 	GetNextArticles(context.Context, *connect.Request[grpc.GetNextArticlesRequest]) (*connect.Response[grpc.GetNextArticlesResponse], error)
 	GetPrevArticles(context.Context, *connect.Request[grpc.GetPrevArticlesRequest]) (*connect.Response[grpc.GetPrevArticlesResponse], error)
+	GetSeries(context.Context, *connect.Request[grpc.GetSeriesRequest]) (*connect.Response[grpc.GetSeriesResponse], error)
 }
 
 // NewArticleServiceClient constructs a client for the article.ArticleService service. By default,
@@ -73,6 +81,12 @@ func NewArticleServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(articleServiceMethods.ByName("GetArticleById")),
 			connect.WithClientOptions(opts...),
 		),
+		getArticleBySlug: connect.NewClient[grpc.GetArticleBySlugRequest, grpc.GetArticleBySlugResponse](
+			httpClient,
+			baseURL+ArticleServiceGetArticleBySlugProcedure,
+			connect.WithSchema(articleServiceMethods.ByName("GetArticleBySlug")),
+			connect.WithClientOptions(opts...),
+		),
 		getAllArticles: connect.NewClient[emptypb.Empty, grpc.GetAllArticlesResponse](
 			httpClient,
 			baseURL+ArticleServiceGetAllArticlesProcedure,
@@ -91,15 +105,23 @@ func NewArticleServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(articleServiceMethods.ByName("GetPrevArticles")),
 			connect.WithClientOptions(opts...),
 		),
+		getSeries: connect.NewClient[grpc.GetSeriesRequest, grpc.GetSeriesResponse](
+			httpClient,
+			baseURL+ArticleServiceGetSeriesProcedure,
+			connect.WithSchema(articleServiceMethods.ByName("GetSeries")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // articleServiceClient implements ArticleServiceClient.
 type articleServiceClient struct {
-	getArticleById  *connect.Client[grpc.GetArticleByIdRequest, grpc.GetArticleByIdResponse]
-	getAllArticles  *connect.Client[emptypb.Empty, grpc.GetAllArticlesResponse]
-	getNextArticles *connect.Client[grpc.GetNextArticlesRequest, grpc.GetNextArticlesResponse]
-	getPrevArticles *connect.Client[grpc.GetPrevArticlesRequest, grpc.GetPrevArticlesResponse]
+	getArticleById   *connect.Client[grpc.GetArticleByIdRequest, grpc.GetArticleByIdResponse]
+	getArticleBySlug *connect.Client[grpc.GetArticleBySlugRequest, grpc.GetArticleBySlugResponse]
+	getAllArticles   *connect.Client[emptypb.Empty, grpc.GetAllArticlesResponse]
+	getNextArticles  *connect.Client[grpc.GetNextArticlesRequest, grpc.GetNextArticlesResponse]
+	getPrevArticles  *connect.Client[grpc.GetPrevArticlesRequest, grpc.GetPrevArticlesResponse]
+	getSeries        *connect.Client[grpc.GetSeriesRequest, grpc.GetSeriesResponse]
 }
 
 // GetArticleById calls article.ArticleService.GetArticleById.
@@ -107,6 +129,11 @@ func (c *articleServiceClient) GetArticleById(ctx context.Context, req *connect.
 	return c.getArticleById.CallUnary(ctx, req)
 }
 
+// GetArticleBySlug calls article.ArticleService.GetArticleBySlug.
+func (c *articleServiceClient) GetArticleBySlug(ctx context.Context, req *connect.Request[grpc.GetArticleBySlugRequest]) (*connect.Response[grpc.GetArticleBySlugResponse], error) {
+	return c.getArticleBySlug.CallUnary(ctx, req)
+}
+
 // GetAllArticles calls article.ArticleService.GetAllArticles.
 func (c *articleServiceClient) GetAllArticles(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[grpc.GetAllArticlesResponse], error) {
 	return c.getAllArticles.CallUnary(ctx, req)
@@ -122,12 +149,19 @@ func (c *articleServiceClient) GetPrevArticles(ctx context.Context, req *connect
 	return c.getPrevArticles.CallUnary(ctx, req)
 }
 
+// GetSeries calls article.ArticleService.GetSeries.
+func (c *articleServiceClient) GetSeries(ctx context.Context, req *connect.Request[grpc.GetSeriesRequest]) (*connect.Response[grpc.GetSeriesResponse], error) {
+	return c.getSeries.CallUnary(ctx, req)
+}
+
 // ArticleServiceHandler is an implementation of the article.ArticleService service.
 type ArticleServiceHandler interface {
 	GetArticleById(context.Context, *connect.Request[grpc.GetArticleByIdRequest]) (*connect.Response[grpc.GetArticleByIdResponse], error)
+	GetArticleBySlug(context.Context, *connect.Request[grpc.GetArticleBySlugRequest]) (*connect.Response[grpc.GetArticleBySlugResponse], error)
 	GetAllArticles(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[grpc.GetAllArticlesResponse], error)
 	GetNextArticles(context.Context, *connect.Request[grpc.GetNextArticlesRequest]) (*connect.Response[grpc.GetNextArticlesResponse], error)
 	GetPrevArticles(context.Context, *connect.Request[grpc.GetPrevArticlesRequest]) (*connect.Response[grpc.GetPrevArticlesResponse], error)
+	GetSeries(context.Context, *connect.Request[grpc.GetSeriesRequest]) (*connect.Response[grpc.GetSeriesResponse], error)
 }
 
 // NewArticleServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -143,6 +177,12 @@ func NewArticleServiceHandler(svc ArticleServiceHandler, opts ...connect.Handler
 		connect.WithSchema(articleServiceMethods.ByName("GetArticleById")),
 		connect.WithHandlerOptions(opts...),
 	)
+	articleServiceGetArticleBySlugHandler := connect.NewUnaryHandler(
+		ArticleServiceGetArticleBySlugProcedure,
+		svc.GetArticleBySlug,
+		connect.WithSchema(articleServiceMethods.ByName("GetArticleBySlug")),
+		connect.WithHandlerOptions(opts...),
+	)
 	articleServiceGetAllArticlesHandler := connect.NewUnaryHandler(
 		ArticleServiceGetAllArticlesProcedure,
 		svc.GetAllArticles,
@@ -161,16 +201,26 @@ func NewArticleServiceHandler(svc ArticleServiceHandler, opts ...connect.Handler
 		connect.WithSchema(articleServiceMethods.ByName("GetPrevArticles")),
 		connect.WithHandlerOptions(opts...),
 	)
+	articleServiceGetSeriesHandler := connect.NewUnaryHandler(
+		ArticleServiceGetSeriesProcedure,
+		svc.GetSeries,
+		connect.WithSchema(articleServiceMethods.ByName("GetSeries")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/article.ArticleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ArticleServiceGetArticleByIdProcedure:
 			articleServiceGetArticleByIdHandler.ServeHTTP(w, r)
+		case ArticleServiceGetArticleBySlugProcedure:
+			articleServiceGetArticleBySlugHandler.ServeHTTP(w, r)
 		case ArticleServiceGetAllArticlesProcedure:
 			articleServiceGetAllArticlesHandler.ServeHTTP(w, r)
 		case ArticleServiceGetNextArticlesProcedure:
 			articleServiceGetNextArticlesHandler.ServeHTTP(w, r)
 		case ArticleServiceGetPrevArticlesProcedure:
 			articleServiceGetPrevArticlesHandler.ServeHTTP(w, r)
+		case ArticleServiceGetSeriesProcedure:
+			articleServiceGetSeriesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -184,6 +234,10 @@ func (UnimplementedArticleServiceHandler) GetArticleById(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("article.ArticleService.GetArticleById is not implemented"))
 }
 
+func (UnimplementedArticleServiceHandler) GetArticleBySlug(context.Context, *connect.Request[grpc.GetArticleBySlugRequest]) (*connect.Response[grpc.GetArticleBySlugResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("article.ArticleService.GetArticleBySlug is not implemented"))
+}
+
 func (UnimplementedArticleServiceHandler) GetAllArticles(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[grpc.GetAllArticlesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("article.ArticleService.GetAllArticles is not implemented"))
 }
@@ -195,3 +249,7 @@ func (UnimplementedArticleServiceHandler) GetNextArticles(context.Context, *conn
 func (UnimplementedArticleServiceHandler) GetPrevArticles(context.Context, *connect.Request[grpc.GetPrevArticlesRequest]) (*connect.Response[grpc.GetPrevArticlesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("article.ArticleService.GetPrevArticles is not implemented"))
 }
+
+func (UnimplementedArticleServiceHandler) GetSeries(context.Context, *connect.Request[grpc.GetSeriesRequest]) (*connect.Response[grpc.GetSeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("article.ArticleService.GetSeries is not implemented"))
+}
@@ -7,13 +7,52 @@ SELECT "a".*,
                        ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
                ) AS json
        ) AS "tags"
-FROM (SELECT * FROM "articles" WHERE "articles"."id" = $1) AS "a"
+FROM (SELECT * FROM "articles" WHERE "articles"."id" = $1 AND NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now())) AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
      ON
          "a"."id" = "t"."article_id"
 GROUP BY "a"."id";
 
+-- name: GetBySlug :one
+SELECT "a".*,
+       CAST(
+               COALESCE(
+                       jsonb_agg(
+                               json_build_object('id', t.id, 'name', t.name)
+                       ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
+               ) AS json
+       ) AS "tags"
+FROM (SELECT *
+      FROM "articles"
+      WHERE "articles"."id" = (SELECT "article_slugs"."article_id" FROM "article_slugs" WHERE "article_slugs"."slug" = $1)
+        AND NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now())) AS "a"
+         LEFT OUTER JOIN
+     "tags" AS "t"
+     ON
+         "a"."id" = "t"."article_id"
+GROUP BY "a"."id";
+
+-- name: GetSeries :many
+SELECT "a".*,
+       CAST(
+               COALESCE(
+                       jsonb_agg(
+                               json_build_object('id', t.id, 'name', t.name)
+                       ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
+               ) AS json
+       ) AS "tags"
+FROM (SELECT *
+      FROM "articles"
+      WHERE "articles"."series_id" = $1
+        AND NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now())) AS "a"
+         LEFT OUTER JOIN
+     "tags" AS "t"
+     ON
+         "a"."id" = "t"."article_id"
+GROUP BY "a"."id"
+ORDER BY "a"."series_position", "a"."id";
+
 -- name: ListAfter :many
 SELECT "a".*,
        CAST(
@@ -23,7 +62,7 @@ SELECT "a".*,
                        ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
                ) AS json
        ) AS "tags"
-FROM (SELECT * FROM "articles" ORDER BY "articles"."id") AS "a"
+FROM (SELECT * FROM "articles" WHERE NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now()) ORDER BY "articles"."id") AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
      ON
@@ -40,7 +79,7 @@ SELECT "a".*,
                        ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
                ) AS json
        ) AS "tags"
-FROM (SELECT * FROM "articles" ORDER BY "articles"."id" LIMIT $1) AS "a"
+FROM (SELECT * FROM "articles" WHERE NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now()) ORDER BY "articles"."id" LIMIT $1) AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
      ON
@@ -63,6 +102,7 @@ FROM (SELECT *
                    FROM "articles"
                    WHERE "articles"."id" = $1)
         AND "articles"."id" > $1
+        AND NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now())
       ORDER BY "articles"."id" LIMIT $2) AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
@@ -80,7 +120,7 @@ SELECT "a".*,
                        ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
                ) AS json
        ) AS "tags"
-FROM (SELECT * FROM "articles" ORDER BY "articles"."id" DESC) AS "a"
+FROM (SELECT * FROM "articles" WHERE NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now()) ORDER BY "articles"."id" DESC) AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
      ON
@@ -97,7 +137,7 @@ SELECT "a".*,
                        ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
                ) AS json
        ) AS "tags"
-FROM (SELECT * FROM "articles" ORDER BY "articles"."id" DESC LIMIT $1) AS "a"
+FROM (SELECT * FROM "articles" WHERE NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now()) ORDER BY "articles"."id" DESC LIMIT $1) AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
      ON
@@ -120,6 +160,7 @@ FROM (SELECT *
                    FROM "articles"
                    WHERE "articles"."id" = $1)
         AND "articles"."id" < $1
+        AND NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now())
       ORDER BY "articles"."id" DESC LIMIT $2) AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
@@ -27,6 +27,12 @@ func Prepare(ctx context.Context, db DBTX) (*Queries, error) {
 	if q.getByIDStmt, err = db.PrepareContext(ctx, getByID); err != nil {
 		return nil, fmt.Errorf("error preparing query GetByID: %w", err)
 	}
+	if q.getBySlugStmt, err = db.PrepareContext(ctx, getBySlug); err != nil {
+		return nil, fmt.Errorf("error preparing query GetBySlug: %w", err)
+	}
+	if q.getSeriesStmt, err = db.PrepareContext(ctx, getSeries); err != nil {
+		return nil, fmt.Errorf("error preparing query GetSeries: %w", err)
+	}
 	if q.listAfterStmt, err = db.PrepareContext(ctx, listAfter); err != nil {
 		return nil, fmt.Errorf("error preparing query ListAfter: %w", err)
 	}
@@ -55,6 +61,16 @@ func (q *Queries) Close() error {
 			err = fmt.Errorf("error closing getByIDStmt: %w", cerr)
 		}
 	}
+	if q.getBySlugStmt != nil {
+		if cerr := q.getBySlugStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getBySlugStmt: %w", cerr)
+		}
+	}
+	if q.getSeriesStmt != nil {
+		if cerr := q.getSeriesStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getSeriesStmt: %w", cerr)
+		}
+	}
 	if q.listAfterStmt != nil {
 		if cerr := q.listAfterStmt.Close(); cerr != nil {
 			err = fmt.Errorf("error closing listAfterStmt: %w", cerr)
@@ -125,6 +141,8 @@ type Queries struct {
 	db                               DBTX
 	tx                               *sql.Tx
 	getByIDStmt                      *sql.Stmt
+	getBySlugStmt                    *sql.Stmt
+	getSeriesStmt                    *sql.Stmt
 	listAfterStmt                    *sql.Stmt
 	listAfterWithLimitStmt           *sql.Stmt
 	listAfterWithLimitAndCursorStmt  *sql.Stmt
@@ -138,6 +156,8 @@ func (q *Queries) WithTx(tx *sql.Tx) *Queries {
 		db:                               tx,
 		tx:                               tx,
 		getByIDStmt:                      q.getByIDStmt,
+		getBySlugStmt:                    q.getBySlugStmt,
+		getSeriesStmt:                    q.getSeriesStmt,
 		listAfterStmt:                    q.listAfterStmt,
 		listAfterWithLimitStmt:           q.listAfterWithLimitStmt,
 		listAfterWithLimitAndCursorStmt:  q.listAfterWithLimitAndCursorStmt,
@@ -12,7 +12,7 @@ import (
 )
 
 const getByID = `-- name: GetByID :one
-SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
+SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at, a.slug, a.created_by, a.updated_by, a.series_id, a.series_title, a.series_position, a.thumbnail_variants,
        CAST(
                COALESCE(
                        jsonb_agg(
@@ -20,7 +20,7 @@ SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
                        ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
                ) AS json
        ) AS "tags"
-FROM (SELECT id, title, body, thumbnail, created_at, updated_at FROM "articles" WHERE "articles"."id" = $1) AS "a"
+FROM (SELECT id, title, body, thumbnail, created_at, updated_at, slug, created_by, updated_by, series_id, series_title, series_position, thumbnail_variants FROM "articles" WHERE "articles"."id" = $1 AND NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now())) AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
      ON
@@ -29,13 +29,20 @@ GROUP BY "a"."id"
 `
 
 type GetByIDRow struct {
-	ID        string        `db:"id"`
-	Title     string        `db:"title"`
-	Body      string        `db:"body"`
-	Thumbnail string        `db:"thumbnail"`
-	CreatedAt types.UTCTime `db:"created_at"`
-	UpdatedAt types.UTCTime `db:"updated_at"`
-	Tags      types.Tags    `db:"tags"`
+	ID                string              `db:"id"`
+	Title             string              `db:"title"`
+	Body              string              `db:"body"`
+	Thumbnail         string              `db:"thumbnail"`
+	CreatedAt         types.UTCTime       `db:"created_at"`
+	UpdatedAt         types.UTCTime       `db:"updated_at"`
+	Slug              string              `db:"slug"`
+	CreatedBy         string              `db:"created_by"`
+	UpdatedBy         string              `db:"updated_by"`
+	SeriesID          string              `db:"series_id"`
+	SeriesTitle       string              `db:"series_title"`
+	SeriesPosition    int32               `db:"series_position"`
+	ThumbnailVariants types.ImageVariants `db:"thumbnail_variants"`
+	Tags              types.Tags          `db:"tags"`
 }
 
 func (q *Queries) GetByID(ctx context.Context, id string) (GetByIDRow, error) {
@@ -48,13 +55,155 @@ func (q *Queries) GetByID(ctx context.Context, id string) (GetByIDRow, error) {
 		&i.Thumbnail,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Slug,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.SeriesID,
+		&i.SeriesTitle,
+		&i.SeriesPosition,
+		&i.ThumbnailVariants,
 		&i.Tags,
 	)
 	return i, err
 }
 
+const getBySlug = `-- name: GetBySlug :one
+SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at, a.slug, a.created_by, a.updated_by, a.series_id, a.series_title, a.series_position, a.thumbnail_variants,
+       CAST(
+               COALESCE(
+                       jsonb_agg(
+                               json_build_object('id', t.id, 'name', t.name)
+                       ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
+               ) AS json
+       ) AS "tags"
+FROM (SELECT id, title, body, thumbnail, created_at, updated_at, slug, created_by, updated_by, series_id, series_title, series_position, thumbnail_variants
+      FROM "articles"
+      WHERE "articles"."id" = (SELECT "article_slugs"."article_id" FROM "article_slugs" WHERE "article_slugs"."slug" = $1)
+        AND NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now())) AS "a"
+         LEFT OUTER JOIN
+     "tags" AS "t"
+     ON
+         "a"."id" = "t"."article_id"
+GROUP BY "a"."id"
+`
+
+type GetBySlugRow struct {
+	ID                string              `db:"id"`
+	Title             string              `db:"title"`
+	Body              string              `db:"body"`
+	Thumbnail         string              `db:"thumbnail"`
+	CreatedAt         types.UTCTime       `db:"created_at"`
+	UpdatedAt         types.UTCTime       `db:"updated_at"`
+	Slug              string              `db:"slug"`
+	CreatedBy         string              `db:"created_by"`
+	UpdatedBy         string              `db:"updated_by"`
+	SeriesID          string              `db:"series_id"`
+	SeriesTitle       string              `db:"series_title"`
+	SeriesPosition    int32               `db:"series_position"`
+	ThumbnailVariants types.ImageVariants `db:"thumbnail_variants"`
+	Tags              types.Tags          `db:"tags"`
+}
+
+func (q *Queries) GetBySlug(ctx context.Context, slug string) (GetBySlugRow, error) {
+	row := q.queryRow(ctx, q.getBySlugStmt, getBySlug, slug)
+	var i GetBySlugRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Body,
+		&i.Thumbnail,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Slug,
+		&i.CreatedBy,
+		&i.UpdatedBy,
+		&i.SeriesID,
+		&i.SeriesTitle,
+		&i.SeriesPosition,
+		&i.ThumbnailVariants,
+		&i.Tags,
+	)
+	return i, err
+}
+
+const getSeries = `-- name: GetSeries :many
+SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at, a.slug, a.created_by, a.updated_by, a.series_id, a.series_title, a.series_position, a.thumbnail_variants,
+       CAST(
+               COALESCE(
+                       jsonb_agg(
+                               json_build_object('id', t.id, 'name', t.name)
+                       ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
+               ) AS json
+       ) AS "tags"
+FROM (SELECT id, title, body, thumbnail, created_at, updated_at, slug, created_by, updated_by, series_id, series_title, series_position, thumbnail_variants
+      FROM "articles"
+      WHERE "articles"."series_id" = $1
+        AND NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now())) AS "a"
+         LEFT OUTER JOIN
+     "tags" AS "t"
+     ON
+         "a"."id" = "t"."article_id"
+GROUP BY "a"."id"
+ORDER BY "a"."series_position", "a"."id"
+`
+
+type GetSeriesRow struct {
+	ID                string              `db:"id"`
+	Title             string              `db:"title"`
+	Body              string              `db:"body"`
+	Thumbnail         string              `db:"thumbnail"`
+	CreatedAt         types.UTCTime       `db:"created_at"`
+	UpdatedAt         types.UTCTime       `db:"updated_at"`
+	Slug              string              `db:"slug"`
+	CreatedBy         string              `db:"created_by"`
+	UpdatedBy         string              `db:"updated_by"`
+	SeriesID          string              `db:"series_id"`
+	SeriesTitle       string              `db:"series_title"`
+	SeriesPosition    int32               `db:"series_position"`
+	ThumbnailVariants types.ImageVariants `db:"thumbnail_variants"`
+	Tags              types.Tags          `db:"tags"`
+}
+
+func (q *Queries) GetSeries(ctx context.Context, seriesID string) ([]GetSeriesRow, error) {
+	rows, err := q.query(ctx, q.getSeriesStmt, getSeries, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSeriesRow
+	for rows.Next() {
+		var i GetSeriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Body,
+			&i.Thumbnail,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Slug,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.SeriesID,
+			&i.SeriesTitle,
+			&i.SeriesPosition,
+			&i.ThumbnailVariants,
+			&i.Tags,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listAfter = `-- name: ListAfter :many
-SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
+SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at, a.slug, a.created_by, a.updated_by, a.series_id, a.series_title, a.series_position, a.thumbnail_variants,
        CAST(
                COALESCE(
                        jsonb_agg(
@@ -62,7 +211,7 @@ SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
                        ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
                ) AS json
        ) AS "tags"
-FROM (SELECT id, title, body, thumbnail, created_at, updated_at FROM "articles" ORDER BY "articles"."id") AS "a"
+FROM (SELECT id, title, body, thumbnail, created_at, updated_at, slug, created_by, updated_by, series_id, series_title, series_position, thumbnail_variants FROM "articles" WHERE NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now()) ORDER BY "articles"."id") AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
      ON
@@ -72,13 +221,20 @@ ORDER BY "a"."id"
 `
 
 type ListAfterRow struct {
-	ID        string        `db:"id"`
-	Title     string        `db:"title"`
-	Body      string        `db:"body"`
-	Thumbnail string        `db:"thumbnail"`
-	CreatedAt types.UTCTime `db:"created_at"`
-	UpdatedAt types.UTCTime `db:"updated_at"`
-	Tags      types.Tags    `db:"tags"`
+	ID                string              `db:"id"`
+	Title             string              `db:"title"`
+	Body              string              `db:"body"`
+	Thumbnail         string              `db:"thumbnail"`
+	CreatedAt         types.UTCTime       `db:"created_at"`
+	UpdatedAt         types.UTCTime       `db:"updated_at"`
+	Slug              string              `db:"slug"`
+	CreatedBy         string              `db:"created_by"`
+	UpdatedBy         string              `db:"updated_by"`
+	SeriesID          string              `db:"series_id"`
+	SeriesTitle       string              `db:"series_title"`
+	SeriesPosition    int32               `db:"series_position"`
+	ThumbnailVariants types.ImageVariants `db:"thumbnail_variants"`
+	Tags              types.Tags          `db:"tags"`
 }
 
 func (q *Queries) ListAfter(ctx context.Context) ([]ListAfterRow, error) {
@@ -97,6 +253,13 @@ func (q *Queries) ListAfter(ctx context.Context) ([]ListAfterRow, error) {
 			&i.Thumbnail,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Slug,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.SeriesID,
+			&i.SeriesTitle,
+			&i.SeriesPosition,
+			&i.ThumbnailVariants,
 			&i.Tags,
 		); err != nil {
 			return nil, err
@@ -113,7 +276,7 @@ func (q *Queries) ListAfter(ctx context.Context) ([]ListAfterRow, error) {
 }
 
 const listAfterWithLimit = `-- name: ListAfterWithLimit :many
-SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
+SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at, a.slug, a.created_by, a.updated_by, a.series_id, a.series_title, a.series_position, a.thumbnail_variants,
        CAST(
                COALESCE(
                        jsonb_agg(
@@ -121,7 +284,7 @@ SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
                        ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
                ) AS json
        ) AS "tags"
-FROM (SELECT id, title, body, thumbnail, created_at, updated_at FROM "articles" ORDER BY "articles"."id" LIMIT $1) AS "a"
+FROM (SELECT id, title, body, thumbnail, created_at, updated_at, slug, created_by, updated_by, series_id, series_title, series_position, thumbnail_variants FROM "articles" WHERE NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now()) ORDER BY "articles"."id" LIMIT $1) AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
      ON
@@ -131,13 +294,20 @@ ORDER BY "a"."id"
 `
 
 type ListAfterWithLimitRow struct {
-	ID        string        `db:"id"`
-	Title     string        `db:"title"`
-	Body      string        `db:"body"`
-	Thumbnail string        `db:"thumbnail"`
-	CreatedAt types.UTCTime `db:"created_at"`
-	UpdatedAt types.UTCTime `db:"updated_at"`
-	Tags      types.Tags    `db:"tags"`
+	ID                string              `db:"id"`
+	Title             string              `db:"title"`
+	Body              string              `db:"body"`
+	Thumbnail         string              `db:"thumbnail"`
+	CreatedAt         types.UTCTime       `db:"created_at"`
+	UpdatedAt         types.UTCTime       `db:"updated_at"`
+	Slug              string              `db:"slug"`
+	CreatedBy         string              `db:"created_by"`
+	UpdatedBy         string              `db:"updated_by"`
+	SeriesID          string              `db:"series_id"`
+	SeriesTitle       string              `db:"series_title"`
+	SeriesPosition    int32               `db:"series_position"`
+	ThumbnailVariants types.ImageVariants `db:"thumbnail_variants"`
+	Tags              types.Tags          `db:"tags"`
 }
 
 func (q *Queries) ListAfterWithLimit(ctx context.Context, limit int32) ([]ListAfterWithLimitRow, error) {
@@ -156,6 +326,13 @@ func (q *Queries) ListAfterWithLimit(ctx context.Context, limit int32) ([]ListAf
 			&i.Thumbnail,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Slug,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.SeriesID,
+			&i.SeriesTitle,
+			&i.SeriesPosition,
+			&i.ThumbnailVariants,
 			&i.Tags,
 		); err != nil {
 			return nil, err
@@ -172,7 +349,7 @@ func (q *Queries) ListAfterWithLimit(ctx context.Context, limit int32) ([]ListAf
 }
 
 const listAfterWithLimitAndCursor = `-- name: ListAfterWithLimitAndCursor :many
-SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
+SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at, a.slug, a.created_by, a.updated_by, a.series_id, a.series_title, a.series_position, a.thumbnail_variants,
        CAST(
                COALESCE(
                        jsonb_agg(
@@ -180,12 +357,13 @@ SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
                        ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
                ) AS json
        ) AS "tags"
-FROM (SELECT id, title, body, thumbnail, created_at, updated_at
+FROM (SELECT id, title, body, thumbnail, created_at, updated_at, slug, created_by, updated_by, series_id, series_title, series_position, thumbnail_variants
       FROM "articles"
       WHERE EXISTS(SELECT id
                    FROM "articles"
                    WHERE "articles"."id" = $1)
         AND "articles"."id" > $1
+        AND NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now())
       ORDER BY "articles"."id" LIMIT $2) AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
@@ -201,13 +379,20 @@ type ListAfterWithLimitAndCursorParams struct {
 }
 
 type ListAfterWithLimitAndCursorRow struct {
-	ID        string        `db:"id"`
-	Title     string        `db:"title"`
-	Body      string        `db:"body"`
-	Thumbnail string        `db:"thumbnail"`
-	CreatedAt types.UTCTime `db:"created_at"`
-	UpdatedAt types.UTCTime `db:"updated_at"`
-	Tags      types.Tags    `db:"tags"`
+	ID                string              `db:"id"`
+	Title             string              `db:"title"`
+	Body              string              `db:"body"`
+	Thumbnail         string              `db:"thumbnail"`
+	CreatedAt         types.UTCTime       `db:"created_at"`
+	UpdatedAt         types.UTCTime       `db:"updated_at"`
+	Slug              string              `db:"slug"`
+	CreatedBy         string              `db:"created_by"`
+	UpdatedBy         string              `db:"updated_by"`
+	SeriesID          string              `db:"series_id"`
+	SeriesTitle       string              `db:"series_title"`
+	SeriesPosition    int32               `db:"series_position"`
+	ThumbnailVariants types.ImageVariants `db:"thumbnail_variants"`
+	Tags              types.Tags          `db:"tags"`
 }
 
 func (q *Queries) ListAfterWithLimitAndCursor(ctx context.Context, arg ListAfterWithLimitAndCursorParams) ([]ListAfterWithLimitAndCursorRow, error) {
@@ -226,6 +411,13 @@ func (q *Queries) ListAfterWithLimitAndCursor(ctx context.Context, arg ListAfter
 			&i.Thumbnail,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Slug,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.SeriesID,
+			&i.SeriesTitle,
+			&i.SeriesPosition,
+			&i.ThumbnailVariants,
 			&i.Tags,
 		); err != nil {
 			return nil, err
@@ -242,7 +434,7 @@ func (q *Queries) ListAfterWithLimitAndCursor(ctx context.Context, arg ListAfter
 }
 
 const listBefore = `-- name: ListBefore :many
-SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
+SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at, a.slug, a.created_by, a.updated_by, a.series_id, a.series_title, a.series_position, a.thumbnail_variants,
        CAST(
                COALESCE(
                        jsonb_agg(
@@ -250,7 +442,7 @@ SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
                        ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
                ) AS json
        ) AS "tags"
-FROM (SELECT id, title, body, thumbnail, created_at, updated_at FROM "articles" ORDER BY "articles"."id" DESC) AS "a"
+FROM (SELECT id, title, body, thumbnail, created_at, updated_at, slug, created_by, updated_by, series_id, series_title, series_position, thumbnail_variants FROM "articles" WHERE NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now()) ORDER BY "articles"."id" DESC) AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
      ON
@@ -260,13 +452,20 @@ ORDER BY "a"."id" DESC
 `
 
 type ListBeforeRow struct {
-	ID        string        `db:"id"`
-	Title     string        `db:"title"`
-	Body      string        `db:"body"`
-	Thumbnail string        `db:"thumbnail"`
-	CreatedAt types.UTCTime `db:"created_at"`
-	UpdatedAt types.UTCTime `db:"updated_at"`
-	Tags      types.Tags    `db:"tags"`
+	ID                string              `db:"id"`
+	Title             string              `db:"title"`
+	Body              string              `db:"body"`
+	Thumbnail         string              `db:"thumbnail"`
+	CreatedAt         types.UTCTime       `db:"created_at"`
+	UpdatedAt         types.UTCTime       `db:"updated_at"`
+	Slug              string              `db:"slug"`
+	CreatedBy         string              `db:"created_by"`
+	UpdatedBy         string              `db:"updated_by"`
+	SeriesID          string              `db:"series_id"`
+	SeriesTitle       string              `db:"series_title"`
+	SeriesPosition    int32               `db:"series_position"`
+	ThumbnailVariants types.ImageVariants `db:"thumbnail_variants"`
+	Tags              types.Tags          `db:"tags"`
 }
 
 func (q *Queries) ListBefore(ctx context.Context) ([]ListBeforeRow, error) {
@@ -285,6 +484,13 @@ func (q *Queries) ListBefore(ctx context.Context) ([]ListBeforeRow, error) {
 			&i.Thumbnail,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Slug,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.SeriesID,
+			&i.SeriesTitle,
+			&i.SeriesPosition,
+			&i.ThumbnailVariants,
 			&i.Tags,
 		); err != nil {
 			return nil, err
@@ -301,7 +507,7 @@ func (q *Queries) ListBefore(ctx context.Context) ([]ListBeforeRow, error) {
 }
 
 const listBeforeWithLimit = `-- name: ListBeforeWithLimit :many
-SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
+SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at, a.slug, a.created_by, a.updated_by, a.series_id, a.series_title, a.series_position, a.thumbnail_variants,
        CAST(
                COALESCE(
                        jsonb_agg(
@@ -309,7 +515,7 @@ SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
                        ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
                ) AS json
        ) AS "tags"
-FROM (SELECT id, title, body, thumbnail, created_at, updated_at FROM "articles" ORDER BY "articles"."id" DESC LIMIT $1) AS "a"
+FROM (SELECT id, title, body, thumbnail, created_at, updated_at, slug, created_by, updated_by, series_id, series_title, series_position, thumbnail_variants FROM "articles" WHERE NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now()) ORDER BY "articles"."id" DESC LIMIT $1) AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
      ON
@@ -319,13 +525,20 @@ ORDER BY "a"."id" DESC
 `
 
 type ListBeforeWithLimitRow struct {
-	ID        string        `db:"id"`
-	Title     string        `db:"title"`
-	Body      string        `db:"body"`
-	Thumbnail string        `db:"thumbnail"`
-	CreatedAt types.UTCTime `db:"created_at"`
-	UpdatedAt types.UTCTime `db:"updated_at"`
-	Tags      types.Tags    `db:"tags"`
+	ID                string              `db:"id"`
+	Title             string              `db:"title"`
+	Body              string              `db:"body"`
+	Thumbnail         string              `db:"thumbnail"`
+	CreatedAt         types.UTCTime       `db:"created_at"`
+	UpdatedAt         types.UTCTime       `db:"updated_at"`
+	Slug              string              `db:"slug"`
+	CreatedBy         string              `db:"created_by"`
+	UpdatedBy         string              `db:"updated_by"`
+	SeriesID          string              `db:"series_id"`
+	SeriesTitle       string              `db:"series_title"`
+	SeriesPosition    int32               `db:"series_position"`
+	ThumbnailVariants types.ImageVariants `db:"thumbnail_variants"`
+	Tags              types.Tags          `db:"tags"`
 }
 
 func (q *Queries) ListBeforeWithLimit(ctx context.Context, limit int32) ([]ListBeforeWithLimitRow, error) {
@@ -344,6 +557,13 @@ func (q *Queries) ListBeforeWithLimit(ctx context.Context, limit int32) ([]ListB
 			&i.Thumbnail,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Slug,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.SeriesID,
+			&i.SeriesTitle,
+			&i.SeriesPosition,
+			&i.ThumbnailVariants,
 			&i.Tags,
 		); err != nil {
 			return nil, err
@@ -360,7 +580,7 @@ func (q *Queries) ListBeforeWithLimit(ctx context.Context, limit int32) ([]ListB
 }
 
 const listBeforeWithLimitAndCursor = `-- name: ListBeforeWithLimitAndCursor :many
-SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
+SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at, a.slug, a.created_by, a.updated_by, a.series_id, a.series_title, a.series_position, a.thumbnail_variants,
        CAST(
                COALESCE(
                        jsonb_agg(
@@ -368,12 +588,13 @@ SELECT a.id, a.title, a.body, a.thumbnail, a.created_at, a.updated_at,
                        ) FILTER(WHERE t.id IS NOT NULL), '[]' ::json
                ) AS json
        ) AS "tags"
-FROM (SELECT id, title, body, thumbnail, created_at, updated_at
+FROM (SELECT id, title, body, thumbnail, created_at, updated_at, slug, created_by, updated_by, series_id, series_title, series_position, thumbnail_variants
       FROM "articles"
       WHERE EXISTS(SELECT id
                    FROM "articles"
                    WHERE "articles"."id" = $1)
         AND "articles"."id" < $1
+        AND NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "articles"."id" AND "article_schedules"."publish_at" > now())
       ORDER BY "articles"."id" DESC LIMIT $2) AS "a"
          LEFT OUTER JOIN
      "tags" AS "t"
@@ -389,13 +610,20 @@ type ListBeforeWithLimitAndCursorParams struct {
 }
 
 type ListBeforeWithLimitAndCursorRow struct {
-	ID        string        `db:"id"`
-	Title     string        `db:"title"`
-	Body      string        `db:"body"`
-	Thumbnail string        `db:"thumbnail"`
-	CreatedAt types.UTCTime `db:"created_at"`
-	UpdatedAt types.UTCTime `db:"updated_at"`
-	Tags      types.Tags    `db:"tags"`
+	ID                string              `db:"id"`
+	Title             string              `db:"title"`
+	Body              string              `db:"body"`
+	Thumbnail         string              `db:"thumbnail"`
+	CreatedAt         types.UTCTime       `db:"created_at"`
+	UpdatedAt         types.UTCTime       `db:"updated_at"`
+	Slug              string              `db:"slug"`
+	CreatedBy         string              `db:"created_by"`
+	UpdatedBy         string              `db:"updated_by"`
+	SeriesID          string              `db:"series_id"`
+	SeriesTitle       string              `db:"series_title"`
+	SeriesPosition    int32               `db:"series_position"`
+	ThumbnailVariants types.ImageVariants `db:"thumbnail_variants"`
+	Tags              types.Tags          `db:"tags"`
 }
 
 func (q *Queries) ListBeforeWithLimitAndCursor(ctx context.Context, arg ListBeforeWithLimitAndCursorParams) ([]ListBeforeWithLimitAndCursorRow, error) {
@@ -414,6 +642,13 @@ func (q *Queries) ListBeforeWithLimitAndCursor(ctx context.Context, arg ListBefo
 			&i.Thumbnail,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Slug,
+			&i.CreatedBy,
+			&i.UpdatedBy,
+			&i.SeriesID,
+			&i.SeriesTitle,
+			&i.SeriesPosition,
+			&i.ThumbnailVariants,
 			&i.Tags,
 		); err != nil {
 			return nil, err
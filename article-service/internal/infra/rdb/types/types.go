@@ -31,3 +31,33 @@ func (t *Tags) Scan(src interface{}) error {
 	}
 	return json.Unmarshal(data, t)
 }
+
+// ImageVariant is a variant of the thumbnail of an article.
+type ImageVariant struct {
+	URL         string `json:"url"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	ContentType string `json:"content_type"`
+}
+
+var _ sql.Scanner = (*ImageVariants)(nil)
+
+type ImageVariants []ImageVariant
+
+func (v *ImageVariants) Scan(src interface{}) error {
+	var data []byte
+	switch src := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		data = src
+	case string:
+		data = []byte(src)
+	default:
+		return fmt.Errorf("unexpected type: %T", src)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// AddArticleToSeries is a use-case for adding an article to an existing series.
+type AddArticleToSeries struct {
+	seriesQuery          query.SeriesService
+	bloggingEventCommand command.BloggingEventService
+}
+
+// Execute executes the AddArticleToSeries use-case.
+// The articles after the position the article is added at are moved back by one, each with an event of its own.
+// A retry of a request with an idempotency key finds the article already at its position and finishes moving the others.
+// Once every article is done, the outcome is recorded under the key, and a retry after that is answered with it.
+func (u *AddArticleToSeries) Execute(ctx context.Context, in *dto.AddArticleToSeriesInDto) (_ *dto.SeriesOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.SeriesOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	if in.Position() < 0 {
+		return nil, errors.WithStack(model.NewValidationError(model.NewFieldViolation("position", "position must not be negative")))
+	}
+
+	replayed, err := replayOutcome(ctx, u.bloggingEventCommand)
+	if err != nil {
+		return nil, err
+	}
+	if replayed != nil {
+		result := dto.NewSeriesOutDto(replayed.SubjectID(), bloggingEventKeyDtos(replayed.Events()))
+		return &result, nil
+	}
+	_, hasIdempotencyKey := model.IdempotencyKeyFromContext(ctx)
+
+	partsOut := db.NewMultipleStatementResult[*model.SeriesArticle]()
+	err = u.seriesQuery.ListSeriesArticles(ctx, in.SeriesID(), partsOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	parts := partsOut.StrictGet()
+	if len(parts) == 0 {
+		return nil, errors.Wrapf(model.ErrNotFound, "series %q", in.SeriesID())
+	}
+
+	articleOut := db.NewSingleStatementResult[*model.SeriesArticle]()
+	err = u.seriesQuery.GetSeriesArticle(ctx, in.ArticleID(), articleOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	article := articleOut.StrictGet()
+	others := make([]*model.SeriesArticle, 0, len(parts))
+	for _, part := range parts {
+		if part.ArticleID() != article.ArticleID() {
+			others = append(others, part)
+		}
+	}
+
+	// the article goes to the end of the series unless it is given a position within it.
+	position := in.Position()
+	if position == 0 || position > len(others) {
+		position = len(others) + 1
+	}
+	title := parts[0].Series().Title()
+
+	// an article a retried request has already put in the series is written again, so that its event is replayed.
+	series := article.Series()
+	retried := hasIdempotencyKey && series.ID() == in.SeriesID() && series.Position() == position
+	if series.ID() != "" && !retried {
+		return nil, model.AlreadyInSeriesError("articleId", *article)
+	}
+
+	events := make([]model.BloggingEventKey, 0, len(parts)+1)
+	command := model.NewAddToSeriesEvent(article.ArticleID(), model.NewArticleSeries(in.SeriesID(), title, position), article.LastEventID())
+	if err = command.Validate(); err != nil {
+		return nil, err
+	}
+	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	articleCtx := model.ContextWithArticleIdempotencyKey(ctx, article.ArticleID())
+	err = u.bloggingEventCommand.AddToSeries(articleCtx, command, commandOut).Execute(articleCtx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	events = append(events, *commandOut.StrictGet())
+
+	// the articles are moved from the end of the series, so that those a failure leaves unmoved keep positions of their own.
+	for i := len(others) - 1; i >= 0; i-- {
+		part := others[i]
+		moved := i + 1
+		shifted := moved >= position
+		if shifted {
+			moved++
+		}
+		// a retry writes the shifted articles again, since those already moved replay their events.
+		if moved == part.Series().Position() && !(retried && shifted) {
+			continue
+		}
+		command := model.NewReorderSeriesEvent(part.ArticleID(), model.NewArticleSeries(in.SeriesID(), title, moved), part.LastEventID())
+		if err = command.Validate(); err != nil {
+			return nil, err
+		}
+		commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
+		articleCtx := model.ContextWithArticleIdempotencyKey(ctx, part.ArticleID())
+		err = u.bloggingEventCommand.ReorderSeries(articleCtx, command, commandOut).Execute(articleCtx)
+		if err != nil {
+			err = errors.WithStack(err)
+			return nil, err
+		}
+		events = append(events, *commandOut.StrictGet())
+	}
+
+	outcome, err := recordOutcome(ctx, u.bloggingEventCommand, model.NewRequestOutcome(in.SeriesID(), events))
+	if err != nil {
+		return nil, err
+	}
+	result := dto.NewSeriesOutDto(outcome.SubjectID(), bloggingEventKeyDtos(outcome.Events()))
+	return &result, nil
+}
+
+// NewAddArticleToSeries is a constructor for AddArticleToSeries use-case.
+func NewAddArticleToSeries(seriesQuery query.SeriesService, bloggingEventCommand command.BloggingEventService) *AddArticleToSeries {
+	return &AddArticleToSeries{
+		seriesQuery:          seriesQuery,
+		bloggingEventCommand: bloggingEventCommand,
+	}
+}
@@ -0,0 +1,370 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mcommand "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/command"
+	mquery "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/query"
+	mdb "blogapi.miyamo.today/blogging-event-service/internal/mock/core/db"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestAddArticleToSeries_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *dto.AddArticleToSeriesInDto
+	}
+	type want struct {
+		out *dto.SeriesOutDto
+		err error
+	}
+	type testCase struct {
+		args                args
+		want                want
+		setupQueryService   func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement)
+		setupCommandService func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement)
+	}
+	errUnhappyPath := errors.New("unhappy_path")
+
+	seriesOf := func(article model.SeriesArticle, parts ...model.SeriesArticle) func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement) {
+		return func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement) {
+			qs.EXPECT().ListSeriesArticles(gomock.Any(), "series_id", gomock.Any()).DoAndReturn(
+				func(ctx context.Context, seriesID string, out *db.MultipleStatementResult[*model.SeriesArticle]) db.Statement {
+					stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+						result := make([]*model.SeriesArticle, 0, len(parts))
+						for _, p := range parts {
+							result = append(result, &p)
+						}
+						out.Set(result)
+						return nil
+					}).Times(1)
+					return stmt
+				}).Times(1)
+			if len(parts) == 0 {
+				return
+			}
+			qs.EXPECT().GetSeriesArticle(gomock.Any(), article.ArticleID(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, articleID string, out *db.SingleStatementResult[*model.SeriesArticle]) db.Statement {
+					stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+						out.Set(&article)
+						return nil
+					}).Times(1)
+					return stmt
+				}).Times(1)
+		}
+	}
+	parts := []model.SeriesArticle{
+		model.NewSeriesArticle("article_id1", model.NewArticleSeries("series_id", "Go in Practice", 1), "last_event_id1"),
+		model.NewSeriesArticle("article_id2", model.NewArticleSeries("series_id", "Go in Practice", 2), "last_event_id2"),
+	}
+	addToSeries := func(position int, eventID string) func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) *gomock.Call {
+		return func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) *gomock.Call {
+			return cs.EXPECT().AddToSeries(gomock.Any(), model.NewAddToSeriesEvent("article_id3", model.NewArticleSeries("series_id", "Go in Practice", position), "last_event_id3"), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, in model.AddToSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+					stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+						v := model.NewBloggingEventKey(eventID, "article_id3")
+						out.Set(&v)
+						return nil
+					}).Times(1)
+					return stmt
+				}).Times(1)
+		}
+	}
+	reorderSeries := func(articleID string, position int, lastEventID, eventID string) func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) *gomock.Call {
+		return func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) *gomock.Call {
+			return cs.EXPECT().ReorderSeries(gomock.Any(), model.NewReorderSeriesEvent(articleID, model.NewArticleSeries("series_id", "Go in Practice", position), lastEventID), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, in model.ReorderSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+					if key, ok := model.IdempotencyKeyFromContext(ctx); ok && key != model.NewIdempotencyKey("caller", "key#"+articleID, "fingerprint") {
+						t.Errorf("ReorderSeries() is called with the idempotency key %+v", key)
+					}
+					stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+						v := model.NewBloggingEventKey(eventID, articleID)
+						out.Set(&v)
+						return nil
+					}).Times(1)
+					return stmt
+				}).Times(1)
+		}
+	}
+	tests := map[string]testCase{
+		"happy_path:to-the-end": {
+			args: func() args {
+				in := dto.NewAddArticleToSeriesInDto("series_id", "article_id3", 0)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewSeriesOutDto("series_id", []dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id3", "article_id3"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: seriesOf(
+				model.NewSeriesArticle("article_id3", model.ArticleSeries{}, "last_event_id3"),
+				parts...,
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				addToSeries(3, "event_id3")(cs, stmt)
+			},
+		},
+		"happy_path:beyond-the-end": {
+			args: func() args {
+				in := dto.NewAddArticleToSeriesInDto("series_id", "article_id3", 10)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewSeriesOutDto("series_id", []dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id3", "article_id3"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: seriesOf(
+				model.NewSeriesArticle("article_id3", model.ArticleSeries{}, "last_event_id3"),
+				parts...,
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				addToSeries(3, "event_id3")(cs, stmt)
+			},
+		},
+		"happy_path:in-the-middle": {
+			args: func() args {
+				in := dto.NewAddArticleToSeriesInDto("series_id", "article_id3", 2)
+				// each event is recorded under the key of the request and its article.
+				ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+				return args{
+					ctx: ctx,
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewSeriesOutDto("series_id", []dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id3", "article_id3"),
+					dto.NewBloggingEventKeyDto("event_id2", "article_id2"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: seriesOf(
+				model.NewSeriesArticle("article_id3", model.ArticleSeries{}, "last_event_id3"),
+				parts...,
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				expectReplayOutcome(t, cs, stmt, nil, nil)
+				addToSeries(2, "event_id3")(cs, stmt)
+				reorderSeries("article_id2", 3, "last_event_id2", "event_id2")(cs, stmt)
+				expectRecordOutcome(t, cs, stmt, model.NewRequestOutcome("series_id", []model.BloggingEventKey{
+					model.NewBloggingEventKey("event_id3", "article_id3"),
+					model.NewBloggingEventKey("event_id2", "article_id2"),
+				}))
+			},
+		},
+		"happy_path:from-the-end": {
+			args: func() args {
+				in := dto.NewAddArticleToSeriesInDto("series_id", "article_id3", 1)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewSeriesOutDto("series_id", []dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id3", "article_id3"),
+					dto.NewBloggingEventKeyDto("event_id2", "article_id2"),
+					dto.NewBloggingEventKeyDto("event_id1", "article_id1"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: seriesOf(
+				model.NewSeriesArticle("article_id3", model.ArticleSeries{}, "last_event_id3"),
+				parts...,
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				// the last article is moved first, so that a failure leaves no two articles at the same position.
+				gomock.InOrder(
+					addToSeries(1, "event_id3")(cs, stmt),
+					reorderSeries("article_id2", 3, "last_event_id2", "event_id2")(cs, stmt),
+					reorderSeries("article_id1", 2, "last_event_id1", "event_id1")(cs, stmt),
+				)
+			},
+		},
+		"happy_path:retried": {
+			args: func() args {
+				in := dto.NewAddArticleToSeriesInDto("series_id", "article_id3", 2)
+				ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+				return args{
+					ctx: ctx,
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewSeriesOutDto("series_id", []dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id3", "article_id3"),
+					dto.NewBloggingEventKeyDto("event_id2", "article_id2"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			// every event was written before the request failed to record its outcome.
+			setupQueryService: seriesOf(
+				model.NewSeriesArticle("article_id3", model.NewArticleSeries("series_id", "Go in Practice", 2), "last_event_id3"),
+				parts[0],
+				model.NewSeriesArticle("article_id3", model.NewArticleSeries("series_id", "Go in Practice", 2), "last_event_id3"),
+				model.NewSeriesArticle("article_id2", model.NewArticleSeries("series_id", "Go in Practice", 3), "last_event_id2"),
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				expectReplayOutcome(t, cs, stmt, nil, nil)
+				addToSeries(2, "event_id3")(cs, stmt)
+				reorderSeries("article_id2", 3, "last_event_id2", "event_id2")(cs, stmt)
+				expectRecordOutcome(t, cs, stmt, model.NewRequestOutcome("series_id", []model.BloggingEventKey{
+					model.NewBloggingEventKey("event_id3", "article_id3"),
+					model.NewBloggingEventKey("event_id2", "article_id2"),
+				}))
+			},
+		},
+		"happy_path:replayed": {
+			args: func() args {
+				in := dto.NewAddArticleToSeriesInDto("series_id", "article_id3", 2)
+				ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+				return args{
+					ctx: ctx,
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewSeriesOutDto("series_id", []dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id3", "article_id3"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement) {},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				outcome := model.NewRequestOutcome("series_id", []model.BloggingEventKey{model.NewBloggingEventKey("event_id3", "article_id3")})
+				expectReplayOutcome(t, cs, stmt, &outcome, nil)
+			},
+		},
+		"unhappy_path:retried-at-another-position": {
+			args: func() args {
+				in := dto.NewAddArticleToSeriesInDto("series_id", "article_id2", 1)
+				ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+				return args{
+					ctx: ctx,
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService: seriesOf(parts[1], parts...),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				expectReplayOutcome(t, cs, stmt, nil, nil)
+			},
+		},
+		"unhappy_path:already-in-series": {
+			args: func() args {
+				in := dto.NewAddArticleToSeriesInDto("series_id", "article_id2", 0)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService:   seriesOf(parts[1], parts...),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:series-not-found": {
+			args: func() args {
+				in := dto.NewAddArticleToSeriesInDto("series_id", "article_id3", 0)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrNotFound,
+			},
+			setupQueryService:   seriesOf(model.SeriesArticle{}),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:negative-position": {
+			args: func() args {
+				in := dto.NewAddArticleToSeriesInDto("series_id", "article_id3", -1)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService:   func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement) {},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path": {
+			args: func() args {
+				in := dto.NewAddArticleToSeriesInDto("series_id", "article_id3", 0)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupQueryService: seriesOf(
+				model.NewSeriesArticle("article_id3", model.ArticleSeries{}, "last_event_id3"),
+				parts...,
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				cs.EXPECT().AddToSeries(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in model.AddToSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errUnhappyPath).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			qs := mquery.NewMockSeriesService(ctrl)
+			cs := mcommand.NewMockBloggingEventService(ctrl)
+			queryStmt := mdb.NewMockStatement(ctrl)
+			commandStmt := mdb.NewMockStatement(ctrl)
+			tt.setupQueryService(qs, queryStmt)
+			tt.setupCommandService(cs, commandStmt)
+
+			u := NewAddArticleToSeries(qs, cs)
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 // AttachTags is a use-case for creating an article.
 type AttachTags struct {
 	bloggingEventCommand command.BloggingEventService
+	tagPolicy            model.TagPolicy
 }
 
 // Execute executes the AttachTags use-case.
@@ -41,7 +42,14 @@ func (u *AttachTags) Execute(ctx context.Context, in *dto.AttachTagsInDto) (_ *d
 		logger.InfoContext(ctx, "END")
 	}()
 
-	command := model.NewAttachTagsEvent(in.ID(), in.TagNames())
+	tagNames, err := u.tagPolicy.Apply("tagNames", in.TagNames())
+	if err != nil {
+		return nil, err
+	}
+	command := model.NewAttachTagsEvent(in.ID(), tagNames, in.ExpectedLastEventID())
+	if err = command.Validate(); err != nil {
+		return nil, err
+	}
 	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
 	err = u.bloggingEventCommand.AttachTags(ctx, command, commandOut).Execute(ctx)
 	if err != nil {
@@ -55,6 +63,9 @@ func (u *AttachTags) Execute(ctx context.Context, in *dto.AttachTagsInDto) (_ *d
 }
 
 // NewAttachTags is a constructor for AttachTags use-case.
-func NewAttachTags(bloggingEventCommand command.BloggingEventService) *AttachTags {
-	return &AttachTags{bloggingEventCommand: bloggingEventCommand}
+func NewAttachTags(bloggingEventCommand command.BloggingEventService, tagPolicy model.TagPolicy) *AttachTags {
+	return &AttachTags{
+		bloggingEventCommand: bloggingEventCommand,
+		tagPolicy:            tagPolicy,
+	}
 }
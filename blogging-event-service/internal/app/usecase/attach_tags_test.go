@@ -32,7 +32,7 @@ func TestAttachTags_Execute(t *testing.T) {
 	tests := map[string]testCase{
 		"happy_path": {
 			args: func() args {
-				in := dto.NewAttachTagsInDto("article_id", []string{"tag1", "tag2"})
+				in := dto.NewAttachTagsInDto("article_id", []string{"tag1", "tag2"}, "last_event_id")
 				return args{
 					ctx: context.Background(),
 					in:  &in,
@@ -58,7 +58,7 @@ func TestAttachTags_Execute(t *testing.T) {
 		},
 		"unhappy_path": {
 			args: func() args {
-				in := dto.NewAttachTagsInDto("article_id", []string{"tag1", "tag2"})
+				in := dto.NewAttachTagsInDto("article_id", []string{"tag1", "tag2"}, "")
 				return args{
 					ctx: context.Background(),
 					in:  &in,
@@ -83,9 +83,9 @@ func TestAttachTags_Execute(t *testing.T) {
 			defer ctrl.Finish()
 			cs := mcommand.NewMockBloggingEventService(ctrl)
 			stmt := mdb.NewMockStatement(ctrl)
-			tt.setupCommandService(cs, model.NewAttachTagsEvent(tt.args.in.ID(), tt.args.in.TagNames()), stmt)
+			tt.setupCommandService(cs, model.NewAttachTagsEvent(tt.args.in.ID(), tt.args.in.TagNames(), tt.args.in.ExpectedLastEventID()), stmt)
 
-			u := NewAttachTags(cs)
+			u := NewAttachTags(cs, model.NewTagPolicy())
 			got, err := u.Execute(tt.args.ctx, tt.args.in)
 			if !errors.Is(err, tt.want.err) {
 				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
@@ -96,3 +96,89 @@ func TestAttachTags_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestAttachTags_Execute_TagPolicy(t *testing.T) {
+	type want struct {
+		tagNames   []string
+		violations []model.FieldViolation
+	}
+	type testCase struct {
+		policy   model.TagPolicy
+		tagNames []string
+		want     want
+	}
+	tests := map[string]testCase{
+		"happy_path/trimmed_and_nfc": {
+			policy:   model.NewTagPolicy(),
+			tagNames: []string{"  Go ", "Cafe\u0301", "C++"},
+			want: want{
+				tagNames: []string{"Go", "Caf\u00e9", "C++"},
+			},
+		},
+		"happy_path/lower": {
+			policy:   model.NewTagPolicy(model.WithTagCaseMode(model.TagCaseLower)),
+			tagNames: []string{"GoLang", "Straße"},
+			want: want{
+				tagNames: []string{"golang", "straße"},
+			},
+		},
+		"happy_path/fold": {
+			policy:   model.NewTagPolicy(model.WithTagCaseMode(model.TagCaseFold)),
+			tagNames: []string{"GoLang", "Straße"},
+			want: want{
+				tagNames: []string{"golang", "strasse"},
+			},
+		},
+		"unhappy_path/violations": {
+			policy:   model.NewTagPolicy(model.WithBlockedTags("Spam")),
+			tagNames: []string{"Go", " ", "123456789012345678901234567890123456", "<script>", "SPAM"},
+			want: want{
+				violations: []model.FieldViolation{
+					model.NewFieldViolation("tagNames[1]", "tag name must not be empty"),
+					model.NewFieldViolation("tagNames[2]", "tag name must be at most 35 characters"),
+					model.NewFieldViolation("tagNames[3]", `tag name "<script>" has characters that are not allowed`),
+					model.NewFieldViolation("tagNames[4]", `tag name "SPAM" is not allowed`),
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cs := mcommand.NewMockBloggingEventService(ctrl)
+			stmt := mdb.NewMockStatement(ctrl)
+			if tt.want.violations == nil {
+				cs.EXPECT().AttachTags(gomock.Any(), model.NewAttachTagsEvent("article_id", tt.want.tagNames, ""), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in model.AttachTagsEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+							v := model.NewBloggingEventKey("event_id", "article_id")
+							out.Set(&v)
+							return nil
+						}).Times(1)
+						return stmt
+					}).Times(1)
+			}
+
+			in := dto.NewAttachTagsInDto("article_id", tt.tagNames, "")
+			u := NewAttachTags(cs, tt.policy)
+			_, err := u.Execute(context.Background(), &in)
+			if tt.want.violations == nil {
+				if err != nil {
+					t.Errorf("Execute() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, model.ErrValidation) {
+				t.Fatalf("Execute() error = %v, want %v", err, model.ErrValidation)
+			}
+			var validationErr *model.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Execute() error = %T, want *model.ValidationError", err)
+			}
+			if !reflect.DeepEqual(validationErr.Violations(), tt.want.violations) {
+				t.Errorf("Execute() violations = %v, want %v", validationErr.Violations(), tt.want.violations)
+			}
+		})
+	}
+}
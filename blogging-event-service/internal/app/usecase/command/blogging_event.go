@@ -9,7 +9,7 @@ import (
 
 // BloggingEventService is a command service for blogging events.
 type BloggingEventService interface {
-	// CreateArticle creates a new article.
+	// CreateArticle creates a new article. It fails with a ValidationError if another article has had the slug.
 	CreateArticle(ctx context.Context, in model.CreateArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
 	// UpdateArticleTitle updates the title of the article.
 	UpdateArticleTitle(ctx context.Context, in model.UpdateArticleTitleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
@@ -17,8 +17,36 @@ type BloggingEventService interface {
 	UpdateArticleBody(ctx context.Context, command model.UpdateArticleBodyEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
 	// UpdateArticleThumbnail updates the thumbnail of the article.
 	UpdateArticleThumbnail(ctx context.Context, command model.UpdateArticleThumbnailEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
+	// UpdateArticleSlug changes the slug of the article. It fails with a ValidationError if another article has had the slug.
+	UpdateArticleSlug(ctx context.Context, command model.UpdateArticleSlugEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
 	// AttachTags attaches tags to the article.
 	AttachTags(ctx context.Context, command model.AttachTagsEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
 	// DetachTags detaches tags from the article.
 	DetachTags(ctx context.Context, command model.DetachTagsEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
+	// HideArticle hides the article.
+	HideArticle(ctx context.Context, command model.HideArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
+	// UnhideArticle makes the hidden article visible again.
+	UnhideArticle(ctx context.Context, command model.UnhideArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
+	// EditArticle changes several fields of the article at once.
+	EditArticle(ctx context.Context, command model.EditArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
+	// ScheduleArticle sets the time the article goes live.
+	ScheduleArticle(ctx context.Context, command model.ScheduleArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
+	// PublishArticle publishes the draft article.
+	PublishArticle(ctx context.Context, command model.PublishArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
+	// RevertArticle restores the article as it was right after an earlier event.
+	RevertArticle(ctx context.Context, command model.RevertArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
+	// RenameTag renames the tag on the article. It is written to hidden articles as well.
+	RenameTag(ctx context.Context, command model.RenameTagEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
+	// MergeTags replaces the source tags of the article with another tag. It is written to hidden articles as well.
+	MergeTags(ctx context.Context, command model.MergeTagsEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
+	// AddToSeries puts the article at a position of a series. It is written to hidden articles as well.
+	AddToSeries(ctx context.Context, command model.AddToSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
+	// ReorderSeries moves the article to another position of its series. It is written to hidden articles as well.
+	ReorderSeries(ctx context.Context, command model.ReorderSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement
+	// RecordOutcome records the outcome of a request written as an event per article under the idempotency key of the request.
+	// It does nothing if the request has no idempotency key. If a retry has recorded an outcome in the meantime, that one is returned.
+	RecordOutcome(ctx context.Context, outcome model.RequestOutcome, out *db.SingleStatementResult[*model.RequestOutcome]) db.Statement
+	// ReplayOutcome returns the outcome recorded under the idempotency key of the request, or nil if there is none.
+	// It fails with ErrIdempotencyKeyReused if the key was used for a request with a different payload.
+	ReplayOutcome(ctx context.Context, out *db.SingleStatementResult[*model.RequestOutcome]) db.Statement
 }
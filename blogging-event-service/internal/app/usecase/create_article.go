@@ -17,6 +17,7 @@ import (
 // CreateArticle is a use-case for creating an article.
 type CreateArticle struct {
 	bloggingEventCommand command.BloggingEventService
+	tagPolicy            model.TagPolicy
 }
 
 // Execute executes the CreateArticle use-case.
@@ -32,7 +33,22 @@ func (u *CreateArticle) Execute(ctx context.Context, in *dto.CreateArticleInDto)
 	}
 	logger.InfoContext(ctx, "BEGIN")
 
-	command := model.NewCreateArticleEvent(in.Title(), in.Body(), in.ThumbnailUrl(), in.TagNames())
+	tagNames, err := u.tagPolicy.Apply("tagNames", in.TagNames())
+	if err != nil {
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("dto.CreateArticleOutDto", nil),
+				slog.Any("error", err)))
+		return nil, err
+	}
+	command := model.NewCreateArticleEvent(in.Title(), in.Body(), in.ThumbnailUrl(), imageVariantsOf(in.ThumbnailVariants()), tagNames, in.PublishAt(), in.Draft(), model.NormalizeSlug(in.Slug()))
+	if err := command.Validate(); err != nil {
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("dto.CreateArticleOutDto", nil),
+				slog.Any("error", err)))
+		return nil, err
+	}
 	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
 	err = u.bloggingEventCommand.CreateArticle(ctx, command, commandOut).Execute(ctx)
 	if err != nil {
@@ -51,6 +67,9 @@ func (u *CreateArticle) Execute(ctx context.Context, in *dto.CreateArticleInDto)
 }
 
 // NewCreateArticle is a constructor for CreateArticle use-case.
-func NewCreateArticle(bloggingEventCommand command.BloggingEventService) *CreateArticle {
-	return &CreateArticle{bloggingEventCommand: bloggingEventCommand}
+func NewCreateArticle(bloggingEventCommand command.BloggingEventService, tagPolicy model.TagPolicy) *CreateArticle {
+	return &CreateArticle{
+		bloggingEventCommand: bloggingEventCommand,
+		tagPolicy:            tagPolicy,
+	}
 }
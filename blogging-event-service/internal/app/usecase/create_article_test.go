@@ -11,6 +11,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestCreateArticle_Execute(t *testing.T) {
@@ -34,7 +35,7 @@ func TestCreateArticle_Execute(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				in: func() *dto.CreateArticleInDto {
-					v := dto.NewCreateArticleInDto("happy_path", "## happy_path", "thumbnail", []string{"tag1", "tag2"})
+					v := dto.NewCreateArticleInDto("happy_path", "## happy_path", "thumbnail", nil, []string{"tag1", "tag2"}, time.Time{}, false, "")
 					return &v
 				}(),
 			},
@@ -58,7 +59,7 @@ func TestCreateArticle_Execute(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				in: func() *dto.CreateArticleInDto {
-					v := dto.NewCreateArticleInDto("unhappy_path", "## unhappy_path", "thumbnail", []string{"tag1", "tag2"})
+					v := dto.NewCreateArticleInDto("unhappy_path", "## unhappy_path", "thumbnail", nil, []string{"tag1", "tag2"}, time.Time{}, false, "")
 					return &v
 				}(),
 			},
@@ -81,9 +82,9 @@ func TestCreateArticle_Execute(t *testing.T) {
 
 			cs := mcommand.NewMockBloggingEventService(ctrl)
 			stmt := mdb.NewMockStatement(ctrl)
-			tt.setupCommandService(cs, model.NewCreateArticleEvent(tt.args.in.Title(), tt.args.in.Body(), tt.args.in.ThumbnailUrl(), tt.args.in.TagNames()), stmt)
+			tt.setupCommandService(cs, model.NewCreateArticleEvent(tt.args.in.Title(), tt.args.in.Body(), tt.args.in.ThumbnailUrl(), nil, tt.args.in.TagNames(), tt.args.in.PublishAt(), tt.args.in.Draft(), tt.args.in.Slug()), stmt)
 
-			u := NewCreateArticle(cs)
+			u := NewCreateArticle(cs, model.NewTagPolicy())
 			got, err := u.Execute(tt.args.ctx, tt.args.in)
 			if !errors.Is(err, tt.want.err) {
 				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
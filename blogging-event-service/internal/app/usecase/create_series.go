@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/blogging-event-service/internal/pkg"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/oklog/ulid/v2"
+	"log/slog"
+)
+
+// CreateSeries is a use-case for putting articles together in a new series.
+type CreateSeries struct {
+	seriesQuery          query.SeriesService
+	bloggingEventCommand command.BloggingEventService
+	ulidGen              pkg.ULIDGenerator
+}
+
+// Execute executes the CreateSeries use-case.
+// An event is appended to each article in turn, so a failure leaves only some of them in the series.
+// A request with an idempotency key puts the articles in a series whose id is derived from the key,
+// so that a retry finds the articles already put in the series at their positions and finishes the job.
+// Once every article is done, the outcome is recorded under the key, and a retry after that is answered with it.
+func (u *CreateSeries) Execute(ctx context.Context, in *dto.CreateSeriesInDto) (_ *dto.SeriesOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.SeriesOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	title := model.NormalizeSeriesTitle(in.Title())
+	if err = model.ValidateSeriesTitle(title); err != nil {
+		return nil, err
+	}
+	if err = model.ValidateSeriesArticleIDs(in.ArticleIDs()); err != nil {
+		return nil, err
+	}
+
+	replayed, err := replayOutcome(ctx, u.bloggingEventCommand)
+	if err != nil {
+		return nil, err
+	}
+	if replayed != nil {
+		result := dto.NewSeriesOutDto(replayed.SubjectID(), bloggingEventKeyDtos(replayed.Events()))
+		return &result, nil
+	}
+
+	seriesID := u.ulidGen().String()
+	if key, ok := model.IdempotencyKeyFromContext(ctx); ok {
+		seriesID = seriesIDOf(key)
+	}
+
+	// every article is checked before the first event is appended.
+	articles := make([]*model.SeriesArticle, 0, len(in.ArticleIDs()))
+	for i, articleID := range in.ArticleIDs() {
+		queryOut := db.NewSingleStatementResult[*model.SeriesArticle]()
+		err = u.seriesQuery.GetSeriesArticle(ctx, articleID, queryOut).Execute(ctx)
+		if err != nil {
+			err = errors.WithStack(err)
+			return nil, err
+		}
+		article := queryOut.StrictGet()
+		// an article a retried request has already put in the series is written again, so that its event is replayed.
+		if series := article.Series(); series.ID() != "" && (series.ID() != seriesID || series.Position() != i+1) {
+			return nil, model.AlreadyInSeriesError(fmt.Sprintf("articleIds[%d]", i), *article)
+		}
+		articles = append(articles, article)
+	}
+
+	events := make([]model.BloggingEventKey, 0, len(articles))
+	for i, article := range articles {
+		command := model.NewAddToSeriesEvent(article.ArticleID(), model.NewArticleSeries(seriesID, title, i+1), article.LastEventID())
+		if err = command.Validate(); err != nil {
+			return nil, err
+		}
+		commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
+		articleCtx := model.ContextWithArticleIdempotencyKey(ctx, article.ArticleID())
+		err = u.bloggingEventCommand.AddToSeries(articleCtx, command, commandOut).Execute(articleCtx)
+		if err != nil {
+			err = errors.WithStack(err)
+			return nil, err
+		}
+		events = append(events, *commandOut.StrictGet())
+	}
+
+	outcome, err := recordOutcome(ctx, u.bloggingEventCommand, model.NewRequestOutcome(seriesID, events))
+	if err != nil {
+		return nil, err
+	}
+	result := dto.NewSeriesOutDto(outcome.SubjectID(), bloggingEventKeyDtos(outcome.Events()))
+	return &result, nil
+}
+
+// seriesIDOf derives the id of the series created by the request with the idempotency key.
+func seriesIDOf(key model.IdempotencyKey) string {
+	sum := sha256.Sum256([]byte(key.Caller() + "#" + key.Key()))
+	var id ulid.ULID
+	copy(id[:], sum[:])
+	return id.String()
+}
+
+// NewCreateSeries is a constructor for CreateSeries use-case.
+// The series id of a request without an idempotency key is generated with ulidGen, or ulid.Make if it is nil.
+func NewCreateSeries(seriesQuery query.SeriesService, bloggingEventCommand command.BloggingEventService, ulidGen *pkg.ULIDGenerator) *CreateSeries {
+	u := &CreateSeries{
+		seriesQuery:          seriesQuery,
+		bloggingEventCommand: bloggingEventCommand,
+		ulidGen:              ulid.Make,
+	}
+	if ulidGen != nil {
+		u.ulidGen = *ulidGen
+	}
+	return u
+}
@@ -0,0 +1,316 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mcommand "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/command"
+	mquery "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/query"
+	mdb "blogapi.miyamo.today/blogging-event-service/internal/mock/core/db"
+	"blogapi.miyamo.today/blogging-event-service/internal/pkg"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/oklog/ulid/v2"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestCreateSeries_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *dto.CreateSeriesInDto
+	}
+	type want struct {
+		out *dto.SeriesOutDto
+		err error
+	}
+	type testCase struct {
+		args                args
+		want                want
+		setupQueryService   func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement)
+		setupCommandService func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement)
+	}
+	errUnhappyPath := errors.New("unhappy_path")
+	seriesID := ulid.MustParse("01JF0RDJYN8NJ57RN65G7FNGHS")
+	ulidGen := pkg.ULIDGenerator(func() ulid.ULID { return seriesID })
+
+	getSeriesArticles := func(articles ...model.SeriesArticle) func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement) {
+		return func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement) {
+			for _, a := range articles {
+				qs.EXPECT().GetSeriesArticle(gomock.Any(), a.ArticleID(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, articleID string, out *db.SingleStatementResult[*model.SeriesArticle]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+							out.Set(&a)
+							return nil
+						}).Times(1)
+						return stmt
+					}).Times(1)
+			}
+		}
+	}
+	keyedSeriesID := seriesIDOf(testIdempotencyKey)
+	addToSeries := func(seriesID string, lastEventIDs ...string) func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+		return func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+			for i, articleID := range []string{"article_id2", "article_id1"} {
+				series := model.NewArticleSeries(seriesID, "Go in Practice", i+1)
+				cs.EXPECT().AddToSeries(gomock.Any(), model.NewAddToSeriesEvent(articleID, series, lastEventIDs[i]), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in model.AddToSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+						if key, ok := model.IdempotencyKeyFromContext(ctx); ok && key != model.NewIdempotencyKey("caller", "key#"+articleID, "fingerprint") {
+							t.Errorf("AddToSeries() is called with the idempotency key %+v", key)
+						}
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+							v := model.NewBloggingEventKey([]string{"event_id2", "event_id1"}[i], articleID)
+							out.Set(&v)
+							return nil
+						}).Times(1)
+						return stmt
+					}).Times(1)
+			}
+		}
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			args: func() args {
+				in := dto.NewCreateSeriesInDto(" Go in Practice ", []string{"article_id2", "article_id1"})
+				// each event is recorded under the key of the request and its article.
+				ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+				return args{
+					ctx: ctx,
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewSeriesOutDto(keyedSeriesID, []dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id2", "article_id2"),
+					dto.NewBloggingEventKeyDto("event_id1", "article_id1"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: getSeriesArticles(
+				model.NewSeriesArticle("article_id2", model.ArticleSeries{}, "last_event_id2"),
+				model.NewSeriesArticle("article_id1", model.ArticleSeries{}, "last_event_id1"),
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				expectReplayOutcome(t, cs, stmt, nil, nil)
+				addToSeries(keyedSeriesID, "last_event_id2", "last_event_id1")(cs, stmt)
+				expectRecordOutcome(t, cs, stmt, model.NewRequestOutcome(keyedSeriesID, []model.BloggingEventKey{
+					model.NewBloggingEventKey("event_id2", "article_id2"),
+					model.NewBloggingEventKey("event_id1", "article_id1"),
+				}))
+			},
+		},
+		"happy_path:without-idempotency-key": {
+			args: func() args {
+				in := dto.NewCreateSeriesInDto("Go in Practice", []string{"article_id2", "article_id1"})
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewSeriesOutDto(seriesID.String(), []dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id2", "article_id2"),
+					dto.NewBloggingEventKeyDto("event_id1", "article_id1"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: getSeriesArticles(
+				model.NewSeriesArticle("article_id2", model.ArticleSeries{}, "last_event_id2"),
+				model.NewSeriesArticle("article_id1", model.ArticleSeries{}, "last_event_id1"),
+			),
+			setupCommandService: addToSeries(seriesID.String(), "last_event_id2", "last_event_id1"),
+		},
+		"happy_path:retried": {
+			args: func() args {
+				in := dto.NewCreateSeriesInDto("Go in Practice", []string{"article_id2", "article_id1"})
+				ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+				return args{
+					ctx: ctx,
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewSeriesOutDto(keyedSeriesID, []dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id2", "article_id2"),
+					dto.NewBloggingEventKeyDto("event_id1", "article_id1"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			// the first article was put in the series before the request failed.
+			setupQueryService: getSeriesArticles(
+				model.NewSeriesArticle("article_id2", model.NewArticleSeries(keyedSeriesID, "Go in Practice", 1), "event_id2"),
+				model.NewSeriesArticle("article_id1", model.ArticleSeries{}, "last_event_id1"),
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				expectReplayOutcome(t, cs, stmt, nil, nil)
+				addToSeries(keyedSeriesID, "event_id2", "last_event_id1")(cs, stmt)
+				expectRecordOutcome(t, cs, stmt, model.NewRequestOutcome(keyedSeriesID, []model.BloggingEventKey{
+					model.NewBloggingEventKey("event_id2", "article_id2"),
+					model.NewBloggingEventKey("event_id1", "article_id1"),
+				}))
+			},
+		},
+		"happy_path:replayed": {
+			args: func() args {
+				in := dto.NewCreateSeriesInDto("Go in Practice", []string{"article_id2", "article_id1"})
+				ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+				return args{
+					ctx: ctx,
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewSeriesOutDto(keyedSeriesID, []dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id2", "article_id2"),
+					dto.NewBloggingEventKeyDto("event_id1", "article_id1"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			// the articles are already in the series, but the request has been applied already.
+			setupQueryService: func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement) {},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				outcome := model.NewRequestOutcome(keyedSeriesID, []model.BloggingEventKey{
+					model.NewBloggingEventKey("event_id2", "article_id2"),
+					model.NewBloggingEventKey("event_id1", "article_id1"),
+				})
+				expectReplayOutcome(t, cs, stmt, &outcome, nil)
+			},
+		},
+		"unhappy_path:retried-at-another-position": {
+			args: func() args {
+				in := dto.NewCreateSeriesInDto("Go in Practice", []string{"article_id2", "article_id1"})
+				ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+				return args{
+					ctx: ctx,
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService: getSeriesArticles(
+				model.NewSeriesArticle("article_id2", model.NewArticleSeries(keyedSeriesID, "Go in Practice", 2), "event_id2"),
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				expectReplayOutcome(t, cs, stmt, nil, nil)
+			},
+		},
+		"unhappy_path:already-in-series": {
+			args: func() args {
+				in := dto.NewCreateSeriesInDto("Go in Practice", []string{"article_id1", "article_id2"})
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			// no article is put in the series if any of them cannot be.
+			setupQueryService: getSeriesArticles(
+				model.NewSeriesArticle("article_id1", model.ArticleSeries{}, "last_event_id1"),
+				model.NewSeriesArticle("article_id2", model.NewArticleSeries("series_id", "Rust", 1), "last_event_id2"),
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:not-found": {
+			args: func() args {
+				in := dto.NewCreateSeriesInDto("Go in Practice", []string{"article_id1"})
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrNotFound,
+			},
+			setupQueryService: func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement) {
+				qs.EXPECT().GetSeriesArticle(gomock.Any(), "article_id1", gomock.Any()).Return(stmt).Times(1)
+				stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(model.ErrNotFound).Times(1)
+			},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:without-title": {
+			args: func() args {
+				in := dto.NewCreateSeriesInDto(" ", []string{"article_id1"})
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService:   func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement) {},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:repeated-article": {
+			args: func() args {
+				in := dto.NewCreateSeriesInDto("Go in Practice", []string{"article_id1", "article_id1"})
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService:   func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement) {},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path": {
+			args: func() args {
+				in := dto.NewCreateSeriesInDto("Go in Practice", []string{"article_id1", "article_id2"})
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupQueryService: getSeriesArticles(
+				model.NewSeriesArticle("article_id1", model.ArticleSeries{}, "last_event_id1"),
+				model.NewSeriesArticle("article_id2", model.ArticleSeries{}, "last_event_id2"),
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				cs.EXPECT().AddToSeries(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in model.AddToSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errUnhappyPath).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			qs := mquery.NewMockSeriesService(ctrl)
+			cs := mcommand.NewMockBloggingEventService(ctrl)
+			queryStmt := mdb.NewMockStatement(ctrl)
+			commandStmt := mdb.NewMockStatement(ctrl)
+			tt.setupQueryService(qs, queryStmt)
+			tt.setupCommandService(cs, commandStmt)
+
+			u := NewCreateSeries(qs, cs, &ulidGen)
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 // DetachTags is a use-case for creating an article.
 type DetachTags struct {
 	bloggingEventCommand command.BloggingEventService
+	tagPolicy            model.TagPolicy
 }
 
 // Execute executes the DetachTags use-case.
@@ -41,7 +42,10 @@ func (u *DetachTags) Execute(ctx context.Context, in *dto.DetachTagsInDto) (_ *d
 		logger.InfoContext(ctx, "END")
 	}()
 
-	command := model.NewDetachTagsEvent(in.ID(), in.TagNames())
+	command := model.NewDetachTagsEvent(in.ID(), u.tagPolicy.LookupAll(in.TagNames()), in.ExpectedLastEventID())
+	if err = command.Validate(); err != nil {
+		return nil, err
+	}
 	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
 	err = u.bloggingEventCommand.DetachTags(ctx, command, commandOut).Execute(ctx)
 	if err != nil {
@@ -55,6 +59,9 @@ func (u *DetachTags) Execute(ctx context.Context, in *dto.DetachTagsInDto) (_ *d
 }
 
 // NewDetachTags is a constructor for DetachTags use-case.
-func NewDetachTags(bloggingEventCommand command.BloggingEventService) *DetachTags {
-	return &DetachTags{bloggingEventCommand: bloggingEventCommand}
+func NewDetachTags(bloggingEventCommand command.BloggingEventService, tagPolicy model.TagPolicy) *DetachTags {
+	return &DetachTags{
+		bloggingEventCommand: bloggingEventCommand,
+		tagPolicy:            tagPolicy,
+	}
 }
@@ -32,7 +32,7 @@ func TestDetachTags_Execute(t *testing.T) {
 	tests := map[string]testCase{
 		"happy_path": {
 			args: func() args {
-				in := dto.NewDetachTagsInDto("article_id", []string{"tag1", "tag2"})
+				in := dto.NewDetachTagsInDto("article_id", []string{"tag1", "tag2"}, "last_event_id")
 				return args{
 					ctx: context.Background(),
 					in:  &in,
@@ -58,7 +58,7 @@ func TestDetachTags_Execute(t *testing.T) {
 		},
 		"unhappy_path": {
 			args: func() args {
-				in := dto.NewDetachTagsInDto("article_id", []string{"tag1", "tag2"})
+				in := dto.NewDetachTagsInDto("article_id", []string{"tag1", "tag2"}, "")
 				return args{
 					ctx: context.Background(),
 					in:  &in,
@@ -83,9 +83,9 @@ func TestDetachTags_Execute(t *testing.T) {
 			defer ctrl.Finish()
 			cs := mcommand.NewMockBloggingEventService(ctrl)
 			stmt := mdb.NewMockStatement(ctrl)
-			tt.setupCommandService(cs, model.NewDetachTagsEvent(tt.args.in.ID(), tt.args.in.TagNames()), stmt)
+			tt.setupCommandService(cs, model.NewDetachTagsEvent(tt.args.in.ID(), tt.args.in.TagNames(), tt.args.in.ExpectedLastEventID()), stmt)
 
-			u := NewDetachTags(cs)
+			u := NewDetachTags(cs, model.NewTagPolicy())
 			got, err := u.Execute(tt.args.ctx, tt.args.in)
 			if !errors.Is(err, tt.want.err) {
 				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
@@ -1,15 +1,21 @@
 package dto
 
 import (
+	"io"
 	"net/url"
+	"time"
 )
 
 // CreateArticleInDto is an Input DTO for CreateArticle use-case
 type CreateArticleInDto struct {
-	title        string
-	body         string
-	thumbnailUrl string
-	tagNames     []string
+	title             string
+	body              string
+	thumbnailUrl      string
+	thumbnailVariants []ImageVariantDto
+	tagNames          []string
+	publishAt         time.Time
+	draft             bool
+	slug              string
 }
 
 // Title returns the title of the article to be created
@@ -27,18 +33,42 @@ func (i CreateArticleInDto) ThumbnailUrl() string {
 	return i.thumbnailUrl
 }
 
+// ThumbnailVariants returns the variants of the thumbnail of the article to be created
+func (i CreateArticleInDto) ThumbnailVariants() []ImageVariantDto {
+	return i.thumbnailVariants
+}
+
 // TagNames returns the tag names of the article to be created
 func (i CreateArticleInDto) TagNames() []string {
 	return i.tagNames
 }
 
+// PublishAt returns the time the article to be created goes live. The zero value publishes it immediately
+func (i CreateArticleInDto) PublishAt() time.Time {
+	return i.publishAt
+}
+
+// Draft reports whether the article to be created is a draft
+func (i CreateArticleInDto) Draft() bool {
+	return i.draft
+}
+
+// Slug returns the slug of the article to be created. It is empty if the article has none
+func (i CreateArticleInDto) Slug() string {
+	return i.slug
+}
+
 // NewCreateArticleInDto is constructor of CreateArticle.
-func NewCreateArticleInDto(title, body, thumbnailUrl string, tagNames []string) CreateArticleInDto {
+func NewCreateArticleInDto(title, body, thumbnailUrl string, thumbnailVariants []ImageVariantDto, tagNames []string, publishAt time.Time, draft bool, slug string) CreateArticleInDto {
 	return CreateArticleInDto{
-		title:        title,
-		body:         body,
-		thumbnailUrl: thumbnailUrl,
-		tagNames:     tagNames,
+		title:             title,
+		body:              body,
+		thumbnailUrl:      thumbnailUrl,
+		thumbnailVariants: thumbnailVariants,
+		tagNames:          tagNames,
+		publishAt:         publishAt,
+		draft:             draft,
+		slug:              slug,
 	}
 }
 
@@ -68,8 +98,9 @@ func NewCreateArticleOutDto(eventID, articleID string) CreateArticleOutDto {
 
 // UpdateArticleTitleInDto is an Input DTO for UpdateArticleTitle use-case
 type UpdateArticleTitleInDto struct {
-	id    string
-	title string
+	id                  string
+	title               string
+	expectedLastEventID string
 }
 
 // ID returns the ID of the article to be updated
@@ -82,11 +113,17 @@ func (i UpdateArticleTitleInDto) Title() string {
 	return i.title
 }
 
+// ExpectedLastEventID returns the ID of the event expected to be the latest one
+func (i UpdateArticleTitleInDto) ExpectedLastEventID() string {
+	return i.expectedLastEventID
+}
+
 // NewUpdateArticleTitleInDto is constructor of UpdateArticleTitleInDto.
-func NewUpdateArticleTitleInDto(id string, title string) UpdateArticleTitleInDto {
+func NewUpdateArticleTitleInDto(id string, title string, expectedLastEventID string) UpdateArticleTitleInDto {
 	return UpdateArticleTitleInDto{
-		id:    id,
-		title: title,
+		id:                  id,
+		title:               title,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
@@ -114,10 +151,66 @@ func NewUpdateArticleTitleOutDto(eventID, articleID string) UpdateArticleTitleOu
 	}
 }
 
+// UpdateArticleSlugInDto is an Input DTO for UpdateArticleSlug use-case
+type UpdateArticleSlugInDto struct {
+	id                  string
+	slug                string
+	expectedLastEventID string
+}
+
+// ID returns the ID of the article to be updated
+func (i UpdateArticleSlugInDto) ID() string {
+	return i.id
+}
+
+// Slug returns the new slug of the article
+func (i UpdateArticleSlugInDto) Slug() string {
+	return i.slug
+}
+
+// ExpectedLastEventID returns the ID of the event expected to be the latest one
+func (i UpdateArticleSlugInDto) ExpectedLastEventID() string {
+	return i.expectedLastEventID
+}
+
+// NewUpdateArticleSlugInDto is constructor of UpdateArticleSlugInDto.
+func NewUpdateArticleSlugInDto(id, slug, expectedLastEventID string) UpdateArticleSlugInDto {
+	return UpdateArticleSlugInDto{
+		id:                  id,
+		slug:                slug,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// UpdateArticleSlugOutDto is an Output DTO for UpdateArticleSlug use-case
+type UpdateArticleSlugOutDto struct {
+	eventID   string
+	articleID string
+}
+
+// EventID returns the ID of the event
+func (o UpdateArticleSlugOutDto) EventID() string {
+	return o.eventID
+}
+
+// ArticleID returns the ID of the article
+func (o UpdateArticleSlugOutDto) ArticleID() string {
+	return o.articleID
+}
+
+// NewUpdateArticleSlugOutDto is constructor of UpdateArticleSlugOutDto.
+func NewUpdateArticleSlugOutDto(eventID, articleID string) UpdateArticleSlugOutDto {
+	return UpdateArticleSlugOutDto{
+		eventID:   eventID,
+		articleID: articleID,
+	}
+}
+
 // UpdateArticleBodyInDto is an Input DTO for UpdateArticleBody use-case
 type UpdateArticleBodyInDto struct {
-	id   string
-	body string
+	id                  string
+	body                string
+	expectedLastEventID string
 }
 
 // ID returns the ID of the article to be updated
@@ -130,11 +223,17 @@ func (i UpdateArticleBodyInDto) Body() string {
 	return i.body
 }
 
+// ExpectedLastEventID returns the ID of the event expected to be the latest one
+func (i UpdateArticleBodyInDto) ExpectedLastEventID() string {
+	return i.expectedLastEventID
+}
+
 // NewUpdateArticleBodyInDto is constructor of UpdateArticleBodyInDto.
-func NewUpdateArticleBodyInDto(id string, body string) UpdateArticleBodyInDto {
+func NewUpdateArticleBodyInDto(id string, body string, expectedLastEventID string) UpdateArticleBodyInDto {
 	return UpdateArticleBodyInDto{
-		id:   id,
-		body: body,
+		id:                  id,
+		body:                body,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
@@ -164,8 +263,10 @@ func NewUpdateArticleBodyOutDto(eventID, articleID string) UpdateArticleBodyOutD
 
 // UpdateArticleThumbnailInDto is an Input DTO for UpdateArticleThumbnail use-case
 type UpdateArticleThumbnailInDto struct {
-	id           string
-	thumbnailUrl url.URL
+	id                  string
+	thumbnailUrl        url.URL
+	thumbnailVariants   []ImageVariantDto
+	expectedLastEventID string
 }
 
 // ID returns the ID of the article to be updated
@@ -178,11 +279,23 @@ func (i UpdateArticleThumbnailInDto) ThumbnailUrl() url.URL {
 	return i.thumbnailUrl
 }
 
+// ThumbnailVariants returns the variants of the thumbnail of the article to be updated
+func (i UpdateArticleThumbnailInDto) ThumbnailVariants() []ImageVariantDto {
+	return i.thumbnailVariants
+}
+
+// ExpectedLastEventID returns the ID of the event expected to be the latest one
+func (i UpdateArticleThumbnailInDto) ExpectedLastEventID() string {
+	return i.expectedLastEventID
+}
+
 // NewUpdateArticleThumbnailInDto is constructor of UpdateArticleThumbnailInDto.
-func NewUpdateArticleThumbnailInDto(id string, thumbnailUrl url.URL) UpdateArticleThumbnailInDto {
+func NewUpdateArticleThumbnailInDto(id string, thumbnailUrl url.URL, thumbnailVariants []ImageVariantDto, expectedLastEventID string) UpdateArticleThumbnailInDto {
 	return UpdateArticleThumbnailInDto{
-		id:           id,
-		thumbnailUrl: thumbnailUrl,
+		id:                  id,
+		thumbnailUrl:        thumbnailUrl,
+		thumbnailVariants:   thumbnailVariants,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
@@ -212,8 +325,9 @@ func NewUpdateArticleThumbnailOutDto(eventID, articleID string) UpdateArticleThu
 
 // AttachTagsInDto is an Input DTO for AttachTag use-case
 type AttachTagsInDto struct {
-	id       string
-	tagNames []string
+	id                  string
+	tagNames            []string
+	expectedLastEventID string
 }
 
 // ID returns the ID of the article to attach tags
@@ -226,11 +340,17 @@ func (i AttachTagsInDto) TagNames() []string {
 	return i.tagNames
 }
 
+// ExpectedLastEventID returns the ID of the event expected to be the latest one
+func (i AttachTagsInDto) ExpectedLastEventID() string {
+	return i.expectedLastEventID
+}
+
 // NewAttachTagsInDto is constructor of AttachTagsInDto.
-func NewAttachTagsInDto(id string, names []string) AttachTagsInDto {
+func NewAttachTagsInDto(id string, names []string, expectedLastEventID string) AttachTagsInDto {
 	return AttachTagsInDto{
-		id:       id,
-		tagNames: names,
+		id:                  id,
+		tagNames:            names,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
@@ -260,8 +380,9 @@ func NewAttachTagsOutDto(eventID, articleID string) AttachTagsOutDto {
 
 // DetachTagsInDto is an Input DTO for DetachTags use-case
 type DetachTagsInDto struct {
-	id       string
-	tagNames []string
+	id                  string
+	tagNames            []string
+	expectedLastEventID string
 }
 
 // ID returns the ID of the article to detach tags
@@ -274,11 +395,17 @@ func (i DetachTagsInDto) TagNames() []string {
 	return i.tagNames
 }
 
+// ExpectedLastEventID returns the ID of the event expected to be the latest one
+func (i DetachTagsInDto) ExpectedLastEventID() string {
+	return i.expectedLastEventID
+}
+
 // NewDetachTagsInDto is constructor of DetachTagsInDto.
-func NewDetachTagsInDto(id string, names []string) DetachTagsInDto {
+func NewDetachTagsInDto(id string, names []string, expectedLastEventID string) DetachTagsInDto {
 	return DetachTagsInDto{
-		id:       id,
-		tagNames: names,
+		id:                  id,
+		tagNames:            names,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
@@ -306,11 +433,198 @@ func NewDetachTagsOutDto(eventID, articleID string) DetachTagsOutDto {
 	}
 }
 
+// HideArticleInDto is an Input DTO for HideArticle use-case
+type HideArticleInDto struct {
+	id                  string
+	expectedLastEventID string
+}
+
+// ID returns the ID of the article to hide
+func (i HideArticleInDto) ID() string {
+	return i.id
+}
+
+// ExpectedLastEventID returns the ID of the event expected to be the latest one
+func (i HideArticleInDto) ExpectedLastEventID() string {
+	return i.expectedLastEventID
+}
+
+// NewHideArticleInDto is constructor of HideArticleInDto.
+func NewHideArticleInDto(id string, expectedLastEventID string) HideArticleInDto {
+	return HideArticleInDto{
+		id:                  id,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// HideArticleOutDto is an Output DTO for HideArticle use-case
+type HideArticleOutDto struct {
+	eventID   string
+	articleID string
+}
+
+// EventID returns the ID of the event
+func (o HideArticleOutDto) EventID() string {
+	return o.eventID
+}
+
+// ArticleID returns the ID of the article
+func (o HideArticleOutDto) ArticleID() string {
+	return o.articleID
+}
+
+// NewHideArticleOutDto is constructor of HideArticleOutDto.
+func NewHideArticleOutDto(eventID, articleID string) HideArticleOutDto {
+	return HideArticleOutDto{
+		eventID:   eventID,
+		articleID: articleID,
+	}
+}
+
+// UnhideArticleInDto is an Input DTO for UnhideArticle use-case
+type UnhideArticleInDto struct {
+	id                  string
+	expectedLastEventID string
+}
+
+// ID returns the ID of the article to unhide
+func (i UnhideArticleInDto) ID() string {
+	return i.id
+}
+
+// ExpectedLastEventID returns the ID of the event expected to be the latest one
+func (i UnhideArticleInDto) ExpectedLastEventID() string {
+	return i.expectedLastEventID
+}
+
+// NewUnhideArticleInDto is constructor of UnhideArticleInDto.
+func NewUnhideArticleInDto(id string, expectedLastEventID string) UnhideArticleInDto {
+	return UnhideArticleInDto{
+		id:                  id,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// UnhideArticleOutDto is an Output DTO for UnhideArticle use-case
+type UnhideArticleOutDto struct {
+	eventID   string
+	articleID string
+}
+
+// EventID returns the ID of the event
+func (o UnhideArticleOutDto) EventID() string {
+	return o.eventID
+}
+
+// ArticleID returns the ID of the article
+func (o UnhideArticleOutDto) ArticleID() string {
+	return o.articleID
+}
+
+// NewUnhideArticleOutDto is constructor of UnhideArticleOutDto.
+func NewUnhideArticleOutDto(eventID, articleID string) UnhideArticleOutDto {
+	return UnhideArticleOutDto{
+		eventID:   eventID,
+		articleID: articleID,
+	}
+}
+
+// EditArticleInDto is an Input DTO for EditArticle use-case
+type EditArticleInDto struct {
+	id                  string
+	title               *string
+	body                *string
+	thumbnail           *url.URL
+	thumbnailVariants   []ImageVariantDto
+	attachTagNames      []string
+	detachTagNames      []string
+	expectedLastEventID string
+}
+
+// ID returns the ID of the article to be edited
+func (i EditArticleInDto) ID() string {
+	return i.id
+}
+
+// Title returns the new title of the article, or nil if it is unchanged
+func (i EditArticleInDto) Title() *string {
+	return i.title
+}
+
+// Body returns the new body of the article, or nil if it is unchanged
+func (i EditArticleInDto) Body() *string {
+	return i.body
+}
+
+// Thumbnail returns the new thumbnail of the article, or nil if it is unchanged
+func (i EditArticleInDto) Thumbnail() *url.URL {
+	return i.thumbnail
+}
+
+// ThumbnailVariants returns the variants of the new thumbnail of the article
+func (i EditArticleInDto) ThumbnailVariants() []ImageVariantDto {
+	return i.thumbnailVariants
+}
+
+// AttachTagNames returns the names of the tags to be attached
+func (i EditArticleInDto) AttachTagNames() []string {
+	return i.attachTagNames
+}
+
+// DetachTagNames returns the names of the tags to be detached
+func (i EditArticleInDto) DetachTagNames() []string {
+	return i.detachTagNames
+}
+
+// ExpectedLastEventID returns the ID of the event expected to be the latest one
+func (i EditArticleInDto) ExpectedLastEventID() string {
+	return i.expectedLastEventID
+}
+
+// NewEditArticleInDto is constructor of EditArticleInDto.
+func NewEditArticleInDto(id string, title, body *string, thumbnail *url.URL, thumbnailVariants []ImageVariantDto, attachTagNames, detachTagNames []string, expectedLastEventID string) EditArticleInDto {
+	return EditArticleInDto{
+		id:                  id,
+		title:               title,
+		body:                body,
+		thumbnail:           thumbnail,
+		thumbnailVariants:   thumbnailVariants,
+		attachTagNames:      attachTagNames,
+		detachTagNames:      detachTagNames,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// EditArticleOutDto is an Output DTO for EditArticle use-case
+type EditArticleOutDto struct {
+	eventID   string
+	articleID string
+}
+
+// EventID returns the ID of the event
+func (o EditArticleOutDto) EventID() string {
+	return o.eventID
+}
+
+// ArticleID returns the ID of the article
+func (o EditArticleOutDto) ArticleID() string {
+	return o.articleID
+}
+
+// NewEditArticleOutDto is constructor of EditArticleOutDto.
+func NewEditArticleOutDto(eventID, articleID string) EditArticleOutDto {
+	return EditArticleOutDto{
+		eventID:   eventID,
+		articleID: articleID,
+	}
+}
+
 // UploadImageInDto is an Input DTO for UploadImage use-case
 type UploadImageInDto struct {
-	name        string
-	bytes       []byte
-	contentType string
+	name         string
+	body         io.Reader
+	contentType  string
+	keepMetadata bool
 }
 
 // Name returns the name of the image to be uploaded
@@ -318,9 +632,9 @@ func (i UploadImageInDto) Name() string {
 	return i.name
 }
 
-// Bytes returns the bytes of the image to be uploaded
-func (i UploadImageInDto) Bytes() []byte {
-	return i.bytes
+// Body returns the reader of the image to be uploaded
+func (i UploadImageInDto) Body() io.Reader {
+	return i.body
 }
 
 // ContentType returns the content type of the image to be uploaded
@@ -328,18 +642,64 @@ func (i UploadImageInDto) ContentType() string {
 	return i.contentType
 }
 
+// KeepMetadata returns whether the metadata of the image is kept instead of stripped
+func (i UploadImageInDto) KeepMetadata() bool {
+	return i.keepMetadata
+}
+
 // NewUploadImageInDto is constructor of UploadImageInDto.
-func NewUploadImageInDto(name string, bytes []byte, contentType string) UploadImageInDto {
+func NewUploadImageInDto(name string, body io.Reader, contentType string, keepMetadata bool) UploadImageInDto {
 	return UploadImageInDto{
-		name:        name,
-		bytes:       bytes,
+		name:         name,
+		body:         body,
+		contentType:  contentType,
+		keepMetadata: keepMetadata,
+	}
+}
+
+// ImageVariantDto is a variant of the uploaded image
+type ImageVariantDto struct {
+	uri         url.URL
+	width       int
+	height      int
+	contentType string
+}
+
+// URL returns the URL of the variant
+func (v ImageVariantDto) URL() url.URL {
+	return v.uri
+}
+
+// Width returns the width of the variant in pixels
+func (v ImageVariantDto) Width() int {
+	return v.width
+}
+
+// Height returns the height of the variant in pixels
+func (v ImageVariantDto) Height() int {
+	return v.height
+}
+
+// ContentType returns the content type of the variant
+func (v ImageVariantDto) ContentType() string {
+	return v.contentType
+}
+
+// NewImageVariantDto is constructor of ImageVariantDto.
+func NewImageVariantDto(uri url.URL, width, height int, contentType string) ImageVariantDto {
+	return ImageVariantDto{
+		uri:         uri,
+		width:       width,
+		height:      height,
 		contentType: contentType,
 	}
 }
 
 // UploadImageOutDto is an Output DTO for UploadImage use-case
 type UploadImageOutDto struct {
-	uri url.URL
+	uri          url.URL
+	variants     []ImageVariantDto
+	deduplicated bool
 }
 
 // URL returns the URL of the uploaded image
@@ -347,9 +707,838 @@ func (o UploadImageOutDto) URL() url.URL {
 	return o.uri
 }
 
+// Variants returns the resized and re-encoded variants stored next to the uploaded image
+func (o UploadImageOutDto) Variants() []ImageVariantDto {
+	return o.variants
+}
+
+// Deduplicated returns whether the same image had already been uploaded, in which case its URL is returned
+func (o UploadImageOutDto) Deduplicated() bool {
+	return o.deduplicated
+}
+
 // NewUploadImageOutDto is constructor of UploadImageOutDto.
-func NewUploadImageOutDto(uri url.URL) UploadImageOutDto {
+func NewUploadImageOutDto(uri url.URL, variants []ImageVariantDto, deduplicated bool) UploadImageOutDto {
 	return UploadImageOutDto{
-		uri: uri,
+		uri:          uri,
+		variants:     variants,
+		deduplicated: deduplicated,
+	}
+}
+
+// ScheduleArticleInDto is an Input DTO for ScheduleArticle use-case
+type ScheduleArticleInDto struct {
+	id                  string
+	publishAt           time.Time
+	expectedLastEventID string
+}
+
+// ID returns the ID of the article to schedule
+func (i ScheduleArticleInDto) ID() string {
+	return i.id
+}
+
+// PublishAt returns the time the article goes live
+func (i ScheduleArticleInDto) PublishAt() time.Time {
+	return i.publishAt
+}
+
+// ExpectedLastEventID returns the ID of the event expected to be the latest one
+func (i ScheduleArticleInDto) ExpectedLastEventID() string {
+	return i.expectedLastEventID
+}
+
+// NewScheduleArticleInDto is constructor of ScheduleArticleInDto.
+func NewScheduleArticleInDto(id string, publishAt time.Time, expectedLastEventID string) ScheduleArticleInDto {
+	return ScheduleArticleInDto{
+		id:                  id,
+		publishAt:           publishAt,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// ScheduleArticleOutDto is an Output DTO for ScheduleArticle use-case
+type ScheduleArticleOutDto struct {
+	eventID   string
+	articleID string
+}
+
+// EventID returns the ID of the event
+func (o ScheduleArticleOutDto) EventID() string {
+	return o.eventID
+}
+
+// ArticleID returns the ID of the article
+func (o ScheduleArticleOutDto) ArticleID() string {
+	return o.articleID
+}
+
+// NewScheduleArticleOutDto is constructor of ScheduleArticleOutDto.
+func NewScheduleArticleOutDto(eventID, articleID string) ScheduleArticleOutDto {
+	return ScheduleArticleOutDto{
+		eventID:   eventID,
+		articleID: articleID,
+	}
+}
+
+// PublishArticleInDto is an Input DTO for PublishArticle use-case
+type PublishArticleInDto struct {
+	id                  string
+	expectedLastEventID string
+}
+
+// ID returns the ID of the article to publish
+func (i PublishArticleInDto) ID() string {
+	return i.id
+}
+
+// ExpectedLastEventID returns the ID of the event expected to be the latest one
+func (i PublishArticleInDto) ExpectedLastEventID() string {
+	return i.expectedLastEventID
+}
+
+// NewPublishArticleInDto is constructor of PublishArticleInDto.
+func NewPublishArticleInDto(id string, expectedLastEventID string) PublishArticleInDto {
+	return PublishArticleInDto{
+		id:                  id,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// PublishArticleOutDto is an Output DTO for PublishArticle use-case
+type PublishArticleOutDto struct {
+	eventID   string
+	articleID string
+}
+
+// EventID returns the ID of the event
+func (o PublishArticleOutDto) EventID() string {
+	return o.eventID
+}
+
+// ArticleID returns the ID of the article
+func (o PublishArticleOutDto) ArticleID() string {
+	return o.articleID
+}
+
+// NewPublishArticleOutDto is constructor of PublishArticleOutDto.
+func NewPublishArticleOutDto(eventID, articleID string) PublishArticleOutDto {
+	return PublishArticleOutDto{
+		eventID:   eventID,
+		articleID: articleID,
+	}
+}
+
+// RevertArticleInDto is an Input DTO for RevertArticle use-case
+type RevertArticleInDto struct {
+	id                  string
+	toEventID           string
+	expectedLastEventID string
+}
+
+// ID returns the ID of the article to revert
+func (i RevertArticleInDto) ID() string {
+	return i.id
+}
+
+// ToEventID returns the ID of the event to restore the article to
+func (i RevertArticleInDto) ToEventID() string {
+	return i.toEventID
+}
+
+// ExpectedLastEventID returns the ID of the event expected to be the latest one
+func (i RevertArticleInDto) ExpectedLastEventID() string {
+	return i.expectedLastEventID
+}
+
+// NewRevertArticleInDto is constructor of RevertArticleInDto.
+func NewRevertArticleInDto(id, toEventID, expectedLastEventID string) RevertArticleInDto {
+	return RevertArticleInDto{
+		id:                  id,
+		toEventID:           toEventID,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// RevertArticleOutDto is an Output DTO for RevertArticle use-case
+type RevertArticleOutDto struct {
+	eventID   string
+	articleID string
+}
+
+// EventID returns the ID of the event
+func (o RevertArticleOutDto) EventID() string {
+	return o.eventID
+}
+
+// ArticleID returns the ID of the article
+func (o RevertArticleOutDto) ArticleID() string {
+	return o.articleID
+}
+
+// NewRevertArticleOutDto is constructor of RevertArticleOutDto.
+func NewRevertArticleOutDto(eventID, articleID string) RevertArticleOutDto {
+	return RevertArticleOutDto{
+		eventID:   eventID,
+		articleID: articleID,
+	}
+}
+
+// BloggingEventKeyDto is a DTO of an event written to an article
+type BloggingEventKeyDto struct {
+	eventID   string
+	articleID string
+}
+
+// EventID returns the ID of the event
+func (d BloggingEventKeyDto) EventID() string {
+	return d.eventID
+}
+
+// ArticleID returns the ID of the article
+func (d BloggingEventKeyDto) ArticleID() string {
+	return d.articleID
+}
+
+// NewBloggingEventKeyDto is constructor of BloggingEventKeyDto.
+func NewBloggingEventKeyDto(eventID, articleID string) BloggingEventKeyDto {
+	return BloggingEventKeyDto{
+		eventID:   eventID,
+		articleID: articleID,
+	}
+}
+
+// RenameTagInDto is an Input DTO for RenameTag use-case
+type RenameTagInDto struct {
+	from string
+	to   string
+}
+
+// From returns the current name of the tag
+func (i RenameTagInDto) From() string {
+	return i.from
+}
+
+// To returns the new name of the tag
+func (i RenameTagInDto) To() string {
+	return i.to
+}
+
+// NewRenameTagInDto is constructor of RenameTagInDto.
+func NewRenameTagInDto(from, to string) RenameTagInDto {
+	return RenameTagInDto{
+		from: from,
+		to:   to,
+	}
+}
+
+// RenameTagOutDto is an Output DTO for RenameTag use-case
+type RenameTagOutDto struct {
+	events []BloggingEventKeyDto
+}
+
+// Events returns the events written to the articles the tag was attached to
+func (o RenameTagOutDto) Events() []BloggingEventKeyDto {
+	return o.events
+}
+
+// NewRenameTagOutDto is constructor of RenameTagOutDto.
+func NewRenameTagOutDto(events []BloggingEventKeyDto) RenameTagOutDto {
+	return RenameTagOutDto{
+		events: events,
+	}
+}
+
+// MergeTagsInDto is an Input DTO for MergeTags use-case
+type MergeTagsInDto struct {
+	sources []string
+	into    string
+}
+
+// Sources returns the names of the tags to merge away
+func (i MergeTagsInDto) Sources() []string {
+	return i.sources
+}
+
+// Into returns the name of the tag to merge the sources into
+func (i MergeTagsInDto) Into() string {
+	return i.into
+}
+
+// NewMergeTagsInDto is constructor of MergeTagsInDto.
+func NewMergeTagsInDto(sources []string, into string) MergeTagsInDto {
+	return MergeTagsInDto{
+		sources: sources,
+		into:    into,
+	}
+}
+
+// MergeTagsOutDto is an Output DTO for MergeTags use-case
+type MergeTagsOutDto struct {
+	events []BloggingEventKeyDto
+}
+
+// Events returns the events written to the articles any of the sources was attached to
+func (o MergeTagsOutDto) Events() []BloggingEventKeyDto {
+	return o.events
+}
+
+// NewMergeTagsOutDto is constructor of MergeTagsOutDto.
+func NewMergeTagsOutDto(events []BloggingEventKeyDto) MergeTagsOutDto {
+	return MergeTagsOutDto{
+		events: events,
+	}
+}
+
+// CreateSeriesInDto is an Input DTO for CreateSeries use-case
+type CreateSeriesInDto struct {
+	title      string
+	articleIDs []string
+}
+
+// Title returns the title of the series
+func (i CreateSeriesInDto) Title() string {
+	return i.title
+}
+
+// ArticleIDs returns the ids of the articles of the series, in order
+func (i CreateSeriesInDto) ArticleIDs() []string {
+	return i.articleIDs
+}
+
+// NewCreateSeriesInDto is constructor of CreateSeriesInDto.
+func NewCreateSeriesInDto(title string, articleIDs []string) CreateSeriesInDto {
+	return CreateSeriesInDto{
+		title:      title,
+		articleIDs: articleIDs,
+	}
+}
+
+// AddArticleToSeriesInDto is an Input DTO for AddArticleToSeries use-case
+type AddArticleToSeriesInDto struct {
+	seriesID  string
+	articleID string
+	position  int
+}
+
+// SeriesID returns the id of the series
+func (i AddArticleToSeriesInDto) SeriesID() string {
+	return i.seriesID
+}
+
+// ArticleID returns the id of the article to add
+func (i AddArticleToSeriesInDto) ArticleID() string {
+	return i.articleID
+}
+
+// Position returns the position to add the article at. 0 adds it to the end of the series
+func (i AddArticleToSeriesInDto) Position() int {
+	return i.position
+}
+
+// NewAddArticleToSeriesInDto is constructor of AddArticleToSeriesInDto.
+func NewAddArticleToSeriesInDto(seriesID, articleID string, position int) AddArticleToSeriesInDto {
+	return AddArticleToSeriesInDto{
+		seriesID:  seriesID,
+		articleID: articleID,
+		position:  position,
+	}
+}
+
+// ReorderSeriesInDto is an Input DTO for ReorderSeries use-case
+type ReorderSeriesInDto struct {
+	seriesID   string
+	articleIDs []string
+}
+
+// SeriesID returns the id of the series
+func (i ReorderSeriesInDto) SeriesID() string {
+	return i.seriesID
+}
+
+// ArticleIDs returns the ids of all the articles of the series, in the new order
+func (i ReorderSeriesInDto) ArticleIDs() []string {
+	return i.articleIDs
+}
+
+// NewReorderSeriesInDto is constructor of ReorderSeriesInDto.
+func NewReorderSeriesInDto(seriesID string, articleIDs []string) ReorderSeriesInDto {
+	return ReorderSeriesInDto{
+		seriesID:   seriesID,
+		articleIDs: articleIDs,
+	}
+}
+
+// SeriesOutDto is an Output DTO for the use-cases that change a series
+type SeriesOutDto struct {
+	seriesID string
+	events   []BloggingEventKeyDto
+}
+
+// SeriesID returns the id of the series
+func (o SeriesOutDto) SeriesID() string {
+	return o.seriesID
+}
+
+// Events returns the events written to the articles of the series
+func (o SeriesOutDto) Events() []BloggingEventKeyDto {
+	return o.events
+}
+
+// NewSeriesOutDto is constructor of SeriesOutDto.
+func NewSeriesOutDto(seriesID string, events []BloggingEventKeyDto) SeriesOutDto {
+	return SeriesOutDto{
+		seriesID: seriesID,
+		events:   events,
+	}
+}
+
+// DraftDto is a DTO of a draft article
+type DraftDto struct {
+	id           string
+	title        string
+	body         string
+	thumbnailUrl string
+	tagNames     []string
+	lastEventID  string
+}
+
+// ID returns the ID of the draft
+func (d DraftDto) ID() string {
+	return d.id
+}
+
+// Title returns the title of the draft
+func (d DraftDto) Title() string {
+	return d.title
+}
+
+// Body returns the body of the draft
+func (d DraftDto) Body() string {
+	return d.body
+}
+
+// ThumbnailUrl returns the thumbnail URL of the draft
+func (d DraftDto) ThumbnailUrl() string {
+	return d.thumbnailUrl
+}
+
+// TagNames returns the tag names of the draft
+func (d DraftDto) TagNames() []string {
+	return d.tagNames
+}
+
+// LastEventID returns the ID of the latest event of the draft
+func (d DraftDto) LastEventID() string {
+	return d.lastEventID
+}
+
+// NewDraftDto is constructor of DraftDto.
+func NewDraftDto(id, title, body, thumbnailUrl string, tagNames []string, lastEventID string) DraftDto {
+	return DraftDto{
+		id:           id,
+		title:        title,
+		body:         body,
+		thumbnailUrl: thumbnailUrl,
+		tagNames:     tagNames,
+		lastEventID:  lastEventID,
+	}
+}
+
+// GetDraftInDto is an Input DTO for GetDraft use-case
+type GetDraftInDto struct {
+	id string
+}
+
+// ID returns the ID of the draft to get
+func (i GetDraftInDto) ID() string {
+	return i.id
+}
+
+// NewGetDraftInDto is constructor of GetDraftInDto.
+func NewGetDraftInDto(id string) GetDraftInDto {
+	return GetDraftInDto{
+		id: id,
+	}
+}
+
+// GetDraftOutDto is an Output DTO for GetDraft use-case
+type GetDraftOutDto struct {
+	draft DraftDto
+}
+
+// Draft returns the draft
+func (o GetDraftOutDto) Draft() DraftDto {
+	return o.draft
+}
+
+// NewGetDraftOutDto is constructor of GetDraftOutDto.
+func NewGetDraftOutDto(draft DraftDto) GetDraftOutDto {
+	return GetDraftOutDto{
+		draft: draft,
+	}
+}
+
+// ListDraftsOutDto is an Output DTO for ListDrafts use-case
+type ListDraftsOutDto struct {
+	drafts []DraftDto
+}
+
+// Drafts returns the drafts
+func (o ListDraftsOutDto) Drafts() []DraftDto {
+	return o.drafts
+}
+
+// NewListDraftsOutDto is constructor of ListDraftsOutDto.
+func NewListDraftsOutDto(drafts []DraftDto) ListDraftsOutDto {
+	return ListDraftsOutDto{
+		drafts: drafts,
+	}
+}
+
+// ArticleEventDto is a DTO of an entry of the history of an article.
+// Fields the event did not change are nil or empty.
+type ArticleEventDto struct {
+	id             string
+	eventType      string
+	occurredAt     time.Time
+	title          *string
+	body           *string
+	thumbnailUrl   *string
+	tagNames       []string
+	attachTagNames []string
+	detachTagNames []string
+	invisible      *bool
+	publishAt      *time.Time
+	draft          *bool
+	actor          string
+	revertedTo     string
+	slug           *string
+	seriesID       *string
+	seriesTitle    *string
+	seriesPosition *int
+}
+
+// ID returns the ID of the event
+func (d ArticleEventDto) ID() string {
+	return d.id
+}
+
+// EventType returns the kind of the event
+func (d ArticleEventDto) EventType() string {
+	return d.eventType
+}
+
+// OccurredAt returns the time the event was written
+func (d ArticleEventDto) OccurredAt() time.Time {
+	return d.occurredAt
+}
+
+// Title returns the title set by the event
+func (d ArticleEventDto) Title() *string {
+	return d.title
+}
+
+// Body returns the body set by the event
+func (d ArticleEventDto) Body() *string {
+	return d.body
+}
+
+// ThumbnailUrl returns the thumbnail URL set by the event
+func (d ArticleEventDto) ThumbnailUrl() *string {
+	return d.thumbnailUrl
+}
+
+// TagNames returns the tag names the article was created with
+func (d ArticleEventDto) TagNames() []string {
+	return d.tagNames
+}
+
+// AttachTagNames returns the tag names attached by the event
+func (d ArticleEventDto) AttachTagNames() []string {
+	return d.attachTagNames
+}
+
+// DetachTagNames returns the tag names detached by the event
+func (d ArticleEventDto) DetachTagNames() []string {
+	return d.detachTagNames
+}
+
+// Invisible returns the visibility set by the event
+func (d ArticleEventDto) Invisible() *bool {
+	return d.invisible
+}
+
+// PublishAt returns the publication time set by the event
+func (d ArticleEventDto) PublishAt() *time.Time {
+	return d.publishAt
+}
+
+// Draft returns the draft state set by the event
+func (d ArticleEventDto) Draft() *bool {
+	return d.draft
+}
+
+// Actor returns who wrote the event
+func (d ArticleEventDto) Actor() string {
+	return d.actor
+}
+
+// RevertedTo returns the id of the event the article was restored to. It is empty unless the event is a revert.
+func (d ArticleEventDto) RevertedTo() string {
+	return d.revertedTo
+}
+
+// Slug returns the slug set by the event
+func (d ArticleEventDto) Slug() *string {
+	return d.slug
+}
+
+// SeriesID returns the id of the series the event put the article in
+func (d ArticleEventDto) SeriesID() *string {
+	return d.seriesID
+}
+
+// SeriesTitle returns the title of the series the event put the article in
+func (d ArticleEventDto) SeriesTitle() *string {
+	return d.seriesTitle
+}
+
+// SeriesPosition returns the position in the series the event put the article at
+func (d ArticleEventDto) SeriesPosition() *int {
+	return d.seriesPosition
+}
+
+// ArticleEventDtoOption is the option of NewArticleEventDto.
+type ArticleEventDtoOption func(*ArticleEventDto)
+
+// ArticleEventDtoWithTitle sets the title set by the event.
+func ArticleEventDtoWithTitle(title string) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.title = &title
+	}
+}
+
+// ArticleEventDtoWithBody sets the body set by the event.
+func ArticleEventDtoWithBody(body string) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.body = &body
+	}
+}
+
+// ArticleEventDtoWithThumbnailUrl sets the thumbnail URL set by the event.
+func ArticleEventDtoWithThumbnailUrl(thumbnailUrl string) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.thumbnailUrl = &thumbnailUrl
+	}
+}
+
+// ArticleEventDtoWithTagNames sets the tag names the article was created with.
+func ArticleEventDtoWithTagNames(tagNames ...string) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.tagNames = tagNames
+	}
+}
+
+// ArticleEventDtoWithAttachTagNames sets the tag names attached by the event.
+func ArticleEventDtoWithAttachTagNames(attachTagNames ...string) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.attachTagNames = attachTagNames
+	}
+}
+
+// ArticleEventDtoWithDetachTagNames sets the tag names detached by the event.
+func ArticleEventDtoWithDetachTagNames(detachTagNames ...string) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.detachTagNames = detachTagNames
+	}
+}
+
+// ArticleEventDtoWithInvisible sets the visibility set by the event.
+func ArticleEventDtoWithInvisible(invisible bool) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.invisible = &invisible
+	}
+}
+
+// ArticleEventDtoWithPublishAt sets the publication time set by the event.
+func ArticleEventDtoWithPublishAt(publishAt time.Time) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.publishAt = &publishAt
+	}
+}
+
+// ArticleEventDtoWithDraft sets the draft state set by the event.
+func ArticleEventDtoWithDraft(draft bool) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.draft = &draft
+	}
+}
+
+// ArticleEventDtoWithActor sets the name of the user who wrote the event.
+func ArticleEventDtoWithActor(actor string) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.actor = actor
+	}
+}
+
+// ArticleEventDtoWithRevertedTo sets the ID of the event the article was reverted to.
+func ArticleEventDtoWithRevertedTo(revertedTo string) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.revertedTo = revertedTo
+	}
+}
+
+// ArticleEventDtoWithSlug sets the slug set by the event.
+func ArticleEventDtoWithSlug(slug string) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.slug = &slug
+	}
+}
+
+// ArticleEventDtoWithSeries sets the series the event put the article in, and the position in it.
+func ArticleEventDtoWithSeries(id, title string, position int) ArticleEventDtoOption {
+	return func(d *ArticleEventDto) {
+		d.seriesID = &id
+		d.seriesTitle = &title
+		d.seriesPosition = &position
+	}
+}
+
+// NewArticleEventDto is constructor of ArticleEventDto.
+func NewArticleEventDto(id, eventType string, occurredAt time.Time, options ...ArticleEventDtoOption) ArticleEventDto {
+	d := ArticleEventDto{
+		id:         id,
+		eventType:  eventType,
+		occurredAt: occurredAt,
+	}
+	for _, option := range options {
+		option(&d)
+	}
+	return d
+}
+
+// ListArticleEventsInDto is an Input DTO for ListArticleEvents use-case
+type ListArticleEventsInDto struct {
+	id string
+}
+
+// ID returns the ID of the article
+func (i ListArticleEventsInDto) ID() string {
+	return i.id
+}
+
+// NewListArticleEventsInDto is constructor of ListArticleEventsInDto.
+func NewListArticleEventsInDto(id string) ListArticleEventsInDto {
+	return ListArticleEventsInDto{
+		id: id,
+	}
+}
+
+// ListArticleEventsOutDto is an Output DTO for ListArticleEvents use-case
+type ListArticleEventsOutDto struct {
+	events []ArticleEventDto
+}
+
+// Events returns the events, oldest first
+func (o ListArticleEventsOutDto) Events() []ArticleEventDto {
+	return o.events
+}
+
+// NewListArticleEventsOutDto is constructor of ListArticleEventsOutDto.
+func NewListArticleEventsOutDto(events []ArticleEventDto) ListArticleEventsOutDto {
+	return ListArticleEventsOutDto{
+		events: events,
+	}
+}
+
+// GetArticleAtInDto is an Input DTO for GetArticleAt use-case
+type GetArticleAtInDto struct {
+	id        string
+	eventID   string
+	timestamp *time.Time
+}
+
+// ID returns the ID of the article
+func (i GetArticleAtInDto) ID() string {
+	return i.id
+}
+
+// EventID returns the ID of the last event to replay
+func (i GetArticleAtInDto) EventID() string {
+	return i.eventID
+}
+
+// Timestamp returns the time up to which events are replayed
+func (i GetArticleAtInDto) Timestamp() *time.Time {
+	return i.timestamp
+}
+
+// NewGetArticleAtInDto is constructor of GetArticleAtInDto.
+// Either eventID or timestamp must be specified.
+func NewGetArticleAtInDto(id, eventID string, timestamp *time.Time) GetArticleAtInDto {
+	return GetArticleAtInDto{
+		id:        id,
+		eventID:   eventID,
+		timestamp: timestamp,
+	}
+}
+
+// GetArticleAtOutDto is an Output DTO for GetArticleAt use-case
+type GetArticleAtOutDto struct {
+	id           string
+	title        string
+	body         string
+	thumbnailUrl string
+	tagNames     []string
+	eventID      string
+	eventAt      time.Time
+}
+
+// ID returns the ID of the article
+func (o GetArticleAtOutDto) ID() string {
+	return o.id
+}
+
+// Title returns the title of the article
+func (o GetArticleAtOutDto) Title() string {
+	return o.title
+}
+
+// Body returns the body of the article
+func (o GetArticleAtOutDto) Body() string {
+	return o.body
+}
+
+// ThumbnailUrl returns the thumbnail URL of the article
+func (o GetArticleAtOutDto) ThumbnailUrl() string {
+	return o.thumbnailUrl
+}
+
+// TagNames returns the tag names of the article
+func (o GetArticleAtOutDto) TagNames() []string {
+	return o.tagNames
+}
+
+// EventID returns the ID of the last replayed event
+func (o GetArticleAtOutDto) EventID() string {
+	return o.eventID
+}
+
+// EventAt returns the time of the last replayed event
+func (o GetArticleAtOutDto) EventAt() time.Time {
+	return o.eventAt
+}
+
+// NewGetArticleAtOutDto is constructor of GetArticleAtOutDto.
+func NewGetArticleAtOutDto(id, title, body, thumbnailUrl string, tagNames []string, eventID string, eventAt time.Time) GetArticleAtOutDto {
+	return GetArticleAtOutDto{
+		id:           id,
+		title:        title,
+		body:         body,
+		thumbnailUrl: thumbnailUrl,
+		tagNames:     tagNames,
+		eventID:      eventID,
+		eventAt:      eventAt,
 	}
 }
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// EditArticle is a use-case for editing several fields of an article at once.
+type EditArticle struct {
+	bloggingEventCommand command.BloggingEventService
+	tagPolicy            model.TagPolicy
+}
+
+// Execute executes the EditArticle use-case.
+func (u *EditArticle) Execute(ctx context.Context, in *dto.EditArticleInDto) (_ *dto.EditArticleOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.EditArticleOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	attachTagNames, err := u.tagPolicy.Apply("attachTagNames", in.AttachTagNames())
+	if err != nil {
+		return nil, err
+	}
+	command := model.NewEditArticleEvent(in.ID(), in.Title(), in.Body(), in.Thumbnail(), imageVariantsOf(in.ThumbnailVariants()), attachTagNames, u.tagPolicy.LookupAll(in.DetachTagNames()), in.ExpectedLastEventID())
+	if err = command.Validate(); err != nil {
+		return nil, err
+	}
+	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	err = u.bloggingEventCommand.EditArticle(ctx, command, commandOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	key := commandOut.StrictGet()
+	result := dto.NewEditArticleOutDto(key.EventID(), key.ArticleID())
+	return &result, nil
+}
+
+// NewEditArticle is a constructor for EditArticle use-case.
+func NewEditArticle(bloggingEventCommand command.BloggingEventService, tagPolicy model.TagPolicy) *EditArticle {
+	return &EditArticle{
+		bloggingEventCommand: bloggingEventCommand,
+		tagPolicy:            tagPolicy,
+	}
+}
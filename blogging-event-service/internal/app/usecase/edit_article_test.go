@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mcommand "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/command"
+	mdb "blogapi.miyamo.today/blogging-event-service/internal/mock/core/db"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestEditArticle_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *dto.EditArticleInDto
+	}
+	type want struct {
+		out *dto.EditArticleOutDto
+		err error
+	}
+	type testCase struct {
+		args                args
+		want                want
+		setupCommandService func(cs *mcommand.MockBloggingEventService, in model.EditArticleEvent, stmt *mdb.MockStatement)
+	}
+	errUnhappyPath := errors.New("unhappy_path")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			args: func() args {
+				title := "title"
+				in := dto.NewEditArticleInDto("article_id", &title, nil, nil, nil, []string{"tag1"}, []string{"tag2"}, "last_event_id")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewEditArticleOutDto("event_id", "article_id")
+				return want{
+					out: &out,
+				}
+			}(),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, in model.EditArticleEvent, stmt *mdb.MockStatement) {
+				cs.EXPECT().EditArticle(gomock.Any(), in, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in model.EditArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+							v := model.NewBloggingEventKey("event_id", "article_id")
+							out.Set(&v)
+							return nil
+						}).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+		"unhappy_path": {
+			args: func() args {
+				body := "body"
+				in := dto.NewEditArticleInDto("article_id", nil, &body, nil, nil, nil, nil, "")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, in model.EditArticleEvent, stmt *mdb.MockStatement) {
+				cs.EXPECT().EditArticle(gomock.Any(), in, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in model.EditArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errUnhappyPath).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+		"unhappy_path/nothing-to-edit": {
+			args: func() args {
+				in := dto.NewEditArticleInDto("article_id", nil, nil, nil, nil, nil, nil, "")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, in model.EditArticleEvent, stmt *mdb.MockStatement) {
+				cs.EXPECT().EditArticle(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cs := mcommand.NewMockBloggingEventService(ctrl)
+			stmt := mdb.NewMockStatement(ctrl)
+			tt.setupCommandService(cs, model.NewEditArticleEvent(tt.args.in.ID(), tt.args.in.Title(), tt.args.in.Body(), tt.args.in.Thumbnail(), nil, tt.args.in.AttachTagNames(), tt.args.in.DetachTagNames(), tt.args.in.ExpectedLastEventID()), stmt)
+
+			u := NewEditArticle(cs, model.NewTagPolicy())
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
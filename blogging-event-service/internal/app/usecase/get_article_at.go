@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/article"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+	"slices"
+)
+
+// GetArticleAt is a use-case for getting an article as it was at a point of its history.
+type GetArticleAt struct {
+	articleEventQuery query.ArticleEventService
+}
+
+// Execute executes the GetArticleAt use-case.
+func (u *GetArticleAt) Execute(ctx context.Context, in *dto.GetArticleAtInDto) (_ *dto.GetArticleAtOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.GetArticleAtOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	if in.ID() == "" {
+		return nil, errors.Wrap(model.ErrValidation, "article id is required")
+	}
+	if (in.EventID() == "") == (in.Timestamp() == nil) {
+		return nil, errors.Wrap(model.ErrValidation, "either event id or timestamp is required")
+	}
+	queryOut := db.NewMultipleStatementResult[*model.ArticleEvent]()
+	err = u.articleEventQuery.ListByArticleID(ctx, in.ID(), queryOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	// events are ordered oldest first, so the ones to replay are a prefix of them.
+	events := queryOut.StrictGet()
+	var n int
+	if in.EventID() != "" {
+		n = slices.IndexFunc(events, func(e *model.ArticleEvent) bool {
+			return e.EventID() == in.EventID()
+		}) + 1
+	} else {
+		n = slices.IndexFunc(events, func(e *model.ArticleEvent) bool {
+			return e.OccurredAt().After(*in.Timestamp())
+		})
+		if n < 0 {
+			n = len(events)
+		}
+	}
+	if n == 0 {
+		return nil, errors.Wrap(model.ErrNotFound, "no event of the article at the given point")
+	}
+
+	projection := article.Project(events[:n])
+	last := events[n-1]
+	result := dto.NewGetArticleAtOutDto(
+		in.ID(),
+		projection.Title(),
+		projection.Body(),
+		projection.Thumbnail(),
+		projection.TagNames(),
+		last.EventID(),
+		last.OccurredAt())
+	return &result, nil
+}
+
+// NewGetArticleAt is a constructor for GetArticleAt use-case.
+func NewGetArticleAt(articleEventQuery query.ArticleEventService) *GetArticleAt {
+	return &GetArticleAt{articleEventQuery: articleEventQuery}
+}
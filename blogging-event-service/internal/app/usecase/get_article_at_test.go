@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mquery "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/query"
+	mdb "blogapi.miyamo.today/blogging-event-service/internal/mock/core/db"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGetArticleAt_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *dto.GetArticleAtInDto
+	}
+	type want struct {
+		out *dto.GetArticleAtOutDto
+		err error
+	}
+	type testCase struct {
+		args              args
+		want              want
+		setupQueryService func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement)
+	}
+	errUnhappyPath := errors.New("unhappy_path")
+	createdAt := time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC)
+	editedAt := createdAt.Add(time.Hour)
+	title1, title2, body, thumbnail := "title1", "title2", "body", "thumbnail"
+
+	listEvents := func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement) {
+		qs.EXPECT().ListByArticleID(gomock.Any(), "article_id", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, articleID string, out *db.MultipleStatementResult[*model.ArticleEvent]) db.Statement {
+				stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+					created := model.NewArticleEvent("01JF0REBGD4QKPFGN1SX2STY4M", model.ArticleEventTypeCreateArticle, createdAt, model.ArticleEventWithTitle(title1), model.ArticleEventWithContent(body), model.ArticleEventWithThumbnail(thumbnail), model.ArticleEventWithTags("tag1"))
+					edited := model.NewArticleEvent("01JF0REBGD4QKPFGN1SX2STY4N", model.ArticleEventTypeEditArticle, editedAt, model.ArticleEventWithTitle(title2), model.ArticleEventWithAttachTags("tag2"), model.ArticleEventWithDetachTags("tag1"))
+					out.Set([]*model.ArticleEvent{&created, &edited})
+					return nil
+				}).Times(1)
+				return stmt
+			}).Times(1)
+	}
+	tests := map[string]testCase{
+		"happy_path:by-event-id": {
+			args: func() args {
+				in := dto.NewGetArticleAtInDto("article_id", "01JF0REBGD4QKPFGN1SX2STY4M", nil)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewGetArticleAtOutDto("article_id", "title1", "body", "thumbnail", []string{"tag1"}, "01JF0REBGD4QKPFGN1SX2STY4M", createdAt)
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: listEvents,
+		},
+		"happy_path:by-timestamp": {
+			args: func() args {
+				at := editedAt.Add(time.Minute)
+				in := dto.NewGetArticleAtInDto("article_id", "", &at)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewGetArticleAtOutDto("article_id", "title2", "body", "thumbnail", []string{"tag2"}, "01JF0REBGD4QKPFGN1SX2STY4N", editedAt)
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: listEvents,
+		},
+		"happy_path:by-timestamp-between-events": {
+			args: func() args {
+				at := createdAt.Add(time.Minute)
+				in := dto.NewGetArticleAtInDto("article_id", "", &at)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewGetArticleAtOutDto("article_id", "title1", "body", "thumbnail", []string{"tag1"}, "01JF0REBGD4QKPFGN1SX2STY4M", createdAt)
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: listEvents,
+		},
+		"unhappy_path:unknown-event-id": {
+			args: func() args {
+				in := dto.NewGetArticleAtInDto("article_id", "01JF0REBGD4QKPFGN1SX2STY4Z", nil)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrNotFound,
+			},
+			setupQueryService: listEvents,
+		},
+		"unhappy_path:before-creation": {
+			args: func() args {
+				at := createdAt.Add(-time.Minute)
+				in := dto.NewGetArticleAtInDto("article_id", "", &at)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrNotFound,
+			},
+			setupQueryService: listEvents,
+		},
+		"unhappy_path": {
+			args: func() args {
+				in := dto.NewGetArticleAtInDto("article_id", "01JF0REBGD4QKPFGN1SX2STY4M", nil)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupQueryService: func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement) {
+				qs.EXPECT().ListByArticleID(gomock.Any(), "article_id", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, articleID string, out *db.MultipleStatementResult[*model.ArticleEvent]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errUnhappyPath).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+		"unhappy_path:without-id": {
+			args: func() args {
+				in := dto.NewGetArticleAtInDto("", "01JF0REBGD4QKPFGN1SX2STY4M", nil)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService: func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:without-as-of": {
+			args: func() args {
+				in := dto.NewGetArticleAtInDto("article_id", "", nil)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService: func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:with-both-event-id-and-timestamp": {
+			args: func() args {
+				at := createdAt
+				in := dto.NewGetArticleAtInDto("article_id", "01JF0REBGD4QKPFGN1SX2STY4M", &at)
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService: func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement) {},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			qs := mquery.NewMockArticleEventService(ctrl)
+			stmt := mdb.NewMockStatement(ctrl)
+			tt.setupQueryService(qs, stmt)
+
+			u := NewGetArticleAt(qs)
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// GetDraft is a use-case for getting a draft article.
+type GetDraft struct {
+	draftQuery query.DraftService
+}
+
+// Execute executes the GetDraft use-case.
+func (u *GetDraft) Execute(ctx context.Context, in *dto.GetDraftInDto) (_ *dto.GetDraftOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.GetDraftOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	if in.ID() == "" {
+		return nil, errors.Wrap(model.ErrValidation, "article id is required")
+	}
+	queryOut := db.NewSingleStatementResult[*model.Draft]()
+	err = u.draftQuery.GetByID(ctx, in.ID(), queryOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	result := dto.NewGetDraftOutDto(newDraftDto(*queryOut.StrictGet()))
+	return &result, nil
+}
+
+// NewGetDraft is a constructor for GetDraft use-case.
+func NewGetDraft(draftQuery query.DraftService) *GetDraft {
+	return &GetDraft{draftQuery: draftQuery}
+}
+
+func newDraftDto(draft model.Draft) dto.DraftDto {
+	return dto.NewDraftDto(draft.ArticleID(), draft.Title(), draft.Content(), draft.Thumbnail(), draft.Tags(), draft.LastEventID())
+}
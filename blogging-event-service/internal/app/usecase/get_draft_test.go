@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mquery "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/query"
+	mdb "blogapi.miyamo.today/blogging-event-service/internal/mock/core/db"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestGetDraft_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *dto.GetDraftInDto
+	}
+	type want struct {
+		out *dto.GetDraftOutDto
+		err error
+	}
+	type testCase struct {
+		args              args
+		want              want
+		setupQueryService func(qs *mquery.MockDraftService, stmt *mdb.MockStatement)
+	}
+	errUnhappyPath := errors.New("unhappy_path")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			args: func() args {
+				in := dto.NewGetDraftInDto("article_id")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewGetDraftOutDto(dto.NewDraftDto("article_id", "title", "body", "thumbnail", []string{"tag1"}, "event_id"))
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: func(qs *mquery.MockDraftService, stmt *mdb.MockStatement) {
+				qs.EXPECT().GetByID(gomock.Any(), "article_id", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, articleID string, out *db.SingleStatementResult[*model.Draft]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+							v := model.NewDraft("article_id", "title", "body", "thumbnail", []string{"tag1"}, "event_id")
+							out.Set(&v)
+							return nil
+						}).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+		"unhappy_path": {
+			args: func() args {
+				in := dto.NewGetDraftInDto("article_id")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupQueryService: func(qs *mquery.MockDraftService, stmt *mdb.MockStatement) {
+				qs.EXPECT().GetByID(gomock.Any(), "article_id", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, articleID string, out *db.SingleStatementResult[*model.Draft]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errUnhappyPath).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+		"unhappy_path:without-id": {
+			args: func() args {
+				in := dto.NewGetDraftInDto("")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService: func(qs *mquery.MockDraftService, stmt *mdb.MockStatement) {},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			qs := mquery.NewMockDraftService(ctrl)
+			stmt := mdb.NewMockStatement(ctrl)
+			tt.setupQueryService(qs, stmt)
+
+			u := NewGetDraft(qs)
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// HideArticle is a use-case for hiding an article.
+type HideArticle struct {
+	bloggingEventCommand command.BloggingEventService
+}
+
+// Execute executes the HideArticle use-case.
+func (u *HideArticle) Execute(ctx context.Context, in *dto.HideArticleInDto) (_ *dto.HideArticleOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.HideArticleOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	command := model.NewHideArticleEvent(in.ID(), in.ExpectedLastEventID())
+	if err = command.Validate(); err != nil {
+		return nil, err
+	}
+	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	err = u.bloggingEventCommand.HideArticle(ctx, command, commandOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	key := commandOut.StrictGet()
+	result := dto.NewHideArticleOutDto(key.EventID(), key.ArticleID())
+	return &result, nil
+}
+
+// NewHideArticle is a constructor for HideArticle use-case.
+func NewHideArticle(bloggingEventCommand command.BloggingEventService) *HideArticle {
+	return &HideArticle{bloggingEventCommand: bloggingEventCommand}
+}
@@ -0,0 +1,60 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../mock/app/usecase/imaging/$GOFILE -package=imaging
+package imaging
+
+import (
+	"context"
+	"io"
+)
+
+// Variant is an image resized to a width and encoded in a format.
+type Variant struct {
+	width       int
+	height      int
+	contentType string
+	bytes       []byte
+}
+
+// Width returns the width of the variant in pixels.
+func (v Variant) Width() int {
+	return v.width
+}
+
+// Height returns the height of the variant in pixels.
+func (v Variant) Height() int {
+	return v.height
+}
+
+// ContentType returns the MIME type of the variant.
+func (v Variant) ContentType() string {
+	return v.contentType
+}
+
+// Bytes returns the encoded variant.
+func (v Variant) Bytes() []byte {
+	return v.bytes
+}
+
+// NewVariant is constructor of Variant.
+func NewVariant(width, height int, contentType string, bytes []byte) Variant {
+	return Variant{
+		width:       width,
+		height:      height,
+		contentType: contentType,
+		bytes:       bytes,
+	}
+}
+
+// Processor is an interface for producing the variants of the uploaded images.
+type Processor interface {
+	// Variants resizes the image read from r and encodes it in the other formats.
+	// It returns no variant for images that cannot be resized, such as SVG, and may leave the rest of r unread.
+	Variants(ctx context.Context, r io.Reader, contentType string) ([]Variant, error)
+}
+
+// Stripper is an interface for removing the metadata of the uploaded images, such as the location they were taken at.
+type Stripper interface {
+	// Strip returns the image read from r without its metadata.
+	// The orientation in the metadata is applied to the pixels instead, so that the image looks the same.
+	// It fails with model.ErrValidation if the orientation cannot be applied to the image.
+	Strip(ctx context.Context, r io.Reader, contentType string) (io.Reader, error)
+}
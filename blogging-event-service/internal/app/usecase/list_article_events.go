@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// ListArticleEvents is a use-case for listing the history of an article.
+type ListArticleEvents struct {
+	articleEventQuery query.ArticleEventService
+}
+
+// Execute executes the ListArticleEvents use-case.
+func (u *ListArticleEvents) Execute(ctx context.Context, in *dto.ListArticleEventsInDto) (_ *dto.ListArticleEventsOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.ListArticleEventsOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	if in.ID() == "" {
+		return nil, errors.Wrap(model.ErrValidation, "article id is required")
+	}
+	queryOut := db.NewMultipleStatementResult[*model.ArticleEvent]()
+	err = u.articleEventQuery.ListByArticleID(ctx, in.ID(), queryOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	events := make([]dto.ArticleEventDto, 0, len(queryOut.StrictGet()))
+	for _, v := range queryOut.StrictGet() {
+		options := []dto.ArticleEventDtoOption{
+			dto.ArticleEventDtoWithTagNames(v.Tags()...),
+			dto.ArticleEventDtoWithAttachTagNames(v.AttachTags()...),
+			dto.ArticleEventDtoWithDetachTagNames(v.DetachTags()...),
+			dto.ArticleEventDtoWithActor(v.Actor()),
+			dto.ArticleEventDtoWithRevertedTo(v.RevertedTo()),
+		}
+		if v.Title() != nil {
+			options = append(options, dto.ArticleEventDtoWithTitle(*v.Title()))
+		}
+		if v.Content() != nil {
+			options = append(options, dto.ArticleEventDtoWithBody(*v.Content()))
+		}
+		if v.Thumbnail() != nil {
+			options = append(options, dto.ArticleEventDtoWithThumbnailUrl(*v.Thumbnail()))
+		}
+		if v.Invisible() != nil {
+			options = append(options, dto.ArticleEventDtoWithInvisible(*v.Invisible()))
+		}
+		if v.PublishAt() != nil {
+			options = append(options, dto.ArticleEventDtoWithPublishAt(*v.PublishAt()))
+		}
+		if v.Draft() != nil {
+			options = append(options, dto.ArticleEventDtoWithDraft(*v.Draft()))
+		}
+		if v.Slug() != nil {
+			options = append(options, dto.ArticleEventDtoWithSlug(*v.Slug()))
+		}
+		if v.SeriesID() != nil && v.SeriesTitle() != nil && v.SeriesPosition() != nil {
+			options = append(options, dto.ArticleEventDtoWithSeries(*v.SeriesID(), *v.SeriesTitle(), *v.SeriesPosition()))
+		}
+		events = append(events, dto.NewArticleEventDto(v.EventID(), string(v.EventType()), v.OccurredAt(), options...))
+	}
+	result := dto.NewListArticleEventsOutDto(events)
+	return &result, nil
+}
+
+// NewListArticleEvents is a constructor for ListArticleEvents use-case.
+func NewListArticleEvents(articleEventQuery query.ArticleEventService) *ListArticleEvents {
+	return &ListArticleEvents{articleEventQuery: articleEventQuery}
+}
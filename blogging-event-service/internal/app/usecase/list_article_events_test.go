@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mquery "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/query"
+	mdb "blogapi.miyamo.today/blogging-event-service/internal/mock/core/db"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListArticleEvents_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *dto.ListArticleEventsInDto
+	}
+	type want struct {
+		out *dto.ListArticleEventsOutDto
+		err error
+	}
+	type testCase struct {
+		args              args
+		want              want
+		setupQueryService func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement)
+	}
+	errUnhappyPath := errors.New("unhappy_path")
+	occurredAt := time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC)
+	title, body, thumbnail := "title", "body", "thumbnail"
+	invisible := true
+
+	tests := map[string]testCase{
+		"happy_path": {
+			args: func() args {
+				in := dto.NewListArticleEventsInDto("article_id")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewListArticleEventsOutDto([]dto.ArticleEventDto{
+					dto.NewArticleEventDto("01JF0REBGD4QKPFGN1SX2STY4M", "CREATE_ARTICLE", occurredAt, dto.ArticleEventDtoWithTitle(title), dto.ArticleEventDtoWithBody(body), dto.ArticleEventDtoWithThumbnailUrl(thumbnail), dto.ArticleEventDtoWithTagNames("tag1")),
+					dto.NewArticleEventDto("01JF0REBGD4QKPFGN1SX2STY4N", "HIDE_ARTICLE", occurredAt, dto.ArticleEventDtoWithInvisible(invisible)),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement) {
+				qs.EXPECT().ListByArticleID(gomock.Any(), "article_id", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, articleID string, out *db.MultipleStatementResult[*model.ArticleEvent]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+							created := model.NewArticleEvent("01JF0REBGD4QKPFGN1SX2STY4M", model.ArticleEventTypeCreateArticle, occurredAt, model.ArticleEventWithTitle(title), model.ArticleEventWithContent(body), model.ArticleEventWithThumbnail(thumbnail), model.ArticleEventWithTags("tag1"))
+							hidden := model.NewArticleEvent("01JF0REBGD4QKPFGN1SX2STY4N", model.ArticleEventTypeHideArticle, occurredAt, model.ArticleEventWithInvisible(invisible))
+							out.Set([]*model.ArticleEvent{&created, &hidden})
+							return nil
+						}).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+		"unhappy_path": {
+			args: func() args {
+				in := dto.NewListArticleEventsInDto("article_id")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupQueryService: func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement) {
+				qs.EXPECT().ListByArticleID(gomock.Any(), "article_id", gomock.Any()).DoAndReturn(
+					func(ctx context.Context, articleID string, out *db.MultipleStatementResult[*model.ArticleEvent]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errUnhappyPath).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+		"unhappy_path:without-id": {
+			args: func() args {
+				in := dto.NewListArticleEventsInDto("")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService: func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement) {},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			qs := mquery.NewMockArticleEventService(ctrl)
+			stmt := mdb.NewMockStatement(ctrl)
+			tt.setupQueryService(qs, stmt)
+
+			u := NewListArticleEvents(qs)
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
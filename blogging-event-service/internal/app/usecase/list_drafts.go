@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// ListDrafts is a use-case for listing draft articles.
+type ListDrafts struct {
+	draftQuery query.DraftService
+}
+
+// Execute executes the ListDrafts use-case.
+func (u *ListDrafts) Execute(ctx context.Context) (_ *dto.ListDraftsOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.ListDraftsOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	queryOut := db.NewMultipleStatementResult[*model.Draft]()
+	err = u.draftQuery.List(ctx, queryOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	drafts := make([]dto.DraftDto, 0, len(queryOut.StrictGet()))
+	for _, v := range queryOut.StrictGet() {
+		drafts = append(drafts, newDraftDto(*v))
+	}
+	result := dto.NewListDraftsOutDto(drafts)
+	return &result, nil
+}
+
+// NewListDrafts is a constructor for ListDrafts use-case.
+func NewListDrafts(draftQuery query.DraftService) *ListDrafts {
+	return &ListDrafts{draftQuery: draftQuery}
+}
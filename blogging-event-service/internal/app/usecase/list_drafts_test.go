@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mquery "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/query"
+	mdb "blogapi.miyamo.today/blogging-event-service/internal/mock/core/db"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestListDrafts_Execute(t *testing.T) {
+	type want struct {
+		out *dto.ListDraftsOutDto
+		err error
+	}
+	type testCase struct {
+		want              want
+		setupQueryService func(qs *mquery.MockDraftService, stmt *mdb.MockStatement)
+	}
+	errUnhappyPath := errors.New("unhappy_path")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			want: func() want {
+				out := dto.NewListDraftsOutDto([]dto.DraftDto{
+					dto.NewDraftDto("article_id1", "title1", "body1", "thumbnail1", []string{"tag1"}, "event_id1"),
+					dto.NewDraftDto("article_id2", "title2", "body2", "thumbnail2", nil, "event_id2"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: func(qs *mquery.MockDraftService, stmt *mdb.MockStatement) {
+				qs.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, out *db.MultipleStatementResult[*model.Draft]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+							v1 := model.NewDraft("article_id1", "title1", "body1", "thumbnail1", []string{"tag1"}, "event_id1")
+							v2 := model.NewDraft("article_id2", "title2", "body2", "thumbnail2", nil, "event_id2")
+							out.Set([]*model.Draft{&v1, &v2})
+							return nil
+						}).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+		"happy_path:no-drafts": {
+			want: func() want {
+				out := dto.NewListDraftsOutDto([]dto.DraftDto{})
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: func(qs *mquery.MockDraftService, stmt *mdb.MockStatement) {
+				qs.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, out *db.MultipleStatementResult[*model.Draft]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+		"unhappy_path": {
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupQueryService: func(qs *mquery.MockDraftService, stmt *mdb.MockStatement) {
+				qs.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, out *db.MultipleStatementResult[*model.Draft]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errUnhappyPath).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			qs := mquery.NewMockDraftService(ctrl)
+			stmt := mdb.NewMockStatement(ctrl)
+			tt.setupQueryService(qs, stmt)
+
+			u := NewListDrafts(qs)
+			got, err := u.Execute(context.Background())
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// MergeTags is a use-case for merging tags into another one on every article they are attached to.
+type MergeTags struct {
+	tagQuery             query.TagService
+	bloggingEventCommand command.BloggingEventService
+	tagPolicy            model.TagPolicy
+}
+
+// Execute executes the MergeTags use-case.
+// An event is appended to each article in turn, so a failure leaves the tags merged on some of them.
+// Retrying finishes the job, since the articles already merged no longer carry the sources.
+// Once every article is done, the outcome is recorded under the idempotency key of the request, and a retry after that is answered with it.
+func (u *MergeTags) Execute(ctx context.Context, in *dto.MergeTagsInDto) (_ *dto.MergeTagsOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.MergeTagsOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	sources := u.tagPolicy.LookupAll(in.Sources())
+	into, err := u.tagPolicy.ApplyOne("into", in.Into())
+	if err != nil {
+		return nil, err
+	}
+	if err = model.ValidateTagMerge(sources, into); err != nil {
+		return nil, err
+	}
+
+	replayed, err := replayOutcome(ctx, u.bloggingEventCommand)
+	if err != nil {
+		return nil, err
+	}
+	if replayed != nil {
+		result := dto.NewMergeTagsOutDto(bloggingEventKeyDtos(replayed.Events()))
+		return &result, nil
+	}
+
+	queryOut := db.NewMultipleStatementResult[*model.TaggedArticle]()
+	err = u.tagQuery.ListTaggedArticles(ctx, sources, queryOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	articles := queryOut.StrictGet()
+	if len(articles) == 0 {
+		return nil, errors.Wrapf(model.ErrNotFound, "tags %q", sources)
+	}
+
+	events := make([]model.BloggingEventKey, 0, len(articles))
+	for _, article := range articles {
+		// each event is appended only if the article has not changed since its tags were read.
+		command := model.NewMergeTagsEvent(article.ArticleID(), sources, into, article.LastEventID())
+		if err = command.Validate(); err != nil {
+			return nil, err
+		}
+		commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
+		articleCtx := model.ContextWithArticleIdempotencyKey(ctx, article.ArticleID())
+		err = u.bloggingEventCommand.MergeTags(articleCtx, command, commandOut).Execute(articleCtx)
+		if err != nil {
+			err = errors.WithStack(err)
+			return nil, err
+		}
+		events = append(events, *commandOut.StrictGet())
+	}
+
+	outcome, err := recordOutcome(ctx, u.bloggingEventCommand, model.NewRequestOutcome("", events))
+	if err != nil {
+		return nil, err
+	}
+	result := dto.NewMergeTagsOutDto(bloggingEventKeyDtos(outcome.Events()))
+	return &result, nil
+}
+
+// NewMergeTags is a constructor for MergeTags use-case.
+func NewMergeTags(tagQuery query.TagService, bloggingEventCommand command.BloggingEventService, tagPolicy model.TagPolicy) *MergeTags {
+	return &MergeTags{
+		tagQuery:             tagQuery,
+		bloggingEventCommand: bloggingEventCommand,
+		tagPolicy:            tagPolicy,
+	}
+}
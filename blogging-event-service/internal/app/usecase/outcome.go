@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+)
+
+// replayOutcome returns the outcome of a request written as an event per article, if a request with the same idempotency key has been applied.
+// It returns nil if the request has no idempotency key.
+func replayOutcome(ctx context.Context, bloggingEventCommand command.BloggingEventService) (*model.RequestOutcome, error) {
+	if _, ok := model.IdempotencyKeyFromContext(ctx); !ok {
+		return nil, nil
+	}
+	out := db.NewSingleStatementResult[*model.RequestOutcome]()
+	err := bloggingEventCommand.ReplayOutcome(ctx, out).Execute(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return out.StrictGet(), nil
+}
+
+// recordOutcome records the outcome of a request written as an event per article, if the request has an idempotency key.
+// It returns the outcome recorded for the key, which is the one of a retry if that has finished first.
+func recordOutcome(ctx context.Context, bloggingEventCommand command.BloggingEventService, outcome model.RequestOutcome) (model.RequestOutcome, error) {
+	if _, ok := model.IdempotencyKeyFromContext(ctx); !ok {
+		return outcome, nil
+	}
+	out := db.NewSingleStatementResult[*model.RequestOutcome]()
+	err := bloggingEventCommand.RecordOutcome(ctx, outcome, out).Execute(ctx)
+	if err != nil {
+		return model.RequestOutcome{}, errors.WithStack(err)
+	}
+	if recorded := out.StrictGet(); recorded != nil {
+		return *recorded, nil
+	}
+	return outcome, nil
+}
+
+// bloggingEventKeyDtos converts the keys of the events to their dtos.
+func bloggingEventKeyDtos(keys []model.BloggingEventKey) []dto.BloggingEventKeyDto {
+	dtos := make([]dto.BloggingEventKeyDto, 0, len(keys))
+	for _, key := range keys {
+		dtos = append(dtos, dto.NewBloggingEventKeyDto(key.EventID(), key.ArticleID()))
+	}
+	return dtos
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mcommand "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/command"
+	mdb "blogapi.miyamo.today/blogging-event-service/internal/mock/core/db"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+// testIdempotencyKey is the idempotency key of the requests replaying or recording their outcome in the tests.
+var testIdempotencyKey = model.NewIdempotencyKey("caller", "key", "fingerprint")
+
+// expectReplayOutcome expects the outcome recorded under testIdempotencyKey to be looked up, and answers with outcome.
+func expectReplayOutcome(t *testing.T, cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement, outcome *model.RequestOutcome, err error) {
+	cs.EXPECT().ReplayOutcome(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, out *db.SingleStatementResult[*model.RequestOutcome]) db.Statement {
+			if key, _ := model.IdempotencyKeyFromContext(ctx); key != testIdempotencyKey {
+				t.Errorf("ReplayOutcome() is called with the idempotency key %+v", key)
+			}
+			stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+				out.Set(outcome)
+				return err
+			}).Times(1)
+			return stmt
+		}).Times(1)
+}
+
+// expectRecordOutcome expects outcome to be recorded under testIdempotencyKey.
+func expectRecordOutcome(t *testing.T, cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement, outcome model.RequestOutcome) {
+	cs.EXPECT().RecordOutcome(gomock.Any(), outcome, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, outcome model.RequestOutcome, out *db.SingleStatementResult[*model.RequestOutcome]) db.Statement {
+			if key, _ := model.IdempotencyKeyFromContext(ctx); key != testIdempotencyKey {
+				t.Errorf("RecordOutcome() is called with the idempotency key %+v", key)
+			}
+			stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+				out.Set(&outcome)
+				return nil
+			}).Times(1)
+			return stmt
+		}).Times(1)
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// PublishArticle is a use-case for publishing a draft article.
+type PublishArticle struct {
+	bloggingEventCommand command.BloggingEventService
+}
+
+// Execute executes the PublishArticle use-case.
+func (u *PublishArticle) Execute(ctx context.Context, in *dto.PublishArticleInDto) (_ *dto.PublishArticleOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.PublishArticleOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	command := model.NewPublishArticleEvent(in.ID(), in.ExpectedLastEventID())
+	if err = command.Validate(); err != nil {
+		return nil, err
+	}
+	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	err = u.bloggingEventCommand.PublishArticle(ctx, command, commandOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	key := commandOut.StrictGet()
+	result := dto.NewPublishArticleOutDto(key.EventID(), key.ArticleID())
+	return &result, nil
+}
+
+// NewPublishArticle is a constructor for PublishArticle use-case.
+func NewPublishArticle(bloggingEventCommand command.BloggingEventService) *PublishArticle {
+	return &PublishArticle{bloggingEventCommand: bloggingEventCommand}
+}
@@ -0,0 +1,14 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../mock/app/usecase/query/$GOFILE -package=$GOPACKAGE
+package query
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"context"
+)
+
+// ArticleEventService is a query service for the history of articles.
+type ArticleEventService interface {
+	// ListByArticleID returns the events of the article, oldest first. It fails with model.ErrNotFound if the article does not exist.
+	ListByArticleID(ctx context.Context, articleID string, out *db.MultipleStatementResult[*model.ArticleEvent]) db.Statement
+}
@@ -0,0 +1,16 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../mock/app/usecase/query/$GOFILE -package=$GOPACKAGE
+package query
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"context"
+)
+
+// DraftService is a query service for draft articles.
+type DraftService interface {
+	// GetByID returns the draft article. It fails with model.ErrNotFound if the article does not exist or has been published.
+	GetByID(ctx context.Context, articleID string, out *db.SingleStatementResult[*model.Draft]) db.Statement
+	// List returns all draft articles.
+	List(ctx context.Context, out *db.MultipleStatementResult[*model.Draft]) db.Statement
+}
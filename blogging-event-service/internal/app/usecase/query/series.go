@@ -0,0 +1,16 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../mock/app/usecase/query/$GOFILE -package=$GOPACKAGE
+package query
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"context"
+)
+
+// SeriesService is a query service for the series articles are a part of.
+type SeriesService interface {
+	// ListSeriesArticles returns the articles of the series in the order of their positions, hidden and draft ones included.
+	ListSeriesArticles(ctx context.Context, seriesID string, out *db.MultipleStatementResult[*model.SeriesArticle]) db.Statement
+	// GetSeriesArticle returns the article with the series it is a part of. It fails with ErrNotFound if the article does not exist.
+	GetSeriesArticle(ctx context.Context, articleID string, out *db.SingleStatementResult[*model.SeriesArticle]) db.Statement
+}
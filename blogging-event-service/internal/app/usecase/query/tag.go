@@ -0,0 +1,14 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../mock/app/usecase/query/$GOFILE -package=$GOPACKAGE
+package query
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"context"
+)
+
+// TagService is a query service for the tags attached to articles.
+type TagService interface {
+	// ListTaggedArticles returns the articles any of the tags is currently attached to, hidden and draft ones included.
+	ListTaggedArticles(ctx context.Context, tagNames []string, out *db.MultipleStatementResult[*model.TaggedArticle]) db.Statement
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// RenameTag is a use-case for renaming a tag on every article it is attached to.
+type RenameTag struct {
+	tagQuery             query.TagService
+	bloggingEventCommand command.BloggingEventService
+	tagPolicy            model.TagPolicy
+}
+
+// Execute executes the RenameTag use-case.
+// An event is appended to each article in turn, so a failure leaves the tag renamed on some of them.
+// Retrying finishes the job, since the articles already renamed no longer carry the old name.
+// Once every article is done, the outcome is recorded under the idempotency key of the request, and a retry after that is answered with it.
+func (u *RenameTag) Execute(ctx context.Context, in *dto.RenameTagInDto) (_ *dto.RenameTagOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.RenameTagOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	from := u.tagPolicy.Lookup(in.From())
+	to, err := u.tagPolicy.ApplyOne("to", in.To())
+	if err != nil {
+		return nil, err
+	}
+	if err = model.ValidateTagRename(from, to); err != nil {
+		return nil, err
+	}
+
+	replayed, err := replayOutcome(ctx, u.bloggingEventCommand)
+	if err != nil {
+		return nil, err
+	}
+	if replayed != nil {
+		result := dto.NewRenameTagOutDto(bloggingEventKeyDtos(replayed.Events()))
+		return &result, nil
+	}
+
+	queryOut := db.NewMultipleStatementResult[*model.TaggedArticle]()
+	err = u.tagQuery.ListTaggedArticles(ctx, []string{from}, queryOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	articles := queryOut.StrictGet()
+	if len(articles) == 0 {
+		return nil, errors.Wrapf(model.ErrNotFound, "tag %q", from)
+	}
+
+	events := make([]model.BloggingEventKey, 0, len(articles))
+	for _, article := range articles {
+		// each event is appended only if the article has not changed since its tags were read.
+		command := model.NewRenameTagEvent(article.ArticleID(), from, to, article.LastEventID())
+		if err = command.Validate(); err != nil {
+			return nil, err
+		}
+		commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
+		articleCtx := model.ContextWithArticleIdempotencyKey(ctx, article.ArticleID())
+		err = u.bloggingEventCommand.RenameTag(articleCtx, command, commandOut).Execute(articleCtx)
+		if err != nil {
+			err = errors.WithStack(err)
+			return nil, err
+		}
+		events = append(events, *commandOut.StrictGet())
+	}
+
+	outcome, err := recordOutcome(ctx, u.bloggingEventCommand, model.NewRequestOutcome("", events))
+	if err != nil {
+		return nil, err
+	}
+	result := dto.NewRenameTagOutDto(bloggingEventKeyDtos(outcome.Events()))
+	return &result, nil
+}
+
+// NewRenameTag is a constructor for RenameTag use-case.
+func NewRenameTag(tagQuery query.TagService, bloggingEventCommand command.BloggingEventService, tagPolicy model.TagPolicy) *RenameTag {
+	return &RenameTag{
+		tagQuery:             tagQuery,
+		bloggingEventCommand: bloggingEventCommand,
+		tagPolicy:            tagPolicy,
+	}
+}
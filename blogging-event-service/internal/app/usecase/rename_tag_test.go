@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mcommand "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/command"
+	mquery "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/query"
+	mdb "blogapi.miyamo.today/blogging-event-service/internal/mock/core/db"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestRenameTag_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *dto.RenameTagInDto
+	}
+	type want struct {
+		out *dto.RenameTagOutDto
+		err error
+	}
+	type testCase struct {
+		args                args
+		want                want
+		setupQueryService   func(qs *mquery.MockTagService, stmt *mdb.MockStatement)
+		setupCommandService func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement)
+	}
+	errUnhappyPath := errors.New("unhappy_path")
+
+	listTaggedArticles := func(articles ...model.TaggedArticle) func(qs *mquery.MockTagService, stmt *mdb.MockStatement) {
+		return func(qs *mquery.MockTagService, stmt *mdb.MockStatement) {
+			qs.EXPECT().ListTaggedArticles(gomock.Any(), []string{"golang"}, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, tagNames []string, out *db.MultipleStatementResult[*model.TaggedArticle]) db.Statement {
+					stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+						result := make([]*model.TaggedArticle, 0, len(articles))
+						for _, a := range articles {
+							result = append(result, &a)
+						}
+						out.Set(result)
+						return nil
+					}).Times(1)
+					return stmt
+				}).Times(1)
+		}
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			args: func() args {
+				in := dto.NewRenameTagInDto("golang", "Go")
+				// each event is recorded under the key of the request and its article.
+				ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+				return args{
+					ctx: ctx,
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewRenameTagOutDto([]dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id1", "article_id1"),
+					dto.NewBloggingEventKeyDto("event_id2", "article_id2"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: listTaggedArticles(
+				model.NewTaggedArticle("article_id1", []string{"golang"}, "last_event_id1"),
+				model.NewTaggedArticle("article_id2", []string{"aws", "golang"}, "last_event_id2"),
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				expectReplayOutcome(t, cs, stmt, nil, nil)
+				for i, articleID := range []string{"article_id1", "article_id2"} {
+					cs.EXPECT().RenameTag(gomock.Any(), model.NewRenameTagEvent(articleID, "golang", "Go", []string{"last_event_id1", "last_event_id2"}[i]), gomock.Any()).DoAndReturn(
+						func(ctx context.Context, in model.RenameTagEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+							if key, _ := model.IdempotencyKeyFromContext(ctx); key != model.NewIdempotencyKey("caller", "key#"+articleID, "fingerprint") {
+								t.Errorf("RenameTag() is called with the idempotency key %+v", key)
+							}
+							stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+								v := model.NewBloggingEventKey([]string{"event_id1", "event_id2"}[i], articleID)
+								out.Set(&v)
+								return nil
+							}).Times(1)
+							return stmt
+						}).Times(1)
+				}
+				// the outcome is recorded under the key of the request.
+				expectRecordOutcome(t, cs, stmt, model.NewRequestOutcome("", []model.BloggingEventKey{
+					model.NewBloggingEventKey("event_id1", "article_id1"),
+					model.NewBloggingEventKey("event_id2", "article_id2"),
+				}))
+			},
+		},
+		"happy_path:replayed": {
+			args: func() args {
+				in := dto.NewRenameTagInDto("golang", "Go")
+				ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+				return args{
+					ctx: ctx,
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewRenameTagOutDto([]dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id1", "article_id1"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			// the tags are no longer attached to any article, but the request has been applied already.
+			setupQueryService: func(qs *mquery.MockTagService, stmt *mdb.MockStatement) {},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				outcome := model.NewRequestOutcome("", []model.BloggingEventKey{model.NewBloggingEventKey("event_id1", "article_id1")})
+				expectReplayOutcome(t, cs, stmt, &outcome, nil)
+			},
+		},
+		"unhappy_path:idempotency-key-reused": {
+			args: func() args {
+				in := dto.NewRenameTagInDto("golang", "Go")
+				ctx := model.ContextWithIdempotencyKey(context.Background(), testIdempotencyKey)
+				return args{
+					ctx: ctx,
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrIdempotencyKeyReused,
+			},
+			setupQueryService: func(qs *mquery.MockTagService, stmt *mdb.MockStatement) {},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				expectReplayOutcome(t, cs, stmt, nil, model.ErrIdempotencyKeyReused)
+			},
+		},
+		"unhappy_path:not-found": {
+			args: func() args {
+				in := dto.NewRenameTagInDto("golang", "Go")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrNotFound,
+			},
+			setupQueryService:   listTaggedArticles(),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:renamed-to-itself": {
+			args: func() args {
+				in := dto.NewRenameTagInDto("golang", "golang")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService:   func(qs *mquery.MockTagService, stmt *mdb.MockStatement) {},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:without-new-name": {
+			args: func() args {
+				in := dto.NewRenameTagInDto("golang", "")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService:   func(qs *mquery.MockTagService, stmt *mdb.MockStatement) {},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path": {
+			args: func() args {
+				in := dto.NewRenameTagInDto("golang", "Go")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupQueryService: listTaggedArticles(
+				model.NewTaggedArticle("article_id1", []string{"golang"}, "last_event_id1"),
+				model.NewTaggedArticle("article_id2", []string{"golang"}, "last_event_id2"),
+			),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				// the remaining articles are left to a retry.
+				cs.EXPECT().RenameTag(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in model.RenameTagEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errUnhappyPath).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			qs := mquery.NewMockTagService(ctrl)
+			cs := mcommand.NewMockBloggingEventService(ctrl)
+			queryStmt := mdb.NewMockStatement(ctrl)
+			commandStmt := mdb.NewMockStatement(ctrl)
+			tt.setupQueryService(qs, queryStmt)
+			tt.setupCommandService(cs, commandStmt)
+
+			u := NewRenameTag(qs, cs, model.NewTagPolicy())
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
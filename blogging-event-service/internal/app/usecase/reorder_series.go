@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// ReorderSeries is a use-case for changing the order of the articles of a series.
+type ReorderSeries struct {
+	seriesQuery          query.SeriesService
+	bloggingEventCommand command.BloggingEventService
+}
+
+// Execute executes the ReorderSeries use-case.
+// Only the articles whose positions change get an event.
+func (u *ReorderSeries) Execute(ctx context.Context, in *dto.ReorderSeriesInDto) (_ *dto.SeriesOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.SeriesOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	queryOut := db.NewMultipleStatementResult[*model.SeriesArticle]()
+	err = u.seriesQuery.ListSeriesArticles(ctx, in.SeriesID(), queryOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	parts := make([]model.SeriesArticle, 0)
+	for _, part := range queryOut.StrictGet() {
+		parts = append(parts, *part)
+	}
+	if len(parts) == 0 {
+		return nil, errors.Wrapf(model.ErrNotFound, "series %q", in.SeriesID())
+	}
+	reordered, err := model.ReorderedSeries(parts, in.ArticleIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make(map[string]int, len(parts))
+	for _, part := range parts {
+		positions[part.ArticleID()] = part.Series().Position()
+	}
+	events := make([]dto.BloggingEventKeyDto, 0, len(reordered))
+	for _, part := range reordered {
+		if part.Series().Position() == positions[part.ArticleID()] {
+			continue
+		}
+		command := model.NewReorderSeriesEvent(part.ArticleID(), part.Series(), part.LastEventID())
+		if err = command.Validate(); err != nil {
+			return nil, err
+		}
+		commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
+		articleCtx := model.ContextWithArticleIdempotencyKey(ctx, part.ArticleID())
+		err = u.bloggingEventCommand.ReorderSeries(articleCtx, command, commandOut).Execute(articleCtx)
+		if err != nil {
+			err = errors.WithStack(err)
+			return nil, err
+		}
+		key := commandOut.StrictGet()
+		events = append(events, dto.NewBloggingEventKeyDto(key.EventID(), key.ArticleID()))
+	}
+
+	result := dto.NewSeriesOutDto(in.SeriesID(), events)
+	return &result, nil
+}
+
+// NewReorderSeries is a constructor for ReorderSeries use-case.
+func NewReorderSeries(seriesQuery query.SeriesService, bloggingEventCommand command.BloggingEventService) *ReorderSeries {
+	return &ReorderSeries{
+		seriesQuery:          seriesQuery,
+		bloggingEventCommand: bloggingEventCommand,
+	}
+}
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mcommand "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/command"
+	mquery "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/query"
+	mdb "blogapi.miyamo.today/blogging-event-service/internal/mock/core/db"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestReorderSeries_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *dto.ReorderSeriesInDto
+	}
+	type want struct {
+		out *dto.SeriesOutDto
+		err error
+	}
+	type testCase struct {
+		args                args
+		want                want
+		setupQueryService   func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement)
+		setupCommandService func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement)
+	}
+	errUnhappyPath := errors.New("unhappy_path")
+
+	listSeriesArticles := func(parts ...model.SeriesArticle) func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement) {
+		return func(qs *mquery.MockSeriesService, stmt *mdb.MockStatement) {
+			qs.EXPECT().ListSeriesArticles(gomock.Any(), "series_id", gomock.Any()).DoAndReturn(
+				func(ctx context.Context, seriesID string, out *db.MultipleStatementResult[*model.SeriesArticle]) db.Statement {
+					stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+						result := make([]*model.SeriesArticle, 0, len(parts))
+						for _, p := range parts {
+							result = append(result, &p)
+						}
+						out.Set(result)
+						return nil
+					}).Times(1)
+					return stmt
+				}).Times(1)
+		}
+	}
+	parts := []model.SeriesArticle{
+		model.NewSeriesArticle("article_id1", model.NewArticleSeries("series_id", "Go in Practice", 1), "last_event_id1"),
+		model.NewSeriesArticle("article_id2", model.NewArticleSeries("series_id", "Go in Practice", 2), "last_event_id2"),
+		model.NewSeriesArticle("article_id3", model.NewArticleSeries("series_id", "Go in Practice", 3), "last_event_id3"),
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			args: func() args {
+				in := dto.NewReorderSeriesInDto("series_id", []string{"article_id1", "article_id3", "article_id2"})
+				// each event is recorded under the key of the request and its article.
+				ctx := model.ContextWithIdempotencyKey(context.Background(), model.NewIdempotencyKey("caller", "key", "fingerprint"))
+				return args{
+					ctx: ctx,
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewSeriesOutDto("series_id", []dto.BloggingEventKeyDto{
+					dto.NewBloggingEventKeyDto("event_id3", "article_id3"),
+					dto.NewBloggingEventKeyDto("event_id2", "article_id2"),
+				})
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: listSeriesArticles(parts...),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				// the first article stays where it is, so it is not written to.
+				for i, articleID := range []string{"article_id3", "article_id2"} {
+					series := model.NewArticleSeries("series_id", "Go in Practice", i+2)
+					cs.EXPECT().ReorderSeries(gomock.Any(), model.NewReorderSeriesEvent(articleID, series, []string{"last_event_id3", "last_event_id2"}[i]), gomock.Any()).DoAndReturn(
+						func(ctx context.Context, in model.ReorderSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+							if key, _ := model.IdempotencyKeyFromContext(ctx); key != model.NewIdempotencyKey("caller", "key#"+articleID, "fingerprint") {
+								t.Errorf("ReorderSeries() is called with the idempotency key %+v", key)
+							}
+							stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+								v := model.NewBloggingEventKey([]string{"event_id3", "event_id2"}[i], articleID)
+								out.Set(&v)
+								return nil
+							}).Times(1)
+							return stmt
+						}).Times(1)
+				}
+			},
+		},
+		"unhappy_path:missing-article": {
+			args: func() args {
+				in := dto.NewReorderSeriesInDto("series_id", []string{"article_id1", "article_id3"})
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService:   listSeriesArticles(parts...),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:foreign-article": {
+			args: func() args {
+				in := dto.NewReorderSeriesInDto("series_id", []string{"article_id1", "article_id3", "article_id4"})
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService:   listSeriesArticles(parts...),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:not-found": {
+			args: func() args {
+				in := dto.NewReorderSeriesInDto("series_id", []string{"article_id1"})
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrNotFound,
+			},
+			setupQueryService:   listSeriesArticles(),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path": {
+			args: func() args {
+				in := dto.NewReorderSeriesInDto("series_id", []string{"article_id3", "article_id2", "article_id1"})
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupQueryService: listSeriesArticles(parts...),
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				cs.EXPECT().ReorderSeries(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in model.ReorderSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errUnhappyPath).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			qs := mquery.NewMockSeriesService(ctrl)
+			cs := mcommand.NewMockBloggingEventService(ctrl)
+			queryStmt := mdb.NewMockStatement(ctrl)
+			commandStmt := mdb.NewMockStatement(ctrl)
+			tt.setupQueryService(qs, queryStmt)
+			tt.setupCommandService(cs, commandStmt)
+
+			u := NewReorderSeries(qs, cs)
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
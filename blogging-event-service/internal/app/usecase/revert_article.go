@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// RevertArticle is a use-case for restoring an article as it was right after an earlier event.
+type RevertArticle struct {
+	articleEventQuery    query.ArticleEventService
+	bloggingEventCommand command.BloggingEventService
+}
+
+// Execute executes the RevertArticle use-case.
+func (u *RevertArticle) Execute(ctx context.Context, in *dto.RevertArticleInDto) (_ *dto.RevertArticleOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.RevertArticleOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	if in.ID() == "" {
+		return nil, errors.Wrap(model.ErrValidation, "article id is required")
+	}
+	if in.ToEventID() == "" {
+		return nil, errors.Wrap(model.ErrValidation, "event id to revert to is required")
+	}
+	queryOut := db.NewMultipleStatementResult[*model.ArticleEvent]()
+	err = u.articleEventQuery.ListByArticleID(ctx, in.ID(), queryOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	// the revert is computed against the events read here, so it is appended only if none has been written since.
+	command, err := model.NewRevertArticleEvent(in.ID(), in.ToEventID(), queryOut.StrictGet())
+	if err != nil {
+		return nil, err
+	}
+	if v := in.ExpectedLastEventID(); v != "" && v != command.ExpectedLastEventID() {
+		return nil, errors.WithStack(model.ErrConflict)
+	}
+	if err = command.Validate(); err != nil {
+		return nil, err
+	}
+	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	err = u.bloggingEventCommand.RevertArticle(ctx, command, commandOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	key := commandOut.StrictGet()
+	result := dto.NewRevertArticleOutDto(key.EventID(), key.ArticleID())
+	return &result, nil
+}
+
+// NewRevertArticle is a constructor for RevertArticle use-case.
+func NewRevertArticle(articleEventQuery query.ArticleEventService, bloggingEventCommand command.BloggingEventService) *RevertArticle {
+	return &RevertArticle{
+		articleEventQuery:    articleEventQuery,
+		bloggingEventCommand: bloggingEventCommand,
+	}
+}
@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mcommand "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/command"
+	mquery "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/query"
+	mdb "blogapi.miyamo.today/blogging-event-service/internal/mock/core/db"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRevertArticle_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *dto.RevertArticleInDto
+	}
+	type want struct {
+		out *dto.RevertArticleOutDto
+		err error
+	}
+	type testCase struct {
+		args                args
+		want                want
+		setupQueryService   func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement)
+		setupCommandService func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement)
+	}
+	errUnhappyPath := errors.New("unhappy_path")
+	createdAt := time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC)
+	title1, title2, body, thumbnail := "title1", "title2", "body", "thumbnail"
+
+	listEvents := func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement) {
+		qs.EXPECT().ListByArticleID(gomock.Any(), "article_id", gomock.Any()).DoAndReturn(
+			func(ctx context.Context, articleID string, out *db.MultipleStatementResult[*model.ArticleEvent]) db.Statement {
+				stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+					created := model.NewArticleEvent("01JF0REBGD4QKPFGN1SX2STY4M", model.ArticleEventTypeCreateArticle, createdAt, model.ArticleEventWithTitle(title1), model.ArticleEventWithContent(body), model.ArticleEventWithThumbnail(thumbnail), model.ArticleEventWithTags("tag1"))
+					edited := model.NewArticleEvent("01JF0REBGD4QKPFGN1SX2STY4N", model.ArticleEventTypeEditArticle, createdAt.Add(time.Hour), model.ArticleEventWithTitle(title2), model.ArticleEventWithAttachTags("tag2"), model.ArticleEventWithDetachTags("tag1"))
+					out.Set([]*model.ArticleEvent{&created, &edited})
+					return nil
+				}).Times(1)
+				return stmt
+			}).Times(1)
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			args: func() args {
+				in := dto.NewRevertArticleInDto("article_id", "01JF0REBGD4QKPFGN1SX2STY4M", "01JF0REBGD4QKPFGN1SX2STY4N")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: func() want {
+				out := dto.NewRevertArticleOutDto("event_id", "article_id")
+				return want{
+					out: &out,
+				}
+			}(),
+			setupQueryService: listEvents,
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				cs.EXPECT().RevertArticle(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in model.RevertArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+						if in.Title() != "title1" || in.Body() != "body" || in.Thumbnail() != "thumbnail" {
+							t.Errorf("RevertArticle() restores title = %v, body = %v, thumbnail = %v", in.Title(), in.Body(), in.Thumbnail())
+						}
+						if !reflect.DeepEqual(in.AttachTags(), []string{"tag1"}) || !reflect.DeepEqual(in.DetachTags(), []string{"tag2"}) {
+							t.Errorf("RevertArticle() attaches %v and detaches %v", in.AttachTags(), in.DetachTags())
+						}
+						if in.ToEventID() != "01JF0REBGD4QKPFGN1SX2STY4M" || in.ExpectedLastEventID() != "01JF0REBGD4QKPFGN1SX2STY4N" {
+							t.Errorf("RevertArticle() to = %v, expected last event = %v", in.ToEventID(), in.ExpectedLastEventID())
+						}
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ ...db.ExecuteOption) error {
+							v := model.NewBloggingEventKey("event_id", "article_id")
+							out.Set(&v)
+							return nil
+						}).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+		"unhappy_path:stale-expected-last-event-id": {
+			args: func() args {
+				in := dto.NewRevertArticleInDto("article_id", "01JF0REBGD4QKPFGN1SX2STY4M", "01JF0REBGD4QKPFGN1SX2STY4M")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrConflict,
+			},
+			setupQueryService:   listEvents,
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:unknown-event-id": {
+			args: func() args {
+				in := dto.NewRevertArticleInDto("article_id", "01JF0REBGD4QKPFGN1SX2STY4Z", "")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrNotFound,
+			},
+			setupQueryService:   listEvents,
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path:without-to-event-id": {
+			args: func() args {
+				in := dto.NewRevertArticleInDto("article_id", "", "")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupQueryService:   func(qs *mquery.MockArticleEventService, stmt *mdb.MockStatement) {},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {},
+		},
+		"unhappy_path": {
+			args: func() args {
+				in := dto.NewRevertArticleInDto("article_id", "01JF0REBGD4QKPFGN1SX2STY4M", "")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupQueryService: listEvents,
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, stmt *mdb.MockStatement) {
+				cs.EXPECT().RevertArticle(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, in model.RevertArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+						stmt.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(errUnhappyPath).Times(1)
+						return stmt
+					}).Times(1)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			qs := mquery.NewMockArticleEventService(ctrl)
+			cs := mcommand.NewMockBloggingEventService(ctrl)
+			queryStmt := mdb.NewMockStatement(ctrl)
+			commandStmt := mdb.NewMockStatement(ctrl)
+			tt.setupQueryService(qs, queryStmt)
+			tt.setupCommandService(cs, commandStmt)
+
+			u := NewRevertArticle(qs, cs)
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,11 +3,16 @@ package storage
 
 import (
 	"context"
+	"io"
 	"net/url"
 )
 
 // Uploader is an interface for uploading files.
 type Uploader interface {
-	// Upload uploads a file.
-	Upload(ctx context.Context, name string, bytes []byte, contentType string) (*url.URL, error)
+	// Upload uploads a file read from body, without reading all of it into memory.
+	// The upload is aborted if body fails.
+	Upload(ctx context.Context, name string, body io.Reader, contentType string) (*url.URL, error)
+	// Rename moves the uploaded file from src to dst, unless a file already exists at dst, in which case src is deleted.
+	// It returns the URL of dst and whether a file already existed there.
+	Rename(ctx context.Context, src, dst string) (*url.URL, bool, error)
 }
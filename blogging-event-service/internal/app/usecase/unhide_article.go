@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// UnhideArticle is a use-case for unhiding an article.
+type UnhideArticle struct {
+	bloggingEventCommand command.BloggingEventService
+}
+
+// Execute executes the UnhideArticle use-case.
+func (u *UnhideArticle) Execute(ctx context.Context, in *dto.UnhideArticleInDto) (_ *dto.UnhideArticleOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.UnhideArticleOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	command := model.NewUnhideArticleEvent(in.ID(), in.ExpectedLastEventID())
+	if err = command.Validate(); err != nil {
+		return nil, err
+	}
+	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	err = u.bloggingEventCommand.UnhideArticle(ctx, command, commandOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	key := commandOut.StrictGet()
+	result := dto.NewUnhideArticleOutDto(key.EventID(), key.ArticleID())
+	return &result, nil
+}
+
+// NewUnhideArticle is a constructor for UnhideArticle use-case.
+func NewUnhideArticle(bloggingEventCommand command.BloggingEventService) *UnhideArticle {
+	return &UnhideArticle{bloggingEventCommand: bloggingEventCommand}
+}
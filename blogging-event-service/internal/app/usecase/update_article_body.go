@@ -42,7 +42,10 @@ func (u *UpdateArticleBody) Execute(ctx context.Context, in *dto.UpdateArticleBo
 		logger.InfoContext(ctx, "END")
 	}()
 
-	command := model.NewUpdateArticleBodyEvent(in.ID(), in.Body())
+	command := model.NewUpdateArticleBodyEvent(in.ID(), in.Body(), in.ExpectedLastEventID())
+	if err = command.Validate(); err != nil {
+		return nil, err
+	}
 	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
 	err = u.bloggingEventCommand.UpdateArticleBody(ctx, command, commandOut).Execute(ctx)
 	if err != nil {
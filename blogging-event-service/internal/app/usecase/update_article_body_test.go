@@ -32,7 +32,7 @@ func TestNewUpdateArticleBody(t *testing.T) {
 	tests := map[string]testCase{
 		"happy_path": {
 			args: func() args {
-				in := dto.NewUpdateArticleBodyInDto("article_id", "body")
+				in := dto.NewUpdateArticleBodyInDto("article_id", "body", "last_event_id")
 				return args{
 					ctx: context.Background(),
 					in:  &in,
@@ -58,7 +58,7 @@ func TestNewUpdateArticleBody(t *testing.T) {
 		},
 		"unhappy_path": {
 			args: func() args {
-				in := dto.NewUpdateArticleBodyInDto("article_id", "body")
+				in := dto.NewUpdateArticleBodyInDto("article_id", "body", "")
 				return args{
 					ctx: context.Background(),
 					in:  &in,
@@ -83,7 +83,7 @@ func TestNewUpdateArticleBody(t *testing.T) {
 			defer ctrl.Finish()
 			cs := mcommand.NewMockBloggingEventService(ctrl)
 			stmt := mdb.NewMockStatement(ctrl)
-			tt.setupCommandService(cs, model.NewUpdateArticleBodyEvent(tt.args.in.ID(), tt.args.in.Body()), stmt)
+			tt.setupCommandService(cs, model.NewUpdateArticleBodyEvent(tt.args.in.ID(), tt.args.in.Body(), tt.args.in.ExpectedLastEventID()), stmt)
 
 			u := NewUpdateArticleBody(cs)
 			got, err := u.Execute(tt.args.ctx, tt.args.in)
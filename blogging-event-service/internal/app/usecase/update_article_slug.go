@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// UpdateArticleSlug is a use-case for changing the slug of an article.
+type UpdateArticleSlug struct {
+	bloggingEventCommand command.BloggingEventService
+}
+
+// Execute executes the UpdateArticleSlug use-case.
+func (u *UpdateArticleSlug) Execute(ctx context.Context, in *dto.UpdateArticleSlugInDto) (_ *dto.UpdateArticleSlugOutDto, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("dto.UpdateArticleSlugOutDto", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END")
+	}()
+
+	command := model.NewUpdateArticleSlugEvent(in.ID(), model.NormalizeSlug(in.Slug()), in.ExpectedLastEventID())
+	if err = command.Validate(); err != nil {
+		return nil, err
+	}
+	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	err = u.bloggingEventCommand.UpdateArticleSlug(ctx, command, commandOut).Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	key := commandOut.StrictGet()
+	result := dto.NewUpdateArticleSlugOutDto(key.EventID(), key.ArticleID())
+	return &result, nil
+}
+
+// NewUpdateArticleSlug is a constructor for UpdateArticleSlug use-case.
+func NewUpdateArticleSlug(bloggingEventCommand command.BloggingEventService) *UpdateArticleSlug {
+	return &UpdateArticleSlug{bloggingEventCommand: bloggingEventCommand}
+}
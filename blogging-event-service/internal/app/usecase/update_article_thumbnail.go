@@ -4,6 +4,7 @@ import (
 	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
 	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
 	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/article"
 	"blogapi.miyamo.today/core/db"
 	"blogapi.miyamo.today/core/log"
 	"context"
@@ -42,7 +43,10 @@ func (u *UpdateArticleThumbnail) Execute(ctx context.Context, in *dto.UpdateArti
 		logger.InfoContext(ctx, "END")
 	}()
 
-	command := model.NewUpdateArticleThumbnailEvent(in.ID(), in.ThumbnailUrl())
+	command := model.NewUpdateArticleThumbnailEvent(in.ID(), in.ThumbnailUrl(), imageVariantsOf(in.ThumbnailVariants()), in.ExpectedLastEventID())
+	if err = command.Validate(); err != nil {
+		return nil, err
+	}
 	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
 	err = u.bloggingEventCommand.UpdateArticleThumbnail(ctx, command, commandOut).Execute(ctx)
 	if err != nil {
@@ -59,3 +63,16 @@ func (u *UpdateArticleThumbnail) Execute(ctx context.Context, in *dto.UpdateArti
 func NewUpdateArticleThumbnail(bloggingEventCommand command.BloggingEventService) *UpdateArticleThumbnail {
 	return &UpdateArticleThumbnail{bloggingEventCommand: bloggingEventCommand}
 }
+
+// imageVariantsOf converts the variants of the thumbnail passed by the client into the ones stored with the event.
+func imageVariantsOf(variants []dto.ImageVariantDto) []article.ImageVariant {
+	if len(variants) == 0 {
+		return nil
+	}
+	result := make([]article.ImageVariant, 0, len(variants))
+	for _, v := range variants {
+		uri := v.URL()
+		result = append(result, article.NewImageVariant(uri.String(), v.Width(), v.Height(), v.ContentType()))
+	}
+	return result
+}
@@ -6,6 +6,7 @@ import (
 	mcommand "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/command"
 	mdb "blogapi.miyamo.today/blogging-event-service/internal/mock/core/db"
 	"blogapi.miyamo.today/blogging-event-service/internal/pkg"
+	"blogapi.miyamo.today/core/article"
 	"blogapi.miyamo.today/core/db"
 	"context"
 	"github.com/cockroachdb/errors"
@@ -25,6 +26,7 @@ func TestNewUpdateArticleThumbnail(t *testing.T) {
 	}
 	type testCase struct {
 		args                args
+		thumbnailVariants   []article.ImageVariant
 		want                want
 		setupCommandService func(cs *mcommand.MockBloggingEventService, in model.UpdateArticleThumbnailEvent, stmt *mdb.MockStatement)
 	}
@@ -33,12 +35,21 @@ func TestNewUpdateArticleThumbnail(t *testing.T) {
 	tests := map[string]testCase{
 		"happy_path": {
 			args: func() args {
-				in := dto.NewUpdateArticleThumbnailInDto("article_id", *pkg.MustParseURL("https://example.com/example.png"))
+				in := dto.NewUpdateArticleThumbnailInDto(
+					"article_id",
+					*pkg.MustParseURL("https://example.com/example.png"),
+					[]dto.ImageVariantDto{
+						dto.NewImageVariantDto(*pkg.MustParseURL("https://example.com/example-640w.webp"), 640, 480, "image/webp"),
+					},
+					"last_event_id")
 				return args{
 					ctx: context.Background(),
 					in:  &in,
 				}
 			}(),
+			thumbnailVariants: []article.ImageVariant{
+				article.NewImageVariant("https://example.com/example-640w.webp", 640, 480, "image/webp"),
+			},
 			want: func() want {
 				out := dto.NewUpdateArticleThumbnailOutDto("event_id", "article_id")
 				return want{
@@ -59,7 +70,7 @@ func TestNewUpdateArticleThumbnail(t *testing.T) {
 		},
 		"unhappy_path": {
 			args: func() args {
-				in := dto.NewUpdateArticleThumbnailInDto("article_id", *pkg.MustParseURL("https://example.com/example.png"))
+				in := dto.NewUpdateArticleThumbnailInDto("article_id", *pkg.MustParseURL("https://example.com/example.png"), nil, "")
 				return args{
 					ctx: context.Background(),
 					in:  &in,
@@ -84,7 +95,7 @@ func TestNewUpdateArticleThumbnail(t *testing.T) {
 			defer ctrl.Finish()
 			cs := mcommand.NewMockBloggingEventService(ctrl)
 			stmt := mdb.NewMockStatement(ctrl)
-			tt.setupCommandService(cs, model.NewUpdateArticleThumbnailEvent(tt.args.in.ID(), tt.args.in.ThumbnailUrl()), stmt)
+			tt.setupCommandService(cs, model.NewUpdateArticleThumbnailEvent(tt.args.in.ID(), tt.args.in.ThumbnailUrl(), tt.thumbnailVariants, tt.args.in.ExpectedLastEventID()), stmt)
 
 			u := NewUpdateArticleThumbnail(cs)
 			got, err := u.Execute(tt.args.ctx, tt.args.in)
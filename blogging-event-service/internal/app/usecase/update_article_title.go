@@ -41,7 +41,10 @@ func (u *UpdateArticleTitle) Execute(ctx context.Context, in *dto.UpdateArticleT
 		logger.InfoContext(ctx, "END")
 	}()
 
-	command := model.NewUpdateArticleTitleEvent(in.ID(), in.Title())
+	command := model.NewUpdateArticleTitleEvent(in.ID(), in.Title(), in.ExpectedLastEventID())
+	if err = command.Validate(); err != nil {
+		return nil, err
+	}
 	commandOut := db.NewSingleStatementResult[*model.BloggingEventKey]()
 	err = u.bloggingEventCommand.UpdateArticleTitle(ctx, command, commandOut).Execute(ctx)
 	if err != nil {
@@ -32,7 +32,7 @@ func TestNewUpdateArticleTitle(t *testing.T) {
 	tests := map[string]testCase{
 		"happy_path": {
 			args: func() args {
-				in := dto.NewUpdateArticleTitleInDto("article_id", "title")
+				in := dto.NewUpdateArticleTitleInDto("article_id", "title", "last_event_id")
 				return args{
 					ctx: context.Background(),
 					in:  &in,
@@ -58,7 +58,7 @@ func TestNewUpdateArticleTitle(t *testing.T) {
 		},
 		"unhappy_path": {
 			args: func() args {
-				in := dto.NewUpdateArticleTitleInDto("article_id", "title")
+				in := dto.NewUpdateArticleTitleInDto("article_id", "title", "")
 				return args{
 					ctx: context.Background(),
 					in:  &in,
@@ -75,6 +75,21 @@ func TestNewUpdateArticleTitle(t *testing.T) {
 					}).Times(1)
 			},
 		},
+		"unhappy_path/empty-title": {
+			args: func() args {
+				in := dto.NewUpdateArticleTitleInDto("article_id", "", "")
+				return args{
+					ctx: context.Background(),
+					in:  &in,
+				}
+			}(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			setupCommandService: func(cs *mcommand.MockBloggingEventService, in model.UpdateArticleTitleEvent, stmt *mdb.MockStatement) {
+				cs.EXPECT().UpdateArticleTitle(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
 	}
 
 	for name, tt := range tests {
@@ -83,7 +98,7 @@ func TestNewUpdateArticleTitle(t *testing.T) {
 			defer ctrl.Finish()
 			cs := mcommand.NewMockBloggingEventService(ctrl)
 			stmt := mdb.NewMockStatement(ctrl)
-			tt.setupCommandService(cs, model.NewUpdateArticleTitleEvent(tt.args.in.ID(), tt.args.in.Title()), stmt)
+			tt.setupCommandService(cs, model.NewUpdateArticleTitleEvent(tt.args.in.ID(), tt.args.in.Title(), tt.args.in.ExpectedLastEventID()), stmt)
 
 			u := NewUpdateArticleTitle(cs)
 			got, err := u.Execute(tt.args.ctx, tt.args.in)
@@ -2,17 +2,31 @@ package usecase
 
 import (
 	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/imaging"
 	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/storage"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
 	"blogapi.miyamo.today/core/log"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/cockroachdb/errors"
 	"github.com/miyamo2/altnrslog"
 	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/oklog/ulid/v2"
+	"io"
 	"log/slog"
+	"path"
+	"strings"
 )
 
 type UploadImage struct {
-	uploader storage.Uploader
+	uploader  storage.Uploader
+	processor imaging.Processor
+	stripper  imaging.Stripper
+	policy    model.ImagePolicy
 }
 
 func (u *UploadImage) Execute(ctx context.Context, in *dto.UploadImageInDto) (*dto.UploadImageOutDto, error) {
@@ -36,14 +50,99 @@ func (u *UploadImage) Execute(ctx context.Context, in *dto.UploadImageInDto) (*d
 		logger.InfoContext(ctx, "END")
 	}()
 
-	uri, err := u.uploader.Upload(ctx, in.Name(), in.Bytes(), in.ContentType())
+	// the content type declared by the client is not trusted, the sniffed one is stored instead.
+	contentType, body, err := u.policy.Inspect(in.Name(), in.Body(), in.ContentType())
 	if err != nil {
 		return nil, err
 	}
-	result := dto.NewUploadImageOutDto(*uri)
+	// the metadata tells where and with what the image was taken, so it is not published unless asked to be.
+	if !in.KeepMetadata() {
+		body, err = u.stripper.Strip(ctx, body, contentType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the image is uploaded while its variants are decoded from the same stream, so it is never held in memory as a whole.
+	recorder := &errorRecorder{r: body}
+	pr, pw := io.Pipe()
+	var (
+		variants    []imaging.Variant
+		variantsErr error
+	)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		tee := io.TeeReader(recorder, pw)
+		variants, variantsErr = u.processor.Variants(ctx, tee, contentType)
+		if variantsErr == nil {
+			// the decoder may leave the end of the image unread, which still has to be uploaded and inspected.
+			_, variantsErr = io.Copy(io.Discard, tee)
+		}
+		pw.CloseWithError(variantsErr)
+	}()
+	// the image is named by the hash of its content, which is only known once all of it is uploaded.
+	// So it is uploaded under a temporary name first, and renamed after that.
+	ext := strings.ToLower(path.Ext(in.Name()))
+	staging := path.Join(stagingDir, ulid.Make().String()+ext)
+	hash := sha256.New()
+	_, err = u.uploader.Upload(ctx, staging, io.TeeReader(pr, hash), contentType)
+	// unblocks the goroutine if the upload stopped reading early.
+	pr.CloseWithError(errUploadStopped)
+	<-done
+	switch {
+	case recorder.err != nil:
+		// the image violated the policy or the stream from the client broke.
+		err = recorder.err
+		return nil, err
+	case variantsErr != nil && !errors.Is(variantsErr, errUploadStopped):
+		err = variantsErr
+		return nil, err
+	case err != nil:
+		return nil, err
+	}
+	stem := hex.EncodeToString(hash.Sum(nil))
+	// the same image uploaded before is kept as it is, and the one just uploaded is discarded.
+	uri, deduplicated, err := u.uploader.Rename(ctx, staging, stem+ext)
+	if err != nil {
+		return nil, err
+	}
+
+	// the variants are stored next to the image, e.g. <hash>-640w.webp for <hash>.png.
+	// Those of a deduplicated image are the same as the ones stored before, which they replace.
+	variantDtos := make([]dto.ImageVariantDto, 0, len(variants))
+	for _, v := range variants {
+		name := fmt.Sprintf("%s-%dw%s", stem, v.Width(), model.ImageExtension(v.ContentType()))
+		variantURI, err := u.uploader.Upload(ctx, name, bytes.NewReader(v.Bytes()), v.ContentType())
+		if err != nil {
+			return nil, err
+		}
+		variantDtos = append(variantDtos, dto.NewImageVariantDto(*variantURI, v.Width(), v.Height(), v.ContentType()))
+	}
+	result := dto.NewUploadImageOutDto(*uri, variantDtos, deduplicated)
 	return &result, nil
 }
 
-func NewUploadImage(uploader storage.Uploader) *UploadImage {
-	return &UploadImage{uploader: uploader}
+// stagingDir is the directory the images are uploaded to until they are named by their hash.
+const stagingDir = "staging"
+
+// errUploadStopped is the error the variants are decoded with after the upload stopped reading the image.
+var errUploadStopped = errors.New("upload stopped")
+
+// errorRecorder records the error reading the image failed with, other than io.EOF.
+type errorRecorder struct {
+	r   io.Reader
+	err error
+}
+
+func (e *errorRecorder) Read(b []byte) (int, error) {
+	n, err := e.r.Read(b)
+	if err != nil && !errors.Is(err, io.EOF) && e.err == nil {
+		e.err = err
+	}
+	return n, err
+}
+
+func NewUploadImage(uploader storage.Uploader, processor imaging.Processor, stripper imaging.Stripper, policy model.ImagePolicy) *UploadImage {
+	return &UploadImage{uploader: uploader, processor: processor, stripper: stripper, policy: policy}
 }
@@ -2,16 +2,98 @@ package usecase
 
 import (
 	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"github.com/cockroachdb/errors"
+	"image"
+	"image/jpeg"
+	"image/png"
 
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/imaging"
+	mimaging "blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/imaging"
 	"blogapi.miyamo.today/blogging-event-service/internal/mock/app/usecase/storage"
 	"blogapi.miyamo.today/blogging-event-service/internal/pkg"
 	"go.uber.org/mock/gomock"
+	"io"
+	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 )
 
+func pngImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatalf("failed to encode png: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func jpegImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height)), nil); err != nil {
+		t.Fatalf("failed to encode jpeg: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func webpImage(width, height int) []byte {
+	data := []byte("RIFF\x00\x00\x00\x00WEBPVP8X\x0a\x00\x00\x00\x00\x00\x00\x00")
+	data = append(data, byte(width-1), byte((width-1)>>8), byte((width-1)>>16))
+	data = append(data, byte(height-1), byte((height-1)>>8), byte((height-1)>>16))
+	binary.LittleEndian.PutUint32(data[4:8], uint32(len(data)-8))
+	return data
+}
+
+// readingUpload returns the fake of Uploader.Upload reading the body as the real ones do.
+// It fails the test if the body is read to the end but differs from want, unless want is nil.
+func readingUpload(t *testing.T, want []byte, uri *url.URL, err error) func(context.Context, string, io.Reader, string) (*url.URL, error) {
+	return func(_ context.Context, _ string, body io.Reader, _ string) (*url.URL, error) {
+		got, readErr := io.ReadAll(body)
+		if readErr != nil {
+			return nil, readErr
+		}
+		if want != nil && !bytes.Equal(got, want) {
+			t.Errorf("Upload() body = %q, want %q", got, want)
+		}
+		return uri, err
+	}
+}
+
+// hashName returns the name of the image stored with the data.
+func hashName(data []byte, ext string) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]) + ext
+}
+
+// expectStored expects the image to be uploaded under a temporary name and then renamed after the hash of data.
+func expectStored(t *testing.T, u *storage.MockUploader, data []byte, ext, contentType string, deduplicated bool) *gomock.Call {
+	var staging string
+	upload := u.EXPECT().
+		Upload(gomock.Any(), gomock.Any(), gomock.Any(), contentType).
+		DoAndReturn(func(ctx context.Context, name string, body io.Reader, contentType string) (*url.URL, error) {
+			staging = name
+			return readingUpload(t, data, pkg.MustParseURL("http://example.com/"+name), nil)(ctx, name, body, contentType)
+		}).
+		Times(1)
+	return u.EXPECT().
+		Rename(gomock.Any(), gomock.Any(), hashName(data, ext)).
+		DoAndReturn(func(_ context.Context, src, dst string) (*url.URL, bool, error) {
+			if src != staging || !strings.HasPrefix(src, "staging/") {
+				t.Errorf("Rename() src = %s, want the staging name %s", src, staging)
+			}
+			return pkg.MustParseURL("http://example.com/" + dst), deduplicated, nil
+		}).
+		After(upload).
+		Times(1)
+}
+
 func TestUploadImage_Execute(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -22,51 +104,434 @@ func TestUploadImage_Execute(t *testing.T) {
 		err error
 	}
 	type testCase struct {
-		args              args
-		want              want
-		setupMockUploader func(u *storage.MockUploader)
+		args               args
+		policy             model.ImagePolicy
+		want               want
+		setupMockProcessor func(p *mimaging.MockProcessor)
+		setupMockStripper  func(s *mimaging.MockStripper)
+		setupMockUploader  func(u *storage.MockUploader)
 	}
 	errUnhappyPath := errors.New("unhappy_path")
+	pngData := pngImage(t, 2, 2)
+	pngHash := strings.TrimSuffix(hashName(pngData, ".png"), ".png")
+	// cameras and editors often pad a JPEG image after its end.
+	paddedJPEG := append(jpegImage(t, 2, 2), make([]byte, 16)...)
+	svg := []byte(`<svg xmlns="http://www.w3.org/2000/svg" width="2" height="2"><rect width="2" height="2"/></svg>`)
+	newArgs := func(name string, data []byte, contentType string) args {
+		in := dto.NewUploadImageInDto(name, bytes.NewReader(data), contentType, false)
+		return args{
+			ctx: context.Background(),
+			in:  &in,
+		}
+	}
 
 	tests := map[string]testCase{
 		"happy_path": {
-			args: func() args {
-				in := dto.NewUploadImageInDto("example.png", []byte{}, "image/png")
-				return args{
-					ctx: context.Background(),
-					in:  &in,
+			args:   newArgs("example.png", pngData, "image/png"),
+			policy: model.NewImagePolicy(),
+			want: func() want {
+				out := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/" + pngHash + ".png"), []dto.ImageVariantDto{}, false)
+				return want{
+					out: &out,
 				}
 			}(),
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				expectStored(t, u, pngData, ".png", "image/png", false)
+			},
+		},
+		"happy_path/without_declared_type": {
+			args:   newArgs("example.png", pngData, ""),
+			policy: model.NewImagePolicy(),
 			want: func() want {
-				out := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/example.png"))
+				out := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/" + pngHash + ".png"), []dto.ImageVariantDto{}, false)
 				return want{
 					out: &out,
 				}
 			}(),
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
 			setupMockUploader: func(u *storage.MockUploader) {
-				u.EXPECT().
-					Upload(gomock.Any(), "example.png", []byte{}, "image/png").
-					Return(pkg.MustParseURL("http://example.com/example.png"), nil).
+				expectStored(t, u, pngData, ".png", "image/png", false)
+			},
+		},
+		"happy_path/webp": {
+			args:   newArgs("example.webp", webpImage(2, 2), "image/webp"),
+			policy: model.NewImagePolicy(),
+			want: func() want {
+				out := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/" + hashName(webpImage(2, 2), ".webp")), []dto.ImageVariantDto{}, false)
+				return want{
+					out: &out,
+				}
+			}(),
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
 					Times(1)
 			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				expectStored(t, u, webpImage(2, 2), ".webp", "image/webp", false)
+			},
 		},
-		"unhappy_path": {
+		"happy_path/jpeg_with_padding": {
+			args:   newArgs("example.jpg", paddedJPEG, "image/jpeg"),
+			policy: model.NewImagePolicy(),
+			want: func() want {
+				out := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/" + hashName(paddedJPEG, ".jpg")), []dto.ImageVariantDto{}, false)
+				return want{
+					out: &out,
+				}
+			}(),
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				expectStored(t, u, paddedJPEG, ".jpg", "image/jpeg", false)
+			},
+		},
+		"happy_path/svg": {
+			args:   newArgs("example.svg", svg, "image/svg+xml"),
+			policy: model.NewImagePolicy(model.WithAllowedImageTypes(model.ImageTypeSVG)),
+			want: func() want {
+				out := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/" + hashName(svg, ".svg")), []dto.ImageVariantDto{}, false)
+				return want{
+					out: &out,
+				}
+			}(),
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				expectStored(t, u, svg, ".svg", "image/svg+xml", false)
+			},
+		},
+		"happy_path/with_variants": {
+			args:   newArgs("example.png", pngData, "image/png"),
+			policy: model.NewImagePolicy(),
+			want: func() want {
+				out := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/" + pngHash + ".png"), []dto.ImageVariantDto{
+					dto.NewImageVariantDto(*pkg.MustParseURL("http://example.com/" + pngHash + "-1w.png"), 1, 1, "image/png"),
+					dto.NewImageVariantDto(*pkg.MustParseURL("http://example.com/" + pngHash + "-2w.webp"), 2, 2, "image/webp"),
+				}, false)
+				return want{
+					out: &out,
+				}
+			}(),
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), "image/png").
+					Return([]imaging.Variant{
+						imaging.NewVariant(1, 1, "image/png", []byte("png")),
+						imaging.NewVariant(2, 2, "image/webp", []byte("webp")),
+					}, nil).
+					Times(1)
+			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				gomock.InOrder(
+					expectStored(t, u, pngData, ".png", "image/png", false),
+					u.EXPECT().
+						Upload(gomock.Any(), pngHash+"-1w.png", gomock.Any(), "image/png").
+						DoAndReturn(readingUpload(t, []byte("png"), pkg.MustParseURL("http://example.com/"+pngHash+"-1w.png"), nil)).
+						Times(1),
+					u.EXPECT().
+						Upload(gomock.Any(), pngHash+"-2w.webp", gomock.Any(), "image/webp").
+						DoAndReturn(readingUpload(t, []byte("webp"), pkg.MustParseURL("http://example.com/"+pngHash+"-2w.webp"), nil)).
+						Times(1),
+				)
+			},
+		},
+		"happy_path/stripped": {
+			args:   newArgs("example.png", pngData, "image/png"),
+			policy: model.NewImagePolicy(),
+			want: func() want {
+				out := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/" + hashName([]byte("stripped"), ".png")), []dto.ImageVariantDto{}, false)
+				return want{
+					out: &out,
+				}
+			}(),
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), "image/png").
+					Return(nil, nil).
+					Times(1)
+			},
+			setupMockStripper: func(s *mimaging.MockStripper) {
+				s.EXPECT().
+					Strip(gomock.Any(), gomock.Any(), "image/png").
+					DoAndReturn(func(_ context.Context, r io.Reader, _ string) (io.Reader, error) {
+						// the stripped image is what is uploaded, while the original is still read to the end.
+						if _, err := io.Copy(io.Discard, r); err != nil {
+							return nil, err
+						}
+						return bytes.NewReader([]byte("stripped")), nil
+					}).
+					Times(1)
+			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				expectStored(t, u, []byte("stripped"), ".png", "image/png", false)
+			},
+		},
+		"happy_path/keep_metadata": {
 			args: func() args {
-				in := dto.NewUploadImageInDto("example.png", []byte{}, "image/png")
+				in := dto.NewUploadImageInDto("example.png", bytes.NewReader(pngData), "image/png", true)
 				return args{
 					ctx: context.Background(),
 					in:  &in,
 				}
 			}(),
+			policy: model.NewImagePolicy(),
+			want: func() want {
+				out := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/" + pngHash + ".png"), []dto.ImageVariantDto{}, false)
+				return want{
+					out: &out,
+				}
+			}(),
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), "image/png").
+					Return(nil, nil).
+					Times(1)
+			},
+			setupMockStripper: func(s *mimaging.MockStripper) {
+				s.EXPECT().
+					Strip(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				expectStored(t, u, pngData, ".png", "image/png", false)
+			},
+		},
+		"unhappy_path/stripper_returns_error": {
+			args:   newArgs("example.png", pngData, "image/png"),
+			policy: model.NewImagePolicy(),
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupMockStripper: func(s *mimaging.MockStripper) {
+				s.EXPECT().
+					Strip(gomock.Any(), gomock.Any(), "image/png").
+					Return(nil, errUnhappyPath).
+					Times(1)
+			},
+		},
+		"happy_path/deduplicated": {
+			args:   newArgs("example.png", pngData, "image/png"),
+			policy: model.NewImagePolicy(),
+			want: func() want {
+				out := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/" + pngHash + ".png"), []dto.ImageVariantDto{}, true)
+				return want{
+					out: &out,
+				}
+			}(),
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), "image/png").
+					Return(nil, nil).
+					Times(1)
+			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				expectStored(t, u, pngData, ".png", "image/png", true)
+			},
+		},
+		"unhappy_path/rename_fails": {
+			args:   newArgs("example.png", pngData, "image/png"),
+			policy: model.NewImagePolicy(),
 			want: want{
 				err: errUnhappyPath,
 			},
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), "image/png").
+					Return(nil, nil).
+					Times(1)
+			},
 			setupMockUploader: func(u *storage.MockUploader) {
 				u.EXPECT().
-					Upload(gomock.Any(), "example.png", []byte{}, "image/png").
+					Upload(gomock.Any(), gomock.Any(), gomock.Any(), "image/png").
+					DoAndReturn(readingUpload(t, pngData, nil, nil)).
+					Times(1)
+				u.EXPECT().
+					Rename(gomock.Any(), gomock.Any(), pngHash+".png").
+					Return(nil, false, errUnhappyPath).
+					Times(1)
+			},
+		},
+		"unhappy_path/processor_returns_error": {
+			args:   newArgs("example.png", pngData, "image/png"),
+			policy: model.NewImagePolicy(),
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), "image/png").
 					Return(nil, errUnhappyPath).
 					Times(1)
 			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				u.EXPECT().
+					Upload(gomock.Any(), gomock.Any(), gomock.Any(), "image/png").
+					DoAndReturn(readingUpload(t, nil, nil, nil)).
+					Times(1)
+			},
+		},
+		"unhappy_path": {
+			args:   newArgs("example.png", pngData, "image/png"),
+			policy: model.NewImagePolicy(),
+			want: want{
+				err: errUnhappyPath,
+			},
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				u.EXPECT().
+					Upload(gomock.Any(), gomock.Any(), gomock.Any(), "image/png").
+					DoAndReturn(readingUpload(t, pngData, nil, errUnhappyPath)).
+					Times(1)
+			},
+		},
+		"unhappy_path/invalid_name": {
+			args:   newArgs("../example.png", pngData, "image/png"),
+			policy: model.NewImagePolicy(),
+			want: want{
+				err: model.ErrValidation,
+			},
+		},
+		"unhappy_path/empty": {
+			args:   newArgs("example.png", []byte{}, "image/png"),
+			policy: model.NewImagePolicy(),
+			want: want{
+				err: model.ErrValidation,
+			},
+		},
+		"unhappy_path/too_large": {
+			args:   newArgs("example.png", pngData, "image/png"),
+			policy: model.NewImagePolicy(model.WithMaxImageBytes(int64(len(pngData) - 1))),
+			want: want{
+				err: model.ErrValidation,
+			},
+		},
+		"unhappy_path/too_many_pixels": {
+			args:   newArgs("example.png", pngData, "image/png"),
+			policy: model.NewImagePolicy(model.WithMaxImageDimensions(2, 1)),
+			want: want{
+				err: model.ErrValidation,
+			},
+		},
+		"unhappy_path/webp_too_many_pixels": {
+			args:   newArgs("example.webp", webpImage(3, 2), "image/webp"),
+			policy: model.NewImagePolicy(model.WithMaxImageDimensions(2, 2)),
+			want: want{
+				err: model.ErrValidation,
+			},
+		},
+		"unhappy_path/type_not_allowed": {
+			args:   newArgs("example.png", []byte("<html><body>example</body></html>"), "image/png"),
+			policy: model.NewImagePolicy(),
+			want: want{
+				err: model.ErrValidation,
+			},
+		},
+		"unhappy_path/declared_type_mismatch": {
+			args:   newArgs("example.png", pngData, "image/gif"),
+			policy: model.NewImagePolicy(),
+			want: want{
+				err: model.ErrValidation,
+			},
+		},
+		"unhappy_path/extension_mismatch": {
+			args:   newArgs("example.html", pngData, "image/png"),
+			policy: model.NewImagePolicy(),
+			want: want{
+				err: model.ErrValidation,
+			},
+		},
+		"unhappy_path/polyglot": {
+			args:   newArgs("example.png", append(bytes.Clone(pngData), []byte("<script>alert(1)</script>")...), "image/png"),
+			policy: model.NewImagePolicy(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			// the image is found to violate the policy while it is being uploaded, which aborts the upload.
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				u.EXPECT().
+					Upload(gomock.Any(), gomock.Any(), gomock.Any(), "image/png").
+					DoAndReturn(readingUpload(t, nil, nil, nil)).
+					Times(1)
+			},
+		},
+		"unhappy_path/jpeg_with_archive": {
+			args:   newArgs("example.jpg", append(jpegImage(t, 2, 2), []byte("PK\x03\x04\x14\x00\x00\x00")...), "image/jpeg"),
+			policy: model.NewImagePolicy(),
+			want: want{
+				err: model.ErrValidation,
+			},
+			// the image is found to violate the policy while it is being uploaded, which aborts the upload.
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				u.EXPECT().
+					Upload(gomock.Any(), gomock.Any(), gomock.Any(), "image/jpeg").
+					DoAndReturn(readingUpload(t, nil, nil, nil)).
+					Times(1)
+			},
+		},
+		"unhappy_path/svg_not_allowed": {
+			args:   newArgs("example.svg", svg, "image/svg+xml"),
+			policy: model.NewImagePolicy(),
+			want: want{
+				err: model.ErrValidation,
+			},
+		},
+		"unhappy_path/svg_with_script": {
+			args:   newArgs("example.svg", []byte(`<svg xmlns="http://www.w3.org/2000/svg" onload="alert(1)"></svg>`), "image/svg+xml"),
+			policy: model.NewImagePolicy(model.WithAllowedImageTypes(model.ImageTypeSVG)),
+			want: want{
+				err: model.ErrValidation,
+			},
+			// the image is found to violate the policy while it is being uploaded, which aborts the upload.
+			setupMockProcessor: func(p *mimaging.MockProcessor) {
+				p.EXPECT().
+					Variants(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, nil).
+					Times(1)
+			},
+			setupMockUploader: func(u *storage.MockUploader) {
+				u.EXPECT().
+					Upload(gomock.Any(), gomock.Any(), gomock.Any(), "image/svg+xml").
+					DoAndReturn(readingUpload(t, nil, nil, nil)).
+					Times(1)
+			},
 		},
 	}
 
@@ -74,10 +539,28 @@ func TestUploadImage_Execute(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
+			processor := mimaging.NewMockProcessor(ctrl)
+			if tt.setupMockProcessor != nil {
+				tt.setupMockProcessor(processor)
+			}
+			stripper := mimaging.NewMockStripper(ctrl)
+			if tt.setupMockStripper != nil {
+				tt.setupMockStripper(stripper)
+			} else {
+				// the images are passed through unless the case is about stripping them.
+				stripper.EXPECT().
+					Strip(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, r io.Reader, _ string) (io.Reader, error) {
+						return r, nil
+					}).
+					AnyTimes()
+			}
 			uploader := storage.NewMockUploader(ctrl)
-			tt.setupMockUploader(uploader)
+			if tt.setupMockUploader != nil {
+				tt.setupMockUploader(uploader)
+			}
 
-			u := NewUploadImage(uploader)
+			u := NewUploadImage(uploader, processor, stripper, tt.policy)
 			got, err := u.Execute(tt.args.ctx, tt.args.in)
 			if !errors.Is(err, tt.want.err) {
 				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
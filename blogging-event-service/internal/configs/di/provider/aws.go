@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/dynamo"
 	"blogapi.miyamo.today/blogging-event-service/internal/infra/s3"
 	"context"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/google/wire"
 )
@@ -22,8 +24,14 @@ func S3Client(awsConfig *aws.Config) *awss3.Client {
 	return awss3.NewFromConfig(*awsConfig)
 }
 
+func DynamoDBClient(awsConfig *aws.Config) *dynamodb.Client {
+	return dynamodb.NewFromConfig(*awsConfig)
+}
+
 var AWSSet = wire.NewSet(
 	AWSConfig,
 	S3Client,
 	wire.Bind(new(s3.Client), new(*awss3.Client)),
+	DynamoDBClient,
+	wire.Bind(new(dynamo.Client), new(*dynamodb.Client)),
 )
@@ -3,14 +3,15 @@ package provider
 import (
 	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
 	"blogapi.miyamo.today/blogging-event-service/internal/infra/dynamo"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/local"
 	"github.com/google/wire"
 )
 
-func BloggingEventCommandService() *dynamo.BloggingEventCommandService {
+func BloggingEventCommandService(localStore *local.Store) command.BloggingEventService {
+	if localStore != nil {
+		return local.NewBloggingEventCommandService(localStore, nil)
+	}
 	return dynamo.NewBloggingEventCommandService(nil)
 }
 
-var CommandSet = wire.NewSet(
-	BloggingEventCommandService,
-	wire.Bind(new(command.BloggingEventService), new(*dynamo.BloggingEventCommandService)),
-)
+var CommandSet = wire.NewSet(BloggingEventCommandService)
@@ -1,8 +1,11 @@
 package provider
 
 import (
+	"blogapi.miyamo.today/blogging-event-service/internal/if-adapter/controller/pb"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/filesystem"
 	blogapictx "blogapi.miyamo.today/core/context"
 	"blogapi.miyamo.today/core/echo/middlewares"
+	"connectrpc.com/connect"
 	"connectrpc.com/grpcreflect"
 	"fmt"
 	"github.com/google/wire"
@@ -19,11 +22,13 @@ import (
 	"github.com/newrelic/go-agent/v3/newrelic"
 )
 
-func Echo(service grpcconnect.BloggingEventServiceHandler, nr *newrelic.Application) *echo.Echo {
+func Echo(service grpcconnect.BloggingEventServiceHandler, nr *newrelic.Application, filesystemUploader *filesystem.Uploader) *echo.Echo {
 	slog.Info("creating echo server")
 	e := echo.New()
 
-	path, handler := grpcconnect.NewBloggingEventServiceHandler(service)
+	path, handler := grpcconnect.NewBloggingEventServiceHandler(
+		service,
+		connect.WithInterceptors(pb.NewActorInterceptor(), pb.NewIdempotencyInterceptor()))
 	e.POST(
 		fmt.Sprintf("%s*", path),
 		echo.WrapHandler(handler),
@@ -43,6 +48,11 @@ func Echo(service grpcconnect.BloggingEventServiceHandler, nr *newrelic.Applicat
 	healthPath, healthHandler := grpchealth.NewHandler(grpchealth.NewStaticChecker(grpcconnect.BloggingEventServiceName))
 	e.POST(fmt.Sprintf("%s*", healthPath), echo.WrapHandler(healthHandler))
 
+	if filesystemUploader != nil {
+		// serves the images uploaded to the local filesystem, in place of the CDN.
+		e.GET(fmt.Sprintf("%s*", filesystem.ServePath), echo.WrapHandler(filesystemUploader.Handler()))
+	}
+
 	e.HTTPErrorHandler = func(err error, c echo.Context) {
 		req := c.Request()
 		ctx := req.Context()
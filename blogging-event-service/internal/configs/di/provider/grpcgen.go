@@ -17,6 +17,8 @@ func NewBloggingEventServiceServer(
 	createArticleConverter presenters.ToCreateArticleResponse,
 	updateArticleTitleUsecase usecase.UpdateArticleTitle,
 	updateArticleTitleConverter presenters.ToUpdateArticleTitleResponse,
+	updateArticleSlugUsecase usecase.UpdateArticleSlug,
+	updateArticleSlugConverter presenters.ToUpdateArticleSlugResponse,
 	updateArticleBodyUsecase usecase.UpdateArticleBody,
 	updateArticleBodyConverter presenters.ToUpdateArticleBodyResponse,
 	updateArticleThumbnailUsecase usecase.UpdateArticleThumbnail,
@@ -25,6 +27,36 @@ func NewBloggingEventServiceServer(
 	attachTagsConverter presenters.ToAttachTagsResponse,
 	detachTagsUsecase usecase.DetachTags,
 	detachTagsConverter presenters.ToDetachTagsResponse,
+	hideArticleUsecase usecase.HideArticle,
+	hideArticleConverter presenters.ToHideArticleResponse,
+	unhideArticleUsecase usecase.UnhideArticle,
+	unhideArticleConverter presenters.ToUnhideArticleResponse,
+	editArticleUsecase usecase.EditArticle,
+	editArticleConverter presenters.ToEditArticleResponse,
+	scheduleArticleUsecase usecase.ScheduleArticle,
+	scheduleArticleConverter presenters.ToScheduleArticleResponse,
+	publishArticleUsecase usecase.PublishArticle,
+	publishArticleConverter presenters.ToPublishArticleResponse,
+	revertArticleUsecase usecase.RevertArticle,
+	revertArticleConverter presenters.ToRevertArticleResponse,
+	renameTagUsecase usecase.RenameTag,
+	renameTagConverter presenters.ToRenameTagResponse,
+	mergeTagsUsecase usecase.MergeTags,
+	mergeTagsConverter presenters.ToMergeTagsResponse,
+	createSeriesUsecase usecase.CreateSeries,
+	createSeriesConverter presenters.ToCreateSeriesResponse,
+	addArticleToSeriesUsecase usecase.AddArticleToSeries,
+	addArticleToSeriesConverter presenters.ToAddArticleToSeriesResponse,
+	reorderSeriesUsecase usecase.ReorderSeries,
+	reorderSeriesConverter presenters.ToReorderSeriesResponse,
+	getDraftUsecase usecase.GetDraft,
+	getDraftConverter presenters.ToGetDraftResponse,
+	listDraftsUsecase usecase.ListDrafts,
+	listDraftsConverter presenters.ToListDraftsResponse,
+	listArticleEventsUsecase usecase.ListArticleEvents,
+	listArticleEventsConverter presenters.ToListArticleEventsResponse,
+	getArticleAtUsecase usecase.GetArticleAt,
+	getArticleAtConverter presenters.ToGetArticleAtResponse,
 	uploadImageUsecase usecase.UploadImage,
 	uploadImageConverter presenters.ToUploadImageResponse,
 ) *pb.BloggingEventServiceServer {
@@ -33,6 +65,8 @@ func NewBloggingEventServiceServer(
 		pb.WithCreateArticleConverter(createArticleConverter),
 		pb.WithUpdateArticleTitleUsecase(updateArticleTitleUsecase),
 		pb.WithUpdateArticleTitleConverter(updateArticleTitleConverter),
+		pb.WithUpdateArticleSlugUsecase(updateArticleSlugUsecase),
+		pb.WithUpdateArticleSlugConverter(updateArticleSlugConverter),
 		pb.WithUpdateArticleBodyUsecase(updateArticleBodyUsecase),
 		pb.WithUpdateArticleBodyConverter(updateArticleBodyConverter),
 		pb.WithUpdateArticleThumbnailUsecase(updateArticleThumbnailUsecase),
@@ -41,6 +75,36 @@ func NewBloggingEventServiceServer(
 		pb.WithAttachTagsConverter(attachTagsConverter),
 		pb.WithDetachTagsUsecase(detachTagsUsecase),
 		pb.WithDetachTagsConverter(detachTagsConverter),
+		pb.WithHideArticleUsecase(hideArticleUsecase),
+		pb.WithHideArticleConverter(hideArticleConverter),
+		pb.WithUnhideArticleUsecase(unhideArticleUsecase),
+		pb.WithUnhideArticleConverter(unhideArticleConverter),
+		pb.WithEditArticleUsecase(editArticleUsecase),
+		pb.WithEditArticleConverter(editArticleConverter),
+		pb.WithScheduleArticleUsecase(scheduleArticleUsecase),
+		pb.WithScheduleArticleConverter(scheduleArticleConverter),
+		pb.WithPublishArticleUsecase(publishArticleUsecase),
+		pb.WithPublishArticleConverter(publishArticleConverter),
+		pb.WithRevertArticleUsecase(revertArticleUsecase),
+		pb.WithRevertArticleConverter(revertArticleConverter),
+		pb.WithRenameTagUsecase(renameTagUsecase),
+		pb.WithRenameTagConverter(renameTagConverter),
+		pb.WithMergeTagsUsecase(mergeTagsUsecase),
+		pb.WithMergeTagsConverter(mergeTagsConverter),
+		pb.WithCreateSeriesUsecase(createSeriesUsecase),
+		pb.WithCreateSeriesConverter(createSeriesConverter),
+		pb.WithAddArticleToSeriesUsecase(addArticleToSeriesUsecase),
+		pb.WithAddArticleToSeriesConverter(addArticleToSeriesConverter),
+		pb.WithReorderSeriesUsecase(reorderSeriesUsecase),
+		pb.WithReorderSeriesConverter(reorderSeriesConverter),
+		pb.WithGetDraftUsecase(getDraftUsecase),
+		pb.WithGetDraftConverter(getDraftConverter),
+		pb.WithListDraftsUsecase(listDraftsUsecase),
+		pb.WithListDraftsConverter(listDraftsConverter),
+		pb.WithListArticleEventsUsecase(listArticleEventsUsecase),
+		pb.WithListArticleEventsConverter(listArticleEventsConverter),
+		pb.WithGetArticleAtUsecase(getArticleAtUsecase),
+		pb.WithGetArticleAtConverter(getArticleAtConverter),
 		pb.WithUploadImageUsecase(uploadImageUsecase),
 		pb.WithUploadImageConverter(uploadImageConverter))
 }
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/local"
+	"github.com/google/wire"
+	"os"
+)
+
+// LocalEventStore opens the local event store if BLOGGING_EVENT_STORE is "local", and returns nil otherwise.
+// The store is kept in BLOGGING_EVENT_LOG_DIR.
+func LocalEventStore() *local.Store {
+	if os.Getenv("BLOGGING_EVENT_STORE") != "local" {
+		return nil
+	}
+	store, err := local.Open(os.Getenv("BLOGGING_EVENT_LOG_DIR"))
+	if err != nil {
+		panic(err)
+	}
+	return store
+}
+
+var LocalSet = wire.NewSet(LocalEventStore)
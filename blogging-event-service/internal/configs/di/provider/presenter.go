@@ -10,10 +10,26 @@ import (
 var (
 	_ presenters.ToCreateArticleResponse          = (*impl.Converter)(nil)
 	_ presenters.ToUpdateArticleTitleResponse     = (*impl.Converter)(nil)
+	_ presenters.ToUpdateArticleSlugResponse      = (*impl.Converter)(nil)
 	_ presenters.ToUpdateArticleBodyResponse      = (*impl.Converter)(nil)
 	_ presenters.ToUpdateArticleThumbnailResponse = (*impl.Converter)(nil)
 	_ presenters.ToAttachTagsResponse             = (*impl.Converter)(nil)
 	_ presenters.ToDetachTagsResponse             = (*impl.Converter)(nil)
+	_ presenters.ToHideArticleResponse            = (*impl.Converter)(nil)
+	_ presenters.ToUnhideArticleResponse          = (*impl.Converter)(nil)
+	_ presenters.ToEditArticleResponse            = (*impl.Converter)(nil)
+	_ presenters.ToScheduleArticleResponse        = (*impl.Converter)(nil)
+	_ presenters.ToPublishArticleResponse         = (*impl.Converter)(nil)
+	_ presenters.ToRevertArticleResponse          = (*impl.Converter)(nil)
+	_ presenters.ToRenameTagResponse              = (*impl.Converter)(nil)
+	_ presenters.ToMergeTagsResponse              = (*impl.Converter)(nil)
+	_ presenters.ToCreateSeriesResponse           = (*impl.Converter)(nil)
+	_ presenters.ToAddArticleToSeriesResponse     = (*impl.Converter)(nil)
+	_ presenters.ToReorderSeriesResponse          = (*impl.Converter)(nil)
+	_ presenters.ToGetDraftResponse               = (*impl.Converter)(nil)
+	_ presenters.ToListDraftsResponse             = (*impl.Converter)(nil)
+	_ presenters.ToListArticleEventsResponse      = (*impl.Converter)(nil)
+	_ presenters.ToGetArticleAtResponse           = (*impl.Converter)(nil)
 	_ presenters.ToUploadImageResponse            = (*impl.Converter)(nil)
 )
 
@@ -21,9 +37,25 @@ var PresenterSet = wire.NewSet(
 	impl.NewConverter,
 	wire.Bind(new(presenters.ToCreateArticleResponse), new(*impl.Converter)),
 	wire.Bind(new(presenters.ToUpdateArticleTitleResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToUpdateArticleSlugResponse), new(*impl.Converter)),
 	wire.Bind(new(presenters.ToUpdateArticleBodyResponse), new(*impl.Converter)),
 	wire.Bind(new(presenters.ToUpdateArticleThumbnailResponse), new(*impl.Converter)),
 	wire.Bind(new(presenters.ToAttachTagsResponse), new(*impl.Converter)),
 	wire.Bind(new(presenters.ToDetachTagsResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToHideArticleResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToUnhideArticleResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToEditArticleResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToScheduleArticleResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToPublishArticleResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToRevertArticleResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToRenameTagResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToMergeTagsResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToCreateSeriesResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToAddArticleToSeriesResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToReorderSeriesResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToGetDraftResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToListDraftsResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToListArticleEventsResponse), new(*impl.Converter)),
+	wire.Bind(new(presenters.ToGetArticleAtResponse), new(*impl.Converter)),
 	wire.Bind(new(presenters.ToUploadImageResponse), new(*impl.Converter)),
 )
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/query"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/dynamo"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/local"
+	"github.com/google/wire"
+)
+
+func DraftQueryService(localStore *local.Store) query.DraftService {
+	if localStore != nil {
+		return local.NewDraftQueryService(localStore)
+	}
+	return dynamo.NewDraftQueryService()
+}
+
+func ArticleEventQueryService(localStore *local.Store) query.ArticleEventService {
+	if localStore != nil {
+		return local.NewArticleEventQueryService(localStore)
+	}
+	return dynamo.NewArticleEventQueryService()
+}
+
+func TagQueryService(localStore *local.Store, client dynamo.Client) query.TagService {
+	if localStore != nil {
+		return local.NewTagQueryService(localStore)
+	}
+	return dynamo.NewTagQueryService(client)
+}
+
+func SeriesQueryService(localStore *local.Store, client dynamo.Client) query.SeriesService {
+	if localStore != nil {
+		return local.NewSeriesQueryService(localStore)
+	}
+	return dynamo.NewSeriesQueryService(client)
+}
+
+var QuerySet = wire.NewSet(
+	DraftQueryService,
+	ArticleEventQueryService,
+	TagQueryService,
+	SeriesQueryService,
+)
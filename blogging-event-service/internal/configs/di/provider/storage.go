@@ -1,12 +1,125 @@
 package provider
 
 import (
+	appimaging "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/imaging"
 	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/storage"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/filesystem"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/imaging"
 	"blogapi.miyamo.today/blogging-event-service/internal/infra/s3"
+	"fmt"
 	"github.com/google/wire"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
 )
 
+// FilesystemUploader returns the uploader writing to IMAGE_STORAGE_DIR if IMAGE_STORAGE is "local", and nil otherwise.
+// The URLs of the uploaded files start with CDN_HOST, which should point at filesystem.ServePath of this service.
+func FilesystemUploader() *filesystem.Uploader {
+	if os.Getenv("IMAGE_STORAGE") != "local" {
+		return nil
+	}
+	return filesystem.NewUploader(os.Getenv("IMAGE_STORAGE_DIR"), os.Getenv("CDN_HOST"))
+}
+
+func Uploader(client s3.Client, filesystemUploader *filesystem.Uploader) storage.Uploader {
+	if filesystemUploader != nil {
+		return filesystemUploader
+	}
+	return s3.NewUploader(client)
+}
+
+// ImagePolicy returns the policy of the uploaded images.
+// IMAGE_ALLOWED_TYPES (comma separated MIME types), IMAGE_MAX_BYTES, IMAGE_MAX_WIDTH and IMAGE_MAX_HEIGHT override its defaults.
+func ImagePolicy() model.ImagePolicy {
+	var options []model.ImagePolicyOption
+	if v := os.Getenv("IMAGE_ALLOWED_TYPES"); v != "" {
+		allowedTypes := strings.Split(v, ",")
+		for i := range allowedTypes {
+			allowedTypes[i] = strings.TrimSpace(allowedTypes[i])
+		}
+		options = append(options, model.WithAllowedImageTypes(allowedTypes...))
+	}
+	if v := os.Getenv("IMAGE_MAX_BYTES"); v != "" {
+		maxBytes, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		options = append(options, model.WithMaxImageBytes(maxBytes))
+	}
+	defaults := model.NewImagePolicy()
+	maxWidth, maxHeight := defaults.MaxWidth(), defaults.MaxHeight()
+	if v := os.Getenv("IMAGE_MAX_WIDTH"); v != "" {
+		var err error
+		if maxWidth, err = strconv.Atoi(v); err != nil {
+			panic(err)
+		}
+	}
+	if v := os.Getenv("IMAGE_MAX_HEIGHT"); v != "" {
+		var err error
+		if maxHeight, err = strconv.Atoi(v); err != nil {
+			panic(err)
+		}
+	}
+	options = append(options, model.WithMaxImageDimensions(maxWidth, maxHeight))
+	return model.NewImagePolicy(options...)
+}
+
+// ImageProcessor returns the processor producing the variants of the uploaded images.
+// IMAGE_VARIANT_WIDTHS (comma separated pixels) overrides the default widths, 320, 640 and 1280.
+// IMAGE_VARIANT_FORMATS (comma separated, "webp" and "avif") adds the formats, which need cwebp and avifenc respectively.
+func ImageProcessor() appimaging.Processor {
+	widths := []int{320, 640, 1280}
+	if v := os.Getenv("IMAGE_VARIANT_WIDTHS"); v != "" {
+		widths = widths[:0]
+		for _, w := range strings.Split(v, ",") {
+			width, err := strconv.Atoi(strings.TrimSpace(w))
+			if err != nil {
+				panic(err)
+			}
+			widths = append(widths, width)
+		}
+	}
+	var formats []imaging.Format
+	if v := os.Getenv("IMAGE_VARIANT_FORMATS"); v != "" {
+		for _, f := range strings.Split(v, ",") {
+			var (
+				format  imaging.Format
+				command string
+			)
+			switch strings.TrimSpace(f) {
+			case "webp":
+				format, command = imaging.WebP(80), "cwebp"
+			case "avif":
+				format, command = imaging.AVIF(60), "avifenc"
+			default:
+				panic(fmt.Sprintf("unknown image variant format %q", f))
+			}
+			if _, err := exec.LookPath(command); err != nil {
+				panic(err)
+			}
+			formats = append(formats, format)
+		}
+	}
+	return imaging.NewProcessor(widths, formats...)
+}
+
+// ImageStripper returns the stripper removing the metadata of the uploaded images.
+// WebP images oriented by their metadata are re-encoded with cwebp, and are refused if it is not on the PATH.
+func ImageStripper() appimaging.Stripper {
+	var formats []imaging.Format
+	if _, err := exec.LookPath("cwebp"); err == nil {
+		formats = append(formats, imaging.WebP(95))
+	}
+	return imaging.NewStripper(formats...)
+}
+
 var StorageSet = wire.NewSet(
-	s3.NewUploader,
-	wire.Bind(new(storage.Uploader), new(*s3.Uploader)),
+	FilesystemUploader,
+	Uploader,
+	ImagePolicy,
+	ImageProcessor,
+	ImageStripper,
 )
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"fmt"
+	"github.com/google/wire"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// TagPolicy returns the policy of the tag names written to the articles.
+// TAG_CASE_MODE (preserve, lower or fold), TAG_MAX_LENGTH, TAG_ALLOWED_PATTERN (a regular expression the whole name must match)
+// and TAG_BLOCKED (comma separated tag names) override its defaults.
+func TagPolicy() model.TagPolicy {
+	var options []model.TagPolicyOption
+	if v := os.Getenv("TAG_CASE_MODE"); v != "" {
+		caseMode := model.TagCaseMode(v)
+		switch caseMode {
+		case model.TagCasePreserve, model.TagCaseLower, model.TagCaseFold:
+		default:
+			panic(fmt.Sprintf("unknown TAG_CASE_MODE %q", v))
+		}
+		options = append(options, model.WithTagCaseMode(caseMode))
+	}
+	if v := os.Getenv("TAG_MAX_LENGTH"); v != "" {
+		maxLength, err := strconv.Atoi(v)
+		if err != nil {
+			panic(err)
+		}
+		options = append(options, model.WithMaxTagLength(maxLength))
+	}
+	if v := os.Getenv("TAG_ALLOWED_PATTERN"); v != "" {
+		options = append(options, model.WithAllowedTagPattern(regexp.MustCompile(v)))
+	}
+	if v := os.Getenv("TAG_BLOCKED"); v != "" {
+		blocked := strings.Split(v, ",")
+		for i := range blocked {
+			blocked[i] = strings.TrimSpace(blocked[i])
+		}
+		options = append(options, model.WithBlockedTags(blocked...))
+	}
+	return model.NewTagPolicy(options...)
+}
+
+var TagSet = wire.NewSet(
+	TagPolicy,
+)
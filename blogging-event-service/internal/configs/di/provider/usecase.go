@@ -3,7 +3,10 @@ package provider
 import (
 	impl "blogapi.miyamo.today/blogging-event-service/internal/app/usecase"
 	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/command"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/imaging"
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/query"
 	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/storage"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
 	"blogapi.miyamo.today/blogging-event-service/internal/if-adapter/controller/pb/usecase"
 	"github.com/google/wire"
 )
@@ -12,18 +15,23 @@ import (
 var (
 	_ usecase.CreateArticle          = (*impl.CreateArticle)(nil)
 	_ usecase.UpdateArticleTitle     = (*impl.UpdateArticleTitle)(nil)
+	_ usecase.UpdateArticleSlug      = (*impl.UpdateArticleSlug)(nil)
 	_ usecase.UpdateArticleBody      = (*impl.UpdateArticleBody)(nil)
 	_ usecase.UpdateArticleThumbnail = (*impl.UpdateArticleThumbnail)(nil)
 )
 
-func CreateArticleUsecase(bloggingEventCommand command.BloggingEventService) *impl.CreateArticle {
-	return impl.NewCreateArticle(bloggingEventCommand)
+func CreateArticleUsecase(bloggingEventCommand command.BloggingEventService, tagPolicy model.TagPolicy) *impl.CreateArticle {
+	return impl.NewCreateArticle(bloggingEventCommand, tagPolicy)
 }
 
 func UpdateArticleTitleUsecase(bloggingEventCommand command.BloggingEventService) *impl.UpdateArticleTitle {
 	return impl.NewUpdateArticleTitle(bloggingEventCommand)
 }
 
+func UpdateArticleSlugUsecase(bloggingEventCommand command.BloggingEventService) *impl.UpdateArticleSlug {
+	return impl.NewUpdateArticleSlug(bloggingEventCommand)
+}
+
 func UpdateArticleBodyUsecase(bloggingEventCommand command.BloggingEventService) *impl.UpdateArticleBody {
 	return impl.NewUpdateArticleBody(bloggingEventCommand)
 }
@@ -32,16 +40,76 @@ func UpdateArticleThumbnailUsecase(bloggingEventCommand command.BloggingEventSer
 	return impl.NewUpdateArticleThumbnail(bloggingEventCommand)
 }
 
-func AttachTagsUsecase(bloggingEventCommand command.BloggingEventService) *impl.AttachTags {
-	return impl.NewAttachTags(bloggingEventCommand)
+func AttachTagsUsecase(bloggingEventCommand command.BloggingEventService, tagPolicy model.TagPolicy) *impl.AttachTags {
+	return impl.NewAttachTags(bloggingEventCommand, tagPolicy)
+}
+
+func DetachTagsUsecase(bloggingEventCommand command.BloggingEventService, tagPolicy model.TagPolicy) *impl.DetachTags {
+	return impl.NewDetachTags(bloggingEventCommand, tagPolicy)
+}
+
+func HideArticleUsecase(bloggingEventCommand command.BloggingEventService) *impl.HideArticle {
+	return impl.NewHideArticle(bloggingEventCommand)
+}
+
+func UnhideArticleUsecase(bloggingEventCommand command.BloggingEventService) *impl.UnhideArticle {
+	return impl.NewUnhideArticle(bloggingEventCommand)
+}
+
+func EditArticleUsecase(bloggingEventCommand command.BloggingEventService, tagPolicy model.TagPolicy) *impl.EditArticle {
+	return impl.NewEditArticle(bloggingEventCommand, tagPolicy)
+}
+
+func ScheduleArticleUsecase(bloggingEventCommand command.BloggingEventService) *impl.ScheduleArticle {
+	return impl.NewScheduleArticle(bloggingEventCommand)
+}
+
+func PublishArticleUsecase(bloggingEventCommand command.BloggingEventService) *impl.PublishArticle {
+	return impl.NewPublishArticle(bloggingEventCommand)
+}
+
+func RevertArticleUsecase(articleEventQuery query.ArticleEventService, bloggingEventCommand command.BloggingEventService) *impl.RevertArticle {
+	return impl.NewRevertArticle(articleEventQuery, bloggingEventCommand)
+}
+
+func RenameTagUsecase(tagQuery query.TagService, bloggingEventCommand command.BloggingEventService, tagPolicy model.TagPolicy) *impl.RenameTag {
+	return impl.NewRenameTag(tagQuery, bloggingEventCommand, tagPolicy)
+}
+
+func MergeTagsUsecase(tagQuery query.TagService, bloggingEventCommand command.BloggingEventService, tagPolicy model.TagPolicy) *impl.MergeTags {
+	return impl.NewMergeTags(tagQuery, bloggingEventCommand, tagPolicy)
+}
+
+func CreateSeriesUsecase(seriesQuery query.SeriesService, bloggingEventCommand command.BloggingEventService) *impl.CreateSeries {
+	return impl.NewCreateSeries(seriesQuery, bloggingEventCommand, nil)
+}
+
+func AddArticleToSeriesUsecase(seriesQuery query.SeriesService, bloggingEventCommand command.BloggingEventService) *impl.AddArticleToSeries {
+	return impl.NewAddArticleToSeries(seriesQuery, bloggingEventCommand)
+}
+
+func ReorderSeriesUsecase(seriesQuery query.SeriesService, bloggingEventCommand command.BloggingEventService) *impl.ReorderSeries {
+	return impl.NewReorderSeries(seriesQuery, bloggingEventCommand)
+}
+
+func GetDraftUsecase(draftQuery query.DraftService) *impl.GetDraft {
+	return impl.NewGetDraft(draftQuery)
+}
+
+func ListDraftsUsecase(draftQuery query.DraftService) *impl.ListDrafts {
+	return impl.NewListDrafts(draftQuery)
+}
+
+func ListArticleEventsUsecase(articleEventQuery query.ArticleEventService) *impl.ListArticleEvents {
+	return impl.NewListArticleEvents(articleEventQuery)
 }
 
-func DetachTagsUsecase(bloggingEventCommand command.BloggingEventService) *impl.DetachTags {
-	return impl.NewDetachTags(bloggingEventCommand)
+func GetArticleAtUsecase(articleEventQuery query.ArticleEventService) *impl.GetArticleAt {
+	return impl.NewGetArticleAt(articleEventQuery)
 }
 
-func UploadImageUsecase(uploader storage.Uploader) *impl.UploadImage {
-	return impl.NewUploadImage(uploader)
+func UploadImageUsecase(uploader storage.Uploader, imageProcessor imaging.Processor, imageStripper imaging.Stripper, imagePolicy model.ImagePolicy) *impl.UploadImage {
+	return impl.NewUploadImage(uploader, imageProcessor, imageStripper, imagePolicy)
 }
 
 var UsecaseSet = wire.NewSet(
@@ -49,6 +117,8 @@ var UsecaseSet = wire.NewSet(
 	wire.Bind(new(usecase.CreateArticle), new(*impl.CreateArticle)),
 	UpdateArticleTitleUsecase,
 	wire.Bind(new(usecase.UpdateArticleTitle), new(*impl.UpdateArticleTitle)),
+	UpdateArticleSlugUsecase,
+	wire.Bind(new(usecase.UpdateArticleSlug), new(*impl.UpdateArticleSlug)),
 	UpdateArticleBodyUsecase,
 	wire.Bind(new(usecase.UpdateArticleBody), new(*impl.UpdateArticleBody)),
 	UpdateArticleThumbnailUsecase,
@@ -57,6 +127,36 @@ var UsecaseSet = wire.NewSet(
 	wire.Bind(new(usecase.AttachTags), new(*impl.AttachTags)),
 	DetachTagsUsecase,
 	wire.Bind(new(usecase.DetachTags), new(*impl.DetachTags)),
+	HideArticleUsecase,
+	wire.Bind(new(usecase.HideArticle), new(*impl.HideArticle)),
+	UnhideArticleUsecase,
+	wire.Bind(new(usecase.UnhideArticle), new(*impl.UnhideArticle)),
+	EditArticleUsecase,
+	wire.Bind(new(usecase.EditArticle), new(*impl.EditArticle)),
+	ScheduleArticleUsecase,
+	wire.Bind(new(usecase.ScheduleArticle), new(*impl.ScheduleArticle)),
+	PublishArticleUsecase,
+	wire.Bind(new(usecase.PublishArticle), new(*impl.PublishArticle)),
+	RevertArticleUsecase,
+	wire.Bind(new(usecase.RevertArticle), new(*impl.RevertArticle)),
+	RenameTagUsecase,
+	wire.Bind(new(usecase.RenameTag), new(*impl.RenameTag)),
+	MergeTagsUsecase,
+	wire.Bind(new(usecase.MergeTags), new(*impl.MergeTags)),
+	CreateSeriesUsecase,
+	wire.Bind(new(usecase.CreateSeries), new(*impl.CreateSeries)),
+	AddArticleToSeriesUsecase,
+	wire.Bind(new(usecase.AddArticleToSeries), new(*impl.AddArticleToSeries)),
+	ReorderSeriesUsecase,
+	wire.Bind(new(usecase.ReorderSeries), new(*impl.ReorderSeries)),
+	GetDraftUsecase,
+	wire.Bind(new(usecase.GetDraft), new(*impl.GetDraft)),
+	ListDraftsUsecase,
+	wire.Bind(new(usecase.ListDrafts), new(*impl.ListDrafts)),
+	ListArticleEventsUsecase,
+	wire.Bind(new(usecase.ListArticleEvents), new(*impl.ListArticleEvents)),
+	GetArticleAtUsecase,
+	wire.Bind(new(usecase.GetArticleAt), new(*impl.GetArticleAt)),
 	UploadImageUsecase,
 	wire.Bind(new(usecase.UploadImage), new(*impl.UploadImage)),
 )
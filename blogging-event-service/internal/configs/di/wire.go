@@ -12,8 +12,11 @@ func GetDependencies() *Dependencies {
 		provider.AWSSet,
 		provider.NewRelicSet,
 		provider.StorageSet,
+		provider.TagSet,
 		provider.GormSet,
+		provider.LocalSet,
 		provider.CommandSet,
+		provider.QuerySet,
 		provider.PresenterSet,
 		provider.UsecaseSet,
 		provider.BloggingEventServiceServerSet,
@@ -9,7 +9,6 @@ package di
 import (
 	"blogapi.miyamo.today/blogging-event-service/internal/configs/di/provider"
 	"blogapi.miyamo.today/blogging-event-service/internal/if-adapter/presenter/pb"
-	"blogapi.miyamo.today/blogging-event-service/internal/infra/s3"
 )
 
 // Injectors from wire.go:
@@ -17,19 +16,46 @@ import (
 func GetDependencies() *Dependencies {
 	config := provider.AWSConfig()
 	application := provider.NewRelic()
-	bloggingEventCommandService := provider.BloggingEventCommandService()
-	createArticle := provider.CreateArticleUsecase(bloggingEventCommandService)
+	store := provider.LocalEventStore()
+	bloggingEventService := provider.BloggingEventCommandService(store)
+	tagPolicy := provider.TagPolicy()
+	createArticle := provider.CreateArticleUsecase(bloggingEventService, tagPolicy)
 	converter := pb.NewConverter()
-	updateArticleTitle := provider.UpdateArticleTitleUsecase(bloggingEventCommandService)
-	updateArticleBody := provider.UpdateArticleBodyUsecase(bloggingEventCommandService)
-	updateArticleThumbnail := provider.UpdateArticleThumbnailUsecase(bloggingEventCommandService)
-	attachTags := provider.AttachTagsUsecase(bloggingEventCommandService)
-	detachTags := provider.DetachTagsUsecase(bloggingEventCommandService)
-	client := provider.S3Client(config)
-	uploader := s3.NewUploader(client)
-	uploadImage := provider.UploadImageUsecase(uploader)
-	bloggingEventServiceServer := provider.NewBloggingEventServiceServer(createArticle, converter, updateArticleTitle, converter, updateArticleBody, converter, updateArticleThumbnail, converter, attachTags, converter, detachTags, converter, uploadImage, converter)
-	echo := provider.Echo(bloggingEventServiceServer, application)
+	updateArticleTitle := provider.UpdateArticleTitleUsecase(bloggingEventService)
+	updateArticleSlug := provider.UpdateArticleSlugUsecase(bloggingEventService)
+	updateArticleBody := provider.UpdateArticleBodyUsecase(bloggingEventService)
+	updateArticleThumbnail := provider.UpdateArticleThumbnailUsecase(bloggingEventService)
+	attachTags := provider.AttachTagsUsecase(bloggingEventService, tagPolicy)
+	detachTags := provider.DetachTagsUsecase(bloggingEventService, tagPolicy)
+	hideArticle := provider.HideArticleUsecase(bloggingEventService)
+	unhideArticle := provider.UnhideArticleUsecase(bloggingEventService)
+	editArticle := provider.EditArticleUsecase(bloggingEventService, tagPolicy)
+	scheduleArticle := provider.ScheduleArticleUsecase(bloggingEventService)
+	publishArticle := provider.PublishArticleUsecase(bloggingEventService)
+	articleEventService := provider.ArticleEventQueryService(store)
+	revertArticle := provider.RevertArticleUsecase(articleEventService, bloggingEventService)
+	client := provider.DynamoDBClient(config)
+	tagService := provider.TagQueryService(store, client)
+	renameTag := provider.RenameTagUsecase(tagService, bloggingEventService, tagPolicy)
+	mergeTags := provider.MergeTagsUsecase(tagService, bloggingEventService, tagPolicy)
+	seriesService := provider.SeriesQueryService(store, client)
+	createSeries := provider.CreateSeriesUsecase(seriesService, bloggingEventService)
+	addArticleToSeries := provider.AddArticleToSeriesUsecase(seriesService, bloggingEventService)
+	reorderSeries := provider.ReorderSeriesUsecase(seriesService, bloggingEventService)
+	draftService := provider.DraftQueryService(store)
+	getDraft := provider.GetDraftUsecase(draftService)
+	listDrafts := provider.ListDraftsUsecase(draftService)
+	listArticleEvents := provider.ListArticleEventsUsecase(articleEventService)
+	getArticleAt := provider.GetArticleAtUsecase(articleEventService)
+	s3Client := provider.S3Client(config)
+	filesystemUploader := provider.FilesystemUploader()
+	uploader := provider.Uploader(s3Client, filesystemUploader)
+	processor := provider.ImageProcessor()
+	stripper := provider.ImageStripper()
+	imagePolicy := provider.ImagePolicy()
+	uploadImage := provider.UploadImageUsecase(uploader, processor, stripper, imagePolicy)
+	bloggingEventServiceServer := provider.NewBloggingEventServiceServer(createArticle, converter, updateArticleTitle, converter, updateArticleSlug, converter, updateArticleBody, converter, updateArticleThumbnail, converter, attachTags, converter, detachTags, converter, hideArticle, converter, unhideArticle, converter, editArticle, converter, scheduleArticle, converter, publishArticle, converter, revertArticle, converter, renameTag, converter, mergeTags, converter, createSeries, converter, addArticleToSeries, converter, reorderSeries, converter, getDraft, converter, listDrafts, converter, listArticleEvents, converter, getArticleAt, converter, uploadImage, converter)
+	echo := provider.Echo(bloggingEventServiceServer, application, filesystemUploader)
 	dialector := provider.GormDialector(config)
 	dependencies := NewDependencies(config, application, echo, dialector)
 	return dependencies
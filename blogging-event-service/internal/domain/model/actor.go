@@ -0,0 +1,48 @@
+package model
+
+import "context"
+
+// Actor is the user who made a request.
+type Actor struct {
+	subject  string
+	username string
+}
+
+// Subject returns the subject of the user's token, which identifies the user.
+func (a Actor) Subject() string {
+	return a.subject
+}
+
+// Username returns the username of the user. It is empty if the token does not carry it.
+func (a Actor) Username() string {
+	return a.username
+}
+
+// Name returns the name events record the user under: the username, or the subject if the token has no username.
+func (a Actor) Name() string {
+	if a.username != "" {
+		return a.username
+	}
+	return a.subject
+}
+
+// NewActor is constructor of Actor.
+func NewActor(subject, username string) Actor {
+	return Actor{
+		subject:  subject,
+		username: username,
+	}
+}
+
+type actorContextKey struct{}
+
+// ContextWithActor returns a copy of ctx that carries the user who made the request.
+func ContextWithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the user who made the request, if any.
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorContextKey{}).(Actor)
+	return actor, ok
+}
@@ -0,0 +1,274 @@
+package model
+
+import (
+	"blogapi.miyamo.today/core/article"
+	"time"
+)
+
+// ArticleEventType is the kind of change a blogging event made to an article.
+type ArticleEventType string
+
+const (
+	ArticleEventTypeCreateArticle   ArticleEventType = "CREATE_ARTICLE"
+	ArticleEventTypeUpdateTitle     ArticleEventType = "UPDATE_TITLE"
+	ArticleEventTypeUpdateBody      ArticleEventType = "UPDATE_BODY"
+	ArticleEventTypeUpdateThumbnail ArticleEventType = "UPDATE_THUMBNAIL"
+	ArticleEventTypeUpdateSlug      ArticleEventType = "UPDATE_SLUG"
+	ArticleEventTypeAttachTags      ArticleEventType = "ATTACH_TAGS"
+	ArticleEventTypeDetachTags      ArticleEventType = "DETACH_TAGS"
+	ArticleEventTypeHideArticle     ArticleEventType = "HIDE_ARTICLE"
+	ArticleEventTypeUnhideArticle   ArticleEventType = "UNHIDE_ARTICLE"
+	ArticleEventTypeEditArticle     ArticleEventType = "EDIT_ARTICLE"
+	ArticleEventTypeScheduleArticle ArticleEventType = "SCHEDULE_ARTICLE"
+	ArticleEventTypePublishArticle  ArticleEventType = "PUBLISH_ARTICLE"
+	ArticleEventTypeRevertArticle   ArticleEventType = "REVERT_ARTICLE"
+	ArticleEventTypeRenameTag       ArticleEventType = "RENAME_TAG"
+	ArticleEventTypeMergeTags       ArticleEventType = "MERGE_TAGS"
+	ArticleEventTypeAddToSeries     ArticleEventType = "ADD_TO_SERIES"
+	ArticleEventTypeReorderSeries   ArticleEventType = "REORDER_SERIES"
+)
+
+// ArticleEvent is an entry of the history of an article.
+// Fields the event did not change are nil or empty.
+type ArticleEvent struct {
+	eventID           string
+	eventType         ArticleEventType
+	occurredAt        time.Time
+	title             *string
+	content           *string
+	thumbnail         *string
+	thumbnailVariants []article.ImageVariant
+	tags              []string
+	attachTags        []string
+	detachTags        []string
+	invisible         *bool
+	publishAt         *time.Time
+	draft             *bool
+	actor             string
+	revertedTo        string
+	slug              *string
+	series            *ArticleSeries
+}
+
+// EventID returns the event id.
+func (e ArticleEvent) EventID() string {
+	return e.eventID
+}
+
+// EventType returns the kind of the event.
+func (e ArticleEvent) EventType() ArticleEventType {
+	return e.eventType
+}
+
+// OccurredAt returns the time the event was written.
+func (e ArticleEvent) OccurredAt() time.Time {
+	return e.occurredAt
+}
+
+// Title returns the title set by the event.
+func (e ArticleEvent) Title() *string {
+	return e.title
+}
+
+// Content returns the body set by the event.
+func (e ArticleEvent) Content() *string {
+	return e.content
+}
+
+// Thumbnail returns the thumbnail URL set by the event.
+func (e ArticleEvent) Thumbnail() *string {
+	return e.thumbnail
+}
+
+// ThumbnailVariants returns the variants of the thumbnail set by the event.
+func (e ArticleEvent) ThumbnailVariants() []article.ImageVariant {
+	return e.thumbnailVariants
+}
+
+// Tags returns the tag names the article was created with.
+func (e ArticleEvent) Tags() []string {
+	return e.tags
+}
+
+// AttachTags returns the tag names attached by the event.
+func (e ArticleEvent) AttachTags() []string {
+	return e.attachTags
+}
+
+// DetachTags returns the tag names detached by the event.
+func (e ArticleEvent) DetachTags() []string {
+	return e.detachTags
+}
+
+// Invisible returns the visibility set by the event.
+func (e ArticleEvent) Invisible() *bool {
+	return e.invisible
+}
+
+// PublishAt returns the publication time set by the event.
+func (e ArticleEvent) PublishAt() *time.Time {
+	return e.publishAt
+}
+
+// Draft returns the draft state set by the event.
+func (e ArticleEvent) Draft() *bool {
+	return e.draft
+}
+
+// Actor returns who wrote the event. It is empty if the event does not record it.
+func (e ArticleEvent) Actor() string {
+	return e.actor
+}
+
+// RevertedTo returns the id of the event the article was restored to. It is empty unless the event is a revert.
+func (e ArticleEvent) RevertedTo() string {
+	return e.revertedTo
+}
+
+// Slug returns the slug set by the event.
+func (e ArticleEvent) Slug() *string {
+	return e.slug
+}
+
+// Series returns the series and the position the event put the article at.
+func (e ArticleEvent) Series() *ArticleSeries {
+	return e.series
+}
+
+// SeriesID returns the id of the series the event put the article in.
+func (e ArticleEvent) SeriesID() *string {
+	if e.series == nil {
+		return nil
+	}
+	return &e.series.id
+}
+
+// SeriesTitle returns the title of the series the event put the article in.
+func (e ArticleEvent) SeriesTitle() *string {
+	if e.series == nil {
+		return nil
+	}
+	return &e.series.title
+}
+
+// SeriesPosition returns the position in the series the event put the article at.
+func (e ArticleEvent) SeriesPosition() *int {
+	if e.series == nil {
+		return nil
+	}
+	return &e.series.position
+}
+
+// ArticleEventOption is the option of NewArticleEvent.
+type ArticleEventOption func(*ArticleEvent)
+
+// ArticleEventWithTitle sets the title set by the event.
+func ArticleEventWithTitle(title string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.title = &title
+	}
+}
+
+// ArticleEventWithContent sets the content set by the event.
+func ArticleEventWithContent(content string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.content = &content
+	}
+}
+
+// ArticleEventWithThumbnail sets the thumbnail set by the event.
+func ArticleEventWithThumbnail(thumbnail string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.thumbnail = &thumbnail
+	}
+}
+
+// ArticleEventWithThumbnailVariants sets the variants of the thumbnail set by the event.
+func ArticleEventWithThumbnailVariants(thumbnailVariants ...article.ImageVariant) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.thumbnailVariants = thumbnailVariants
+	}
+}
+
+// ArticleEventWithTags sets the tags set by the event.
+func ArticleEventWithTags(tags ...string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.tags = tags
+	}
+}
+
+// ArticleEventWithAttachTags sets the tags attached by the event.
+func ArticleEventWithAttachTags(attachTags ...string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.attachTags = attachTags
+	}
+}
+
+// ArticleEventWithDetachTags sets the tags detached by the event.
+func ArticleEventWithDetachTags(detachTags ...string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.detachTags = detachTags
+	}
+}
+
+// ArticleEventWithInvisible sets the visibility set by the event.
+func ArticleEventWithInvisible(invisible bool) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.invisible = &invisible
+	}
+}
+
+// ArticleEventWithPublishAt sets the publication time set by the event.
+func ArticleEventWithPublishAt(publishAt time.Time) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.publishAt = &publishAt
+	}
+}
+
+// ArticleEventWithDraft sets the draft state set by the event.
+func ArticleEventWithDraft(draft bool) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.draft = &draft
+	}
+}
+
+// ArticleEventWithActor sets who wrote the event.
+func ArticleEventWithActor(actor string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.actor = actor
+	}
+}
+
+// ArticleEventWithRevertedTo sets the id of the event the article was restored to.
+func ArticleEventWithRevertedTo(revertedTo string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.revertedTo = revertedTo
+	}
+}
+
+// ArticleEventWithSlug sets the slug set by the event.
+func ArticleEventWithSlug(slug string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.slug = &slug
+	}
+}
+
+// ArticleEventWithSeries sets the series the event put the article in.
+func ArticleEventWithSeries(series ArticleSeries) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.series = &series
+	}
+}
+
+// NewArticleEvent creates a new ArticleEvent.
+func NewArticleEvent(eventID string, eventType ArticleEventType, occurredAt time.Time, options ...ArticleEventOption) ArticleEvent {
+	e := ArticleEvent{
+		eventID:    eventID,
+		eventType:  eventType,
+		occurredAt: occurredAt,
+	}
+	for _, option := range options {
+		option(&e)
+	}
+	return e
+}
@@ -1,12 +1,22 @@
 package model
 
-import "net/url"
+import (
+	"blogapi.miyamo.today/core/article"
+	"github.com/cockroachdb/errors"
+	"net/url"
+	"slices"
+	"time"
+)
 
 type CreateArticleEvent struct {
-	title     string
-	content   string
-	thumbnail string
-	tags      []string
+	title             string
+	content           string
+	thumbnail         string
+	thumbnailVariants []article.ImageVariant
+	tags              []string
+	publishAt         time.Time
+	draft             bool
+	slug              string
 }
 
 func (c CreateArticleEvent) Title() string {
@@ -21,22 +31,61 @@ func (c CreateArticleEvent) Thumbnail() string {
 	return c.thumbnail
 }
 
+// ThumbnailVariants returns the resized and re-encoded variants of the thumbnail, such as the sources of a srcset.
+func (c CreateArticleEvent) ThumbnailVariants() []article.ImageVariant {
+	return c.thumbnailVariants
+}
+
 func (c CreateArticleEvent) Tags() []string {
 	return c.tags
 }
 
-func NewCreateArticleEvent(title, content, thumbnail string, tags []string) CreateArticleEvent {
+// PublishAt returns the time the article goes live. The zero value publishes the article immediately.
+func (c CreateArticleEvent) PublishAt() time.Time {
+	return c.publishAt
+}
+
+// Draft reports whether the article is created as a draft. A draft is kept out of the read models until it is published.
+func (c CreateArticleEvent) Draft() bool {
+	return c.draft
+}
+
+// Slug returns the slug the article is addressed by. It is empty if the article is created without one.
+func (c CreateArticleEvent) Slug() string {
+	return c.slug
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (c CreateArticleEvent) Validate() error {
+	if c.title == "" {
+		return errors.Wrap(ErrValidation, "title is required")
+	}
+	if err := validateImageVariants("thumbnailVariants", c.thumbnailVariants); err != nil {
+		return err
+	}
+	if c.slug != "" {
+		return ValidateSlug(c.slug)
+	}
+	return nil
+}
+
+func NewCreateArticleEvent(title, content, thumbnail string, thumbnailVariants []article.ImageVariant, tags []string, publishAt time.Time, draft bool, slug string) CreateArticleEvent {
 	return CreateArticleEvent{
-		title:     title,
-		content:   content,
-		thumbnail: thumbnail,
-		tags:      tags,
+		title:             title,
+		content:           content,
+		thumbnail:         thumbnail,
+		thumbnailVariants: thumbnailVariants,
+		tags:              tags,
+		publishAt:         publishAt,
+		draft:             draft,
+		slug:              slug,
 	}
 }
 
 type UpdateArticleTitleEvent struct {
-	articleID string
-	title     string
+	articleID           string
+	title               string
+	expectedLastEventID string
 }
 
 func (u UpdateArticleTitleEvent) ArticleID() string {
@@ -47,17 +96,35 @@ func (u UpdateArticleTitleEvent) Title() string {
 	return u.title
 }
 
-func NewUpdateArticleTitleEvent(articleID, title string) UpdateArticleTitleEvent {
+// ExpectedLastEventID returns the expected last event id. An empty value skips the concurrency check.
+func (u UpdateArticleTitleEvent) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (u UpdateArticleTitleEvent) Validate() error {
+	if u.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	if u.title == "" {
+		return errors.Wrap(ErrValidation, "title is required")
+	}
+	return nil
+}
+
+func NewUpdateArticleTitleEvent(articleID, title, expectedLastEventID string) UpdateArticleTitleEvent {
 	return UpdateArticleTitleEvent{
-		articleID: articleID,
-		title:     title,
+		articleID:           articleID,
+		title:               title,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
 // UpdateArticleBodyEvent is an event to update the article body.
 type UpdateArticleBodyEvent struct {
-	articleID string
-	body      string
+	articleID           string
+	body                string
+	expectedLastEventID string
 }
 
 // ArticleID returns the article id.
@@ -70,18 +137,35 @@ func (u UpdateArticleBodyEvent) Body() string {
 	return u.body
 }
 
+// ExpectedLastEventID returns the expected last event id. An empty value skips the concurrency check.
+func (u UpdateArticleBodyEvent) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (u UpdateArticleBodyEvent) Validate() error {
+	if u.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	return nil
+}
+
 // NewUpdateArticleBodyEvent creates a new UpdateArticleBodyEvent.
-func NewUpdateArticleBodyEvent(id string, body string) UpdateArticleBodyEvent {
+func NewUpdateArticleBodyEvent(id, body, expectedLastEventID string) UpdateArticleBodyEvent {
 	return UpdateArticleBodyEvent{
-		articleID: id,
-		body:      body,
+		articleID:           id,
+		body:                body,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
 // UpdateArticleThumbnailEvent is an event to update the article thumbnail.
+// The variants of the former thumbnail are replaced with those given with the new one.
 type UpdateArticleThumbnailEvent struct {
-	articleID string
-	thumbnail url.URL
+	articleID           string
+	thumbnail           url.URL
+	thumbnailVariants   []article.ImageVariant
+	expectedLastEventID string
 }
 
 // ArticleID returns the article id.
@@ -94,18 +178,79 @@ func (u UpdateArticleThumbnailEvent) Thumbnail() url.URL {
 	return u.thumbnail
 }
 
+// ThumbnailVariants returns the resized and re-encoded variants of the thumbnail, such as the sources of a srcset.
+func (u UpdateArticleThumbnailEvent) ThumbnailVariants() []article.ImageVariant {
+	return u.thumbnailVariants
+}
+
+// ExpectedLastEventID returns the expected last event id. An empty value skips the concurrency check.
+func (u UpdateArticleThumbnailEvent) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (u UpdateArticleThumbnailEvent) Validate() error {
+	if u.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	return validateImageVariants("thumbnailVariants", u.thumbnailVariants)
+}
+
 // NewUpdateArticleThumbnailEvent creates a new UpdateArticleThumbnailEvent.
-func NewUpdateArticleThumbnailEvent(id string, thumbnail url.URL) UpdateArticleThumbnailEvent {
+func NewUpdateArticleThumbnailEvent(id string, thumbnail url.URL, thumbnailVariants []article.ImageVariant, expectedLastEventID string) UpdateArticleThumbnailEvent {
 	return UpdateArticleThumbnailEvent{
-		articleID: id,
-		thumbnail: thumbnail,
+		articleID:           id,
+		thumbnail:           thumbnail,
+		thumbnailVariants:   thumbnailVariants,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// UpdateArticleSlugEvent is an event to change the slug the article is addressed by.
+// The former slug stays with the article, so that it can be redirected to the new one.
+type UpdateArticleSlugEvent struct {
+	articleID           string
+	slug                string
+	expectedLastEventID string
+}
+
+// ArticleID returns the article id.
+func (u UpdateArticleSlugEvent) ArticleID() string {
+	return u.articleID
+}
+
+// Slug returns the new slug.
+func (u UpdateArticleSlugEvent) Slug() string {
+	return u.slug
+}
+
+// ExpectedLastEventID returns the expected last event id. An empty value skips the concurrency check.
+func (u UpdateArticleSlugEvent) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (u UpdateArticleSlugEvent) Validate() error {
+	if u.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	return ValidateSlug(u.slug)
+}
+
+// NewUpdateArticleSlugEvent creates a new UpdateArticleSlugEvent.
+func NewUpdateArticleSlugEvent(articleID, slug, expectedLastEventID string) UpdateArticleSlugEvent {
+	return UpdateArticleSlugEvent{
+		articleID:           articleID,
+		slug:                slug,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
 // AttachTagsEvent is an event to attach tags to the article.
 type AttachTagsEvent struct {
-	articleID string
-	tags      []string
+	articleID           string
+	tags                []string
+	expectedLastEventID string
 }
 
 // ArticleID returns the article id.
@@ -118,18 +263,33 @@ func (a AttachTagsEvent) Tags() []string {
 	return a.tags
 }
 
+// ExpectedLastEventID returns the expected last event id. An empty value skips the concurrency check.
+func (a AttachTagsEvent) ExpectedLastEventID() string {
+	return a.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (a AttachTagsEvent) Validate() error {
+	if a.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	return validateTagNames(a.tags)
+}
+
 // NewAttachTagsEvent creates a new AttachTagsEvent.
-func NewAttachTagsEvent(articleID string, tags []string) AttachTagsEvent {
+func NewAttachTagsEvent(articleID string, tags []string, expectedLastEventID string) AttachTagsEvent {
 	return AttachTagsEvent{
-		articleID: articleID,
-		tags:      tags,
+		articleID:           articleID,
+		tags:                tags,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
 // DetachTagsEvent is an event to detach tags from the article.
 type DetachTagsEvent struct {
-	articleID string
-	tags      []string
+	articleID           string
+	tags                []string
+	expectedLastEventID string
 }
 
 // ArticleID returns the article id.
@@ -142,14 +302,484 @@ func (d DetachTagsEvent) Tags() []string {
 	return d.tags
 }
 
+// ExpectedLastEventID returns the expected last event id. An empty value skips the concurrency check.
+func (d DetachTagsEvent) ExpectedLastEventID() string {
+	return d.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (d DetachTagsEvent) Validate() error {
+	if d.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	return validateTagNames(d.tags)
+}
+
 // NewDetachTagsEvent creates a new DetachTagsEvent.
-func NewDetachTagsEvent(articleID string, tags []string) DetachTagsEvent {
+func NewDetachTagsEvent(articleID string, tags []string, expectedLastEventID string) DetachTagsEvent {
 	return DetachTagsEvent{
-		articleID: articleID,
-		tags:      tags,
+		articleID:           articleID,
+		tags:                tags,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
+// HideArticleEvent is an event to hide the article.
+type HideArticleEvent struct {
+	articleID           string
+	expectedLastEventID string
+}
+
+// ArticleID returns the article id.
+func (h HideArticleEvent) ArticleID() string {
+	return h.articleID
+}
+
+// ExpectedLastEventID returns the expected last event id. An empty value skips the concurrency check.
+func (h HideArticleEvent) ExpectedLastEventID() string {
+	return h.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (h HideArticleEvent) Validate() error {
+	if h.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	return nil
+}
+
+// NewHideArticleEvent creates a new HideArticleEvent.
+func NewHideArticleEvent(articleID, expectedLastEventID string) HideArticleEvent {
+	return HideArticleEvent{
+		articleID:           articleID,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// UnhideArticleEvent is an event to make the hidden article visible again.
+type UnhideArticleEvent struct {
+	articleID           string
+	expectedLastEventID string
+}
+
+// ArticleID returns the article id.
+func (u UnhideArticleEvent) ArticleID() string {
+	return u.articleID
+}
+
+// ExpectedLastEventID returns the expected last event id. An empty value skips the concurrency check.
+func (u UnhideArticleEvent) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (u UnhideArticleEvent) Validate() error {
+	if u.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	return nil
+}
+
+// NewUnhideArticleEvent creates a new UnhideArticleEvent.
+func NewUnhideArticleEvent(articleID, expectedLastEventID string) UnhideArticleEvent {
+	return UnhideArticleEvent{
+		articleID:           articleID,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// EditArticleEvent is an event to change several fields of the article at once.
+// Fields with nil or empty values are left unchanged.
+type EditArticleEvent struct {
+	articleID           string
+	title               *string
+	body                *string
+	thumbnail           *url.URL
+	thumbnailVariants   []article.ImageVariant
+	attachTags          []string
+	detachTags          []string
+	expectedLastEventID string
+}
+
+// ArticleID returns the article id.
+func (e EditArticleEvent) ArticleID() string {
+	return e.articleID
+}
+
+// Title returns the new article title, or nil if the title is unchanged.
+func (e EditArticleEvent) Title() *string {
+	return e.title
+}
+
+// Body returns the new article body, or nil if the body is unchanged.
+func (e EditArticleEvent) Body() *string {
+	return e.body
+}
+
+// Thumbnail returns the new article thumbnail, or nil if the thumbnail is unchanged.
+func (e EditArticleEvent) Thumbnail() *url.URL {
+	return e.thumbnail
+}
+
+// ThumbnailVariants returns the variants of the new article thumbnail. They are only given with a new thumbnail.
+func (e EditArticleEvent) ThumbnailVariants() []article.ImageVariant {
+	return e.thumbnailVariants
+}
+
+// AttachTags returns the tag names to attach.
+func (e EditArticleEvent) AttachTags() []string {
+	return e.attachTags
+}
+
+// DetachTags returns the tag names to detach.
+func (e EditArticleEvent) DetachTags() []string {
+	return e.detachTags
+}
+
+// ExpectedLastEventID returns the expected last event id. An empty value skips the concurrency check.
+func (e EditArticleEvent) ExpectedLastEventID() string {
+	return e.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (e EditArticleEvent) Validate() error {
+	if e.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	if e.title == nil && e.body == nil && e.thumbnail == nil && len(e.attachTags) == 0 && len(e.detachTags) == 0 {
+		return errors.Wrap(ErrValidation, "at least one field to edit is required")
+	}
+	if e.title != nil && *e.title == "" {
+		return errors.Wrap(ErrValidation, "title must not be empty")
+	}
+	if e.thumbnail == nil && len(e.thumbnailVariants) > 0 {
+		return errors.WithStack(NewValidationError(NewFieldViolation("thumbnailVariants", "thumbnail variants require a new thumbnail")))
+	}
+	if err := validateImageVariants("thumbnailVariants", e.thumbnailVariants); err != nil {
+		return err
+	}
+	for _, tags := range [][]string{e.attachTags, e.detachTags} {
+		if len(tags) == 0 {
+			continue
+		}
+		if err := validateTagNames(tags); err != nil {
+			return err
+		}
+	}
+	for _, tag := range e.attachTags {
+		if slices.Contains(e.detachTags, tag) {
+			return errors.Wrapf(ErrValidation, "tag %q is both attached and detached", tag)
+		}
+	}
+	return nil
+}
+
+// NewEditArticleEvent creates a new EditArticleEvent.
+func NewEditArticleEvent(articleID string, title, body *string, thumbnail *url.URL, thumbnailVariants []article.ImageVariant, attachTags, detachTags []string, expectedLastEventID string) EditArticleEvent {
+	return EditArticleEvent{
+		articleID:           articleID,
+		title:               title,
+		body:                body,
+		thumbnail:           thumbnail,
+		thumbnailVariants:   thumbnailVariants,
+		attachTags:          attachTags,
+		detachTags:          detachTags,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// ScheduleArticleEvent is an event to publish the article at the given time.
+// Until then, the article is kept out of the read models.
+type ScheduleArticleEvent struct {
+	articleID           string
+	publishAt           time.Time
+	expectedLastEventID string
+}
+
+// ArticleID returns the article id.
+func (s ScheduleArticleEvent) ArticleID() string {
+	return s.articleID
+}
+
+// PublishAt returns the time the article goes live.
+func (s ScheduleArticleEvent) PublishAt() time.Time {
+	return s.publishAt
+}
+
+// ExpectedLastEventID returns the expected last event id. An empty value skips the concurrency check.
+func (s ScheduleArticleEvent) ExpectedLastEventID() string {
+	return s.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (s ScheduleArticleEvent) Validate() error {
+	if s.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	if s.publishAt.IsZero() {
+		return errors.Wrap(ErrValidation, "publish at is required")
+	}
+	return nil
+}
+
+// NewScheduleArticleEvent creates a new ScheduleArticleEvent.
+func NewScheduleArticleEvent(articleID string, publishAt time.Time, expectedLastEventID string) ScheduleArticleEvent {
+	return ScheduleArticleEvent{
+		articleID:           articleID,
+		publishAt:           publishAt,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// PublishArticleEvent is an event to publish the draft article.
+type PublishArticleEvent struct {
+	articleID           string
+	expectedLastEventID string
+}
+
+// ArticleID returns the article id.
+func (p PublishArticleEvent) ArticleID() string {
+	return p.articleID
+}
+
+// ExpectedLastEventID returns the expected last event id. An empty value skips the concurrency check.
+func (p PublishArticleEvent) ExpectedLastEventID() string {
+	return p.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (p PublishArticleEvent) Validate() error {
+	if p.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	return nil
+}
+
+// NewPublishArticleEvent creates a new PublishArticleEvent.
+func NewPublishArticleEvent(articleID, expectedLastEventID string) PublishArticleEvent {
+	return PublishArticleEvent{
+		articleID:           articleID,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// RevertArticleEvent restores the title, body, thumbnail with its variants and tags of an article as they were right after an earlier event.
+// It is appended as a new event, so the stream stays append-only.
+type RevertArticleEvent struct {
+	articleID           string
+	toEventID           string
+	title               string
+	body                string
+	thumbnail           string
+	thumbnailVariants   []article.ImageVariant
+	attachTags          []string
+	detachTags          []string
+	expectedLastEventID string
+}
+
+// ArticleID returns the article id.
+func (r RevertArticleEvent) ArticleID() string {
+	return r.articleID
+}
+
+// ToEventID returns the id of the event the article is restored to.
+func (r RevertArticleEvent) ToEventID() string {
+	return r.toEventID
+}
+
+// Title returns the restored title.
+func (r RevertArticleEvent) Title() string {
+	return r.title
+}
+
+// Body returns the restored body.
+func (r RevertArticleEvent) Body() string {
+	return r.body
+}
+
+// Thumbnail returns the restored thumbnail url.
+func (r RevertArticleEvent) Thumbnail() string {
+	return r.thumbnail
+}
+
+// ThumbnailVariants returns the restored variants of the thumbnail.
+func (r RevertArticleEvent) ThumbnailVariants() []article.ImageVariant {
+	return r.thumbnailVariants
+}
+
+// AttachTags returns the tag names to attach to restore the tag set.
+func (r RevertArticleEvent) AttachTags() []string {
+	return r.attachTags
+}
+
+// DetachTags returns the tag names to detach to restore the tag set.
+func (r RevertArticleEvent) DetachTags() []string {
+	return r.detachTags
+}
+
+// ExpectedLastEventID returns the last event of the stream the revert was computed from.
+func (r RevertArticleEvent) ExpectedLastEventID() string {
+	return r.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (r RevertArticleEvent) Validate() error {
+	if r.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	if r.toEventID == "" {
+		return errors.Wrap(ErrValidation, "event id to revert to is required")
+	}
+	return nil
+}
+
+// NewRevertArticleEvent creates a new RevertArticleEvent from the events of the article, oldest first.
+// It returns ErrNotFound if toEventID is not an event of the article.
+func NewRevertArticleEvent(articleID, toEventID string, events []*ArticleEvent) (RevertArticleEvent, error) {
+	i := slices.IndexFunc(events, func(e *ArticleEvent) bool {
+		return e.EventID() == toEventID
+	})
+	if i < 0 {
+		return RevertArticleEvent{}, errors.Wrapf(ErrNotFound, "event %q of the article", toEventID)
+	}
+	target := article.Project(events[:i+1])
+	current := article.Project(events)
+	return RevertArticleEvent{
+		articleID:         articleID,
+		toEventID:         toEventID,
+		title:             target.Title(),
+		body:              target.Body(),
+		thumbnail:         target.Thumbnail(),
+		thumbnailVariants: target.ThumbnailVariants(),
+		attachTags: slices.DeleteFunc(slices.Clone(target.TagNames()), func(v string) bool {
+			return slices.Contains(current.TagNames(), v)
+		}),
+		detachTags: slices.DeleteFunc(slices.Clone(current.TagNames()), func(v string) bool {
+			return slices.Contains(target.TagNames(), v)
+		}),
+		expectedLastEventID: events[len(events)-1].EventID(),
+	}, nil
+}
+
+// RenameTagEvent renames a tag on one of the articles it is attached to.
+// A tag is renamed by appending one of these to the stream of every article it is attached to.
+type RenameTagEvent struct {
+	articleID           string
+	from                string
+	to                  string
+	expectedLastEventID string
+}
+
+// ArticleID returns the article id.
+func (r RenameTagEvent) ArticleID() string {
+	return r.articleID
+}
+
+// From returns the current name of the tag.
+func (r RenameTagEvent) From() string {
+	return r.from
+}
+
+// To returns the new name of the tag.
+func (r RenameTagEvent) To() string {
+	return r.to
+}
+
+// ExpectedLastEventID returns the last event of the stream the tags of the article were read from.
+func (r RenameTagEvent) ExpectedLastEventID() string {
+	return r.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (r RenameTagEvent) Validate() error {
+	if r.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	return ValidateTagRename(r.from, r.to)
+}
+
+// NewRenameTagEvent creates a new RenameTagEvent.
+func NewRenameTagEvent(articleID, from, to, expectedLastEventID string) RenameTagEvent {
+	return RenameTagEvent{
+		articleID:           articleID,
+		from:                from,
+		to:                  to,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// MergeTagsEvent replaces the source tags of one of the articles they are attached to with another tag.
+// Tags are merged by appending one of these to the stream of every article any of the sources is attached to.
+type MergeTagsEvent struct {
+	articleID           string
+	sources             []string
+	into                string
+	expectedLastEventID string
+}
+
+// ArticleID returns the article id.
+func (m MergeTagsEvent) ArticleID() string {
+	return m.articleID
+}
+
+// Sources returns the names of the tags merged away.
+func (m MergeTagsEvent) Sources() []string {
+	return m.sources
+}
+
+// Into returns the name of the tag the sources are merged into.
+func (m MergeTagsEvent) Into() string {
+	return m.into
+}
+
+// ExpectedLastEventID returns the last event of the stream the tags of the article were read from.
+func (m MergeTagsEvent) ExpectedLastEventID() string {
+	return m.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (m MergeTagsEvent) Validate() error {
+	if m.articleID == "" {
+		return errors.Wrap(ErrValidation, "article id is required")
+	}
+	return ValidateTagMerge(m.sources, m.into)
+}
+
+// NewMergeTagsEvent creates a new MergeTagsEvent.
+func NewMergeTagsEvent(articleID string, sources []string, into, expectedLastEventID string) MergeTagsEvent {
+	return MergeTagsEvent{
+		articleID:           articleID,
+		sources:             sources,
+		into:                into,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// ValidateTagRename returns ErrValidation if the tag cannot be renamed from one name to the other.
+func ValidateTagRename(from, to string) error {
+	if err := validateTagNames([]string{from, to}); err != nil {
+		return err
+	}
+	if from == to {
+		return errors.Wrapf(ErrValidation, "tag %q is renamed to itself", from)
+	}
+	return nil
+}
+
+// ValidateTagMerge returns ErrValidation if the sources cannot be merged into the tag.
+func ValidateTagMerge(sources []string, into string) error {
+	if err := validateTagNames(sources); err != nil {
+		return err
+	}
+	if into == "" {
+		return errors.Wrap(ErrValidation, "tag name to merge into is required")
+	}
+	if slices.Contains(sources, into) {
+		return errors.Wrapf(ErrValidation, "tag %q is merged into itself", into)
+	}
+	return nil
+}
+
 type BloggingEventKey struct {
 	eventID   string
 	articleID string
@@ -169,3 +799,16 @@ func NewBloggingEventKey(eventID, articleID string) BloggingEventKey {
 		articleID: articleID,
 	}
 }
+
+// validateTagNames returns ErrValidation if tags is empty or has an empty tag name.
+func validateTagNames(tags []string) error {
+	if len(tags) == 0 {
+		return errors.Wrap(ErrValidation, "at least one tag name is required")
+	}
+	for _, tag := range tags {
+		if tag == "" {
+			return errors.Wrap(ErrValidation, "tag name must not be empty")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package model
+
+// Draft is an article which has not been published yet.
+type Draft struct {
+	articleID   string
+	title       string
+	content     string
+	thumbnail   string
+	tags        []string
+	lastEventID string
+}
+
+// ArticleID returns the article id.
+func (d Draft) ArticleID() string {
+	return d.articleID
+}
+
+// Title returns the title of the draft.
+func (d Draft) Title() string {
+	return d.title
+}
+
+// Content returns the body of the draft.
+func (d Draft) Content() string {
+	return d.content
+}
+
+// Thumbnail returns the thumbnail URL of the draft.
+func (d Draft) Thumbnail() string {
+	return d.thumbnail
+}
+
+// Tags returns the tag names of the draft.
+func (d Draft) Tags() []string {
+	return d.tags
+}
+
+// LastEventID returns the id of the latest event of the draft, to be passed as the expected last event id of the next edit.
+func (d Draft) LastEventID() string {
+	return d.lastEventID
+}
+
+// NewDraft creates a new Draft.
+func NewDraft(articleID, title, content, thumbnail string, tags []string, lastEventID string) Draft {
+	return Draft{
+		articleID:   articleID,
+		title:       title,
+		content:     content,
+		thumbnail:   thumbnail,
+		tags:        tags,
+		lastEventID: lastEventID,
+	}
+}
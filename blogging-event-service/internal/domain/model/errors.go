@@ -0,0 +1,77 @@
+package model
+
+import (
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"strings"
+)
+
+// Errors returned from the commands. Use errors.Is to find the kind of the error.
+var (
+	// ErrValidation is returned when the command has an invalid value.
+	ErrValidation = errors.New("invalid command")
+	// ErrNotFound is returned when the article does not exist or is hidden.
+	ErrNotFound = errors.New("article not found")
+	// ErrConflict is returned when the article's event stream has moved past the expected last event.
+	ErrConflict = errors.New("article event stream has moved past the expected last event")
+	// ErrUnavailable is returned when the event store is temporarily unavailable and the command may be retried.
+	ErrUnavailable = errors.New("event store is unavailable")
+	// ErrIdempotencyKeyReused is returned when the idempotency key was already used for a request with a different payload.
+	ErrIdempotencyKeyReused = errors.New("idempotency key was already used for a different request")
+	// ErrInternal is returned when the command fails with an unexpected error.
+	ErrInternal = errors.New("internal error")
+)
+
+// FieldViolation tells why a field of a command is invalid.
+type FieldViolation struct {
+	field       string
+	description string
+}
+
+// Field returns the name of the invalid field, with the index of the element for a list, e.g. "tagNames[1]".
+func (v FieldViolation) Field() string {
+	return v.field
+}
+
+// Description returns why the field is invalid.
+func (v FieldViolation) Description() string {
+	return v.description
+}
+
+// NewFieldViolation is constructor of FieldViolation.
+func NewFieldViolation(field, description string) FieldViolation {
+	return FieldViolation{
+		field:       field,
+		description: description,
+	}
+}
+
+// ValidationError is an ErrValidation telling which fields of the command are invalid.
+type ValidationError struct {
+	violations []FieldViolation
+}
+
+// Violations returns the violations of the fields.
+func (e *ValidationError) Violations() []FieldViolation {
+	return e.violations
+}
+
+func (e *ValidationError) Error() string {
+	descriptions := make([]string, 0, len(e.violations))
+	for _, v := range e.violations {
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s", v.field, v.description))
+	}
+	return fmt.Sprintf("%s: %s", ErrValidation, strings.Join(descriptions, "; "))
+}
+
+// Unwrap returns ErrValidation, so that errors.Is tells the kind of the error.
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+// NewValidationError is constructor of ValidationError.
+func NewValidationError(violations ...FieldViolation) *ValidationError {
+	return &ValidationError{
+		violations: violations,
+	}
+}
@@ -0,0 +1,84 @@
+package model
+
+import "context"
+
+// IdempotencyKey identifies a mutation request of a caller.
+// A request replayed with the same key gets the result of the first one, as long as the payload is the same.
+type IdempotencyKey struct {
+	caller      string
+	key         string
+	fingerprint string
+}
+
+// Caller returns the caller who issued the key.
+func (k IdempotencyKey) Caller() string {
+	return k.caller
+}
+
+// Key returns the key chosen by the caller.
+func (k IdempotencyKey) Key() string {
+	return k.key
+}
+
+// Fingerprint returns the digest of the request payload.
+func (k IdempotencyKey) Fingerprint() string {
+	return k.fingerprint
+}
+
+// NewIdempotencyKey is constructor of IdempotencyKey.
+func NewIdempotencyKey(caller, key, fingerprint string) IdempotencyKey {
+	return IdempotencyKey{
+		caller:      caller,
+		key:         key,
+		fingerprint: fingerprint,
+	}
+}
+
+type idempotencyKeyContextKey struct{}
+
+// ContextWithIdempotencyKey returns a copy of ctx that carries the idempotency key of the request.
+func ContextWithIdempotencyKey(ctx context.Context, key IdempotencyKey) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key of the request, if any.
+func IdempotencyKeyFromContext(ctx context.Context) (IdempotencyKey, bool) {
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(IdempotencyKey)
+	return key, ok
+}
+
+// ContextWithArticleIdempotencyKey returns a copy of ctx whose idempotency key, if any, is derived from the key of the request and the article.
+// A request written as an event per article records each of them under its own key, so that a retry only writes the ones not written yet.
+func ContextWithArticleIdempotencyKey(ctx context.Context, articleID string) context.Context {
+	key, ok := IdempotencyKeyFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return ContextWithIdempotencyKey(ctx, NewIdempotencyKey(key.caller, key.key+"#"+articleID, key.fingerprint))
+}
+
+// RequestOutcome is what a request written as an event per article resulted in.
+// It is recorded under the idempotency key of the request once every event is written,
+// so that a retry is answered with it even when the request no longer applies.
+type RequestOutcome struct {
+	subjectID string
+	events    []BloggingEventKey
+}
+
+// SubjectID returns the id of what the request created or changed, such as a series. It is empty if there is none.
+func (o RequestOutcome) SubjectID() string {
+	return o.subjectID
+}
+
+// Events returns the keys of the events the request wrote, in the order they were written.
+func (o RequestOutcome) Events() []BloggingEventKey {
+	return o.events
+}
+
+// NewRequestOutcome is constructor of RequestOutcome.
+func NewRequestOutcome(subjectID string, events []BloggingEventKey) RequestOutcome {
+	return RequestOutcome{
+		subjectID: subjectID,
+		events:    events,
+	}
+}
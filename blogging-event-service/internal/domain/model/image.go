@@ -0,0 +1,364 @@
+package model
+
+import (
+	"blogapi.miyamo.today/core/article"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"github.com/cockroachdb/errors"
+	_ "golang.org/x/image/webp"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Image types known to ImagePolicy.
+const (
+	ImageTypePNG  = "image/png"
+	ImageTypeJPEG = "image/jpeg"
+	ImageTypeGIF  = "image/gif"
+	ImageTypeWebP = "image/webp"
+	ImageTypeSVG  = "image/svg+xml"
+	ImageTypeAVIF = "image/avif"
+)
+
+// imageExtensions are the file name extensions allowed for each image type.
+var imageExtensions = map[string][]string{
+	ImageTypePNG:  {".png"},
+	ImageTypeJPEG: {".jpg", ".jpeg"},
+	ImageTypeGIF:  {".gif"},
+	ImageTypeWebP: {".webp"},
+	ImageTypeSVG:  {".svg"},
+	ImageTypeAVIF: {".avif"},
+}
+
+// ImageExtension returns the file name extension of the image type, or an empty string if the type is unknown.
+func ImageExtension(contentType string) string {
+	if extensions := imageExtensions[contentType]; len(extensions) > 0 {
+		return extensions[0]
+	}
+	return ""
+}
+
+// validateImageVariants returns a ValidationError if any of the variants of an image is invalid.
+// field is the name of the field the variants are given in, which the violations are reported against with the index of the variant.
+func validateImageVariants(field string, variants []article.ImageVariant) error {
+	var violations []FieldViolation
+	for i, v := range variants {
+		prefix := fmt.Sprintf("%s[%d]", field, i)
+		if u, err := url.Parse(v.URL()); err != nil || !u.IsAbs() || (u.Scheme != "https" && u.Scheme != "http") {
+			violations = append(violations, NewFieldViolation(prefix+".url", "url must be an absolute http or https url"))
+		}
+		if v.Width() <= 0 {
+			violations = append(violations, NewFieldViolation(prefix+".width", "width must be positive"))
+		}
+		if v.Height() <= 0 {
+			violations = append(violations, NewFieldViolation(prefix+".height", "height must be positive"))
+		}
+		if ImageExtension(v.ContentType()) == "" {
+			violations = append(violations, NewFieldViolation(prefix+".contentType", fmt.Sprintf("content type %q is not an image type", v.ContentType())))
+		}
+	}
+	if len(violations) > 0 {
+		return errors.WithStack(NewValidationError(violations...))
+	}
+	return nil
+}
+
+var (
+	// svgPattern finds the root element of an SVG document.
+	svgPattern = regexp.MustCompile(`(?i)<svg[\s>]`)
+	// activeSVGPattern finds the parts of an SVG document that run scripts or load other documents.
+	activeSVGPattern = regexp.MustCompile(`(?i)<script|<foreignObject|<!ENTITY|\son[a-z]+\s*=|javascript:`)
+	// markupPattern finds markup hidden in a raster image, which makes it a polyglot.
+	markupPattern = regexp.MustCompile(`(?i)<(?:script|html|body|iframe|svg|\?php)[\s>]`)
+	// archiveSignatures are the magic bytes of the archives a polyglot hides after the end of a raster image.
+	archiveSignatures = [][]byte{
+		[]byte("PK\x03\x04"),
+		[]byte("PK\x05\x06"),
+		[]byte("Rar!\x1a\x07"),
+		[]byte("7z\xbc\xaf\x27\x1c"),
+		[]byte("\x1f\x8b\x08"),
+	}
+)
+
+// jpegEOI is the marker a JPEG image ends with.
+var jpegEOI = []byte{0xff, 0xd9}
+
+// ImagePolicy is the policy the uploaded images must follow.
+type ImagePolicy struct {
+	allowedTypes []string
+	maxBytes     int64
+	maxWidth     int
+	maxHeight    int
+}
+
+// AllowedTypes returns the MIME types of the images allowed to be uploaded.
+func (p ImagePolicy) AllowedTypes() []string {
+	return p.allowedTypes
+}
+
+// MaxBytes returns the maximum size of an image in bytes.
+func (p ImagePolicy) MaxBytes() int64 {
+	return p.maxBytes
+}
+
+// MaxWidth returns the maximum width of an image in pixels.
+func (p ImagePolicy) MaxWidth() int {
+	return p.maxWidth
+}
+
+// MaxHeight returns the maximum height of an image in pixels.
+func (p ImagePolicy) MaxHeight() int {
+	return p.maxHeight
+}
+
+// Inspect checks the image read from r against the policy without reading all of it into memory.
+// It checks the head of the image up front and returns the MIME type sniffed from it, which the declared content type has to agree with.
+// The rest is checked as it is read from the returned reader, which fails with ErrValidation instead of io.EOF
+// if the image turns out to violate the policy, so that whatever consumes it can abort.
+func (p ImagePolicy) Inspect(name string, r io.Reader, declaredType string) (string, io.Reader, error) {
+	if name == "" || path.Base(name) != name || strings.ContainsFunc(name, unicode.IsControl) {
+		return "", nil, errors.Wrapf(ErrValidation, "invalid file name %q", name)
+	}
+	r = &limitedImageReader{r: r, remaining: p.maxBytes, maxBytes: p.maxBytes}
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	switch {
+	case n == 0 && (err == nil || errors.Is(err, io.EOF)):
+		return "", nil, errors.Wrap(ErrValidation, "image is empty")
+	case err != nil && !errors.Is(err, io.ErrUnexpectedEOF):
+		return "", nil, err
+	}
+	head = head[:n]
+
+	contentType := sniffImageType(head)
+	if !slices.Contains(p.allowedTypes, contentType) {
+		return "", nil, errors.Wrapf(ErrValidation, "image type %s is not allowed", contentType)
+	}
+	if declaredType != "" {
+		mediaType, _, err := mime.ParseMediaType(declaredType)
+		if err != nil || mediaType != contentType {
+			return "", nil, errors.Wrapf(ErrValidation, "declared content type %q does not match %s", declaredType, contentType)
+		}
+	}
+	if !slices.Contains(imageExtensions[contentType], strings.ToLower(path.Ext(name))) {
+		return "", nil, errors.Wrapf(ErrValidation, "file name %q does not match %s", name, contentType)
+	}
+
+	if contentType == ImageTypeSVG {
+		return contentType, &imageScanner{
+			r:           io.MultiReader(bytes.NewReader(head), r),
+			contentType: contentType,
+			pattern:     activeSVGPattern,
+		}, nil
+	}
+
+	// the bytes the decoder reads past the head are replayed, so that the returned reader yields the whole image.
+	var consumed bytes.Buffer
+	config, _, err := image.DecodeConfig(io.TeeReader(io.MultiReader(bytes.NewReader(head), r), &consumed))
+	if err != nil {
+		if errors.Is(err, ErrValidation) {
+			return "", nil, err
+		}
+		return "", nil, errors.Wrapf(ErrValidation, "malformed %s: %v", contentType, err)
+	}
+	if config.Width > p.maxWidth || config.Height > p.maxHeight {
+		return "", nil, errors.Wrapf(ErrValidation, "image exceeds %dx%d pixels", p.maxWidth, p.maxHeight)
+	}
+	if consumed.Len() < len(head) {
+		consumed.Write(head[consumed.Len():])
+	}
+	return contentType, &imageScanner{
+		r:           io.MultiReader(&consumed, r),
+		contentType: contentType,
+		pattern:     markupPattern,
+	}, nil
+}
+
+// sniffLen is the length of the head of the image its type is sniffed from.
+const sniffLen = 4096
+
+// sniffImageType returns the MIME type of the data judging from its magic bytes.
+func sniffImageType(data []byte) string {
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	switch contentType {
+	case "text/xml", "text/plain":
+		// http.DetectContentType does not know SVG.
+		if svgPattern.Match(data) {
+			return ImageTypeSVG
+		}
+	}
+	return contentType
+}
+
+// limitedImageReader fails with ErrValidation once more than maxBytes are read.
+type limitedImageReader struct {
+	r         io.Reader
+	remaining int64
+	maxBytes  int64
+}
+
+func (l *limitedImageReader) Read(b []byte) (int, error) {
+	// one more byte than allowed is read to tell an image of exactly maxBytes from a larger one.
+	if int64(len(b)) > l.remaining+1 {
+		b = b[:l.remaining+1]
+	}
+	n, err := l.r.Read(b)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return 0, errors.Wrapf(ErrValidation, "image exceeds %d bytes", l.maxBytes)
+	}
+	return n, err
+}
+
+// scanWindow is the number of bytes kept from the previous read, so that the patterns spanning two reads are found.
+// It is also long enough for the trailers checked at the end of the image.
+const scanWindow = 64
+
+// imageScanner checks the image as it is read.
+type imageScanner struct {
+	r           io.Reader
+	contentType string
+	pattern     *regexp.Regexp
+	// window is the tail of the image read so far.
+	window []byte
+	// riffSize is the size in the header of a WebP image.
+	riffSize int64
+	// endSeen tells whether the end of a JPEG image has been read.
+	endSeen bool
+	// archiveAfterEnd tells whether an archive follows the last end of a JPEG image read so far.
+	archiveAfterEnd bool
+	size            int64
+	err             error
+}
+
+func (s *imageScanner) Read(b []byte) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	n, err := s.r.Read(b)
+	if n > 0 {
+		if s.size < 8 && s.size+int64(n) >= 8 && s.contentType == ImageTypeWebP {
+			header := append(slices.Clone(s.window), b[:n]...)
+			s.riffSize = int64(binary.LittleEndian.Uint32(header[4:8]))
+		}
+		s.size += int64(n)
+		chunk := append(s.window, b[:n]...)
+		if s.pattern.Match(chunk) {
+			s.err = s.violation()
+			return 0, s.err
+		}
+		if s.contentType == ImageTypeJPEG {
+			s.scanJPEGEnd(chunk)
+		}
+		s.window = slices.Clone(chunk[max(0, len(chunk)-scanWindow):])
+	}
+	if errors.Is(err, io.EOF) && !s.endsWithTrailer() {
+		s.err = s.violation()
+		return 0, s.err
+	}
+	return n, err
+}
+
+// scanJPEGEnd looks for the end of a JPEG image and the archives following it in the chunk.
+// An embedded thumbnail ends with the same marker, so only what follows the last one counts.
+func (s *imageScanner) scanJPEGEnd(chunk []byte) {
+	if i := bytes.LastIndex(chunk, jpegEOI); i >= 0 {
+		s.endSeen = true
+		s.archiveAfterEnd = containsArchive(chunk[i+len(jpegEOI):])
+		return
+	}
+	if containsArchive(chunk) {
+		s.archiveAfterEnd = true
+	}
+}
+
+// containsArchive reports whether the data contains the signature of an archive.
+func containsArchive(data []byte) bool {
+	for _, signature := range archiveSignatures {
+		if bytes.Contains(data, signature) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *imageScanner) violation() error {
+	if s.contentType == ImageTypeSVG {
+		return errors.Wrap(ErrValidation, "svg must not contain scripts")
+	}
+	return errors.Wrapf(ErrValidation, "image is not a plain %s", s.contentType)
+}
+
+// endsWithTrailer reports whether the image ends where its format says it ends.
+// Data appended after the end of an image is the usual way to make a polyglot.
+// A JPEG image may be followed by padding, as cameras and editors often leave it, but not by an archive.
+func (s *imageScanner) endsWithTrailer() bool {
+	tail := s.window
+	switch s.contentType {
+	case ImageTypeSVG:
+		return true
+	case ImageTypePNG:
+		// IEND chunk has no data, so it is followed only by its CRC.
+		return len(tail) >= 8 && bytes.Equal(tail[len(tail)-8:len(tail)-4], []byte("IEND"))
+	case ImageTypeJPEG:
+		return s.endSeen && !s.archiveAfterEnd
+	case ImageTypeGIF:
+		return bytes.HasSuffix(tail, []byte{0x3b})
+	case ImageTypeWebP:
+		return s.size >= 12 && s.riffSize+8 == s.size
+	}
+	return false
+}
+
+// ImagePolicyOption is the option of NewImagePolicy.
+type ImagePolicyOption func(*ImagePolicy)
+
+// WithAllowedImageTypes sets the MIME types of the images allowed to be uploaded.
+func WithAllowedImageTypes(allowedTypes ...string) ImagePolicyOption {
+	return func(p *ImagePolicy) {
+		p.allowedTypes = allowedTypes
+	}
+}
+
+// WithMaxImageBytes sets the maximum size of an image in bytes.
+func WithMaxImageBytes(maxBytes int64) ImagePolicyOption {
+	return func(p *ImagePolicy) {
+		p.maxBytes = maxBytes
+	}
+}
+
+// WithMaxImageDimensions sets the maximum width and height of an image in pixels.
+func WithMaxImageDimensions(maxWidth, maxHeight int) ImagePolicyOption {
+	return func(p *ImagePolicy) {
+		p.maxWidth = maxWidth
+		p.maxHeight = maxHeight
+	}
+}
+
+// NewImagePolicy is constructor of ImagePolicy.
+// By default, PNG, JPEG, GIF and WebP images up to 10 MiB and 8192x8192 pixels are allowed.
+func NewImagePolicy(options ...ImagePolicyOption) ImagePolicy {
+	p := ImagePolicy{
+		allowedTypes: []string{ImageTypePNG, ImageTypeJPEG, ImageTypeGIF, ImageTypeWebP},
+		maxBytes:     10 << 20,
+		maxWidth:     8192,
+		maxHeight:    8192,
+	}
+	for _, option := range options {
+		option(&p)
+	}
+	return p
+}
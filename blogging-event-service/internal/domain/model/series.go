@@ -0,0 +1,213 @@
+package model
+
+import (
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxSeriesTitleLength is the length of the series_title column of the article read models.
+const maxSeriesTitleLength = 255
+
+// ArticleSeries is the series an article is a part of, and the position of the article in it.
+type ArticleSeries struct {
+	id       string
+	title    string
+	position int
+}
+
+// ID returns the series id.
+func (s ArticleSeries) ID() string {
+	return s.id
+}
+
+// Title returns the title of the series.
+func (s ArticleSeries) Title() string {
+	return s.title
+}
+
+// Position returns the position of the article in the series, starting at 1.
+func (s ArticleSeries) Position() int {
+	return s.position
+}
+
+// NewArticleSeries creates a new ArticleSeries.
+func NewArticleSeries(id, title string, position int) ArticleSeries {
+	return ArticleSeries{
+		id:       id,
+		title:    title,
+		position: position,
+	}
+}
+
+// SeriesArticle is an article with the series it is a part of.
+type SeriesArticle struct {
+	articleID   string
+	series      ArticleSeries
+	lastEventID string
+}
+
+// ArticleID returns the article id.
+func (s SeriesArticle) ArticleID() string {
+	return s.articleID
+}
+
+// Series returns the series the article is a part of. Its id is empty if the article is not a part of any series.
+func (s SeriesArticle) Series() ArticleSeries {
+	return s.series
+}
+
+// LastEventID returns the id of the latest event of the article the series was read from.
+func (s SeriesArticle) LastEventID() string {
+	return s.lastEventID
+}
+
+// NewSeriesArticle creates a new SeriesArticle.
+func NewSeriesArticle(articleID string, series ArticleSeries, lastEventID string) SeriesArticle {
+	return SeriesArticle{
+		articleID:   articleID,
+		series:      series,
+		lastEventID: lastEventID,
+	}
+}
+
+// NormalizeSeriesTitle trims the title of a series.
+func NormalizeSeriesTitle(title string) string {
+	return strings.TrimSpace(title)
+}
+
+// ValidateSeriesTitle returns a ValidationError of the title field if the title cannot name a series.
+func ValidateSeriesTitle(title string) error {
+	var description string
+	switch {
+	case title == "":
+		description = "title must not be empty"
+	case utf8.RuneCountInString(title) > maxSeriesTitleLength:
+		description = fmt.Sprintf("title must be at most %d characters", maxSeriesTitleLength)
+	default:
+		return nil
+	}
+	return errors.WithStack(NewValidationError(NewFieldViolation("title", description)))
+}
+
+// ValidateSeriesArticleIDs returns a ValidationError of the articleIds field if the ids are empty or repeat an article.
+func ValidateSeriesArticleIDs(articleIDs []string) error {
+	if len(articleIDs) == 0 {
+		return errors.WithStack(NewValidationError(NewFieldViolation("articleIds", "article ids must not be empty")))
+	}
+	var violations []FieldViolation
+	for i, id := range articleIDs {
+		if slices.Contains(articleIDs[:i], id) {
+			violations = append(violations, NewFieldViolation(fmt.Sprintf("articleIds[%d]", i), fmt.Sprintf("article %q is given more than once", id)))
+		}
+	}
+	if len(violations) > 0 {
+		return errors.WithStack(NewValidationError(violations...))
+	}
+	return nil
+}
+
+// AlreadyInSeriesError returns a ValidationError of the field telling that the article is already a part of a series.
+// An article is a part of one series at most.
+func AlreadyInSeriesError(field string, article SeriesArticle) error {
+	return errors.WithStack(NewValidationError(NewFieldViolation(field,
+		fmt.Sprintf("article %q is already a part of series %q", article.ArticleID(), article.Series().ID()))))
+}
+
+// ReorderedSeries returns the parts of the series in the order of articleIDs, with their new positions.
+// It returns a ValidationError of the articleIds field unless articleIDs are exactly the articles of the series.
+func ReorderedSeries(parts []SeriesArticle, articleIDs []string) ([]SeriesArticle, error) {
+	if err := ValidateSeriesArticleIDs(articleIDs); err != nil {
+		return nil, err
+	}
+	var violations []FieldViolation
+	result := make([]SeriesArticle, 0, len(articleIDs))
+	for i, id := range articleIDs {
+		j := slices.IndexFunc(parts, func(p SeriesArticle) bool { return p.ArticleID() == id })
+		if j < 0 {
+			violations = append(violations, NewFieldViolation(fmt.Sprintf("articleIds[%d]", i), fmt.Sprintf("article %q is not a part of the series", id)))
+			continue
+		}
+		part := parts[j]
+		part.series.position = i + 1
+		result = append(result, part)
+	}
+	if len(violations) == 0 && len(articleIDs) != len(parts) {
+		violations = append(violations, NewFieldViolation("articleIds", fmt.Sprintf("article ids must list all the %d articles of the series", len(parts))))
+	}
+	if len(violations) > 0 {
+		return nil, errors.WithStack(NewValidationError(violations...))
+	}
+	return result, nil
+}
+
+// SeriesEvent puts an article at a position of a series.
+// Series are not stored on their own: each article records the series it is a part of with one of these,
+// and a series is made of the articles that record it.
+type SeriesEvent struct {
+	articleID           string
+	series              ArticleSeries
+	expectedLastEventID string
+}
+
+// ArticleID returns the article id.
+func (s SeriesEvent) ArticleID() string {
+	return s.articleID
+}
+
+// Series returns the series and the position the article is put at.
+func (s SeriesEvent) Series() ArticleSeries {
+	return s.series
+}
+
+// ExpectedLastEventID returns the last event of the stream the series of the article was read from.
+func (s SeriesEvent) ExpectedLastEventID() string {
+	return s.expectedLastEventID
+}
+
+// Validate returns ErrValidation if the event has an invalid value.
+func (s SeriesEvent) Validate() error {
+	switch {
+	case s.articleID == "":
+		return errors.Wrap(ErrValidation, "article id is required")
+	case s.series.id == "":
+		return errors.Wrap(ErrValidation, "series id is required")
+	case s.series.position < 1:
+		return errors.WithStack(NewValidationError(NewFieldViolation("position", "position must be at least 1")))
+	}
+	return ValidateSeriesTitle(s.series.title)
+}
+
+// AddToSeriesEvent adds an article to a series.
+type AddToSeriesEvent struct {
+	SeriesEvent
+}
+
+// NewAddToSeriesEvent creates a new AddToSeriesEvent.
+func NewAddToSeriesEvent(articleID string, series ArticleSeries, expectedLastEventID string) AddToSeriesEvent {
+	return AddToSeriesEvent{
+		SeriesEvent: SeriesEvent{
+			articleID:           articleID,
+			series:              series,
+			expectedLastEventID: expectedLastEventID,
+		},
+	}
+}
+
+// ReorderSeriesEvent moves an article of a series to another position.
+type ReorderSeriesEvent struct {
+	SeriesEvent
+}
+
+// NewReorderSeriesEvent creates a new ReorderSeriesEvent.
+func NewReorderSeriesEvent(articleID string, series ArticleSeries, expectedLastEventID string) ReorderSeriesEvent {
+	return ReorderSeriesEvent{
+		SeriesEvent: SeriesEvent{
+			articleID:           articleID,
+			series:              series,
+			expectedLastEventID: expectedLastEventID,
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"regexp"
+	"strings"
+)
+
+// maxSlugLength is the length of the slug column of the article read models.
+const maxSlugLength = 100
+
+// slugPattern allows lowercase letters and digits in words joined by single hyphens, e.g. "hello-world-2".
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// NormalizeSlug trims the slug and lowers its case.
+func NormalizeSlug(slug string) string {
+	return strings.ToLower(strings.TrimSpace(slug))
+}
+
+// ValidateSlug returns a ValidationError of the slug field if the slug cannot address an article.
+func ValidateSlug(slug string) error {
+	var description string
+	switch {
+	case slug == "":
+		description = "slug must not be empty"
+	case len(slug) > maxSlugLength:
+		description = fmt.Sprintf("slug must be at most %d characters", maxSlugLength)
+	case !slugPattern.MatchString(slug):
+		description = fmt.Sprintf("slug %q must be lowercase letters and digits joined by single hyphens", slug)
+	default:
+		return nil
+	}
+	return errors.WithStack(NewValidationError(NewFieldViolation("slug", description)))
+}
+
+// SlugTakenError returns a ValidationError of the slug field telling that another article has had the slug.
+// Slugs an article had before stay with it, so that they can be redirected to its current slug.
+func SlugTakenError(slug string) error {
+	return errors.WithStack(NewValidationError(NewFieldViolation("slug", fmt.Sprintf("slug %q is already taken", slug))))
+}
@@ -0,0 +1,207 @@
+package model
+
+import (
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// TaggedArticle is an article with the names of the tags attached to it.
+type TaggedArticle struct {
+	articleID   string
+	tagNames    []string
+	lastEventID string
+}
+
+// ArticleID returns the article id.
+func (t TaggedArticle) ArticleID() string {
+	return t.articleID
+}
+
+// TagNames returns the names of the tags attached to the article.
+func (t TaggedArticle) TagNames() []string {
+	return t.tagNames
+}
+
+// LastEventID returns the id of the latest event of the article the tags were read from.
+func (t TaggedArticle) LastEventID() string {
+	return t.lastEventID
+}
+
+// NewTaggedArticle creates a new TaggedArticle.
+func NewTaggedArticle(articleID string, tagNames []string, lastEventID string) TaggedArticle {
+	return TaggedArticle{
+		articleID:   articleID,
+		tagNames:    tagNames,
+		lastEventID: lastEventID,
+	}
+}
+
+// TagCaseMode is how TagPolicy folds the case of the tag names.
+type TagCaseMode string
+
+const (
+	// TagCasePreserve keeps the case of the tag names as they are given.
+	TagCasePreserve TagCaseMode = "preserve"
+	// TagCaseLower lower-cases the tag names.
+	TagCaseLower TagCaseMode = "lower"
+	// TagCaseFold applies Unicode case folding to the tag names, which also folds e.g. "ß" into "ss".
+	TagCaseFold TagCaseMode = "fold"
+)
+
+// defaultMaxTagLength is the length of the name column of the tag read models.
+const defaultMaxTagLength = 35
+
+// defaultAllowedTagPattern allows letters, digits, spaces and the punctuation of names such as "C++", "C#" and "Node.js".
+var defaultAllowedTagPattern = regexp.MustCompile(`^[\p{L}\p{M}\p{N} ._+#-]+$`)
+
+// TagPolicy is the policy the tag names written to the articles must follow.
+// The names are trimmed, normalized to NFC and folded to the case mode before they are validated,
+// so that names looking the same are written, and derive the tag id, the same way.
+type TagPolicy struct {
+	caseMode       TagCaseMode
+	maxLength      int
+	allowedPattern *regexp.Regexp
+	blocked        []string
+}
+
+// CaseMode returns how the case of the tag names is folded.
+func (p TagPolicy) CaseMode() TagCaseMode {
+	return p.caseMode
+}
+
+// MaxLength returns the maximum number of characters of a tag name.
+func (p TagPolicy) MaxLength() int {
+	return p.maxLength
+}
+
+// Normalize returns the tag name trimmed, normalized to NFC and folded to the case mode.
+func (p TagPolicy) Normalize(name string) string {
+	name = p.Lookup(name)
+	switch p.caseMode {
+	case TagCaseLower:
+		return cases.Lower(language.Und).String(name)
+	case TagCaseFold:
+		return cases.Fold().String(name)
+	}
+	return name
+}
+
+// Lookup returns the name of a tag to find on the articles, trimmed and normalized to NFC.
+// Its case is kept, since the tag may have been written before the case mode was set.
+func (p TagPolicy) Lookup(name string) string {
+	return norm.NFC.String(strings.TrimSpace(name))
+}
+
+// LookupAll is Lookup for each of the names.
+func (p TagPolicy) LookupAll(names []string) []string {
+	if names == nil {
+		return nil
+	}
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		result = append(result, p.Lookup(name))
+	}
+	return result
+}
+
+// Apply normalizes the tag names to write and validates them.
+// field is the name of the field the names are given in, which the violations are reported against with the index of the name.
+// It returns a ValidationError if any of the names violates the policy.
+func (p TagPolicy) Apply(field string, names []string) ([]string, error) {
+	if names == nil {
+		return nil, nil
+	}
+	result := make([]string, 0, len(names))
+	var violations []FieldViolation
+	for i, name := range names {
+		name = p.Normalize(name)
+		if description := p.violation(name); description != "" {
+			violations = append(violations, NewFieldViolation(fmt.Sprintf("%s[%d]", field, i), description))
+		}
+		result = append(result, name)
+	}
+	if len(violations) > 0 {
+		return nil, errors.WithStack(NewValidationError(violations...))
+	}
+	return result, nil
+}
+
+// ApplyOne is Apply for a field holding a single tag name.
+func (p TagPolicy) ApplyOne(field, name string) (string, error) {
+	name = p.Normalize(name)
+	if description := p.violation(name); description != "" {
+		return "", errors.WithStack(NewValidationError(NewFieldViolation(field, description)))
+	}
+	return name, nil
+}
+
+// violation returns why the normalized tag name violates the policy, or an empty string if it does not.
+func (p TagPolicy) violation(name string) string {
+	switch {
+	case name == "":
+		return "tag name must not be empty"
+	case utf8.RuneCountInString(name) > p.maxLength:
+		return fmt.Sprintf("tag name must be at most %d characters", p.maxLength)
+	case !p.allowedPattern.MatchString(name):
+		return fmt.Sprintf("tag name %q has characters that are not allowed", name)
+	}
+	folded := cases.Fold().String(name)
+	for _, blocked := range p.blocked {
+		if cases.Fold().String(norm.NFC.String(blocked)) == folded {
+			return fmt.Sprintf("tag name %q is not allowed", name)
+		}
+	}
+	return ""
+}
+
+// TagPolicyOption is the option of NewTagPolicy.
+type TagPolicyOption func(*TagPolicy)
+
+// WithTagCaseMode sets how the case of the tag names is folded.
+func WithTagCaseMode(caseMode TagCaseMode) TagPolicyOption {
+	return func(p *TagPolicy) {
+		p.caseMode = caseMode
+	}
+}
+
+// WithMaxTagLength sets the maximum number of characters of a tag name.
+// It must not exceed the length of the name column of the tag read models.
+func WithMaxTagLength(maxLength int) TagPolicyOption {
+	return func(p *TagPolicy) {
+		p.maxLength = maxLength
+	}
+}
+
+// WithAllowedTagPattern sets the pattern the whole of a tag name must match.
+func WithAllowedTagPattern(allowedPattern *regexp.Regexp) TagPolicyOption {
+	return func(p *TagPolicy) {
+		p.allowedPattern = allowedPattern
+	}
+}
+
+// WithBlockedTags sets the tag names not allowed to be written, which are compared regardless of their case.
+func WithBlockedTags(blocked ...string) TagPolicyOption {
+	return func(p *TagPolicy) {
+		p.blocked = blocked
+	}
+}
+
+// NewTagPolicy is constructor of TagPolicy.
+// By default, the case of the tag names is preserved, and names of up to 35 letters, digits, spaces and "._+#-" are allowed.
+func NewTagPolicy(options ...TagPolicyOption) TagPolicy {
+	p := TagPolicy{
+		caseMode:       TagCasePreserve,
+		maxLength:      defaultMaxTagLength,
+		allowedPattern: defaultAllowedTagPattern,
+	}
+	for _, option := range options {
+		option(&p)
+	}
+	return p
+}
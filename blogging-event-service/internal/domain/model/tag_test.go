@@ -0,0 +1,226 @@
+package model
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+func TestTagPolicy_Apply(t *testing.T) {
+	type testCase struct {
+		policy         TagPolicy
+		names          []string
+		want           []string
+		wantViolations []FieldViolation
+	}
+	tests := map[string]testCase{
+		"happy_path/nil": {
+			policy: NewTagPolicy(),
+			names:  nil,
+			want:   nil,
+		},
+		"happy_path/trimmed": {
+			policy: NewTagPolicy(),
+			names:  []string{"  Go  ", "Node.js", "C++", "C#", "gRPC-Web", "tag_name"},
+			want:   []string{"Go", "Node.js", "C++", "C#", "gRPC-Web", "tag_name"},
+		},
+		"happy_path/nfc": {
+			// "e" followed by a combining acute accent is composed into "é".
+			policy: NewTagPolicy(),
+			names:  []string{"Cafe\u0301"},
+			want:   []string{"Café"},
+		},
+		"happy_path/case_preserved": {
+			policy: NewTagPolicy(WithTagCaseMode(TagCasePreserve)),
+			names:  []string{"GoLang", "Straße"},
+			want:   []string{"GoLang", "Straße"},
+		},
+		"happy_path/case_lower": {
+			policy: NewTagPolicy(WithTagCaseMode(TagCaseLower)),
+			names:  []string{"GoLang", "Straße"},
+			want:   []string{"golang", "straße"},
+		},
+		"happy_path/case_folded": {
+			policy: NewTagPolicy(WithTagCaseMode(TagCaseFold)),
+			names:  []string{"GoLang", "Straße"},
+			want:   []string{"golang", "strasse"},
+		},
+		"happy_path/nfc_before_case_folded": {
+			policy: NewTagPolicy(WithTagCaseMode(TagCaseFold)),
+			names:  []string{"CAFE\u0301", "café"},
+			want:   []string{"café", "café"},
+		},
+		"happy_path/max_length": {
+			policy: NewTagPolicy(),
+			names:  []string{strings.Repeat("a", 35), strings.Repeat("é", 35)},
+			want:   []string{strings.Repeat("a", 35), strings.Repeat("é", 35)},
+		},
+		"happy_path/custom_max_length": {
+			policy: NewTagPolicy(WithMaxTagLength(3)),
+			names:  []string{"aws"},
+			want:   []string{"aws"},
+		},
+		"happy_path/custom_allowed_pattern": {
+			policy: NewTagPolicy(WithAllowedTagPattern(regexp.MustCompile(`^[a-z_]+$`))),
+			names:  []string{"tag_name"},
+			want:   []string{"tag_name"},
+		},
+		"unhappy_path/empty": {
+			policy: NewTagPolicy(),
+			names:  []string{"go", "   "},
+			wantViolations: []FieldViolation{
+				NewFieldViolation("tagNames[1]", "tag name must not be empty"),
+			},
+		},
+		"unhappy_path/too_long": {
+			policy: NewTagPolicy(),
+			names:  []string{strings.Repeat("a", 36), strings.Repeat("é", 36)},
+			wantViolations: []FieldViolation{
+				NewFieldViolation("tagNames[0]", "tag name must be at most 35 characters"),
+				NewFieldViolation("tagNames[1]", "tag name must be at most 35 characters"),
+			},
+		},
+		"unhappy_path/too_long_after_nfc_is_not_counted_before": {
+			// 35 "é" written as "e" and a combining accent are 70 runes before they are composed.
+			policy: NewTagPolicy(),
+			names:  []string{strings.Repeat("e\u0301", 35), strings.Repeat("e\u0301", 36)},
+			wantViolations: []FieldViolation{
+				NewFieldViolation("tagNames[1]", "tag name must be at most 35 characters"),
+			},
+		},
+		"unhappy_path/custom_max_length": {
+			policy: NewTagPolicy(WithMaxTagLength(3)),
+			names:  []string{"rust"},
+			wantViolations: []FieldViolation{
+				NewFieldViolation("tagNames[0]", "tag name must be at most 3 characters"),
+			},
+		},
+		"unhappy_path/not_allowed_characters": {
+			policy: NewTagPolicy(),
+			names:  []string{"go/aws", "<script>", "go\taws"},
+			wantViolations: []FieldViolation{
+				NewFieldViolation("tagNames[0]", `tag name "go/aws" has characters that are not allowed`),
+				NewFieldViolation("tagNames[1]", `tag name "<script>" has characters that are not allowed`),
+				NewFieldViolation("tagNames[2]", `tag name "go\taws" has characters that are not allowed`),
+			},
+		},
+		"unhappy_path/custom_allowed_pattern": {
+			policy: NewTagPolicy(WithAllowedTagPattern(regexp.MustCompile(`^[a-z]+$`))),
+			names:  []string{"Go"},
+			wantViolations: []FieldViolation{
+				NewFieldViolation("tagNames[0]", `tag name "Go" has characters that are not allowed`),
+			},
+		},
+		"unhappy_path/blocked": {
+			policy: NewTagPolicy(WithBlockedTags("spam", "Straße", "Cafe\u0301")),
+			names:  []string{"SPAM", "strasse", "café", "go"},
+			wantViolations: []FieldViolation{
+				NewFieldViolation("tagNames[0]", `tag name "SPAM" is not allowed`),
+				NewFieldViolation("tagNames[1]", `tag name "strasse" is not allowed`),
+				NewFieldViolation("tagNames[2]", `tag name "café" is not allowed`),
+			},
+		},
+		"unhappy_path/blocked_after_case_folded": {
+			policy: NewTagPolicy(WithTagCaseMode(TagCaseFold), WithBlockedTags("spam")),
+			names:  []string{" Spam "},
+			wantViolations: []FieldViolation{
+				NewFieldViolation("tagNames[0]", `tag name "spam" is not allowed`),
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tt.policy.Apply("tagNames", tt.names)
+			if tt.wantViolations != nil {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("Apply() error = %v, want ValidationError", err)
+				}
+				if !errors.Is(err, ErrValidation) {
+					t.Errorf("Apply() error = %v, want to wrap %v", err, ErrValidation)
+				}
+				if !reflect.DeepEqual(validationErr.Violations(), tt.wantViolations) {
+					t.Errorf("Apply() violations = %+v, want %+v", validationErr.Violations(), tt.wantViolations)
+				}
+				if got != nil {
+					t.Errorf("Apply() got = %v, want nil", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Apply() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagPolicy_ApplyOne(t *testing.T) {
+	type testCase struct {
+		policy         TagPolicy
+		name           string
+		want           string
+		wantViolations []FieldViolation
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			policy: NewTagPolicy(WithTagCaseMode(TagCaseLower)),
+			name:   " Cafe\u0301 ",
+			want:   "café",
+		},
+		"unhappy_path/blocked": {
+			policy: NewTagPolicy(WithBlockedTags("spam")),
+			name:   "Spam",
+			wantViolations: []FieldViolation{
+				NewFieldViolation("to", `tag name "Spam" is not allowed`),
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tt.policy.ApplyOne("to", tt.name)
+			if tt.wantViolations != nil {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("ApplyOne() error = %v, want ValidationError", err)
+				}
+				if !reflect.DeepEqual(validationErr.Violations(), tt.wantViolations) {
+					t.Errorf("ApplyOne() violations = %+v, want %+v", validationErr.Violations(), tt.wantViolations)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ApplyOne() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ApplyOne() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagPolicy_Lookup(t *testing.T) {
+	tests := map[string]struct {
+		policy TagPolicy
+		name   string
+		want   string
+	}{
+		"happy_path/nfc_and_case_kept": {
+			policy: NewTagPolicy(WithTagCaseMode(TagCaseFold)),
+			name:   " CAFE\u0301 ",
+			want:   "CAFÉ",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tt.policy.Lookup(tt.name); got != tt.want {
+				t.Errorf("Lookup() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package pb
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"connectrpc.com/connect"
+	"context"
+)
+
+const (
+	// HeaderActorSubject is the request header carrying the subject of the token of the user who made the request.
+	HeaderActorSubject = "X-Actor-Subject"
+	// HeaderActorUsername is the request header carrying the username of the user who made the request.
+	HeaderActorUsername = "X-Actor-Username"
+)
+
+// NewActorInterceptor returns an interceptor that stores the user who made a unary request to the context,
+// so that the events written by the request record who wrote them.
+// Requests without the subject header are passed through without an actor.
+func NewActorInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			subject := req.Header().Get(HeaderActorSubject)
+			if subject == "" || req.Spec().IsClient {
+				return next(ctx, req)
+			}
+			ctx = model.ContextWithActor(ctx, model.NewActor(subject, req.Header().Get(HeaderActorUsername)))
+			return next(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package pb
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/grpc"
+	"connectrpc.com/connect"
+	"context"
+	"testing"
+)
+
+func TestNewActorInterceptor(t *testing.T) {
+	type testCase struct {
+		header   map[string]string
+		wantOK   bool
+		want     model.Actor
+		wantName string
+	}
+	intercept := func(header map[string]string) (model.Actor, bool) {
+		req := connect.NewRequest(&grpc.UpdateArticleTitleRequest{Id: "Article1", Title: "Title1"})
+		for k, v := range header {
+			req.Header().Set(k, v)
+		}
+		var (
+			got model.Actor
+			ok  bool
+		)
+		next := func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+			got, ok = model.ActorFromContext(ctx)
+			return nil, nil
+		}
+		_, _ = NewActorInterceptor()(next)(context.Background(), req)
+		return got, ok
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			header:   map[string]string{HeaderActorSubject: "Subject1", HeaderActorUsername: "editor"},
+			wantOK:   true,
+			want:     model.NewActor("Subject1", "editor"),
+			wantName: "editor",
+		},
+		"happy_path/without-username": {
+			header:   map[string]string{HeaderActorSubject: "Subject1"},
+			wantOK:   true,
+			want:     model.NewActor("Subject1", ""),
+			wantName: "Subject1",
+		},
+		"happy_path/without-subject": {
+			header: map[string]string{HeaderActorUsername: "editor"},
+			wantOK: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := intercept(tt.header)
+			if ok != tt.wantOK {
+				t.Fatalf("ActorFromContext() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Errorf("ActorFromContext() = %+v, want %+v", got, tt.want)
+			}
+			if got.Name() != tt.wantName {
+				t.Errorf("Name() = %v, want %v", got.Name(), tt.wantName)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package pb
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"connectrpc.com/connect"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// HeaderIdempotencyKey is the request header carrying the caller's key for deduplicating retried requests.
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderCallerID is the request header identifying the caller. Idempotency keys are scoped to it.
+	HeaderCallerID = "X-Caller-Id"
+)
+
+// NewIdempotencyInterceptor returns an interceptor that stores the idempotency key of unary requests to the context.
+// The key is fingerprinted with the procedure and the request message, so that a reused key with a different payload can be told apart from a retry.
+func NewIdempotencyInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			key := req.Header().Get(HeaderIdempotencyKey)
+			if key == "" || req.Spec().IsClient {
+				return next(ctx, req)
+			}
+			msg, ok := req.Any().(proto.Message)
+			if !ok {
+				return next(ctx, req)
+			}
+			b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			h := sha256.New()
+			h.Write([]byte(req.Spec().Procedure))
+			h.Write([]byte{0})
+			h.Write(b)
+			ctx = model.ContextWithIdempotencyKey(ctx,
+				model.NewIdempotencyKey(req.Header().Get(HeaderCallerID), key, hex.EncodeToString(h.Sum(nil))))
+			return next(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package pb
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/grpc"
+	"connectrpc.com/connect"
+	"context"
+	"testing"
+)
+
+func TestNewIdempotencyInterceptor(t *testing.T) {
+	type testCase struct {
+		header  map[string]string
+		message *grpc.UpdateArticleTitleRequest
+		wantOK  bool
+		want    func(fingerprint string) model.IdempotencyKey
+	}
+	newRequest := func(message *grpc.UpdateArticleTitleRequest, header map[string]string) *connect.Request[grpc.UpdateArticleTitleRequest] {
+		req := connect.NewRequest(message)
+		for k, v := range header {
+			req.Header().Set(k, v)
+		}
+		return req
+	}
+	intercept := func(req connect.AnyRequest) (model.IdempotencyKey, bool) {
+		var (
+			got model.IdempotencyKey
+			ok  bool
+		)
+		next := func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+			got, ok = model.IdempotencyKeyFromContext(ctx)
+			return nil, nil
+		}
+		_, _ = NewIdempotencyInterceptor()(next)(context.Background(), req)
+		return got, ok
+	}
+	// fingerprint of the happy path request, used to tell whether the payload affects it.
+	base, _ := intercept(newRequest(
+		&grpc.UpdateArticleTitleRequest{Id: "Article1", Title: "Title1"},
+		map[string]string{HeaderIdempotencyKey: "Mutation1", HeaderCallerID: "Caller1"}))
+
+	tests := map[string]testCase{
+		"happy_path/same-payload": {
+			header:  map[string]string{HeaderIdempotencyKey: "Mutation1", HeaderCallerID: "Caller1"},
+			message: &grpc.UpdateArticleTitleRequest{Id: "Article1", Title: "Title1"},
+			wantOK:  true,
+			want: func(fingerprint string) model.IdempotencyKey {
+				return model.NewIdempotencyKey("Caller1", "Mutation1", base.Fingerprint())
+			},
+		},
+		"happy_path/different-payload": {
+			header:  map[string]string{HeaderIdempotencyKey: "Mutation1", HeaderCallerID: "Caller1"},
+			message: &grpc.UpdateArticleTitleRequest{Id: "Article1", Title: "Title2"},
+			wantOK:  true,
+			want: func(fingerprint string) model.IdempotencyKey {
+				if fingerprint == base.Fingerprint() {
+					t.Errorf("fingerprint must differ for a different payload")
+				}
+				return model.NewIdempotencyKey("Caller1", "Mutation1", fingerprint)
+			},
+		},
+		"happy_path/without-key": {
+			header:  map[string]string{HeaderCallerID: "Caller1"},
+			message: &grpc.UpdateArticleTitleRequest{Id: "Article1", Title: "Title1"},
+			wantOK:  false,
+			want: func(string) model.IdempotencyKey {
+				return model.IdempotencyKey{}
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := intercept(newRequest(tt.message, tt.header))
+			if ok != tt.wantOK {
+				t.Fatalf("IdempotencyKeyFromContext() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if want := tt.want(got.Fingerprint()); got != want {
+				t.Errorf("IdempotencyKeyFromContext() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
@@ -2,20 +2,24 @@ package pb
 
 import (
 	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
 	"blogapi.miyamo.today/blogging-event-service/internal/if-adapter/controller/pb/presenters"
 	"blogapi.miyamo.today/blogging-event-service/internal/if-adapter/controller/pb/usecase"
 	grpcgen "blogapi.miyamo.today/blogging-event-service/internal/infra/grpc"
 	"blogapi.miyamo.today/blogging-event-service/internal/infra/grpc/grpcconnect"
 	"blogapi.miyamo.today/core/log"
-	"bytes"
 	"connectrpc.com/connect"
 	"context"
+	"fmt"
 	"github.com/cockroachdb/errors"
 	"github.com/miyamo2/altnrslog"
 	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"io"
 	"log/slog"
 	"net/url"
+	"time"
 )
 
 var _ grpcconnect.BloggingEventServiceHandler = (*BloggingEventServiceServer)(nil)
@@ -38,32 +42,582 @@ func (s *BloggingEventServiceServer) CreateArticle(ctx context.Context, req *con
 	}
 
 	logger.InfoContext(ctx, "BEGIN",
-		slog.Group("parameters", slog.String("title", req.Msg.GetTitle()), slog.String("body", req.Msg.GetBody()), slog.String("thumbnail", req.Msg.GetThumbnailUrl()), slog.Any("tagNames", req.Msg.GetTagNames())))
+		slog.Group("parameters", slog.String("title", req.Msg.GetTitle()), slog.String("body", req.Msg.GetBody()), slog.String("thumbnail", req.Msg.GetThumbnailUrl()), slog.Any("tagNames", req.Msg.GetTagNames()), slog.Bool("draft", req.Msg.GetDraft()), slog.String("slug", req.Msg.GetSlug())))
 
-	inDto := dto.NewCreateArticleInDto(req.Msg.GetTitle(), req.Msg.GetBody(), req.Msg.GetThumbnailUrl(), req.Msg.GetTagNames())
+	var publishAt time.Time
+	if req.Msg.PublishAt != nil {
+		if err := req.Msg.PublishAt.CheckValid(); err != nil {
+			err = errors.Mark(errors.WithStack(err), model.ErrValidation)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return nil, toConnectError(err)
+		}
+		publishAt = req.Msg.PublishAt.AsTime()
+	}
+	thumbnailVariants, err := imageVariantDtos("thumbnailVariants", req.Msg.GetThumbnailVariants())
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	inDto := dto.NewCreateArticleInDto(req.Msg.GetTitle(), req.Msg.GetBody(), req.Msg.GetThumbnailUrl(), thumbnailVariants, req.Msg.GetTagNames(), publishAt, req.Msg.GetDraft(), req.Msg.GetSlug())
 	outDto, err := s.createArticleUsecase.Execute(ctx, &inDto)
 	if err != nil {
-		return nil, err
+		return nil, toConnectError(err)
+	}
+	response, err := s.createArticleConverter.ToCreateArticleArticleResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) UpdateArticleTitle(ctx context.Context, request *connect.Request[grpcgen.UpdateArticleTitleRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("UpdateArticleTitle").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("article id", request.Msg.GetId()), slog.String("title", request.Msg.GetTitle())))
+
+	inDto := dto.NewUpdateArticleTitleInDto(request.Msg.GetId(), request.Msg.GetTitle(), request.Msg.GetExpectedLastEventId())
+	outDto, err := s.updateArticleTitleUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	response, err := s.updateArticleTitleConverter.ToUpdateArticleTitleResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) UpdateArticleSlug(ctx context.Context, request *connect.Request[grpcgen.UpdateArticleSlugRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("UpdateArticleSlug").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("article id", request.Msg.GetId()), slog.String("slug", request.Msg.GetSlug())))
+
+	inDto := dto.NewUpdateArticleSlugInDto(request.Msg.GetId(), request.Msg.GetSlug(), request.Msg.GetExpectedLastEventId())
+	outDto, err := s.updateArticleSlugUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	response, err := s.updateArticleSlugConverter.ToUpdateArticleSlugResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) UpdateArticleBody(ctx context.Context, request *connect.Request[grpcgen.UpdateArticleBodyRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("UpdateArticleBody").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("article id", request.Msg.GetId()), slog.String("body", request.Msg.GetBody())))
+
+	inDto := dto.NewUpdateArticleBodyInDto(request.Msg.GetId(), request.Msg.GetBody(), request.Msg.GetExpectedLastEventId())
+	outDto, err := s.updateArticleBodyUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	response, err := s.updateArticleBodyConverter.ToUpdateArticleBodyResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) UpdateArticleThumbnail(ctx context.Context, request *connect.Request[grpcgen.UpdateArticleThumbnailRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("UpdateArticleThumbnail").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("article id", request.Msg.GetId()), slog.String("thumbnail", request.Msg.GetThumbnailUrl())))
+
+	thumbnailUrl, err := url.Parse(request.Msg.GetThumbnailUrl())
+	if err != nil {
+		err = errors.Mark(errors.WithStack(err), model.ErrValidation)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	thumbnailVariants, err := imageVariantDtos("thumbnailVariants", request.Msg.GetThumbnailVariants())
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	inDto := dto.NewUpdateArticleThumbnailInDto(request.Msg.GetId(), *thumbnailUrl, thumbnailVariants, request.Msg.GetExpectedLastEventId())
+	outDto, err := s.updateArticleThumbnailUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	response, err := s.updateArticleThumbnailConverter.ToUpdateArticleThumbnailResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) AttachTags(ctx context.Context, request *connect.Request[grpcgen.AttachTagsRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("AttachTag").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("article id", request.Msg.GetId()), slog.Any("attach_tag", request.Msg.GetTagNames())))
+
+	inDto := dto.NewAttachTagsInDto(request.Msg.GetId(), request.Msg.GetTagNames(), request.Msg.GetExpectedLastEventId())
+	outDto, err := s.attachTagUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	response, err := s.attachTagConverter.ToAttachTagsResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) DetachTags(ctx context.Context, request *connect.Request[grpcgen.DetachTagsRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("DetachTag").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("article id", request.Msg.GetId()), slog.Any("detach_tag", request.Msg.GetTagNames())))
+
+	inDto := dto.NewDetachTagsInDto(request.Msg.GetId(), request.Msg.GetTagNames(), request.Msg.GetExpectedLastEventId())
+	outDto, err := s.detachTagUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	response, err := s.detachTagConverter.ToDetachTagsResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) HideArticle(ctx context.Context, request *connect.Request[grpcgen.HideArticleRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("HideArticle").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("article id", request.Msg.GetId())))
+
+	inDto := dto.NewHideArticleInDto(request.Msg.GetId(), request.Msg.GetExpectedLastEventId())
+	outDto, err := s.hideArticleUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	response, err := s.hideArticleConverter.ToHideArticleResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) UnhideArticle(ctx context.Context, request *connect.Request[grpcgen.UnhideArticleRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("UnhideArticle").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("article id", request.Msg.GetId())))
+
+	inDto := dto.NewUnhideArticleInDto(request.Msg.GetId(), request.Msg.GetExpectedLastEventId())
+	outDto, err := s.unhideArticleUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	response, err := s.unhideArticleConverter.ToUnhideArticleResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) EditArticle(ctx context.Context, request *connect.Request[grpcgen.EditArticleRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("EditArticle").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters",
+			slog.String("article id", request.Msg.GetId()),
+			slog.Any("title", request.Msg.Title),
+			slog.Any("body", request.Msg.Body),
+			slog.Any("thumbnail", request.Msg.ThumbnailUrl),
+			slog.Any("attachTagNames", request.Msg.GetAttachTagNames()),
+			slog.Any("detachTagNames", request.Msg.GetDetachTagNames())))
+
+	var thumbnailUrl *url.URL
+	if request.Msg.ThumbnailUrl != nil {
+		thumbnailUrl, err = url.Parse(request.Msg.GetThumbnailUrl())
+		if err != nil {
+			err = errors.Mark(errors.WithStack(err), model.ErrValidation)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return nil, toConnectError(err)
+		}
+	}
+	thumbnailVariants, err := imageVariantDtos("thumbnailVariants", request.Msg.GetThumbnailVariants())
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	inDto := dto.NewEditArticleInDto(
+		request.Msg.GetId(),
+		request.Msg.Title,
+		request.Msg.Body,
+		thumbnailUrl,
+		thumbnailVariants,
+		request.Msg.GetAttachTagNames(),
+		request.Msg.GetDetachTagNames(),
+		request.Msg.GetExpectedLastEventId())
+	outDto, err := s.editArticleUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	response, err := s.editArticleConverter.ToEditArticleResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) ScheduleArticle(ctx context.Context, request *connect.Request[grpcgen.ScheduleArticleRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ScheduleArticle").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("article id", request.Msg.GetId()), slog.Any("publishAt", request.Msg.GetPublishAt())))
+
+	var publishAt time.Time
+	if request.Msg.PublishAt != nil {
+		if err := request.Msg.PublishAt.CheckValid(); err != nil {
+			err = errors.Mark(errors.WithStack(err), model.ErrValidation)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return nil, toConnectError(err)
+		}
+		publishAt = request.Msg.PublishAt.AsTime()
+	}
+	inDto := dto.NewScheduleArticleInDto(request.Msg.GetId(), publishAt, request.Msg.GetExpectedLastEventId())
+	outDto, err := s.scheduleArticleUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	response, err := s.scheduleArticleConverter.ToScheduleArticleResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) PublishArticle(ctx context.Context, request *connect.Request[grpcgen.PublishArticleRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("PublishArticle").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("article id", request.Msg.GetId())))
+
+	inDto := dto.NewPublishArticleInDto(request.Msg.GetId(), request.Msg.GetExpectedLastEventId())
+	outDto, err := s.publishArticleUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	response, err := s.publishArticleConverter.ToPublishArticleResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) RevertArticle(ctx context.Context, request *connect.Request[grpcgen.RevertArticleRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("RevertArticle").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters",
+			slog.String("article id", request.Msg.GetId()),
+			slog.String("to event id", request.Msg.GetToEventId())))
+
+	inDto := dto.NewRevertArticleInDto(request.Msg.GetId(), request.Msg.GetToEventId(), request.Msg.GetExpectedLastEventId())
+	outDto, err := s.revertArticleUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	response, err := s.revertArticleConverter.ToRevertArticleResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.BloggingEventResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) RenameTag(ctx context.Context, request *connect.Request[grpcgen.RenameTagRequest]) (*connect.Response[grpcgen.RenameTagResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("RenameTag").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters",
+			slog.String("from", request.Msg.GetFrom()),
+			slog.String("to", request.Msg.GetTo())))
+
+	inDto := dto.NewRenameTagInDto(request.Msg.GetFrom(), request.Msg.GetTo())
+	outDto, err := s.renameTagUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	response, err := s.renameTagConverter.ToRenameTagResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.RenameTagResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.RenameTagResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) MergeTags(ctx context.Context, request *connect.Request[grpcgen.MergeTagsRequest]) (*connect.Response[grpcgen.MergeTagsResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("MergeTags").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters",
+			slog.Any("sources", request.Msg.GetSources()),
+			slog.String("into", request.Msg.GetInto())))
+
+	inDto := dto.NewMergeTagsInDto(request.Msg.GetSources(), request.Msg.GetInto())
+	outDto, err := s.mergeTagsUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
 	}
-	response, err := s.createArticleConverter.ToCreateArticleArticleResponse(ctx, outDto)
+	response, err := s.mergeTagsConverter.ToMergeTagsResponse(ctx, outDto)
 	if err != nil {
 		err = errors.WithStack(err)
 		nrtx.NoticeError(nrpkgerrors.Wrap(err))
 		logger.WarnContext(ctx, "END",
 			slog.Group("return",
-				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("grpc.MergeTagsResponse", nil),
 				slog.Any("error", err)))
-		return nil, err
+		return nil, toConnectError(err)
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("return",
-			slog.String("grpc.BloggingEventResponse", response.String())))
+			slog.String("grpc.MergeTagsResponse", response.String())))
 	return connect.NewResponse(response), nil
 }
 
-func (s *BloggingEventServiceServer) UpdateArticleTitle(ctx context.Context, request *connect.Request[grpcgen.UpdateArticleTitleRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+func (s *BloggingEventServiceServer) CreateSeries(ctx context.Context, request *connect.Request[grpcgen.CreateSeriesRequest]) (*connect.Response[grpcgen.SeriesResponse], error) {
 	nrtx := newrelic.FromContext(ctx)
-	defer nrtx.StartSegment("UpdateArticleTitle").End()
+	defer nrtx.StartSegment("CreateSeries").End()
 	logger, err := altnrslog.FromContext(ctx)
 	if err != nil {
 		err = errors.WithStack(err)
@@ -71,32 +625,36 @@ func (s *BloggingEventServiceServer) UpdateArticleTitle(ctx context.Context, req
 		logger = log.DefaultLogger()
 	}
 	logger.InfoContext(ctx, "BEGIN",
-		slog.Group("parameters", slog.String("article id", request.Msg.GetId()), slog.String("title", request.Msg.GetTitle())))
+		slog.Group("parameters",
+			slog.String("title", request.Msg.GetTitle()),
+			slog.Any("articleIds", request.Msg.GetArticleIds())))
 
-	inDto := dto.NewUpdateArticleTitleInDto(request.Msg.GetId(), request.Msg.GetTitle())
-	outDto, err := s.updateArticleTitleUsecase.Execute(ctx, &inDto)
+	inDto := dto.NewCreateSeriesInDto(request.Msg.GetTitle(), request.Msg.GetArticleIds())
+	outDto, err := s.createSeriesUsecase.Execute(ctx, &inDto)
 	if err != nil {
-		return nil, err
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
 	}
-	response, err := s.updateArticleTitleConverter.ToUpdateArticleTitleResponse(ctx, outDto)
+	response, err := s.createSeriesConverter.ToCreateSeriesResponse(ctx, outDto)
 	if err != nil {
 		err = errors.WithStack(err)
 		nrtx.NoticeError(nrpkgerrors.Wrap(err))
 		logger.WarnContext(ctx, "END",
 			slog.Group("return",
-				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("grpc.SeriesResponse", nil),
 				slog.Any("error", err)))
-		return nil, err
+		return nil, toConnectError(err)
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("return",
-			slog.String("grpc.BloggingEventResponse", response.String())))
+			slog.String("grpc.SeriesResponse", response.String())))
 	return connect.NewResponse(response), nil
 }
 
-func (s *BloggingEventServiceServer) UpdateArticleBody(ctx context.Context, request *connect.Request[grpcgen.UpdateArticleBodyRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+func (s *BloggingEventServiceServer) AddArticleToSeries(ctx context.Context, request *connect.Request[grpcgen.AddArticleToSeriesRequest]) (*connect.Response[grpcgen.SeriesResponse], error) {
 	nrtx := newrelic.FromContext(ctx)
-	defer nrtx.StartSegment("UpdateArticleBody").End()
+	defer nrtx.StartSegment("AddArticleToSeries").End()
 	logger, err := altnrslog.FromContext(ctx)
 	if err != nil {
 		err = errors.WithStack(err)
@@ -104,32 +662,37 @@ func (s *BloggingEventServiceServer) UpdateArticleBody(ctx context.Context, requ
 		logger = log.DefaultLogger()
 	}
 	logger.InfoContext(ctx, "BEGIN",
-		slog.Group("parameters", slog.String("article id", request.Msg.GetId()), slog.String("body", request.Msg.GetBody())))
+		slog.Group("parameters",
+			slog.String("seriesId", request.Msg.GetSeriesId()),
+			slog.String("articleId", request.Msg.GetArticleId()),
+			slog.Any("position", request.Msg.Position)))
 
-	inDto := dto.NewUpdateArticleBodyInDto(request.Msg.GetId(), request.Msg.GetBody())
-	outDto, err := s.updateArticleBodyUsecase.Execute(ctx, &inDto)
+	inDto := dto.NewAddArticleToSeriesInDto(request.Msg.GetSeriesId(), request.Msg.GetArticleId(), int(request.Msg.GetPosition()))
+	outDto, err := s.addArticleToSeriesUsecase.Execute(ctx, &inDto)
 	if err != nil {
-		return nil, err
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
 	}
-	response, err := s.updateArticleBodyConverter.ToUpdateArticleBodyResponse(ctx, outDto)
+	response, err := s.addArticleToSeriesConverter.ToAddArticleToSeriesResponse(ctx, outDto)
 	if err != nil {
 		err = errors.WithStack(err)
 		nrtx.NoticeError(nrpkgerrors.Wrap(err))
 		logger.WarnContext(ctx, "END",
 			slog.Group("return",
-				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("grpc.SeriesResponse", nil),
 				slog.Any("error", err)))
-		return nil, err
+		return nil, toConnectError(err)
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("return",
-			slog.String("grpc.BloggingEventResponse", response.String())))
+			slog.String("grpc.SeriesResponse", response.String())))
 	return connect.NewResponse(response), nil
 }
 
-func (s *BloggingEventServiceServer) UpdateArticleThumbnail(ctx context.Context, request *connect.Request[grpcgen.UpdateArticleThumbnailRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+func (s *BloggingEventServiceServer) ReorderSeries(ctx context.Context, request *connect.Request[grpcgen.ReorderSeriesRequest]) (*connect.Response[grpcgen.SeriesResponse], error) {
 	nrtx := newrelic.FromContext(ctx)
-	defer nrtx.StartSegment("UpdateArticleThumbnail").End()
+	defer nrtx.StartSegment("ReorderSeries").End()
 	logger, err := altnrslog.FromContext(ctx)
 	if err != nil {
 		err = errors.WithStack(err)
@@ -137,38 +700,104 @@ func (s *BloggingEventServiceServer) UpdateArticleThumbnail(ctx context.Context,
 		logger = log.DefaultLogger()
 	}
 	logger.InfoContext(ctx, "BEGIN",
-		slog.Group("parameters", slog.String("article id", request.Msg.GetId()), slog.String("thumbnail", request.Msg.GetThumbnailUrl())))
+		slog.Group("parameters",
+			slog.String("seriesId", request.Msg.GetSeriesId()),
+			slog.Any("articleIds", request.Msg.GetArticleIds())))
 
-	thumbnailUrl, err := url.Parse(request.Msg.GetThumbnailUrl())
+	inDto := dto.NewReorderSeriesInDto(request.Msg.GetSeriesId(), request.Msg.GetArticleIds())
+	outDto, err := s.reorderSeriesUsecase.Execute(ctx, &inDto)
 	if err != nil {
 		err = errors.WithStack(err)
 		nrtx.NoticeError(nrpkgerrors.Wrap(err))
-		return nil, err
+		return nil, toConnectError(err)
 	}
-	inDto := dto.NewUpdateArticleThumbnailInDto(request.Msg.GetId(), *thumbnailUrl)
-	outDto, err := s.updateArticleThumbnailUsecase.Execute(ctx, &inDto)
+	response, err := s.reorderSeriesConverter.ToReorderSeriesResponse(ctx, outDto)
 	if err != nil {
-		return nil, err
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.SeriesResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
 	}
-	response, err := s.updateArticleThumbnailConverter.ToUpdateArticleThumbnailResponse(ctx, outDto)
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.SeriesResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) GetDraft(ctx context.Context, request *connect.Request[grpcgen.GetDraftRequest]) (*connect.Response[grpcgen.GetDraftResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("GetDraft").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("article id", request.Msg.GetId())))
+
+	inDto := dto.NewGetDraftInDto(request.Msg.GetId())
+	outDto, err := s.getDraftUsecase.Execute(ctx, &inDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	response, err := s.getDraftConverter.ToGetDraftResponse(ctx, outDto)
 	if err != nil {
 		err = errors.WithStack(err)
 		nrtx.NoticeError(nrpkgerrors.Wrap(err))
 		logger.WarnContext(ctx, "END",
 			slog.Group("return",
-				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("grpc.GetDraftResponse", nil),
 				slog.Any("error", err)))
-		return nil, err
+		return nil, toConnectError(err)
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("return",
-			slog.String("grpc.BloggingEventResponse", response.String())))
+			slog.String("grpc.GetDraftResponse", response.String())))
 	return connect.NewResponse(response), nil
 }
 
-func (s *BloggingEventServiceServer) AttachTags(ctx context.Context, request *connect.Request[grpcgen.AttachTagsRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+func (s *BloggingEventServiceServer) ListDrafts(ctx context.Context, _ *connect.Request[grpcgen.ListDraftsRequest]) (*connect.Response[grpcgen.ListDraftsResponse], error) {
 	nrtx := newrelic.FromContext(ctx)
-	defer nrtx.StartSegment("AttachTag").End()
+	defer nrtx.StartSegment("ListDrafts").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+
+	outDto, err := s.listDraftsUsecase.Execute(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, toConnectError(err)
+	}
+	response, err := s.listDraftsConverter.ToListDraftsResponse(ctx, outDto)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.ListDraftsResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.String("grpc.ListDraftsResponse", response.String())))
+	return connect.NewResponse(response), nil
+}
+
+func (s *BloggingEventServiceServer) ListArticleEvents(ctx context.Context, request *connect.Request[grpcgen.ListArticleEventsRequest]) (*connect.Response[grpcgen.ListArticleEventsResponse], error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ListArticleEvents").End()
 	logger, err := altnrslog.FromContext(ctx)
 	if err != nil {
 		err = errors.WithStack(err)
@@ -176,34 +805,34 @@ func (s *BloggingEventServiceServer) AttachTags(ctx context.Context, request *co
 		logger = log.DefaultLogger()
 	}
 	logger.InfoContext(ctx, "BEGIN",
-		slog.Group("parameters", slog.String("article id", request.Msg.GetId()), slog.Any("attach_tag", request.Msg.GetTagNames())))
+		slog.Group("parameters", slog.String("article id", request.Msg.GetId())))
 
-	inDto := dto.NewAttachTagsInDto(request.Msg.GetId(), request.Msg.GetTagNames())
-	outDto, err := s.attachTagUsecase.Execute(ctx, &inDto)
+	inDto := dto.NewListArticleEventsInDto(request.Msg.GetId())
+	outDto, err := s.listArticleEventsUsecase.Execute(ctx, &inDto)
 	if err != nil {
 		err = errors.WithStack(err)
 		nrtx.NoticeError(nrpkgerrors.Wrap(err))
-		return nil, err
+		return nil, toConnectError(err)
 	}
-	response, err := s.attachTagConverter.ToAttachTagsResponse(ctx, outDto)
+	response, err := s.listArticleEventsConverter.ToListArticleEventsResponse(ctx, outDto)
 	if err != nil {
 		err = errors.WithStack(err)
 		nrtx.NoticeError(nrpkgerrors.Wrap(err))
 		logger.WarnContext(ctx, "END",
 			slog.Group("return",
-				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("grpc.ListArticleEventsResponse", nil),
 				slog.Any("error", err)))
-		return nil, err
+		return nil, toConnectError(err)
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("return",
-			slog.String("grpc.BloggingEventResponse", response.String())))
+			slog.String("grpc.ListArticleEventsResponse", response.String())))
 	return connect.NewResponse(response), nil
 }
 
-func (s *BloggingEventServiceServer) DetachTags(ctx context.Context, request *connect.Request[grpcgen.DetachTagsRequest]) (*connect.Response[grpcgen.BloggingEventResponse], error) {
+func (s *BloggingEventServiceServer) GetArticleAt(ctx context.Context, request *connect.Request[grpcgen.GetArticleAtRequest]) (*connect.Response[grpcgen.GetArticleAtResponse], error) {
 	nrtx := newrelic.FromContext(ctx)
-	defer nrtx.StartSegment("DetachTag").End()
+	defer nrtx.StartSegment("GetArticleAt").End()
 	logger, err := altnrslog.FromContext(ctx)
 	if err != nil {
 		err = errors.WithStack(err)
@@ -211,28 +840,36 @@ func (s *BloggingEventServiceServer) DetachTags(ctx context.Context, request *co
 		logger = log.DefaultLogger()
 	}
 	logger.InfoContext(ctx, "BEGIN",
-		slog.Group("parameters", slog.String("article id", request.Msg.GetId()), slog.Any("detach_tag", request.Msg.GetTagNames())))
+		slog.Group("parameters",
+			slog.String("article id", request.Msg.GetId()),
+			slog.String("event id", request.Msg.GetEventId()),
+			slog.Any("timestamp", request.Msg.GetTimestamp())))
 
-	inDto := dto.NewDetachTagsInDto(request.Msg.GetId(), request.Msg.GetTagNames())
-	outDto, err := s.detachTagUsecase.Execute(ctx, &inDto)
+	var timestamp *time.Time
+	if request.Msg.GetTimestamp() != nil {
+		v := request.Msg.GetTimestamp().AsTime()
+		timestamp = &v
+	}
+	inDto := dto.NewGetArticleAtInDto(request.Msg.GetId(), request.Msg.GetEventId(), timestamp)
+	outDto, err := s.getArticleAtUsecase.Execute(ctx, &inDto)
 	if err != nil {
 		err = errors.WithStack(err)
 		nrtx.NoticeError(nrpkgerrors.Wrap(err))
-		return nil, err
+		return nil, toConnectError(err)
 	}
-	response, err := s.detachTagConverter.ToDetachTagsResponse(ctx, outDto)
+	response, err := s.getArticleAtConverter.ToGetArticleAtResponse(ctx, outDto)
 	if err != nil {
 		err = errors.WithStack(err)
 		nrtx.NoticeError(nrpkgerrors.Wrap(err))
 		logger.WarnContext(ctx, "END",
 			slog.Group("return",
-				slog.Any("grpc.BloggingEventResponse", nil),
+				slog.Any("grpc.GetArticleAtResponse", nil),
 				slog.Any("error", err)))
-		return nil, err
+		return nil, toConnectError(err)
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("return",
-			slog.String("grpc.BloggingEventResponse", response.String())))
+			slog.String("grpc.GetArticleAtResponse", response.String())))
 	return connect.NewResponse(response), nil
 }
 
@@ -247,36 +884,31 @@ func (s *BloggingEventServiceServer) UploadImage(ctx context.Context, streamingS
 	}
 	logger.InfoContext(ctx, "BEGIN")
 
-	var (
-		binary      []byte
-		fileName    string
-		contentType string
-	)
-	buf := bytes.NewBuffer(binary)
-
-	for streamingServer.Receive() {
-		messege := streamingServer.Msg()
-		if data := messege.GetData(); len(data) > 0 {
-			logger.InfoContext(ctx, "Received data", slog.Group("data", slog.String("data", string(data))))
-			buf.Write(data)
-		}
-		if meta := messege.GetMeta(); meta != nil {
-			logger.InfoContext(ctx, "Received meta", slog.Group("meta", slog.String("name", meta.GetName())))
-			fileName = meta.GetName()
-			contentType = meta.GetContentType()
+	// the first message carries the meta of the image, and the data follows, which is passed on as it is received.
+	if !streamingServer.Receive() {
+		err := streamingServer.Err()
+		if err == nil {
+			err = errors.Wrap(model.ErrValidation, "no image is sent")
 		}
-	}
-
-	if err := streamingServer.Err(); err != nil {
 		err = errors.WithStack(err)
 		logger.WarnContext(ctx, "END",
 			slog.Group("return",
 				slog.Any("grpc.UploadImageResponse", nil),
 				slog.Any("error", err)))
-		return nil, err
+		return nil, toConnectError(err)
+	}
+	meta := streamingServer.Msg().GetMeta()
+	if meta == nil {
+		err := errors.Wrap(model.ErrValidation, "the meta of the image must be sent first")
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("grpc.UploadImageResponse", nil),
+				slog.Any("error", err)))
+		return nil, toConnectError(err)
 	}
+	logger.InfoContext(ctx, "Received meta", slog.Group("meta", slog.String("name", meta.GetName())))
 
-	inDto := dto.NewUploadImageInDto(fileName, buf.Bytes(), contentType)
+	inDto := dto.NewUploadImageInDto(meta.GetName(), &imageStream{stream: streamingServer}, meta.GetContentType(), meta.GetKeepMetadata())
 	outDto, err := s.uploadImageUsecase.Execute(ctx, &inDto)
 	if err != nil {
 		err = errors.WithStack(err)
@@ -284,7 +916,7 @@ func (s *BloggingEventServiceServer) UploadImage(ctx context.Context, streamingS
 			slog.Group("return",
 				slog.Any("grpc.UploadImageResponse", nil),
 				slog.Any("error", err)))
-		return nil, err
+		return nil, toConnectError(err)
 	}
 	response, err := s.uploadImageConverter.ToUploadImageResponse(ctx, outDto)
 	if err != nil {
@@ -293,7 +925,7 @@ func (s *BloggingEventServiceServer) UploadImage(ctx context.Context, streamingS
 			slog.Group("return",
 				slog.Any("grpc.UploadImageResponse", nil),
 				slog.Any("error", err)))
-		return nil, err
+		return nil, toConnectError(err)
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("return",
@@ -301,11 +933,101 @@ func (s *BloggingEventServiceServer) UploadImage(ctx context.Context, streamingS
 	return connect.NewResponse(response), nil
 }
 
+// imageStream reads the data of the image from the messages following the meta.
+type imageStream struct {
+	stream *connect.ClientStream[grpcgen.UploadImageRequest]
+	data   []byte
+}
+
+func (r *imageStream) Read(b []byte) (int, error) {
+	for len(r.data) == 0 {
+		if !r.stream.Receive() {
+			if err := r.stream.Err(); err != nil {
+				return 0, errors.WithStack(err)
+			}
+			return 0, io.EOF
+		}
+		if r.stream.Msg().GetMeta() != nil {
+			return 0, errors.Wrap(model.ErrValidation, "the meta of the image must be sent only once")
+		}
+		r.data = r.stream.Msg().GetData()
+	}
+	n := copy(b, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// imageVariantDtos converts the image variants of the request into the DTOs.
+// A variant whose url cannot be parsed is reported as a violation of the field.
+func imageVariantDtos(field string, variants []*grpcgen.ImageVariant) ([]dto.ImageVariantDto, error) {
+	if len(variants) == 0 {
+		return nil, nil
+	}
+	result := make([]dto.ImageVariantDto, 0, len(variants))
+	var violations []model.FieldViolation
+	for i, v := range variants {
+		uri, err := url.Parse(v.GetUrl())
+		if err != nil {
+			violations = append(violations, model.NewFieldViolation(fmt.Sprintf("%s[%d].url", field, i), "url must be an absolute http or https url"))
+			continue
+		}
+		result = append(result, dto.NewImageVariantDto(*uri, int(v.GetWidth()), int(v.GetHeight()), v.GetContentType()))
+	}
+	if len(violations) > 0 {
+		return nil, errors.WithStack(model.NewValidationError(violations...))
+	}
+	return result, nil
+}
+
+// toConnectError converts the error to the connect error with the code of its kind.
+// Errors of unknown kind are regarded as internal errors.
+func toConnectError(err error) error {
+	var connectErr *connect.Error
+	switch {
+	case errors.As(err, &connectErr):
+		return err
+	case errors.Is(err, model.ErrValidation):
+		return invalidArgumentError(err)
+	case errors.Is(err, model.ErrNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, model.ErrConflict):
+		return connect.NewError(connect.CodeAborted, err)
+	case errors.Is(err, model.ErrIdempotencyKeyReused):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, model.ErrUnavailable):
+		return connect.NewError(connect.CodeUnavailable, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+// invalidArgumentError converts the validation error to the connect error.
+// The violations of a model.ValidationError are attached as the field violations of a BadRequest detail.
+func invalidArgumentError(err error) *connect.Error {
+	connectErr := connect.NewError(connect.CodeInvalidArgument, err)
+	var validationErr *model.ValidationError
+	if !errors.As(err, &validationErr) {
+		return connectErr
+	}
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range validationErr.Violations() {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field(),
+			Description: v.Description(),
+		})
+	}
+	if detail, detailErr := connect.NewErrorDetail(badRequest); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
 type bloggingEventServiceServerConfig struct {
 	createArticleUsecase            usecase.CreateArticle
 	createArticleConverter          presenters.ToCreateArticleResponse
 	updateArticleTitleUsecase       usecase.UpdateArticleTitle
 	updateArticleTitleConverter     presenters.ToUpdateArticleTitleResponse
+	updateArticleSlugUsecase        usecase.UpdateArticleSlug
+	updateArticleSlugConverter      presenters.ToUpdateArticleSlugResponse
 	updateArticleBodyUsecase        usecase.UpdateArticleBody
 	updateArticleBodyConverter      presenters.ToUpdateArticleBodyResponse
 	updateArticleThumbnailUsecase   usecase.UpdateArticleThumbnail
@@ -314,6 +1036,36 @@ type bloggingEventServiceServerConfig struct {
 	attachTagConverter              presenters.ToAttachTagsResponse
 	detachTagUsecase                usecase.DetachTags
 	detachTagConverter              presenters.ToDetachTagsResponse
+	hideArticleUsecase              usecase.HideArticle
+	hideArticleConverter            presenters.ToHideArticleResponse
+	unhideArticleUsecase            usecase.UnhideArticle
+	unhideArticleConverter          presenters.ToUnhideArticleResponse
+	editArticleUsecase              usecase.EditArticle
+	editArticleConverter            presenters.ToEditArticleResponse
+	scheduleArticleUsecase          usecase.ScheduleArticle
+	scheduleArticleConverter        presenters.ToScheduleArticleResponse
+	publishArticleUsecase           usecase.PublishArticle
+	publishArticleConverter         presenters.ToPublishArticleResponse
+	revertArticleUsecase            usecase.RevertArticle
+	revertArticleConverter          presenters.ToRevertArticleResponse
+	renameTagUsecase                usecase.RenameTag
+	renameTagConverter              presenters.ToRenameTagResponse
+	mergeTagsUsecase                usecase.MergeTags
+	mergeTagsConverter              presenters.ToMergeTagsResponse
+	createSeriesUsecase             usecase.CreateSeries
+	createSeriesConverter           presenters.ToCreateSeriesResponse
+	addArticleToSeriesUsecase       usecase.AddArticleToSeries
+	addArticleToSeriesConverter     presenters.ToAddArticleToSeriesResponse
+	reorderSeriesUsecase            usecase.ReorderSeries
+	reorderSeriesConverter          presenters.ToReorderSeriesResponse
+	getDraftUsecase                 usecase.GetDraft
+	getDraftConverter               presenters.ToGetDraftResponse
+	listDraftsUsecase               usecase.ListDrafts
+	listDraftsConverter             presenters.ToListDraftsResponse
+	listArticleEventsUsecase        usecase.ListArticleEvents
+	listArticleEventsConverter      presenters.ToListArticleEventsResponse
+	getArticleAtUsecase             usecase.GetArticleAt
+	getArticleAtConverter           presenters.ToGetArticleAtResponse
 	uploadImageUsecase              usecase.UploadImage
 	uploadImageConverter            presenters.ToUploadImageResponse
 }
@@ -344,6 +1096,18 @@ func WithUpdateArticleTitleConverter(updateArticleTitleConverter presenters.ToUp
 	}
 }
 
+func WithUpdateArticleSlugUsecase(updateArticleSlugUsecase usecase.UpdateArticleSlug) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.updateArticleSlugUsecase = updateArticleSlugUsecase
+	}
+}
+
+func WithUpdateArticleSlugConverter(updateArticleSlugConverter presenters.ToUpdateArticleSlugResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.updateArticleSlugConverter = updateArticleSlugConverter
+	}
+}
+
 func WithUpdateArticleBodyUsecase(updateArticleBodyUsecase usecase.UpdateArticleBody) BloggingEventServiceServerOption {
 	return func(c *bloggingEventServiceServerConfig) {
 		c.updateArticleBodyUsecase = updateArticleBodyUsecase
@@ -392,6 +1156,186 @@ func WithDetachTagsConverter(detachTagConverter presenters.ToDetachTagsResponse)
 	}
 }
 
+func WithHideArticleUsecase(hideArticleUsecase usecase.HideArticle) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.hideArticleUsecase = hideArticleUsecase
+	}
+}
+
+func WithHideArticleConverter(hideArticleConverter presenters.ToHideArticleResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.hideArticleConverter = hideArticleConverter
+	}
+}
+
+func WithUnhideArticleUsecase(unhideArticleUsecase usecase.UnhideArticle) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.unhideArticleUsecase = unhideArticleUsecase
+	}
+}
+
+func WithUnhideArticleConverter(unhideArticleConverter presenters.ToUnhideArticleResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.unhideArticleConverter = unhideArticleConverter
+	}
+}
+
+func WithEditArticleUsecase(editArticleUsecase usecase.EditArticle) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.editArticleUsecase = editArticleUsecase
+	}
+}
+
+func WithEditArticleConverter(editArticleConverter presenters.ToEditArticleResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.editArticleConverter = editArticleConverter
+	}
+}
+
+func WithScheduleArticleUsecase(scheduleArticleUsecase usecase.ScheduleArticle) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.scheduleArticleUsecase = scheduleArticleUsecase
+	}
+}
+
+func WithScheduleArticleConverter(scheduleArticleConverter presenters.ToScheduleArticleResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.scheduleArticleConverter = scheduleArticleConverter
+	}
+}
+
+func WithPublishArticleUsecase(publishArticleUsecase usecase.PublishArticle) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.publishArticleUsecase = publishArticleUsecase
+	}
+}
+
+func WithPublishArticleConverter(publishArticleConverter presenters.ToPublishArticleResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.publishArticleConverter = publishArticleConverter
+	}
+}
+
+func WithRevertArticleUsecase(revertArticleUsecase usecase.RevertArticle) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.revertArticleUsecase = revertArticleUsecase
+	}
+}
+
+func WithRevertArticleConverter(revertArticleConverter presenters.ToRevertArticleResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.revertArticleConverter = revertArticleConverter
+	}
+}
+
+func WithRenameTagUsecase(renameTagUsecase usecase.RenameTag) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.renameTagUsecase = renameTagUsecase
+	}
+}
+
+func WithRenameTagConverter(renameTagConverter presenters.ToRenameTagResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.renameTagConverter = renameTagConverter
+	}
+}
+
+func WithMergeTagsUsecase(mergeTagsUsecase usecase.MergeTags) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.mergeTagsUsecase = mergeTagsUsecase
+	}
+}
+
+func WithMergeTagsConverter(mergeTagsConverter presenters.ToMergeTagsResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.mergeTagsConverter = mergeTagsConverter
+	}
+}
+
+func WithCreateSeriesUsecase(createSeriesUsecase usecase.CreateSeries) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.createSeriesUsecase = createSeriesUsecase
+	}
+}
+
+func WithCreateSeriesConverter(createSeriesConverter presenters.ToCreateSeriesResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.createSeriesConverter = createSeriesConverter
+	}
+}
+
+func WithAddArticleToSeriesUsecase(addArticleToSeriesUsecase usecase.AddArticleToSeries) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.addArticleToSeriesUsecase = addArticleToSeriesUsecase
+	}
+}
+
+func WithAddArticleToSeriesConverter(addArticleToSeriesConverter presenters.ToAddArticleToSeriesResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.addArticleToSeriesConverter = addArticleToSeriesConverter
+	}
+}
+
+func WithReorderSeriesUsecase(reorderSeriesUsecase usecase.ReorderSeries) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.reorderSeriesUsecase = reorderSeriesUsecase
+	}
+}
+
+func WithReorderSeriesConverter(reorderSeriesConverter presenters.ToReorderSeriesResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.reorderSeriesConverter = reorderSeriesConverter
+	}
+}
+
+func WithGetDraftUsecase(getDraftUsecase usecase.GetDraft) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.getDraftUsecase = getDraftUsecase
+	}
+}
+
+func WithGetDraftConverter(getDraftConverter presenters.ToGetDraftResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.getDraftConverter = getDraftConverter
+	}
+}
+
+func WithListDraftsUsecase(listDraftsUsecase usecase.ListDrafts) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.listDraftsUsecase = listDraftsUsecase
+	}
+}
+
+func WithListDraftsConverter(listDraftsConverter presenters.ToListDraftsResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.listDraftsConverter = listDraftsConverter
+	}
+}
+
+func WithListArticleEventsUsecase(listArticleEventsUsecase usecase.ListArticleEvents) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.listArticleEventsUsecase = listArticleEventsUsecase
+	}
+}
+
+func WithListArticleEventsConverter(listArticleEventsConverter presenters.ToListArticleEventsResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.listArticleEventsConverter = listArticleEventsConverter
+	}
+}
+
+func WithGetArticleAtUsecase(getArticleAtUsecase usecase.GetArticleAt) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.getArticleAtUsecase = getArticleAtUsecase
+	}
+}
+
+func WithGetArticleAtConverter(getArticleAtConverter presenters.ToGetArticleAtResponse) BloggingEventServiceServerOption {
+	return func(c *bloggingEventServiceServerConfig) {
+		c.getArticleAtConverter = getArticleAtConverter
+	}
+}
+
 func WithUploadImageUsecase(uploadImageUsecase usecase.UploadImage) BloggingEventServiceServerOption {
 	return func(c *bloggingEventServiceServerConfig) {
 		c.uploadImageUsecase = uploadImageUsecase
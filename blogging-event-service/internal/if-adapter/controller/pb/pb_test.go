@@ -2,7 +2,9 @@ package pb
 
 import (
 	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
 	"blogapi.miyamo.today/blogging-event-service/internal/infra/grpc"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/grpc/grpcconnect"
 	mpresenter "blogapi.miyamo.today/blogging-event-service/internal/mock/if-adapter/controller/pb/presenter"
 	musecase "blogapi.miyamo.today/blogging-event-service/internal/mock/if-adapter/controller/pb/usecase"
 	"blogapi.miyamo.today/blogging-event-service/internal/pkg"
@@ -12,8 +14,15 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestBloggingEventServiceServer_CreateArticle(t *testing.T) {
@@ -38,7 +47,7 @@ func TestBloggingEventServiceServer_CreateArticle(t *testing.T) {
 		"happy_path": {
 			outDto: dto.NewCreateArticleOutDto("eventID", "articleID"),
 			setupUsecase: func(out dto.CreateArticleOutDto, u *musecase.MockCreateArticle) {
-				in := dto.NewCreateArticleInDto("title", "body", "https://example.com/example.jpg", []string{"tag1", "tag2"})
+				in := dto.NewCreateArticleInDto("title", "body", "https://example.com/example.jpg", nil, []string{"tag1", "tag2"}, time.Time{}, false, "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, nil).
@@ -63,10 +72,39 @@ func TestBloggingEventServiceServer_CreateArticle(t *testing.T) {
 				response: connect.NewResponse(&grpc.BloggingEventResponse{EventId: "eventID", ArticleId: "articleID"}),
 			},
 		},
+		"happy_path/draft": {
+			outDto: dto.NewCreateArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.CreateArticleOutDto, u *musecase.MockCreateArticle) {
+				in := dto.NewCreateArticleInDto("title", "body", "https://example.com/example.jpg", nil, []string{"tag1", "tag2"}, time.Time{}, true, "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.CreateArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToCreateArticleResponse) {
+				conv.EXPECT().
+					ToCreateArticleArticleResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.CreateArticleRequest{
+					Title:        "title",
+					Body:         "body",
+					ThumbnailUrl: "https://example.com/example.jpg",
+					TagNames:     []string{"tag1", "tag2"},
+					Draft:        proto.Bool(true),
+				}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.BloggingEventResponse{EventId: "eventID", ArticleId: "articleID"}),
+			},
+		},
 		"unhappy_path/usecase-returns-error": {
 			outDto: dto.NewCreateArticleOutDto("", ""),
 			setupUsecase: func(out dto.CreateArticleOutDto, u *musecase.MockCreateArticle) {
-				in := dto.NewCreateArticleInDto("title", "body", "https://example.com/example.jpg", []string{"tag1", "tag2"})
+				in := dto.NewCreateArticleInDto("title", "body", "https://example.com/example.jpg", nil, []string{"tag1", "tag2"}, time.Time{}, false, "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, errInUsecase).
@@ -93,7 +131,7 @@ func TestBloggingEventServiceServer_CreateArticle(t *testing.T) {
 		"unhappy_path/converter-returns-error": {
 			outDto: dto.NewCreateArticleOutDto("eventID", "articleID"),
 			setupUsecase: func(out dto.CreateArticleOutDto, u *musecase.MockCreateArticle) {
-				in := dto.NewCreateArticleInDto("title", "body", "https://example.com/example.jpg", []string{"tag1", "tag2"})
+				in := dto.NewCreateArticleInDto("title", "body", "https://example.com/example.jpg", nil, []string{"tag1", "tag2"}, time.Time{}, false, "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, nil).
@@ -169,7 +207,7 @@ func TestBloggingEventServiceServer_UpdateArticleTitle(t *testing.T) {
 		"happy_path": {
 			outDto: dto.NewUpdateArticleTitleOutDto("eventID", "articleID"),
 			setupUsecase: func(out dto.UpdateArticleTitleOutDto, u *musecase.MockUpdateArticleTitle) {
-				in := dto.NewUpdateArticleTitleInDto("articleID", "title")
+				in := dto.NewUpdateArticleTitleInDto("articleID", "title", "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, nil).
@@ -195,7 +233,7 @@ func TestBloggingEventServiceServer_UpdateArticleTitle(t *testing.T) {
 		"unhappy_path/usecase-returns-error": {
 			outDto: dto.NewUpdateArticleTitleOutDto("", ""),
 			setupUsecase: func(out dto.UpdateArticleTitleOutDto, u *musecase.MockUpdateArticleTitle) {
-				in := dto.NewUpdateArticleTitleInDto("articleID", "title")
+				in := dto.NewUpdateArticleTitleInDto("articleID", "title", "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, errInUsecase).
@@ -217,10 +255,36 @@ func TestBloggingEventServiceServer_UpdateArticleTitle(t *testing.T) {
 				err: errInUsecase,
 			},
 		},
+		"unhappy_path/usecase-returns-conflict": {
+			outDto: dto.NewUpdateArticleTitleOutDto("", ""),
+			setupUsecase: func(out dto.UpdateArticleTitleOutDto, u *musecase.MockUpdateArticleTitle) {
+				in := dto.NewUpdateArticleTitleInDto("articleID", "title", "lastEventID")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(nil, model.ErrConflict).
+					Times(1)
+			},
+			setupConverter: func(from dto.UpdateArticleTitleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToUpdateArticleTitleResponse) {
+				conv.EXPECT().
+					ToUpdateArticleTitleResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.UpdateArticleTitleRequest{
+					Id:                  "articleID",
+					Title:               "title",
+					ExpectedLastEventId: proto.String("lastEventID"),
+				}),
+			},
+			want: want{
+				err: model.ErrConflict,
+			},
+		},
 		"unhappy_path/converter-returns-error": {
 			outDto: dto.NewUpdateArticleTitleOutDto("eventID", "articleID"),
 			setupUsecase: func(out dto.UpdateArticleTitleOutDto, u *musecase.MockUpdateArticleTitle) {
-				in := dto.NewUpdateArticleTitleInDto("articleID", "title")
+				in := dto.NewUpdateArticleTitleInDto("articleID", "title", "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, nil).
@@ -271,6 +335,158 @@ func TestBloggingEventServiceServer_UpdateArticleTitle(t *testing.T) {
 	}
 }
 
+func TestBloggingEventServiceServer_UpdateArticleSlug(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.UpdateArticleSlugRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.BloggingEventResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.UpdateArticleSlugOutDto
+		setupUsecase   func(out dto.UpdateArticleSlugOutDto, u *musecase.MockUpdateArticleSlug)
+		setupConverter func(from dto.UpdateArticleSlugOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToUpdateArticleSlugResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewUpdateArticleSlugOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.UpdateArticleSlugOutDto, u *musecase.MockUpdateArticleSlug) {
+				in := dto.NewUpdateArticleSlugInDto("articleID", "hello-world", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.UpdateArticleSlugOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToUpdateArticleSlugResponse) {
+				conv.EXPECT().ToUpdateArticleSlugResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(
+					&grpc.UpdateArticleSlugRequest{
+						Id:   "articleID",
+						Slug: "hello-world",
+					}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.BloggingEventResponse{EventId: "eventID", ArticleId: "articleID"}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			outDto: dto.NewUpdateArticleSlugOutDto("", ""),
+			setupUsecase: func(out dto.UpdateArticleSlugOutDto, u *musecase.MockUpdateArticleSlug) {
+				in := dto.NewUpdateArticleSlugInDto("articleID", "hello-world", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.UpdateArticleSlugOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToUpdateArticleSlugResponse) {
+				conv.EXPECT().
+					ToUpdateArticleSlugResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.UpdateArticleSlugRequest{
+					Id:   "articleID",
+					Slug: "hello-world",
+				}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/usecase-returns-conflict": {
+			outDto: dto.NewUpdateArticleSlugOutDto("", ""),
+			setupUsecase: func(out dto.UpdateArticleSlugOutDto, u *musecase.MockUpdateArticleSlug) {
+				in := dto.NewUpdateArticleSlugInDto("articleID", "hello-world", "lastEventID")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(nil, model.ErrConflict).
+					Times(1)
+			},
+			setupConverter: func(from dto.UpdateArticleSlugOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToUpdateArticleSlugResponse) {
+				conv.EXPECT().
+					ToUpdateArticleSlugResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.UpdateArticleSlugRequest{
+					Id:                  "articleID",
+					Slug:                "hello-world",
+					ExpectedLastEventId: proto.String("lastEventID"),
+				}),
+			},
+			want: want{
+				err: model.ErrConflict,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewUpdateArticleSlugOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.UpdateArticleSlugOutDto, u *musecase.MockUpdateArticleSlug) {
+				in := dto.NewUpdateArticleSlugInDto("articleID", "hello-world", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.UpdateArticleSlugOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToUpdateArticleSlugResponse) {
+				conv.EXPECT().
+					ToUpdateArticleSlugResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.UpdateArticleSlugRequest{
+					Id:   "articleID",
+					Slug: "hello-world",
+				}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockUpdateArticleSlug(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.BloggingEventResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToUpdateArticleSlugResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithUpdateArticleSlugUsecase(u), WithUpdateArticleSlugConverter(conv))
+			got, err := s.UpdateArticleSlug(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("CreateArticle() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.BloggingEventResponse]{})}...); diff != "" {
+				t.Errorf("GetArticleById() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
 func TestBloggingEventServiceServer_UpdateArticleBody(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -295,7 +511,7 @@ func TestBloggingEventServiceServer_UpdateArticleBody(t *testing.T) {
 		"happy_path": {
 			outDto: dto.NewUpdateArticleBodyOutDto("eventID", "articleID"),
 			setupUsecase: func(out dto.UpdateArticleBodyOutDto, u *musecase.MockUpdateArticleBody) {
-				in := dto.NewUpdateArticleBodyInDto("articleID", "body")
+				in := dto.NewUpdateArticleBodyInDto("articleID", "body", "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, nil).
@@ -320,7 +536,7 @@ func TestBloggingEventServiceServer_UpdateArticleBody(t *testing.T) {
 		"unhappy_path/usecase-returns-error": {
 			outDto: dto.NewUpdateArticleBodyOutDto("", ""),
 			setupUsecase: func(out dto.UpdateArticleBodyOutDto, u *musecase.MockUpdateArticleBody) {
-				in := dto.NewUpdateArticleBodyInDto("articleID", "body")
+				in := dto.NewUpdateArticleBodyInDto("articleID", "body", "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, errInUsecase).
@@ -345,7 +561,7 @@ func TestBloggingEventServiceServer_UpdateArticleBody(t *testing.T) {
 		"unhappy_path/converter-returns-error": {
 			outDto: dto.NewUpdateArticleBodyOutDto("eventID", "articleID"),
 			setupUsecase: func(out dto.UpdateArticleBodyOutDto, u *musecase.MockUpdateArticleBody) {
-				in := dto.NewUpdateArticleBodyInDto("articleID", "body")
+				in := dto.NewUpdateArticleBodyInDto("articleID", "body", "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, nil).
@@ -420,7 +636,38 @@ func TestBloggingEventServiceServer_UpdateArticleThumbnail(t *testing.T) {
 		"happy_path": {
 			outDto: dto.NewUpdateArticleThumbnailOutDto("eventID", "articleID"),
 			setupUsecase: func(out dto.UpdateArticleThumbnailOutDto, u *musecase.MockUpdateArticleThumbnail) {
-				in := dto.NewUpdateArticleThumbnailInDto("articleID", *pkg.MustParseURL("https://example.com/example.jpg"))
+				in := dto.NewUpdateArticleThumbnailInDto("articleID", *pkg.MustParseURL("https://example.com/example.jpg"), nil, "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.UpdateArticleThumbnailOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToUpdateArticleThumbnailResponse) {
+				conv.EXPECT().ToUpdateArticleThumbnailResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.UpdateArticleThumbnailRequest{
+					Id:           "articleID",
+					ThumbnailUrl: "https://example.com/example.jpg",
+				}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.BloggingEventResponse{EventId: "eventID", ArticleId: "articleID"}),
+			},
+		},
+		"happy_path/thumbnail_variants": {
+			outDto: dto.NewUpdateArticleThumbnailOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.UpdateArticleThumbnailOutDto, u *musecase.MockUpdateArticleThumbnail) {
+				in := dto.NewUpdateArticleThumbnailInDto(
+					"articleID",
+					*pkg.MustParseURL("https://example.com/example.jpg"),
+					[]dto.ImageVariantDto{
+						dto.NewImageVariantDto(*pkg.MustParseURL("https://example.com/example-640w.webp"), 640, 480, "image/webp"),
+					},
+					"")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, nil).
@@ -436,16 +683,45 @@ func TestBloggingEventServiceServer_UpdateArticleThumbnail(t *testing.T) {
 				in: connect.NewRequest(&grpc.UpdateArticleThumbnailRequest{
 					Id:           "articleID",
 					ThumbnailUrl: "https://example.com/example.jpg",
+					ThumbnailVariants: []*grpc.ImageVariant{
+						{Url: "https://example.com/example-640w.webp", Width: 640, Height: 480, ContentType: "image/webp"},
+					},
 				}),
 			},
 			want: want{
 				response: connect.NewResponse(&grpc.BloggingEventResponse{EventId: "eventID", ArticleId: "articleID"}),
 			},
 		},
+		"unhappy_path/invalid-thumbnail-variant-url": {
+			outDto: dto.NewUpdateArticleThumbnailOutDto("", ""),
+			setupUsecase: func(out dto.UpdateArticleThumbnailOutDto, u *musecase.MockUpdateArticleThumbnail) {
+				u.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupConverter: func(from dto.UpdateArticleThumbnailOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToUpdateArticleThumbnailResponse) {
+				conv.EXPECT().
+					ToUpdateArticleThumbnailResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.UpdateArticleThumbnailRequest{
+					Id:           "articleID",
+					ThumbnailUrl: "https://example.com/example.jpg",
+					ThumbnailVariants: []*grpc.ImageVariant{
+						{Url: "https://example.com/%zz", Width: 640, Height: 480, ContentType: "image/webp"},
+					},
+				}),
+			},
+			want: want{
+				err: model.ErrValidation,
+			},
+		},
 		"unhappy_path/usecase-returns-error": {
 			outDto: dto.NewUpdateArticleThumbnailOutDto("", ""),
 			setupUsecase: func(out dto.UpdateArticleThumbnailOutDto, u *musecase.MockUpdateArticleThumbnail) {
-				in := dto.NewUpdateArticleThumbnailInDto("articleID", *pkg.MustParseURL("https://example.com/example.jpg"))
+				in := dto.NewUpdateArticleThumbnailInDto("articleID", *pkg.MustParseURL("https://example.com/example.jpg"), nil, "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, errInUsecase).
@@ -470,7 +746,7 @@ func TestBloggingEventServiceServer_UpdateArticleThumbnail(t *testing.T) {
 		"unhappy_path/converter-returns-error": {
 			outDto: dto.NewUpdateArticleThumbnailOutDto("eventID", "articleID"),
 			setupUsecase: func(out dto.UpdateArticleThumbnailOutDto, u *musecase.MockUpdateArticleThumbnail) {
-				in := dto.NewUpdateArticleThumbnailInDto("articleID", *pkg.MustParseURL("https://example.com/example.jpg"))
+				in := dto.NewUpdateArticleThumbnailInDto("articleID", *pkg.MustParseURL("https://example.com/example.jpg"), nil, "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, nil).
@@ -545,7 +821,7 @@ func TestBloggingEventServiceServer_AttachTags(t *testing.T) {
 		"happy_path": {
 			outDto: dto.NewAttachTagsOutDto("eventID", "articleID"),
 			setupUsecase: func(out dto.AttachTagsOutDto, u *musecase.MockAttachTags) {
-				in := dto.NewAttachTagsInDto("articleID", []string{"tag1", "tag2"})
+				in := dto.NewAttachTagsInDto("articleID", []string{"tag1", "tag2"}, "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, nil).
@@ -570,7 +846,7 @@ func TestBloggingEventServiceServer_AttachTags(t *testing.T) {
 		"unhappy_path/usecase-returns-error": {
 			outDto: dto.NewAttachTagsOutDto("", ""),
 			setupUsecase: func(out dto.AttachTagsOutDto, u *musecase.MockAttachTags) {
-				in := dto.NewAttachTagsInDto("articleID", []string{"tag1", "tag2"})
+				in := dto.NewAttachTagsInDto("articleID", []string{"tag1", "tag2"}, "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, errInUsecase).
@@ -592,10 +868,35 @@ func TestBloggingEventServiceServer_AttachTags(t *testing.T) {
 				err: errInUsecase,
 			},
 		},
+		"unhappy_path/usecase-returns-not-found": {
+			outDto: dto.NewAttachTagsOutDto("", ""),
+			setupUsecase: func(out dto.AttachTagsOutDto, u *musecase.MockAttachTags) {
+				in := dto.NewAttachTagsInDto("articleID", []string{"tag1", "tag2"}, "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(nil, model.ErrNotFound).
+					Times(1)
+			},
+			setupConverter: func(from dto.AttachTagsOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToAttachTagsResponse) {
+				conv.EXPECT().
+					ToAttachTagsResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.AttachTagsRequest{
+					Id:       "articleID",
+					TagNames: []string{"tag1", "tag2"},
+				}),
+			},
+			want: want{
+				err: model.ErrNotFound,
+			},
+		},
 		"unhappy_path/converter-returns-error": {
 			outDto: dto.NewAttachTagsOutDto("eventID", "articleID"),
 			setupUsecase: func(out dto.AttachTagsOutDto, u *musecase.MockAttachTags) {
-				in := dto.NewAttachTagsInDto("articleID", []string{"tag1", "tag2"})
+				in := dto.NewAttachTagsInDto("articleID", []string{"tag1", "tag2"}, "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, nil).
@@ -670,7 +971,7 @@ func TestBloggingEventServiceServer_DetachTags(t *testing.T) {
 		"happy_path": {
 			outDto: dto.NewDetachTagsOutDto("eventID", "articleID"),
 			setupUsecase: func(out dto.DetachTagsOutDto, u *musecase.MockDetachTags) {
-				in := dto.NewDetachTagsInDto("articleID", []string{"tag1", "tag2"})
+				in := dto.NewDetachTagsInDto("articleID", []string{"tag1", "tag2"}, "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, nil).
@@ -695,7 +996,7 @@ func TestBloggingEventServiceServer_DetachTags(t *testing.T) {
 		"unhappy_path/usecase-returns-error": {
 			outDto: dto.NewDetachTagsOutDto("", ""),
 			setupUsecase: func(out dto.DetachTagsOutDto, u *musecase.MockDetachTags) {
-				in := dto.NewDetachTagsInDto("articleID", []string{"tag1", "tag2"})
+				in := dto.NewDetachTagsInDto("articleID", []string{"tag1", "tag2"}, "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, errInUsecase).
@@ -720,7 +1021,7 @@ func TestBloggingEventServiceServer_DetachTags(t *testing.T) {
 		"unhappy_path/converter-returns-error": {
 			outDto: dto.NewDetachTagsOutDto("eventID", "articleID"),
 			setupUsecase: func(out dto.DetachTagsOutDto, u *musecase.MockDetachTags) {
-				in := dto.NewDetachTagsInDto("articleID", []string{"tag1", "tag2"})
+				in := dto.NewDetachTagsInDto("articleID", []string{"tag1", "tag2"}, "")
 				u.EXPECT().
 					Execute(gomock.Any(), &in).
 					Return(&out, nil).
@@ -770,3 +1071,2162 @@ func TestBloggingEventServiceServer_DetachTags(t *testing.T) {
 		})
 	}
 }
+
+func TestBloggingEventServiceServer_HideArticle(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.HideArticleRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.BloggingEventResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.HideArticleOutDto
+		setupUsecase   func(out dto.HideArticleOutDto, u *musecase.MockHideArticle)
+		setupConverter func(from dto.HideArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToHideArticleResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewHideArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.HideArticleOutDto, u *musecase.MockHideArticle) {
+				in := dto.NewHideArticleInDto("articleID", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.HideArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToHideArticleResponse) {
+				conv.EXPECT().ToHideArticleResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.HideArticleRequest{
+					Id: "articleID",
+				}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.BloggingEventResponse{EventId: "eventID", ArticleId: "articleID"}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			outDto: dto.NewHideArticleOutDto("", ""),
+			setupUsecase: func(out dto.HideArticleOutDto, u *musecase.MockHideArticle) {
+				in := dto.NewHideArticleInDto("articleID", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.HideArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToHideArticleResponse) {
+				conv.EXPECT().
+					ToHideArticleResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.HideArticleRequest{
+					Id: "articleID",
+				}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewHideArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.HideArticleOutDto, u *musecase.MockHideArticle) {
+				in := dto.NewHideArticleInDto("articleID", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.HideArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToHideArticleResponse) {
+				conv.EXPECT().
+					ToHideArticleResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.HideArticleRequest{
+					Id: "articleID",
+				}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockHideArticle(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.BloggingEventResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToHideArticleResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithHideArticleUsecase(u), WithHideArticleConverter(conv))
+			got, err := s.HideArticle(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("CreateArticle() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.BloggingEventResponse]{})}...); diff != "" {
+				t.Errorf("GetArticleById() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_UnhideArticle(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.UnhideArticleRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.BloggingEventResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.UnhideArticleOutDto
+		setupUsecase   func(out dto.UnhideArticleOutDto, u *musecase.MockUnhideArticle)
+		setupConverter func(from dto.UnhideArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToUnhideArticleResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewUnhideArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.UnhideArticleOutDto, u *musecase.MockUnhideArticle) {
+				in := dto.NewUnhideArticleInDto("articleID", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.UnhideArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToUnhideArticleResponse) {
+				conv.EXPECT().ToUnhideArticleResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.UnhideArticleRequest{
+					Id: "articleID",
+				}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.BloggingEventResponse{EventId: "eventID", ArticleId: "articleID"}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			outDto: dto.NewUnhideArticleOutDto("", ""),
+			setupUsecase: func(out dto.UnhideArticleOutDto, u *musecase.MockUnhideArticle) {
+				in := dto.NewUnhideArticleInDto("articleID", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.UnhideArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToUnhideArticleResponse) {
+				conv.EXPECT().
+					ToUnhideArticleResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.UnhideArticleRequest{
+					Id: "articleID",
+				}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewUnhideArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.UnhideArticleOutDto, u *musecase.MockUnhideArticle) {
+				in := dto.NewUnhideArticleInDto("articleID", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.UnhideArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToUnhideArticleResponse) {
+				conv.EXPECT().
+					ToUnhideArticleResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.UnhideArticleRequest{
+					Id: "articleID",
+				}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockUnhideArticle(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.BloggingEventResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToUnhideArticleResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithUnhideArticleUsecase(u), WithUnhideArticleConverter(conv))
+			got, err := s.UnhideArticle(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("CreateArticle() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.BloggingEventResponse]{})}...); diff != "" {
+				t.Errorf("GetArticleById() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_ScheduleArticle(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.ScheduleArticleRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.BloggingEventResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.ScheduleArticleOutDto
+		setupUsecase   func(out dto.ScheduleArticleOutDto, u *musecase.MockScheduleArticle)
+		setupConverter func(from dto.ScheduleArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToScheduleArticleResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewScheduleArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.ScheduleArticleOutDto, u *musecase.MockScheduleArticle) {
+				in := dto.NewScheduleArticleInDto("articleID", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.ScheduleArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToScheduleArticleResponse) {
+				conv.EXPECT().ToScheduleArticleResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.ScheduleArticleRequest{
+					Id:        "articleID",
+					PublishAt: timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+				}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.BloggingEventResponse{EventId: "eventID", ArticleId: "articleID"}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			outDto: dto.NewScheduleArticleOutDto("", ""),
+			setupUsecase: func(out dto.ScheduleArticleOutDto, u *musecase.MockScheduleArticle) {
+				in := dto.NewScheduleArticleInDto("articleID", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.ScheduleArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToScheduleArticleResponse) {
+				conv.EXPECT().
+					ToScheduleArticleResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.ScheduleArticleRequest{
+					Id:        "articleID",
+					PublishAt: timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+				}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewScheduleArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.ScheduleArticleOutDto, u *musecase.MockScheduleArticle) {
+				in := dto.NewScheduleArticleInDto("articleID", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.ScheduleArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToScheduleArticleResponse) {
+				conv.EXPECT().
+					ToScheduleArticleResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.ScheduleArticleRequest{
+					Id:        "articleID",
+					PublishAt: timestamppb.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+				}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+		"unhappy_path/invalid-publish-at": {
+			outDto: dto.NewScheduleArticleOutDto("", ""),
+			setupUsecase: func(out dto.ScheduleArticleOutDto, u *musecase.MockScheduleArticle) {
+				u.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupConverter: func(from dto.ScheduleArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToScheduleArticleResponse) {
+				conv.EXPECT().
+					ToScheduleArticleResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.ScheduleArticleRequest{
+					Id:        "articleID",
+					PublishAt: &timestamppb.Timestamp{Nanos: -1},
+				}),
+			},
+			want: want{
+				err: model.ErrValidation,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockScheduleArticle(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.BloggingEventResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToScheduleArticleResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithScheduleArticleUsecase(u), WithScheduleArticleConverter(conv))
+			got, err := s.ScheduleArticle(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("CreateArticle() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.BloggingEventResponse]{})}...); diff != "" {
+				t.Errorf("GetArticleById() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_EditArticle(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.EditArticleRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.BloggingEventResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.EditArticleOutDto
+		setupUsecase   func(out dto.EditArticleOutDto, u *musecase.MockEditArticle)
+		setupConverter func(from dto.EditArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToEditArticleResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewEditArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.EditArticleOutDto, u *musecase.MockEditArticle) {
+				in := dto.NewEditArticleInDto("articleID", proto.String("title"), nil, nil, nil, []string{"tag"}, nil, "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.EditArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToEditArticleResponse) {
+				conv.EXPECT().ToEditArticleResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.EditArticleRequest{
+					Id:             "articleID",
+					Title:          proto.String("title"),
+					AttachTagNames: []string{"tag"},
+				}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.BloggingEventResponse{EventId: "eventID", ArticleId: "articleID"}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			outDto: dto.NewEditArticleOutDto("", ""),
+			setupUsecase: func(out dto.EditArticleOutDto, u *musecase.MockEditArticle) {
+				in := dto.NewEditArticleInDto("articleID", proto.String("title"), nil, nil, nil, []string{"tag"}, nil, "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.EditArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToEditArticleResponse) {
+				conv.EXPECT().
+					ToEditArticleResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.EditArticleRequest{
+					Id:             "articleID",
+					Title:          proto.String("title"),
+					AttachTagNames: []string{"tag"},
+				}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewEditArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.EditArticleOutDto, u *musecase.MockEditArticle) {
+				in := dto.NewEditArticleInDto("articleID", proto.String("title"), nil, nil, nil, []string{"tag"}, nil, "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.EditArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToEditArticleResponse) {
+				conv.EXPECT().
+					ToEditArticleResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.EditArticleRequest{
+					Id:             "articleID",
+					Title:          proto.String("title"),
+					AttachTagNames: []string{"tag"},
+				}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+		"unhappy_path/invalid-thumbnail-url": {
+			outDto: dto.NewEditArticleOutDto("", ""),
+			setupUsecase: func(out dto.EditArticleOutDto, u *musecase.MockEditArticle) {
+				u.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupConverter: func(from dto.EditArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToEditArticleResponse) {
+				conv.EXPECT().
+					ToEditArticleResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.EditArticleRequest{
+					Id:           "articleID",
+					ThumbnailUrl: proto.String(":invalid"),
+				}),
+			},
+			want: want{
+				err: model.ErrValidation,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockEditArticle(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.BloggingEventResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToEditArticleResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithEditArticleUsecase(u), WithEditArticleConverter(conv))
+			got, err := s.EditArticle(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("CreateArticle() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.BloggingEventResponse]{})}...); diff != "" {
+				t.Errorf("GetArticleById() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_PublishArticle(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.PublishArticleRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.BloggingEventResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.PublishArticleOutDto
+		setupUsecase   func(out dto.PublishArticleOutDto, u *musecase.MockPublishArticle)
+		setupConverter func(from dto.PublishArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToPublishArticleResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewPublishArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.PublishArticleOutDto, u *musecase.MockPublishArticle) {
+				in := dto.NewPublishArticleInDto("articleID", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.PublishArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToPublishArticleResponse) {
+				conv.EXPECT().ToPublishArticleResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.PublishArticleRequest{
+					Id: "articleID",
+				}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.BloggingEventResponse{EventId: "eventID", ArticleId: "articleID"}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			outDto: dto.NewPublishArticleOutDto("", ""),
+			setupUsecase: func(out dto.PublishArticleOutDto, u *musecase.MockPublishArticle) {
+				in := dto.NewPublishArticleInDto("articleID", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.PublishArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToPublishArticleResponse) {
+				conv.EXPECT().
+					ToPublishArticleResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.PublishArticleRequest{
+					Id: "articleID",
+				}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewPublishArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.PublishArticleOutDto, u *musecase.MockPublishArticle) {
+				in := dto.NewPublishArticleInDto("articleID", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.PublishArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToPublishArticleResponse) {
+				conv.EXPECT().
+					ToPublishArticleResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.PublishArticleRequest{
+					Id: "articleID",
+				}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockPublishArticle(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.BloggingEventResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToPublishArticleResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithPublishArticleUsecase(u), WithPublishArticleConverter(conv))
+			got, err := s.PublishArticle(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("CreateArticle() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.BloggingEventResponse]{})}...); diff != "" {
+				t.Errorf("GetArticleById() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_RevertArticle(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.RevertArticleRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.BloggingEventResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.RevertArticleOutDto
+		setupUsecase   func(out dto.RevertArticleOutDto, u *musecase.MockRevertArticle)
+		setupConverter func(from dto.RevertArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToRevertArticleResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewRevertArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.RevertArticleOutDto, u *musecase.MockRevertArticle) {
+				in := dto.NewRevertArticleInDto("articleID", "toEventID", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.RevertArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToRevertArticleResponse) {
+				conv.EXPECT().ToRevertArticleResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.RevertArticleRequest{
+					Id:        "articleID",
+					ToEventId: "toEventID",
+				}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.BloggingEventResponse{EventId: "eventID", ArticleId: "articleID"}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			outDto: dto.NewRevertArticleOutDto("", ""),
+			setupUsecase: func(out dto.RevertArticleOutDto, u *musecase.MockRevertArticle) {
+				in := dto.NewRevertArticleInDto("articleID", "toEventID", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.RevertArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToRevertArticleResponse) {
+				conv.EXPECT().
+					ToRevertArticleResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.RevertArticleRequest{
+					Id:        "articleID",
+					ToEventId: "toEventID",
+				}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewRevertArticleOutDto("eventID", "articleID"),
+			setupUsecase: func(out dto.RevertArticleOutDto, u *musecase.MockRevertArticle) {
+				in := dto.NewRevertArticleInDto("articleID", "toEventID", "")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.RevertArticleOutDto, res *grpc.BloggingEventResponse, conv *mpresenter.MockToRevertArticleResponse) {
+				conv.EXPECT().
+					ToRevertArticleResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.RevertArticleRequest{
+					Id:        "articleID",
+					ToEventId: "toEventID",
+				}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockRevertArticle(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.BloggingEventResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToRevertArticleResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithRevertArticleUsecase(u), WithRevertArticleConverter(conv))
+			got, err := s.RevertArticle(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("CreateArticle() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.BloggingEventResponse]{})}...); diff != "" {
+				t.Errorf("GetArticleById() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_RenameTag(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.RenameTagRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.RenameTagResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.RenameTagOutDto
+		setupUsecase   func(out dto.RenameTagOutDto, u *musecase.MockRenameTag)
+		setupConverter func(from dto.RenameTagOutDto, res *grpc.RenameTagResponse, conv *mpresenter.MockToRenameTagResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewRenameTagOutDto([]dto.BloggingEventKeyDto{dto.NewBloggingEventKeyDto("eventID", "articleID")}),
+			setupUsecase: func(out dto.RenameTagOutDto, u *musecase.MockRenameTag) {
+				in := dto.NewRenameTagInDto("golang", "Go")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.RenameTagOutDto, res *grpc.RenameTagResponse, conv *mpresenter.MockToRenameTagResponse) {
+				conv.EXPECT().ToRenameTagResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.RenameTagRequest{From: "golang", To: "Go"}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.RenameTagResponse{
+					Events: []*grpc.BloggingEventResponse{{EventId: "eventID", ArticleId: "articleID"}},
+				}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			outDto: dto.NewRenameTagOutDto(nil),
+			setupUsecase: func(out dto.RenameTagOutDto, u *musecase.MockRenameTag) {
+				in := dto.NewRenameTagInDto("golang", "Go")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.RenameTagOutDto, res *grpc.RenameTagResponse, conv *mpresenter.MockToRenameTagResponse) {
+				conv.EXPECT().
+					ToRenameTagResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.RenameTagRequest{From: "golang", To: "Go"}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewRenameTagOutDto([]dto.BloggingEventKeyDto{dto.NewBloggingEventKeyDto("eventID", "articleID")}),
+			setupUsecase: func(out dto.RenameTagOutDto, u *musecase.MockRenameTag) {
+				in := dto.NewRenameTagInDto("golang", "Go")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.RenameTagOutDto, res *grpc.RenameTagResponse, conv *mpresenter.MockToRenameTagResponse) {
+				conv.EXPECT().
+					ToRenameTagResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.RenameTagRequest{From: "golang", To: "Go"}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockRenameTag(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.RenameTagResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToRenameTagResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithRenameTagUsecase(u), WithRenameTagConverter(conv))
+			got, err := s.RenameTag(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("RenameTag() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.RenameTagResponse]{})}...); diff != "" {
+				t.Errorf("RenameTag() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_CreateSeries(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.CreateSeriesRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.SeriesResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.SeriesOutDto
+		setupUsecase   func(out dto.SeriesOutDto, u *musecase.MockCreateSeries)
+		setupConverter func(from dto.SeriesOutDto, res *grpc.SeriesResponse, conv *mpresenter.MockToCreateSeriesResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewSeriesOutDto("seriesID", []dto.BloggingEventKeyDto{dto.NewBloggingEventKeyDto("eventID", "articleID")}),
+			setupUsecase: func(out dto.SeriesOutDto, u *musecase.MockCreateSeries) {
+				in := dto.NewCreateSeriesInDto("Go in Practice", []string{"articleID"})
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.SeriesOutDto, res *grpc.SeriesResponse, conv *mpresenter.MockToCreateSeriesResponse) {
+				conv.EXPECT().ToCreateSeriesResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.CreateSeriesRequest{Title: "Go in Practice", ArticleIds: []string{"articleID"}}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.SeriesResponse{
+					SeriesId: "seriesID",
+					Events:   []*grpc.BloggingEventResponse{{EventId: "eventID", ArticleId: "articleID"}},
+				}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			outDto: dto.NewSeriesOutDto("", nil),
+			setupUsecase: func(out dto.SeriesOutDto, u *musecase.MockCreateSeries) {
+				in := dto.NewCreateSeriesInDto("Go in Practice", []string{"articleID"})
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.SeriesOutDto, res *grpc.SeriesResponse, conv *mpresenter.MockToCreateSeriesResponse) {
+				conv.EXPECT().
+					ToCreateSeriesResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.CreateSeriesRequest{Title: "Go in Practice", ArticleIds: []string{"articleID"}}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewSeriesOutDto("seriesID", []dto.BloggingEventKeyDto{dto.NewBloggingEventKeyDto("eventID", "articleID")}),
+			setupUsecase: func(out dto.SeriesOutDto, u *musecase.MockCreateSeries) {
+				in := dto.NewCreateSeriesInDto("Go in Practice", []string{"articleID"})
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.SeriesOutDto, res *grpc.SeriesResponse, conv *mpresenter.MockToCreateSeriesResponse) {
+				conv.EXPECT().
+					ToCreateSeriesResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.CreateSeriesRequest{Title: "Go in Practice", ArticleIds: []string{"articleID"}}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockCreateSeries(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.SeriesResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToCreateSeriesResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithCreateSeriesUsecase(u), WithCreateSeriesConverter(conv))
+			got, err := s.CreateSeries(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("CreateSeries() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.SeriesResponse]{})}...); diff != "" {
+				t.Errorf("CreateSeries() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_AddArticleToSeries(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.AddArticleToSeriesRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.SeriesResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.SeriesOutDto
+		setupUsecase   func(out dto.SeriesOutDto, u *musecase.MockAddArticleToSeries)
+		setupConverter func(from dto.SeriesOutDto, res *grpc.SeriesResponse, conv *mpresenter.MockToAddArticleToSeriesResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewSeriesOutDto("seriesID", []dto.BloggingEventKeyDto{dto.NewBloggingEventKeyDto("eventID", "articleID")}),
+			setupUsecase: func(out dto.SeriesOutDto, u *musecase.MockAddArticleToSeries) {
+				in := dto.NewAddArticleToSeriesInDto("seriesID", "articleID", 2)
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.SeriesOutDto, res *grpc.SeriesResponse, conv *mpresenter.MockToAddArticleToSeriesResponse) {
+				conv.EXPECT().ToAddArticleToSeriesResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.AddArticleToSeriesRequest{SeriesId: "seriesID", ArticleId: "articleID", Position: proto.Int32(2)}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.SeriesResponse{
+					SeriesId: "seriesID",
+					Events:   []*grpc.BloggingEventResponse{{EventId: "eventID", ArticleId: "articleID"}},
+				}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			outDto: dto.NewSeriesOutDto("", nil),
+			setupUsecase: func(out dto.SeriesOutDto, u *musecase.MockAddArticleToSeries) {
+				in := dto.NewAddArticleToSeriesInDto("seriesID", "articleID", 2)
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.SeriesOutDto, res *grpc.SeriesResponse, conv *mpresenter.MockToAddArticleToSeriesResponse) {
+				conv.EXPECT().
+					ToAddArticleToSeriesResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.AddArticleToSeriesRequest{SeriesId: "seriesID", ArticleId: "articleID", Position: proto.Int32(2)}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewSeriesOutDto("seriesID", []dto.BloggingEventKeyDto{dto.NewBloggingEventKeyDto("eventID", "articleID")}),
+			setupUsecase: func(out dto.SeriesOutDto, u *musecase.MockAddArticleToSeries) {
+				in := dto.NewAddArticleToSeriesInDto("seriesID", "articleID", 2)
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.SeriesOutDto, res *grpc.SeriesResponse, conv *mpresenter.MockToAddArticleToSeriesResponse) {
+				conv.EXPECT().
+					ToAddArticleToSeriesResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.AddArticleToSeriesRequest{SeriesId: "seriesID", ArticleId: "articleID", Position: proto.Int32(2)}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockAddArticleToSeries(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.SeriesResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToAddArticleToSeriesResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithAddArticleToSeriesUsecase(u), WithAddArticleToSeriesConverter(conv))
+			got, err := s.AddArticleToSeries(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("AddArticleToSeries() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.SeriesResponse]{})}...); diff != "" {
+				t.Errorf("AddArticleToSeries() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_ReorderSeries(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.ReorderSeriesRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.SeriesResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.SeriesOutDto
+		setupUsecase   func(out dto.SeriesOutDto, u *musecase.MockReorderSeries)
+		setupConverter func(from dto.SeriesOutDto, res *grpc.SeriesResponse, conv *mpresenter.MockToReorderSeriesResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewSeriesOutDto("seriesID", []dto.BloggingEventKeyDto{dto.NewBloggingEventKeyDto("eventID", "articleID")}),
+			setupUsecase: func(out dto.SeriesOutDto, u *musecase.MockReorderSeries) {
+				in := dto.NewReorderSeriesInDto("seriesID", []string{"articleID"})
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.SeriesOutDto, res *grpc.SeriesResponse, conv *mpresenter.MockToReorderSeriesResponse) {
+				conv.EXPECT().ToReorderSeriesResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.ReorderSeriesRequest{SeriesId: "seriesID", ArticleIds: []string{"articleID"}}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.SeriesResponse{
+					SeriesId: "seriesID",
+					Events:   []*grpc.BloggingEventResponse{{EventId: "eventID", ArticleId: "articleID"}},
+				}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			outDto: dto.NewSeriesOutDto("", nil),
+			setupUsecase: func(out dto.SeriesOutDto, u *musecase.MockReorderSeries) {
+				in := dto.NewReorderSeriesInDto("seriesID", []string{"articleID"})
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.SeriesOutDto, res *grpc.SeriesResponse, conv *mpresenter.MockToReorderSeriesResponse) {
+				conv.EXPECT().
+					ToReorderSeriesResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.ReorderSeriesRequest{SeriesId: "seriesID", ArticleIds: []string{"articleID"}}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewSeriesOutDto("seriesID", []dto.BloggingEventKeyDto{dto.NewBloggingEventKeyDto("eventID", "articleID")}),
+			setupUsecase: func(out dto.SeriesOutDto, u *musecase.MockReorderSeries) {
+				in := dto.NewReorderSeriesInDto("seriesID", []string{"articleID"})
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.SeriesOutDto, res *grpc.SeriesResponse, conv *mpresenter.MockToReorderSeriesResponse) {
+				conv.EXPECT().
+					ToReorderSeriesResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.ReorderSeriesRequest{SeriesId: "seriesID", ArticleIds: []string{"articleID"}}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockReorderSeries(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.SeriesResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToReorderSeriesResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithReorderSeriesUsecase(u), WithReorderSeriesConverter(conv))
+			got, err := s.ReorderSeries(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ReorderSeries() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.SeriesResponse]{})}...); diff != "" {
+				t.Errorf("ReorderSeries() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_MergeTags(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.MergeTagsRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.MergeTagsResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.MergeTagsOutDto
+		setupUsecase   func(out dto.MergeTagsOutDto, u *musecase.MockMergeTags)
+		setupConverter func(from dto.MergeTagsOutDto, res *grpc.MergeTagsResponse, conv *mpresenter.MockToMergeTagsResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewMergeTagsOutDto([]dto.BloggingEventKeyDto{dto.NewBloggingEventKeyDto("eventID", "articleID")}),
+			setupUsecase: func(out dto.MergeTagsOutDto, u *musecase.MockMergeTags) {
+				in := dto.NewMergeTagsInDto([]string{"golang", "go"}, "Go")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.MergeTagsOutDto, res *grpc.MergeTagsResponse, conv *mpresenter.MockToMergeTagsResponse) {
+				conv.EXPECT().ToMergeTagsResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.MergeTagsRequest{Sources: []string{"golang", "go"}, Into: "Go"}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.MergeTagsResponse{
+					Events: []*grpc.BloggingEventResponse{{EventId: "eventID", ArticleId: "articleID"}},
+				}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			outDto: dto.NewMergeTagsOutDto(nil),
+			setupUsecase: func(out dto.MergeTagsOutDto, u *musecase.MockMergeTags) {
+				in := dto.NewMergeTagsInDto([]string{"golang", "go"}, "Go")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.MergeTagsOutDto, res *grpc.MergeTagsResponse, conv *mpresenter.MockToMergeTagsResponse) {
+				conv.EXPECT().
+					ToMergeTagsResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.MergeTagsRequest{Sources: []string{"golang", "go"}, Into: "Go"}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewMergeTagsOutDto([]dto.BloggingEventKeyDto{dto.NewBloggingEventKeyDto("eventID", "articleID")}),
+			setupUsecase: func(out dto.MergeTagsOutDto, u *musecase.MockMergeTags) {
+				in := dto.NewMergeTagsInDto([]string{"golang", "go"}, "Go")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.MergeTagsOutDto, res *grpc.MergeTagsResponse, conv *mpresenter.MockToMergeTagsResponse) {
+				conv.EXPECT().
+					ToMergeTagsResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.MergeTagsRequest{Sources: []string{"golang", "go"}, Into: "Go"}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockMergeTags(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.MergeTagsResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToMergeTagsResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithMergeTagsUsecase(u), WithMergeTagsConverter(conv))
+			got, err := s.MergeTags(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("MergeTags() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.MergeTagsResponse]{})}...); diff != "" {
+				t.Errorf("MergeTags() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_GetDraft(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.GetDraftRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.GetDraftResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.GetDraftOutDto
+		setupUsecase   func(out dto.GetDraftOutDto, u *musecase.MockGetDraft)
+		setupConverter func(from dto.GetDraftOutDto, res *grpc.GetDraftResponse, conv *mpresenter.MockToGetDraftResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewGetDraftOutDto(dto.NewDraftDto("articleID", "title", "body", "https://example.com/example.jpg", []string{"tag1"}, "eventID")),
+			setupUsecase: func(out dto.GetDraftOutDto, u *musecase.MockGetDraft) {
+				in := dto.NewGetDraftInDto("articleID")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.GetDraftOutDto, res *grpc.GetDraftResponse, conv *mpresenter.MockToGetDraftResponse) {
+				conv.EXPECT().ToGetDraftResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.GetDraftRequest{Id: "articleID"}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.GetDraftResponse{
+					Draft: &grpc.Draft{
+						Id:           "articleID",
+						Title:        "title",
+						Body:         "body",
+						ThumbnailUrl: "https://example.com/example.jpg",
+						TagNames:     []string{"tag1"},
+						LastEventId:  "eventID",
+					},
+				}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			setupUsecase: func(out dto.GetDraftOutDto, u *musecase.MockGetDraft) {
+				in := dto.NewGetDraftInDto("articleID")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(nil, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.GetDraftOutDto, res *grpc.GetDraftResponse, conv *mpresenter.MockToGetDraftResponse) {
+				conv.EXPECT().
+					ToGetDraftResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.GetDraftRequest{Id: "articleID"}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/usecase-returns-not-found": {
+			setupUsecase: func(out dto.GetDraftOutDto, u *musecase.MockGetDraft) {
+				in := dto.NewGetDraftInDto("articleID")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(nil, model.ErrNotFound).
+					Times(1)
+			},
+			setupConverter: func(from dto.GetDraftOutDto, res *grpc.GetDraftResponse, conv *mpresenter.MockToGetDraftResponse) {
+				conv.EXPECT().
+					ToGetDraftResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.GetDraftRequest{Id: "articleID"}),
+			},
+			want: want{
+				err: model.ErrNotFound,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewGetDraftOutDto(dto.NewDraftDto("articleID", "title", "body", "https://example.com/example.jpg", []string{"tag1"}, "eventID")),
+			setupUsecase: func(out dto.GetDraftOutDto, u *musecase.MockGetDraft) {
+				in := dto.NewGetDraftInDto("articleID")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.GetDraftOutDto, res *grpc.GetDraftResponse, conv *mpresenter.MockToGetDraftResponse) {
+				conv.EXPECT().
+					ToGetDraftResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.GetDraftRequest{Id: "articleID"}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockGetDraft(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.GetDraftResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToGetDraftResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithGetDraftUsecase(u), WithGetDraftConverter(conv))
+			got, err := s.GetDraft(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("GetDraft() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.GetDraftResponse]{})}...); diff != "" {
+				t.Errorf("GetDraft() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_ListArticleEvents(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.ListArticleEventsRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.ListArticleEventsResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.ListArticleEventsOutDto
+		setupUsecase   func(out dto.ListArticleEventsOutDto, u *musecase.MockListArticleEvents)
+		setupConverter func(from dto.ListArticleEventsOutDto, res *grpc.ListArticleEventsResponse, conv *mpresenter.MockToListArticleEventsResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewListArticleEventsOutDto([]dto.ArticleEventDto{dto.NewArticleEventDto("eventID", "UPDATE_TITLE", time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC), dto.ArticleEventDtoWithTitle("title"))}),
+			setupUsecase: func(out dto.ListArticleEventsOutDto, u *musecase.MockListArticleEvents) {
+				in := dto.NewListArticleEventsInDto("articleID")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.ListArticleEventsOutDto, res *grpc.ListArticleEventsResponse, conv *mpresenter.MockToListArticleEventsResponse) {
+				conv.EXPECT().ToListArticleEventsResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.ListArticleEventsRequest{Id: "articleID"}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.ListArticleEventsResponse{
+					Events: []*grpc.ArticleEvent{
+						{
+							Id:         "eventID",
+							Type:       "UPDATE_TITLE",
+							OccurredAt: timestamppb.New(time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC)),
+							Title:      proto.String("title"),
+						},
+					},
+				}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			setupUsecase: func(out dto.ListArticleEventsOutDto, u *musecase.MockListArticleEvents) {
+				in := dto.NewListArticleEventsInDto("articleID")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(nil, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.ListArticleEventsOutDto, res *grpc.ListArticleEventsResponse, conv *mpresenter.MockToListArticleEventsResponse) {
+				conv.EXPECT().
+					ToListArticleEventsResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.ListArticleEventsRequest{Id: "articleID"}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/usecase-returns-not-found": {
+			setupUsecase: func(out dto.ListArticleEventsOutDto, u *musecase.MockListArticleEvents) {
+				in := dto.NewListArticleEventsInDto("articleID")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(nil, model.ErrNotFound).
+					Times(1)
+			},
+			setupConverter: func(from dto.ListArticleEventsOutDto, res *grpc.ListArticleEventsResponse, conv *mpresenter.MockToListArticleEventsResponse) {
+				conv.EXPECT().
+					ToListArticleEventsResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.ListArticleEventsRequest{Id: "articleID"}),
+			},
+			want: want{
+				err: model.ErrNotFound,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewListArticleEventsOutDto([]dto.ArticleEventDto{dto.NewArticleEventDto("eventID", "UPDATE_TITLE", time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC), dto.ArticleEventDtoWithTitle("title"))}),
+			setupUsecase: func(out dto.ListArticleEventsOutDto, u *musecase.MockListArticleEvents) {
+				in := dto.NewListArticleEventsInDto("articleID")
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.ListArticleEventsOutDto, res *grpc.ListArticleEventsResponse, conv *mpresenter.MockToListArticleEventsResponse) {
+				conv.EXPECT().
+					ToListArticleEventsResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.ListArticleEventsRequest{Id: "articleID"}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockListArticleEvents(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.ListArticleEventsResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToListArticleEventsResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithListArticleEventsUsecase(u), WithListArticleEventsConverter(conv))
+			got, err := s.ListArticleEvents(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ListArticleEvents() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.ListArticleEventsResponse]{})}...); diff != "" {
+				t.Errorf("ListArticleEvents() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_GetArticleAt(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  *connect.Request[grpc.GetArticleAtRequest]
+	}
+	type want struct {
+		response *connect.Response[grpc.GetArticleAtResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.GetArticleAtOutDto
+		setupUsecase   func(out dto.GetArticleAtOutDto, u *musecase.MockGetArticleAt)
+		setupConverter func(from dto.GetArticleAtOutDto, res *grpc.GetArticleAtResponse, conv *mpresenter.MockToGetArticleAtResponse)
+		args           args
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewGetArticleAtOutDto("articleID", "title", "body", "thumbnail", []string{"tag"}, "eventID", time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC)),
+			setupUsecase: func(out dto.GetArticleAtOutDto, u *musecase.MockGetArticleAt) {
+				in := dto.NewGetArticleAtInDto("articleID", "eventID", nil)
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.GetArticleAtOutDto, res *grpc.GetArticleAtResponse, conv *mpresenter.MockToGetArticleAtResponse) {
+				conv.EXPECT().ToGetArticleAtResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.GetArticleAtRequest{Id: "articleID", AsOf: &grpc.GetArticleAtRequest_EventId{EventId: "eventID"}}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.GetArticleAtResponse{
+					Article: &grpc.ArticleSnapshot{
+						Id:           "articleID",
+						Title:        "title",
+						Body:         "body",
+						ThumbnailUrl: "thumbnail",
+						TagNames:     []string{"tag"},
+						EventId:      "eventID",
+						EventAt:      timestamppb.New(time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC)),
+					},
+				}),
+			},
+		},
+		"happy_path/by-timestamp": {
+			outDto: dto.NewGetArticleAtOutDto("articleID", "title", "body", "thumbnail", []string{"tag"}, "eventID", time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC)),
+			setupUsecase: func(out dto.GetArticleAtOutDto, u *musecase.MockGetArticleAt) {
+				at := time.Date(2024, 12, 14, 0, 0, 0, 0, time.UTC)
+				in := dto.NewGetArticleAtInDto("articleID", "", &at)
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.GetArticleAtOutDto, res *grpc.GetArticleAtResponse, conv *mpresenter.MockToGetArticleAtResponse) {
+				conv.EXPECT().ToGetArticleAtResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in: connect.NewRequest(&grpc.GetArticleAtRequest{
+					Id:   "articleID",
+					AsOf: &grpc.GetArticleAtRequest_Timestamp{Timestamp: timestamppb.New(time.Date(2024, 12, 14, 0, 0, 0, 0, time.UTC))},
+				}),
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.GetArticleAtResponse{
+					Article: &grpc.ArticleSnapshot{
+						Id:           "articleID",
+						Title:        "title",
+						Body:         "body",
+						ThumbnailUrl: "thumbnail",
+						TagNames:     []string{"tag"},
+						EventId:      "eventID",
+						EventAt:      timestamppb.New(time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC)),
+					},
+				}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			setupUsecase: func(out dto.GetArticleAtOutDto, u *musecase.MockGetArticleAt) {
+				in := dto.NewGetArticleAtInDto("articleID", "eventID", nil)
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(nil, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.GetArticleAtOutDto, res *grpc.GetArticleAtResponse, conv *mpresenter.MockToGetArticleAtResponse) {
+				conv.EXPECT().
+					ToGetArticleAtResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.GetArticleAtRequest{Id: "articleID", AsOf: &grpc.GetArticleAtRequest_EventId{EventId: "eventID"}}),
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/usecase-returns-not-found": {
+			setupUsecase: func(out dto.GetArticleAtOutDto, u *musecase.MockGetArticleAt) {
+				in := dto.NewGetArticleAtInDto("articleID", "eventID", nil)
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(nil, model.ErrNotFound).
+					Times(1)
+			},
+			setupConverter: func(from dto.GetArticleAtOutDto, res *grpc.GetArticleAtResponse, conv *mpresenter.MockToGetArticleAtResponse) {
+				conv.EXPECT().
+					ToGetArticleAtResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.GetArticleAtRequest{Id: "articleID", AsOf: &grpc.GetArticleAtRequest_EventId{EventId: "eventID"}}),
+			},
+			want: want{
+				err: model.ErrNotFound,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewGetArticleAtOutDto("articleID", "title", "body", "thumbnail", []string{"tag"}, "eventID", time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC)),
+			setupUsecase: func(out dto.GetArticleAtOutDto, u *musecase.MockGetArticleAt) {
+				in := dto.NewGetArticleAtInDto("articleID", "eventID", nil)
+				u.EXPECT().
+					Execute(gomock.Any(), &in).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.GetArticleAtOutDto, res *grpc.GetArticleAtResponse, conv *mpresenter.MockToGetArticleAtResponse) {
+				conv.EXPECT().
+					ToGetArticleAtResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			args: args{
+				ctx: context.Background(),
+				in:  connect.NewRequest(&grpc.GetArticleAtRequest{Id: "articleID", AsOf: &grpc.GetArticleAtRequest_EventId{EventId: "eventID"}}),
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockGetArticleAt(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.GetArticleAtResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToGetArticleAtResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithGetArticleAtUsecase(u), WithGetArticleAtConverter(conv))
+			got, err := s.GetArticleAt(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("GetArticleAt() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.GetArticleAtResponse]{})}...); diff != "" {
+				t.Errorf("GetArticleAt() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_ListDrafts(t *testing.T) {
+	type want struct {
+		response *connect.Response[grpc.ListDraftsResponse]
+		err      error
+	}
+	type testCase struct {
+		outDto         dto.ListDraftsOutDto
+		setupUsecase   func(out dto.ListDraftsOutDto, u *musecase.MockListDrafts)
+		setupConverter func(from dto.ListDraftsOutDto, res *grpc.ListDraftsResponse, conv *mpresenter.MockToListDraftsResponse)
+		want           want
+	}
+
+	errInUsecase := errors.New("error in usecase")
+	errInConverter := errors.New("error in converter")
+
+	tests := map[string]testCase{
+		"happy_path": {
+			outDto: dto.NewListDraftsOutDto([]dto.DraftDto{
+				dto.NewDraftDto("articleID", "title", "body", "https://example.com/example.jpg", []string{"tag1"}, "eventID"),
+			}),
+			setupUsecase: func(out dto.ListDraftsOutDto, u *musecase.MockListDrafts) {
+				u.EXPECT().
+					Execute(gomock.Any()).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.ListDraftsOutDto, res *grpc.ListDraftsResponse, conv *mpresenter.MockToListDraftsResponse) {
+				conv.EXPECT().ToListDraftsResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			want: want{
+				response: connect.NewResponse(&grpc.ListDraftsResponse{
+					Drafts: []*grpc.Draft{
+						{
+							Id:           "articleID",
+							Title:        "title",
+							Body:         "body",
+							ThumbnailUrl: "https://example.com/example.jpg",
+							TagNames:     []string{"tag1"},
+							LastEventId:  "eventID",
+						},
+					},
+				}),
+			},
+		},
+		"unhappy_path/usecase-returns-error": {
+			setupUsecase: func(out dto.ListDraftsOutDto, u *musecase.MockListDrafts) {
+				u.EXPECT().
+					Execute(gomock.Any()).
+					Return(nil, errInUsecase).
+					Times(1)
+			},
+			setupConverter: func(from dto.ListDraftsOutDto, res *grpc.ListDraftsResponse, conv *mpresenter.MockToListDraftsResponse) {
+				conv.EXPECT().
+					ToListDraftsResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			want: want{
+				err: errInUsecase,
+			},
+		},
+		"unhappy_path/converter-returns-error": {
+			outDto: dto.NewListDraftsOutDto([]dto.DraftDto{}),
+			setupUsecase: func(out dto.ListDraftsOutDto, u *musecase.MockListDrafts) {
+				u.EXPECT().
+					Execute(gomock.Any()).
+					Return(&out, nil).
+					Times(1)
+			},
+			setupConverter: func(from dto.ListDraftsOutDto, res *grpc.ListDraftsResponse, conv *mpresenter.MockToListDraftsResponse) {
+				conv.EXPECT().
+					ToListDraftsResponse(gomock.Any(), &from).
+					Return(nil, errInConverter).
+					Times(1)
+			},
+			want: want{
+				err: errInConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockListDrafts(ctrl)
+			tt.setupUsecase(out, u)
+			response := tt.want.response
+			var message *grpc.ListDraftsResponse
+			if response != nil {
+				message = response.Msg
+			}
+			conv := mpresenter.NewMockToListDraftsResponse(ctrl)
+			tt.setupConverter(out, message, conv)
+			s := NewBloggingEventServiceServer(WithListDraftsUsecase(u), WithListDraftsConverter(conv))
+			got, err := s.ListDrafts(context.Background(), connect.NewRequest(&grpc.ListDraftsRequest{}))
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ListDrafts() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(got, tt.want.response, []cmp.Option{protocmp.Transform(), cmpopts.IgnoreUnexported(connect.Response[grpc.ListDraftsResponse]{})}...); diff != "" {
+				t.Errorf("ListDrafts() got = %v, want %v", got, tt.want.response)
+			}
+		})
+	}
+}
+
+func TestBloggingEventServiceServer_UploadImage(t *testing.T) {
+	type want struct {
+		response *grpc.UploadImageResponse
+		code     connect.Code
+	}
+	type testCase struct {
+		requests       []*grpc.UploadImageRequest
+		outDto         dto.UploadImageOutDto
+		setupUsecase   func(out dto.UploadImageOutDto, u *musecase.MockUploadImage)
+		setupConverter func(from dto.UploadImageOutDto, res *grpc.UploadImageResponse, conv *mpresenter.MockToUploadImageResponse)
+		want           want
+	}
+
+	requests := []*grpc.UploadImageRequest{
+		{Value: &grpc.UploadImageRequest_Meta{Meta: &grpc.Meta{Name: "example.png", ContentType: "image/png"}}},
+		{Value: &grpc.UploadImageRequest_Data{Data: []byte("abc")}},
+		{Value: &grpc.UploadImageRequest_Data{Data: []byte("de")}},
+	}
+
+	tests := map[string]testCase{
+		"happy_path": {
+			requests: requests,
+			outDto:   dto.NewUploadImageOutDto(*pkg.MustParseURL("https://example.com/example.png"), nil, false),
+			setupUsecase: func(out dto.UploadImageOutDto, u *musecase.MockUploadImage) {
+				u.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, in *dto.UploadImageInDto) (*dto.UploadImageOutDto, error) {
+						if in.Name() != "example.png" || in.ContentType() != "image/png" || in.KeepMetadata() {
+							t.Errorf("Execute() got name = %s, contentType = %s, keepMetadata = %t", in.Name(), in.ContentType(), in.KeepMetadata())
+						}
+						data, err := io.ReadAll(in.Body())
+						if err != nil {
+							return nil, err
+						}
+						if string(data) != "abcde" {
+							t.Errorf("Execute() got body = %q, want %q", data, "abcde")
+						}
+						return &out, nil
+					}).
+					Times(1)
+			},
+			setupConverter: func(from dto.UploadImageOutDto, res *grpc.UploadImageResponse, conv *mpresenter.MockToUploadImageResponse) {
+				conv.EXPECT().
+					ToUploadImageResponse(gomock.Any(), &from).
+					Return(res, nil).
+					Times(1)
+			},
+			want: want{
+				response: &grpc.UploadImageResponse{
+					Success: true,
+					Url:     proto.String("https://example.com/example.png"),
+				},
+			},
+		},
+		"unhappy_path/data-before-meta": {
+			requests: []*grpc.UploadImageRequest{requests[1], requests[0], requests[2]},
+			setupUsecase: func(out dto.UploadImageOutDto, u *musecase.MockUploadImage) {
+				u.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupConverter: func(from dto.UploadImageOutDto, res *grpc.UploadImageResponse, conv *mpresenter.MockToUploadImageResponse) {
+				conv.EXPECT().
+					ToUploadImageResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			want: want{
+				code: connect.CodeInvalidArgument,
+			},
+		},
+		"unhappy_path/meta-sent-twice": {
+			requests: []*grpc.UploadImageRequest{requests[0], requests[1], requests[0]},
+			setupUsecase: func(out dto.UploadImageOutDto, u *musecase.MockUploadImage) {
+				u.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, in *dto.UploadImageInDto) (*dto.UploadImageOutDto, error) {
+						_, err := io.ReadAll(in.Body())
+						return nil, err
+					}).
+					Times(1)
+			},
+			setupConverter: func(from dto.UploadImageOutDto, res *grpc.UploadImageResponse, conv *mpresenter.MockToUploadImageResponse) {
+				conv.EXPECT().
+					ToUploadImageResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			want: want{
+				code: connect.CodeInvalidArgument,
+			},
+		},
+		"unhappy_path/usecase-returns-validation-error": {
+			requests: requests,
+			setupUsecase: func(out dto.UploadImageOutDto, u *musecase.MockUploadImage) {
+				u.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Wrap(model.ErrValidation, "image type text/plain is not allowed")).
+					Times(1)
+			},
+			setupConverter: func(from dto.UploadImageOutDto, res *grpc.UploadImageResponse, conv *mpresenter.MockToUploadImageResponse) {
+				conv.EXPECT().
+					ToUploadImageResponse(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			want: want{
+				code: connect.CodeInvalidArgument,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			out := tt.outDto
+			u := musecase.NewMockUploadImage(ctrl)
+			tt.setupUsecase(out, u)
+			conv := mpresenter.NewMockToUploadImageResponse(ctrl)
+			tt.setupConverter(out, tt.want.response, conv)
+			s := NewBloggingEventServiceServer(WithUploadImageUsecase(u), WithUploadImageConverter(conv))
+
+			// client streams are only constructed by connect, so the server is called through HTTP.
+			mux := http.NewServeMux()
+			mux.Handle(grpcconnect.NewBloggingEventServiceHandler(s))
+			server := httptest.NewServer(mux)
+			defer server.Close()
+			stream := grpcconnect.NewBloggingEventServiceClient(server.Client(), server.URL).UploadImage(context.Background())
+			for _, request := range tt.requests {
+				if err := stream.Send(request); err != nil {
+					break
+				}
+			}
+			got, err := stream.CloseAndReceive()
+			var code connect.Code
+			if err != nil {
+				code = connect.CodeOf(err)
+			}
+			if code != tt.want.code {
+				t.Fatalf("UploadImage() error = %v, want code %v", err, tt.want.code)
+			}
+			var message *grpc.UploadImageResponse
+			if got != nil {
+				message = got.Msg
+			}
+			if diff := cmp.Diff(message, tt.want.response, protocmp.Transform()); diff != "" {
+				t.Errorf("UploadImage() got = %v, want %v", message, tt.want.response)
+			}
+		})
+	}
+}
+
+func Test_invalidArgumentError(t *testing.T) {
+	type testCase struct {
+		err  error
+		want []*errdetails.BadRequest_FieldViolation
+	}
+	tests := map[string]testCase{
+		"happy_path/validation-error": {
+			err: errors.WithStack(model.NewValidationError(
+				model.NewFieldViolation("tagNames[0]", "tag name must not be empty"),
+				model.NewFieldViolation("tagNames[2]", `tag name "admin" is not allowed`),
+			)),
+			want: []*errdetails.BadRequest_FieldViolation{
+				{Field: "tagNames[0]", Description: "tag name must not be empty"},
+				{Field: "tagNames[2]", Description: `tag name "admin" is not allowed`},
+			},
+		},
+		"happy_path/plain-validation-error": {
+			err: errors.WithMessage(model.ErrValidation, "title must not be empty"),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := invalidArgumentError(tt.err)
+			if got.Code() != connect.CodeInvalidArgument {
+				t.Errorf("invalidArgumentError() code = %v, want %v", got.Code(), connect.CodeInvalidArgument)
+			}
+			if !errors.Is(got, model.ErrValidation) {
+				t.Errorf("invalidArgumentError() error = %v, want %v", got, model.ErrValidation)
+			}
+			var violations []*errdetails.BadRequest_FieldViolation
+			for _, detail := range got.Details() {
+				value, err := detail.Value()
+				if err != nil {
+					t.Fatalf("failed to unmarshal the detail: %v", err)
+				}
+				if badRequest, ok := value.(*errdetails.BadRequest); ok {
+					violations = append(violations, badRequest.GetFieldViolations()...)
+				}
+			}
+			if diff := cmp.Diff(tt.want, violations, protocmp.Transform()); diff != "" {
+				t.Errorf("invalidArgumentError() violations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
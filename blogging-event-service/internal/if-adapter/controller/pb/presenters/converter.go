@@ -19,6 +19,12 @@ type ToUpdateArticleTitleResponse interface {
 	ToUpdateArticleTitleResponse(ctx context.Context, from *dto.UpdateArticleTitleOutDto) (response *grpc.BloggingEventResponse, err error)
 }
 
+// ToUpdateArticleSlugResponse is a converter interface for converting from UpdateArticleSlug use-case's dto to pb response.
+type ToUpdateArticleSlugResponse interface {
+	// ToUpdateArticleSlugResponse converts from UpdateArticleSlug use-case's dto to pb response.
+	ToUpdateArticleSlugResponse(ctx context.Context, from *dto.UpdateArticleSlugOutDto) (response *grpc.BloggingEventResponse, err error)
+}
+
 // ToUpdateArticleBodyResponse is a converter interface for converting from UpdateArticleBody use-case's dto to pb response.
 type ToUpdateArticleBodyResponse interface {
 	// ToUpdateArticleBodyResponse converts from UpdateArticleBody use-case's dto to pb response.
@@ -43,6 +49,96 @@ type ToDetachTagsResponse interface {
 	ToDetachTagsResponse(ctx context.Context, from *dto.DetachTagsOutDto) (response *grpc.BloggingEventResponse, err error)
 }
 
+// ToHideArticleResponse is a converter interface for converting from HideArticle use-case's dto to pb response.
+type ToHideArticleResponse interface {
+	// ToHideArticleResponse converts from HideArticle use-case's dto to pb response.
+	ToHideArticleResponse(ctx context.Context, from *dto.HideArticleOutDto) (response *grpc.BloggingEventResponse, err error)
+}
+
+// ToUnhideArticleResponse is a converter interface for converting from UnhideArticle use-case's dto to pb response.
+type ToUnhideArticleResponse interface {
+	// ToUnhideArticleResponse converts from UnhideArticle use-case's dto to pb response.
+	ToUnhideArticleResponse(ctx context.Context, from *dto.UnhideArticleOutDto) (response *grpc.BloggingEventResponse, err error)
+}
+
+// ToEditArticleResponse is a converter interface for converting from EditArticle use-case's dto to pb response.
+type ToEditArticleResponse interface {
+	// ToEditArticleResponse converts from EditArticle use-case's dto to pb response.
+	ToEditArticleResponse(ctx context.Context, from *dto.EditArticleOutDto) (response *grpc.BloggingEventResponse, err error)
+}
+
+// ToScheduleArticleResponse is a converter interface for converting from ScheduleArticle use-case's dto to pb response.
+type ToScheduleArticleResponse interface {
+	// ToScheduleArticleResponse converts from ScheduleArticle use-case's dto to pb response.
+	ToScheduleArticleResponse(ctx context.Context, from *dto.ScheduleArticleOutDto) (response *grpc.BloggingEventResponse, err error)
+}
+
+// ToPublishArticleResponse is a converter interface for converting from PublishArticle use-case's dto to pb response.
+type ToPublishArticleResponse interface {
+	// ToPublishArticleResponse converts from PublishArticle use-case's dto to pb response.
+	ToPublishArticleResponse(ctx context.Context, from *dto.PublishArticleOutDto) (response *grpc.BloggingEventResponse, err error)
+}
+
+// ToRevertArticleResponse is a converter interface for converting from RevertArticle use-case's dto to pb response.
+type ToRevertArticleResponse interface {
+	// ToRevertArticleResponse converts from RevertArticle use-case's dto to pb response.
+	ToRevertArticleResponse(ctx context.Context, from *dto.RevertArticleOutDto) (response *grpc.BloggingEventResponse, err error)
+}
+
+// ToRenameTagResponse is a converter interface for converting from RenameTag use-case's dto to pb response.
+type ToRenameTagResponse interface {
+	// ToRenameTagResponse converts from RenameTag use-case's dto to pb response.
+	ToRenameTagResponse(ctx context.Context, from *dto.RenameTagOutDto) (response *grpc.RenameTagResponse, err error)
+}
+
+// ToMergeTagsResponse is a converter interface for converting from MergeTags use-case's dto to pb response.
+type ToMergeTagsResponse interface {
+	// ToMergeTagsResponse converts from MergeTags use-case's dto to pb response.
+	ToMergeTagsResponse(ctx context.Context, from *dto.MergeTagsOutDto) (response *grpc.MergeTagsResponse, err error)
+}
+
+// ToCreateSeriesResponse is a converter interface for converting from CreateSeries use-case's dto to pb response.
+type ToCreateSeriesResponse interface {
+	// ToCreateSeriesResponse converts from CreateSeries use-case's dto to pb response.
+	ToCreateSeriesResponse(ctx context.Context, from *dto.SeriesOutDto) (response *grpc.SeriesResponse, err error)
+}
+
+// ToAddArticleToSeriesResponse is a converter interface for converting from AddArticleToSeries use-case's dto to pb response.
+type ToAddArticleToSeriesResponse interface {
+	// ToAddArticleToSeriesResponse converts from AddArticleToSeries use-case's dto to pb response.
+	ToAddArticleToSeriesResponse(ctx context.Context, from *dto.SeriesOutDto) (response *grpc.SeriesResponse, err error)
+}
+
+// ToReorderSeriesResponse is a converter interface for converting from ReorderSeries use-case's dto to pb response.
+type ToReorderSeriesResponse interface {
+	// ToReorderSeriesResponse converts from ReorderSeries use-case's dto to pb response.
+	ToReorderSeriesResponse(ctx context.Context, from *dto.SeriesOutDto) (response *grpc.SeriesResponse, err error)
+}
+
+// ToGetDraftResponse is a converter interface for converting from GetDraft use-case's dto to pb response.
+type ToGetDraftResponse interface {
+	// ToGetDraftResponse converts from GetDraft use-case's dto to pb response.
+	ToGetDraftResponse(ctx context.Context, from *dto.GetDraftOutDto) (response *grpc.GetDraftResponse, err error)
+}
+
+// ToListDraftsResponse is a converter interface for converting from ListDrafts use-case's dto to pb response.
+type ToListDraftsResponse interface {
+	// ToListDraftsResponse converts from ListDrafts use-case's dto to pb response.
+	ToListDraftsResponse(ctx context.Context, from *dto.ListDraftsOutDto) (response *grpc.ListDraftsResponse, err error)
+}
+
+// ToListArticleEventsResponse is a converter interface for converting from ListArticleEvents use-case's dto to pb response.
+type ToListArticleEventsResponse interface {
+	// ToListArticleEventsResponse converts from ListArticleEvents use-case's dto to pb response.
+	ToListArticleEventsResponse(ctx context.Context, from *dto.ListArticleEventsOutDto) (response *grpc.ListArticleEventsResponse, err error)
+}
+
+// ToGetArticleAtResponse is a converter interface for converting from GetArticleAt use-case's dto to pb response.
+type ToGetArticleAtResponse interface {
+	// ToGetArticleAtResponse converts from GetArticleAt use-case's dto to pb response.
+	ToGetArticleAtResponse(ctx context.Context, from *dto.GetArticleAtOutDto) (response *grpc.GetArticleAtResponse, err error)
+}
+
 // ToUploadImageResponse is a converter interface for converting from UploadImage use-case's dto to pb response.
 type ToUploadImageResponse interface {
 	// ToUploadImageResponse converts from UploadImage use-case's dto to pb response.
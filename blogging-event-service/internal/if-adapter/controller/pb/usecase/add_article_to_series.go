@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// AddArticleToSeries is a use-case interface for adding an article to an existing series.
+type AddArticleToSeries interface {
+	// Execute adds an article to a series.
+	Execute(ctx context.Context, in *dto.AddArticleToSeriesInDto) (*dto.SeriesOutDto, error)
+}
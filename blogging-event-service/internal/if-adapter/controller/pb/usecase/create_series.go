@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// CreateSeries is a use-case interface for putting articles together in a new series.
+type CreateSeries interface {
+	// Execute creates a series of the articles, in the order they are given.
+	Execute(ctx context.Context, in *dto.CreateSeriesInDto) (*dto.SeriesOutDto, error)
+}
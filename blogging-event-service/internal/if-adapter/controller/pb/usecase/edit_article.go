@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// EditArticle is a use-case interface for editing several fields of an article at once.
+type EditArticle interface {
+	// Execute edits an article.
+	Execute(ctx context.Context, in *dto.EditArticleInDto) (*dto.EditArticleOutDto, error)
+}
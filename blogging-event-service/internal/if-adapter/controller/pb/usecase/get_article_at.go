@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// GetArticleAt is a use-case interface for getting an article as it was at a point of its history.
+type GetArticleAt interface {
+	// Execute gets an article by replaying its events up to an event or a timestamp.
+	Execute(ctx context.Context, in *dto.GetArticleAtInDto) (*dto.GetArticleAtOutDto, error)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// GetDraft is a use-case interface for getting a draft article.
+type GetDraft interface {
+	// Execute gets a draft article.
+	Execute(ctx context.Context, in *dto.GetDraftInDto) (*dto.GetDraftOutDto, error)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// HideArticle is a use-case interface for hiding an article.
+type HideArticle interface {
+	// Execute hides an article.
+	Execute(ctx context.Context, in *dto.HideArticleInDto) (*dto.HideArticleOutDto, error)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// ListArticleEvents is a use-case interface for listing the history of an article.
+type ListArticleEvents interface {
+	// Execute lists the events of an article.
+	Execute(ctx context.Context, in *dto.ListArticleEventsInDto) (*dto.ListArticleEventsOutDto, error)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// ListDrafts is a use-case interface for listing draft articles.
+type ListDrafts interface {
+	// Execute lists all draft articles.
+	Execute(ctx context.Context) (*dto.ListDraftsOutDto, error)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// MergeTags is a use-case interface for merging tags into another one on every article they are attached to.
+type MergeTags interface {
+	// Execute merges tags into another one on every article they are attached to.
+	Execute(ctx context.Context, in *dto.MergeTagsInDto) (*dto.MergeTagsOutDto, error)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// PublishArticle is a use-case interface for publishing a draft article.
+type PublishArticle interface {
+	// Execute publishes a draft article.
+	Execute(ctx context.Context, in *dto.PublishArticleInDto) (*dto.PublishArticleOutDto, error)
+}
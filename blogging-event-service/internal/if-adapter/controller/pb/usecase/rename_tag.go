@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// RenameTag is a use-case interface for renaming a tag on every article it is attached to.
+type RenameTag interface {
+	// Execute renames a tag on every article it is attached to.
+	Execute(ctx context.Context, in *dto.RenameTagInDto) (*dto.RenameTagOutDto, error)
+}
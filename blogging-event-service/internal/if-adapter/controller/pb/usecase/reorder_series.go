@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// ReorderSeries is a use-case interface for changing the order of the articles of a series.
+type ReorderSeries interface {
+	// Execute puts the articles of a series in the given order.
+	Execute(ctx context.Context, in *dto.ReorderSeriesInDto) (*dto.SeriesOutDto, error)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// RevertArticle is a use-case interface for restoring an article as it was right after an earlier event.
+type RevertArticle interface {
+	// Execute restores an article as it was right after an earlier event.
+	Execute(ctx context.Context, in *dto.RevertArticleInDto) (*dto.RevertArticleOutDto, error)
+}
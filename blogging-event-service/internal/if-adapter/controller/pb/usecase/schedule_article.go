@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// ScheduleArticle is a use-case interface for scheduling the publication of an article.
+type ScheduleArticle interface {
+	// Execute sets the time an article goes live.
+	Execute(ctx context.Context, in *dto.ScheduleArticleInDto) (*dto.ScheduleArticleOutDto, error)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// UnhideArticle is a use-case interface for unhiding an article.
+type UnhideArticle interface {
+	// Execute makes a hidden article visible again.
+	Execute(ctx context.Context, in *dto.UnhideArticleInDto) (*dto.UnhideArticleOutDto, error)
+}
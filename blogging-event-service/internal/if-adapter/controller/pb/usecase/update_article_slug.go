@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=../../../../mock/if-adapter/controller/pb/usecase/$GOFILE -package=$GOPACKAGE
+package usecase
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	"context"
+)
+
+// UpdateArticleSlug is a use-case interface for updating the slug of an article.
+type UpdateArticleSlug interface {
+	// Execute updates the slug of an article.
+	Execute(ctx context.Context, in *dto.UpdateArticleSlugInDto) (*dto.UpdateArticleSlugOutDto, error)
+}
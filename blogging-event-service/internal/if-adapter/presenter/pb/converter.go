@@ -9,6 +9,8 @@ import (
 	"github.com/miyamo2/altnrslog"
 	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"log/slog"
 )
 
@@ -56,6 +58,27 @@ func (c Converter) ToUpdateArticleTitleResponse(ctx context.Context, from *dto.U
 	return
 }
 
+func (c Converter) ToUpdateArticleSlugResponse(ctx context.Context, from *dto.UpdateArticleSlugOutDto) (response *grpc.BloggingEventResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToUpdateArticleSlugResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", *response)))
+	}()
+	response = &grpc.BloggingEventResponse{
+		EventId:   from.EventID(),
+		ArticleId: from.ArticleID(),
+	}
+	return
+}
+
 func (c Converter) ToUpdateArticleBodyResponse(ctx context.Context, from *dto.UpdateArticleBodyOutDto) (response *grpc.BloggingEventResponse, err error) {
 	nrtx := newrelic.FromContext(ctx)
 	defer nrtx.StartSegment("ToUpdateArticleBodyResponse").End()
@@ -140,6 +163,400 @@ func (c Converter) ToDetachTagsResponse(ctx context.Context, from *dto.DetachTag
 	return
 }
 
+func (c Converter) ToHideArticleResponse(ctx context.Context, from *dto.HideArticleOutDto) (response *grpc.BloggingEventResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToHideArticleResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", *response)))
+	}()
+	response = &grpc.BloggingEventResponse{
+		EventId:   from.EventID(),
+		ArticleId: from.ArticleID(),
+	}
+	return
+}
+
+func (c Converter) ToUnhideArticleResponse(ctx context.Context, from *dto.UnhideArticleOutDto) (response *grpc.BloggingEventResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToUnhideArticleResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", *response)))
+	}()
+	response = &grpc.BloggingEventResponse{
+		EventId:   from.EventID(),
+		ArticleId: from.ArticleID(),
+	}
+	return
+}
+
+func (c Converter) ToEditArticleResponse(ctx context.Context, from *dto.EditArticleOutDto) (response *grpc.BloggingEventResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToEditArticleResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", *response)))
+	}()
+	response = &grpc.BloggingEventResponse{
+		EventId:   from.EventID(),
+		ArticleId: from.ArticleID(),
+	}
+	return
+}
+
+func (c Converter) ToScheduleArticleResponse(ctx context.Context, from *dto.ScheduleArticleOutDto) (response *grpc.BloggingEventResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToScheduleArticleResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", *response)))
+	}()
+	response = &grpc.BloggingEventResponse{
+		EventId:   from.EventID(),
+		ArticleId: from.ArticleID(),
+	}
+	return
+}
+
+func (c Converter) ToPublishArticleResponse(ctx context.Context, from *dto.PublishArticleOutDto) (response *grpc.BloggingEventResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToPublishArticleResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", response)))
+	}()
+	response = &grpc.BloggingEventResponse{
+		EventId:   from.EventID(),
+		ArticleId: from.ArticleID(),
+	}
+	return
+}
+
+func (c Converter) ToRevertArticleResponse(ctx context.Context, from *dto.RevertArticleOutDto) (response *grpc.BloggingEventResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToRevertArticleResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", response)))
+	}()
+	response = &grpc.BloggingEventResponse{
+		EventId:   from.EventID(),
+		ArticleId: from.ArticleID(),
+	}
+	return
+}
+
+func (c Converter) ToRenameTagResponse(ctx context.Context, from *dto.RenameTagOutDto) (response *grpc.RenameTagResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToRenameTagResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", response)))
+	}()
+	events := make([]*grpc.BloggingEventResponse, 0, len(from.Events()))
+	for _, e := range from.Events() {
+		events = append(events, &grpc.BloggingEventResponse{
+			EventId:   e.EventID(),
+			ArticleId: e.ArticleID(),
+		})
+	}
+	response = &grpc.RenameTagResponse{
+		Events: events,
+	}
+	return
+}
+
+func (c Converter) ToMergeTagsResponse(ctx context.Context, from *dto.MergeTagsOutDto) (response *grpc.MergeTagsResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToMergeTagsResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", response)))
+	}()
+	events := make([]*grpc.BloggingEventResponse, 0, len(from.Events()))
+	for _, e := range from.Events() {
+		events = append(events, &grpc.BloggingEventResponse{
+			EventId:   e.EventID(),
+			ArticleId: e.ArticleID(),
+		})
+	}
+	response = &grpc.MergeTagsResponse{
+		Events: events,
+	}
+	return
+}
+
+func (c Converter) ToCreateSeriesResponse(ctx context.Context, from *dto.SeriesOutDto) (response *grpc.SeriesResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToCreateSeriesResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", response)))
+	}()
+	response = toSeriesResponse(from)
+	return
+}
+
+func (c Converter) ToAddArticleToSeriesResponse(ctx context.Context, from *dto.SeriesOutDto) (response *grpc.SeriesResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToAddArticleToSeriesResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", response)))
+	}()
+	response = toSeriesResponse(from)
+	return
+}
+
+func (c Converter) ToReorderSeriesResponse(ctx context.Context, from *dto.SeriesOutDto) (response *grpc.SeriesResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToReorderSeriesResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", response)))
+	}()
+	response = toSeriesResponse(from)
+	return
+}
+
+func toSeriesResponse(from *dto.SeriesOutDto) *grpc.SeriesResponse {
+	events := make([]*grpc.BloggingEventResponse, 0, len(from.Events()))
+	for _, e := range from.Events() {
+		events = append(events, &grpc.BloggingEventResponse{
+			EventId:   e.EventID(),
+			ArticleId: e.ArticleID(),
+		})
+	}
+	return &grpc.SeriesResponse{
+		SeriesId: from.SeriesID(),
+		Events:   events,
+	}
+}
+
+func (c Converter) ToGetDraftResponse(ctx context.Context, from *dto.GetDraftOutDto) (response *grpc.GetDraftResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToGetDraftResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", response)))
+	}()
+	response = &grpc.GetDraftResponse{
+		Draft: toDraft(from.Draft()),
+	}
+	return
+}
+
+func (c Converter) ToListDraftsResponse(ctx context.Context, from *dto.ListDraftsOutDto) (response *grpc.ListDraftsResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToListDraftsResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", response)))
+	}()
+	drafts := make([]*grpc.Draft, 0, len(from.Drafts()))
+	for _, v := range from.Drafts() {
+		drafts = append(drafts, toDraft(v))
+	}
+	response = &grpc.ListDraftsResponse{
+		Drafts: drafts,
+	}
+	return
+}
+
+func toDraft(from dto.DraftDto) *grpc.Draft {
+	return &grpc.Draft{
+		Id:           from.ID(),
+		Title:        from.Title(),
+		Body:         from.Body(),
+		ThumbnailUrl: from.ThumbnailUrl(),
+		TagNames:     from.TagNames(),
+		LastEventId:  from.LastEventID(),
+	}
+}
+
+func (c Converter) ToListArticleEventsResponse(ctx context.Context, from *dto.ListArticleEventsOutDto) (response *grpc.ListArticleEventsResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToListArticleEventsResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", response)))
+	}()
+	events := make([]*grpc.ArticleEvent, 0, len(from.Events()))
+	for _, v := range from.Events() {
+		events = append(events, toArticleEvent(v))
+	}
+	response = &grpc.ListArticleEventsResponse{
+		Events: events,
+	}
+	return
+}
+
+func toArticleEvent(from dto.ArticleEventDto) *grpc.ArticleEvent {
+	var publishAt *timestamppb.Timestamp
+	if v := from.PublishAt(); v != nil {
+		publishAt = timestamppb.New(*v)
+	}
+	var actor *string
+	if v := from.Actor(); v != "" {
+		actor = &v
+	}
+	var revertedTo *string
+	if v := from.RevertedTo(); v != "" {
+		revertedTo = &v
+	}
+	var seriesPosition *int32
+	if v := from.SeriesPosition(); v != nil {
+		seriesPosition = proto.Int32(int32(*v))
+	}
+	return &grpc.ArticleEvent{
+		Id:             from.ID(),
+		Type:           from.EventType(),
+		OccurredAt:     timestamppb.New(from.OccurredAt()),
+		Title:          from.Title(),
+		Body:           from.Body(),
+		ThumbnailUrl:   from.ThumbnailUrl(),
+		TagNames:       from.TagNames(),
+		AttachTagNames: from.AttachTagNames(),
+		DetachTagNames: from.DetachTagNames(),
+		Invisible:      from.Invisible(),
+		PublishAt:      publishAt,
+		Draft:          from.Draft(),
+		Actor:          actor,
+		RevertedTo:     revertedTo,
+		Slug:           from.Slug(),
+		SeriesId:       from.SeriesID(),
+		SeriesTitle:    from.SeriesTitle(),
+		SeriesPosition: seriesPosition,
+	}
+}
+
+func (c Converter) ToGetArticleAtResponse(ctx context.Context, from *dto.GetArticleAtOutDto) (response *grpc.GetArticleAtResponse, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToGetArticleAtResponse").End()
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+		err = nil
+	}
+	logger.InfoContext(ctx, "BEGIN", slog.Group("parameters", slog.Any("from", *from)))
+	defer func() {
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("response", response)))
+	}()
+	response = &grpc.GetArticleAtResponse{
+		Article: &grpc.ArticleSnapshot{
+			Id:           from.ID(),
+			Title:        from.Title(),
+			Body:         from.Body(),
+			ThumbnailUrl: from.ThumbnailUrl(),
+			TagNames:     from.TagNames(),
+			EventId:      from.EventID(),
+			EventAt:      timestamppb.New(from.EventAt()),
+		},
+	}
+	return
+}
+
 func (c Converter) ToUploadImageResponse(ctx context.Context, from *dto.UploadImageOutDto) (response *grpc.UploadImageResponse, err error) {
 	nrtx := newrelic.FromContext(ctx)
 	defer nrtx.StartSegment("ToUploadImageResponse").End()
@@ -156,12 +573,24 @@ func (c Converter) ToUploadImageResponse(ctx context.Context, from *dto.UploadIm
 	}()
 
 	uri := from.URL()
+	variants := make([]*grpc.ImageVariant, 0, len(from.Variants()))
+	for _, v := range from.Variants() {
+		variantURI := v.URL()
+		variants = append(variants, &grpc.ImageVariant{
+			Url:         variantURI.String(),
+			Width:       int32(v.Width()),
+			Height:      int32(v.Height()),
+			ContentType: v.ContentType(),
+		})
+	}
 	response = &grpc.UploadImageResponse{
 		Success: true,
 		Url: func() *string {
 			v := uri.String()
 			return &v
 		}(),
+		Variants:     variants,
+		Deduplicated: from.Deduplicated(),
 	}
 	return
 }
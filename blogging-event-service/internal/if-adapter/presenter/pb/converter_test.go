@@ -7,9 +7,12 @@ import (
 	"context"
 	"github.com/cockroachdb/errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestConverter_ToCreateArticleArticleResponse(t *testing.T) {
@@ -94,6 +97,47 @@ func TestConverter_ToUpdateArticleTitleResponse(t *testing.T) {
 	}
 }
 
+func TestConverter_ToUpdateArticleSlugResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.UpdateArticleSlugOutDto
+	}
+	type want struct {
+		result *grpc.BloggingEventResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/single": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.UpdateArticleSlugOutDto {
+					o := dto.NewUpdateArticleSlugOutDto("abc", "def")
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.BloggingEventResponse{EventId: "abc", ArticleId: "def"},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToUpdateArticleSlugResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToUpdateArticleSlugResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToUpdateArticleSlugResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
 func TestConverter_ToUpdateArticleBodyResponse(t *testing.T) {
 	type args struct {
 		ctx  context.Context
@@ -258,6 +302,170 @@ func TestConverter_ToDetachTagsResponse(t *testing.T) {
 	}
 }
 
+func TestConverter_ToHideArticleResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.HideArticleOutDto
+	}
+	type want struct {
+		result *grpc.BloggingEventResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/single": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.HideArticleOutDto {
+					o := dto.NewHideArticleOutDto("abc", "def")
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.BloggingEventResponse{EventId: "abc", ArticleId: "def"},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToHideArticleResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToHideArticleResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToHideArticleResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToUnhideArticleResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.UnhideArticleOutDto
+	}
+	type want struct {
+		result *grpc.BloggingEventResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/single": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.UnhideArticleOutDto {
+					o := dto.NewUnhideArticleOutDto("abc", "def")
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.BloggingEventResponse{EventId: "abc", ArticleId: "def"},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToUnhideArticleResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToUnhideArticleResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToUnhideArticleResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToEditArticleResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.EditArticleOutDto
+	}
+	type want struct {
+		result *grpc.BloggingEventResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/single": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.EditArticleOutDto {
+					o := dto.NewEditArticleOutDto("abc", "def")
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.BloggingEventResponse{EventId: "abc", ArticleId: "def"},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToEditArticleResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToEditArticleResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToEditArticleResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToScheduleArticleResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.ScheduleArticleOutDto
+	}
+	type want struct {
+		result *grpc.BloggingEventResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/single": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.ScheduleArticleOutDto {
+					o := dto.NewScheduleArticleOutDto("abc", "def")
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.BloggingEventResponse{EventId: "abc", ArticleId: "def"},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToScheduleArticleResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToScheduleArticleResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToScheduleArticleResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
 func TestConverter_ToUploadImageResponse(t *testing.T) {
 	type args struct {
 		ctx  context.Context
@@ -276,7 +484,7 @@ func TestConverter_ToUploadImageResponse(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				from: func() *dto.UploadImageOutDto {
-					o := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/example.png"))
+					o := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/example.png"), nil, false)
 					return &o
 				},
 			},
@@ -289,6 +497,48 @@ func TestConverter_ToUploadImageResponse(t *testing.T) {
 					}()},
 			},
 		},
+		"happy_path/deduplicated": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.UploadImageOutDto {
+					o := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/example.png"), nil, true)
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.UploadImageResponse{
+					Success: true,
+					Url: func() *string {
+						v := "http://example.com/example.png"
+						return &v
+					}(),
+					Deduplicated: true},
+			},
+		},
+		"happy_path/with_variants": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.UploadImageOutDto {
+					o := dto.NewUploadImageOutDto(*pkg.MustParseURL("http://example.com/example.png"), []dto.ImageVariantDto{
+						dto.NewImageVariantDto(*pkg.MustParseURL("http://example.com/example-640w.png"), 640, 480, "image/png"),
+						dto.NewImageVariantDto(*pkg.MustParseURL("http://example.com/example-640w.webp"), 640, 480, "image/webp"),
+					}, false)
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.UploadImageResponse{
+					Success: true,
+					Url: func() *string {
+						v := "http://example.com/example.png"
+						return &v
+					}(),
+					Variants: []*grpc.ImageVariant{
+						{Url: "http://example.com/example-640w.png", Width: 640, Height: 480, ContentType: "image/png"},
+						{Url: "http://example.com/example-640w.webp", Width: 640, Height: 480, ContentType: "image/webp"},
+					}},
+			},
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -303,3 +553,585 @@ func TestConverter_ToUploadImageResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestConverter_ToPublishArticleResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.PublishArticleOutDto
+	}
+	type want struct {
+		result *grpc.BloggingEventResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/single": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.PublishArticleOutDto {
+					o := dto.NewPublishArticleOutDto("abc", "def")
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.BloggingEventResponse{EventId: "abc", ArticleId: "def"},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToPublishArticleResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToPublishArticleResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToPublishArticleResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToRevertArticleResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.RevertArticleOutDto
+	}
+	type want struct {
+		result *grpc.BloggingEventResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/single": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.RevertArticleOutDto {
+					o := dto.NewRevertArticleOutDto("abc", "def")
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.BloggingEventResponse{EventId: "abc", ArticleId: "def"},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToRevertArticleResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToRevertArticleResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToRevertArticleResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToRenameTagResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.RenameTagOutDto
+	}
+	type want struct {
+		result *grpc.RenameTagResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/multiple": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.RenameTagOutDto {
+					o := dto.NewRenameTagOutDto([]dto.BloggingEventKeyDto{
+						dto.NewBloggingEventKeyDto("abc", "def"),
+						dto.NewBloggingEventKeyDto("ghi", "jkl"),
+					})
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.RenameTagResponse{
+					Events: []*grpc.BloggingEventResponse{
+						{EventId: "abc", ArticleId: "def"},
+						{EventId: "ghi", ArticleId: "jkl"},
+					},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToRenameTagResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToRenameTagResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToRenameTagResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToCreateSeriesResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.SeriesOutDto
+	}
+	type want struct {
+		result *grpc.SeriesResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/multiple": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.SeriesOutDto {
+					o := dto.NewSeriesOutDto("series_id", []dto.BloggingEventKeyDto{
+						dto.NewBloggingEventKeyDto("abc", "def"),
+						dto.NewBloggingEventKeyDto("ghi", "jkl"),
+					})
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.SeriesResponse{
+					SeriesId: "series_id",
+					Events: []*grpc.BloggingEventResponse{
+						{EventId: "abc", ArticleId: "def"},
+						{EventId: "ghi", ArticleId: "jkl"},
+					},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToCreateSeriesResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToCreateSeriesResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToCreateSeriesResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToAddArticleToSeriesResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.SeriesOutDto
+	}
+	type want struct {
+		result *grpc.SeriesResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/multiple": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.SeriesOutDto {
+					o := dto.NewSeriesOutDto("series_id", []dto.BloggingEventKeyDto{
+						dto.NewBloggingEventKeyDto("abc", "def"),
+						dto.NewBloggingEventKeyDto("ghi", "jkl"),
+					})
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.SeriesResponse{
+					SeriesId: "series_id",
+					Events: []*grpc.BloggingEventResponse{
+						{EventId: "abc", ArticleId: "def"},
+						{EventId: "ghi", ArticleId: "jkl"},
+					},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToAddArticleToSeriesResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToAddArticleToSeriesResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToAddArticleToSeriesResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToReorderSeriesResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.SeriesOutDto
+	}
+	type want struct {
+		result *grpc.SeriesResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/multiple": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.SeriesOutDto {
+					o := dto.NewSeriesOutDto("series_id", []dto.BloggingEventKeyDto{
+						dto.NewBloggingEventKeyDto("abc", "def"),
+						dto.NewBloggingEventKeyDto("ghi", "jkl"),
+					})
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.SeriesResponse{
+					SeriesId: "series_id",
+					Events: []*grpc.BloggingEventResponse{
+						{EventId: "abc", ArticleId: "def"},
+						{EventId: "ghi", ArticleId: "jkl"},
+					},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToReorderSeriesResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToReorderSeriesResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToReorderSeriesResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToMergeTagsResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.MergeTagsOutDto
+	}
+	type want struct {
+		result *grpc.MergeTagsResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/multiple": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.MergeTagsOutDto {
+					o := dto.NewMergeTagsOutDto([]dto.BloggingEventKeyDto{
+						dto.NewBloggingEventKeyDto("abc", "def"),
+						dto.NewBloggingEventKeyDto("ghi", "jkl"),
+					})
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.MergeTagsResponse{
+					Events: []*grpc.BloggingEventResponse{
+						{EventId: "abc", ArticleId: "def"},
+						{EventId: "ghi", ArticleId: "jkl"},
+					},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToMergeTagsResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToMergeTagsResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToMergeTagsResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToGetDraftResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.GetDraftOutDto
+	}
+	type want struct {
+		result *grpc.GetDraftResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/single": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.GetDraftOutDto {
+					o := dto.NewGetDraftOutDto(dto.NewDraftDto("abc", "title", "body", "http://example.com/example.png", []string{"tag1"}, "def"))
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.GetDraftResponse{
+					Draft: &grpc.Draft{
+						Id:           "abc",
+						Title:        "title",
+						Body:         "body",
+						ThumbnailUrl: "http://example.com/example.png",
+						TagNames:     []string{"tag1"},
+						LastEventId:  "def",
+					},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToGetDraftResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToGetDraftResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToGetDraftResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToListArticleEventsResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.ListArticleEventsOutDto
+	}
+	type want struct {
+		result *grpc.ListArticleEventsResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	title, body, thumbnail := "title", "body", "http://example.com/example.png"
+	publishAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	invisible := true
+	seriesID, seriesTitle, seriesPosition := "series_id", "Go in Practice", 2
+	tests := map[string]testCase{
+		"happy_path/multiple": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.ListArticleEventsOutDto {
+					o := dto.NewListArticleEventsOutDto([]dto.ArticleEventDto{
+						dto.NewArticleEventDto("abc", "CREATE_ARTICLE", time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC), dto.ArticleEventDtoWithTitle(title), dto.ArticleEventDtoWithBody(body), dto.ArticleEventDtoWithThumbnailUrl(thumbnail), dto.ArticleEventDtoWithTagNames("tag1"), dto.ArticleEventDtoWithPublishAt(publishAt)),
+						dto.NewArticleEventDto("def", "HIDE_ARTICLE", time.Date(2024, 12, 14, 0, 0, 0, 0, time.UTC), dto.ArticleEventDtoWithInvisible(invisible), dto.ArticleEventDtoWithActor("author")),
+						dto.NewArticleEventDto("ghi", "ADD_TO_SERIES", time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC), dto.ArticleEventDtoWithSeries(seriesID, seriesTitle, seriesPosition)),
+					})
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.ListArticleEventsResponse{
+					Events: []*grpc.ArticleEvent{
+						{
+							Id:           "abc",
+							Type:         "CREATE_ARTICLE",
+							OccurredAt:   timestamppb.New(time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC)),
+							Title:        &title,
+							Body:         &body,
+							ThumbnailUrl: &thumbnail,
+							TagNames:     []string{"tag1"},
+							PublishAt:    timestamppb.New(publishAt),
+						},
+						{
+							Id:         "def",
+							Type:       "HIDE_ARTICLE",
+							OccurredAt: timestamppb.New(time.Date(2024, 12, 14, 0, 0, 0, 0, time.UTC)),
+							Invisible:  &invisible,
+							Actor:      proto.String("author"),
+						},
+						{
+							Id:             "ghi",
+							Type:           "ADD_TO_SERIES",
+							OccurredAt:     timestamppb.New(time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC)),
+							SeriesId:       &seriesID,
+							SeriesTitle:    &seriesTitle,
+							SeriesPosition: proto.Int32(2),
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToListArticleEventsResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToListArticleEventsResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToListArticleEventsResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToGetArticleAtResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.GetArticleAtOutDto
+	}
+	type want struct {
+		result *grpc.GetArticleAtResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.GetArticleAtOutDto {
+					o := dto.NewGetArticleAtOutDto("abc", "title", "body", "http://example.com/example.png", []string{"tag1"}, "def", time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC))
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.GetArticleAtResponse{
+					Article: &grpc.ArticleSnapshot{
+						Id:           "abc",
+						Title:        "title",
+						Body:         "body",
+						ThumbnailUrl: "http://example.com/example.png",
+						TagNames:     []string{"tag1"},
+						EventId:      "def",
+						EventAt:      timestamppb.New(time.Date(2024, 12, 13, 0, 0, 0, 0, time.UTC)),
+					},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToGetArticleAtResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToGetArticleAtResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToGetArticleAtResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestConverter_ToListDraftsResponse(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from func() *dto.ListDraftsOutDto
+	}
+	type want struct {
+		result *grpc.ListDraftsResponse
+		err    error
+	}
+	type testCase struct {
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path/multiple": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.ListDraftsOutDto {
+					o := dto.NewListDraftsOutDto([]dto.DraftDto{
+						dto.NewDraftDto("abc", "title1", "body1", "http://example.com/example1.png", []string{"tag1"}, "def"),
+						dto.NewDraftDto("ghi", "title2", "body2", "http://example.com/example2.png", nil, "jkl"),
+					})
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.ListDraftsResponse{
+					Drafts: []*grpc.Draft{
+						{
+							Id:           "abc",
+							Title:        "title1",
+							Body:         "body1",
+							ThumbnailUrl: "http://example.com/example1.png",
+							TagNames:     []string{"tag1"},
+							LastEventId:  "def",
+						},
+						{
+							Id:           "ghi",
+							Title:        "title2",
+							Body:         "body2",
+							ThumbnailUrl: "http://example.com/example2.png",
+							LastEventId:  "jkl",
+						},
+					},
+				},
+			},
+		},
+		"happy_path/empty": {
+			args: args{
+				ctx: context.Background(),
+				from: func() *dto.ListDraftsOutDto {
+					o := dto.NewListDraftsOutDto([]dto.DraftDto{})
+					return &o
+				},
+			},
+			want: want{
+				result: &grpc.ListDraftsResponse{},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewConverter()
+			got, err := c.ToListDraftsResponse(tt.args.ctx, tt.args.from())
+			if diff := cmp.Diff(got, tt.want.result, protocmp.Transform()); diff != "" {
+				t.Errorf("ToListDraftsResponse() = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToListDraftsResponse() error = %v, want %v", err, tt.want.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package dynamo
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/article"
+	"blogapi.miyamo.today/core/db"
+	gw "blogapi.miyamo.today/core/db/gorm"
+	"context"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/dynmgrm"
+	"github.com/miyamo2/sqldav"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/oklog/ulid/v2"
+	"gorm.io/gorm"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// bloggingEventRow holds all the attributes a blogging event may have.
+// Attributes the event did not write are nil or empty.
+type bloggingEventRow struct {
+	EventID           string
+	EventType         *string
+	SchemaVersion     *int
+	Title             *string
+	Content           *string
+	Thumbnail         *string
+	ThumbnailVariants imageVariants
+	Tags              sqldav.Set[string]
+	AttachTags        sqldav.Set[string]
+	DetachTags        sqldav.Set[string]
+	Invisible         *bool
+	PublishAt         *string
+	Draft             *bool
+	RevertedTo        *string
+	Slug              *string
+	SeriesID          *string
+	SeriesTitle       *string
+	SeriesPosition    *int
+	Actor             *string
+}
+
+// listEvents returns the events of the article, oldest first.
+func listEvents(tx *gorm.DB, articleID string) ([]bloggingEventRow, error) {
+	events := make([]bloggingEventRow, 0)
+	err := tx.Clauses(
+		dynmgrm.SecondaryIndex("article_id_event_id-Index",
+			dynmgrm.SecondaryIndexOf(os.Getenv("BLOGGING_EVENTS_TABLE_NAME")))).
+		Select("event_id", "event_type", "schema_version", "title", "content", "thumbnail", "thumbnail_variants", "tags", "attach_tags", "detach_tags", "invisible", "publish_at", "draft", "reverted_to", "slug", "series_id", "series_title", "series_position", "actor").
+		Where("article_id = ?", articleID).
+		Scan(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	// ULIDs are lexicographically sortable.
+	slices.SortFunc(events, func(a, b bloggingEventRow) int {
+		return strings.Compare(a.EventID, b.EventID)
+	})
+	return events, nil
+}
+
+// eventTypeOf returns the stored kind of the event.
+// Events of schema version 1 do not store it, so it is told from the attributes they wrote.
+func eventTypeOf(row bloggingEventRow, first bool) model.ArticleEventType {
+	if row.EventType != nil && *row.EventType != "" {
+		return model.ArticleEventType(*row.EventType)
+	}
+	return model.ArticleEventType(article.EventTypeOfV1(article.V1Attributes{
+		Title:      row.Title,
+		Content:    row.Content,
+		Thumbnail:  row.Thumbnail,
+		AttachTags: row.AttachTags,
+		DetachTags: row.DetachTags,
+		Invisible:  row.Invisible,
+		Draft:      row.Draft,
+		PublishAt:  row.PublishAt,
+		RevertedTo: row.RevertedTo,
+	}, first))
+}
+
+// articleEventFromRow converts the row to model.ArticleEvent.
+func articleEventFromRow(row bloggingEventRow, first bool) (model.ArticleEvent, error) {
+	id, err := ulid.Parse(row.EventID)
+	if err != nil {
+		return model.ArticleEvent{}, err
+	}
+	options := []model.ArticleEventOption{
+		model.ArticleEventWithTags(row.Tags...),
+		model.ArticleEventWithAttachTags(row.AttachTags...),
+		model.ArticleEventWithDetachTags(row.DetachTags...),
+	}
+	if row.Title != nil {
+		options = append(options, model.ArticleEventWithTitle(*row.Title))
+	}
+	if row.Content != nil {
+		options = append(options, model.ArticleEventWithContent(*row.Content))
+	}
+	if row.Thumbnail != nil {
+		options = append(options, model.ArticleEventWithThumbnail(*row.Thumbnail), model.ArticleEventWithThumbnailVariants(row.ThumbnailVariants.toModel()...))
+	}
+	if row.Invisible != nil {
+		options = append(options, model.ArticleEventWithInvisible(*row.Invisible))
+	}
+	if row.PublishAt != nil && *row.PublishAt != "" {
+		publishAt, err := time.Parse(time.RFC3339, *row.PublishAt)
+		if err != nil {
+			return model.ArticleEvent{}, err
+		}
+		options = append(options, model.ArticleEventWithPublishAt(publishAt))
+	}
+	if row.Draft != nil {
+		options = append(options, model.ArticleEventWithDraft(*row.Draft))
+	}
+	if row.Actor != nil {
+		options = append(options, model.ArticleEventWithActor(*row.Actor))
+	}
+	if row.RevertedTo != nil {
+		options = append(options, model.ArticleEventWithRevertedTo(*row.RevertedTo))
+	}
+	if row.Slug != nil {
+		options = append(options, model.ArticleEventWithSlug(*row.Slug))
+	}
+	if row.SeriesID != nil {
+		options = append(options, model.ArticleEventWithSeries(model.NewArticleSeries(*row.SeriesID, aws.ToString(row.SeriesTitle), aws.ToInt(row.SeriesPosition))))
+	}
+	return model.NewArticleEvent(row.EventID, eventTypeOf(row, first), ulid.Time(id.Time()).UTC(), options...), nil
+}
+
+type ArticleEventQueryService struct{}
+
+func (s *ArticleEventQueryService) ListByArticleID(ctx context.Context, articleID string, out *db.MultipleStatementResult[*model.ArticleEvent]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ArticleEventQueryService#ListByArticleID").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("ArticleEventQueryService#ListByArticleID#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		rows, err := listEvents(tx, articleID)
+		if err != nil {
+			err = errors.WithStack(classifyError(err))
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		if len(rows) == 0 {
+			return errors.WithStack(model.ErrNotFound)
+		}
+
+		events := make([]*model.ArticleEvent, 0, len(rows))
+		for i, row := range rows {
+			event, err := articleEventFromRow(row, i == 0)
+			if err != nil {
+				err = errors.WithStack(err)
+				nrtx.NoticeError(nrpkgerrors.Wrap(err))
+				return err
+			}
+			events = append(events, &event)
+		}
+
+		out.Set(events)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+func NewArticleEventQueryService() *ArticleEventQueryService {
+	return &ArticleEventQueryService{}
+}
@@ -0,0 +1,106 @@
+package dynamo
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"reflect"
+	"testing"
+)
+
+func TestEventTypeOf(t *testing.T) {
+	tests := map[string]struct {
+		row   bloggingEventRow
+		first bool
+		want  model.ArticleEventType
+	}{
+		"create-article": {
+			row:   bloggingEventRow{Title: aws.String("title"), Content: aws.String("content"), Thumbnail: aws.String("thumbnail")},
+			first: true,
+			want:  model.ArticleEventTypeCreateArticle,
+		},
+		"update-title": {
+			row:  bloggingEventRow{Title: aws.String("title")},
+			want: model.ArticleEventTypeUpdateTitle,
+		},
+		"update-body": {
+			row:  bloggingEventRow{Content: aws.String("content")},
+			want: model.ArticleEventTypeUpdateBody,
+		},
+		"update-thumbnail": {
+			row:  bloggingEventRow{Thumbnail: aws.String("thumbnail")},
+			want: model.ArticleEventTypeUpdateThumbnail,
+		},
+		"attach-tags": {
+			row:  bloggingEventRow{AttachTags: []string{"tag"}},
+			want: model.ArticleEventTypeAttachTags,
+		},
+		"detach-tags": {
+			row:  bloggingEventRow{DetachTags: []string{"tag"}},
+			want: model.ArticleEventTypeDetachTags,
+		},
+		"hide-article": {
+			row:  bloggingEventRow{Invisible: aws.Bool(true)},
+			want: model.ArticleEventTypeHideArticle,
+		},
+		"unhide-article": {
+			row:  bloggingEventRow{Invisible: aws.Bool(false)},
+			want: model.ArticleEventTypeUnhideArticle,
+		},
+		"edit-article": {
+			row:  bloggingEventRow{Title: aws.String("title"), AttachTags: []string{"tag"}},
+			want: model.ArticleEventTypeEditArticle,
+		},
+		"schedule-article": {
+			row:  bloggingEventRow{PublishAt: aws.String("2026-01-01T00:00:00Z")},
+			want: model.ArticleEventTypeScheduleArticle,
+		},
+		"publish-article": {
+			row:  bloggingEventRow{Draft: aws.Bool(false)},
+			want: model.ArticleEventTypePublishArticle,
+		},
+		"stored-event-type": {
+			row:  bloggingEventRow{EventType: aws.String(string(model.ArticleEventTypeEditArticle)), SchemaVersion: aws.Int(schemaVersion), Title: aws.String("title")},
+			want: model.ArticleEventTypeEditArticle,
+		},
+		"revert-article": {
+			row:  bloggingEventRow{Title: aws.String("title"), Content: aws.String("content"), Thumbnail: aws.String("thumbnail"), RevertedTo: aws.String("01JF0REBGD4QKPFGN1SX2STY4M")},
+			want: model.ArticleEventTypeRevertArticle,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := eventTypeOf(tt.row, tt.first); got != tt.want {
+				t.Errorf("eventTypeOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImageVariants_Scan(t *testing.T) {
+	tests := map[string]struct {
+		value interface{}
+		want  imageVariants
+	}{
+		"happy_path/missing": {
+			value: nil,
+			want:  nil,
+		},
+		"happy_path/list": {
+			value: []interface{}{
+				map[string]interface{}{"url": "https://example.com/example-640w.webp", "width": float64(640), "height": float64(480), "content_type": "image/webp"},
+			},
+			want: imageVariants{{URL: "https://example.com/example-640w.webp", Width: 640, Height: 480, ContentType: "image/webp"}},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got imageVariants
+			if err := got.Scan(tt.value); err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
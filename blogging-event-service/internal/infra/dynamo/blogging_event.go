@@ -3,10 +3,15 @@ package dynamo
 import (
 	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
 	"blogapi.miyamo.today/blogging-event-service/internal/pkg"
+	"blogapi.miyamo.today/core/article"
 	"blogapi.miyamo.today/core/db"
 	gw "blogapi.miyamo.today/core/db/gorm"
 	"context"
+	"database/sql/driver"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/dynmgrm"
 	"github.com/miyamo2/sqldav"
 	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
 	"github.com/newrelic/go-agent/v3/newrelic"
@@ -15,8 +20,95 @@ import (
 	"gorm.io/gorm/schema"
 	"log/slog"
 	"os"
+	"slices"
+	"strings"
+	"time"
 )
 
+// schemaVersion is the version of the shape of the events written by this service.
+// Events written before the version was recorded have no schema_version nor event_type, and are taken as version 1.
+const schemaVersion = 2
+
+// eventActor is the user who wrote an event, as the attributes of the event.
+// Both are nil if the request did not tell who made it.
+type eventActor struct {
+	name *string
+	id   *string
+}
+
+// actorOf returns the user who made the request.
+// Empty values are left nil, so that the event does not record an actor nobody can tell.
+func actorOf(ctx context.Context) eventActor {
+	actor, ok := model.ActorFromContext(ctx)
+	if !ok {
+		return eventActor{}
+	}
+	var result eventActor
+	if name := actor.Name(); name != "" {
+		result.name = aws.String(name)
+	}
+	if subject := actor.Subject(); subject != "" {
+		result.id = aws.String(subject)
+	}
+	return result
+}
+
+// imageVariant is a variant of the thumbnail, as an element of the thumbnail_variants attribute.
+type imageVariant struct {
+	URL         string
+	Width       int
+	Height      int
+	ContentType string
+}
+
+// imageVariants is the thumbnail_variants attribute.
+// Unlike sqldav.TypedList, it scans the attribute missing on events written before it was recorded as empty.
+type imageVariants sqldav.TypedList[imageVariant]
+
+// newImageVariants converts the variants of the event to the attribute.
+func newImageVariants(variants []article.ImageVariant) imageVariants {
+	result := make(imageVariants, 0, len(variants))
+	for _, v := range variants {
+		result = append(result, imageVariant{
+			URL:         v.URL(),
+			Width:       v.Width(),
+			Height:      v.Height(),
+			ContentType: v.ContentType(),
+		})
+	}
+	return result
+}
+
+// toModel converts the attribute to the variants of the event.
+func (v imageVariants) toModel() []article.ImageVariant {
+	if len(v) == 0 {
+		return nil
+	}
+	result := make([]article.ImageVariant, 0, len(v))
+	for _, e := range v {
+		result = append(result, article.NewImageVariant(e.URL, e.Width, e.Height, e.ContentType))
+	}
+	return result
+}
+
+// Scan implements the sql.Scanner interface.
+func (v *imageVariants) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	return (*sqldav.TypedList[imageVariant])(v).Scan(value)
+}
+
+// Value implements the driver.Valuer interface.
+func (v imageVariants) Value() (driver.Value, error) {
+	return sqldav.TypedList[imageVariant](v).Value()
+}
+
+// GormDataType returns the data type for Gorm.
+func (v *imageVariants) GormDataType() string {
+	return "L"
+}
+
 type DB struct {
 	*gorm.DB
 }
@@ -24,15 +116,90 @@ type DB struct {
 var (
 	_ schema.Tabler = (*bloggingEventCreateArticle)(nil)
 	_ schema.Tabler = (*bloggingEventUpdateArticleTitle)(nil)
+	_ schema.Tabler = (*articleStreamHead)(nil)
+	_ schema.Tabler = (*idempotencyRecord)(nil)
+	_ schema.Tabler = (*idempotencyOutcome)(nil)
+	_ schema.Tabler = (*articleSlug)(nil)
 )
 
+// articleStreamHead holds the ID of the latest event, the visibility and the draft state of each article.
+// It is rewritten with a conditional update on every event, so that concurrent writers cannot both succeed.
+type articleStreamHead struct {
+	ArticleID   string `gorm:"primaryKey"`
+	LastEventID string
+	Invisible   bool
+	Draft       bool
+}
+
+func (h articleStreamHead) TableName() string {
+	return os.Getenv("ARTICLE_STREAM_HEADS_TABLE_NAME")
+}
+
+// idempotencyRecord remembers the event written for an idempotency key of a caller.
+type idempotencyRecord struct {
+	IdempotencyKey string `gorm:"primaryKey"`
+	Fingerprint    string
+	EventID        string
+	ArticleID      string
+}
+
+func (r idempotencyRecord) TableName() string {
+	return os.Getenv("IDEMPOTENCY_KEYS_TABLE_NAME")
+}
+
+// idempotencyOutcome remembers the outcome of a request written as an event per article.
+// It shares the table of idempotencyRecord, under the key of the request rather than those of its articles.
+type idempotencyOutcome struct {
+	IdempotencyKey string `gorm:"primaryKey"`
+	Fingerprint    string
+	SubjectID      string
+	Events         sqldav.TypedList[idempotencyOutcomeEvent]
+}
+
+func (o idempotencyOutcome) TableName() string {
+	return os.Getenv("IDEMPOTENCY_KEYS_TABLE_NAME")
+}
+
+// idempotencyOutcomeEvent is the key of an event written by the request of an idempotencyOutcome.
+type idempotencyOutcomeEvent struct {
+	EventID   string
+	ArticleID string
+}
+
+// idempotencyRecordKey scopes the key to its caller.
+func idempotencyRecordKey(key model.IdempotencyKey) string {
+	return key.Caller() + "#" + key.Key()
+}
+
+// articleSlug holds the article a slug was given to.
+// It is kept when the article moves to another slug, so that no other article can take the slug it is redirected from.
+type articleSlug struct {
+	Slug      string `gorm:"primaryKey"`
+	ArticleID string
+}
+
+func (a articleSlug) TableName() string {
+	return os.Getenv("ARTICLE_SLUGS_TABLE_NAME")
+}
+
 type bloggingEventCreateArticle struct {
-	EventID   string `gorm:"primaryKey"`
-	ArticleID string `gorm:"primaryKey"`
-	Title     string
-	Content   string
-	Thumbnail string
-	Tags      sqldav.Set[string]
+	EventID       string `gorm:"primaryKey"`
+	ArticleID     string `gorm:"primaryKey"`
+	EventType     string
+	SchemaVersion int
+	Title         string
+	Content       string
+	Thumbnail     string
+	// ThumbnailVariants is empty unless the client passed the variants of the thumbnail.
+	ThumbnailVariants imageVariants
+	Tags              sqldav.Set[string]
+	PublishAt         string
+	// Draft is nil unless the article is created as a draft.
+	Draft *bool
+	Slug  string
+	// Actor and ActorID are nil unless the request told who made it.
+	Actor   *string
+	ActorID *string
 }
 
 func (b bloggingEventCreateArticle) TableName() string {
@@ -57,21 +224,70 @@ func (s *BloggingEventCommandService) CreateArticle(ctx context.Context, in mode
 		eventID := s.ulidGen().String()
 		articleID := s.ulidGen().String()
 
+		actor := actorOf(ctx)
 		event := bloggingEventCreateArticle{
-			EventID:   eventID,
-			ArticleID: articleID,
-			Title:     in.Title(),
-			Content:   in.Content(),
-			Thumbnail: in.Thumbnail(),
-			Tags:      sqldav.Set[string](in.Tags()),
+			EventID:           eventID,
+			ArticleID:         articleID,
+			EventType:         string(model.ArticleEventTypeCreateArticle),
+			SchemaVersion:     schemaVersion,
+			Actor:             actor.name,
+			ActorID:           actor.id,
+			Title:             in.Title(),
+			Content:           in.Content(),
+			Thumbnail:         in.Thumbnail(),
+			ThumbnailVariants: newImageVariants(in.ThumbnailVariants()),
+			Tags:              sqldav.Set[string](in.Tags()),
+			PublishAt:         formatPublishAt(in.PublishAt()),
+			Slug:              in.Slug(),
+		}
+		if in.Draft() {
+			event.Draft = aws.Bool(true)
+		}
+		head := articleStreamHead{
+			ArticleID:   articleID,
+			LastEventID: eventID,
+			Draft:       in.Draft(),
 		}
 
-		if err := tx.Create(&event).Error; err != nil {
+		key, replayed, err := s.replay(ctx, tx)
+		if err != nil {
 			err = errors.WithStack(err)
 			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		if replayed {
+			out.Set(&key)
+			logger.Info("END")
+			return nil
+		}
+		slug, err := s.claimSlug(tx, in.Slug(), articleID)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		err = tx.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Create(&event).Error; err != nil {
+				return err
+			}
+			if err := tx.Create(&head).Error; err != nil {
+				return err
+			}
+			if slug != nil {
+				if err := tx.Create(slug).Error; err != nil {
+					return err
+				}
+			}
+			return recordIdempotencyKey(ctx, tx, eventID, articleID)
+		})
+		key, err = s.resolveWriteError(ctx, tx, eventID, articleID, classifyError(err))
+		if err != nil {
+			err = errors.WithStack(s.resolveSlugError(tx, in.Slug(), articleID, err))
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
 		}
 
-		key := model.NewBloggingEventKey(eventID, articleID)
 		out.Set(&key)
 		logger.Info("END")
 		return nil
@@ -79,9 +295,13 @@ func (s *BloggingEventCommandService) CreateArticle(ctx context.Context, in mode
 }
 
 type bloggingEventUpdateArticleTitle struct {
-	EventID   string `gorm:"primaryKey"`
-	ArticleID string `gorm:"primaryKey"`
-	Title     string
+	EventID       string `gorm:"primaryKey"`
+	ArticleID     string `gorm:"primaryKey"`
+	EventType     string
+	SchemaVersion int
+	Title         string
+	Actor         *string
+	ActorID       *string
 }
 
 func (b bloggingEventUpdateArticleTitle) TableName() string {
@@ -102,17 +322,23 @@ func (s *BloggingEventCommandService) UpdateArticleTitle(ctx context.Context, in
 		eventID := s.ulidGen().String()
 		articleID := in.ArticleID()
 
+		actor := actorOf(ctx)
 		event := bloggingEventUpdateArticleTitle{
-			EventID:   eventID,
-			ArticleID: articleID,
-			Title:     in.Title(),
+			EventID:       eventID,
+			ArticleID:     articleID,
+			EventType:     string(model.ArticleEventTypeUpdateTitle),
+			SchemaVersion: schemaVersion,
+			Actor:         actor.name,
+			ActorID:       actor.id,
+			Title:         in.Title(),
 		}
-		if err := tx.Create(&event).Error; err != nil {
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, in.ExpectedLastEventID(), &event)
+		if err != nil {
 			err = errors.WithStack(err)
 			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
 		}
 
-		key := model.NewBloggingEventKey(eventID, articleID)
 		out.Set(&key)
 		logger.Info("END")
 		return nil
@@ -120,9 +346,13 @@ func (s *BloggingEventCommandService) UpdateArticleTitle(ctx context.Context, in
 }
 
 type bloggingEventUpdateArticleBody struct {
-	EventID   string `gorm:"primaryKey"`
-	ArticleID string `gorm:"primaryKey"`
-	Content   string
+	EventID       string `gorm:"primaryKey"`
+	ArticleID     string `gorm:"primaryKey"`
+	EventType     string
+	SchemaVersion int
+	Content       string
+	Actor         *string
+	ActorID       *string
 }
 
 func (b bloggingEventUpdateArticleBody) TableName() string {
@@ -143,17 +373,23 @@ func (s *BloggingEventCommandService) UpdateArticleBody(ctx context.Context, in
 		eventID := s.ulidGen().String()
 		articleID := in.ArticleID()
 
+		actor := actorOf(ctx)
 		event := bloggingEventUpdateArticleBody{
-			EventID:   eventID,
-			ArticleID: articleID,
-			Content:   in.Body(),
+			EventID:       eventID,
+			ArticleID:     articleID,
+			EventType:     string(model.ArticleEventTypeUpdateBody),
+			SchemaVersion: schemaVersion,
+			Actor:         actor.name,
+			ActorID:       actor.id,
+			Content:       in.Body(),
 		}
-		if err := tx.Create(&event).Error; err != nil {
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, in.ExpectedLastEventID(), &event)
+		if err != nil {
 			err = errors.WithStack(err)
 			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
 		}
 
-		key := model.NewBloggingEventKey(eventID, articleID)
 		out.Set(&key)
 		logger.Info("END")
 		return nil
@@ -161,9 +397,14 @@ func (s *BloggingEventCommandService) UpdateArticleBody(ctx context.Context, in
 }
 
 type bloggingEventUpdateThumbnail struct {
-	EventID   string `gorm:"primaryKey"`
-	ArticleID string `gorm:"primaryKey"`
-	Thumbnail string
+	EventID           string `gorm:"primaryKey"`
+	ArticleID         string `gorm:"primaryKey"`
+	EventType         string
+	SchemaVersion     int
+	Thumbnail         string
+	ThumbnailVariants imageVariants
+	Actor             *string
+	ActorID           *string
 }
 
 func (b bloggingEventUpdateThumbnail) TableName() string {
@@ -185,17 +426,85 @@ func (s *BloggingEventCommandService) UpdateArticleThumbnail(ctx context.Context
 		articleID := command.ArticleID()
 		thumbnail := command.Thumbnail()
 
+		actor := actorOf(ctx)
 		event := bloggingEventUpdateThumbnail{
-			EventID:   eventID,
-			ArticleID: articleID,
-			Thumbnail: thumbnail.String(),
+			EventID:           eventID,
+			ArticleID:         articleID,
+			EventType:         string(model.ArticleEventTypeUpdateThumbnail),
+			SchemaVersion:     schemaVersion,
+			Actor:             actor.name,
+			ActorID:           actor.id,
+			Thumbnail:         thumbnail.String(),
+			ThumbnailVariants: newImageVariants(command.ThumbnailVariants()),
+		}
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, command.ExpectedLastEventID(), &event)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(&key)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+type bloggingEventUpdateSlug struct {
+	EventID       string `gorm:"primaryKey"`
+	ArticleID     string `gorm:"primaryKey"`
+	EventType     string
+	SchemaVersion int
+	Slug          string
+	Actor         *string
+	ActorID       *string
+}
+
+func (b bloggingEventUpdateSlug) TableName() string {
+	return os.Getenv("BLOGGING_EVENTS_TABLE_NAME")
+}
+
+func (s *BloggingEventCommandService) UpdateArticleSlug(ctx context.Context, command model.UpdateArticleSlugEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#UpdateArticleSlug").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#UpdateArticleSlug#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		eventID := s.ulidGen().String()
+		articleID := command.ArticleID()
+
+		actor := actorOf(ctx)
+		event := bloggingEventUpdateSlug{
+			EventID:       eventID,
+			ArticleID:     articleID,
+			EventType:     string(model.ArticleEventTypeUpdateSlug),
+			SchemaVersion: schemaVersion,
+			Actor:         actor.name,
+			ActorID:       actor.id,
+			Slug:          command.Slug(),
 		}
-		if err := tx.Create(&event).Error; err != nil {
+		slug, err := s.claimSlug(tx, command.Slug(), articleID)
+		if err != nil {
 			err = errors.WithStack(err)
 			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		var related []schema.Tabler
+		if slug != nil {
+			related = append(related, slug)
+		}
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, command.ExpectedLastEventID(), &event, related...)
+		if err != nil {
+			err = errors.WithStack(s.resolveSlugError(tx, command.Slug(), articleID, err))
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
 		}
 
-		key := model.NewBloggingEventKey(eventID, articleID)
 		out.Set(&key)
 		logger.Info("END")
 		return nil
@@ -203,9 +512,13 @@ func (s *BloggingEventCommandService) UpdateArticleThumbnail(ctx context.Context
 }
 
 type bloggingEventAttachTags struct {
-	EventID    string `gorm:"primaryKey"`
-	ArticleID  string `gorm:"primaryKey"`
-	AttachTags sqldav.Set[string]
+	EventID       string `gorm:"primaryKey"`
+	ArticleID     string `gorm:"primaryKey"`
+	EventType     string
+	SchemaVersion int
+	AttachTags    sqldav.Set[string]
+	Actor         *string
+	ActorID       *string
 }
 
 func (b bloggingEventAttachTags) TableName() string {
@@ -226,17 +539,23 @@ func (s *BloggingEventCommandService) AttachTags(ctx context.Context, command mo
 		eventID := s.ulidGen().String()
 		articleID := command.ArticleID()
 
+		actor := actorOf(ctx)
 		event := bloggingEventAttachTags{
-			EventID:    eventID,
-			ArticleID:  articleID,
-			AttachTags: sqldav.Set[string](command.Tags()),
+			EventID:       eventID,
+			ArticleID:     articleID,
+			EventType:     string(model.ArticleEventTypeAttachTags),
+			SchemaVersion: schemaVersion,
+			Actor:         actor.name,
+			ActorID:       actor.id,
+			AttachTags:    sqldav.Set[string](command.Tags()),
 		}
-		if err := tx.Create(&event).Error; err != nil {
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, command.ExpectedLastEventID(), &event)
+		if err != nil {
 			err = errors.WithStack(err)
 			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
 		}
 
-		key := model.NewBloggingEventKey(eventID, articleID)
 		out.Set(&key)
 		logger.Info("END")
 		return nil
@@ -244,9 +563,13 @@ func (s *BloggingEventCommandService) AttachTags(ctx context.Context, command mo
 }
 
 type bloggingEventDetachTags struct {
-	EventID    string `gorm:"primaryKey"`
-	ArticleID  string `gorm:"primaryKey"`
-	DetachTags sqldav.Set[string]
+	EventID       string `gorm:"primaryKey"`
+	ArticleID     string `gorm:"primaryKey"`
+	EventType     string
+	SchemaVersion int
+	DetachTags    sqldav.Set[string]
+	Actor         *string
+	ActorID       *string
 }
 
 func (b bloggingEventDetachTags) TableName() string {
@@ -267,23 +590,811 @@ func (s *BloggingEventCommandService) DetachTags(ctx context.Context, command mo
 		eventID := s.ulidGen().String()
 		articleID := command.ArticleID()
 
+		actor := actorOf(ctx)
 		event := bloggingEventDetachTags{
-			EventID:    eventID,
-			ArticleID:  articleID,
-			DetachTags: sqldav.Set[string](command.Tags()),
+			EventID:       eventID,
+			ArticleID:     articleID,
+			EventType:     string(model.ArticleEventTypeDetachTags),
+			SchemaVersion: schemaVersion,
+			Actor:         actor.name,
+			ActorID:       actor.id,
+			DetachTags:    sqldav.Set[string](command.Tags()),
+		}
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, command.ExpectedLastEventID(), &event)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(&key)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+type bloggingEventChangeVisibility struct {
+	EventID       string `gorm:"primaryKey"`
+	ArticleID     string `gorm:"primaryKey"`
+	EventType     string
+	SchemaVersion int
+	// Invisible is a pointer, since zero values are omitted from the inserted item.
+	Invisible *bool
+	Actor     *string
+	ActorID   *string
+}
+
+func (b bloggingEventChangeVisibility) TableName() string {
+	return os.Getenv("BLOGGING_EVENTS_TABLE_NAME")
+}
+
+func (s *BloggingEventCommandService) HideArticle(ctx context.Context, command model.HideArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#HideArticle").End()
+	return s.changeVisibility(command.ArticleID(), true, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) UnhideArticle(ctx context.Context, command model.UnhideArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#UnhideArticle").End()
+	return s.changeVisibility(command.ArticleID(), false, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) changeVisibility(articleID string, invisible bool, expectedLastEventID string, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#changeVisibility#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		eventID := s.ulidGen().String()
+		eventType := model.ArticleEventTypeUnhideArticle
+		if invisible {
+			eventType = model.ArticleEventTypeHideArticle
+		}
+
+		actor := actorOf(ctx)
+		event := bloggingEventChangeVisibility{
+			EventID:       eventID,
+			ArticleID:     articleID,
+			EventType:     string(eventType),
+			SchemaVersion: schemaVersion,
+			Actor:         actor.name,
+			ActorID:       actor.id,
+			Invisible:     &invisible,
+		}
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, expectedLastEventID, &event)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(&key)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+// bloggingEventEditArticle holds every field changed by an edit.
+// Unchanged fields are left zero, so that they are omitted from the inserted item.
+type bloggingEventEditArticle struct {
+	EventID           string `gorm:"primaryKey"`
+	ArticleID         string `gorm:"primaryKey"`
+	EventType         string
+	SchemaVersion     int
+	Title             string
+	Content           string
+	Thumbnail         string
+	ThumbnailVariants imageVariants
+	AttachTags        sqldav.Set[string]
+	DetachTags        sqldav.Set[string]
+	Actor             *string
+	ActorID           *string
+}
+
+func (b bloggingEventEditArticle) TableName() string {
+	return os.Getenv("BLOGGING_EVENTS_TABLE_NAME")
+}
+
+func (s *BloggingEventCommandService) EditArticle(ctx context.Context, command model.EditArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#EditArticle").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#EditArticle#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		eventID := s.ulidGen().String()
+		articleID := command.ArticleID()
+
+		actor := actorOf(ctx)
+		event := bloggingEventEditArticle{
+			EventID:       eventID,
+			ArticleID:     articleID,
+			EventType:     string(model.ArticleEventTypeEditArticle),
+			SchemaVersion: schemaVersion,
+			Actor:         actor.name,
+			ActorID:       actor.id,
+		}
+		if v := command.Title(); v != nil {
+			event.Title = *v
+		}
+		if v := command.Body(); v != nil {
+			event.Content = *v
+		}
+		if v := command.Thumbnail(); v != nil {
+			event.Thumbnail = v.String()
+			event.ThumbnailVariants = newImageVariants(command.ThumbnailVariants())
+		}
+		if v := command.AttachTags(); len(v) > 0 {
+			event.AttachTags = sqldav.Set[string](v)
+		}
+		if v := command.DetachTags(); len(v) > 0 {
+			event.DetachTags = sqldav.Set[string](v)
+		}
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, command.ExpectedLastEventID(), &event)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(&key)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+type bloggingEventScheduleArticle struct {
+	EventID       string `gorm:"primaryKey"`
+	ArticleID     string `gorm:"primaryKey"`
+	EventType     string
+	SchemaVersion int
+	PublishAt     string
+	Actor         *string
+	ActorID       *string
+}
+
+func (b bloggingEventScheduleArticle) TableName() string {
+	return os.Getenv("BLOGGING_EVENTS_TABLE_NAME")
+}
+
+func (s *BloggingEventCommandService) ScheduleArticle(ctx context.Context, command model.ScheduleArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#ScheduleArticle").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#ScheduleArticle#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		eventID := s.ulidGen().String()
+		articleID := command.ArticleID()
+
+		actor := actorOf(ctx)
+		event := bloggingEventScheduleArticle{
+			EventID:       eventID,
+			ArticleID:     articleID,
+			EventType:     string(model.ArticleEventTypeScheduleArticle),
+			SchemaVersion: schemaVersion,
+			Actor:         actor.name,
+			ActorID:       actor.id,
+			PublishAt:     formatPublishAt(command.PublishAt()),
+		}
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, command.ExpectedLastEventID(), &event)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(&key)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+type bloggingEventPublishArticle struct {
+	EventID       string `gorm:"primaryKey"`
+	ArticleID     string `gorm:"primaryKey"`
+	EventType     string
+	SchemaVersion int
+	// Draft is a pointer, since zero values are omitted from the inserted item.
+	Draft   *bool
+	Actor   *string
+	ActorID *string
+}
+
+func (b bloggingEventPublishArticle) TableName() string {
+	return os.Getenv("BLOGGING_EVENTS_TABLE_NAME")
+}
+
+func (s *BloggingEventCommandService) PublishArticle(ctx context.Context, command model.PublishArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#PublishArticle").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#PublishArticle#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		eventID := s.ulidGen().String()
+		articleID := command.ArticleID()
+
+		actor := actorOf(ctx)
+		event := bloggingEventPublishArticle{
+			EventID:       eventID,
+			ArticleID:     articleID,
+			EventType:     string(model.ArticleEventTypePublishArticle),
+			SchemaVersion: schemaVersion,
+			Actor:         actor.name,
+			ActorID:       actor.id,
+			Draft:         aws.Bool(false),
 		}
-		if err := tx.Create(&event).Error; err != nil {
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, command.ExpectedLastEventID(), &event)
+		if err != nil {
 			err = errors.WithStack(err)
 			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
 		}
 
-		key := model.NewBloggingEventKey(eventID, articleID)
 		out.Set(&key)
 		logger.Info("END")
 		return nil
 	}, out)
 }
 
+type bloggingEventRevertArticle struct {
+	EventID       string `gorm:"primaryKey"`
+	ArticleID     string `gorm:"primaryKey"`
+	EventType     string
+	SchemaVersion int
+	// Title, Content and Thumbnail are pointers, since zero values are omitted from the inserted item
+	// and the revision restored may have an empty one.
+	Title             *string
+	Content           *string
+	Thumbnail         *string
+	ThumbnailVariants imageVariants
+	AttachTags        sqldav.Set[string]
+	DetachTags        sqldav.Set[string]
+	RevertedTo        string
+	Actor             *string
+	ActorID           *string
+}
+
+func (b bloggingEventRevertArticle) TableName() string {
+	return os.Getenv("BLOGGING_EVENTS_TABLE_NAME")
+}
+
+// newBloggingEventRevertArticle returns the event restoring the revision of the command.
+// The title, body and thumbnail are always written, so that empty ones are restored as well.
+func newBloggingEventRevertArticle(eventID string, actor eventActor, command model.RevertArticleEvent) bloggingEventRevertArticle {
+	event := bloggingEventRevertArticle{
+		EventID:           eventID,
+		ArticleID:         command.ArticleID(),
+		EventType:         string(model.ArticleEventTypeRevertArticle),
+		SchemaVersion:     schemaVersion,
+		Actor:             actor.name,
+		ActorID:           actor.id,
+		Title:             aws.String(command.Title()),
+		Content:           aws.String(command.Body()),
+		Thumbnail:         aws.String(command.Thumbnail()),
+		ThumbnailVariants: newImageVariants(command.ThumbnailVariants()),
+		RevertedTo:        command.ToEventID(),
+	}
+	if v := command.AttachTags(); len(v) > 0 {
+		event.AttachTags = sqldav.Set[string](v)
+	}
+	if v := command.DetachTags(); len(v) > 0 {
+		event.DetachTags = sqldav.Set[string](v)
+	}
+	return event
+}
+
+func (s *BloggingEventCommandService) RevertArticle(ctx context.Context, command model.RevertArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#RevertArticle").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#RevertArticle#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		eventID := s.ulidGen().String()
+		articleID := command.ArticleID()
+
+		event := newBloggingEventRevertArticle(eventID, actorOf(ctx), command)
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, command.ExpectedLastEventID(), &event)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(&key)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+// bloggingEventRetag moves the article from some tags to another one.
+type bloggingEventRetag struct {
+	EventID       string `gorm:"primaryKey"`
+	ArticleID     string `gorm:"primaryKey"`
+	EventType     string
+	SchemaVersion int
+	AttachTags    sqldav.Set[string]
+	DetachTags    sqldav.Set[string]
+	Actor         *string
+	ActorID       *string
+}
+
+func (b bloggingEventRetag) TableName() string {
+	return os.Getenv("BLOGGING_EVENTS_TABLE_NAME")
+}
+
+func (s *BloggingEventCommandService) RenameTag(ctx context.Context, command model.RenameTagEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#RenameTag").End()
+	return s.retag(model.ArticleEventTypeRenameTag, command.ArticleID(), command.To(), []string{command.From()}, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) MergeTags(ctx context.Context, command model.MergeTagsEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#MergeTags").End()
+	return s.retag(model.ArticleEventTypeMergeTags, command.ArticleID(), command.Into(), command.Sources(), command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) retag(eventType model.ArticleEventType, articleID, attachTag string, detachTags []string, expectedLastEventID string, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#retag#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		eventID := s.ulidGen().String()
+
+		actor := actorOf(ctx)
+		event := bloggingEventRetag{
+			EventID:       eventID,
+			ArticleID:     articleID,
+			EventType:     string(eventType),
+			SchemaVersion: schemaVersion,
+			Actor:         actor.name,
+			ActorID:       actor.id,
+			AttachTags:    sqldav.Set[string]{attachTag},
+			DetachTags:    sqldav.Set[string](detachTags),
+		}
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, expectedLastEventID, &event)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(&key)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+type bloggingEventSeries struct {
+	EventID        string `gorm:"primaryKey"`
+	ArticleID      string `gorm:"primaryKey"`
+	EventType      string
+	SchemaVersion  int
+	SeriesID       string
+	SeriesTitle    string
+	SeriesPosition int
+	Actor          *string
+	ActorID        *string
+}
+
+func (b bloggingEventSeries) TableName() string {
+	return os.Getenv("BLOGGING_EVENTS_TABLE_NAME")
+}
+
+func (s *BloggingEventCommandService) AddToSeries(ctx context.Context, command model.AddToSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#AddToSeries").End()
+	return s.setSeries(model.ArticleEventTypeAddToSeries, command.SeriesEvent, out)
+}
+
+func (s *BloggingEventCommandService) ReorderSeries(ctx context.Context, command model.ReorderSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#ReorderSeries").End()
+	return s.setSeries(model.ArticleEventTypeReorderSeries, command.SeriesEvent, out)
+}
+
+func (s *BloggingEventCommandService) setSeries(eventType model.ArticleEventType, command model.SeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#setSeries#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		eventID := s.ulidGen().String()
+		articleID := command.ArticleID()
+
+		actor := actorOf(ctx)
+		series := command.Series()
+		event := bloggingEventSeries{
+			EventID:        eventID,
+			ArticleID:      articleID,
+			EventType:      string(eventType),
+			SchemaVersion:  schemaVersion,
+			Actor:          actor.name,
+			ActorID:        actor.id,
+			SeriesID:       series.ID(),
+			SeriesTitle:    series.Title(),
+			SeriesPosition: series.Position(),
+		}
+		key, err := s.appendEvent(ctx, tx, eventID, articleID, command.ExpectedLastEventID(), &event)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(&key)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+func (s *BloggingEventCommandService) RecordOutcome(ctx context.Context, outcome model.RequestOutcome, out *db.SingleStatementResult[*model.RequestOutcome]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#RecordOutcome").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#RecordOutcome#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		idempotencyKey, ok := model.IdempotencyKeyFromContext(ctx)
+		if !ok {
+			out.Set(&outcome)
+			logger.Info("END")
+			return nil
+		}
+		events := make(sqldav.TypedList[idempotencyOutcomeEvent], 0, len(outcome.Events()))
+		for _, e := range outcome.Events() {
+			events = append(events, idempotencyOutcomeEvent{EventID: e.EventID(), ArticleID: e.ArticleID()})
+		}
+		err = tx.Create(&idempotencyOutcome{
+			IdempotencyKey: idempotencyRecordKey(idempotencyKey),
+			Fingerprint:    idempotencyKey.Fingerprint(),
+			SubjectID:      outcome.SubjectID(),
+			Events:         events,
+		}).Error
+		if err = classifyError(err); errors.Is(err, model.ErrConflict) {
+			// a retry of the request has recorded its outcome in the meantime.
+			recorded, replayErr := s.replayOutcome(ctx, tx)
+			if replayErr == nil && recorded != nil {
+				out.Set(recorded)
+				logger.Info("END")
+				return nil
+			}
+		}
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(&outcome)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+func (s *BloggingEventCommandService) ReplayOutcome(ctx context.Context, out *db.SingleStatementResult[*model.RequestOutcome]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#ReplayOutcome").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#ReplayOutcome#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		outcome, err := s.replayOutcome(ctx, tx)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(outcome)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+// replayOutcome returns the outcome recorded under the idempotency key of the request, or nil if the request has no idempotency key or the key has not been used yet.
+// It returns model.ErrIdempotencyKeyReused if the key was used for a request with a different payload.
+func (s *BloggingEventCommandService) replayOutcome(ctx context.Context, tx *gorm.DB) (*model.RequestOutcome, error) {
+	idempotencyKey, ok := model.IdempotencyKeyFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	records := make([]idempotencyOutcome, 0, 1)
+	err := tx.Where("idempotency_key = ?", idempotencyRecordKey(idempotencyKey)).Find(&records).Error
+	if err != nil {
+		return nil, classifyError(err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	if records[0].Fingerprint != idempotencyKey.Fingerprint() {
+		return nil, errors.WithStack(model.ErrIdempotencyKeyReused)
+	}
+	events := make([]model.BloggingEventKey, 0, len(records[0].Events))
+	for _, e := range records[0].Events {
+		events = append(events, model.NewBloggingEventKey(e.EventID, e.ArticleID))
+	}
+	outcome := model.NewRequestOutcome(records[0].SubjectID, events)
+	return &outcome, nil
+}
+
+// formatPublishAt formats the publish time in RFC 3339 in UTC. The zero value is formatted as empty, so that it is omitted from the item.
+func formatPublishAt(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// appendEvent writes the event and moves the article's stream head onto it in a single transaction.
+// It returns model.ErrNotFound if the article does not exist, or is hidden and the event neither changes its visibility, retags it nor puts it in a series.
+// It returns model.ErrConflict if the head is not at expectedLastEventID, or if it moves during the write.
+// An empty expectedLastEventID skips the former check.
+// If the request has already been applied under the same idempotency key, nothing is written and the key of the recorded event is returned.
+// The related items are written in the same transaction as the event.
+func (s *BloggingEventCommandService) appendEvent(ctx context.Context, tx *gorm.DB, eventID, articleID, expectedLastEventID string, event schema.Tabler, related ...schema.Tabler) (model.BloggingEventKey, error) {
+	key, replayed, err := s.replay(ctx, tx)
+	if err != nil || replayed {
+		return key, err
+	}
+
+	current, headExists, err := s.streamHead(tx, articleID)
+	if err != nil {
+		return model.BloggingEventKey{}, classifyError(err)
+	}
+	if current.LastEventID == "" {
+		return model.BloggingEventKey{}, errors.WithStack(model.ErrNotFound)
+	}
+
+	next := articleStreamHead{
+		ArticleID:   articleID,
+		LastEventID: eventID,
+		Invisible:   current.Invisible,
+		Draft:       current.Draft,
+	}
+	switch v := event.(type) {
+	case *bloggingEventChangeVisibility:
+		next.Invisible = *v.Invisible
+	case *bloggingEventRetag:
+		// tags are renamed on hidden articles as well, so that they do not come back with the old names.
+	case *bloggingEventSeries:
+		// hidden articles keep their positions in the series, so that they come back in order.
+	default:
+		if current.Invisible {
+			return model.BloggingEventKey{}, errors.WithStack(model.ErrNotFound)
+		}
+	}
+	if _, ok := event.(*bloggingEventPublishArticle); ok {
+		next.Draft = false
+	}
+	if expectedLastEventID != "" && expectedLastEventID != current.LastEventID {
+		return model.BloggingEventKey{}, errors.WithStack(model.ErrConflict)
+	}
+
+	err = tx.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(event).Error; err != nil {
+			return err
+		}
+		for _, item := range related {
+			if err := tx.Create(item).Error; err != nil {
+				return err
+			}
+		}
+		if err := recordIdempotencyKey(ctx, tx, eventID, articleID); err != nil {
+			return err
+		}
+		if !headExists {
+			// fails with a duplicate item if another writer created the head in the meantime.
+			return tx.Create(&next).Error
+		}
+		return tx.Model(&articleStreamHead{}).
+			Where("article_id = ? AND last_event_id = ?", articleID, current.LastEventID).
+			Updates(map[string]any{
+				"last_event_id": next.LastEventID,
+				"invisible":     next.Invisible,
+				"draft":         next.Draft,
+			}).Error
+	})
+	return s.resolveWriteError(ctx, tx, eventID, articleID, classifyError(err))
+}
+
+// replay returns the key of the event recorded for the idempotency key of the request.
+// replayed is false if the request has no idempotency key or the key has not been used yet.
+// It returns model.ErrIdempotencyKeyReused if the key was used for a request with a different payload.
+func (s *BloggingEventCommandService) replay(ctx context.Context, tx *gorm.DB) (key model.BloggingEventKey, replayed bool, err error) {
+	idempotencyKey, ok := model.IdempotencyKeyFromContext(ctx)
+	if !ok {
+		return model.BloggingEventKey{}, false, nil
+	}
+	records := make([]idempotencyRecord, 0, 1)
+	err = tx.Where("idempotency_key = ?", idempotencyRecordKey(idempotencyKey)).Find(&records).Error
+	if err != nil {
+		return model.BloggingEventKey{}, false, classifyError(err)
+	}
+	if len(records) == 0 {
+		return model.BloggingEventKey{}, false, nil
+	}
+	if records[0].Fingerprint != idempotencyKey.Fingerprint() {
+		return model.BloggingEventKey{}, false, errors.WithStack(model.ErrIdempotencyKeyReused)
+	}
+	return model.NewBloggingEventKey(records[0].EventID, records[0].ArticleID), true, nil
+}
+
+// resolveWriteError returns the key of the written event if err is nil.
+// A conflicting write may have lost the race against a retry of the same request, in which case the result of the retry is returned.
+func (s *BloggingEventCommandService) resolveWriteError(ctx context.Context, tx *gorm.DB, eventID, articleID string, err error) (model.BloggingEventKey, error) {
+	if err == nil {
+		return model.NewBloggingEventKey(eventID, articleID), nil
+	}
+	if !errors.Is(err, model.ErrConflict) {
+		return model.BloggingEventKey{}, err
+	}
+	key, replayed, replayErr := s.replay(ctx, tx)
+	if replayErr != nil {
+		return model.BloggingEventKey{}, replayErr
+	}
+	if replayed {
+		return key, nil
+	}
+	return model.BloggingEventKey{}, err
+}
+
+// claimSlug returns the item giving the slug to the article, or nil if the slug is empty or the article has already had it.
+// It returns model.SlugTakenError if another article has had the slug.
+func (s *BloggingEventCommandService) claimSlug(tx *gorm.DB, slug, articleID string) (*articleSlug, error) {
+	if slug == "" {
+		return nil, nil
+	}
+	slugs := make([]articleSlug, 0, 1)
+	if err := tx.Where("slug = ?", slug).Find(&slugs).Error; err != nil {
+		return nil, classifyError(err)
+	}
+	if len(slugs) == 0 {
+		return &articleSlug{Slug: slug, ArticleID: articleID}, nil
+	}
+	if slugs[0].ArticleID != articleID {
+		return nil, model.SlugTakenError(slug)
+	}
+	return nil, nil
+}
+
+// resolveSlugError tells a conflicting write that lost the slug to another article from one that lost the race on the stream head.
+func (s *BloggingEventCommandService) resolveSlugError(tx *gorm.DB, slug, articleID string, err error) error {
+	if slug == "" || !errors.Is(err, model.ErrConflict) {
+		return err
+	}
+	if _, claimErr := s.claimSlug(tx, slug, articleID); claimErr != nil {
+		return claimErr
+	}
+	return err
+}
+
+// recordIdempotencyKey writes the idempotency record of the request, if it has an idempotency key.
+// It fails with a duplicate item if the key has been used in the meantime.
+func recordIdempotencyKey(ctx context.Context, tx *gorm.DB, eventID, articleID string) error {
+	idempotencyKey, ok := model.IdempotencyKeyFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return tx.Create(&idempotencyRecord{
+		IdempotencyKey: idempotencyRecordKey(idempotencyKey),
+		Fingerprint:    idempotencyKey.Fingerprint(),
+		EventID:        eventID,
+		ArticleID:      articleID,
+	}).Error
+}
+
+// classifyError marks the error returned from DynamoDB with the kind of model error.
+func classifyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var (
+		canceled     *types.TransactionCanceledException
+		conditional  *types.ConditionalCheckFailedException
+		txConflict   *types.TransactionConflictException
+		throughput   *types.ProvisionedThroughputExceededException
+		requestLimit *types.RequestLimitExceeded
+		internal     *types.InternalServerError
+	)
+	switch {
+	case errors.As(err, &canceled):
+		for _, reason := range canceled.CancellationReasons {
+			switch aws.ToString(reason.Code) {
+			case "ConditionalCheckFailed", "DuplicateItem", "TransactionConflict":
+				return errors.Mark(err, model.ErrConflict)
+			case "ThrottlingError", "ProvisionedThroughputExceeded":
+				return errors.Mark(err, model.ErrUnavailable)
+			}
+		}
+		return errors.Mark(err, model.ErrInternal)
+	case errors.As(err, &conditional), errors.As(err, &txConflict):
+		return errors.Mark(err, model.ErrConflict)
+	case errors.As(err, &throughput), errors.As(err, &requestLimit), errors.As(err, &internal),
+		errors.Is(err, context.DeadlineExceeded):
+		return errors.Mark(err, model.ErrUnavailable)
+	}
+	return errors.Mark(err, model.ErrInternal)
+}
+
+// streamHead returns the stream head of the article. LastEventID of the head is empty if the article does not exist.
+// Articles written before the stream head was introduced have no head, so it is built from the event table.
+func (s *BloggingEventCommandService) streamHead(tx *gorm.DB, articleID string) (head articleStreamHead, headExists bool, err error) {
+	heads := make([]articleStreamHead, 0, 1)
+	if err := tx.Where("article_id = ?", articleID).Find(&heads).Error; err != nil {
+		return articleStreamHead{}, false, err
+	}
+	if len(heads) > 0 {
+		return heads[0], true, nil
+	}
+
+	type eventRow struct {
+		EventID   string
+		Invisible *bool
+	}
+	events := make([]eventRow, 0)
+	err = tx.Clauses(
+		dynmgrm.SecondaryIndex("article_id_event_id-Index",
+			dynmgrm.SecondaryIndexOf(os.Getenv("BLOGGING_EVENTS_TABLE_NAME")))).
+		Select("event_id", "invisible").
+		Where("article_id = ?", articleID).
+		Scan(&events).Error
+	if err != nil {
+		return articleStreamHead{}, false, err
+	}
+	// ULIDs are lexicographically sortable.
+	slices.SortFunc(events, func(a, b eventRow) int {
+		return strings.Compare(a.EventID, b.EventID)
+	})
+
+	head.ArticleID = articleID
+	for _, e := range events {
+		head.LastEventID = e.EventID
+		if e.Invisible != nil {
+			head.Invisible = *e.Invisible
+		}
+	}
+	return head, false, nil
+}
+
 func NewBloggingEventCommandService(ulidGen *pkg.ULIDGenerator) *BloggingEventCommandService {
 	if ulidGen == nil {
 		return &BloggingEventCommandService{
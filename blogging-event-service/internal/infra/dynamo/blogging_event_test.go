@@ -1,7 +1,18 @@
 package dynamo
 
 import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/dynmgrm"
+	"gorm.io/gorm"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestBloggingEventCommandService_CreateArticle(t *testing.T) {
@@ -79,3 +90,172 @@ func TestBloggingEventCommandService_CreateArticle(t *testing.T) {
 	//	})
 	//}
 }
+
+func TestClassifyError(t *testing.T) {
+	errUnexpected := errors.New("unexpected")
+	tests := map[string]struct {
+		err  error
+		want error
+	}{
+		"nil": {
+			err:  nil,
+			want: nil,
+		},
+		"transaction-canceled-by-condition": {
+			err: &types.TransactionCanceledException{
+				CancellationReasons: []types.CancellationReason{
+					{Code: aws.String("None")},
+					{Code: aws.String("ConditionalCheckFailed")},
+				},
+			},
+			want: model.ErrConflict,
+		},
+		"transaction-canceled-by-throttling": {
+			err: &types.TransactionCanceledException{
+				CancellationReasons: []types.CancellationReason{
+					{Code: aws.String("ThrottlingError")},
+				},
+			},
+			want: model.ErrUnavailable,
+		},
+		"conditional-check-failed": {
+			err:  errors.WithStack(&types.ConditionalCheckFailedException{}),
+			want: model.ErrConflict,
+		},
+		"provisioned-throughput-exceeded": {
+			err:  &types.ProvisionedThroughputExceededException{},
+			want: model.ErrUnavailable,
+		},
+		"deadline-exceeded": {
+			err:  errors.Wrap(context.DeadlineExceeded, "timeout"),
+			want: model.ErrUnavailable,
+		},
+		"unexpected": {
+			err:  errUnexpected,
+			want: model.ErrInternal,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := classifyError(tt.err)
+			if !errors.Is(got, tt.want) {
+				t.Errorf("classifyError() = %v, want %v", got, tt.want)
+			}
+			if tt.err != nil && !errors.Is(got, tt.err) {
+				t.Errorf("classifyError() = %v, want to wrap %v", got, tt.err)
+			}
+		})
+	}
+}
+
+func TestActorOf(t *testing.T) {
+	tests := map[string]struct {
+		ctx  context.Context
+		want eventActor
+	}{
+		"with-username": {
+			ctx:  model.ContextWithActor(context.Background(), model.NewActor("Subject1", "editor")),
+			want: eventActor{name: aws.String("editor"), id: aws.String("Subject1")},
+		},
+		"without-username": {
+			ctx:  model.ContextWithActor(context.Background(), model.NewActor("Subject1", "")),
+			want: eventActor{name: aws.String("Subject1"), id: aws.String("Subject1")},
+		},
+		"without-subject-and-username": {
+			ctx:  model.ContextWithActor(context.Background(), model.NewActor("", "")),
+			want: eventActor{},
+		},
+		"without-actor": {
+			ctx:  context.Background(),
+			want: eventActor{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := actorOf(tt.ctx)
+			if aws.ToString(got.name) != aws.ToString(tt.want.name) || (got.name == nil) != (tt.want.name == nil) {
+				t.Errorf("actorOf() name = %v, want %v", aws.ToString(got.name), aws.ToString(tt.want.name))
+			}
+			if aws.ToString(got.id) != aws.ToString(tt.want.id) || (got.id == nil) != (tt.want.id == nil) {
+				t.Errorf("actorOf() id = %v, want %v", aws.ToString(got.id), aws.ToString(tt.want.id))
+			}
+		})
+	}
+}
+
+// dryRunDB returns a DB that builds statements without running them.
+func dryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(dynmgrm.New(dynmgrm.WithConnection(sql.OpenDB(nopConnector{}))), &gorm.Config{
+		DryRun:                 true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db
+}
+
+type nopConnector struct{}
+
+func (nopConnector) Connect(context.Context) (driver.Conn, error) { return nopConn{}, nil }
+func (nopConnector) Driver() driver.Driver                        { return nil }
+
+type nopConn struct{}
+
+func (nopConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not connected") }
+func (nopConn) Close() error                        { return nil }
+func (nopConn) Begin() (driver.Tx, error)           { return nil, errors.New("not connected") }
+
+func TestNewBloggingEventRevertArticle(t *testing.T) {
+	created := model.NewArticleEvent("Event1", model.ArticleEventTypeCreateArticle, time.Time{},
+		model.ArticleEventWithTitle("title"), model.ArticleEventWithContent(""), model.ArticleEventWithTags("go"))
+	titled := model.NewArticleEvent("Event2", model.ArticleEventTypeUpdateTitle, time.Time{},
+		model.ArticleEventWithTitle("new title"))
+	thumbnailed := model.NewArticleEvent("Event3", model.ArticleEventTypeUpdateThumbnail, time.Time{},
+		model.ArticleEventWithThumbnail("https://example.com/thumbnail.png"))
+	events := []*model.ArticleEvent{&created, &titled, &thumbnailed}
+
+	tests := map[string]struct {
+		toEventID string
+		want      map[string]string
+	}{
+		"to-empty-body-and-thumbnail": {
+			toEventID: "Event1",
+			want:      map[string]string{"title": "title", "content": "", "thumbnail": ""},
+		},
+		"to-retitled-revision": {
+			toEventID: "Event2",
+			want:      map[string]string{"title": "new title", "content": "", "thumbnail": ""},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("BLOGGING_EVENTS_TABLE_NAME", "blogging_events")
+			command, err := model.NewRevertArticleEvent("Article1", tt.toEventID, events)
+			if err != nil {
+				t.Fatalf("NewRevertArticleEvent() error = %v", err)
+			}
+			event := newBloggingEventRevertArticle("Event4", eventActor{}, command)
+			stmt := dryRunDB(t).Create(&event).Statement
+			for attr, want := range tt.want {
+				// an attribute missing from the inserted item would leave the current value in place.
+				if !strings.Contains(stmt.SQL.String(), "'"+attr+"' : ?") {
+					t.Errorf("INSERT = %s, want it to write %s", stmt.SQL.String(), attr)
+				}
+				var got *string
+				switch attr {
+				case "title":
+					got = event.Title
+				case "content":
+					got = event.Content
+				case "thumbnail":
+					got = event.Thumbnail
+				}
+				if aws.ToString(got) != want {
+					t.Errorf("%s = %q, want %q", attr, aws.ToString(got), want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+//go:generate mockgen -source=$GOFILE -destination=../../mock/infra/dynamo/$GOFILE -package=$GOPACKAGE
+package dynamo
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+)
+
+// Client is a subset of the DynamoDB client methods used where PartiQL falls short.
+type Client interface {
+	// Scan reads the items of a table a page at a time.
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_Scan.html
+	Scan(ctx context.Context, params *dynamodb.ScanInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error)
+}
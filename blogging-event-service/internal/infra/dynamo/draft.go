@@ -0,0 +1,114 @@
+package dynamo
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/article"
+	"blogapi.miyamo.today/core/db"
+	gw "blogapi.miyamo.today/core/db/gorm"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"gorm.io/gorm"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+type DraftQueryService struct{}
+
+func (s *DraftQueryService) GetByID(ctx context.Context, articleID string, out *db.SingleStatementResult[*model.Draft]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("DraftQueryService#GetByID").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("DraftQueryService#GetByID#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		heads := make([]articleStreamHead, 0, 1)
+		if err := tx.Where("article_id = ?", articleID).Find(&heads).Error; err != nil {
+			err = errors.WithStack(classifyError(err))
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		if len(heads) == 0 || !heads[0].Draft {
+			return errors.WithStack(model.ErrNotFound)
+		}
+
+		draft, err := s.fold(tx, heads[0])
+		if err != nil {
+			err = errors.WithStack(classifyError(err))
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(&draft)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+func (s *DraftQueryService) List(ctx context.Context, out *db.MultipleStatementResult[*model.Draft]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("DraftQueryService#List").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("DraftQueryService#List#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		heads := make([]articleStreamHead, 0)
+		if err := tx.Where("draft = ?", true).Find(&heads).Error; err != nil {
+			err = errors.WithStack(classifyError(err))
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		// the newest draft comes first, since ULIDs are lexicographically sortable.
+		slices.SortFunc(heads, func(a, b articleStreamHead) int {
+			return strings.Compare(b.ArticleID, a.ArticleID)
+		})
+
+		drafts := make([]*model.Draft, 0, len(heads))
+		for _, head := range heads {
+			draft, err := s.fold(tx, head)
+			if err != nil {
+				err = errors.WithStack(classifyError(err))
+				nrtx.NoticeError(nrpkgerrors.Wrap(err))
+				return err
+			}
+			drafts = append(drafts, &draft)
+		}
+
+		out.Set(drafts)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+// fold builds the draft by applying the events of the article in order.
+func (s *DraftQueryService) fold(tx *gorm.DB, head articleStreamHead) (model.Draft, error) {
+	rows, err := listEvents(tx, head.ArticleID)
+	if err != nil {
+		return model.Draft{}, err
+	}
+
+	events := make([]model.ArticleEvent, 0, len(rows))
+	for i, row := range rows {
+		event, err := articleEventFromRow(row, i == 0)
+		if err != nil {
+			return model.Draft{}, err
+		}
+		events = append(events, event)
+	}
+	projection := article.Project(events)
+	return model.NewDraft(head.ArticleID, projection.Title(), projection.Body(), projection.Thumbnail(), projection.TagNames(), head.LastEventID), nil
+}
+
+func NewDraftQueryService() *DraftQueryService {
+	return &DraftQueryService{}
+}
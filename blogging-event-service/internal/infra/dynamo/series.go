@@ -0,0 +1,184 @@
+package dynamo
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/db"
+	gw "blogapi.miyamo.today/core/db/gorm"
+	"cmp"
+	"context"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"gorm.io/gorm"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+)
+
+// seriesEventRow holds the attributes of a blogging event that put its article in a series.
+type seriesEventRow struct {
+	EventID        string
+	ArticleID      string
+	SeriesID       *string
+	SeriesTitle    *string
+	SeriesPosition *int
+}
+
+type SeriesQueryService struct {
+	client Client
+}
+
+func (s *SeriesQueryService) ListSeriesArticles(ctx context.Context, seriesID string, out *db.MultipleStatementResult[*model.SeriesArticle]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("SeriesQueryService#ListSeriesArticles").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("SeriesQueryService#ListSeriesArticles#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		// an article is a part of one series at most, so only the events naming the series are scanned for.
+		mentions, err := scanSeriesEvents(ctx, s.client, seriesID)
+		if err != nil {
+			err = errors.WithStack(classifyError(err))
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		// the last event of the articles is read from all of their events.
+		rows := make([]seriesEventRow, 0)
+		for _, candidate := range seriesArticles(mentions, seriesID) {
+			events, err := listEvents(tx, candidate.ArticleID())
+			if err != nil {
+				err = errors.WithStack(classifyError(err))
+				nrtx.NoticeError(nrpkgerrors.Wrap(err))
+				return err
+			}
+			for _, e := range events {
+				rows = append(rows, seriesEventRow{
+					EventID:        e.EventID,
+					ArticleID:      candidate.ArticleID(),
+					SeriesID:       e.SeriesID,
+					SeriesTitle:    e.SeriesTitle,
+					SeriesPosition: e.SeriesPosition,
+				})
+			}
+		}
+
+		out.Set(seriesArticles(rows, seriesID))
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+func (s *SeriesQueryService) GetSeriesArticle(ctx context.Context, articleID string, out *db.SingleStatementResult[*model.SeriesArticle]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("SeriesQueryService#GetSeriesArticle").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("SeriesQueryService#GetSeriesArticle#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		rows, err := listEvents(tx, articleID)
+		if err != nil {
+			err = errors.WithStack(classifyError(err))
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		if len(rows) == 0 {
+			return errors.WithStack(model.ErrNotFound)
+		}
+		var series model.ArticleSeries
+		for _, row := range rows {
+			if row.SeriesID != nil {
+				series = model.NewArticleSeries(*row.SeriesID, aws.ToString(row.SeriesTitle), aws.ToInt(row.SeriesPosition))
+			}
+		}
+		article := model.NewSeriesArticle(articleID, series, rows[len(rows)-1].EventID)
+
+		out.Set(&article)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+// scanSeriesEvents returns the events that put their article in the series.
+// The table is scanned a page at a time, following LastEvaluatedKey until it is exhausted.
+func scanSeriesEvents(ctx context.Context, client Client, seriesID string) ([]seriesEventRow, error) {
+	rows := make([]seriesEventRow, 0)
+	paginator := dynamodb.NewScanPaginator(client, &dynamodb.ScanInput{
+		TableName:            aws.String(os.Getenv("BLOGGING_EVENTS_TABLE_NAME")),
+		ProjectionExpression: aws.String("event_id, article_id, series_id, series_title, series_position"),
+		FilterExpression:     aws.String("series_id = :series_id"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":series_id": &types.AttributeValueMemberS{Value: seriesID},
+		},
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			position, err := intAttribute(item["series_position"])
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, seriesEventRow{
+				EventID:        stringAttribute(item["event_id"]),
+				ArticleID:      stringAttribute(item["article_id"]),
+				SeriesID:       aws.String(stringAttribute(item["series_id"])),
+				SeriesTitle:    aws.String(stringAttribute(item["series_title"])),
+				SeriesPosition: aws.Int(position),
+			})
+		}
+	}
+	return rows, nil
+}
+
+// seriesArticles folds the events of each article and returns those that are a part of the series, in the order of their positions.
+func seriesArticles(rows []seriesEventRow, seriesID string) []*model.SeriesArticle {
+	// ULIDs are lexicographically sortable, so the events of each article are in order.
+	slices.SortFunc(rows, func(a, b seriesEventRow) int {
+		if c := strings.Compare(a.ArticleID, b.ArticleID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.EventID, b.EventID)
+	})
+
+	result := make([]*model.SeriesArticle, 0)
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && rows[end].ArticleID == rows[start].ArticleID {
+			end++
+		}
+		var series model.ArticleSeries
+		for _, row := range rows[start:end] {
+			if row.SeriesID != nil {
+				series = model.NewArticleSeries(*row.SeriesID, aws.ToString(row.SeriesTitle), aws.ToInt(row.SeriesPosition))
+			}
+		}
+		if series.ID() == seriesID {
+			article := model.NewSeriesArticle(rows[start].ArticleID, series, rows[end-1].EventID)
+			result = append(result, &article)
+		}
+		start = end
+	}
+	slices.SortStableFunc(result, func(a, b *model.SeriesArticle) int {
+		return cmp.Compare(a.Series().Position(), b.Series().Position())
+	})
+	return result
+}
+
+func NewSeriesQueryService(client Client) *SeriesQueryService {
+	return &SeriesQueryService{client: client}
+}
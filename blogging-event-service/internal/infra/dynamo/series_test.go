@@ -0,0 +1,123 @@
+package dynamo
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mdynamo "blogapi.miyamo.today/blogging-event-service/internal/mock/infra/dynamo"
+	"context"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestSeriesArticles(t *testing.T) {
+	rows := []seriesEventRow{
+		// events of an article are scanned in no particular order.
+		{EventID: "01JF0REBGD4QKPFGN1SX2STY4N", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHS", SeriesID: ptr("Series1"), SeriesTitle: ptr("Go"), SeriesPosition: ptr(2)},
+		{EventID: "01JF0REBGD4QKPFGN1SX2STY4M", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHS", SeriesID: ptr("Series1"), SeriesTitle: ptr("Go"), SeriesPosition: ptr(1)},
+		{EventID: "01JF0REBGD4QKPFGN1SX2STY4P", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHT", SeriesID: ptr("Series1"), SeriesTitle: ptr("Go"), SeriesPosition: ptr(1)},
+		{EventID: "01JF0REBGD4QKPFGN1SX2STY4S", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHT"},
+		{EventID: "01JF0REBGD4QKPFGN1SX2STY4Q", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHV", SeriesID: ptr("Series2"), SeriesTitle: ptr("Rust"), SeriesPosition: ptr(1)},
+		{EventID: "01JF0REBGD4QKPFGN1SX2STY4R", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHW"},
+	}
+	got := seriesArticles(rows, "Series1")
+	want := []*model.SeriesArticle{
+		ptr(model.NewSeriesArticle("01JF0RDJYN8NJ57RN65G7FNGHT", model.NewArticleSeries("Series1", "Go", 1), "01JF0REBGD4QKPFGN1SX2STY4S")),
+		ptr(model.NewSeriesArticle("01JF0RDJYN8NJ57RN65G7FNGHS", model.NewArticleSeries("Series1", "Go", 2), "01JF0REBGD4QKPFGN1SX2STY4N")),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("seriesArticles() = %v, want %v", got, want)
+	}
+}
+
+func TestScanSeriesEvents(t *testing.T) {
+	type testCase struct {
+		setup   func(client *mdynamo.MockClient)
+		want    []seriesEventRow
+		wantErr error
+	}
+	errScan := errors.New("scan failed")
+	item := func(eventID, articleID, position string) map[string]types.AttributeValue {
+		return map[string]types.AttributeValue{
+			"event_id":        &types.AttributeValueMemberS{Value: eventID},
+			"article_id":      &types.AttributeValueMemberS{Value: articleID},
+			"series_id":       &types.AttributeValueMemberS{Value: "Series1"},
+			"series_title":    &types.AttributeValueMemberS{Value: "Go"},
+			"series_position": &types.AttributeValueMemberN{Value: position},
+		}
+	}
+	lastKey := map[string]types.AttributeValue{"event_id": &types.AttributeValueMemberS{Value: "01JF0REBGD4QKPFGN1SX2STY4M"}}
+	tests := map[string]testCase{
+		"happy_path/pages": {
+			setup: func(client *mdynamo.MockClient) {
+				first := client.EXPECT().
+					Scan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, in *dynamodb.ScanInput, _ ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error) {
+						if in.ExclusiveStartKey != nil {
+							t.Errorf("Scan() ExclusiveStartKey = %v, want nil", in.ExclusiveStartKey)
+						}
+						if got := aws.ToString(in.FilterExpression); got != "series_id = :series_id" {
+							t.Errorf("Scan() FilterExpression = %s, want series_id = :series_id", got)
+						}
+						want := &types.AttributeValueMemberS{Value: "Series1"}
+						if got := in.ExpressionAttributeValues[":series_id"]; !reflect.DeepEqual(got, want) {
+							t.Errorf("Scan() :series_id = %v, want %v", got, want)
+						}
+						return &dynamodb.ScanOutput{
+							Items:            []map[string]types.AttributeValue{item("01JF0REBGD4QKPFGN1SX2STY4M", "01JF0RDJYN8NJ57RN65G7FNGHS", "1")},
+							LastEvaluatedKey: lastKey,
+						}, nil
+					}).
+					Times(1)
+				// a page may hold no item that matches the filter and still be followed by others.
+				second := client.EXPECT().
+					Scan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, in *dynamodb.ScanInput, _ ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error) {
+						if !reflect.DeepEqual(in.ExclusiveStartKey, lastKey) {
+							t.Errorf("Scan() ExclusiveStartKey = %v, want %v", in.ExclusiveStartKey, lastKey)
+						}
+						return &dynamodb.ScanOutput{LastEvaluatedKey: lastKey}, nil
+					}).
+					After(first).
+					Times(1)
+				client.EXPECT().
+					Scan(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&dynamodb.ScanOutput{
+						Items: []map[string]types.AttributeValue{item("01JF0REBGD4QKPFGN1SX2STY4P", "01JF0RDJYN8NJ57RN65G7FNGHT", "2")},
+					}, nil).
+					After(second).
+					Times(1)
+			},
+			want: []seriesEventRow{
+				{EventID: "01JF0REBGD4QKPFGN1SX2STY4M", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHS", SeriesID: ptr("Series1"), SeriesTitle: ptr("Go"), SeriesPosition: ptr(1)},
+				{EventID: "01JF0REBGD4QKPFGN1SX2STY4P", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHT", SeriesID: ptr("Series1"), SeriesTitle: ptr("Go"), SeriesPosition: ptr(2)},
+			},
+		},
+		"unhappy_path/scan_fails": {
+			setup: func(client *mdynamo.MockClient) {
+				client.EXPECT().
+					Scan(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errScan).
+					Times(1)
+			},
+			wantErr: errScan,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := mdynamo.NewMockClient(ctrl)
+			tt.setup(client)
+			got, err := scanSeriesEvents(context.Background(), client, "Series1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("scanSeriesEvents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("scanSeriesEvents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
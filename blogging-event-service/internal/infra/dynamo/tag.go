@@ -0,0 +1,207 @@
+package dynamo
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/article"
+	"blogapi.miyamo.today/core/db"
+	gw "blogapi.miyamo.today/core/db/gorm"
+	"context"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/sqldav"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"gorm.io/gorm"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// tagEventRow holds the attributes of a blogging event that change the tags of its article.
+type tagEventRow struct {
+	EventID    string
+	ArticleID  string
+	Tags       sqldav.Set[string]
+	AttachTags sqldav.Set[string]
+	DetachTags sqldav.Set[string]
+}
+
+type TagQueryService struct {
+	client Client
+}
+
+func (s *TagQueryService) ListTaggedArticles(ctx context.Context, tagNames []string, out *db.MultipleStatementResult[*model.TaggedArticle]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("TagQueryService#ListTaggedArticles").End()
+	return gw.NewStatement(func(ctx context.Context, tx *gorm.DB, out db.StatementResult) (err error) {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("TagQueryService#ListTaggedArticles#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		tx = tx.WithContext(ctx)
+
+		// whether an article has a tag depends only on the events naming the tag, so only those are scanned for.
+		mentions, err := scanTagEvents(ctx, s.client, tagNames)
+		if err != nil {
+			err = errors.WithStack(classifyError(err))
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		candidates, err := taggedArticles(mentions, tagNames)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		// the other tags and the last event of the articles are read from all of their events.
+		rows := make([]tagEventRow, 0)
+		for _, candidate := range candidates {
+			events, err := listEvents(tx, candidate.ArticleID())
+			if err != nil {
+				err = errors.WithStack(classifyError(err))
+				nrtx.NoticeError(nrpkgerrors.Wrap(err))
+				return err
+			}
+			for _, e := range events {
+				rows = append(rows, tagEventRow{
+					EventID:    e.EventID,
+					ArticleID:  candidate.ArticleID(),
+					Tags:       e.Tags,
+					AttachTags: e.AttachTags,
+					DetachTags: e.DetachTags,
+				})
+			}
+		}
+
+		articles, err := taggedArticles(rows, tagNames)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(articles)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+// scanTagEvents returns the events that name any of the tags in their tags, attached tags or detached tags.
+// The table is scanned a page at a time, following LastEvaluatedKey until it is exhausted.
+func scanTagEvents(ctx context.Context, client Client, tagNames []string) ([]tagEventRow, error) {
+	rows := make([]tagEventRow, 0)
+	if len(tagNames) == 0 {
+		return rows, nil
+	}
+	conditions := make([]string, 0, len(tagNames))
+	values := make(map[string]types.AttributeValue, len(tagNames))
+	for i, name := range tagNames {
+		placeholder := fmt.Sprintf(":tag%d", i)
+		conditions = append(conditions, fmt.Sprintf("contains(#tags, %[1]s) OR contains(#attach_tags, %[1]s) OR contains(#detach_tags, %[1]s)", placeholder))
+		values[placeholder] = &types.AttributeValueMemberS{Value: name}
+	}
+	paginator := dynamodb.NewScanPaginator(client, &dynamodb.ScanInput{
+		TableName:            aws.String(os.Getenv("BLOGGING_EVENTS_TABLE_NAME")),
+		ProjectionExpression: aws.String("event_id, article_id, #tags, #attach_tags, #detach_tags"),
+		FilterExpression:     aws.String(strings.Join(conditions, " OR ")),
+		ExpressionAttributeNames: map[string]string{
+			"#tags":        "tags",
+			"#attach_tags": "attach_tags",
+			"#detach_tags": "detach_tags",
+		},
+		ExpressionAttributeValues: values,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Items {
+			rows = append(rows, tagEventRow{
+				EventID:    stringAttribute(item["event_id"]),
+				ArticleID:  stringAttribute(item["article_id"]),
+				Tags:       stringSetAttribute(item["tags"]),
+				AttachTags: stringSetAttribute(item["attach_tags"]),
+				DetachTags: stringSetAttribute(item["detach_tags"]),
+			})
+		}
+	}
+	return rows, nil
+}
+
+// stringAttribute returns the value of a string attribute, or empty if it is not one.
+func stringAttribute(v types.AttributeValue) string {
+	if s, ok := v.(*types.AttributeValueMemberS); ok {
+		return s.Value
+	}
+	return ""
+}
+
+// intAttribute returns the value of a number attribute, or zero if it is not one.
+func intAttribute(v types.AttributeValue) (int, error) {
+	n, ok := v.(*types.AttributeValueMemberN)
+	if !ok {
+		return 0, nil
+	}
+	return strconv.Atoi(n.Value)
+}
+
+// stringSetAttribute returns the values of a string set attribute, or nil if it is not one.
+func stringSetAttribute(v types.AttributeValue) sqldav.Set[string] {
+	if ss, ok := v.(*types.AttributeValueMemberSS); ok {
+		return ss.Value
+	}
+	return nil
+}
+
+// taggedArticles folds the events of each article and returns those any of the tags is attached to, oldest first.
+func taggedArticles(rows []tagEventRow, tagNames []string) ([]*model.TaggedArticle, error) {
+	// ULIDs are lexicographically sortable, so the events of each article are in order, and so are the articles.
+	slices.SortFunc(rows, func(a, b tagEventRow) int {
+		if c := strings.Compare(a.ArticleID, b.ArticleID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.EventID, b.EventID)
+	})
+
+	result := make([]*model.TaggedArticle, 0)
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && rows[end].ArticleID == rows[start].ArticleID {
+			end++
+		}
+		events := make([]model.ArticleEvent, 0, end-start)
+		for i, row := range rows[start:end] {
+			event, err := articleEventFromRow(bloggingEventRow{
+				EventID:    row.EventID,
+				Tags:       row.Tags,
+				AttachTags: row.AttachTags,
+				DetachTags: row.DetachTags,
+			}, i == 0)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+		projection := article.Project(events)
+		if slices.ContainsFunc(projection.TagNames(), func(v string) bool {
+			return slices.Contains(tagNames, v)
+		}) {
+			tagged := model.NewTaggedArticle(rows[start].ArticleID, projection.TagNames(), rows[end-1].EventID)
+			result = append(result, &tagged)
+		}
+		start = end
+	}
+	return result, nil
+}
+
+func NewTagQueryService(client Client) *TagQueryService {
+	return &TagQueryService{client: client}
+}
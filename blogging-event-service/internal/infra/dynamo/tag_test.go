@@ -0,0 +1,132 @@
+package dynamo
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	mdynamo "blogapi.miyamo.today/blogging-event-service/internal/mock/infra/dynamo"
+	"context"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestTaggedArticles(t *testing.T) {
+	rows := []tagEventRow{
+		// events of an article are scanned in no particular order.
+		{EventID: "01JF0REBGD4QKPFGN1SX2STY4N", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHS", AttachTags: []string{"Go"}, DetachTags: []string{"go"}},
+		{EventID: "01JF0REBGD4QKPFGN1SX2STY4M", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHS", Tags: []string{"go", "aws"}},
+		{EventID: "01JF0REBGD4QKPFGN1SX2STY4P", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHT", Tags: []string{"go"}},
+		{EventID: "01JF0REBGD4QKPFGN1SX2STY4Q", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHV", Tags: []string{"rust"}},
+		{EventID: "01JF0REBGD4QKPFGN1SX2STY4R", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHV", AttachTags: []string{"golang"}},
+	}
+	got, err := taggedArticles(rows, []string{"go", "golang"})
+	if err != nil {
+		t.Fatalf("taggedArticles() error = %v", err)
+	}
+	want := []*model.TaggedArticle{
+		ptr(model.NewTaggedArticle("01JF0RDJYN8NJ57RN65G7FNGHT", []string{"go"}, "01JF0REBGD4QKPFGN1SX2STY4P")),
+		ptr(model.NewTaggedArticle("01JF0RDJYN8NJ57RN65G7FNGHV", []string{"rust", "golang"}, "01JF0REBGD4QKPFGN1SX2STY4R")),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("taggedArticles() = %v, want %v", got, want)
+	}
+}
+
+func TestScanTagEvents(t *testing.T) {
+	type testCase struct {
+		tagNames []string
+		setup    func(client *mdynamo.MockClient)
+		want     []tagEventRow
+		wantErr  error
+	}
+	errScan := errors.New("scan failed")
+	item := func(eventID, articleID string, tags []string) map[string]types.AttributeValue {
+		return map[string]types.AttributeValue{
+			"event_id":   &types.AttributeValueMemberS{Value: eventID},
+			"article_id": &types.AttributeValueMemberS{Value: articleID},
+			"tags":       &types.AttributeValueMemberSS{Value: tags},
+		}
+	}
+	lastKey := map[string]types.AttributeValue{"event_id": &types.AttributeValueMemberS{Value: "01JF0REBGD4QKPFGN1SX2STY4M"}}
+	tests := map[string]testCase{
+		"happy_path/pages": {
+			tagNames: []string{"go", "golang"},
+			setup: func(client *mdynamo.MockClient) {
+				first := client.EXPECT().
+					Scan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, in *dynamodb.ScanInput, _ ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error) {
+						if in.ExclusiveStartKey != nil {
+							t.Errorf("Scan() ExclusiveStartKey = %v, want nil", in.ExclusiveStartKey)
+						}
+						wantFilter := "contains(#tags, :tag0) OR contains(#attach_tags, :tag0) OR contains(#detach_tags, :tag0) OR " +
+							"contains(#tags, :tag1) OR contains(#attach_tags, :tag1) OR contains(#detach_tags, :tag1)"
+						if got := aws.ToString(in.FilterExpression); got != wantFilter {
+							t.Errorf("Scan() FilterExpression = %s, want %s", got, wantFilter)
+						}
+						return &dynamodb.ScanOutput{
+							Items:            []map[string]types.AttributeValue{item("01JF0REBGD4QKPFGN1SX2STY4M", "01JF0RDJYN8NJ57RN65G7FNGHS", []string{"go"})},
+							LastEvaluatedKey: lastKey,
+						}, nil
+					}).
+					Times(1)
+				// a page may hold no item that matches the filter and still be followed by others.
+				second := client.EXPECT().
+					Scan(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, in *dynamodb.ScanInput, _ ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error) {
+						if !reflect.DeepEqual(in.ExclusiveStartKey, lastKey) {
+							t.Errorf("Scan() ExclusiveStartKey = %v, want %v", in.ExclusiveStartKey, lastKey)
+						}
+						return &dynamodb.ScanOutput{LastEvaluatedKey: lastKey}, nil
+					}).
+					After(first).
+					Times(1)
+				client.EXPECT().
+					Scan(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&dynamodb.ScanOutput{
+						Items: []map[string]types.AttributeValue{item("01JF0REBGD4QKPFGN1SX2STY4P", "01JF0RDJYN8NJ57RN65G7FNGHT", []string{"golang", "aws"})},
+					}, nil).
+					After(second).
+					Times(1)
+			},
+			want: []tagEventRow{
+				{EventID: "01JF0REBGD4QKPFGN1SX2STY4M", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHS", Tags: []string{"go"}},
+				{EventID: "01JF0REBGD4QKPFGN1SX2STY4P", ArticleID: "01JF0RDJYN8NJ57RN65G7FNGHT", Tags: []string{"golang", "aws"}},
+			},
+		},
+		"happy_path/no_tags": {
+			setup: func(client *mdynamo.MockClient) {},
+			want:  []tagEventRow{},
+		},
+		"unhappy_path/scan_fails": {
+			tagNames: []string{"go"},
+			setup: func(client *mdynamo.MockClient) {
+				client.EXPECT().
+					Scan(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errScan).
+					Times(1)
+			},
+			wantErr: errScan,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := mdynamo.NewMockClient(ctrl)
+			tt.setup(client)
+			got, err := scanTagEvents(context.Background(), client, tt.tagNames)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("scanTagEvents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("scanTagEvents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,168 @@
+package filesystem
+
+import (
+	"blogapi.miyamo.today/core/log"
+	"context"
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ServePath is the path the uploaded files are served under.
+const ServePath = "/images/"
+
+// ErrInvalidName is returned when the name of the file points outside the upload directory.
+var ErrInvalidName = errors.New("invalid file name")
+
+// Uploader writes files to a local directory instead of S3, for local and CI environments.
+type Uploader struct {
+	dir     string
+	baseURL string
+}
+
+func (s *Uploader) Upload(ctx context.Context, name string, body io.Reader, contentType string) (url *url.URL, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Upload").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*url.URL", nil),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("*url.URL", fmt.Sprintf("%+v", *url))))
+	}()
+
+	if !filepath.IsLocal(filepath.FromSlash(name)) {
+		err = errors.WithStack(ErrInvalidName)
+		return nil, err
+	}
+	path := filepath.Join(s.dir, filepath.FromSlash(name))
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	// the file is written under a temporary name first, so that a failed upload never leaves a partial file behind.
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	defer os.Remove(file.Name())
+	if _, err = io.Copy(file, body); err != nil {
+		file.Close()
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	if err = file.Close(); err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	if err = os.Chmod(file.Name(), 0o644); err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	if err = os.Rename(file.Name(), path); err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+
+	uri, err := url.Parse(fmt.Sprintf("%s/%s", s.baseURL, name))
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, err
+	}
+	return uri, nil
+}
+
+func (s *Uploader) Rename(ctx context.Context, src, dst string) (uri *url.URL, existed bool, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Rename").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*url.URL", nil),
+					slog.Bool("existed", false),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("*url.URL", fmt.Sprintf("%+v", *uri)), slog.Bool("existed", existed)))
+	}()
+
+	if !filepath.IsLocal(filepath.FromSlash(src)) || !filepath.IsLocal(filepath.FromSlash(dst)) {
+		err = errors.WithStack(ErrInvalidName)
+		return nil, false, err
+	}
+	srcPath := filepath.Join(s.dir, filepath.FromSlash(src))
+	dstPath := filepath.Join(s.dir, filepath.FromSlash(dst))
+	if err = os.MkdirAll(filepath.Dir(dstPath), 0o755); err != nil {
+		err = errors.WithStack(err)
+		return nil, false, err
+	}
+	// linking fails if dst exists, unlike renaming, which would replace it.
+	switch err = os.Link(srcPath, dstPath); {
+	case errors.Is(err, fs.ErrExist):
+		existed = true
+	case err != nil:
+		err = errors.WithStack(err)
+		return nil, false, err
+	}
+	if err = os.Remove(srcPath); err != nil {
+		err = errors.WithStack(err)
+		return nil, false, err
+	}
+
+	uri, err = url.Parse(fmt.Sprintf("%s/%s", s.baseURL, dst))
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, false, err
+	}
+	return uri, existed, nil
+}
+
+// Handler returns the handler serving the uploaded files under ServePath.
+func (s *Uploader) Handler() http.Handler {
+	files := http.FileServer(http.Dir(s.dir))
+	return http.StripPrefix(strings.TrimSuffix(ServePath, "/"), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// directories are not listed.
+		if strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		files.ServeHTTP(w, r)
+	}))
+}
+
+// NewUploader creates a new Uploader writing to dir. The URLs of the uploaded files start with baseURL.
+func NewUploader(dir, baseURL string) *Uploader {
+	return &Uploader{
+		dir:     dir,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
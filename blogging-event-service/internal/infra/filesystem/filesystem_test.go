@@ -0,0 +1,215 @@
+package filesystem
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/pkg"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var errBody = errors.New("body failed")
+
+func TestUploader_Upload(t *testing.T) {
+	type want struct {
+		uri *url.URL
+		err error
+	}
+	type testCase struct {
+		name string
+		body io.Reader
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			name: "example.png",
+			body: strings.NewReader("abcd"),
+			want: want{
+				uri: pkg.MustParseURL("http://localhost:8080/images/example.png"),
+			},
+		},
+		"happy_path/nested": {
+			name: "2026/example.png",
+			body: strings.NewReader("abcd"),
+			want: want{
+				uri: pkg.MustParseURL("http://localhost:8080/images/2026/example.png"),
+			},
+		},
+		"unhappy_path/outside_of_dir": {
+			name: "../example.png",
+			body: strings.NewReader("abcd"),
+			want: want{
+				err: ErrInvalidName,
+			},
+		},
+		"unhappy_path/absolute": {
+			name: "/etc/example.png",
+			body: strings.NewReader("abcd"),
+			want: want{
+				err: ErrInvalidName,
+			},
+		},
+		"unhappy_path/body_fails": {
+			name: "example.png",
+			body: io.MultiReader(strings.NewReader("ab"), iotest.ErrReader(errBody)),
+			want: want{
+				err: errBody,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			s := NewUploader(dir, "http://localhost:8080/images/")
+			got, err := s.Upload(context.Background(), tt.name, tt.body, "image/png")
+			if !errors.Is(err, tt.want.err) {
+				t.Fatalf("Upload() error = %v, want %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(got, tt.want.uri) {
+				t.Errorf("Upload() got = %v, want %v", got, tt.want.uri)
+			}
+			if tt.want.err != nil {
+				// nothing is left behind by a failed upload.
+				entries, err := os.ReadDir(dir)
+				if err != nil {
+					t.Fatalf("failed to read the upload directory: %v", err)
+				}
+				if len(entries) != 0 {
+					t.Errorf("upload directory has %d entries, want none", len(entries))
+				}
+				return
+			}
+			data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(tt.name)))
+			if err != nil {
+				t.Fatalf("failed to read the uploaded file: %v", err)
+			}
+			if string(data) != "abcd" {
+				t.Errorf("uploaded file = %q, want %q", data, "abcd")
+			}
+		})
+	}
+}
+
+func TestUploader_Rename(t *testing.T) {
+	type want struct {
+		uri     *url.URL
+		existed bool
+		data    string
+		err     error
+	}
+	type testCase struct {
+		dst string
+		// existing is the content of dst before renaming, if it exists.
+		existing *string
+		want     want
+	}
+	existing := "efgh"
+	tests := map[string]testCase{
+		"happy_path/moved": {
+			dst: "abcd.png",
+			want: want{
+				uri:  pkg.MustParseURL("http://localhost:8080/images/abcd.png"),
+				data: "abcd",
+			},
+		},
+		"happy_path/existed": {
+			dst:      "abcd.png",
+			existing: &existing,
+			want: want{
+				uri:     pkg.MustParseURL("http://localhost:8080/images/abcd.png"),
+				existed: true,
+				data:    "efgh",
+			},
+		},
+		"unhappy_path/outside_of_dir": {
+			dst: "../abcd.png",
+			want: want{
+				err: ErrInvalidName,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			s := NewUploader(dir, "http://localhost:8080/images/")
+			if _, err := s.Upload(context.Background(), "staging/example.png", strings.NewReader("abcd"), "image/png"); err != nil {
+				t.Fatalf("failed to upload the file: %v", err)
+			}
+			if tt.existing != nil {
+				if err := os.WriteFile(filepath.Join(dir, tt.dst), []byte(*tt.existing), 0o644); err != nil {
+					t.Fatalf("failed to write the existing file: %v", err)
+				}
+			}
+			got, existed, err := s.Rename(context.Background(), "staging/example.png", tt.dst)
+			if !errors.Is(err, tt.want.err) {
+				t.Fatalf("Rename() error = %v, want %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(got, tt.want.uri) {
+				t.Errorf("Rename() got = %v, want %v", got, tt.want.uri)
+			}
+			if existed != tt.want.existed {
+				t.Errorf("Rename() existed = %v, want %v", existed, tt.want.existed)
+			}
+			if tt.want.err != nil {
+				return
+			}
+			if _, err := os.Stat(filepath.Join(dir, "staging", "example.png")); !errors.Is(err, os.ErrNotExist) {
+				t.Errorf("source file is left behind: %v", err)
+			}
+			data, err := os.ReadFile(filepath.Join(dir, tt.dst))
+			if err != nil {
+				t.Fatalf("failed to read the renamed file: %v", err)
+			}
+			if string(data) != tt.want.data {
+				t.Errorf("renamed file = %q, want %q", data, tt.want.data)
+			}
+		})
+	}
+}
+
+func TestUploader_Handler(t *testing.T) {
+	type testCase struct {
+		path       string
+		wantStatus int
+		wantBody   string
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			path:       "/images/2026/example.png",
+			wantStatus: http.StatusOK,
+			wantBody:   "abcd",
+		},
+		"unhappy_path/not_found": {
+			path:       "/images/missing.png",
+			wantStatus: http.StatusNotFound,
+		},
+		"unhappy_path/directory": {
+			path:       "/images/2026/",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	s := NewUploader(t.TempDir(), "http://localhost:8080/images")
+	if _, err := s.Upload(context.Background(), "2026/example.png", strings.NewReader("abcd"), "image/png"); err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			s.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", rec.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package imaging
+
+import (
+	appimaging "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/imaging"
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"bytes"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+)
+
+// resizableTypes are the image types variants are produced for.
+// GIF is left out since resizing would drop its animation, and SVG since it is scalable by itself.
+var resizableTypes = []string{model.ImageTypePNG, model.ImageTypeJPEG, model.ImageTypeWebP}
+
+// Format encodes images in a format.
+type Format struct {
+	contentType string
+	encode      func(ctx context.Context, img image.Image) ([]byte, error)
+}
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	return f.contentType
+}
+
+// JPEG returns the JPEG format encoded with the quality.
+func JPEG(quality int) Format {
+	return Format{
+		contentType: model.ImageTypeJPEG,
+		encode: func(_ context.Context, img image.Image) ([]byte, error) {
+			var buf bytes.Buffer
+			if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+				return nil, errors.WithStack(err)
+			}
+			return buf.Bytes(), nil
+		},
+	}
+}
+
+// PNG returns the PNG format.
+func PNG() Format {
+	return Format{
+		contentType: model.ImageTypePNG,
+		encode: func(_ context.Context, img image.Image) ([]byte, error) {
+			var buf bytes.Buffer
+			if err := png.Encode(&buf, img); err != nil {
+				return nil, errors.WithStack(err)
+			}
+			return buf.Bytes(), nil
+		},
+	}
+}
+
+// Command returns the format encoded by an external command, for the formats the standard library cannot encode.
+// args returns the arguments of the command converting the PNG at in to the format at out.
+func Command(contentType, name string, args func(in, out string) []string) Format {
+	return Format{
+		contentType: contentType,
+		encode: func(ctx context.Context, img image.Image) ([]byte, error) {
+			dir, err := os.MkdirTemp("", "imaging")
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			defer os.RemoveAll(dir)
+
+			in := filepath.Join(dir, "in.png")
+			out := filepath.Join(dir, "out"+model.ImageExtension(contentType))
+			data, err := PNG().encode(ctx, img)
+			if err != nil {
+				return nil, err
+			}
+			if err := os.WriteFile(in, data, 0o600); err != nil {
+				return nil, errors.WithStack(err)
+			}
+			if output, err := exec.CommandContext(ctx, name, args(in, out)...).CombinedOutput(); err != nil {
+				return nil, errors.Wrapf(err, "%s: %s", name, output)
+			}
+			data, err = os.ReadFile(out)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			return data, nil
+		},
+	}
+}
+
+// WebP returns the WebP format encoded by cwebp with the quality.
+func WebP(quality int) Format {
+	return Command(model.ImageTypeWebP, "cwebp", func(in, out string) []string {
+		return []string{"-quiet", "-q", strconv.Itoa(quality), in, "-o", out}
+	})
+}
+
+// AVIF returns the AVIF format encoded by avifenc with the quality.
+func AVIF(quality int) Format {
+	return Command(model.ImageTypeAVIF, "avifenc", func(in, out string) []string {
+		return []string{"-q", strconv.Itoa(quality), in, out}
+	})
+}
+
+// Processor resizes the uploaded images to the configured widths and encodes them in the configured formats.
+type Processor struct {
+	widths  []int
+	formats []Format
+}
+
+// Variants returns the image resized to each width narrower than itself, encoded in the format of the original,
+// and the image at each of those widths and its own width encoded in each configured format.
+// The image itself is not among them. The variants are upright even if the image is oriented by its metadata.
+func (p *Processor) Variants(ctx context.Context, r io.Reader, contentType string) ([]appimaging.Variant, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Processor#Variants").End()
+
+	if !slices.Contains(resizableTypes, contentType) {
+		return nil, nil
+	}
+	head := &headWriter{limit: orientationHeadLen}
+	src := &readErrorRecorder{r: io.TeeReader(r, head)}
+	img, _, err := image.Decode(src)
+	if err != nil {
+		// reading the image failed, e.g. it exceeded the policy or its upload stopped, rather than decoding it.
+		if src.err != nil {
+			return nil, src.err
+		}
+		return nil, errors.Mark(errors.Wrapf(err, "malformed %s", contentType), model.ErrValidation)
+	}
+	// the variants carry no metadata, so the orientation of an image uploaded with its metadata is applied to them.
+	img = orient(img, headOrientation(contentType, head.data))
+	bounds := img.Bounds()
+
+	// PNG is the fallback of WebP, which the standard library cannot encode.
+	fallback := PNG()
+	if contentType == model.ImageTypeJPEG {
+		fallback = JPEG(jpegQuality)
+	}
+
+	variants := make([]appimaging.Variant, 0)
+	widths := append(slices.DeleteFunc(slices.Clone(p.widths), func(w int) bool { return w >= bounds.Dx() }), bounds.Dx())
+	for _, width := range widths {
+		resized := img
+		formats := p.formats
+		if width < bounds.Dx() {
+			resized = resize(img, width)
+			formats = append([]Format{fallback}, formats...)
+		}
+		for _, f := range formats {
+			if width == bounds.Dx() && f.contentType == contentType {
+				continue
+			}
+			encoded, err := f.encode(ctx, resized)
+			if err != nil {
+				return nil, err
+			}
+			variants = append(variants, appimaging.NewVariant(width, resized.Bounds().Dy(), f.contentType, encoded))
+		}
+	}
+	return variants, nil
+}
+
+// readErrorRecorder records the error reading failed with, other than io.EOF.
+type readErrorRecorder struct {
+	r   io.Reader
+	err error
+}
+
+func (e *readErrorRecorder) Read(b []byte) (int, error) {
+	n, err := e.r.Read(b)
+	if err != nil && !errors.Is(err, io.EOF) && e.err == nil {
+		e.err = err
+	}
+	return n, err
+}
+
+// orientationHeadLen is the length of the head of an image its orientation is read from.
+const orientationHeadLen = 256 << 10
+
+// headWriter keeps the first limit bytes written to it.
+type headWriter struct {
+	data  []byte
+	limit int
+}
+
+func (w *headWriter) Write(b []byte) (int, error) {
+	if room := w.limit - len(w.data); room > 0 {
+		w.data = append(w.data, b[:min(room, len(b))]...)
+	}
+	return len(b), nil
+}
+
+// headOrientation returns the orientation in the metadata at the head of the image, or 1 if it has none.
+// The metadata of a WebP follows its image data, so it is not read for its orientation.
+func headOrientation(contentType string, head []byte) int {
+	var (
+		orientation int
+		err         error
+	)
+	switch contentType {
+	case model.ImageTypeJPEG:
+		_, orientation, err = stripJPEG(bytes.NewReader(head))
+	case model.ImageTypePNG:
+		_, orientation, err = stripPNG(bytes.NewReader(head))
+	default:
+		return 1
+	}
+	if err != nil {
+		return 1
+	}
+	return orientation
+}
+
+// resize scales the image to the width, keeping its aspect ratio.
+func resize(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	height := max(1, (bounds.Dy()*width+bounds.Dx()/2)/bounds.Dx())
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	return dst
+}
+
+// jpegQuality is the quality of the JPEG variants.
+const jpegQuality = 85
+
+// NewProcessor creates a new Processor producing the variants of widths in formats.
+func NewProcessor(widths []int, formats ...Format) *Processor {
+	return &Processor{
+		widths:  widths,
+		formats: formats,
+	}
+}
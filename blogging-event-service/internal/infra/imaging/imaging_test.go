@@ -0,0 +1,172 @@
+package imaging
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"bytes"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type variant struct {
+	Width       int
+	Height      int
+	ContentType string
+}
+
+func encode(t *testing.T, contentType string, width, height int) []byte {
+	t.Helper()
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	var (
+		buf bytes.Buffer
+		err error
+	)
+	switch contentType {
+	case "image/png":
+		err = png.Encode(&buf, img)
+	case "image/jpeg":
+		err = jpeg.Encode(&buf, img, nil)
+	case "image/gif":
+		err = gif.Encode(&buf, img, nil)
+	}
+	if err != nil {
+		t.Fatalf("failed to encode %s: %v", contentType, err)
+	}
+	return buf.Bytes()
+}
+
+// fakeFormat returns the format encoded by a script copying the PNG as is.
+func fakeFormat(t *testing.T, contentType string) Format {
+	t.Helper()
+	script := filepath.Join(t.TempDir(), "encode")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\ncp \"$1\" \"$2\"\n"), 0o755); err != nil {
+		t.Fatalf("failed to write the script: %v", err)
+	}
+	return Command(contentType, script, func(in, out string) []string {
+		return []string{in, out}
+	})
+}
+
+// errReadFailed is the error failingReader fails with in the tests.
+var errReadFailed = errors.New("read failed")
+
+// failingReader fails every read with err.
+type failingReader struct {
+	err error
+}
+
+func (f *failingReader) Read([]byte) (int, error) {
+	return 0, f.err
+}
+
+func TestProcessor_Variants(t *testing.T) {
+	type testCase struct {
+		contentType string
+		data        []byte
+		widths      []int
+		formats     []Format
+		readErr     error
+		want        []variant
+		wantErr     bool
+		wantErrIs   error
+	}
+	tests := map[string]testCase{
+		"happy_path/png": {
+			contentType: "image/png",
+			data:        encode(t, "image/png", 100, 50),
+			widths:      []int{32, 64, 200},
+			want: []variant{
+				{Width: 32, Height: 16, ContentType: "image/png"},
+				{Width: 64, Height: 32, ContentType: "image/png"},
+			},
+		},
+		"happy_path/jpeg_with_formats": {
+			contentType: "image/jpeg",
+			data:        encode(t, "image/jpeg", 100, 50),
+			widths:      []int{32, 64},
+			formats:     []Format{fakeFormat(t, "image/webp")},
+			want: []variant{
+				{Width: 32, Height: 16, ContentType: "image/jpeg"},
+				{Width: 32, Height: 16, ContentType: "image/webp"},
+				{Width: 64, Height: 32, ContentType: "image/jpeg"},
+				{Width: 64, Height: 32, ContentType: "image/webp"},
+				{Width: 100, Height: 50, ContentType: "image/webp"},
+			},
+		},
+		"happy_path/oriented_jpeg": {
+			contentType: "image/jpeg",
+			data:        jpegWithMetadata(t, 100, 50, 6),
+			widths:      []int{32},
+			want: []variant{
+				{Width: 32, Height: 64, ContentType: "image/jpeg"},
+			},
+		},
+		"happy_path/gif": {
+			contentType: "image/gif",
+			data:        encode(t, "image/gif", 100, 50),
+			widths:      []int{32},
+		},
+		"unhappy_path/malformed": {
+			contentType: "image/png",
+			data:        []byte("not a png"),
+			widths:      []int{32},
+			wantErr:     true,
+			wantErrIs:   model.ErrValidation,
+		},
+		"unhappy_path/read_fails": {
+			contentType: "image/png",
+			data:        encode(t, "image/png", 100, 50)[:64],
+			widths:      []int{32},
+			readErr:     errReadFailed,
+			wantErr:     true,
+			wantErrIs:   errReadFailed,
+		},
+		"unhappy_path/command_fails": {
+			contentType: "image/png",
+			data:        encode(t, "image/png", 100, 50),
+			widths:      []int{32},
+			formats: []Format{Command("image/webp", "false", func(in, out string) []string {
+				return nil
+			})},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewProcessor(tt.widths, tt.formats...)
+			var r io.Reader = bytes.NewReader(tt.data)
+			if tt.readErr != nil {
+				r = io.MultiReader(r, &failingReader{err: tt.readErr})
+			}
+			got, err := p.Variants(context.Background(), r, tt.contentType)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Variants() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Fatalf("Variants() error = %v, want %v", err, tt.wantErrIs)
+			}
+			// a failure to read the image is not the fault of the image.
+			if tt.readErr != nil && errors.Is(err, model.ErrValidation) {
+				t.Fatalf("Variants() error = %v, want it not to be %v", err, model.ErrValidation)
+			}
+			var variants []variant
+			for _, v := range got {
+				variants = append(variants, variant{Width: v.Width(), Height: v.Height(), ContentType: v.ContentType()})
+				if len(v.Bytes()) == 0 {
+					t.Errorf("Variants() returned an empty %s", v.ContentType())
+				}
+			}
+			if diff := cmp.Diff(tt.want, variants); diff != "" {
+				t.Errorf("Variants() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,421 @@
+package imaging
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"image"
+	"io"
+	"slices"
+)
+
+// Stripper removes the metadata of the uploaded JPEG, PNG and WebP images, such as the location and the camera they were taken with.
+// It rewrites the images as they are read, and re-encodes only the ones whose orientation has to be applied to the pixels.
+type Stripper struct {
+	formats map[string]Format
+}
+
+// Strip returns the image read from r without its metadata. Images of the other types are returned as they are.
+// It fails with model.ErrValidation if the image is rotated by its metadata and there is no format to re-encode it in.
+func (s *Stripper) Strip(ctx context.Context, r io.Reader, contentType string) (io.Reader, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Stripper#Strip").End()
+
+	var (
+		stripped    io.Reader
+		orientation int
+		err         error
+	)
+	switch contentType {
+	case model.ImageTypeJPEG:
+		stripped, orientation, err = stripJPEG(r)
+	case model.ImageTypePNG:
+		stripped, orientation, err = stripPNG(r)
+	case model.ImageTypeWebP:
+		stripped, orientation, err = stripWebP(r)
+	default:
+		return r, nil
+	}
+	if err != nil {
+		return nil, malformed(contentType, err)
+	}
+	if orientation == 1 {
+		return stripped, nil
+	}
+
+	format, ok := s.formats[contentType]
+	if !ok {
+		// the image would be shown as it is stored rather than upright, so it is refused instead of being kept unrotated.
+		return nil, errors.Wrapf(model.ErrValidation, "%s rotated by its metadata cannot be stored upright; upload it upright or keep its metadata", contentType)
+	}
+	img, _, err := image.Decode(stripped)
+	if err != nil {
+		return nil, malformed(contentType, err)
+	}
+	// the rest of the image is read all the same, so that it is checked by whatever reads it.
+	if _, err := io.Copy(io.Discard, stripped); err != nil {
+		return nil, err
+	}
+	data, err := format.encode(ctx, orient(img, orientation))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// errCorrupt is returned when the structure of an image is broken.
+var errCorrupt = errors.New("corrupt image")
+
+// malformed returns the error of an image whose structure is broken or ends before its metadata does.
+// The other errors are those of reading the image, which are returned as they are.
+func malformed(contentType string, err error) error {
+	if errors.Is(err, errCorrupt) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return errors.Wrapf(model.ErrValidation, "malformed %s", contentType)
+	}
+	return err
+}
+
+// orientationTag is the tag of the orientation in EXIF.
+const orientationTag = 0x0112
+
+// exifOrientation returns the orientation in the EXIF data, or 1 if it has none.
+// The orientation tells how the pixels are flipped and rotated to be shown upright. 1 is upright, and 2 to 8 are the others.
+func exifOrientation(data []byte) int {
+	data = bytes.TrimPrefix(data, []byte("Exif\x00\x00"))
+	if len(data) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(data[:4]) {
+	case "II*\x00":
+		order = binary.LittleEndian
+	case "MM\x00*":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	offset := int(order.Uint32(data[4:8]))
+	if offset < 8 || offset+2 > len(data) {
+		return 1
+	}
+	count := int(order.Uint16(data[offset:]))
+	for i := range count {
+		entry := data[min(len(data), offset+2+i*12):]
+		if len(entry) < 12 {
+			return 1
+		}
+		// the orientation is a SHORT, which is the type 3.
+		if order.Uint16(entry) != orientationTag || order.Uint16(entry[2:]) != 3 {
+			continue
+		}
+		if orientation := int(order.Uint16(entry[8:])); orientation >= 1 && orientation <= 8 {
+			return orientation
+		}
+		return 1
+	}
+	return 1
+}
+
+// orient flips and rotates the image by the orientation so that it is upright.
+func orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	if orientation >= 5 {
+		// the orientations from 5 on swap the width and the height.
+		dst = image.NewNRGBA(image.Rect(0, 0, h, w))
+	}
+	for y := range dst.Bounds().Dy() {
+		for x := range dst.Bounds().Dx() {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			dst.Set(x, y, img.At(bounds.Min.X+sx, bounds.Min.Y+sy))
+		}
+	}
+	return dst
+}
+
+// jpegKeptSegments are the APPn segments of a JPEG kept, by their markers and the prefixes of their data.
+// The others, such as Exif, XMP and IPTC, are metadata. The color profile and the Adobe segment change how the image looks.
+var jpegKeptSegments = map[byte][]byte{
+	0xe0: []byte("JFIF\x00"),
+	0xe2: []byte("ICC_PROFILE\x00"),
+	0xee: []byte("Adobe"),
+}
+
+// stripJPEG returns the JPEG read from r without its metadata and the orientation in it.
+// The segments up to the scan are rewritten, and the scan is passed through as it is.
+func stripJPEG(r io.Reader) (io.Reader, int, error) {
+	br := bufio.NewReader(r)
+	var head bytes.Buffer
+	soi := make([]byte, 2)
+	if _, err := io.ReadFull(br, soi); err != nil {
+		return nil, 0, err
+	}
+	if soi[0] != 0xff || soi[1] != 0xd8 {
+		return nil, 0, errCorrupt
+	}
+	head.Write(soi)
+
+	orientation := 1
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return nil, 0, err
+		}
+		if b != 0xff {
+			return nil, 0, errCorrupt
+		}
+		marker := b
+		// a marker may be preceded by any number of fill bytes.
+		for marker == 0xff {
+			if marker, err = br.ReadByte(); err != nil {
+				return nil, 0, err
+			}
+		}
+		switch {
+		case marker == 0xda, marker == 0xd9:
+			// SOS is followed by the scan, and EOI by nothing.
+			head.Write([]byte{0xff, marker})
+			return io.MultiReader(&head, br), orientation, nil
+		case marker == 0x01, marker >= 0xd0 && marker <= 0xd7:
+			// TEM and RSTn have no length.
+			head.Write([]byte{0xff, marker})
+			continue
+		}
+
+		length := make([]byte, 2)
+		if _, err := io.ReadFull(br, length); err != nil {
+			return nil, 0, err
+		}
+		if binary.BigEndian.Uint16(length) < 2 {
+			return nil, 0, errCorrupt
+		}
+		data := make([]byte, binary.BigEndian.Uint16(length)-2)
+		if _, err := io.ReadFull(br, data); err != nil {
+			return nil, 0, err
+		}
+		keep := true
+		switch {
+		case marker == 0xe1 && bytes.HasPrefix(data, []byte("Exif\x00\x00")):
+			orientation = exifOrientation(data)
+			keep = false
+		case marker >= 0xe0 && marker <= 0xef:
+			prefix, ok := jpegKeptSegments[marker]
+			keep = ok && bytes.HasPrefix(data, prefix)
+		case marker == 0xfe:
+			// COM
+			keep = false
+		}
+		if keep {
+			head.Write([]byte{0xff, marker})
+			head.Write(length)
+			head.Write(data)
+		}
+	}
+}
+
+// pngSignature is the signature every PNG starts with.
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// pngMetadataChunks are the chunks of a PNG carrying metadata.
+var pngMetadataChunks = []string{"eXIf", "tEXt", "zTXt", "iTXt", "tIME"}
+
+// stripPNG returns the PNG read from r without its metadata and the orientation in it.
+// The chunks before the image data are read up front for the orientation, and the rest are stripped as they are read.
+func stripPNG(r io.Reader) (io.Reader, int, error) {
+	signature := make([]byte, len(pngSignature))
+	if _, err := io.ReadFull(r, signature); err != nil {
+		return nil, 0, err
+	}
+	if !bytes.Equal(signature, pngSignature) {
+		return nil, 0, errCorrupt
+	}
+	s := &pngStripper{r: r, orientation: 1}
+	head := bytes.NewBuffer(signature)
+	for {
+		chunkType, chunk, err := s.next()
+		if err != nil {
+			return nil, 0, err
+		}
+		if chunkType == "IDAT" {
+			s.chunk = chunk
+			break
+		}
+		if _, err := io.Copy(head, chunk); err != nil {
+			return nil, 0, err
+		}
+	}
+	return io.MultiReader(head, s), s.orientation, nil
+}
+
+// pngStripper drops the metadata chunks of the PNG read from r as it is read.
+type pngStripper struct {
+	r io.Reader
+	// chunk is the rest of the chunk being read.
+	chunk       io.Reader
+	orientation int
+}
+
+// next returns the type and the reader of the next chunk kept, dropping the metadata chunks on the way.
+// It returns io.EOF if the PNG ends before another chunk.
+func (s *pngStripper) next() (string, io.Reader, error) {
+	for {
+		head := make([]byte, 8)
+		if _, err := io.ReadFull(s.r, head); err != nil {
+			return "", nil, err
+		}
+		chunkType := string(head[4:])
+		// the data of the chunk is followed by its CRC.
+		chunk := io.LimitReader(s.r, int64(binary.BigEndian.Uint32(head))+4)
+		if !slices.Contains(pngMetadataChunks, chunkType) {
+			return chunkType, io.MultiReader(bytes.NewReader(head), chunk), nil
+		}
+		if chunkType == "eXIf" {
+			data, err := io.ReadAll(chunk)
+			if err != nil {
+				return "", nil, err
+			}
+			s.orientation = exifOrientation(data[:max(0, len(data)-4)])
+			continue
+		}
+		if _, err := io.Copy(io.Discard, chunk); err != nil {
+			return "", nil, err
+		}
+	}
+}
+
+func (s *pngStripper) Read(b []byte) (int, error) {
+	for {
+		if s.chunk == nil {
+			_, chunk, err := s.next()
+			if err != nil {
+				return 0, err
+			}
+			s.chunk = chunk
+		}
+		n, err := s.chunk.Read(b)
+		if errors.Is(err, io.EOF) {
+			s.chunk = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+// Flags of the VP8X chunk of a WebP.
+const (
+	webpEXIFFlag      = 0x08
+	webpXMPFlag       = 0x04
+	webpAnimationFlag = 0x02
+)
+
+// stripWebP returns the WebP read from r without its metadata and the orientation in it.
+// The metadata of a WebP follows the image data, and dropping it changes the size in the header,
+// so the WebPs having metadata are read into memory. The others are passed through as they are.
+func stripWebP(r io.Reader) (io.Reader, int, error) {
+	// the RIFF header and the header of the first chunk.
+	head := make([]byte, 20)
+	if _, err := io.ReadFull(r, head); err != nil {
+		return nil, 0, err
+	}
+	if string(head[12:16]) != "VP8X" {
+		// the simple formats have no metadata.
+		return io.MultiReader(bytes.NewReader(head), r), 1, nil
+	}
+	vp8x := make([]byte, binary.LittleEndian.Uint32(head[16:20]))
+	if _, err := io.ReadFull(r, vp8x); err != nil {
+		return nil, 0, err
+	}
+	if len(vp8x) == 0 {
+		return nil, 0, errCorrupt
+	}
+	if vp8x[0]&(webpEXIFFlag|webpXMPFlag) == 0 {
+		return io.MultiReader(bytes.NewReader(head), bytes.NewReader(vp8x), r), 1, nil
+	}
+
+	rest, err := io.ReadAll(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	orientation := 1
+	vp8x[0] &^= webpEXIFFlag | webpXMPFlag
+	chunks := bytes.NewBuffer(head[12:])
+	chunks.Write(vp8x)
+	for len(rest) > 0 {
+		if len(rest) < 8 {
+			return nil, 0, errCorrupt
+		}
+		size := int(binary.LittleEndian.Uint32(rest[4:8]))
+		// chunks of odd sizes are padded.
+		end := 8 + size + size&1
+		if end > len(rest) {
+			if 8+size != len(rest) {
+				return nil, 0, errCorrupt
+			}
+			end = len(rest)
+		}
+		switch string(rest[:4]) {
+		case "EXIF":
+			orientation = exifOrientation(rest[8 : 8+size])
+		case "XMP ":
+		default:
+			chunks.Write(rest[:end])
+		}
+		rest = rest[end:]
+	}
+	if vp8x[0]&webpAnimationFlag != 0 {
+		// the frames of an animation cannot be rotated.
+		orientation = 1
+	}
+
+	stripped := make([]byte, 12, 12+chunks.Len())
+	copy(stripped, head[:12])
+	binary.LittleEndian.PutUint32(stripped[4:8], uint32(4+chunks.Len()))
+	stripped = append(stripped, chunks.Bytes()...)
+	return bytes.NewReader(stripped), orientation, nil
+}
+
+// NewStripper creates a new Stripper. The images whose orientation is applied are re-encoded in the format of their type,
+// which is the formats given or either of JPEG and PNG. The images of the other types rotated by their metadata are refused.
+func NewStripper(formats ...Format) *Stripper {
+	s := &Stripper{
+		formats: map[string]Format{
+			model.ImageTypeJPEG: JPEG(orientedJPEGQuality),
+			model.ImageTypePNG:  PNG(),
+		},
+	}
+	for _, f := range formats {
+		s.formats[f.contentType] = f
+	}
+	return s
+}
+
+// orientedJPEGQuality is the quality the JPEGs whose orientation is applied are re-encoded with.
+// It is higher than that of the variants since the image replaces the original.
+const orientedJPEGQuality = 95
@@ -0,0 +1,206 @@
+package imaging
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"io"
+	"testing"
+)
+
+// exif returns the EXIF data with the orientation and a GPS tag.
+func exif(orientation int) []byte {
+	data := []byte("Exif\x00\x00MM\x00*\x00\x00\x00\x08\x00\x02")
+	// orientation, SHORT
+	data = append(data, 0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, byte(orientation), 0x00, 0x00)
+	// GPS IFD pointer, LONG
+	data = append(data, 0x88, 0x25, 0x00, 0x04, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00)
+	return append(data, 0x00, 0x00, 0x00, 0x00)
+}
+
+// jpegWithMetadata returns the JPEG with the EXIF of the orientation and a comment.
+func jpegWithMetadata(t *testing.T, width, height, orientation int) []byte {
+	t.Helper()
+	data := encode(t, "image/jpeg", width, height)
+	app1 := []byte{0xff, 0xe1, 0x00, 0x00}
+	app1 = append(app1, exif(orientation)...)
+	binary.BigEndian.PutUint16(app1[2:], uint16(len(app1)-2))
+	com := []byte{0xff, 0xfe, 0x00, 0x07, 'P', 'h', 'o', 'n', 'e'}
+	return append(append(append([]byte{0xff, 0xd8}, app1...), com...), data[2:]...)
+}
+
+func pngChunk(chunkType string, data []byte) []byte {
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(data)))
+	chunk = append(chunk, chunkType...)
+	chunk = append(chunk, data...)
+	return binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+}
+
+// pngWithMetadata returns the PNG with the EXIF of the orientation before its image data and texts around it.
+func pngWithMetadata(t *testing.T, width, height, orientation int) []byte {
+	t.Helper()
+	data := encode(t, "image/png", width, height)
+	// the signature is followed by IHDR, which is 25 bytes long.
+	head, rest := data[:33], data[33:]
+	out := append([]byte{}, head...)
+	out = append(out, pngChunk("eXIf", exif(orientation)[6:])...)
+	out = append(out, pngChunk("tEXt", []byte("Author\x00Phone"))...)
+	// IEND is 12 bytes long.
+	out = append(out, rest[:len(rest)-12]...)
+	out = append(out, pngChunk("iTXt", []byte("Comment\x00\x00\x00\x00\x00Phone"))...)
+	return append(out, rest[len(rest)-12:]...)
+}
+
+func webpChunk(fourCC string, data []byte) []byte {
+	chunk := append([]byte(fourCC), binary.LittleEndian.AppendUint32(nil, uint32(len(data)))...)
+	chunk = append(chunk, data...)
+	if len(data)%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+	return chunk
+}
+
+func webp(chunks ...[]byte) []byte {
+	data := []byte("RIFF\x00\x00\x00\x00WEBP")
+	for _, c := range chunks {
+		data = append(data, c...)
+	}
+	binary.LittleEndian.PutUint32(data[4:8], uint32(len(data)-8))
+	return data
+}
+
+func TestStripper_Strip(t *testing.T) {
+	type testCase struct {
+		contentType string
+		data        []byte
+		// want is the stripped image, compared if it is not nil.
+		want []byte
+		// wantSize is the size of the stripped image, checked if it is a raster the standard library decodes.
+		wantSize image.Point
+		wantErr  error
+	}
+	vp8x := func(flags byte) []byte {
+		return webpChunk("VP8X", []byte{flags, 0, 0, 0, 1, 0, 0, 1, 0, 0})
+	}
+	vp8l := webpChunk("VP8L", []byte("pixels"))
+	tests := map[string]testCase{
+		"happy_path/jpeg": {
+			contentType: "image/jpeg",
+			data:        jpegWithMetadata(t, 4, 2, 1),
+			wantSize:    image.Pt(4, 2),
+		},
+		"happy_path/jpeg_rotated": {
+			contentType: "image/jpeg",
+			data:        jpegWithMetadata(t, 4, 2, 6),
+			wantSize:    image.Pt(2, 4),
+		},
+		"happy_path/png": {
+			contentType: "image/png",
+			data:        pngWithMetadata(t, 4, 2, 1),
+			wantSize:    image.Pt(4, 2),
+		},
+		"happy_path/png_rotated": {
+			contentType: "image/png",
+			data:        pngWithMetadata(t, 4, 2, 8),
+			wantSize:    image.Pt(2, 4),
+		},
+		"happy_path/webp": {
+			contentType: "image/webp",
+			data:        webp(vp8x(webpEXIFFlag|webpXMPFlag|0x10), vp8l, webpChunk("EXIF", exif(1)), webpChunk("XMP ", []byte("<x:xmpmeta/>"))),
+			want:        webp(vp8x(0x10), vp8l),
+		},
+		"happy_path/webp_without_metadata": {
+			contentType: "image/webp",
+			data:        webp(vp8x(0x10), vp8l),
+			want:        webp(vp8x(0x10), vp8l),
+		},
+		"happy_path/gif": {
+			contentType: "image/gif",
+			data:        encode(t, "image/gif", 4, 2),
+			want:        encode(t, "image/gif", 4, 2),
+		},
+		"unhappy_path/truncated": {
+			contentType: "image/jpeg",
+			data:        jpegWithMetadata(t, 4, 2, 1)[:10],
+			wantErr:     model.ErrValidation,
+		},
+		"unhappy_path/webp_rotated_without_format": {
+			contentType: "image/webp",
+			data:        webp(vp8x(webpEXIFFlag|0x10), vp8l, webpChunk("EXIF", exif(6))),
+			wantErr:     model.ErrValidation,
+		},
+		"unhappy_path/not_png": {
+			contentType: "image/png",
+			data:        []byte("not a png at all"),
+			wantErr:     model.ErrValidation,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := NewStripper()
+			r, err := s.Strip(context.Background(), bytes.NewReader(tt.data), tt.contentType)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Strip() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("failed to read the stripped image: %v", err)
+			}
+			if tt.want != nil && !bytes.Equal(got, tt.want) {
+				t.Errorf("Strip() got = %q, want %q", got, tt.want)
+			}
+			for _, metadata := range []string{"Exif", "eXIf", "tEXt", "iTXt", "Phone", "XMP "} {
+				if bytes.Contains(got, []byte(metadata)) {
+					t.Errorf("Strip() left %q in the image", metadata)
+				}
+			}
+			if tt.wantSize == (image.Point{}) {
+				return
+			}
+			img, _, err := image.Decode(bytes.NewReader(got))
+			if err != nil {
+				t.Fatalf("failed to decode the stripped image: %v", err)
+			}
+			if size := img.Bounds().Size(); size != tt.wantSize {
+				t.Errorf("Strip() got size = %v, want %v", size, tt.wantSize)
+			}
+		})
+	}
+}
+
+func TestOrient(t *testing.T) {
+	// the pixel at the top left of the source is white, and the others are black.
+	src := image.NewGray(image.Rect(0, 0, 3, 2))
+	src.Pix[0] = 0xff
+	tests := map[int]image.Point{
+		1: image.Pt(0, 0),
+		2: image.Pt(2, 0),
+		3: image.Pt(2, 1),
+		4: image.Pt(0, 1),
+		5: image.Pt(0, 0),
+		6: image.Pt(1, 0),
+		7: image.Pt(1, 2),
+		8: image.Pt(0, 2),
+	}
+	for orientation, want := range tests {
+		img := orient(src, orientation)
+		var got []image.Point
+		for y := range img.Bounds().Dy() {
+			for x := range img.Bounds().Dx() {
+				if r, _, _, _ := img.At(x, y).RGBA(); r != 0 {
+					got = append(got, image.Pt(x, y))
+				}
+			}
+		}
+		if len(got) != 1 || got[0] != want {
+			t.Errorf("orient(%d) got the white pixel at %v, want %v", orientation, got, want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package local
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/local/eventlog"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/oklog/ulid/v2"
+	"log/slog"
+	"time"
+)
+
+// articleEventFromEntry converts the entry of the log to model.ArticleEvent.
+func articleEventFromEntry(e eventlog.BloggingEvent) (model.ArticleEvent, error) {
+	id, err := ulid.Parse(e.EventID)
+	if err != nil {
+		return model.ArticleEvent{}, err
+	}
+	options := []model.ArticleEventOption{
+		model.ArticleEventWithTags(e.Tags...),
+		model.ArticleEventWithAttachTags(e.AttachTags...),
+		model.ArticleEventWithDetachTags(e.DetachTags...),
+	}
+	if e.Title != nil {
+		options = append(options, model.ArticleEventWithTitle(*e.Title))
+	}
+	if e.Content != nil {
+		options = append(options, model.ArticleEventWithContent(*e.Content))
+	}
+	if e.Thumbnail != nil {
+		options = append(options, model.ArticleEventWithThumbnail(*e.Thumbnail), model.ArticleEventWithThumbnailVariants(imageVariantsFrom(e.ThumbnailVariants)...))
+	}
+	if e.Invisible != nil {
+		options = append(options, model.ArticleEventWithInvisible(*e.Invisible))
+	}
+	if e.PublishAt != nil && *e.PublishAt != "" {
+		publishAt, err := time.Parse(time.RFC3339, *e.PublishAt)
+		if err != nil {
+			return model.ArticleEvent{}, err
+		}
+		options = append(options, model.ArticleEventWithPublishAt(publishAt))
+	}
+	if e.Draft != nil {
+		options = append(options, model.ArticleEventWithDraft(*e.Draft))
+	}
+	if e.Actor != nil {
+		options = append(options, model.ArticleEventWithActor(*e.Actor))
+	}
+	if e.RevertedTo != nil {
+		options = append(options, model.ArticleEventWithRevertedTo(*e.RevertedTo))
+	}
+	if e.Slug != nil {
+		options = append(options, model.ArticleEventWithSlug(*e.Slug))
+	}
+	if e.SeriesID != nil {
+		options = append(options, model.ArticleEventWithSeries(model.NewArticleSeries(*e.SeriesID, deref(e.SeriesTitle), deref(e.SeriesPosition))))
+	}
+	return model.NewArticleEvent(e.EventID, model.ArticleEventType(e.EventType), ulid.Time(id.Time()).UTC(), options...), nil
+}
+
+// ArticleEventQueryService reads the history of articles from the local event store.
+type ArticleEventQueryService struct {
+	store *Store
+}
+
+func (s *ArticleEventQueryService) ListByArticleID(ctx context.Context, articleID string, out *db.MultipleStatementResult[*model.ArticleEvent]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ArticleEventQueryService#ListByArticleID").End()
+	return newStatement(func(ctx context.Context, out db.StatementResult) error {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("ArticleEventQueryService#ListByArticleID#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		entries, err := s.store.events.Entries()
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		rows := eventsOf(entries, articleID)
+		if len(rows) == 0 {
+			return errors.WithStack(model.ErrNotFound)
+		}
+
+		events := make([]*model.ArticleEvent, 0, len(rows))
+		for _, row := range rows {
+			event, err := articleEventFromEntry(row)
+			if err != nil {
+				err = errors.WithStack(err)
+				nrtx.NoticeError(nrpkgerrors.Wrap(err))
+				return err
+			}
+			events = append(events, &event)
+		}
+
+		out.Set(events)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+func NewArticleEventQueryService(store *Store) *ArticleEventQueryService {
+	return &ArticleEventQueryService{store: store}
+}
@@ -0,0 +1,483 @@
+package local
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/local/eventlog"
+	"blogapi.miyamo.today/blogging-event-service/internal/pkg"
+	"blogapi.miyamo.today/core/article"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/oklog/ulid/v2"
+	"log/slog"
+	"time"
+)
+
+// schemaVersion is the version of the shape of the events written by this service.
+const schemaVersion = 2
+
+// BloggingEventCommandService writes blogging events to the local event store instead of DynamoDB.
+type BloggingEventCommandService struct {
+	store   *Store
+	ulidGen pkg.ULIDGenerator
+}
+
+func (s *BloggingEventCommandService) CreateArticle(ctx context.Context, in model.CreateArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#CreateArticle").End()
+	event := s.newEvent(s.ulidGen().String(), model.ArticleEventTypeCreateArticle)
+	event.Title = nonZero(in.Title())
+	event.Content = nonZero(in.Content())
+	event.Thumbnail = nonZero(in.Thumbnail())
+	event.ThumbnailVariants = imageVariantsOf(in.ThumbnailVariants())
+	event.Tags = in.Tags()
+	event.PublishAt = nonZero(formatPublishAt(in.PublishAt()))
+	if in.Draft() {
+		event.Draft = ptr(true)
+	}
+	event.Slug = nonZero(in.Slug())
+	return s.write("CreateArticle", event, "", out)
+}
+
+func (s *BloggingEventCommandService) UpdateArticleTitle(ctx context.Context, in model.UpdateArticleTitleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#UpdateArticleTitle").End()
+	event := s.newEvent(in.ArticleID(), model.ArticleEventTypeUpdateTitle)
+	event.Title = nonZero(in.Title())
+	return s.write("UpdateArticleTitle", event, in.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) UpdateArticleBody(ctx context.Context, in model.UpdateArticleBodyEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#UpdateArticleBody").End()
+	event := s.newEvent(in.ArticleID(), model.ArticleEventTypeUpdateBody)
+	event.Content = nonZero(in.Body())
+	return s.write("UpdateArticleBody", event, in.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) UpdateArticleThumbnail(ctx context.Context, command model.UpdateArticleThumbnailEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#UpdateArticleThumbnail").End()
+	thumbnail := command.Thumbnail()
+	event := s.newEvent(command.ArticleID(), model.ArticleEventTypeUpdateThumbnail)
+	event.Thumbnail = nonZero(thumbnail.String())
+	event.ThumbnailVariants = imageVariantsOf(command.ThumbnailVariants())
+	return s.write("UpdateArticleThumbnail", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) UpdateArticleSlug(ctx context.Context, command model.UpdateArticleSlugEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#UpdateArticleSlug").End()
+	event := s.newEvent(command.ArticleID(), model.ArticleEventTypeUpdateSlug)
+	event.Slug = nonZero(command.Slug())
+	return s.write("UpdateArticleSlug", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) AttachTags(ctx context.Context, command model.AttachTagsEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#AttachTags").End()
+	event := s.newEvent(command.ArticleID(), model.ArticleEventTypeAttachTags)
+	event.AttachTags = command.Tags()
+	return s.write("AttachTags", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) DetachTags(ctx context.Context, command model.DetachTagsEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#DetachTags").End()
+	event := s.newEvent(command.ArticleID(), model.ArticleEventTypeDetachTags)
+	event.DetachTags = command.Tags()
+	return s.write("DetachTags", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) HideArticle(ctx context.Context, command model.HideArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#HideArticle").End()
+	event := s.newEvent(command.ArticleID(), model.ArticleEventTypeHideArticle)
+	event.Invisible = ptr(true)
+	return s.write("HideArticle", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) UnhideArticle(ctx context.Context, command model.UnhideArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#UnhideArticle").End()
+	event := s.newEvent(command.ArticleID(), model.ArticleEventTypeUnhideArticle)
+	event.Invisible = ptr(false)
+	return s.write("UnhideArticle", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) EditArticle(ctx context.Context, command model.EditArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#EditArticle").End()
+	event := s.newEvent(command.ArticleID(), model.ArticleEventTypeEditArticle)
+	if v := command.Title(); v != nil {
+		event.Title = nonZero(*v)
+	}
+	if v := command.Body(); v != nil {
+		event.Content = nonZero(*v)
+	}
+	if v := command.Thumbnail(); v != nil {
+		event.Thumbnail = nonZero(v.String())
+		event.ThumbnailVariants = imageVariantsOf(command.ThumbnailVariants())
+	}
+	event.AttachTags = command.AttachTags()
+	event.DetachTags = command.DetachTags()
+	return s.write("EditArticle", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) ScheduleArticle(ctx context.Context, command model.ScheduleArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#ScheduleArticle").End()
+	event := s.newEvent(command.ArticleID(), model.ArticleEventTypeScheduleArticle)
+	event.PublishAt = nonZero(formatPublishAt(command.PublishAt()))
+	return s.write("ScheduleArticle", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) PublishArticle(ctx context.Context, command model.PublishArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#PublishArticle").End()
+	event := s.newEvent(command.ArticleID(), model.ArticleEventTypePublishArticle)
+	event.Draft = ptr(false)
+	return s.write("PublishArticle", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) RevertArticle(ctx context.Context, command model.RevertArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#RevertArticle").End()
+	event := s.newEvent(command.ArticleID(), model.ArticleEventTypeRevertArticle)
+	// the title, body and thumbnail are always written, so that empty ones are restored as well.
+	event.Title = ptr(command.Title())
+	event.Content = ptr(command.Body())
+	event.Thumbnail = ptr(command.Thumbnail())
+	event.ThumbnailVariants = imageVariantsOf(command.ThumbnailVariants())
+	event.AttachTags = command.AttachTags()
+	event.DetachTags = command.DetachTags()
+	event.RevertedTo = nonZero(command.ToEventID())
+	return s.write("RevertArticle", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) RenameTag(ctx context.Context, command model.RenameTagEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#RenameTag").End()
+	event := s.newEvent(command.ArticleID(), model.ArticleEventTypeRenameTag)
+	event.AttachTags = []string{command.To()}
+	event.DetachTags = []string{command.From()}
+	return s.write("RenameTag", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) MergeTags(ctx context.Context, command model.MergeTagsEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#MergeTags").End()
+	event := s.newEvent(command.ArticleID(), model.ArticleEventTypeMergeTags)
+	event.AttachTags = []string{command.Into()}
+	event.DetachTags = command.Sources()
+	return s.write("MergeTags", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) AddToSeries(ctx context.Context, command model.AddToSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#AddToSeries").End()
+	event := s.newSeriesEvent(model.ArticleEventTypeAddToSeries, command.SeriesEvent)
+	return s.write("AddToSeries", event, command.ExpectedLastEventID(), out)
+}
+
+func (s *BloggingEventCommandService) ReorderSeries(ctx context.Context, command model.ReorderSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#ReorderSeries").End()
+	event := s.newSeriesEvent(model.ArticleEventTypeReorderSeries, command.SeriesEvent)
+	return s.write("ReorderSeries", event, command.ExpectedLastEventID(), out)
+}
+
+// newSeriesEvent returns an event putting the article at the position of the series.
+func (s *BloggingEventCommandService) newSeriesEvent(eventType model.ArticleEventType, command model.SeriesEvent) eventlog.BloggingEvent {
+	event := s.newEvent(command.ArticleID(), eventType)
+	series := command.Series()
+	event.SeriesID = ptr(series.ID())
+	event.SeriesTitle = ptr(series.Title())
+	event.SeriesPosition = ptr(series.Position())
+	return event
+}
+
+// newEvent returns an event of the article with a new event id.
+func (s *BloggingEventCommandService) newEvent(articleID string, eventType model.ArticleEventType) eventlog.BloggingEvent {
+	return eventlog.BloggingEvent{
+		EventID:       s.ulidGen().String(),
+		ArticleID:     articleID,
+		EventType:     string(eventType),
+		SchemaVersion: schemaVersion,
+	}
+}
+
+// write returns the statement appending the event to the log.
+func (s *BloggingEventCommandService) write(name string, event eventlog.BloggingEvent, expectedLastEventID string, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	return newStatement(func(ctx context.Context, out db.StatementResult) error {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#" + name + "#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		key, err := s.appendEvent(ctx, event, expectedLastEventID)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(&key)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+// appendEvent appends the event to the log, with the same checks as the DynamoDB store.
+// It returns model.ErrNotFound if the article does not exist, or is hidden and the event neither changes its visibility nor retags it.
+// It returns model.ErrConflict if the last event of the article is not expectedLastEventID. An empty expectedLastEventID skips the check.
+// It returns model.SlugTakenError if the event sets a slug another article has had.
+// If the request has already been applied under the same idempotency key, nothing is written and the key of the recorded event is returned.
+// The event records the user who made the request, if the request tells.
+func (s *BloggingEventCommandService) appendEvent(ctx context.Context, event eventlog.BloggingEvent, expectedLastEventID string) (model.BloggingEventKey, error) {
+	key := model.NewBloggingEventKey(event.EventID, event.ArticleID)
+	idempotencyKey, hasIdempotencyKey := model.IdempotencyKeyFromContext(ctx)
+	if actor, ok := model.ActorFromContext(ctx); ok {
+		event.Actor = nonZero(actor.Name())
+		event.ActorID = nonZero(actor.Subject())
+	}
+
+	// the idempotency log is locked first, so that a retry of the request waits for the original one.
+	err := s.store.idempotency.Append(func(records []idempotencyRecord) ([]idempotencyRecord, error) {
+		if hasIdempotencyKey {
+			for _, r := range records {
+				if r.IdempotencyKey != idempotencyRecordKey(idempotencyKey) {
+					continue
+				}
+				if r.Fingerprint != idempotencyKey.Fingerprint() {
+					return nil, errors.WithStack(model.ErrIdempotencyKeyReused)
+				}
+				key = model.NewBloggingEventKey(r.EventID, r.ArticleID)
+				return nil, nil
+			}
+		}
+
+		err := s.store.events.Append(func(entries []eventlog.BloggingEvent) ([]eventlog.BloggingEvent, error) {
+			if event.Slug != nil {
+				if owner, ok := slugOwners(entries)[*event.Slug]; ok && owner != event.ArticleID {
+					return nil, model.SlugTakenError(*event.Slug)
+				}
+			}
+			if event.EventType == string(model.ArticleEventTypeCreateArticle) {
+				return []eventlog.BloggingEvent{event}, nil
+			}
+			head, ok := streamHeads(entries)[event.ArticleID]
+			if !ok {
+				return nil, errors.WithStack(model.ErrNotFound)
+			}
+			if event.Invisible == nil && !writesToHidden(event) && head.invisible {
+				return nil, errors.WithStack(model.ErrNotFound)
+			}
+			if expectedLastEventID != "" && expectedLastEventID != head.lastEventID {
+				return nil, errors.WithStack(model.ErrConflict)
+			}
+			return []eventlog.BloggingEvent{event}, nil
+		})
+		if err != nil || !hasIdempotencyKey {
+			return nil, err
+		}
+		return []idempotencyRecord{{
+			IdempotencyKey: idempotencyRecordKey(idempotencyKey),
+			Fingerprint:    idempotencyKey.Fingerprint(),
+			EventID:        event.EventID,
+			ArticleID:      event.ArticleID,
+		}}, nil
+	})
+	if err != nil {
+		return model.BloggingEventKey{}, err
+	}
+	return key, nil
+}
+
+func (s *BloggingEventCommandService) RecordOutcome(ctx context.Context, outcome model.RequestOutcome, out *db.SingleStatementResult[*model.RequestOutcome]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#RecordOutcome").End()
+	return newStatement(func(ctx context.Context, out db.StatementResult) error {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#RecordOutcome#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		idempotencyKey, ok := model.IdempotencyKeyFromContext(ctx)
+		if !ok {
+			out.Set(&outcome)
+			logger.Info("END")
+			return nil
+		}
+		recorded := &outcome
+		err := s.store.idempotency.Append(func(records []idempotencyRecord) ([]idempotencyRecord, error) {
+			if r, ok := findIdempotencyRecord(records, idempotencyKey); ok {
+				// a retry of the request has recorded its outcome in the meantime.
+				if r.Fingerprint != idempotencyKey.Fingerprint() {
+					return nil, errors.WithStack(model.ErrIdempotencyKeyReused)
+				}
+				recorded = outcomeOf(r)
+				return nil, nil
+			}
+			events := make([]idempotencyOutcomeEvent, 0, len(outcome.Events()))
+			for _, e := range outcome.Events() {
+				events = append(events, idempotencyOutcomeEvent{EventID: e.EventID(), ArticleID: e.ArticleID()})
+			}
+			return []idempotencyRecord{{
+				IdempotencyKey: idempotencyRecordKey(idempotencyKey),
+				Fingerprint:    idempotencyKey.Fingerprint(),
+				SubjectID:      outcome.SubjectID(),
+				Events:         events,
+			}}, nil
+		})
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(recorded)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+func (s *BloggingEventCommandService) ReplayOutcome(ctx context.Context, out *db.SingleStatementResult[*model.RequestOutcome]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventCommandService#ReplayOutcome").End()
+	return newStatement(func(ctx context.Context, out db.StatementResult) error {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("BloggingEventCommandService#ReplayOutcome#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		idempotencyKey, ok := model.IdempotencyKeyFromContext(ctx)
+		if !ok {
+			logger.Info("END")
+			return nil
+		}
+		records, err := s.store.idempotency.Entries()
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		r, ok := findIdempotencyRecord(records, idempotencyKey)
+		if !ok {
+			logger.Info("END")
+			return nil
+		}
+		if r.Fingerprint != idempotencyKey.Fingerprint() {
+			err = errors.WithStack(model.ErrIdempotencyKeyReused)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(outcomeOf(r))
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+// findIdempotencyRecord returns the record of the idempotency key, if there is one.
+func findIdempotencyRecord(records []idempotencyRecord, key model.IdempotencyKey) (idempotencyRecord, bool) {
+	for _, r := range records {
+		if r.IdempotencyKey == idempotencyRecordKey(key) {
+			return r, true
+		}
+	}
+	return idempotencyRecord{}, false
+}
+
+// outcomeOf returns the outcome recorded by the record.
+func outcomeOf(r idempotencyRecord) *model.RequestOutcome {
+	events := make([]model.BloggingEventKey, 0, len(r.Events))
+	for _, e := range r.Events {
+		events = append(events, model.NewBloggingEventKey(e.EventID, e.ArticleID))
+	}
+	outcome := model.NewRequestOutcome(r.SubjectID, events)
+	return &outcome
+}
+
+// writesToHidden reports whether the event is written to hidden articles as well:
+// renaming or merging tags, and putting the article in a series, so that the positions of the series stay in order.
+func writesToHidden(event eventlog.BloggingEvent) bool {
+	switch model.ArticleEventType(event.EventType) {
+	case model.ArticleEventTypeRenameTag, model.ArticleEventTypeMergeTags,
+		model.ArticleEventTypeAddToSeries, model.ArticleEventTypeReorderSeries:
+		return true
+	}
+	return false
+}
+
+// idempotencyRecordKey scopes the key to its caller.
+func idempotencyRecordKey(key model.IdempotencyKey) string {
+	return key.Caller() + "#" + key.Key()
+}
+
+// formatPublishAt formats the publish time in RFC 3339 in UTC. The zero value is formatted as empty.
+func formatPublishAt(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// nonZero returns nil for the empty string, since the DynamoDB store omits zero values from the item.
+func nonZero(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// imageVariantsOf converts the variants of the thumbnail to the ones stored in the log.
+func imageVariantsOf(variants []article.ImageVariant) []eventlog.ImageVariant {
+	if len(variants) == 0 {
+		return nil
+	}
+	result := make([]eventlog.ImageVariant, 0, len(variants))
+	for _, v := range variants {
+		result = append(result, eventlog.ImageVariant{URL: v.URL(), Width: v.Width(), Height: v.Height(), ContentType: v.ContentType()})
+	}
+	return result
+}
+
+// imageVariantsFrom converts the variants of the thumbnail stored in the log to the ones of the event.
+func imageVariantsFrom(variants []eventlog.ImageVariant) []article.ImageVariant {
+	if len(variants) == 0 {
+		return nil
+	}
+	result := make([]article.ImageVariant, 0, len(variants))
+	for _, v := range variants {
+		result = append(result, article.NewImageVariant(v.URL, v.Width, v.Height, v.ContentType))
+	}
+	return result
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// deref returns the value p points to, or the zero value if p is nil.
+func deref[T any](p *T) (v T) {
+	if p != nil {
+		v = *p
+	}
+	return v
+}
+
+func NewBloggingEventCommandService(store *Store, ulidGen *pkg.ULIDGenerator) *BloggingEventCommandService {
+	if ulidGen == nil {
+		return &BloggingEventCommandService{
+			store:   store,
+			ulidGen: ulid.Make,
+		}
+	}
+	return &BloggingEventCommandService{
+		store:   store,
+		ulidGen: *ulidGen,
+	}
+}
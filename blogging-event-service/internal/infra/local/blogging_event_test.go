@@ -0,0 +1,423 @@
+package local
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/article"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func newTestServices(t *testing.T) (*BloggingEventCommandService, *ArticleEventQueryService, *DraftQueryService) {
+	t.Helper()
+	store, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	return NewBloggingEventCommandService(store, nil), NewArticleEventQueryService(store), NewDraftQueryService(store)
+}
+
+func createArticle(t *testing.T, ctx context.Context, s *BloggingEventCommandService, draft bool) model.BloggingEventKey {
+	t.Helper()
+	out := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	in := model.NewCreateArticleEvent("title", "content", "https://example.com/thumbnail.png", nil, []string{"go"}, time.Time{}, draft, "")
+	if err := s.CreateArticle(ctx, in, out).Execute(ctx); err != nil {
+		t.Fatalf("CreateArticle() error = %v", err)
+	}
+	return *out.StrictGet()
+}
+
+func TestBloggingEventCommandService_UpdateArticleTitle(t *testing.T) {
+	type testCase struct {
+		setup   func(t *testing.T, ctx context.Context, s *BloggingEventCommandService) (articleID, expectedLastEventID string)
+		wantErr error
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			setup: func(t *testing.T, ctx context.Context, s *BloggingEventCommandService) (string, string) {
+				key := createArticle(t, ctx, s, false)
+				return key.ArticleID(), key.EventID()
+			},
+		},
+		"unhappy_path/not_found": {
+			setup: func(t *testing.T, ctx context.Context, s *BloggingEventCommandService) (string, string) {
+				return "01JF0REBGD4QKPFGN1SX2STY4M", ""
+			},
+			wantErr: model.ErrNotFound,
+		},
+		"unhappy_path/hidden": {
+			setup: func(t *testing.T, ctx context.Context, s *BloggingEventCommandService) (string, string) {
+				key := createArticle(t, ctx, s, false)
+				out := db.NewSingleStatementResult[*model.BloggingEventKey]()
+				if err := s.HideArticle(ctx, model.NewHideArticleEvent(key.ArticleID(), ""), out).Execute(ctx); err != nil {
+					t.Fatalf("HideArticle() error = %v", err)
+				}
+				return key.ArticleID(), ""
+			},
+			wantErr: model.ErrNotFound,
+		},
+		"unhappy_path/conflict": {
+			setup: func(t *testing.T, ctx context.Context, s *BloggingEventCommandService) (string, string) {
+				key := createArticle(t, ctx, s, false)
+				out := db.NewSingleStatementResult[*model.BloggingEventKey]()
+				if err := s.UpdateArticleBody(ctx, model.NewUpdateArticleBodyEvent(key.ArticleID(), "body", ""), out).Execute(ctx); err != nil {
+					t.Fatalf("UpdateArticleBody() error = %v", err)
+				}
+				return key.ArticleID(), key.EventID()
+			},
+			wantErr: model.ErrConflict,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s, _, _ := newTestServices(t)
+			articleID, expectedLastEventID := tt.setup(t, ctx, s)
+
+			out := db.NewSingleStatementResult[*model.BloggingEventKey]()
+			err := s.UpdateArticleTitle(ctx, model.NewUpdateArticleTitleEvent(articleID, "new title", expectedLastEventID), out).Execute(ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("UpdateArticleTitle() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && out.StrictGet().ArticleID() != articleID {
+				t.Errorf("UpdateArticleTitle() article id = %v, want %v", out.StrictGet().ArticleID(), articleID)
+			}
+		})
+	}
+}
+
+func TestBloggingEventCommandService_UpdateArticleSlug(t *testing.T) {
+	ctx := context.Background()
+	s, _, _ := newTestServices(t)
+	first := createArticle(t, ctx, s, false)
+	second := createArticle(t, ctx, s, false)
+	updateSlug := func(articleID, slug string) error {
+		out := db.NewSingleStatementResult[*model.BloggingEventKey]()
+		return s.UpdateArticleSlug(ctx, model.NewUpdateArticleSlugEvent(articleID, slug, ""), out).Execute(ctx)
+	}
+
+	if err := updateSlug(first.ArticleID(), "old-slug"); err != nil {
+		t.Fatalf("UpdateArticleSlug() error = %v", err)
+	}
+	if err := updateSlug(first.ArticleID(), "new-slug"); err != nil {
+		t.Fatalf("UpdateArticleSlug() error = %v", err)
+	}
+	// the former slug stays with the first article, so that it can be redirected.
+	if err := updateSlug(second.ArticleID(), "old-slug"); !errors.Is(err, model.ErrValidation) {
+		t.Errorf("UpdateArticleSlug() error = %v, want %v", err, model.ErrValidation)
+	}
+	if err := updateSlug(second.ArticleID(), "new-slug"); !errors.Is(err, model.ErrValidation) {
+		t.Errorf("UpdateArticleSlug() error = %v, want %v", err, model.ErrValidation)
+	}
+	if err := updateSlug(first.ArticleID(), "old-slug"); err != nil {
+		t.Errorf("UpdateArticleSlug() error = %v, want the article to take its former slug back", err)
+	}
+}
+
+func TestBloggingEventCommandService_Idempotency(t *testing.T) {
+	s, q, _ := newTestServices(t)
+	key := createArticle(t, context.Background(), s, false)
+
+	ctx := model.ContextWithIdempotencyKey(context.Background(), model.NewIdempotencyKey("caller", "key", "fingerprint"))
+	first := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	if err := s.UpdateArticleTitle(ctx, model.NewUpdateArticleTitleEvent(key.ArticleID(), "new title", ""), first).Execute(ctx); err != nil {
+		t.Fatalf("UpdateArticleTitle() error = %v", err)
+	}
+	retry := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	if err := s.UpdateArticleTitle(ctx, model.NewUpdateArticleTitleEvent(key.ArticleID(), "new title", ""), retry).Execute(ctx); err != nil {
+		t.Fatalf("UpdateArticleTitle() error = %v", err)
+	}
+	if !reflect.DeepEqual(retry.StrictGet(), first.StrictGet()) {
+		t.Errorf("UpdateArticleTitle() retried = %v, want %v", retry.StrictGet(), first.StrictGet())
+	}
+
+	reused := model.ContextWithIdempotencyKey(context.Background(), model.NewIdempotencyKey("caller", "key", "another"))
+	err := s.UpdateArticleTitle(reused, model.NewUpdateArticleTitleEvent(key.ArticleID(), "another title", ""), db.NewSingleStatementResult[*model.BloggingEventKey]()).Execute(reused)
+	if !errors.Is(err, model.ErrIdempotencyKeyReused) {
+		t.Errorf("UpdateArticleTitle() error = %v, want %v", err, model.ErrIdempotencyKeyReused)
+	}
+
+	events := db.NewMultipleStatementResult[*model.ArticleEvent]()
+	if err := q.ListByArticleID(context.Background(), key.ArticleID(), events).Execute(context.Background()); err != nil {
+		t.Fatalf("ListByArticleID() error = %v", err)
+	}
+	if got := len(events.StrictGet()); got != 2 {
+		t.Errorf("ListByArticleID() returned %d events, want 2", got)
+	}
+}
+
+func TestBloggingEventCommandService_Outcome(t *testing.T) {
+	s, _, _ := newTestServices(t)
+	ctx := model.ContextWithIdempotencyKey(context.Background(), model.NewIdempotencyKey("caller", "key", "fingerprint"))
+
+	replayed := db.NewSingleStatementResult[*model.RequestOutcome]()
+	if err := s.ReplayOutcome(ctx, replayed).Execute(ctx); err != nil {
+		t.Fatalf("ReplayOutcome() error = %v", err)
+	}
+	if got := replayed.StrictGet(); got != nil {
+		t.Errorf("ReplayOutcome() before the request = %v, want nil", got)
+	}
+
+	outcome := model.NewRequestOutcome("series_id", []model.BloggingEventKey{model.NewBloggingEventKey("event_id", "article_id")})
+	if err := s.RecordOutcome(ctx, outcome, db.NewSingleStatementResult[*model.RequestOutcome]()).Execute(ctx); err != nil {
+		t.Fatalf("RecordOutcome() error = %v", err)
+	}
+	// a retry finishing later is answered with the outcome recorded first.
+	recorded := db.NewSingleStatementResult[*model.RequestOutcome]()
+	if err := s.RecordOutcome(ctx, model.NewRequestOutcome("another", nil), recorded).Execute(ctx); err != nil {
+		t.Fatalf("RecordOutcome() error = %v", err)
+	}
+	if !reflect.DeepEqual(recorded.StrictGet(), &outcome) {
+		t.Errorf("RecordOutcome() retried = %v, want %v", recorded.StrictGet(), &outcome)
+	}
+	replayed = db.NewSingleStatementResult[*model.RequestOutcome]()
+	if err := s.ReplayOutcome(ctx, replayed).Execute(ctx); err != nil {
+		t.Fatalf("ReplayOutcome() error = %v", err)
+	}
+	if !reflect.DeepEqual(replayed.StrictGet(), &outcome) {
+		t.Errorf("ReplayOutcome() = %v, want %v", replayed.StrictGet(), &outcome)
+	}
+
+	reused := model.ContextWithIdempotencyKey(context.Background(), model.NewIdempotencyKey("caller", "key", "another"))
+	err := s.ReplayOutcome(reused, db.NewSingleStatementResult[*model.RequestOutcome]()).Execute(reused)
+	if !errors.Is(err, model.ErrIdempotencyKeyReused) {
+		t.Errorf("ReplayOutcome() error = %v, want %v", err, model.ErrIdempotencyKeyReused)
+	}
+}
+
+func TestBloggingEventCommandService_RevertArticle(t *testing.T) {
+	ctx := context.Background()
+	s, q, _ := newTestServices(t)
+	created := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	in := model.NewCreateArticleEvent("title", "content", "", nil, []string{"go"}, time.Time{}, false, "")
+	if err := s.CreateArticle(ctx, in, created).Execute(ctx); err != nil {
+		t.Fatalf("CreateArticle() error = %v", err)
+	}
+	key := *created.StrictGet()
+	updated := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	thumbnail, _ := url.Parse("https://example.com/thumbnail.png")
+	variants := []article.ImageVariant{article.NewImageVariant("https://example.com/thumbnail-640w.webp", 640, 480, "image/webp")}
+	if err := s.UpdateArticleThumbnail(ctx, model.NewUpdateArticleThumbnailEvent(key.ArticleID(), *thumbnail, variants, ""), updated).Execute(ctx); err != nil {
+		t.Fatalf("UpdateArticleThumbnail() error = %v", err)
+	}
+
+	listEvents := func() []*model.ArticleEvent {
+		t.Helper()
+		out := db.NewMultipleStatementResult[*model.ArticleEvent]()
+		if err := q.ListByArticleID(ctx, key.ArticleID(), out).Execute(ctx); err != nil {
+			t.Fatalf("ListByArticleID() error = %v", err)
+		}
+		return out.StrictGet()
+	}
+	if got := article.Project(listEvents()).ThumbnailVariants(); !reflect.DeepEqual(got, variants) {
+		t.Errorf("ThumbnailVariants() = %v, want %v", got, variants)
+	}
+	command, err := model.NewRevertArticleEvent(key.ArticleID(), key.EventID(), listEvents())
+	if err != nil {
+		t.Fatalf("NewRevertArticleEvent() error = %v", err)
+	}
+	reverted := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	if err := s.RevertArticle(ctx, command, reverted).Execute(ctx); err != nil {
+		t.Fatalf("RevertArticle() error = %v", err)
+	}
+
+	// the revision reverted to has no thumbnail, so the revert must clear it.
+	got := article.Project(listEvents())
+	if got.Thumbnail() != "" {
+		t.Errorf("Thumbnail() = %q, want empty", got.Thumbnail())
+	}
+	if len(got.ThumbnailVariants()) != 0 {
+		t.Errorf("ThumbnailVariants() = %v, want empty", got.ThumbnailVariants())
+	}
+	if got.Title() != "title" || got.Body() != "content" {
+		t.Errorf("Title(), Body() = %q, %q, want %q, %q", got.Title(), got.Body(), "title", "content")
+	}
+}
+
+func TestArticleEventQueryService_ListByArticleID(t *testing.T) {
+	ctx := context.Background()
+	s, q, _ := newTestServices(t)
+	key := createArticle(t, ctx, s, false)
+	hidden := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	if err := s.HideArticle(ctx, model.NewHideArticleEvent(key.ArticleID(), ""), hidden).Execute(ctx); err != nil {
+		t.Fatalf("HideArticle() error = %v", err)
+	}
+
+	out := db.NewMultipleStatementResult[*model.ArticleEvent]()
+	if err := q.ListByArticleID(ctx, key.ArticleID(), out).Execute(ctx); err != nil {
+		t.Fatalf("ListByArticleID() error = %v", err)
+	}
+	var got []model.ArticleEventType
+	for _, e := range out.StrictGet() {
+		got = append(got, e.EventType())
+	}
+	want := []model.ArticleEventType{model.ArticleEventTypeCreateArticle, model.ArticleEventTypeHideArticle}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListByArticleID() event types = %v, want %v", got, want)
+	}
+
+	err := q.ListByArticleID(ctx, "01JF0REBGD4QKPFGN1SX2STY4M", db.NewMultipleStatementResult[*model.ArticleEvent]()).Execute(ctx)
+	if !errors.Is(err, model.ErrNotFound) {
+		t.Errorf("ListByArticleID() error = %v, want %v", err, model.ErrNotFound)
+	}
+}
+
+func TestArticleEventQueryService_ListByArticleID_Actor(t *testing.T) {
+	ctx := context.Background()
+	s, q, _ := newTestServices(t)
+	key := createArticle(t, ctx, s, false)
+	actorCtx := model.ContextWithActor(ctx, model.NewActor("Subject1", "editor"))
+	hidden := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	if err := s.HideArticle(actorCtx, model.NewHideArticleEvent(key.ArticleID(), ""), hidden).Execute(actorCtx); err != nil {
+		t.Fatalf("HideArticle() error = %v", err)
+	}
+
+	anonymousCtx := model.ContextWithActor(ctx, model.NewActor("", ""))
+	unhidden := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	if err := s.UnhideArticle(anonymousCtx, model.NewUnhideArticleEvent(key.ArticleID(), ""), unhidden).Execute(anonymousCtx); err != nil {
+		t.Fatalf("UnhideArticle() error = %v", err)
+	}
+	entries, err := s.store.events.Entries()
+	if err != nil {
+		t.Fatalf("Entries() error = %v", err)
+	}
+	if last := entries[len(entries)-1]; last.Actor != nil || last.ActorID != nil {
+		t.Errorf("UnhideArticle() recorded actor = %v, actor_id = %v, want neither", last.Actor, last.ActorID)
+	}
+
+	out := db.NewMultipleStatementResult[*model.ArticleEvent]()
+	if err := q.ListByArticleID(ctx, key.ArticleID(), out).Execute(ctx); err != nil {
+		t.Fatalf("ListByArticleID() error = %v", err)
+	}
+	var got []string
+	for _, e := range out.StrictGet() {
+		got = append(got, e.Actor())
+	}
+	// the article was created without an actor, and unhidden by an actor nobody can tell.
+	want := []string{"", "editor", ""}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListByArticleID() actors = %v, want %v", got, want)
+	}
+}
+
+func TestDraftQueryService(t *testing.T) {
+	ctx := context.Background()
+	s, _, q := newTestServices(t)
+	createArticle(t, ctx, s, false)
+	draft := createArticle(t, ctx, s, true)
+	published := createArticle(t, ctx, s, true)
+	out := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	if err := s.PublishArticle(ctx, model.NewPublishArticleEvent(published.ArticleID(), ""), out).Execute(ctx); err != nil {
+		t.Fatalf("PublishArticle() error = %v", err)
+	}
+
+	drafts := db.NewMultipleStatementResult[*model.Draft]()
+	if err := q.List(ctx, drafts).Execute(ctx); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	want := []*model.Draft{
+		ptr(model.NewDraft(draft.ArticleID(), "title", "content", "https://example.com/thumbnail.png", []string{"go"}, draft.EventID())),
+	}
+	if !reflect.DeepEqual(drafts.StrictGet(), want) {
+		t.Errorf("List() = %v, want %v", drafts.StrictGet(), want)
+	}
+
+	err := q.GetByID(ctx, published.ArticleID(), db.NewSingleStatementResult[*model.Draft]()).Execute(ctx)
+	if !errors.Is(err, model.ErrNotFound) {
+		t.Errorf("GetByID() error = %v, want %v", err, model.ErrNotFound)
+	}
+}
+
+func TestTagQueryService(t *testing.T) {
+	ctx := context.Background()
+	s, _, _ := newTestServices(t)
+	q := NewTagQueryService(s.store)
+	visible := createArticle(t, ctx, s, false)
+	hidden := createArticle(t, ctx, s, false)
+	out := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	if err := s.HideArticle(ctx, model.NewHideArticleEvent(hidden.ArticleID(), ""), out).Execute(ctx); err != nil {
+		t.Fatalf("HideArticle() error = %v", err)
+	}
+	hiddenAt := out.StrictGet().EventID()
+	other := createArticle(t, ctx, s, false)
+	out = db.NewSingleStatementResult[*model.BloggingEventKey]()
+	if err := s.DetachTags(ctx, model.NewDetachTagsEvent(other.ArticleID(), []string{"go"}, ""), out).Execute(ctx); err != nil {
+		t.Fatalf("DetachTags() error = %v", err)
+	}
+
+	tagged := db.NewMultipleStatementResult[*model.TaggedArticle]()
+	if err := q.ListTaggedArticles(ctx, []string{"go"}, tagged).Execute(ctx); err != nil {
+		t.Fatalf("ListTaggedArticles() error = %v", err)
+	}
+	want := []*model.TaggedArticle{
+		ptr(model.NewTaggedArticle(visible.ArticleID(), []string{"go"}, visible.EventID())),
+		ptr(model.NewTaggedArticle(hidden.ArticleID(), []string{"go"}, hiddenAt)),
+	}
+	if !reflect.DeepEqual(tagged.StrictGet(), want) {
+		t.Errorf("ListTaggedArticles() = %v, want %v", tagged.StrictGet(), want)
+	}
+
+	// tags are renamed on hidden articles as well.
+	for _, article := range tagged.StrictGet() {
+		in := model.NewRenameTagEvent(article.ArticleID(), "go", "Go", article.LastEventID())
+		if err := s.RenameTag(ctx, in, db.NewSingleStatementResult[*model.BloggingEventKey]()).Execute(ctx); err != nil {
+			t.Fatalf("RenameTag() error = %v", err)
+		}
+	}
+	renamed := db.NewMultipleStatementResult[*model.TaggedArticle]()
+	if err := q.ListTaggedArticles(ctx, []string{"go"}, renamed).Execute(ctx); err != nil {
+		t.Fatalf("ListTaggedArticles() error = %v", err)
+	}
+	if got := len(renamed.StrictGet()); got != 0 {
+		t.Errorf("ListTaggedArticles() returned %d articles after the rename, want none", got)
+	}
+}
+
+func TestSeriesQueryService(t *testing.T) {
+	ctx := context.Background()
+	s, _, _ := newTestServices(t)
+	q := NewSeriesQueryService(s.store)
+	first := createArticle(t, ctx, s, false)
+	second := createArticle(t, ctx, s, false)
+	out := db.NewSingleStatementResult[*model.BloggingEventKey]()
+	if err := s.HideArticle(ctx, model.NewHideArticleEvent(second.ArticleID(), ""), out).Execute(ctx); err != nil {
+		t.Fatalf("HideArticle() error = %v", err)
+	}
+	createArticle(t, ctx, s, false)
+
+	// the series is written to hidden articles as well.
+	lastEventIDs := make(map[string]string)
+	for i, article := range []model.BloggingEventKey{second, first} {
+		got := db.NewSingleStatementResult[*model.SeriesArticle]()
+		if err := q.GetSeriesArticle(ctx, article.ArticleID(), got).Execute(ctx); err != nil {
+			t.Fatalf("GetSeriesArticle() error = %v", err)
+		}
+		in := model.NewAddToSeriesEvent(article.ArticleID(), model.NewArticleSeries("series_id", "Go in Practice", i+1), got.StrictGet().LastEventID())
+		out := db.NewSingleStatementResult[*model.BloggingEventKey]()
+		if err := s.AddToSeries(ctx, in, out).Execute(ctx); err != nil {
+			t.Fatalf("AddToSeries() error = %v", err)
+		}
+		lastEventIDs[article.ArticleID()] = out.StrictGet().EventID()
+	}
+
+	parts := db.NewMultipleStatementResult[*model.SeriesArticle]()
+	if err := q.ListSeriesArticles(ctx, "series_id", parts).Execute(ctx); err != nil {
+		t.Fatalf("ListSeriesArticles() error = %v", err)
+	}
+	want := []*model.SeriesArticle{
+		ptr(model.NewSeriesArticle(second.ArticleID(), model.NewArticleSeries("series_id", "Go in Practice", 1), lastEventIDs[second.ArticleID()])),
+		ptr(model.NewSeriesArticle(first.ArticleID(), model.NewArticleSeries("series_id", "Go in Practice", 2), lastEventIDs[first.ArticleID()])),
+	}
+	if !reflect.DeepEqual(parts.StrictGet(), want) {
+		t.Errorf("ListSeriesArticles() = %v, want %v", parts.StrictGet(), want)
+	}
+
+	missing := db.NewSingleStatementResult[*model.SeriesArticle]()
+	if err := q.GetSeriesArticle(ctx, "missing", missing).Execute(ctx); !errors.Is(err, model.ErrNotFound) {
+		t.Errorf("GetSeriesArticle() error = %v, want %v", err, model.ErrNotFound)
+	}
+}
@@ -0,0 +1,116 @@
+package local
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/local/eventlog"
+	"blogapi.miyamo.today/core/article"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+// DraftQueryService reads draft articles from the local event store.
+type DraftQueryService struct {
+	store *Store
+}
+
+func (s *DraftQueryService) GetByID(ctx context.Context, articleID string, out *db.SingleStatementResult[*model.Draft]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("DraftQueryService#GetByID").End()
+	return newStatement(func(ctx context.Context, out db.StatementResult) error {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("DraftQueryService#GetByID#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		entries, err := s.store.events.Entries()
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		head, ok := streamHeads(entries)[articleID]
+		if !ok || !head.draft {
+			return errors.WithStack(model.ErrNotFound)
+		}
+
+		draft, err := fold(entries, articleID, head)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+
+		out.Set(&draft)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+func (s *DraftQueryService) List(ctx context.Context, out *db.MultipleStatementResult[*model.Draft]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("DraftQueryService#List").End()
+	return newStatement(func(ctx context.Context, out db.StatementResult) error {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("DraftQueryService#List#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		entries, err := s.store.events.Entries()
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		heads := streamHeads(entries)
+		articleIDs := make([]string, 0, len(heads))
+		for articleID, head := range heads {
+			if head.draft {
+				articleIDs = append(articleIDs, articleID)
+			}
+		}
+		// the newest draft comes first, since ULIDs are lexicographically sortable.
+		slices.SortFunc(articleIDs, func(a, b string) int {
+			return strings.Compare(b, a)
+		})
+
+		drafts := make([]*model.Draft, 0, len(articleIDs))
+		for _, articleID := range articleIDs {
+			draft, err := fold(entries, articleID, heads[articleID])
+			if err != nil {
+				err = errors.WithStack(err)
+				nrtx.NoticeError(nrpkgerrors.Wrap(err))
+				return err
+			}
+			drafts = append(drafts, &draft)
+		}
+
+		out.Set(drafts)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+// fold builds the draft by applying the events of the article in order.
+func fold(entries []eventlog.BloggingEvent, articleID string, head streamHead) (model.Draft, error) {
+	rows := eventsOf(entries, articleID)
+	events := make([]model.ArticleEvent, 0, len(rows))
+	for _, row := range rows {
+		event, err := articleEventFromEntry(row)
+		if err != nil {
+			return model.Draft{}, err
+		}
+		events = append(events, event)
+	}
+	projection := article.Project(events)
+	return model.NewDraft(articleID, projection.Title(), projection.Body(), projection.Thumbnail(), projection.TagNames(), head.lastEventID), nil
+}
+
+func NewDraftQueryService(store *Store) *DraftQueryService {
+	return &DraftQueryService{store: store}
+}
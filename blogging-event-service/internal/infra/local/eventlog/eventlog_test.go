@@ -0,0 +1,121 @@
+package eventlog
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+type entry struct {
+	ID string `json:"id"`
+}
+
+func appendEntries(t *testing.T, l *Log[entry], entries ...entry) {
+	t.Helper()
+	err := l.Append(func([]entry) ([]entry, error) {
+		return entries, nil
+	})
+	if err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+}
+
+func TestLog_Append(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events", "log.jsonl")
+	l, err := Open[entry](path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	appendEntries(t, l, entry{ID: "1"}, entry{ID: "2"})
+	appendEntries(t, l, entry{ID: "3"})
+
+	got, err := l.Entries()
+	if err != nil {
+		t.Fatalf("Entries() error = %v", err)
+	}
+	want := []entry{{ID: "1"}, {ID: "2"}, {ID: "3"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Entries() = %v, want %v", got, want)
+	}
+}
+
+func TestLog_Append_Rejected(t *testing.T) {
+	l, err := Open[entry](filepath.Join(t.TempDir(), "log.jsonl"))
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	appendEntries(t, l, entry{ID: "1"})
+
+	errRejected := errors.New("rejected")
+	var seen []entry
+	err = l.Append(func(entries []entry) ([]entry, error) {
+		seen = entries
+		return []entry{{ID: "2"}}, errRejected
+	})
+	if !errors.Is(err, errRejected) {
+		t.Errorf("Append() error = %v, want %v", err, errRejected)
+	}
+	if want := []entry{{ID: "1"}}; !reflect.DeepEqual(seen, want) {
+		t.Errorf("fn received %v, want %v", seen, want)
+	}
+	got, _ := l.Entries()
+	if want := []entry{{ID: "1"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Entries() = %v, want %v", got, want)
+	}
+}
+
+func TestLog_EntriesFrom(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log.jsonl")
+	writer, err := Open[entry](path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	reader, err := Open[entry](path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	appendEntries(t, writer, entry{ID: "1"}, entry{ID: "2"})
+
+	got, err := reader.EntriesFrom(1)
+	if err != nil {
+		t.Fatalf("EntriesFrom() error = %v", err)
+	}
+	if want := []entry{{ID: "2"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("EntriesFrom(1) = %v, want %v", got, want)
+	}
+	got, err = reader.EntriesFrom(2)
+	if err != nil {
+		t.Fatalf("EntriesFrom() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("EntriesFrom(2) = %v, want none", got)
+	}
+}
+
+func TestLog_PartialLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log.jsonl")
+	if err := os.WriteFile(path, []byte(`{"id":"1"}`+"\n"+`{"id":`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	l, err := Open[entry](path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	got, _ := l.Entries()
+	if want := []entry{{ID: "1"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Entries() = %v, want %v", got, want)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString(`"2"}` + "\n")
+	_ = f.Close()
+	got, _ = l.Entries()
+	if want := []entry{{ID: "1"}, {ID: "2"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Entries() = %v, want %v", got, want)
+	}
+}
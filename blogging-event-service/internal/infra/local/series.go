@@ -0,0 +1,119 @@
+package local
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/local/eventlog"
+	"blogapi.miyamo.today/core/article"
+	"blogapi.miyamo.today/core/db"
+	"cmp"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+// SeriesQueryService reads the series of articles from the local event store.
+type SeriesQueryService struct {
+	store *Store
+}
+
+func (s *SeriesQueryService) ListSeriesArticles(ctx context.Context, seriesID string, out *db.MultipleStatementResult[*model.SeriesArticle]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("SeriesQueryService#ListSeriesArticles").End()
+	return newStatement(func(ctx context.Context, out db.StatementResult) error {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("SeriesQueryService#ListSeriesArticles#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		entries, err := s.store.events.Entries()
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		heads := streamHeads(entries)
+		articleIDs := make([]string, 0, len(heads))
+		for articleID := range heads {
+			articleIDs = append(articleIDs, articleID)
+		}
+		slices.SortFunc(articleIDs, strings.Compare)
+
+		articles := make([]*model.SeriesArticle, 0)
+		for _, articleID := range articleIDs {
+			series, err := seriesOf(entries, articleID)
+			if err != nil {
+				err = errors.WithStack(err)
+				nrtx.NoticeError(nrpkgerrors.Wrap(err))
+				return err
+			}
+			if series.ID() != seriesID {
+				continue
+			}
+			part := model.NewSeriesArticle(articleID, series, heads[articleID].lastEventID)
+			articles = append(articles, &part)
+		}
+		slices.SortStableFunc(articles, func(a, b *model.SeriesArticle) int {
+			return cmp.Compare(a.Series().Position(), b.Series().Position())
+		})
+
+		out.Set(articles)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+func (s *SeriesQueryService) GetSeriesArticle(ctx context.Context, articleID string, out *db.SingleStatementResult[*model.SeriesArticle]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("SeriesQueryService#GetSeriesArticle").End()
+	return newStatement(func(ctx context.Context, out db.StatementResult) error {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("SeriesQueryService#GetSeriesArticle#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		entries, err := s.store.events.Entries()
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		head, ok := streamHeads(entries)[articleID]
+		if !ok {
+			return errors.WithStack(model.ErrNotFound)
+		}
+		series, err := seriesOf(entries, articleID)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		part := model.NewSeriesArticle(articleID, series, head.lastEventID)
+
+		out.Set(&part)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+// seriesOf folds the events of the article into the series it is a part of.
+func seriesOf(entries []eventlog.BloggingEvent, articleID string) (model.ArticleSeries, error) {
+	rows := eventsOf(entries, articleID)
+	events := make([]model.ArticleEvent, 0, len(rows))
+	for _, row := range rows {
+		event, err := articleEventFromEntry(row)
+		if err != nil {
+			return model.ArticleSeries{}, err
+		}
+		events = append(events, event)
+	}
+	series := article.Project(events).Series()
+	return model.NewArticleSeries(series.ID(), series.Title(), series.Position()), nil
+}
+
+func NewSeriesQueryService(store *Store) *SeriesQueryService {
+	return &SeriesQueryService{store: store}
+}
@@ -0,0 +1,123 @@
+package local
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/infra/local/eventlog"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"path/filepath"
+)
+
+var ErrAlreadyExecuted = errors.New("statement is already executed.")
+
+// Store holds the logs of the local event store.
+type Store struct {
+	events      *eventlog.Log[eventlog.BloggingEvent]
+	idempotency *eventlog.Log[idempotencyRecord]
+}
+
+// idempotencyRecord remembers the event written for an idempotency key of a caller,
+// or the outcome of a request written as an event per article.
+type idempotencyRecord struct {
+	IdempotencyKey string                    `json:"idempotency_key"`
+	Fingerprint    string                    `json:"fingerprint"`
+	EventID        string                    `json:"event_id,omitempty"`
+	ArticleID      string                    `json:"article_id,omitempty"`
+	SubjectID      string                    `json:"subject_id,omitempty"`
+	Events         []idempotencyOutcomeEvent `json:"events,omitempty"`
+}
+
+// idempotencyOutcomeEvent is the key of an event written by the request of an outcome.
+type idempotencyOutcomeEvent struct {
+	EventID   string `json:"event_id"`
+	ArticleID string `json:"article_id"`
+}
+
+// Open opens the local event store kept in dir, creating it if it does not exist.
+func Open(dir string) (*Store, error) {
+	events, err := eventlog.Open[eventlog.BloggingEvent](filepath.Join(dir, eventlog.BloggingEventsFile))
+	if err != nil {
+		return nil, err
+	}
+	idempotency, err := eventlog.Open[idempotencyRecord](filepath.Join(dir, "idempotency_keys.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+	return &Store{
+		events:      events,
+		idempotency: idempotency,
+	}, nil
+}
+
+// statement is a db.Statement run against the local event store.
+type statement struct {
+	out      db.StatementResult
+	function func(ctx context.Context, out db.StatementResult) error
+	executed bool
+}
+
+func (s *statement) Execute(ctx context.Context, _ ...db.ExecuteOption) error {
+	if s.executed {
+		return ErrAlreadyExecuted
+	}
+	defer func() { s.executed = true }()
+	return s.function(ctx, s.out)
+}
+
+func (s *statement) Result() db.StatementResult {
+	return s.out
+}
+
+func newStatement(fn func(ctx context.Context, out db.StatementResult) error, out db.StatementResult) db.Statement {
+	return &statement{function: fn, out: out}
+}
+
+// streamHead is the latest state of an article in the log.
+type streamHead struct {
+	lastEventID string
+	invisible   bool
+	draft       bool
+}
+
+// streamHeads returns the stream head of every article in the log.
+func streamHeads(entries []eventlog.BloggingEvent) map[string]streamHead {
+	heads := make(map[string]streamHead)
+	for _, e := range entries {
+		head := heads[e.ArticleID]
+		head.lastEventID = e.EventID
+		if e.Invisible != nil {
+			head.invisible = *e.Invisible
+		}
+		if e.Draft != nil {
+			head.draft = *e.Draft
+		}
+		heads[e.ArticleID] = head
+	}
+	return heads
+}
+
+// slugOwners returns the article each slug in the log was given to first.
+// Articles keep the slugs they had before, so that they can be redirected to the current one.
+func slugOwners(entries []eventlog.BloggingEvent) map[string]string {
+	owners := make(map[string]string)
+	for _, e := range entries {
+		if e.Slug == nil {
+			continue
+		}
+		if _, ok := owners[*e.Slug]; !ok {
+			owners[*e.Slug] = e.ArticleID
+		}
+	}
+	return owners
+}
+
+// eventsOf returns the events of the article, oldest first.
+func eventsOf(entries []eventlog.BloggingEvent, articleID string) []eventlog.BloggingEvent {
+	events := make([]eventlog.BloggingEvent, 0)
+	for _, e := range entries {
+		if e.ArticleID == articleID {
+			events = append(events, e)
+		}
+	}
+	return events
+}
@@ -0,0 +1,75 @@
+package local
+
+import (
+	"blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	"blogapi.miyamo.today/core/article"
+	"blogapi.miyamo.today/core/db"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+	"slices"
+	"strings"
+)
+
+// TagQueryService reads the tags of articles from the local event store.
+type TagQueryService struct {
+	store *Store
+}
+
+func (s *TagQueryService) ListTaggedArticles(ctx context.Context, tagNames []string, out *db.MultipleStatementResult[*model.TaggedArticle]) db.Statement {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("TagQueryService#ListTaggedArticles").End()
+	return newStatement(func(ctx context.Context, out db.StatementResult) error {
+		nrtx := newrelic.FromContext(ctx)
+		defer nrtx.StartSegment("TagQueryService#ListTaggedArticles#Execute").End()
+		logger := slog.Default()
+		logger.Info("START")
+
+		entries, err := s.store.events.Entries()
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			return err
+		}
+		heads := streamHeads(entries)
+		articleIDs := make([]string, 0, len(heads))
+		for articleID := range heads {
+			articleIDs = append(articleIDs, articleID)
+		}
+		// the oldest article comes first, since ULIDs are lexicographically sortable.
+		slices.SortFunc(articleIDs, strings.Compare)
+
+		articles := make([]*model.TaggedArticle, 0)
+		for _, articleID := range articleIDs {
+			rows := eventsOf(entries, articleID)
+			events := make([]model.ArticleEvent, 0, len(rows))
+			for _, row := range rows {
+				event, err := articleEventFromEntry(row)
+				if err != nil {
+					err = errors.WithStack(err)
+					nrtx.NoticeError(nrpkgerrors.Wrap(err))
+					return err
+				}
+				events = append(events, event)
+			}
+			projection := article.Project(events)
+			if !slices.ContainsFunc(projection.TagNames(), func(v string) bool {
+				return slices.Contains(tagNames, v)
+			}) {
+				continue
+			}
+			tagged := model.NewTaggedArticle(articleID, projection.TagNames(), heads[articleID].lastEventID)
+			articles = append(articles, &tagged)
+		}
+
+		out.Set(articles)
+		logger.Info("END")
+		return nil
+	}, out)
+}
+
+func NewTagQueryService(store *Store) *TagQueryService {
+	return &TagQueryService{store: store}
+}
@@ -11,4 +11,25 @@ type Client interface {
 	// PutObject uploads an object to an Uploader bucket.
 	// See https://docs.aws.amazon.com/sdk-for-go/api/service/s3/#S3.PutObject
 	PutObject(ctx context.Context, params *awss3.PutObjectInput, optFns ...func(*awss3.Options)) (*awss3.PutObjectOutput, error)
+	// CreateMultipartUpload starts a multipart upload.
+	// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_CreateMultipartUpload.html
+	CreateMultipartUpload(ctx context.Context, params *awss3.CreateMultipartUploadInput, optFns ...func(*awss3.Options)) (*awss3.CreateMultipartUploadOutput, error)
+	// UploadPart uploads a part of a multipart upload.
+	// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_UploadPart.html
+	UploadPart(ctx context.Context, params *awss3.UploadPartInput, optFns ...func(*awss3.Options)) (*awss3.UploadPartOutput, error)
+	// CompleteMultipartUpload assembles the uploaded parts into an object.
+	// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_CompleteMultipartUpload.html
+	CompleteMultipartUpload(ctx context.Context, params *awss3.CompleteMultipartUploadInput, optFns ...func(*awss3.Options)) (*awss3.CompleteMultipartUploadOutput, error)
+	// AbortMultipartUpload discards the parts of a multipart upload.
+	// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_AbortMultipartUpload.html
+	AbortMultipartUpload(ctx context.Context, params *awss3.AbortMultipartUploadInput, optFns ...func(*awss3.Options)) (*awss3.AbortMultipartUploadOutput, error)
+	// HeadObject returns the metadata of an object.
+	// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_HeadObject.html
+	HeadObject(ctx context.Context, params *awss3.HeadObjectInput, optFns ...func(*awss3.Options)) (*awss3.HeadObjectOutput, error)
+	// CopyObject copies an object within the bucket.
+	// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_CopyObject.html
+	CopyObject(ctx context.Context, params *awss3.CopyObjectInput, optFns ...func(*awss3.Options)) (*awss3.CopyObjectOutput, error)
+	// DeleteObject deletes an object.
+	// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteObject.html
+	DeleteObject(ctx context.Context, params *awss3.DeleteObjectInput, optFns ...func(*awss3.Options)) (*awss3.DeleteObjectOutput, error)
 }
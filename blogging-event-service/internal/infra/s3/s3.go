@@ -2,26 +2,32 @@ package s3
 
 import (
 	"blogapi.miyamo.today/core/log"
-	"bytes"
 	"context"
 	"fmt"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/cockroachdb/errors"
 	"github.com/miyamo2/altnrslog"
 	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"io"
 	"log/slog"
 	"net/url"
 	"os"
 
 	s3sdk "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 )
 
 type Uploader struct {
 	client Client
 }
 
-func (s *Uploader) Upload(ctx context.Context, name string, data []byte, contentType string) (url *url.URL, err error) {
+// partConcurrency is the number of parts uploaded at once.
+// At most PartSize times one more than it is held in memory for an upload, whatever the size of the file.
+const partConcurrency = 2
+
+func (s *Uploader) Upload(ctx context.Context, name string, body io.Reader, contentType string) (url *url.URL, err error) {
 	nrtx := newrelic.FromContext(ctx)
 	defer nrtx.StartSegment("Upload").End()
 
@@ -42,10 +48,16 @@ func (s *Uploader) Upload(ctx context.Context, name string, data []byte, content
 		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("*url.URL", fmt.Sprintf("%+v", *url))))
 	}()
 
-	_, err = s.client.PutObject(ctx, &s3sdk.PutObjectInput{
+	// files smaller than a part are put at once, the others are uploaded in parts as they are read.
+	// The parts of a failed upload are aborted by the manager.
+	uploader := manager.NewUploader(s.client, func(u *manager.Uploader) {
+		u.PartSize = manager.MinUploadPartSize
+		u.Concurrency = partConcurrency
+	})
+	_, err = uploader.Upload(ctx, &s3sdk.PutObjectInput{
 		Bucket:      aws.String(os.Getenv("S3_BUCKET")),
 		Key:         aws.String(name),
-		Body:        bytes.NewBuffer(data),
+		Body:        body,
 		ContentType: aws.String(contentType),
 	})
 	if err != nil {
@@ -57,6 +69,69 @@ func (s *Uploader) Upload(ctx context.Context, name string, data []byte, content
 	return uri, nil
 }
 
+func (s *Uploader) Rename(ctx context.Context, src, dst string) (uri *url.URL, existed bool, err error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Rename").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	defer func() {
+		if err != nil {
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*url.URL", nil),
+					slog.Bool("existed", false),
+					slog.Any("error", err)))
+			return
+		}
+		logger.InfoContext(ctx, "END", slog.Group("return", slog.Any("*url.URL", fmt.Sprintf("%+v", *uri)), slog.Bool("existed", existed)))
+	}()
+
+	bucket := os.Getenv("S3_BUCKET")
+	_, err = s.client.HeadObject(ctx, &s3sdk.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(dst),
+	})
+	var notFound *types.NotFound
+	switch {
+	case err == nil:
+		existed = true
+	case errors.As(err, &notFound):
+		// S3 has no way to move an object, so it is copied and deleted.
+		_, err = s.client.CopyObject(ctx, &s3sdk.CopyObjectInput{
+			Bucket:     aws.String(bucket),
+			Key:        aws.String(dst),
+			CopySource: aws.String(fmt.Sprintf("%s/%s", bucket, src)),
+		})
+		if err != nil {
+			err = errors.WithStack(err)
+			return nil, false, err
+		}
+	default:
+		err = errors.WithStack(err)
+		return nil, false, err
+	}
+	_, err = s.client.DeleteObject(ctx, &s3sdk.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(src),
+	})
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, false, err
+	}
+
+	uri, err = url.Parse(fmt.Sprintf("%s/%s", os.Getenv("CDN_HOST"), dst))
+	if err != nil {
+		err = errors.WithStack(err)
+		return nil, false, err
+	}
+	return uri, existed, nil
+}
+
 // NewUploader creates a new Uploader
 func NewUploader(client Client) *Uploader {
 	return &Uploader{
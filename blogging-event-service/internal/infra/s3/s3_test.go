@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/cockroachdb/errors"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -15,14 +17,29 @@ import (
 	"io"
 	"net/url"
 	"reflect"
+	"sync/atomic"
 	"testing"
+	"testing/iotest"
 )
 
+var (
+	errS3   = errors.New("s3 failed")
+	errBody = errors.New("body failed")
+)
+
+// zeros is an endless stream of zero bytes.
+type zeros struct{}
+
+func (zeros) Read(b []byte) (int, error) {
+	clear(b)
+	return len(b), nil
+}
+
 func TestUploader_Upload(t *testing.T) {
 	type args struct {
 		ctx         context.Context
 		name        string
-		data        []byte
+		body        io.Reader
 		contentType string
 	}
 	type want struct {
@@ -39,7 +56,7 @@ func TestUploader_Upload(t *testing.T) {
 			args: args{
 				ctx:         context.Background(),
 				name:        "example.png",
-				data:        []byte("abcd"),
+				body:        bytes.NewReader([]byte("abcd")),
 				contentType: "image/png",
 			},
 			want: want{
@@ -54,7 +71,101 @@ func TestUploader_Upload(t *testing.T) {
 						Body:        bytes.NewBuffer([]byte("abcd")),
 						ContentType: aws.String("image/png"),
 					}), gomock.Any()).
-					Return(nil, nil).
+					Return(&awss3.PutObjectOutput{}, nil).
+					Times(1)
+			},
+		},
+		"happy_path/multipart": {
+			args: args{
+				ctx:         context.Background(),
+				name:        "example.png",
+				body:        io.LimitReader(zeros{}, manager.MinUploadPartSize+1),
+				contentType: "image/png",
+			},
+			want: want{
+				uri: pkg.MustParseURL("https://example.com/example.png"),
+				err: nil,
+			},
+			setupMockS3Client: func(client *s3.MockClient) {
+				client.EXPECT().
+					CreateMultipartUpload(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, params *awss3.CreateMultipartUploadInput, _ ...func(*awss3.Options)) (*awss3.CreateMultipartUploadOutput, error) {
+						if aws.ToString(params.Bucket) != "example" || aws.ToString(params.Key) != "example.png" || aws.ToString(params.ContentType) != "image/png" {
+							t.Errorf("CreateMultipartUpload() got = %+v", params)
+						}
+						return &awss3.CreateMultipartUploadOutput{UploadId: aws.String("upload")}, nil
+					}).
+					Times(1)
+				var uploaded atomic.Int64
+				client.EXPECT().
+					UploadPart(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, params *awss3.UploadPartInput, _ ...func(*awss3.Options)) (*awss3.UploadPartOutput, error) {
+						n, err := io.Copy(io.Discard, params.Body)
+						if err != nil {
+							return nil, err
+						}
+						uploaded.Add(n)
+						return &awss3.UploadPartOutput{ETag: aws.String(fmt.Sprintf("part-%d", aws.ToInt32(params.PartNumber)))}, nil
+					}).
+					Times(2)
+				client.EXPECT().
+					CompleteMultipartUpload(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, params *awss3.CompleteMultipartUploadInput, _ ...func(*awss3.Options)) (*awss3.CompleteMultipartUploadOutput, error) {
+						if len(params.MultipartUpload.Parts) != 2 {
+							t.Errorf("CompleteMultipartUpload() got %d parts, want 2", len(params.MultipartUpload.Parts))
+						}
+						if got := uploaded.Load(); got != manager.MinUploadPartSize+1 {
+							t.Errorf("uploaded %d bytes, want %d", got, manager.MinUploadPartSize+1)
+						}
+						return &awss3.CompleteMultipartUploadOutput{}, nil
+					}).
+					Times(1)
+			},
+		},
+		"unhappy_path/put_object_fails": {
+			args: args{
+				ctx:         context.Background(),
+				name:        "example.png",
+				body:        bytes.NewReader([]byte("abcd")),
+				contentType: "image/png",
+			},
+			want: want{
+				err: errS3,
+			},
+			setupMockS3Client: func(client *s3.MockClient) {
+				client.EXPECT().
+					PutObject(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errS3).
+					Times(1)
+			},
+		},
+		"unhappy_path/body_fails": {
+			args: args{
+				ctx:         context.Background(),
+				name:        "example.png",
+				body:        io.MultiReader(io.LimitReader(zeros{}, manager.MinUploadPartSize), iotest.ErrReader(errBody)),
+				contentType: "image/png",
+			},
+			want: want{
+				err: errBody,
+			},
+			setupMockS3Client: func(client *s3.MockClient) {
+				client.EXPECT().
+					CreateMultipartUpload(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&awss3.CreateMultipartUploadOutput{UploadId: aws.String("upload")}, nil).
+					Times(1)
+				client.EXPECT().
+					UploadPart(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&awss3.UploadPartOutput{ETag: aws.String("part-1")}, nil).
+					AnyTimes()
+				client.EXPECT().
+					AbortMultipartUpload(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, params *awss3.AbortMultipartUploadInput, _ ...func(*awss3.Options)) (*awss3.AbortMultipartUploadOutput, error) {
+						if aws.ToString(params.UploadId) != "upload" {
+							t.Errorf("AbortMultipartUpload() got UploadId = %s, want upload", aws.ToString(params.UploadId))
+						}
+						return &awss3.AbortMultipartUploadOutput{}, nil
+					}).
 					Times(1)
 			},
 		},
@@ -69,7 +180,7 @@ func TestUploader_Upload(t *testing.T) {
 			tt.setupMockS3Client(client)
 
 			u := NewUploader(client)
-			uri, err := u.Upload(tt.args.ctx, tt.args.name, tt.args.data, tt.args.contentType)
+			uri, err := u.Upload(tt.args.ctx, tt.args.name, tt.args.body, tt.args.contentType)
 			if !errors.Is(err, tt.want.err) {
 				t.Errorf("Upload() = %v, want %v", err, tt.want)
 			}
@@ -80,6 +191,116 @@ func TestUploader_Upload(t *testing.T) {
 	}
 }
 
+func TestUploader_Rename(t *testing.T) {
+	type want struct {
+		uri     *url.URL
+		existed bool
+		err     error
+	}
+	type testCase struct {
+		want              want
+		setupMockS3Client func(client *s3.MockClient)
+	}
+	head := &awss3.HeadObjectInput{
+		Bucket: aws.String("example"),
+		Key:    aws.String("abcd.png"),
+	}
+	del := &awss3.DeleteObjectInput{
+		Bucket: aws.String("example"),
+		Key:    aws.String("staging/example.png"),
+	}
+	tests := map[string]testCase{
+		"happy_path/moved": {
+			want: want{
+				uri: pkg.MustParseURL("https://example.com/abcd.png"),
+			},
+			setupMockS3Client: func(client *s3.MockClient) {
+				gomock.InOrder(
+					client.EXPECT().
+						HeadObject(gomock.Any(), head).
+						Return(nil, &types.NotFound{}).
+						Times(1),
+					client.EXPECT().
+						CopyObject(gomock.Any(), &awss3.CopyObjectInput{
+							Bucket:     aws.String("example"),
+							Key:        aws.String("abcd.png"),
+							CopySource: aws.String("example/staging/example.png"),
+						}).
+						Return(&awss3.CopyObjectOutput{}, nil).
+						Times(1),
+					client.EXPECT().
+						DeleteObject(gomock.Any(), del).
+						Return(&awss3.DeleteObjectOutput{}, nil).
+						Times(1),
+				)
+			},
+		},
+		"happy_path/existed": {
+			want: want{
+				uri:     pkg.MustParseURL("https://example.com/abcd.png"),
+				existed: true,
+			},
+			setupMockS3Client: func(client *s3.MockClient) {
+				client.EXPECT().
+					HeadObject(gomock.Any(), head).
+					Return(&awss3.HeadObjectOutput{}, nil).
+					Times(1)
+				client.EXPECT().
+					DeleteObject(gomock.Any(), del).
+					Return(&awss3.DeleteObjectOutput{}, nil).
+					Times(1)
+			},
+		},
+		"unhappy_path/head_fails": {
+			want: want{
+				err: errS3,
+			},
+			setupMockS3Client: func(client *s3.MockClient) {
+				client.EXPECT().
+					HeadObject(gomock.Any(), head).
+					Return(nil, errS3).
+					Times(1)
+			},
+		},
+		"unhappy_path/copy_fails": {
+			want: want{
+				err: errS3,
+			},
+			setupMockS3Client: func(client *s3.MockClient) {
+				client.EXPECT().
+					HeadObject(gomock.Any(), head).
+					Return(nil, &types.NotFound{}).
+					Times(1)
+				client.EXPECT().
+					CopyObject(gomock.Any(), gomock.Any()).
+					Return(nil, errS3).
+					Times(1)
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("S3_BUCKET", "example")
+			t.Setenv("CDN_HOST", "https://example.com")
+
+			client := s3.NewMockClient(gomock.NewController(t))
+			tt.setupMockS3Client(client)
+
+			u := NewUploader(client)
+			uri, existed, err := u.Rename(context.Background(), "staging/example.png", "abcd.png")
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Rename() error = %v, want %v", err, tt.want.err)
+			}
+			if !reflect.DeepEqual(uri, tt.want.uri) {
+				t.Errorf("Rename() uri = %v, want %v", uri, tt.want.uri)
+			}
+			if existed != tt.want.existed {
+				t.Errorf("Rename() existed = %v, want %v", existed, tt.want.existed)
+			}
+		})
+	}
+}
+
 type PutObjectInputMatcher struct {
 	gomock.Matcher
 	expect *awss3.PutObjectInput
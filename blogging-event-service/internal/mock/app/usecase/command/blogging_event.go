@@ -42,6 +42,20 @@ func (m *MockBloggingEventService) EXPECT() *MockBloggingEventServiceMockRecorde
 	return m.recorder
 }
 
+// AddToSeries mocks base method.
+func (m *MockBloggingEventService) AddToSeries(ctx context.Context, command model.AddToSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddToSeries", ctx, command, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// AddToSeries indicates an expected call of AddToSeries.
+func (mr *MockBloggingEventServiceMockRecorder) AddToSeries(ctx, command, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToSeries", reflect.TypeOf((*MockBloggingEventService)(nil).AddToSeries), ctx, command, out)
+}
+
 // AttachTags mocks base method.
 func (m *MockBloggingEventService) AttachTags(ctx context.Context, command model.AttachTagsEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
 	m.ctrl.T.Helper()
@@ -84,6 +98,160 @@ func (mr *MockBloggingEventServiceMockRecorder) DetachTags(ctx, command, out any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachTags", reflect.TypeOf((*MockBloggingEventService)(nil).DetachTags), ctx, command, out)
 }
 
+// EditArticle mocks base method.
+func (m *MockBloggingEventService) EditArticle(ctx context.Context, command model.EditArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditArticle", ctx, command, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// EditArticle indicates an expected call of EditArticle.
+func (mr *MockBloggingEventServiceMockRecorder) EditArticle(ctx, command, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditArticle", reflect.TypeOf((*MockBloggingEventService)(nil).EditArticle), ctx, command, out)
+}
+
+// HideArticle mocks base method.
+func (m *MockBloggingEventService) HideArticle(ctx context.Context, command model.HideArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideArticle", ctx, command, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// HideArticle indicates an expected call of HideArticle.
+func (mr *MockBloggingEventServiceMockRecorder) HideArticle(ctx, command, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideArticle", reflect.TypeOf((*MockBloggingEventService)(nil).HideArticle), ctx, command, out)
+}
+
+// MergeTags mocks base method.
+func (m *MockBloggingEventService) MergeTags(ctx context.Context, command model.MergeTagsEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", ctx, command, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockBloggingEventServiceMockRecorder) MergeTags(ctx, command, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockBloggingEventService)(nil).MergeTags), ctx, command, out)
+}
+
+// PublishArticle mocks base method.
+func (m *MockBloggingEventService) PublishArticle(ctx context.Context, command model.PublishArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishArticle", ctx, command, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// PublishArticle indicates an expected call of PublishArticle.
+func (mr *MockBloggingEventServiceMockRecorder) PublishArticle(ctx, command, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishArticle", reflect.TypeOf((*MockBloggingEventService)(nil).PublishArticle), ctx, command, out)
+}
+
+// RecordOutcome mocks base method.
+func (m *MockBloggingEventService) RecordOutcome(ctx context.Context, outcome model.RequestOutcome, out *db.SingleStatementResult[*model.RequestOutcome]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordOutcome", ctx, outcome, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// RecordOutcome indicates an expected call of RecordOutcome.
+func (mr *MockBloggingEventServiceMockRecorder) RecordOutcome(ctx, outcome, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOutcome", reflect.TypeOf((*MockBloggingEventService)(nil).RecordOutcome), ctx, outcome, out)
+}
+
+// RenameTag mocks base method.
+func (m *MockBloggingEventService) RenameTag(ctx context.Context, command model.RenameTagEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", ctx, command, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockBloggingEventServiceMockRecorder) RenameTag(ctx, command, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockBloggingEventService)(nil).RenameTag), ctx, command, out)
+}
+
+// ReorderSeries mocks base method.
+func (m *MockBloggingEventService) ReorderSeries(ctx context.Context, command model.ReorderSeriesEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderSeries", ctx, command, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// ReorderSeries indicates an expected call of ReorderSeries.
+func (mr *MockBloggingEventServiceMockRecorder) ReorderSeries(ctx, command, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderSeries", reflect.TypeOf((*MockBloggingEventService)(nil).ReorderSeries), ctx, command, out)
+}
+
+// ReplayOutcome mocks base method.
+func (m *MockBloggingEventService) ReplayOutcome(ctx context.Context, out *db.SingleStatementResult[*model.RequestOutcome]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayOutcome", ctx, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// ReplayOutcome indicates an expected call of ReplayOutcome.
+func (mr *MockBloggingEventServiceMockRecorder) ReplayOutcome(ctx, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayOutcome", reflect.TypeOf((*MockBloggingEventService)(nil).ReplayOutcome), ctx, out)
+}
+
+// RevertArticle mocks base method.
+func (m *MockBloggingEventService) RevertArticle(ctx context.Context, command model.RevertArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertArticle", ctx, command, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// RevertArticle indicates an expected call of RevertArticle.
+func (mr *MockBloggingEventServiceMockRecorder) RevertArticle(ctx, command, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertArticle", reflect.TypeOf((*MockBloggingEventService)(nil).RevertArticle), ctx, command, out)
+}
+
+// ScheduleArticle mocks base method.
+func (m *MockBloggingEventService) ScheduleArticle(ctx context.Context, command model.ScheduleArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleArticle", ctx, command, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// ScheduleArticle indicates an expected call of ScheduleArticle.
+func (mr *MockBloggingEventServiceMockRecorder) ScheduleArticle(ctx, command, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleArticle", reflect.TypeOf((*MockBloggingEventService)(nil).ScheduleArticle), ctx, command, out)
+}
+
+// UnhideArticle mocks base method.
+func (m *MockBloggingEventService) UnhideArticle(ctx context.Context, command model.UnhideArticleEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnhideArticle", ctx, command, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// UnhideArticle indicates an expected call of UnhideArticle.
+func (mr *MockBloggingEventServiceMockRecorder) UnhideArticle(ctx, command, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnhideArticle", reflect.TypeOf((*MockBloggingEventService)(nil).UnhideArticle), ctx, command, out)
+}
+
 // UpdateArticleBody mocks base method.
 func (m *MockBloggingEventService) UpdateArticleBody(ctx context.Context, command model.UpdateArticleBodyEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
 	m.ctrl.T.Helper()
@@ -98,6 +266,20 @@ func (mr *MockBloggingEventServiceMockRecorder) UpdateArticleBody(ctx, command,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticleBody", reflect.TypeOf((*MockBloggingEventService)(nil).UpdateArticleBody), ctx, command, out)
 }
 
+// UpdateArticleSlug mocks base method.
+func (m *MockBloggingEventService) UpdateArticleSlug(ctx context.Context, command model.UpdateArticleSlugEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArticleSlug", ctx, command, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// UpdateArticleSlug indicates an expected call of UpdateArticleSlug.
+func (mr *MockBloggingEventServiceMockRecorder) UpdateArticleSlug(ctx, command, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticleSlug", reflect.TypeOf((*MockBloggingEventService)(nil).UpdateArticleSlug), ctx, command, out)
+}
+
 // UpdateArticleThumbnail mocks base method.
 func (m *MockBloggingEventService) UpdateArticleThumbnail(ctx context.Context, command model.UpdateArticleThumbnailEvent, out *db.SingleStatementResult[*model.BloggingEventKey]) db.Statement {
 	m.ctrl.T.Helper()
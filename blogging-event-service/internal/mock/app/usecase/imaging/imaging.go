@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: imaging.go
+//
+// Generated by this command:
+//
+//	mockgen -source=imaging.go -destination=../../../mock/app/usecase/imaging/imaging.go -package=imaging
+//
+
+// Package imaging is a generated GoMock package.
+package imaging
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	imaging "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/imaging"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProcessor is a mock of Processor interface.
+type MockProcessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockProcessorMockRecorder
+	isgomock struct{}
+}
+
+// MockProcessorMockRecorder is the mock recorder for MockProcessor.
+type MockProcessorMockRecorder struct {
+	mock *MockProcessor
+}
+
+// NewMockProcessor creates a new mock instance.
+func NewMockProcessor(ctrl *gomock.Controller) *MockProcessor {
+	mock := &MockProcessor{ctrl: ctrl}
+	mock.recorder = &MockProcessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProcessor) EXPECT() *MockProcessorMockRecorder {
+	return m.recorder
+}
+
+// Variants mocks base method.
+func (m *MockProcessor) Variants(ctx context.Context, r io.Reader, contentType string) ([]imaging.Variant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Variants", ctx, r, contentType)
+	ret0, _ := ret[0].([]imaging.Variant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Variants indicates an expected call of Variants.
+func (mr *MockProcessorMockRecorder) Variants(ctx, r, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Variants", reflect.TypeOf((*MockProcessor)(nil).Variants), ctx, r, contentType)
+}
+
+// MockStripper is a mock of Stripper interface.
+type MockStripper struct {
+	ctrl     *gomock.Controller
+	recorder *MockStripperMockRecorder
+	isgomock struct{}
+}
+
+// MockStripperMockRecorder is the mock recorder for MockStripper.
+type MockStripperMockRecorder struct {
+	mock *MockStripper
+}
+
+// NewMockStripper creates a new mock instance.
+func NewMockStripper(ctrl *gomock.Controller) *MockStripper {
+	mock := &MockStripper{ctrl: ctrl}
+	mock.recorder = &MockStripperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStripper) EXPECT() *MockStripperMockRecorder {
+	return m.recorder
+}
+
+// Strip mocks base method.
+func (m *MockStripper) Strip(ctx context.Context, r io.Reader, contentType string) (io.Reader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Strip", ctx, r, contentType)
+	ret0, _ := ret[0].(io.Reader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Strip indicates an expected call of Strip.
+func (mr *MockStripperMockRecorder) Strip(ctx, r, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Strip", reflect.TypeOf((*MockStripper)(nil).Strip), ctx, r, contentType)
+}
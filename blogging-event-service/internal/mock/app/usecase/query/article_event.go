@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: article_event.go
+//
+// Generated by this command:
+//
+//	mockgen -source=article_event.go -destination=../../../mock/app/usecase/query/article_event.go -package=query
+//
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	db "blogapi.miyamo.today/core/db"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleEventService is a mock of ArticleEventService interface.
+type MockArticleEventService struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleEventServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockArticleEventServiceMockRecorder is the mock recorder for MockArticleEventService.
+type MockArticleEventServiceMockRecorder struct {
+	mock *MockArticleEventService
+}
+
+// NewMockArticleEventService creates a new mock instance.
+func NewMockArticleEventService(ctrl *gomock.Controller) *MockArticleEventService {
+	mock := &MockArticleEventService{ctrl: ctrl}
+	mock.recorder = &MockArticleEventServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleEventService) EXPECT() *MockArticleEventServiceMockRecorder {
+	return m.recorder
+}
+
+// ListByArticleID mocks base method.
+func (m *MockArticleEventService) ListByArticleID(ctx context.Context, articleID string, out *db.MultipleStatementResult[*model.ArticleEvent]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByArticleID", ctx, articleID, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// ListByArticleID indicates an expected call of ListByArticleID.
+func (mr *MockArticleEventServiceMockRecorder) ListByArticleID(ctx, articleID, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByArticleID", reflect.TypeOf((*MockArticleEventService)(nil).ListByArticleID), ctx, articleID, out)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: draft.go
+//
+// Generated by this command:
+//
+//	mockgen -source=draft.go -destination=../../../mock/app/usecase/query/draft.go -package=query
+//
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	db "blogapi.miyamo.today/core/db"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDraftService is a mock of DraftService interface.
+type MockDraftService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDraftServiceMockRecorder is the mock recorder for MockDraftService.
+type MockDraftServiceMockRecorder struct {
+	mock *MockDraftService
+}
+
+// NewMockDraftService creates a new mock instance.
+func NewMockDraftService(ctrl *gomock.Controller) *MockDraftService {
+	mock := &MockDraftService{ctrl: ctrl}
+	mock.recorder = &MockDraftServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDraftService) EXPECT() *MockDraftServiceMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockDraftService) GetByID(ctx context.Context, articleID string, out *db.SingleStatementResult[*model.Draft]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, articleID, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockDraftServiceMockRecorder) GetByID(ctx, articleID, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockDraftService)(nil).GetByID), ctx, articleID, out)
+}
+
+// List mocks base method.
+func (m *MockDraftService) List(ctx context.Context, out *db.MultipleStatementResult[*model.Draft]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockDraftServiceMockRecorder) List(ctx, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDraftService)(nil).List), ctx, out)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: series.go
+//
+// Generated by this command:
+//
+//	mockgen -source=series.go -destination=../../../mock/app/usecase/query/series.go -package=query
+//
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	db "blogapi.miyamo.today/core/db"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSeriesService is a mock of SeriesService interface.
+type MockSeriesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSeriesServiceMockRecorder is the mock recorder for MockSeriesService.
+type MockSeriesServiceMockRecorder struct {
+	mock *MockSeriesService
+}
+
+// NewMockSeriesService creates a new mock instance.
+func NewMockSeriesService(ctrl *gomock.Controller) *MockSeriesService {
+	mock := &MockSeriesService{ctrl: ctrl}
+	mock.recorder = &MockSeriesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesService) EXPECT() *MockSeriesServiceMockRecorder {
+	return m.recorder
+}
+
+// GetSeriesArticle mocks base method.
+func (m *MockSeriesService) GetSeriesArticle(ctx context.Context, articleID string, out *db.SingleStatementResult[*model.SeriesArticle]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeriesArticle", ctx, articleID, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// GetSeriesArticle indicates an expected call of GetSeriesArticle.
+func (mr *MockSeriesServiceMockRecorder) GetSeriesArticle(ctx, articleID, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeriesArticle", reflect.TypeOf((*MockSeriesService)(nil).GetSeriesArticle), ctx, articleID, out)
+}
+
+// ListSeriesArticles mocks base method.
+func (m *MockSeriesService) ListSeriesArticles(ctx context.Context, seriesID string, out *db.MultipleStatementResult[*model.SeriesArticle]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeriesArticles", ctx, seriesID, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// ListSeriesArticles indicates an expected call of ListSeriesArticles.
+func (mr *MockSeriesServiceMockRecorder) ListSeriesArticles(ctx, seriesID, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeriesArticles", reflect.TypeOf((*MockSeriesService)(nil).ListSeriesArticles), ctx, seriesID, out)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tag.go -destination=../../../mock/app/usecase/query/tag.go -package=query
+//
+
+// Package query is a generated GoMock package.
+package query
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "blogapi.miyamo.today/blogging-event-service/internal/domain/model"
+	db "blogapi.miyamo.today/core/db"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTagService is a mock of TagService interface.
+type MockTagService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTagServiceMockRecorder is the mock recorder for MockTagService.
+type MockTagServiceMockRecorder struct {
+	mock *MockTagService
+}
+
+// NewMockTagService creates a new mock instance.
+func NewMockTagService(ctrl *gomock.Controller) *MockTagService {
+	mock := &MockTagService{ctrl: ctrl}
+	mock.recorder = &MockTagServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagService) EXPECT() *MockTagServiceMockRecorder {
+	return m.recorder
+}
+
+// ListTaggedArticles mocks base method.
+func (m *MockTagService) ListTaggedArticles(ctx context.Context, tagNames []string, out *db.MultipleStatementResult[*model.TaggedArticle]) db.Statement {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaggedArticles", ctx, tagNames, out)
+	ret0, _ := ret[0].(db.Statement)
+	return ret0
+}
+
+// ListTaggedArticles indicates an expected call of ListTaggedArticles.
+func (mr *MockTagServiceMockRecorder) ListTaggedArticles(ctx, tagNames, out any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaggedArticles", reflect.TypeOf((*MockTagService)(nil).ListTaggedArticles), ctx, tagNames, out)
+}
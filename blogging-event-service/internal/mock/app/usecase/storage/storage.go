@@ -11,6 +11,7 @@ package storage
 
 import (
 	context "context"
+	io "io"
 	url "net/url"
 	reflect "reflect"
 
@@ -41,17 +42,33 @@ func (m *MockUploader) EXPECT() *MockUploaderMockRecorder {
 	return m.recorder
 }
 
+// Rename mocks base method.
+func (m *MockUploader) Rename(ctx context.Context, src, dst string) (*url.URL, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", ctx, src, dst)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockUploaderMockRecorder) Rename(ctx, src, dst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockUploader)(nil).Rename), ctx, src, dst)
+}
+
 // Upload mocks base method.
-func (m *MockUploader) Upload(ctx context.Context, name string, bytes []byte, contentType string) (*url.URL, error) {
+func (m *MockUploader) Upload(ctx context.Context, name string, body io.Reader, contentType string) (*url.URL, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Upload", ctx, name, bytes, contentType)
+	ret := m.ctrl.Call(m, "Upload", ctx, name, body, contentType)
 	ret0, _ := ret[0].(*url.URL)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Upload indicates an expected call of Upload.
-func (mr *MockUploaderMockRecorder) Upload(ctx, name, bytes, contentType any) *gomock.Call {
+func (mr *MockUploaderMockRecorder) Upload(ctx, name, body, contentType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockUploader)(nil).Upload), ctx, name, bytes, contentType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockUploader)(nil).Upload), ctx, name, body, contentType)
 }
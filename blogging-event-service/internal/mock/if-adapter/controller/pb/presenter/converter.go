@@ -96,6 +96,45 @@ func (mr *MockToUpdateArticleTitleResponseMockRecorder) ToUpdateArticleTitleResp
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToUpdateArticleTitleResponse", reflect.TypeOf((*MockToUpdateArticleTitleResponse)(nil).ToUpdateArticleTitleResponse), ctx, from)
 }
 
+// MockToUpdateArticleSlugResponse is a mock of ToUpdateArticleSlugResponse interface.
+type MockToUpdateArticleSlugResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToUpdateArticleSlugResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToUpdateArticleSlugResponseMockRecorder is the mock recorder for MockToUpdateArticleSlugResponse.
+type MockToUpdateArticleSlugResponseMockRecorder struct {
+	mock *MockToUpdateArticleSlugResponse
+}
+
+// NewMockToUpdateArticleSlugResponse creates a new mock instance.
+func NewMockToUpdateArticleSlugResponse(ctrl *gomock.Controller) *MockToUpdateArticleSlugResponse {
+	mock := &MockToUpdateArticleSlugResponse{ctrl: ctrl}
+	mock.recorder = &MockToUpdateArticleSlugResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToUpdateArticleSlugResponse) EXPECT() *MockToUpdateArticleSlugResponseMockRecorder {
+	return m.recorder
+}
+
+// ToUpdateArticleSlugResponse mocks base method.
+func (m *MockToUpdateArticleSlugResponse) ToUpdateArticleSlugResponse(ctx context.Context, from *dto.UpdateArticleSlugOutDto) (*grpc.BloggingEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToUpdateArticleSlugResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.BloggingEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToUpdateArticleSlugResponse indicates an expected call of ToUpdateArticleSlugResponse.
+func (mr *MockToUpdateArticleSlugResponseMockRecorder) ToUpdateArticleSlugResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToUpdateArticleSlugResponse", reflect.TypeOf((*MockToUpdateArticleSlugResponse)(nil).ToUpdateArticleSlugResponse), ctx, from)
+}
+
 // MockToUpdateArticleBodyResponse is a mock of ToUpdateArticleBodyResponse interface.
 type MockToUpdateArticleBodyResponse struct {
 	ctrl     *gomock.Controller
@@ -252,6 +291,591 @@ func (mr *MockToDetachTagsResponseMockRecorder) ToDetachTagsResponse(ctx, from a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToDetachTagsResponse", reflect.TypeOf((*MockToDetachTagsResponse)(nil).ToDetachTagsResponse), ctx, from)
 }
 
+// MockToHideArticleResponse is a mock of ToHideArticleResponse interface.
+type MockToHideArticleResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToHideArticleResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToHideArticleResponseMockRecorder is the mock recorder for MockToHideArticleResponse.
+type MockToHideArticleResponseMockRecorder struct {
+	mock *MockToHideArticleResponse
+}
+
+// NewMockToHideArticleResponse creates a new mock instance.
+func NewMockToHideArticleResponse(ctrl *gomock.Controller) *MockToHideArticleResponse {
+	mock := &MockToHideArticleResponse{ctrl: ctrl}
+	mock.recorder = &MockToHideArticleResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToHideArticleResponse) EXPECT() *MockToHideArticleResponseMockRecorder {
+	return m.recorder
+}
+
+// ToHideArticleResponse mocks base method.
+func (m *MockToHideArticleResponse) ToHideArticleResponse(ctx context.Context, from *dto.HideArticleOutDto) (*grpc.BloggingEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToHideArticleResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.BloggingEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToHideArticleResponse indicates an expected call of ToHideArticleResponse.
+func (mr *MockToHideArticleResponseMockRecorder) ToHideArticleResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToHideArticleResponse", reflect.TypeOf((*MockToHideArticleResponse)(nil).ToHideArticleResponse), ctx, from)
+}
+
+// MockToUnhideArticleResponse is a mock of ToUnhideArticleResponse interface.
+type MockToUnhideArticleResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToUnhideArticleResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToUnhideArticleResponseMockRecorder is the mock recorder for MockToUnhideArticleResponse.
+type MockToUnhideArticleResponseMockRecorder struct {
+	mock *MockToUnhideArticleResponse
+}
+
+// NewMockToUnhideArticleResponse creates a new mock instance.
+func NewMockToUnhideArticleResponse(ctrl *gomock.Controller) *MockToUnhideArticleResponse {
+	mock := &MockToUnhideArticleResponse{ctrl: ctrl}
+	mock.recorder = &MockToUnhideArticleResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToUnhideArticleResponse) EXPECT() *MockToUnhideArticleResponseMockRecorder {
+	return m.recorder
+}
+
+// ToUnhideArticleResponse mocks base method.
+func (m *MockToUnhideArticleResponse) ToUnhideArticleResponse(ctx context.Context, from *dto.UnhideArticleOutDto) (*grpc.BloggingEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToUnhideArticleResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.BloggingEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToUnhideArticleResponse indicates an expected call of ToUnhideArticleResponse.
+func (mr *MockToUnhideArticleResponseMockRecorder) ToUnhideArticleResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToUnhideArticleResponse", reflect.TypeOf((*MockToUnhideArticleResponse)(nil).ToUnhideArticleResponse), ctx, from)
+}
+
+// MockToEditArticleResponse is a mock of ToEditArticleResponse interface.
+type MockToEditArticleResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToEditArticleResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToEditArticleResponseMockRecorder is the mock recorder for MockToEditArticleResponse.
+type MockToEditArticleResponseMockRecorder struct {
+	mock *MockToEditArticleResponse
+}
+
+// NewMockToEditArticleResponse creates a new mock instance.
+func NewMockToEditArticleResponse(ctrl *gomock.Controller) *MockToEditArticleResponse {
+	mock := &MockToEditArticleResponse{ctrl: ctrl}
+	mock.recorder = &MockToEditArticleResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToEditArticleResponse) EXPECT() *MockToEditArticleResponseMockRecorder {
+	return m.recorder
+}
+
+// ToEditArticleResponse mocks base method.
+func (m *MockToEditArticleResponse) ToEditArticleResponse(ctx context.Context, from *dto.EditArticleOutDto) (*grpc.BloggingEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToEditArticleResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.BloggingEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToEditArticleResponse indicates an expected call of ToEditArticleResponse.
+func (mr *MockToEditArticleResponseMockRecorder) ToEditArticleResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToEditArticleResponse", reflect.TypeOf((*MockToEditArticleResponse)(nil).ToEditArticleResponse), ctx, from)
+}
+
+// MockToScheduleArticleResponse is a mock of ToScheduleArticleResponse interface.
+type MockToScheduleArticleResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToScheduleArticleResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToScheduleArticleResponseMockRecorder is the mock recorder for MockToScheduleArticleResponse.
+type MockToScheduleArticleResponseMockRecorder struct {
+	mock *MockToScheduleArticleResponse
+}
+
+// NewMockToScheduleArticleResponse creates a new mock instance.
+func NewMockToScheduleArticleResponse(ctrl *gomock.Controller) *MockToScheduleArticleResponse {
+	mock := &MockToScheduleArticleResponse{ctrl: ctrl}
+	mock.recorder = &MockToScheduleArticleResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToScheduleArticleResponse) EXPECT() *MockToScheduleArticleResponseMockRecorder {
+	return m.recorder
+}
+
+// ToScheduleArticleResponse mocks base method.
+func (m *MockToScheduleArticleResponse) ToScheduleArticleResponse(ctx context.Context, from *dto.ScheduleArticleOutDto) (*grpc.BloggingEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToScheduleArticleResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.BloggingEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToScheduleArticleResponse indicates an expected call of ToScheduleArticleResponse.
+func (mr *MockToScheduleArticleResponseMockRecorder) ToScheduleArticleResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToScheduleArticleResponse", reflect.TypeOf((*MockToScheduleArticleResponse)(nil).ToScheduleArticleResponse), ctx, from)
+}
+
+// MockToPublishArticleResponse is a mock of ToPublishArticleResponse interface.
+type MockToPublishArticleResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToPublishArticleResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToPublishArticleResponseMockRecorder is the mock recorder for MockToPublishArticleResponse.
+type MockToPublishArticleResponseMockRecorder struct {
+	mock *MockToPublishArticleResponse
+}
+
+// NewMockToPublishArticleResponse creates a new mock instance.
+func NewMockToPublishArticleResponse(ctrl *gomock.Controller) *MockToPublishArticleResponse {
+	mock := &MockToPublishArticleResponse{ctrl: ctrl}
+	mock.recorder = &MockToPublishArticleResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToPublishArticleResponse) EXPECT() *MockToPublishArticleResponseMockRecorder {
+	return m.recorder
+}
+
+// ToPublishArticleResponse mocks base method.
+func (m *MockToPublishArticleResponse) ToPublishArticleResponse(ctx context.Context, from *dto.PublishArticleOutDto) (*grpc.BloggingEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToPublishArticleResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.BloggingEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToPublishArticleResponse indicates an expected call of ToPublishArticleResponse.
+func (mr *MockToPublishArticleResponseMockRecorder) ToPublishArticleResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToPublishArticleResponse", reflect.TypeOf((*MockToPublishArticleResponse)(nil).ToPublishArticleResponse), ctx, from)
+}
+
+// MockToRevertArticleResponse is a mock of ToRevertArticleResponse interface.
+type MockToRevertArticleResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToRevertArticleResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToRevertArticleResponseMockRecorder is the mock recorder for MockToRevertArticleResponse.
+type MockToRevertArticleResponseMockRecorder struct {
+	mock *MockToRevertArticleResponse
+}
+
+// NewMockToRevertArticleResponse creates a new mock instance.
+func NewMockToRevertArticleResponse(ctrl *gomock.Controller) *MockToRevertArticleResponse {
+	mock := &MockToRevertArticleResponse{ctrl: ctrl}
+	mock.recorder = &MockToRevertArticleResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToRevertArticleResponse) EXPECT() *MockToRevertArticleResponseMockRecorder {
+	return m.recorder
+}
+
+// ToRevertArticleResponse mocks base method.
+func (m *MockToRevertArticleResponse) ToRevertArticleResponse(ctx context.Context, from *dto.RevertArticleOutDto) (*grpc.BloggingEventResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToRevertArticleResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.BloggingEventResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToRevertArticleResponse indicates an expected call of ToRevertArticleResponse.
+func (mr *MockToRevertArticleResponseMockRecorder) ToRevertArticleResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToRevertArticleResponse", reflect.TypeOf((*MockToRevertArticleResponse)(nil).ToRevertArticleResponse), ctx, from)
+}
+
+// MockToRenameTagResponse is a mock of ToRenameTagResponse interface.
+type MockToRenameTagResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToRenameTagResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToRenameTagResponseMockRecorder is the mock recorder for MockToRenameTagResponse.
+type MockToRenameTagResponseMockRecorder struct {
+	mock *MockToRenameTagResponse
+}
+
+// NewMockToRenameTagResponse creates a new mock instance.
+func NewMockToRenameTagResponse(ctrl *gomock.Controller) *MockToRenameTagResponse {
+	mock := &MockToRenameTagResponse{ctrl: ctrl}
+	mock.recorder = &MockToRenameTagResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToRenameTagResponse) EXPECT() *MockToRenameTagResponseMockRecorder {
+	return m.recorder
+}
+
+// ToRenameTagResponse mocks base method.
+func (m *MockToRenameTagResponse) ToRenameTagResponse(ctx context.Context, from *dto.RenameTagOutDto) (*grpc.RenameTagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToRenameTagResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.RenameTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToRenameTagResponse indicates an expected call of ToRenameTagResponse.
+func (mr *MockToRenameTagResponseMockRecorder) ToRenameTagResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToRenameTagResponse", reflect.TypeOf((*MockToRenameTagResponse)(nil).ToRenameTagResponse), ctx, from)
+}
+
+// MockToMergeTagsResponse is a mock of ToMergeTagsResponse interface.
+type MockToMergeTagsResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToMergeTagsResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToMergeTagsResponseMockRecorder is the mock recorder for MockToMergeTagsResponse.
+type MockToMergeTagsResponseMockRecorder struct {
+	mock *MockToMergeTagsResponse
+}
+
+// NewMockToMergeTagsResponse creates a new mock instance.
+func NewMockToMergeTagsResponse(ctrl *gomock.Controller) *MockToMergeTagsResponse {
+	mock := &MockToMergeTagsResponse{ctrl: ctrl}
+	mock.recorder = &MockToMergeTagsResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToMergeTagsResponse) EXPECT() *MockToMergeTagsResponseMockRecorder {
+	return m.recorder
+}
+
+// ToMergeTagsResponse mocks base method.
+func (m *MockToMergeTagsResponse) ToMergeTagsResponse(ctx context.Context, from *dto.MergeTagsOutDto) (*grpc.MergeTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToMergeTagsResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.MergeTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToMergeTagsResponse indicates an expected call of ToMergeTagsResponse.
+func (mr *MockToMergeTagsResponseMockRecorder) ToMergeTagsResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMergeTagsResponse", reflect.TypeOf((*MockToMergeTagsResponse)(nil).ToMergeTagsResponse), ctx, from)
+}
+
+// MockToCreateSeriesResponse is a mock of ToCreateSeriesResponse interface.
+type MockToCreateSeriesResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToCreateSeriesResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToCreateSeriesResponseMockRecorder is the mock recorder for MockToCreateSeriesResponse.
+type MockToCreateSeriesResponseMockRecorder struct {
+	mock *MockToCreateSeriesResponse
+}
+
+// NewMockToCreateSeriesResponse creates a new mock instance.
+func NewMockToCreateSeriesResponse(ctrl *gomock.Controller) *MockToCreateSeriesResponse {
+	mock := &MockToCreateSeriesResponse{ctrl: ctrl}
+	mock.recorder = &MockToCreateSeriesResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToCreateSeriesResponse) EXPECT() *MockToCreateSeriesResponseMockRecorder {
+	return m.recorder
+}
+
+// ToCreateSeriesResponse mocks base method.
+func (m *MockToCreateSeriesResponse) ToCreateSeriesResponse(ctx context.Context, from *dto.SeriesOutDto) (*grpc.SeriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToCreateSeriesResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.SeriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToCreateSeriesResponse indicates an expected call of ToCreateSeriesResponse.
+func (mr *MockToCreateSeriesResponseMockRecorder) ToCreateSeriesResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToCreateSeriesResponse", reflect.TypeOf((*MockToCreateSeriesResponse)(nil).ToCreateSeriesResponse), ctx, from)
+}
+
+// MockToAddArticleToSeriesResponse is a mock of ToAddArticleToSeriesResponse interface.
+type MockToAddArticleToSeriesResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToAddArticleToSeriesResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToAddArticleToSeriesResponseMockRecorder is the mock recorder for MockToAddArticleToSeriesResponse.
+type MockToAddArticleToSeriesResponseMockRecorder struct {
+	mock *MockToAddArticleToSeriesResponse
+}
+
+// NewMockToAddArticleToSeriesResponse creates a new mock instance.
+func NewMockToAddArticleToSeriesResponse(ctrl *gomock.Controller) *MockToAddArticleToSeriesResponse {
+	mock := &MockToAddArticleToSeriesResponse{ctrl: ctrl}
+	mock.recorder = &MockToAddArticleToSeriesResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToAddArticleToSeriesResponse) EXPECT() *MockToAddArticleToSeriesResponseMockRecorder {
+	return m.recorder
+}
+
+// ToAddArticleToSeriesResponse mocks base method.
+func (m *MockToAddArticleToSeriesResponse) ToAddArticleToSeriesResponse(ctx context.Context, from *dto.SeriesOutDto) (*grpc.SeriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToAddArticleToSeriesResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.SeriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToAddArticleToSeriesResponse indicates an expected call of ToAddArticleToSeriesResponse.
+func (mr *MockToAddArticleToSeriesResponseMockRecorder) ToAddArticleToSeriesResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToAddArticleToSeriesResponse", reflect.TypeOf((*MockToAddArticleToSeriesResponse)(nil).ToAddArticleToSeriesResponse), ctx, from)
+}
+
+// MockToReorderSeriesResponse is a mock of ToReorderSeriesResponse interface.
+type MockToReorderSeriesResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToReorderSeriesResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToReorderSeriesResponseMockRecorder is the mock recorder for MockToReorderSeriesResponse.
+type MockToReorderSeriesResponseMockRecorder struct {
+	mock *MockToReorderSeriesResponse
+}
+
+// NewMockToReorderSeriesResponse creates a new mock instance.
+func NewMockToReorderSeriesResponse(ctrl *gomock.Controller) *MockToReorderSeriesResponse {
+	mock := &MockToReorderSeriesResponse{ctrl: ctrl}
+	mock.recorder = &MockToReorderSeriesResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToReorderSeriesResponse) EXPECT() *MockToReorderSeriesResponseMockRecorder {
+	return m.recorder
+}
+
+// ToReorderSeriesResponse mocks base method.
+func (m *MockToReorderSeriesResponse) ToReorderSeriesResponse(ctx context.Context, from *dto.SeriesOutDto) (*grpc.SeriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToReorderSeriesResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.SeriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToReorderSeriesResponse indicates an expected call of ToReorderSeriesResponse.
+func (mr *MockToReorderSeriesResponseMockRecorder) ToReorderSeriesResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToReorderSeriesResponse", reflect.TypeOf((*MockToReorderSeriesResponse)(nil).ToReorderSeriesResponse), ctx, from)
+}
+
+// MockToGetDraftResponse is a mock of ToGetDraftResponse interface.
+type MockToGetDraftResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToGetDraftResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToGetDraftResponseMockRecorder is the mock recorder for MockToGetDraftResponse.
+type MockToGetDraftResponseMockRecorder struct {
+	mock *MockToGetDraftResponse
+}
+
+// NewMockToGetDraftResponse creates a new mock instance.
+func NewMockToGetDraftResponse(ctrl *gomock.Controller) *MockToGetDraftResponse {
+	mock := &MockToGetDraftResponse{ctrl: ctrl}
+	mock.recorder = &MockToGetDraftResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToGetDraftResponse) EXPECT() *MockToGetDraftResponseMockRecorder {
+	return m.recorder
+}
+
+// ToGetDraftResponse mocks base method.
+func (m *MockToGetDraftResponse) ToGetDraftResponse(ctx context.Context, from *dto.GetDraftOutDto) (*grpc.GetDraftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToGetDraftResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.GetDraftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToGetDraftResponse indicates an expected call of ToGetDraftResponse.
+func (mr *MockToGetDraftResponseMockRecorder) ToGetDraftResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToGetDraftResponse", reflect.TypeOf((*MockToGetDraftResponse)(nil).ToGetDraftResponse), ctx, from)
+}
+
+// MockToListDraftsResponse is a mock of ToListDraftsResponse interface.
+type MockToListDraftsResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToListDraftsResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToListDraftsResponseMockRecorder is the mock recorder for MockToListDraftsResponse.
+type MockToListDraftsResponseMockRecorder struct {
+	mock *MockToListDraftsResponse
+}
+
+// NewMockToListDraftsResponse creates a new mock instance.
+func NewMockToListDraftsResponse(ctrl *gomock.Controller) *MockToListDraftsResponse {
+	mock := &MockToListDraftsResponse{ctrl: ctrl}
+	mock.recorder = &MockToListDraftsResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToListDraftsResponse) EXPECT() *MockToListDraftsResponseMockRecorder {
+	return m.recorder
+}
+
+// ToListDraftsResponse mocks base method.
+func (m *MockToListDraftsResponse) ToListDraftsResponse(ctx context.Context, from *dto.ListDraftsOutDto) (*grpc.ListDraftsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToListDraftsResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.ListDraftsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToListDraftsResponse indicates an expected call of ToListDraftsResponse.
+func (mr *MockToListDraftsResponseMockRecorder) ToListDraftsResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToListDraftsResponse", reflect.TypeOf((*MockToListDraftsResponse)(nil).ToListDraftsResponse), ctx, from)
+}
+
+// MockToListArticleEventsResponse is a mock of ToListArticleEventsResponse interface.
+type MockToListArticleEventsResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToListArticleEventsResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToListArticleEventsResponseMockRecorder is the mock recorder for MockToListArticleEventsResponse.
+type MockToListArticleEventsResponseMockRecorder struct {
+	mock *MockToListArticleEventsResponse
+}
+
+// NewMockToListArticleEventsResponse creates a new mock instance.
+func NewMockToListArticleEventsResponse(ctrl *gomock.Controller) *MockToListArticleEventsResponse {
+	mock := &MockToListArticleEventsResponse{ctrl: ctrl}
+	mock.recorder = &MockToListArticleEventsResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToListArticleEventsResponse) EXPECT() *MockToListArticleEventsResponseMockRecorder {
+	return m.recorder
+}
+
+// ToListArticleEventsResponse mocks base method.
+func (m *MockToListArticleEventsResponse) ToListArticleEventsResponse(ctx context.Context, from *dto.ListArticleEventsOutDto) (*grpc.ListArticleEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToListArticleEventsResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.ListArticleEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToListArticleEventsResponse indicates an expected call of ToListArticleEventsResponse.
+func (mr *MockToListArticleEventsResponseMockRecorder) ToListArticleEventsResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToListArticleEventsResponse", reflect.TypeOf((*MockToListArticleEventsResponse)(nil).ToListArticleEventsResponse), ctx, from)
+}
+
+// MockToGetArticleAtResponse is a mock of ToGetArticleAtResponse interface.
+type MockToGetArticleAtResponse struct {
+	ctrl     *gomock.Controller
+	recorder *MockToGetArticleAtResponseMockRecorder
+	isgomock struct{}
+}
+
+// MockToGetArticleAtResponseMockRecorder is the mock recorder for MockToGetArticleAtResponse.
+type MockToGetArticleAtResponseMockRecorder struct {
+	mock *MockToGetArticleAtResponse
+}
+
+// NewMockToGetArticleAtResponse creates a new mock instance.
+func NewMockToGetArticleAtResponse(ctrl *gomock.Controller) *MockToGetArticleAtResponse {
+	mock := &MockToGetArticleAtResponse{ctrl: ctrl}
+	mock.recorder = &MockToGetArticleAtResponseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToGetArticleAtResponse) EXPECT() *MockToGetArticleAtResponseMockRecorder {
+	return m.recorder
+}
+
+// ToGetArticleAtResponse mocks base method.
+func (m *MockToGetArticleAtResponse) ToGetArticleAtResponse(ctx context.Context, from *dto.GetArticleAtOutDto) (*grpc.GetArticleAtResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToGetArticleAtResponse", ctx, from)
+	ret0, _ := ret[0].(*grpc.GetArticleAtResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToGetArticleAtResponse indicates an expected call of ToGetArticleAtResponse.
+func (mr *MockToGetArticleAtResponseMockRecorder) ToGetArticleAtResponse(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToGetArticleAtResponse", reflect.TypeOf((*MockToGetArticleAtResponse)(nil).ToGetArticleAtResponse), ctx, from)
+}
+
 // MockToUploadImageResponse is a mock of ToUploadImageResponse interface.
 type MockToUploadImageResponse struct {
 	ctrl     *gomock.Controller
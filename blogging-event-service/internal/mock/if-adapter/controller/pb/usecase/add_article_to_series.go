@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: add_article_to_series.go
+//
+// Generated by this command:
+//
+//	mockgen -source=add_article_to_series.go -destination=../../../../mock/if-adapter/controller/pb/usecase/add_article_to_series.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAddArticleToSeries is a mock of AddArticleToSeries interface.
+type MockAddArticleToSeries struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddArticleToSeriesMockRecorder
+	isgomock struct{}
+}
+
+// MockAddArticleToSeriesMockRecorder is the mock recorder for MockAddArticleToSeries.
+type MockAddArticleToSeriesMockRecorder struct {
+	mock *MockAddArticleToSeries
+}
+
+// NewMockAddArticleToSeries creates a new mock instance.
+func NewMockAddArticleToSeries(ctrl *gomock.Controller) *MockAddArticleToSeries {
+	mock := &MockAddArticleToSeries{ctrl: ctrl}
+	mock.recorder = &MockAddArticleToSeriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddArticleToSeries) EXPECT() *MockAddArticleToSeriesMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAddArticleToSeries) Execute(ctx context.Context, in *dto.AddArticleToSeriesInDto) (*dto.SeriesOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.SeriesOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAddArticleToSeriesMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAddArticleToSeries)(nil).Execute), ctx, in)
+}
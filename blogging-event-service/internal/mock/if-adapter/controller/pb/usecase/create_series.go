@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: create_series.go
+//
+// Generated by this command:
+//
+//	mockgen -source=create_series.go -destination=../../../../mock/if-adapter/controller/pb/usecase/create_series.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreateSeries is a mock of CreateSeries interface.
+type MockCreateSeries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSeriesMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateSeriesMockRecorder is the mock recorder for MockCreateSeries.
+type MockCreateSeriesMockRecorder struct {
+	mock *MockCreateSeries
+}
+
+// NewMockCreateSeries creates a new mock instance.
+func NewMockCreateSeries(ctrl *gomock.Controller) *MockCreateSeries {
+	mock := &MockCreateSeries{ctrl: ctrl}
+	mock.recorder = &MockCreateSeriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSeries) EXPECT() *MockCreateSeriesMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateSeries) Execute(ctx context.Context, in *dto.CreateSeriesInDto) (*dto.SeriesOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.SeriesOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateSeriesMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateSeries)(nil).Execute), ctx, in)
+}
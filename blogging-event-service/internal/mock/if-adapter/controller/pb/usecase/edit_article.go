@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: edit_article.go
+//
+// Generated by this command:
+//
+//	mockgen -source=edit_article.go -destination=../../../../mock/if-adapter/controller/pb/usecase/edit_article.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEditArticle is a mock of EditArticle interface.
+type MockEditArticle struct {
+	ctrl     *gomock.Controller
+	recorder *MockEditArticleMockRecorder
+	isgomock struct{}
+}
+
+// MockEditArticleMockRecorder is the mock recorder for MockEditArticle.
+type MockEditArticleMockRecorder struct {
+	mock *MockEditArticle
+}
+
+// NewMockEditArticle creates a new mock instance.
+func NewMockEditArticle(ctrl *gomock.Controller) *MockEditArticle {
+	mock := &MockEditArticle{ctrl: ctrl}
+	mock.recorder = &MockEditArticleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEditArticle) EXPECT() *MockEditArticleMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockEditArticle) Execute(ctx context.Context, in *dto.EditArticleInDto) (*dto.EditArticleOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.EditArticleOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockEditArticleMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEditArticle)(nil).Execute), ctx, in)
+}
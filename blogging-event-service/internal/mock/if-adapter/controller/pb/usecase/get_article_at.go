@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: get_article_at.go
+//
+// Generated by this command:
+//
+//	mockgen -source=get_article_at.go -destination=../../../../mock/if-adapter/controller/pb/usecase/get_article_at.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetArticleAt is a mock of GetArticleAt interface.
+type MockGetArticleAt struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetArticleAtMockRecorder
+	isgomock struct{}
+}
+
+// MockGetArticleAtMockRecorder is the mock recorder for MockGetArticleAt.
+type MockGetArticleAtMockRecorder struct {
+	mock *MockGetArticleAt
+}
+
+// NewMockGetArticleAt creates a new mock instance.
+func NewMockGetArticleAt(ctrl *gomock.Controller) *MockGetArticleAt {
+	mock := &MockGetArticleAt{ctrl: ctrl}
+	mock.recorder = &MockGetArticleAtMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetArticleAt) EXPECT() *MockGetArticleAtMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetArticleAt) Execute(ctx context.Context, in *dto.GetArticleAtInDto) (*dto.GetArticleAtOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.GetArticleAtOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetArticleAtMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetArticleAt)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: get_draft.go
+//
+// Generated by this command:
+//
+//	mockgen -source=get_draft.go -destination=../../../../mock/if-adapter/controller/pb/usecase/get_draft.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetDraft is a mock of GetDraft interface.
+type MockGetDraft struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetDraftMockRecorder
+	isgomock struct{}
+}
+
+// MockGetDraftMockRecorder is the mock recorder for MockGetDraft.
+type MockGetDraftMockRecorder struct {
+	mock *MockGetDraft
+}
+
+// NewMockGetDraft creates a new mock instance.
+func NewMockGetDraft(ctrl *gomock.Controller) *MockGetDraft {
+	mock := &MockGetDraft{ctrl: ctrl}
+	mock.recorder = &MockGetDraftMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetDraft) EXPECT() *MockGetDraftMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetDraft) Execute(ctx context.Context, in *dto.GetDraftInDto) (*dto.GetDraftOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.GetDraftOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetDraftMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetDraft)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hide_article.go
+//
+// Generated by this command:
+//
+//	mockgen -source=hide_article.go -destination=../../../../mock/if-adapter/controller/pb/usecase/hide_article.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockHideArticle is a mock of HideArticle interface.
+type MockHideArticle struct {
+	ctrl     *gomock.Controller
+	recorder *MockHideArticleMockRecorder
+	isgomock struct{}
+}
+
+// MockHideArticleMockRecorder is the mock recorder for MockHideArticle.
+type MockHideArticleMockRecorder struct {
+	mock *MockHideArticle
+}
+
+// NewMockHideArticle creates a new mock instance.
+func NewMockHideArticle(ctrl *gomock.Controller) *MockHideArticle {
+	mock := &MockHideArticle{ctrl: ctrl}
+	mock.recorder = &MockHideArticleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHideArticle) EXPECT() *MockHideArticleMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockHideArticle) Execute(ctx context.Context, in *dto.HideArticleInDto) (*dto.HideArticleOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.HideArticleOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockHideArticleMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockHideArticle)(nil).Execute), ctx, in)
+}
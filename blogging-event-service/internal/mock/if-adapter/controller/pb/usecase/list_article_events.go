@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: list_article_events.go
+//
+// Generated by this command:
+//
+//	mockgen -source=list_article_events.go -destination=../../../../mock/if-adapter/controller/pb/usecase/list_article_events.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListArticleEvents is a mock of ListArticleEvents interface.
+type MockListArticleEvents struct {
+	ctrl     *gomock.Controller
+	recorder *MockListArticleEventsMockRecorder
+	isgomock struct{}
+}
+
+// MockListArticleEventsMockRecorder is the mock recorder for MockListArticleEvents.
+type MockListArticleEventsMockRecorder struct {
+	mock *MockListArticleEvents
+}
+
+// NewMockListArticleEvents creates a new mock instance.
+func NewMockListArticleEvents(ctrl *gomock.Controller) *MockListArticleEvents {
+	mock := &MockListArticleEvents{ctrl: ctrl}
+	mock.recorder = &MockListArticleEventsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListArticleEvents) EXPECT() *MockListArticleEventsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListArticleEvents) Execute(ctx context.Context, in *dto.ListArticleEventsInDto) (*dto.ListArticleEventsOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.ListArticleEventsOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListArticleEventsMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListArticleEvents)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: list_drafts.go
+//
+// Generated by this command:
+//
+//	mockgen -source=list_drafts.go -destination=../../../../mock/if-adapter/controller/pb/usecase/list_drafts.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockListDrafts is a mock of ListDrafts interface.
+type MockListDrafts struct {
+	ctrl     *gomock.Controller
+	recorder *MockListDraftsMockRecorder
+	isgomock struct{}
+}
+
+// MockListDraftsMockRecorder is the mock recorder for MockListDrafts.
+type MockListDraftsMockRecorder struct {
+	mock *MockListDrafts
+}
+
+// NewMockListDrafts creates a new mock instance.
+func NewMockListDrafts(ctrl *gomock.Controller) *MockListDrafts {
+	mock := &MockListDrafts{ctrl: ctrl}
+	mock.recorder = &MockListDraftsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListDrafts) EXPECT() *MockListDraftsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListDrafts) Execute(ctx context.Context) (*dto.ListDraftsOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx)
+	ret0, _ := ret[0].(*dto.ListDraftsOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListDraftsMockRecorder) Execute(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListDrafts)(nil).Execute), ctx)
+}
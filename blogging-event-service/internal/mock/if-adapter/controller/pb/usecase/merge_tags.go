@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: merge_tags.go
+//
+// Generated by this command:
+//
+//	mockgen -source=merge_tags.go -destination=../../../../mock/if-adapter/controller/pb/usecase/merge_tags.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMergeTags is a mock of MergeTags interface.
+type MockMergeTags struct {
+	ctrl     *gomock.Controller
+	recorder *MockMergeTagsMockRecorder
+	isgomock struct{}
+}
+
+// MockMergeTagsMockRecorder is the mock recorder for MockMergeTags.
+type MockMergeTagsMockRecorder struct {
+	mock *MockMergeTags
+}
+
+// NewMockMergeTags creates a new mock instance.
+func NewMockMergeTags(ctrl *gomock.Controller) *MockMergeTags {
+	mock := &MockMergeTags{ctrl: ctrl}
+	mock.recorder = &MockMergeTagsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMergeTags) EXPECT() *MockMergeTagsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockMergeTags) Execute(ctx context.Context, in *dto.MergeTagsInDto) (*dto.MergeTagsOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.MergeTagsOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockMergeTagsMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockMergeTags)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: publish_article.go
+//
+// Generated by this command:
+//
+//	mockgen -source=publish_article.go -destination=../../../../mock/if-adapter/controller/pb/usecase/publish_article.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPublishArticle is a mock of PublishArticle interface.
+type MockPublishArticle struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublishArticleMockRecorder
+	isgomock struct{}
+}
+
+// MockPublishArticleMockRecorder is the mock recorder for MockPublishArticle.
+type MockPublishArticleMockRecorder struct {
+	mock *MockPublishArticle
+}
+
+// NewMockPublishArticle creates a new mock instance.
+func NewMockPublishArticle(ctrl *gomock.Controller) *MockPublishArticle {
+	mock := &MockPublishArticle{ctrl: ctrl}
+	mock.recorder = &MockPublishArticleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublishArticle) EXPECT() *MockPublishArticleMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockPublishArticle) Execute(ctx context.Context, in *dto.PublishArticleInDto) (*dto.PublishArticleOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.PublishArticleOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockPublishArticleMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockPublishArticle)(nil).Execute), ctx, in)
+}
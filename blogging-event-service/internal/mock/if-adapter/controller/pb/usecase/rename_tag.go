@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rename_tag.go
+//
+// Generated by this command:
+//
+//	mockgen -source=rename_tag.go -destination=../../../../mock/if-adapter/controller/pb/usecase/rename_tag.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRenameTag is a mock of RenameTag interface.
+type MockRenameTag struct {
+	ctrl     *gomock.Controller
+	recorder *MockRenameTagMockRecorder
+	isgomock struct{}
+}
+
+// MockRenameTagMockRecorder is the mock recorder for MockRenameTag.
+type MockRenameTagMockRecorder struct {
+	mock *MockRenameTag
+}
+
+// NewMockRenameTag creates a new mock instance.
+func NewMockRenameTag(ctrl *gomock.Controller) *MockRenameTag {
+	mock := &MockRenameTag{ctrl: ctrl}
+	mock.recorder = &MockRenameTagMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRenameTag) EXPECT() *MockRenameTagMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRenameTag) Execute(ctx context.Context, in *dto.RenameTagInDto) (*dto.RenameTagOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.RenameTagOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRenameTagMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRenameTag)(nil).Execute), ctx, in)
+}
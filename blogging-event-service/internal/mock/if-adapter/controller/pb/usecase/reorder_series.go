@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reorder_series.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reorder_series.go -destination=../../../../mock/if-adapter/controller/pb/usecase/reorder_series.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReorderSeries is a mock of ReorderSeries interface.
+type MockReorderSeries struct {
+	ctrl     *gomock.Controller
+	recorder *MockReorderSeriesMockRecorder
+	isgomock struct{}
+}
+
+// MockReorderSeriesMockRecorder is the mock recorder for MockReorderSeries.
+type MockReorderSeriesMockRecorder struct {
+	mock *MockReorderSeries
+}
+
+// NewMockReorderSeries creates a new mock instance.
+func NewMockReorderSeries(ctrl *gomock.Controller) *MockReorderSeries {
+	mock := &MockReorderSeries{ctrl: ctrl}
+	mock.recorder = &MockReorderSeriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReorderSeries) EXPECT() *MockReorderSeriesMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockReorderSeries) Execute(ctx context.Context, in *dto.ReorderSeriesInDto) (*dto.SeriesOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.SeriesOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockReorderSeriesMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockReorderSeries)(nil).Execute), ctx, in)
+}
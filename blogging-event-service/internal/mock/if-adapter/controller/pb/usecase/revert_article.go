@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: revert_article.go
+//
+// Generated by this command:
+//
+//	mockgen -source=revert_article.go -destination=../../../../mock/if-adapter/controller/pb/usecase/revert_article.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRevertArticle is a mock of RevertArticle interface.
+type MockRevertArticle struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevertArticleMockRecorder
+	isgomock struct{}
+}
+
+// MockRevertArticleMockRecorder is the mock recorder for MockRevertArticle.
+type MockRevertArticleMockRecorder struct {
+	mock *MockRevertArticle
+}
+
+// NewMockRevertArticle creates a new mock instance.
+func NewMockRevertArticle(ctrl *gomock.Controller) *MockRevertArticle {
+	mock := &MockRevertArticle{ctrl: ctrl}
+	mock.recorder = &MockRevertArticleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevertArticle) EXPECT() *MockRevertArticleMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRevertArticle) Execute(ctx context.Context, in *dto.RevertArticleInDto) (*dto.RevertArticleOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.RevertArticleOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRevertArticleMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRevertArticle)(nil).Execute), ctx, in)
+}
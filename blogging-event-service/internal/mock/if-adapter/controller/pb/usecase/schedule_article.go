@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: schedule_article.go
+//
+// Generated by this command:
+//
+//	mockgen -source=schedule_article.go -destination=../../../../mock/if-adapter/controller/pb/usecase/schedule_article.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockScheduleArticle is a mock of ScheduleArticle interface.
+type MockScheduleArticle struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleArticleMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduleArticleMockRecorder is the mock recorder for MockScheduleArticle.
+type MockScheduleArticleMockRecorder struct {
+	mock *MockScheduleArticle
+}
+
+// NewMockScheduleArticle creates a new mock instance.
+func NewMockScheduleArticle(ctrl *gomock.Controller) *MockScheduleArticle {
+	mock := &MockScheduleArticle{ctrl: ctrl}
+	mock.recorder = &MockScheduleArticleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleArticle) EXPECT() *MockScheduleArticleMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockScheduleArticle) Execute(ctx context.Context, in *dto.ScheduleArticleInDto) (*dto.ScheduleArticleOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.ScheduleArticleOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockScheduleArticleMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockScheduleArticle)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: unhide_article.go
+//
+// Generated by this command:
+//
+//	mockgen -source=unhide_article.go -destination=../../../../mock/if-adapter/controller/pb/usecase/unhide_article.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUnhideArticle is a mock of UnhideArticle interface.
+type MockUnhideArticle struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnhideArticleMockRecorder
+	isgomock struct{}
+}
+
+// MockUnhideArticleMockRecorder is the mock recorder for MockUnhideArticle.
+type MockUnhideArticleMockRecorder struct {
+	mock *MockUnhideArticle
+}
+
+// NewMockUnhideArticle creates a new mock instance.
+func NewMockUnhideArticle(ctrl *gomock.Controller) *MockUnhideArticle {
+	mock := &MockUnhideArticle{ctrl: ctrl}
+	mock.recorder = &MockUnhideArticleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnhideArticle) EXPECT() *MockUnhideArticleMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUnhideArticle) Execute(ctx context.Context, in *dto.UnhideArticleInDto) (*dto.UnhideArticleOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.UnhideArticleOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUnhideArticleMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUnhideArticle)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: update_article_slug.go
+//
+// Generated by this command:
+//
+//	mockgen -source=update_article_slug.go -destination=../../../../mock/if-adapter/controller/pb/usecase/update_article_slug.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "blogapi.miyamo.today/blogging-event-service/internal/app/usecase/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUpdateArticleSlug is a mock of UpdateArticleSlug interface.
+type MockUpdateArticleSlug struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateArticleSlugMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateArticleSlugMockRecorder is the mock recorder for MockUpdateArticleSlug.
+type MockUpdateArticleSlugMockRecorder struct {
+	mock *MockUpdateArticleSlug
+}
+
+// NewMockUpdateArticleSlug creates a new mock instance.
+func NewMockUpdateArticleSlug(ctrl *gomock.Controller) *MockUpdateArticleSlug {
+	mock := &MockUpdateArticleSlug{ctrl: ctrl}
+	mock.recorder = &MockUpdateArticleSlugMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateArticleSlug) EXPECT() *MockUpdateArticleSlugMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUpdateArticleSlug) Execute(ctx context.Context, in *dto.UpdateArticleSlugInDto) (*dto.UpdateArticleSlugOutDto, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*dto.UpdateArticleSlugOutDto)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUpdateArticleSlugMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateArticleSlug)(nil).Execute), ctx, in)
+}
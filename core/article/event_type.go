@@ -0,0 +1,73 @@
+package article
+
+// EventType is the kind of a blogging event, as stored in its event_type attribute.
+type EventType string
+
+// Kinds of the events of schema version 1, which are told from the attributes they wrote.
+const (
+	EventTypeCreateArticle   EventType = "CREATE_ARTICLE"
+	EventTypeUpdateTitle     EventType = "UPDATE_TITLE"
+	EventTypeUpdateBody      EventType = "UPDATE_BODY"
+	EventTypeUpdateThumbnail EventType = "UPDATE_THUMBNAIL"
+	EventTypeAttachTags      EventType = "ATTACH_TAGS"
+	EventTypeDetachTags      EventType = "DETACH_TAGS"
+	EventTypeHideArticle     EventType = "HIDE_ARTICLE"
+	EventTypeUnhideArticle   EventType = "UNHIDE_ARTICLE"
+	EventTypeEditArticle     EventType = "EDIT_ARTICLE"
+	EventTypeScheduleArticle EventType = "SCHEDULE_ARTICLE"
+	EventTypePublishArticle  EventType = "PUBLISH_ARTICLE"
+	EventTypeRevertArticle   EventType = "REVERT_ARTICLE"
+)
+
+// V1Attributes are the attributes an event of schema version 1 wrote. nil means the event did not write it.
+type V1Attributes struct {
+	Title      *string
+	Content    *string
+	Thumbnail  *string
+	AttachTags []string
+	DetachTags []string
+	Invisible  *bool
+	Draft      *bool
+	PublishAt  *string
+	RevertedTo *string
+}
+
+// EventTypeOfV1 tells the kind of an event of schema version 1, which did not store its event_type, from the attributes it wrote.
+// first tells whether the event is the oldest one of the article.
+// An edit of a single field is indistinguishable from the dedicated update of that field, so it is told as the update.
+func EventTypeOfV1(a V1Attributes, first bool) EventType {
+	switch {
+	case first:
+		return EventTypeCreateArticle
+	case a.RevertedTo != nil && *a.RevertedTo != "":
+		return EventTypeRevertArticle
+	case a.Invisible != nil && *a.Invisible:
+		return EventTypeHideArticle
+	case a.Invisible != nil:
+		return EventTypeUnhideArticle
+	case a.Draft != nil:
+		return EventTypePublishArticle
+	case a.PublishAt != nil && *a.PublishAt != "":
+		return EventTypeScheduleArticle
+	}
+	var changed []EventType
+	if a.Title != nil {
+		changed = append(changed, EventTypeUpdateTitle)
+	}
+	if a.Content != nil {
+		changed = append(changed, EventTypeUpdateBody)
+	}
+	if a.Thumbnail != nil {
+		changed = append(changed, EventTypeUpdateThumbnail)
+	}
+	if len(a.AttachTags) > 0 {
+		changed = append(changed, EventTypeAttachTags)
+	}
+	if len(a.DetachTags) > 0 {
+		changed = append(changed, EventTypeDetachTags)
+	}
+	if len(changed) == 1 {
+		return changed[0]
+	}
+	return EventTypeEditArticle
+}
@@ -0,0 +1,106 @@
+package article
+
+import (
+	"testing"
+)
+
+func TestEventTypeOfV1(t *testing.T) {
+	type testCase struct {
+		attributes V1Attributes
+		first      bool
+		want       EventType
+	}
+	tests := map[string]testCase{
+		"happy_path/create_article": {
+			attributes: V1Attributes{Title: ptr("title"), Content: ptr("body"), Thumbnail: ptr("thumbnail")},
+			first:      true,
+			want:       EventTypeCreateArticle,
+		},
+		"happy_path/update_title": {
+			attributes: V1Attributes{Title: ptr("title")},
+			want:       EventTypeUpdateTitle,
+		},
+		"happy_path/update_body": {
+			attributes: V1Attributes{Content: ptr("body")},
+			want:       EventTypeUpdateBody,
+		},
+		"happy_path/update_thumbnail": {
+			attributes: V1Attributes{Thumbnail: ptr("thumbnail")},
+			want:       EventTypeUpdateThumbnail,
+		},
+		"happy_path/attach_tags": {
+			attributes: V1Attributes{AttachTags: []string{"go"}},
+			want:       EventTypeAttachTags,
+		},
+		"happy_path/detach_tags": {
+			attributes: V1Attributes{DetachTags: []string{"go"}},
+			want:       EventTypeDetachTags,
+		},
+		"happy_path/hide_article": {
+			attributes: V1Attributes{Invisible: ptr(true)},
+			want:       EventTypeHideArticle,
+		},
+		"happy_path/unhide_article": {
+			attributes: V1Attributes{Invisible: ptr(false)},
+			want:       EventTypeUnhideArticle,
+		},
+		"happy_path/publish_article": {
+			attributes: V1Attributes{Draft: ptr(false)},
+			want:       EventTypePublishArticle,
+		},
+		"happy_path/schedule_article": {
+			attributes: V1Attributes{PublishAt: ptr("2025-01-01T09:00:00Z")},
+			want:       EventTypeScheduleArticle,
+		},
+		"happy_path/revert_article": {
+			attributes: V1Attributes{RevertedTo: ptr("01JF0REBGD4QKPFGN1SX2STY4M")},
+			want:       EventTypeRevertArticle,
+		},
+		"happy_path/edit_article": {
+			attributes: V1Attributes{Title: ptr("title"), Content: ptr("body"), AttachTags: []string{"go"}},
+			want:       EventTypeEditArticle,
+		},
+		"happy_path/edit_article_without_changes": {
+			attributes: V1Attributes{},
+			want:       EventTypeEditArticle,
+		},
+		"happy_path/ambiguous/first_wins_over_everything": {
+			attributes: V1Attributes{Title: ptr("title"), Invisible: ptr(true), Draft: ptr(true), RevertedTo: ptr("01JF0REBGD4QKPFGN1SX2STY4M")},
+			first:      true,
+			want:       EventTypeCreateArticle,
+		},
+		"happy_path/ambiguous/revert_with_every_field": {
+			attributes: V1Attributes{
+				Title:      ptr("title"),
+				Content:    ptr("body"),
+				Thumbnail:  ptr("thumbnail"),
+				AttachTags: []string{"go"},
+				DetachTags: []string{"aws"},
+				Invisible:  ptr(true),
+				Draft:      ptr(false),
+				PublishAt:  ptr("2025-01-01T09:00:00Z"),
+				RevertedTo: ptr("01JF0REBGD4QKPFGN1SX2STY4M"),
+			},
+			want: EventTypeRevertArticle,
+		},
+		"happy_path/ambiguous/hide_with_title": {
+			attributes: V1Attributes{Title: ptr("title"), Invisible: ptr(true), Draft: ptr(false)},
+			want:       EventTypeHideArticle,
+		},
+		"happy_path/ambiguous/publish_with_schedule": {
+			attributes: V1Attributes{Draft: ptr(false), PublishAt: ptr("2025-01-01T09:00:00Z")},
+			want:       EventTypePublishArticle,
+		},
+		"happy_path/ambiguous/empty_reverted_to_and_publish_at": {
+			attributes: V1Attributes{Thumbnail: ptr(""), PublishAt: ptr(""), RevertedTo: ptr("")},
+			want:       EventTypeUpdateThumbnail,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := EventTypeOfV1(tt.attributes, tt.first); got != tt.want {
+				t.Errorf("EventTypeOfV1() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package article
+
+import (
+	"slices"
+)
+
+// Event is a blogging event that changes an article.
+// Getters return nil or empty for the fields the event did not change.
+type Event interface {
+	Title() *string
+	Content() *string
+	Thumbnail() *string
+	Tags() []string
+	AttachTags() []string
+	DetachTags() []string
+	Invisible() *bool
+	Draft() *bool
+}
+
+// SlugEvent is an Event that can change the slug of an article.
+// Events that do not implement it leave the slug as it is.
+type SlugEvent interface {
+	Event
+	Slug() *string
+}
+
+// SeriesEvent is an Event that can put an article in a series.
+// Events that do not implement it leave the series as it is.
+type SeriesEvent interface {
+	Event
+	SeriesID() *string
+	SeriesTitle() *string
+	SeriesPosition() *int
+}
+
+// ThumbnailEvent is an Event that can change the variants of the thumbnail of an article.
+// The variants belong to the thumbnail they were made from, so they are replaced whenever the thumbnail is,
+// and events that change the thumbnail without implementing it leave the article without variants.
+type ThumbnailEvent interface {
+	Event
+	ThumbnailVariants() []ImageVariant
+}
+
+// ImageVariant is a resized or re-encoded copy of an image, such as a source of a srcset.
+type ImageVariant struct {
+	url         string
+	width       int
+	height      int
+	contentType string
+}
+
+// URL returns the url of the variant.
+func (v ImageVariant) URL() string {
+	return v.url
+}
+
+// Width returns the width of the variant in pixels.
+func (v ImageVariant) Width() int {
+	return v.width
+}
+
+// Height returns the height of the variant in pixels.
+func (v ImageVariant) Height() int {
+	return v.height
+}
+
+// ContentType returns the content type of the variant, such as image/webp.
+func (v ImageVariant) ContentType() string {
+	return v.contentType
+}
+
+// NewImageVariant is constructor of ImageVariant.
+func NewImageVariant(url string, width, height int, contentType string) ImageVariant {
+	return ImageVariant{
+		url:         url,
+		width:       width,
+		height:      height,
+		contentType: contentType,
+	}
+}
+
+// Projection is the state of an article built from its events.
+type Projection struct {
+	title             string
+	body              string
+	thumbnail         string
+	thumbnailVariants []ImageVariant
+	tagNames          []string
+	invisible         bool
+	draft             bool
+	slug              string
+	slugs             []string
+	series            Series
+}
+
+// Series is the series an article is a part of.
+type Series struct {
+	id       string
+	title    string
+	position int
+}
+
+// ID returns the id of the series. It is empty if the article is not a part of any series.
+func (s Series) ID() string {
+	return s.id
+}
+
+// Title returns the title of the series.
+func (s Series) Title() string {
+	return s.title
+}
+
+// Position returns the position of the article in the series, starting at 1.
+func (s Series) Position() int {
+	return s.position
+}
+
+// Title returns the title.
+func (p Projection) Title() string {
+	return p.title
+}
+
+// Body returns the body.
+func (p Projection) Body() string {
+	return p.body
+}
+
+// Thumbnail returns the thumbnail url.
+func (p Projection) Thumbnail() string {
+	return p.thumbnail
+}
+
+// ThumbnailVariants returns the variants of the thumbnail. It is empty if the thumbnail has none.
+func (p Projection) ThumbnailVariants() []ImageVariant {
+	return p.thumbnailVariants
+}
+
+// TagNames returns the tag names in the order they were attached.
+func (p Projection) TagNames() []string {
+	return p.tagNames
+}
+
+// Invisible reports whether the article is hidden from readers.
+func (p Projection) Invisible() bool {
+	return p.invisible
+}
+
+// Draft reports whether the article has not been published yet.
+func (p Projection) Draft() bool {
+	return p.draft
+}
+
+// Slug returns the current slug. It is empty if the article has none.
+func (p Projection) Slug() string {
+	return p.slug
+}
+
+// Slugs returns every slug the article has had, oldest first, including the current one.
+// Former slugs stay with the article, so that they can be redirected to it.
+func (p Projection) Slugs() []string {
+	return p.slugs
+}
+
+// Series returns the series the article is a part of.
+func (p Projection) Series() Series {
+	return p.series
+}
+
+// Apply returns the projection with the event applied.
+func (p Projection) Apply(e Event) Projection {
+	if v := e.Title(); v != nil {
+		p.title = *v
+	}
+	if v := e.Content(); v != nil {
+		p.body = *v
+	}
+	if v := e.Thumbnail(); v != nil {
+		p.thumbnail = *v
+		p.thumbnailVariants = nil
+		if e, ok := e.(ThumbnailEvent); ok {
+			p.thumbnailVariants = e.ThumbnailVariants()
+		}
+	}
+	if v := e.Invisible(); v != nil {
+		p.invisible = *v
+	}
+	if v := e.Draft(); v != nil {
+		p.draft = *v
+	}
+	if e, ok := e.(SlugEvent); ok {
+		if v := e.Slug(); v != nil {
+			p.slug = *v
+			if *v != "" && !slices.Contains(p.slugs, *v) {
+				p.slugs = append(slices.Clone(p.slugs), *v)
+			}
+		}
+	}
+	if e, ok := e.(SeriesEvent); ok {
+		if v := e.SeriesID(); v != nil {
+			p.series.id = *v
+		}
+		if v := e.SeriesTitle(); v != nil {
+			p.series.title = *v
+		}
+		if v := e.SeriesPosition(); v != nil {
+			p.series.position = *v
+		}
+	}
+	tagNames := slices.Clone(p.tagNames)
+	for _, name := range slices.Concat(e.Tags(), e.AttachTags()) {
+		if !slices.Contains(tagNames, name) {
+			tagNames = append(tagNames, name)
+		}
+	}
+	p.tagNames = slices.DeleteFunc(tagNames, func(v string) bool {
+		return slices.Contains(e.DetachTags(), v)
+	})
+	return p
+}
+
+// Project replays the events, oldest first, and returns the resulting state of the article.
+func Project[E Event](events []E) Projection {
+	p := Projection{
+		tagNames: make([]string, 0),
+	}
+	for _, e := range events {
+		p = p.Apply(e)
+	}
+	return p
+}
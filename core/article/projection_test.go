@@ -0,0 +1,284 @@
+package article
+
+import (
+	"reflect"
+	"testing"
+)
+
+type event struct {
+	title             *string
+	content           *string
+	thumbnail         *string
+	thumbnailVariants []ImageVariant
+	tags              []string
+	attachTags        []string
+	detachTags        []string
+	invisible         *bool
+	draft             *bool
+	slug              *string
+	series            *Series
+}
+
+func (e event) Title() *string       { return e.title }
+func (e event) Content() *string     { return e.content }
+func (e event) Thumbnail() *string   { return e.thumbnail }
+func (e event) Tags() []string       { return e.tags }
+func (e event) AttachTags() []string { return e.attachTags }
+func (e event) DetachTags() []string { return e.detachTags }
+func (e event) Invisible() *bool     { return e.invisible }
+func (e event) Draft() *bool         { return e.draft }
+func (e event) Slug() *string        { return e.slug }
+
+func (e event) ThumbnailVariants() []ImageVariant { return e.thumbnailVariants }
+
+func (e event) SeriesID() *string {
+	if e.series == nil {
+		return nil
+	}
+	return &e.series.id
+}
+
+func (e event) SeriesTitle() *string {
+	if e.series == nil {
+		return nil
+	}
+	return &e.series.title
+}
+
+func (e event) SeriesPosition() *int {
+	if e.series == nil {
+		return nil
+	}
+	return &e.series.position
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestProject(t *testing.T) {
+	type testCase struct {
+		events []event
+		want   Projection
+	}
+	tests := map[string]testCase{
+		"happy_path/no_events": {
+			events: nil,
+			want: Projection{
+				tagNames: []string{},
+			},
+		},
+		"happy_path/create_only": {
+			events: []event{
+				{
+					title:     ptr("title"),
+					content:   ptr("body"),
+					thumbnail: ptr("https://example.com/example.png"),
+					tags:      []string{"tag1", "tag2"},
+					draft:     ptr(true),
+				},
+			},
+			want: Projection{
+				title:     "title",
+				body:      "body",
+				thumbnail: "https://example.com/example.png",
+				tagNames:  []string{"tag1", "tag2"},
+				draft:     true,
+			},
+		},
+		"happy_path/later_events_win": {
+			events: []event{
+				{
+					title:     ptr("title1"),
+					content:   ptr("body1"),
+					thumbnail: ptr("https://example.com/example1.png"),
+					tags:      []string{"tag1", "tag2"},
+				},
+				{
+					title: ptr("title2"),
+				},
+				{
+					attachTags: []string{"tag2", "tag3"},
+				},
+				{
+					detachTags: []string{"tag1"},
+				},
+				{
+					invisible: ptr(true),
+				},
+				{
+					content:   ptr("body2"),
+					invisible: ptr(false),
+				},
+			},
+			want: Projection{
+				title:     "title2",
+				body:      "body2",
+				thumbnail: "https://example.com/example1.png",
+				tagNames:  []string{"tag2", "tag3"},
+			},
+		},
+		"happy_path/slug_changes": {
+			events: []event{
+				{
+					title: ptr("title"),
+					slug:  ptr("first"),
+				},
+				{
+					slug: ptr("second"),
+				},
+				{
+					title: ptr("title2"),
+				},
+				{
+					slug: ptr("first"),
+				},
+			},
+			want: Projection{
+				title:    "title2",
+				tagNames: []string{},
+				slug:     "first",
+				slugs:    []string{"first", "second"},
+			},
+		},
+		"happy_path/series_changes": {
+			events: []event{
+				{
+					title: ptr("title"),
+				},
+				{
+					series: &Series{id: "Series1", title: "Go tutorial", position: 1},
+				},
+				{
+					series: &Series{id: "Series1", title: "Go tutorial", position: 2},
+				},
+			},
+			want: Projection{
+				title:    "title",
+				tagNames: []string{},
+				series:   Series{id: "Series1", title: "Go tutorial", position: 2},
+			},
+		},
+		"happy_path/thumbnail_variants_replaced_with_thumbnail": {
+			events: []event{
+				{
+					thumbnail: ptr("https://example.com/example1.png"),
+					thumbnailVariants: []ImageVariant{
+						NewImageVariant("https://example.com/example1-640w.webp", 640, 480, "image/webp"),
+					},
+				},
+				{
+					title:             ptr("title"),
+					thumbnailVariants: []ImageVariant{NewImageVariant("https://example.com/ignored-640w.webp", 640, 480, "image/webp")},
+				},
+				{
+					thumbnail: ptr("https://example.com/example2.png"),
+					thumbnailVariants: []ImageVariant{
+						NewImageVariant("https://example.com/example2-640w.png", 640, 360, "image/png"),
+						NewImageVariant("https://example.com/example2-640w.avif", 640, 360, "image/avif"),
+					},
+				},
+			},
+			want: Projection{
+				title:     "title",
+				thumbnail: "https://example.com/example2.png",
+				thumbnailVariants: []ImageVariant{
+					{url: "https://example.com/example2-640w.png", width: 640, height: 360, contentType: "image/png"},
+					{url: "https://example.com/example2-640w.avif", width: 640, height: 360, contentType: "image/avif"},
+				},
+				tagNames: []string{},
+			},
+		},
+		"happy_path/thumbnail_variants_cleared_with_thumbnail": {
+			events: []event{
+				{
+					thumbnail: ptr("https://example.com/example1.png"),
+					thumbnailVariants: []ImageVariant{
+						NewImageVariant("https://example.com/example1-640w.webp", 640, 480, "image/webp"),
+					},
+				},
+				{
+					thumbnail: ptr("https://example.com/example2.png"),
+				},
+			},
+			want: Projection{
+				thumbnail: "https://example.com/example2.png",
+				tagNames:  []string{},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := Project(tt.events); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Project() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProjection_Apply(t *testing.T) {
+	before := Projection{
+		title:    "title",
+		tagNames: []string{"tag1"},
+	}
+	after := before.Apply(event{
+		title:      ptr("title2"),
+		attachTags: []string{"tag2"},
+	})
+	if !reflect.DeepEqual(before, Projection{title: "title", tagNames: []string{"tag1"}}) {
+		t.Errorf("Apply() changed the receiver: %v", before)
+	}
+	if !reflect.DeepEqual(after, Projection{title: "title2", tagNames: []string{"tag1", "tag2"}}) {
+		t.Errorf("Apply() = %v", after)
+	}
+}
+
+func TestApplyWithoutSlugOrSeriesEvent(t *testing.T) {
+	before := Projection{
+		tagNames: []string{},
+		slug:     "first",
+		slugs:    []string{"first"},
+		series:   Series{id: "Series1", title: "Go tutorial", position: 1},
+	}
+	// struct{ Event } hides every method but those of Event, as events written against 0.25.0 have.
+	after := before.Apply(struct{ Event }{event{
+		title:  ptr("title"),
+		slug:   ptr("second"),
+		series: &Series{id: "Series2", title: "Rust tutorial", position: 2},
+	}})
+	want := Projection{
+		title:    "title",
+		tagNames: []string{},
+		slug:     "first",
+		slugs:    []string{"first"},
+		series:   Series{id: "Series1", title: "Go tutorial", position: 1},
+	}
+	if !reflect.DeepEqual(after, want) {
+		t.Errorf("Apply() = %v", after)
+	}
+}
+
+func TestApplyWithoutThumbnailEvent(t *testing.T) {
+	before := Projection{
+		thumbnail: "https://example.com/example1.png",
+		thumbnailVariants: []ImageVariant{
+			NewImageVariant("https://example.com/example1-640w.webp", 640, 480, "image/webp"),
+		},
+		tagNames: []string{},
+	}
+	// struct{ Event } hides every method but those of Event, as events written against 0.27.0 have.
+	// The variants of the former thumbnail do not fit the new one, so they are dropped.
+	after := before.Apply(struct{ Event }{event{
+		thumbnail: ptr("https://example.com/example2.png"),
+		thumbnailVariants: []ImageVariant{
+			NewImageVariant("https://example.com/example2-640w.webp", 640, 480, "image/webp"),
+		},
+	}})
+	want := Projection{
+		thumbnail: "https://example.com/example2.png",
+		tagNames:  []string{},
+	}
+	if !reflect.DeepEqual(after, want) {
+		t.Errorf("Apply() = %v", after)
+	}
+}
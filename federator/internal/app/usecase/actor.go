@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/echo/middlewares"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+)
+
+const (
+	// headerActorSubject is the request header blogging-event-service records the subject of the acting user from.
+	headerActorSubject = "X-Actor-Subject"
+	// headerActorUsername is the request header blogging-event-service records the username of the acting user from.
+	headerActorUsername = "X-Actor-Username"
+)
+
+// usernameClaims are the claims of the caller's token the username is read from, in order of preference.
+var usernameClaims = []string{"username", "cognito:username"}
+
+// withActor sets the subject and the username of the caller's token to the request headers,
+// so that the events written by the mutation record who made it.
+func withActor[T any](ctx context.Context, req *connect.Request[T]) *connect.Request[T] {
+	token, ok := ctx.Value(middlewares.JWTContextKey{}).(jwt.Token)
+	if !ok {
+		return req
+	}
+	subject, ok := token.Subject()
+	if !ok || subject == "" {
+		return req
+	}
+	req.Header().Set(headerActorSubject, subject)
+	for _, claim := range usernameClaims {
+		var username string
+		if err := token.Get(claim, &username); err == nil && username != "" {
+			req.Header().Set(headerActorUsername, username)
+			break
+		}
+	}
+	return req
+}
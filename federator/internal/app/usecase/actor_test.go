@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/echo/middlewares"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"testing"
+)
+
+func Test_withActor(t *testing.T) {
+	type want struct {
+		subject  string
+		username string
+	}
+	type testCase struct {
+		ctx  func(t *testing.T) context.Context
+		want want
+	}
+	withToken := func(t *testing.T, builder *jwt.Builder) context.Context {
+		token, err := builder.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return context.WithValue(context.Background(), middlewares.JWTContextKey{}, token)
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			ctx: func(t *testing.T) context.Context {
+				return withToken(t, jwt.NewBuilder().Subject("Caller1").Claim("username", "editor"))
+			},
+			want: want{
+				subject:  "Caller1",
+				username: "editor",
+			},
+		},
+		"happy_path/cognito-username": {
+			ctx: func(t *testing.T) context.Context {
+				return withToken(t, jwt.NewBuilder().Subject("Caller1").Claim("cognito:username", "editor"))
+			},
+			want: want{
+				subject:  "Caller1",
+				username: "editor",
+			},
+		},
+		"happy_path/without-username": {
+			ctx: func(t *testing.T) context.Context {
+				return withToken(t, jwt.NewBuilder().Subject("Caller1"))
+			},
+			want: want{
+				subject: "Caller1",
+			},
+		},
+		"happy_path/without-subject": {
+			ctx: func(t *testing.T) context.Context {
+				return withToken(t, jwt.NewBuilder().Claim("username", "editor"))
+			},
+			want: want{},
+		},
+		"happy_path/without-token": {
+			ctx: func(t *testing.T) context.Context {
+				return context.Background()
+			},
+			want: want{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := withActor(tt.ctx(t), connect.NewRequest(&grpc.HideArticleRequest{Id: "Article1"}))
+			if got := req.Header().Get(headerActorSubject); got != tt.want.subject {
+				t.Errorf("withActor() %s = %v, want %v", headerActorSubject, got, tt.want.subject)
+			}
+			if got := req.Header().Get(headerActorUsername); got != tt.want.username {
+				t.Errorf("withActor() %s = %v, want %v", headerActorUsername, got, tt.want.username)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"log/slog"
+
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"google.golang.org/protobuf/proto"
+)
+
+// AddArticleToSeries is a use-case for adding an article to a series.
+type AddArticleToSeries struct {
+	// bloggingEventServiceClient is a client of blogging event service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute adds an article to a series, shifting the later parts back.
+//
+// It sends no idempotency key, since the blogging event service writes an event to each article and cannot replay them as one.
+func (u *AddArticleToSeries) Execute(ctx context.Context, in dto.AddArticleToSeriesInDTO) (dto.AddArticleToSeriesOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("AddArticleToSeries#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+
+	request := &grpc.AddArticleToSeriesRequest{
+		SeriesId:  in.SeriesID(),
+		ArticleId: in.ArticleID(),
+	}
+	if in.Position() > 0 {
+		request.Position = proto.Int32(int32(in.Position()))
+	}
+	response, err := u.bloggingEventServiceClient.AddArticleToSeries(
+		newrelic.NewContext(ctx, nrtx),
+		withActor(ctx, connect.NewRequest(request)))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.AddArticleToSeriesOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.AddArticleToSeriesOutDTO{}, err
+	}
+
+	out := dto.NewAddArticleToSeriesOutDTO(response.Msg.GetSeriesId(), seriesArticlesFromPB(response.Msg.GetEvents()), in.ClientMutationID())
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.AddArticleToSeriesOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// NewAddArticleToSeries is a constructor of AddArticleToSeries.
+func NewAddArticleToSeries(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *AddArticleToSeries {
+	return &AddArticleToSeries{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
@@ -18,13 +18,40 @@ import (
 	"github.com/newrelic/go-agent/v3/newrelic"
 )
 
-// Article is a use-case of getting an article by id.
+// Article is a use-case of getting an article by id or slug.
 type Article struct {
 	// articleServiceClient is a client of article service.
 	articleServiceClient articleconnect.ArticleServiceClient
 }
 
-// Execute gets an article by id.
+// getArticle gets the article by slug if the slug is given, otherwise by id.
+// The article found by a former slug has its current slug.
+func (u *Article) getArticle(ctx context.Context, in dto.ArticleInDTO) (*grpc.Article, error) {
+	if in.Slug() != "" {
+		response, err := u.articleServiceClient.GetArticleBySlug(
+			ctx,
+			connect.NewRequest(
+				&grpc.GetArticleBySlugRequest{
+					Slug: in.Slug(),
+				}))
+		if err != nil {
+			return nil, err
+		}
+		return response.Msg.GetArticle(), nil
+	}
+	response, err := u.articleServiceClient.GetArticleById(
+		ctx,
+		connect.NewRequest(
+			&grpc.GetArticleByIdRequest{
+				Id: in.ID(),
+			}))
+	if err != nil {
+		return nil, err
+	}
+	return response.Msg.GetArticle(), nil
+}
+
+// Execute gets an article by id or slug.
 func (u *Article) Execute(ctx context.Context, in dto.ArticleInDTO) (dto.ArticleOutDTO, error) {
 	nrtx := newrelic.FromContext(ctx)
 	defer nrtx.StartSegment("Execute").End()
@@ -37,12 +64,7 @@ func (u *Article) Execute(ctx context.Context, in dto.ArticleInDTO) (dto.Article
 	}
 	logger.InfoContext(ctx, "BEGIN",
 		slog.Group("parameters", slog.Any("in", in)))
-	response, err := u.articleServiceClient.GetArticleById(
-		newrelic.NewContext(ctx, nrtx),
-		connect.NewRequest(
-			&grpc.GetArticleByIdRequest{
-				Id: in.ID(),
-			}))
+	articlePB, err := u.getArticle(newrelic.NewContext(ctx, nrtx), in)
 	if err != nil {
 		err = errors.WithStack(err)
 		logger.WarnContext(ctx, "END",
@@ -52,8 +74,6 @@ func (u *Article) Execute(ctx context.Context, in dto.ArticleInDTO) (dto.Article
 		return dto.ArticleOutDTO{}, err
 	}
 
-	message := response.Msg
-	articlePB := message.Article
 	tagPBs := articlePB.GetTags()
 
 	tagDTOs := make([]dto.Tag, 0, len(tagPBs))
@@ -74,14 +94,27 @@ func (u *Article) Execute(ctx context.Context, in dto.ArticleInDTO) (dto.Article
 				slog.Any("error", err)))
 		return dto.ArticleOutDTO{}, err
 	}
+	thumbnailVariants, err := imageVariantsFromPB(articlePB.GetThumbnailVariants())
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.ArticleOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.ArticleOutDTO{}, err
+	}
 	articleDTO := dto.NewArticleTag(
 		articlePB.Id,
 		articlePB.Title,
 		articlePB.Body,
 		*thumbnailURL,
+		thumbnailVariants,
 		createdAt,
 		updatedAt,
-		tagDTOs)
+		tagDTOs,
+		articlePB.Slug,
+		articlePB.CreatedBy,
+		articlePB.UpdatedBy)
 	out := dto.NewArticleOutDTO(articleDTO)
 	logger.InfoContext(ctx, "END",
 		slog.Group("return",
@@ -90,6 +123,22 @@ func (u *Article) Execute(ctx context.Context, in dto.ArticleInDTO) (dto.Article
 	return out, nil
 }
 
+// imageVariantsFromPB converts grpc.ImageVariant to dto.ImageVariant.
+func imageVariantsFromPB(from []*grpc.ImageVariant) ([]dto.ImageVariant, error) {
+	if len(from) == 0 {
+		return nil, nil
+	}
+	variants := make([]dto.ImageVariant, 0, len(from))
+	for _, v := range from {
+		variantURL, err := url.Parse(v.GetUrl())
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, dto.NewImageVariant(*variantURL, int(v.GetWidth()), int(v.GetHeight()), v.GetContentType()))
+	}
+	return variants, nil
+}
+
 // NewArticle is a constructor of CreateArticle.
 func NewArticle(articleServiceClient articleconnect.ArticleServiceClient) *Article {
 	return &Article{
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log/slog"
+	"net/url"
+)
+
+// ArticleAt is a use-case of getting an article as it was at a point of its history.
+type ArticleAt struct {
+	// bloggingEventServiceClient is a client of blogging event service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute gets an article as it was right after the event or at the time.
+func (u *ArticleAt) Execute(ctx context.Context, in dto.ArticleAtInDTO) (dto.ArticleAtOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ArticleAt#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+	request := grpc.GetArticleAtRequest{
+		Id: in.ID(),
+	}
+	if v := in.Timestamp(); v != nil {
+		request.AsOf = &grpc.GetArticleAtRequest_Timestamp{Timestamp: timestamppb.New(v.StdTime())}
+	} else {
+		request.AsOf = &grpc.GetArticleAtRequest_EventId{EventId: in.EventID()}
+	}
+	response, err := u.bloggingEventServiceClient.GetArticleAt(
+		newrelic.NewContext(ctx, nrtx),
+		connect.NewRequest(&request))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.ArticleAtOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.ArticleAtOutDTO{}, err
+	}
+
+	articlePB := response.Msg.GetArticle()
+	thumbnailURL, err := url.Parse(articlePB.GetThumbnailUrl())
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.ArticleAtOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.ArticleAtOutDTO{}, err
+	}
+	out := dto.NewArticleAtOutDTO(dto.NewArticleSnapshot(
+		articlePB.GetId(),
+		articlePB.GetTitle(),
+		articlePB.GetBody(),
+		*thumbnailURL,
+		articlePB.GetTagNames(),
+		articlePB.GetEventId(),
+		synchro.In[tz.UTC](articlePB.GetEventAt().AsTime())))
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.ArticleAtOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// NewArticleAt is a constructor of ArticleAt.
+func NewArticleAt(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *ArticleAt {
+	return &ArticleAt{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	blogapictx "blogapi.miyamo.today/core/context"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestArticleAt_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  dto.ArticleAtInDTO
+	}
+	type want struct {
+		out dto.ArticleAtOutDTO
+		err error
+	}
+	type testCase struct {
+		bloggingEventServiceClient func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient
+		args                       args
+		want                       want
+		wantErr                    bool
+	}
+	errTestArticleAt := errors.New("test error")
+	mockBlogAPIContext := func() context.Context {
+		return blogapictx.StoreToContext(
+			context.Background(),
+			blogapictx.New(
+				"1234567890",
+				"0987654321",
+				blogapictx.RequestTypeGRPC,
+				nil,
+				nil))
+	}
+	eventAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	response := connect.NewResponse(&grpc.GetArticleAtResponse{
+		Article: &grpc.ArticleSnapshot{
+			Id:           "Article1",
+			Title:        "Title1",
+			Body:         "Body1",
+			ThumbnailUrl: "https://example.com/example.png",
+			TagNames:     []string{"Tag1"},
+			EventId:      "Event1",
+			EventAt:      timestamppb.New(eventAt),
+		},
+	})
+	out := dto.NewArticleAtOutDTO(dto.NewArticleSnapshot(
+		"Article1",
+		"Title1",
+		"Body1",
+		utils.MustURLParse("https://example.com/example.png"),
+		[]string{"Tag1"},
+		"Event1",
+		synchro.In[tz.UTC](eventAt)))
+	tests := map[string]testCase{
+		"happy_path/by_event_id": {
+			bloggingEventServiceClient: func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					GetArticleAt(gomock.Any(), connect.NewRequest(&grpc.GetArticleAtRequest{
+						Id:   "Article1",
+						AsOf: &grpc.GetArticleAtRequest_EventId{EventId: "Event1"},
+					})).
+					Return(response, nil).
+					Times(1)
+				return bloggingEventServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewArticleAtInDTO("Article1", dto.ArticleAtInWithEventID("Event1")),
+			},
+			want: want{
+				out: out,
+			},
+		},
+		"happy_path/by_timestamp": {
+			bloggingEventServiceClient: func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					GetArticleAt(gomock.Any(), connect.NewRequest(&grpc.GetArticleAtRequest{
+						Id:   "Article1",
+						AsOf: &grpc.GetArticleAtRequest_Timestamp{Timestamp: timestamppb.New(eventAt.Add(time.Hour))},
+					})).
+					Return(response, nil).
+					Times(1)
+				return bloggingEventServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewArticleAtInDTO("Article1", dto.ArticleAtInWithTimestamp(synchro.In[tz.UTC](eventAt.Add(time.Hour)))),
+			},
+			want: want{
+				out: out,
+			},
+		},
+		"unhappy_path/grpc_returns_error": {
+			bloggingEventServiceClient: func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					GetArticleAt(gomock.Any(), gomock.Any()).
+					Return(nil, errTestArticleAt).
+					Times(1)
+				return bloggingEventServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewArticleAtInDTO("Article1", dto.ArticleAtInWithEventID("Event1")),
+			},
+			want: want{
+				out: dto.ArticleAtOutDTO{},
+				err: errTestArticleAt,
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			bloggingEventServiceClient := tt.bloggingEventServiceClient(ctrl)
+			u := NewArticleAt(bloggingEventServiceClient)
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Execute() expected error but got nil")
+					return
+				}
+				if !errors.Is(err, tt.want.err) {
+					t.Errorf("Execute() error = %v, want %v", err, tt.wantErr)
+					return
+				}
+			} else if err != nil {
+				t.Errorf("Execute() expected nil but got error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want.out)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+	"net/url"
+	"slices"
+)
+
+// ErrUnknownHistoryCursor is returned when the after cursor is not an event of the article.
+var ErrUnknownHistoryCursor = errors.New("unknown history cursor")
+
+// ArticleHistory is a use-case of listing the events of an article.
+type ArticleHistory struct {
+	// bloggingEventServiceClient is a client of blogging event service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute lists the events of an article, oldest first.
+func (u *ArticleHistory) Execute(ctx context.Context, in dto.ArticleHistoryInDTO) (dto.ArticleHistoryOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ArticleHistory#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+	response, err := u.bloggingEventServiceClient.ListArticleEvents(
+		newrelic.NewContext(ctx, nrtx),
+		connect.NewRequest(&grpc.ListArticleEventsRequest{
+			Id: in.ID(),
+		}))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.ArticleHistoryOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.ArticleHistoryOutDTO{}, err
+	}
+
+	eventPBs := response.Msg.GetEvents()
+	// the cursor of an event is its id.
+	start := 0
+	if in.After() != "" {
+		i := slices.IndexFunc(eventPBs, func(e *grpc.ArticleEvent) bool {
+			return e.GetId() == in.After()
+		})
+		if i < 0 {
+			err = errors.WithStack(ErrUnknownHistoryCursor)
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*dto.ArticleHistoryOutDTO", nil),
+					slog.Any("error", err)))
+			return dto.ArticleHistoryOutDTO{}, err
+		}
+		start = i + 1
+	}
+	end := len(eventPBs)
+	if in.First() > 0 && start+in.First() < end {
+		end = start + in.First()
+	}
+
+	events := make([]dto.ArticleEvent, 0, end-start)
+	for _, eventPB := range eventPBs[start:end] {
+		event, err := articleEventFromPB(eventPB)
+		if err != nil {
+			err = errors.WithStack(err)
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*dto.ArticleHistoryOutDTO", nil),
+					slog.Any("error", err)))
+			return dto.ArticleHistoryOutDTO{}, err
+		}
+		events = append(events, event)
+	}
+	out := dto.NewArticleHistoryOutDTO(events, end < len(eventPBs), len(eventPBs))
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.ArticleHistoryOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// articleEventFromPB converts grpc.ArticleEvent to dto.ArticleEvent.
+func articleEventFromPB(from *grpc.ArticleEvent) (dto.ArticleEvent, error) {
+	options := []dto.ArticleEventOption{
+		dto.ArticleEventWithTagNames(from.GetTagNames()...),
+		dto.ArticleEventWithAttachTagNames(from.GetAttachTagNames()...),
+		dto.ArticleEventWithDetachTagNames(from.GetDetachTagNames()...),
+	}
+	if from.Title != nil {
+		options = append(options, dto.ArticleEventWithTitle(from.GetTitle()))
+	}
+	if from.Body != nil {
+		options = append(options, dto.ArticleEventWithBody(from.GetBody()))
+	}
+	if from.ThumbnailUrl != nil {
+		thumbnailURL, err := url.Parse(from.GetThumbnailUrl())
+		if err != nil {
+			return dto.ArticleEvent{}, err
+		}
+		options = append(options, dto.ArticleEventWithThumbnailURL(*thumbnailURL))
+	}
+	if from.Invisible != nil {
+		options = append(options, dto.ArticleEventWithInvisible(from.GetInvisible()))
+	}
+	if from.PublishAt != nil {
+		options = append(options, dto.ArticleEventWithPublishAt(synchro.In[tz.UTC](from.GetPublishAt().AsTime())))
+	}
+	if from.Draft != nil {
+		options = append(options, dto.ArticleEventWithDraft(from.GetDraft()))
+	}
+	if from.Actor != nil {
+		options = append(options, dto.ArticleEventWithActor(from.GetActor()))
+	}
+	if from.RevertedTo != nil {
+		options = append(options, dto.ArticleEventWithRevertedTo(from.GetRevertedTo()))
+	}
+	if from.Slug != nil {
+		options = append(options, dto.ArticleEventWithSlug(from.GetSlug()))
+	}
+	if from.SeriesId != nil {
+		options = append(options, dto.ArticleEventWithSeries(from.GetSeriesId(), from.GetSeriesTitle(), int(from.GetSeriesPosition())))
+	}
+	return dto.NewArticleEvent(from.GetId(), from.GetType(), synchro.In[tz.UTC](from.GetOccurredAt().AsTime()), options...), nil
+}
+
+// NewArticleHistory is a constructor of ArticleHistory.
+func NewArticleHistory(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *ArticleHistory {
+	return &ArticleHistory{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	blogapictx "blogapi.miyamo.today/core/context"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestArticleHistory_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  dto.ArticleHistoryInDTO
+	}
+	type want struct {
+		out dto.ArticleHistoryOutDTO
+		err error
+	}
+	type testCase struct {
+		bloggingEventServiceClient func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient
+		args                       args
+		want                       want
+		wantErr                    bool
+	}
+	errTestArticleHistory := errors.New("test error")
+	mockBlogAPIContext := func() context.Context {
+		return blogapictx.StoreToContext(
+			context.Background(),
+			blogapictx.New(
+				"1234567890",
+				"0987654321",
+				blogapictx.RequestTypeGRPC,
+				nil,
+				nil))
+	}
+	occurredAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	eventPBs := func() []*grpc.ArticleEvent {
+		return []*grpc.ArticleEvent{
+			{
+				Id:           "Event1",
+				Type:         "CREATE_ARTICLE",
+				OccurredAt:   timestamppb.New(occurredAt),
+				Title:        proto.String("Title1"),
+				Body:         proto.String("Body1"),
+				ThumbnailUrl: proto.String("https://example.com/example.png"),
+				TagNames:     []string{"Tag1"},
+			},
+			{
+				Id:         "Event2",
+				Type:       "HIDE_ARTICLE",
+				OccurredAt: timestamppb.New(occurredAt.Add(time.Hour)),
+				Invisible:  proto.Bool(true),
+				Actor:      proto.String("editor"),
+			},
+			{
+				Id:         "Event3",
+				Type:       "SCHEDULE_ARTICLE",
+				OccurredAt: timestamppb.New(occurredAt.Add(2 * time.Hour)),
+				PublishAt:  timestamppb.New(occurredAt.Add(24 * time.Hour)),
+			},
+		}
+	}
+	thumbnailURL := utils.MustURLParse("https://example.com/example.png")
+	publishAt := synchro.In[tz.UTC](occurredAt.Add(24 * time.Hour))
+	event1 := dto.NewArticleEvent(
+		"Event1",
+		"CREATE_ARTICLE",
+		synchro.In[tz.UTC](occurredAt),
+		dto.ArticleEventWithTitle("Title1"),
+		dto.ArticleEventWithBody("Body1"),
+		dto.ArticleEventWithThumbnailURL(thumbnailURL),
+		dto.ArticleEventWithTagNames("Tag1"))
+	event2 := dto.NewArticleEvent(
+		"Event2",
+		"HIDE_ARTICLE",
+		synchro.In[tz.UTC](occurredAt.Add(time.Hour)),
+		dto.ArticleEventWithInvisible(true),
+		dto.ArticleEventWithActor("editor"))
+	event3 := dto.NewArticleEvent(
+		"Event3",
+		"SCHEDULE_ARTICLE",
+		synchro.In[tz.UTC](occurredAt.Add(2*time.Hour)),
+		dto.ArticleEventWithPublishAt(publishAt))
+	listArticleEvents := func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient {
+		bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+		bloggingEventServiceClient.EXPECT().
+			ListArticleEvents(gomock.Any(), connect.NewRequest(&grpc.ListArticleEventsRequest{Id: "Article1"})).
+			Return(connect.NewResponse(&grpc.ListArticleEventsResponse{Events: eventPBs()}), nil).
+			Times(1)
+		return bloggingEventServiceClient
+	}
+	tests := map[string]testCase{
+		"happy_path/all": {
+			bloggingEventServiceClient: listArticleEvents,
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewArticleHistoryInDTO("Article1", 0, ""),
+			},
+			want: want{
+				out: dto.NewArticleHistoryOutDTO([]dto.ArticleEvent{event1, event2, event3}, false, 3),
+			},
+		},
+		"happy_path/first": {
+			bloggingEventServiceClient: listArticleEvents,
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewArticleHistoryInDTO("Article1", 2, ""),
+			},
+			want: want{
+				out: dto.NewArticleHistoryOutDTO([]dto.ArticleEvent{event1, event2}, true, 3),
+			},
+		},
+		"happy_path/first_after": {
+			bloggingEventServiceClient: listArticleEvents,
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewArticleHistoryInDTO("Article1", 2, "Event1"),
+			},
+			want: want{
+				out: dto.NewArticleHistoryOutDTO([]dto.ArticleEvent{event2, event3}, false, 3),
+			},
+		},
+		"happy_path/after_last": {
+			bloggingEventServiceClient: listArticleEvents,
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewArticleHistoryInDTO("Article1", 0, "Event3"),
+			},
+			want: want{
+				out: dto.NewArticleHistoryOutDTO([]dto.ArticleEvent{}, false, 3),
+			},
+		},
+		"unhappy_path/unknown_cursor": {
+			bloggingEventServiceClient: listArticleEvents,
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewArticleHistoryInDTO("Article1", 0, "Unknown"),
+			},
+			want: want{
+				out: dto.ArticleHistoryOutDTO{},
+				err: ErrUnknownHistoryCursor,
+			},
+			wantErr: true,
+		},
+		"unhappy_path/grpc_returns_error": {
+			bloggingEventServiceClient: func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					ListArticleEvents(gomock.Any(), gomock.Any()).
+					Return(nil, errTestArticleHistory).
+					Times(1)
+				return bloggingEventServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewArticleHistoryInDTO("Article1", 0, ""),
+			},
+			want: want{
+				out: dto.ArticleHistoryOutDTO{},
+				err: errTestArticleHistory,
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			bloggingEventServiceClient := tt.bloggingEventServiceClient(ctrl)
+			u := NewArticleHistory(bloggingEventServiceClient)
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Execute() expected error but got nil")
+					return
+				}
+				if !errors.Is(err, tt.want.err) {
+					t.Errorf("Execute() error = %v, want %v", err, tt.wantErr)
+					return
+				}
+			} else if err != nil {
+				t.Errorf("Execute() expected nil but got error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want.out)
+			}
+		})
+	}
+}
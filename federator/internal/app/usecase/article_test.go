@@ -81,11 +81,15 @@ func TestArticle_Execute(t *testing.T) {
 						"happy_path/single_tag",
 						"## happy_path/single_tag",
 						utils.MustURLParse("example.com/example.png"),
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						[]dto.Tag{
 							dto.NewTag("Tag1", "Tag1"),
-						}),
+						},
+						"",
+						"",
+						""),
 				),
 			},
 		},
@@ -132,6 +136,7 @@ func TestArticle_Execute(t *testing.T) {
 						"happy_path/multiple_tags",
 						"## happy_path/multiple_tags",
 						utils.MustURLParse("example.com/example.png"),
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						[]dto.Tag{
@@ -139,6 +144,9 @@ func TestArticle_Execute(t *testing.T) {
 							dto.NewTag("Tag2", "Tag2"),
 							dto.NewTag("Tag3", "Tag3"),
 						},
+						"",
+						"",
+						"",
 					),
 				),
 			},
@@ -172,9 +180,97 @@ func TestArticle_Execute(t *testing.T) {
 						"happy_path/no_tags",
 						"## happy_path/no_tags",
 						utils.MustURLParse("example.com/example.png"),
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
-						[]dto.Tag{})),
+						[]dto.Tag{},
+						"",
+						"",
+						"")),
+			},
+		},
+		"happy_path/thumbnail_variants": {
+			articleServiceClient: func(ctrl *gomock.Controller) articleconnect.ArticleServiceClient {
+				articleServiceClient := marticleconnect.NewMockArticleServiceClient(ctrl)
+				articleServiceClient.EXPECT().
+					GetArticleById(gomock.Any(), gomock.Any()).
+					Return(connect.NewResponse(&grpc.GetArticleByIdResponse{
+						Article: &grpc.Article{
+							Id:           "Article1",
+							Title:        "happy_path/thumbnail_variants",
+							Body:         "## happy_path/thumbnail_variants",
+							ThumbnailUrl: "example.com/example.png",
+							ThumbnailVariants: []*grpc.ImageVariant{
+								{Url: "example.com/example-640w.webp", Width: 640, Height: 480, ContentType: "image/webp"},
+							},
+							CreatedAt: timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+							UpdatedAt: timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+						},
+					}), nil).
+					Times(1)
+				return articleServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewArticleInDTO("Article1"),
+			},
+			want: want{
+				out: dto.NewArticleOutDTO(
+					dto.NewArticleTag(
+						"Article1",
+						"happy_path/thumbnail_variants",
+						"## happy_path/thumbnail_variants",
+						utils.MustURLParse("example.com/example.png"),
+						[]dto.ImageVariant{
+							dto.NewImageVariant(utils.MustURLParse("example.com/example-640w.webp"), 640, 480, "image/webp"),
+						},
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						[]dto.Tag{},
+						"",
+						"",
+						"")),
+			},
+		},
+		"happy_path/former_slug": {
+			articleServiceClient: func(ctrl *gomock.Controller) articleconnect.ArticleServiceClient {
+				articleServiceClient := marticleconnect.NewMockArticleServiceClient(ctrl)
+				articleServiceClient.EXPECT().
+					GetArticleBySlug(gomock.Any(), gomock.Any()).
+					Return(connect.NewResponse(&grpc.GetArticleBySlugResponse{
+						Article: &grpc.Article{
+							Id:           "Article1",
+							Title:        "happy_path/former_slug",
+							Body:         "## happy_path/former_slug",
+							ThumbnailUrl: "example.com/example.png",
+							CreatedAt:    timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+							UpdatedAt:    timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+							Slug:         "current-slug",
+							CreatedBy:    "author",
+							UpdatedBy:    "editor",
+						},
+					}), nil).
+					Times(1)
+				return articleServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewArticleBySlugInDTO("former-slug"),
+			},
+			want: want{
+				out: dto.NewArticleOutDTO(
+					dto.NewArticleTag(
+						"Article1",
+						"happy_path/former_slug",
+						"## happy_path/former_slug",
+						utils.MustURLParse("example.com/example.png"),
+						nil,
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						[]dto.Tag{},
+						"current-slug",
+						"author",
+						"editor")),
 			},
 		},
 		"unhappy_path/grpc_returns_error": {
@@ -196,6 +292,25 @@ func TestArticle_Execute(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		"unhappy_path/grpc_returns_error_by_slug": {
+			articleServiceClient: func(ctrl *gomock.Controller) articleconnect.ArticleServiceClient {
+				articleServiceClient := marticleconnect.NewMockArticleServiceClient(ctrl)
+				articleServiceClient.EXPECT().
+					GetArticleBySlug(gomock.Any(), gomock.Any()).
+					Return(nil, errTestArticle).
+					Times(1)
+				return articleServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewArticleBySlugInDTO("former-slug"),
+			},
+			want: want{
+				out: dto.ArticleOutDTO{},
+				err: errTestArticle,
+			},
+			wantErr: true,
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
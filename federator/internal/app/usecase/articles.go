@@ -112,15 +112,28 @@ func (u *Articles) executeNextPaging(ctx context.Context, in dto.ArticlesInDTO)
 					slog.Any("error", err)))
 			return dto.ArticlesOutDTO{}, err
 		}
+		thumbnailVariants, err := imageVariantsFromPB(article.GetThumbnailVariants())
+		if err != nil {
+			err = errors.WithStack(err)
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*dto.ArticleOutDTO", nil),
+					slog.Any("error", err)))
+			return dto.ArticlesOutDTO{}, err
+		}
 
 		articleDTOs = append(articleDTOs, dto.NewArticleTag(
 			article.Id,
 			article.Title,
 			article.Body,
 			*thumbnailURL,
+			thumbnailVariants,
 			createdAt,
 			updatedAt,
-			tagDTOs))
+			tagDTOs,
+			article.Slug,
+			article.CreatedBy,
+			article.UpdatedBy))
 	}
 	out := dto.NewArticlesOutDTO(articleDTOs, dto.ArticlesOutDTOWithHasNext(message.StillExists))
 	logger.InfoContext(ctx, "END",
@@ -191,6 +204,15 @@ func (u *Articles) executePrevPaging(ctx context.Context, in dto.ArticlesInDTO)
 					slog.Any("error", err)))
 			return dto.ArticlesOutDTO{}, err
 		}
+		thumbnailVariants, err := imageVariantsFromPB(article.GetThumbnailVariants())
+		if err != nil {
+			err = errors.WithStack(err)
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*dto.ArticleOutDTO", nil),
+					slog.Any("error", err)))
+			return dto.ArticlesOutDTO{}, err
+		}
 
 		tagPBs := article.GetTags()
 		tagDTOs := make([]dto.Tag, 0, len(tagPBs))
@@ -204,9 +226,13 @@ func (u *Articles) executePrevPaging(ctx context.Context, in dto.ArticlesInDTO)
 			article.Title,
 			article.Body,
 			*thumbnailURL,
+			thumbnailVariants,
 			createdAt,
 			updatedAt,
-			tagDTOs))
+			tagDTOs,
+			article.Slug,
+			article.CreatedBy,
+			article.UpdatedBy))
 	}
 	out := dto.NewArticlesOutDTO(articleDTOs, dto.ArticlesOutDTOWithHasPrev(message.StillExists))
 	logger.InfoContext(ctx, "END",
@@ -255,6 +281,15 @@ func (u *Articles) execute(ctx context.Context) (dto.ArticlesOutDTO, error) {
 					slog.Any("error", err)))
 			return dto.ArticlesOutDTO{}, err
 		}
+		thumbnailVariants, err := imageVariantsFromPB(article.GetThumbnailVariants())
+		if err != nil {
+			err = errors.WithStack(err)
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*dto.ArticleOutDTO", nil),
+					slog.Any("error", err)))
+			return dto.ArticlesOutDTO{}, err
+		}
 
 		tagPBs := article.GetTags()
 		tagDTOs := make([]dto.Tag, 0, len(tagPBs))
@@ -268,9 +303,13 @@ func (u *Articles) execute(ctx context.Context) (dto.ArticlesOutDTO, error) {
 			article.Title,
 			article.Body,
 			*thumbnailURL,
+			thumbnailVariants,
 			createdAt,
 			updatedAt,
-			tagDTOs))
+			tagDTOs,
+			article.Slug,
+			article.CreatedBy,
+			article.UpdatedBy))
 	}
 	out := dto.NewArticlesOutDTO(articleDTOs)
 	logger.InfoContext(ctx, "END",
@@ -88,11 +88,15 @@ func TestArticles_Execute(t *testing.T) {
 							"happy_path/next_paging",
 							"## happy_path/next_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 					dto.ArticlesOutDTOWithHasNext(true),
 				),
@@ -162,11 +166,15 @@ func TestArticles_Execute(t *testing.T) {
 							"happy_path/prev_paging",
 							"## happy_path/prev_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 					dto.ArticlesOutDTOWithHasPrev(true),
 				),
@@ -232,11 +240,15 @@ func TestArticles_Execute(t *testing.T) {
 							"happy_path/execute",
 							"## happy_path/execute",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 				),
 			},
@@ -357,11 +369,15 @@ func TestArticles_executeNextPaging(t *testing.T) {
 							"happy_path/next_paging",
 							"## happy_path/next_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 					dto.ArticlesOutDTOWithHasNext(true),
 				),
@@ -486,11 +502,15 @@ func TestArticles_executePrevPaging(t *testing.T) {
 							"happy_path/prev_paging",
 							"## happy_path/prev_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 					dto.ArticlesOutDTOWithHasPrev(true),
 				),
@@ -609,11 +629,15 @@ func TestArticles_execute(t *testing.T) {
 							"happy_path/all_articles",
 							"## happy_path/all_articles",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 				),
 			},
@@ -13,6 +13,8 @@ import (
 	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
 	"github.com/cockroachdb/errors"
 	"github.com/newrelic/go-agent/v3/newrelic"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // CreateArticle is a use-case of create an article by id.
@@ -36,14 +38,26 @@ func (u *CreateArticle) Execute(ctx context.Context, in dto.CreateArticleInDTO)
 		slog.Group("parameters", slog.Any("in", in)))
 
 	thumbnail := in.ThumbnailURL()
+	var publishAt *timestamppb.Timestamp
+	if v := in.PublishAt(); v != nil {
+		publishAt = timestamppb.New(v.StdTime())
+	}
+	var draft *bool
+	if in.Draft() {
+		draft = proto.Bool(true)
+	}
 	response, err := u.bloggingEventServiceClient.CreateArticle(
 		newrelic.NewContext(ctx, nrtx),
-		connect.NewRequest(&grpc.CreateArticleRequest{
-			Title:        in.Title(),
-			Body:         in.Body(),
-			ThumbnailUrl: thumbnail.String(),
-			TagNames:     in.TagNames(),
-		}))
+		withActor(ctx, withIdempotencyKey(ctx, connect.NewRequest(&grpc.CreateArticleRequest{
+			Title:             in.Title(),
+			Body:              in.Body(),
+			ThumbnailUrl:      thumbnail.String(),
+			ThumbnailVariants: imageVariantPBs(in.ThumbnailVariants()),
+			TagNames:          in.TagNames(),
+			PublishAt:         publishAt,
+			Draft:             draft,
+			Slug:              in.Slug(),
+		}), in.ClientMutationID())))
 	if err != nil {
 		err = errors.WithStack(err)
 		logger.WarnContext(ctx, "END",
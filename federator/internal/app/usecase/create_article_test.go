@@ -10,10 +10,14 @@ import (
 	"connectrpc.com/connect"
 	"context"
 	"fmt"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
 	"github.com/cockroachdb/errors"
 	"github.com/google/go-cmp/cmp"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"testing"
 )
 
@@ -60,7 +64,76 @@ func TestCreateArticle_Execute(t *testing.T) {
 			}),
 			args: args{
 				ctx: mockBlogAPIContext(),
-				in:  dto.NewCreateArticleInDTO("Title1", "happy_path", utils.MustURLParse("https://example.com/example.png"), []string{"Tag1"}, "Mutation1"),
+				in:  dto.NewCreateArticleInDTO("Title1", "happy_path", utils.MustURLParse("https://example.com/example.png"), nil, []string{"Tag1"}, nil, false, "Mutation1", nil),
+			},
+			want: want{
+				out: dto.NewCreateArticleOutDTO("Event1", "Article1", "Mutation1"),
+			},
+		},
+		"happy_path:with-publish-at": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.CreateArticleRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					CreateArticle(gomock.Any(), NewCreateArticleRequestMatcher(t, req)).
+					Return(connect.NewResponse(&grpc.BloggingEventResponse{EventId: "Event1", ArticleId: "Article1"}), nil).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.CreateArticleRequest{
+				Title:        "Title1",
+				Body:         "happy_path",
+				ThumbnailUrl: "https://example.com/example.png",
+				TagNames:     []string{"Tag1"},
+				PublishAt:    timestamppb.New(synchro.New[tz.UTC](2026, 1, 1, 0, 0, 0, 0).StdTime()),
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewCreateArticleInDTO("Title1", "happy_path", utils.MustURLParse("https://example.com/example.png"), nil, []string{"Tag1"}, func() *synchro.Time[tz.UTC] { v := synchro.New[tz.UTC](2026, 1, 1, 0, 0, 0, 0); return &v }(), false, "Mutation1", nil),
+			},
+			want: want{
+				out: dto.NewCreateArticleOutDTO("Event1", "Article1", "Mutation1"),
+			},
+		},
+		"happy_path:as-draft": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.CreateArticleRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					CreateArticle(gomock.Any(), NewCreateArticleRequestMatcher(t, req)).
+					Return(connect.NewResponse(&grpc.BloggingEventResponse{EventId: "Event1", ArticleId: "Article1"}), nil).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.CreateArticleRequest{
+				Title:        "Title1",
+				Body:         "happy_path",
+				ThumbnailUrl: "https://example.com/example.png",
+				TagNames:     []string{"Tag1"},
+				Draft:        proto.Bool(true),
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewCreateArticleInDTO("Title1", "happy_path", utils.MustURLParse("https://example.com/example.png"), nil, []string{"Tag1"}, nil, true, "Mutation1", nil),
+			},
+			want: want{
+				out: dto.NewCreateArticleOutDTO("Event1", "Article1", "Mutation1"),
+			},
+		},
+		"happy_path:slug": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.CreateArticleRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					CreateArticle(gomock.Any(), NewCreateArticleRequestMatcher(t, req)).
+					Return(connect.NewResponse(&grpc.BloggingEventResponse{EventId: "Event1", ArticleId: "Article1"}), nil).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.CreateArticleRequest{
+				Title:        "Title1",
+				Body:         "happy_path",
+				ThumbnailUrl: "https://example.com/example.png",
+				TagNames:     []string{"Tag1"},
+				Slug:         proto.String("hello-world"),
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewCreateArticleInDTO("Title1", "happy_path", utils.MustURLParse("https://example.com/example.png"), nil, []string{"Tag1"}, nil, false, "Mutation1", proto.String("hello-world")),
 			},
 			want: want{
 				out: dto.NewCreateArticleOutDTO("Event1", "Article1", "Mutation1"),
@@ -82,7 +155,7 @@ func TestCreateArticle_Execute(t *testing.T) {
 			}),
 			args: args{
 				ctx: mockBlogAPIContext(),
-				in:  dto.NewCreateArticleInDTO("Title1", "happy_path", utils.MustURLParse("https://example.com/example.png"), []string{"Tag1"}, "Mutation1"),
+				in:  dto.NewCreateArticleInDTO("Title1", "happy_path", utils.MustURLParse("https://example.com/example.png"), nil, []string{"Tag1"}, nil, false, "Mutation1", nil),
 			},
 			want: want{
 				out: dto.CreateArticleOutDTO{},
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"log/slog"
+
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// CreateSeries is a use-case for creating a series of articles.
+type CreateSeries struct {
+	// bloggingEventServiceClient is a client of blogging event service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute creates a series of the articles, in the order they are given.
+//
+// It sends no idempotency key, since the blogging event service writes an event to each article and cannot replay them as one.
+func (u *CreateSeries) Execute(ctx context.Context, in dto.CreateSeriesInDTO) (dto.CreateSeriesOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("CreateSeries#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+
+	response, err := u.bloggingEventServiceClient.CreateSeries(
+		newrelic.NewContext(ctx, nrtx),
+		withActor(ctx, connect.NewRequest(&grpc.CreateSeriesRequest{
+			Title:      in.Title(),
+			ArticleIds: in.ArticleIDs(),
+		})))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.CreateSeriesOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.CreateSeriesOutDTO{}, err
+	}
+
+	out := dto.NewCreateSeriesOutDTO(response.Msg.GetSeriesId(), seriesArticlesFromPB(response.Msg.GetEvents()), in.ClientMutationID())
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.CreateSeriesOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// seriesArticlesFromPB converts the events written to the articles of a series.
+func seriesArticlesFromPB(events []*grpc.BloggingEventResponse) []dto.SeriesArticle {
+	articles := make([]dto.SeriesArticle, 0, len(events))
+	for _, e := range events {
+		articles = append(articles, dto.NewSeriesArticle(e.EventId, e.ArticleId))
+	}
+	return articles
+}
+
+// NewCreateSeries is a constructor of CreateSeries.
+func NewCreateSeries(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *CreateSeries {
+	return &CreateSeries{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	blogapictx "blogapi.miyamo.today/core/context"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"testing"
+)
+
+func TestCreateSeries_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  dto.CreateSeriesInDTO
+	}
+	type want struct {
+		out dto.CreateSeriesOutDTO
+		err error
+	}
+	type testCase struct {
+		bloggingEventServiceClient func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.CreateSeriesRequest]) blogging_eventconnect.BloggingEventServiceClient
+		args                       args
+		expectedReq                *connect.Request[grpc.CreateSeriesRequest]
+		want                       want
+	}
+	errTestCreateSeries := errors.New("test error")
+	mockBlogAPIContext := func() context.Context {
+		return blogapictx.StoreToContext(
+			context.Background(),
+			blogapictx.New(
+				"1234567890",
+				"0987654321",
+				blogapictx.RequestTypeGRPC,
+				nil,
+				nil))
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.CreateSeriesRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					CreateSeries(gomock.Any(), NewCreateSeriesRequestMatcher(t, req)).
+					Return(connect.NewResponse(&grpc.SeriesResponse{
+						SeriesId: "Series1",
+						Events: []*grpc.BloggingEventResponse{
+							{EventId: "Event1", ArticleId: "Article1"},
+							{EventId: "Event2", ArticleId: "Article2"},
+						},
+					}), nil).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.CreateSeriesRequest{Title: "Go Tutorial", ArticleIds: []string{"Article1", "Article2"}}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewCreateSeriesInDTO("Go Tutorial", []string{"Article1", "Article2"}, "ClientMutationID1"),
+			},
+			want: want{
+				out: dto.NewCreateSeriesOutDTO("Series1", []dto.SeriesArticle{
+					dto.NewSeriesArticle("Event1", "Article1"),
+					dto.NewSeriesArticle("Event2", "Article2"),
+				}, "ClientMutationID1"),
+			},
+		},
+		"unhappy_path:grpc-return-error": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.CreateSeriesRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					CreateSeries(gomock.Any(), NewCreateSeriesRequestMatcher(t, req)).
+					Return(nil, errTestCreateSeries).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.CreateSeriesRequest{Title: "Go Tutorial", ArticleIds: []string{"Article1", "Article2"}}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewCreateSeriesInDTO("Go Tutorial", []string{"Article1", "Article2"}, "ClientMutationID1"),
+			},
+			want: want{
+				err: errTestCreateSeries,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := NewCreateSeries(tt.bloggingEventServiceClient(t, ctrl, tt.expectedReq))
+			out, err := uc.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() err = %v, want %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(tt.want.out, out, cmp.AllowUnexported(dto.CreateSeriesOutDTO{}, dto.SeriesArticle{})); diff != "" {
+				t.Errorf("Execute() out = %v, want %v", out, tt.want.out)
+			}
+		})
+	}
+}
+
+func NewCreateSeriesRequestMatcher(t *testing.T, expect *connect.Request[grpc.CreateSeriesRequest]) gomock.Matcher {
+	return &CreateSeriesRequestMatcher{
+		expect: expect,
+		t:      t,
+	}
+}
+
+type CreateSeriesRequestMatcher struct {
+	gomock.Matcher
+	expect *connect.Request[grpc.CreateSeriesRequest]
+	t      *testing.T
+}
+
+func (m *CreateSeriesRequestMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case *connect.Request[grpc.CreateSeriesRequest]:
+		if x == nil {
+			return m.expect == nil
+		}
+		diff := cmp.Diff(x.Msg, m.expect.Msg, protocmp.Transform())
+		if diff != "" {
+			m.t.Errorf("CreateSeriesRequest mismatch (-want +got):\n%s", diff)
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+func (m *CreateSeriesRequestMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
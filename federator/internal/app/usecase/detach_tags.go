@@ -11,6 +11,7 @@ import (
 	"log/slog"
 
 	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"blogapi.miyamo.today/federator/internal/utils"
 	"github.com/cockroachdb/errors"
 	"github.com/newrelic/go-agent/v3/newrelic"
 )
@@ -37,10 +38,11 @@ func (u *DetachTags) Execute(ctx context.Context, in dto.DetachTagsInDTO) (dto.D
 
 	response, err := u.bloggingEventServiceClient.DetachTags(
 		newrelic.NewContext(ctx, nrtx),
-		connect.NewRequest(&grpc.DetachTagsRequest{
-			Id:       in.ID(),
-			TagNames: in.TagNames(),
-		}))
+		withActor(ctx, withIdempotencyKey(ctx, connect.NewRequest(&grpc.DetachTagsRequest{
+			Id:                  in.ID(),
+			TagNames:            in.TagNames(),
+			ExpectedLastEventId: utils.PtrFromString(in.ExpectedLastEventID()),
+		}), in.ClientMutationID())))
 	if err != nil {
 		err = errors.WithStack(err)
 		logger.WarnContext(ctx, "END",
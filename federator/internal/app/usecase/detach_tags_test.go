@@ -6,6 +6,7 @@ import (
 	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
 	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
 	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
 	"connectrpc.com/connect"
 	"context"
 	"fmt"
@@ -52,12 +53,13 @@ func TestDetachTags_Execute(t *testing.T) {
 				return bloggingEventServiceClient
 			},
 			expectedReq: connect.NewRequest(&grpc.DetachTagsRequest{
-				Id:       "Article1",
-				TagNames: []string{"Tag1"},
+				Id:                  "Article1",
+				TagNames:            []string{"Tag1"},
+				ExpectedLastEventId: utils.PtrFromString("Event0"),
 			}),
 			args: args{
 				ctx: mockBlogAPIContext(),
-				in:  dto.NewDetachTagsInDTO("Article1", []string{"Tag1"}, "ClientMutationID1"),
+				in:  dto.NewDetachTagsInDTO("Article1", []string{"Tag1"}, "ClientMutationID1", "Event0"),
 			},
 			want: want{
 				out: dto.NewDetachTagsOutDTO("Event1", "Article1", "ClientMutationID1"),
@@ -77,7 +79,7 @@ func TestDetachTags_Execute(t *testing.T) {
 			}),
 			args: args{
 				ctx: mockBlogAPIContext(),
-				in:  dto.NewDetachTagsInDTO("Article1", []string{"Tag1"}, "ClientMutationID1"),
+				in:  dto.NewDetachTagsInDTO("Article1", []string{"Tag1"}, "ClientMutationID1", ""),
 			},
 			want: want{
 				err: errTestDetachTags,
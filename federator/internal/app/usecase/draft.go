@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+	"net/url"
+)
+
+// Draft is a use-case of getting a draft article by id.
+type Draft struct {
+	// bloggingEventServiceClient is a client of blogging event service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute gets a draft article by id.
+func (u *Draft) Execute(ctx context.Context, in dto.DraftInDTO) (dto.DraftOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Draft#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+	response, err := u.bloggingEventServiceClient.GetDraft(
+		newrelic.NewContext(ctx, nrtx),
+		connect.NewRequest(&grpc.GetDraftRequest{
+			Id: in.ID(),
+		}))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.DraftOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.DraftOutDTO{}, err
+	}
+
+	draft, err := draftFromPB(response.Msg.Draft)
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.DraftOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.DraftOutDTO{}, err
+	}
+	out := dto.NewDraftOutDTO(draft)
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.DraftOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// draftFromPB converts grpc.Draft to dto.Draft.
+func draftFromPB(from *grpc.Draft) (dto.Draft, error) {
+	thumbnailURL, err := url.Parse(from.GetThumbnailUrl())
+	if err != nil {
+		return dto.Draft{}, err
+	}
+	return dto.NewDraft(
+		from.GetId(),
+		from.GetTitle(),
+		from.GetBody(),
+		*thumbnailURL,
+		from.GetTagNames(),
+		from.GetLastEventId()), nil
+}
+
+// NewDraft is a constructor of Draft.
+func NewDraft(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *Draft {
+	return &Draft{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
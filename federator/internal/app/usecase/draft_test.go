@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	blogapictx "blogapi.miyamo.today/core/context"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestDraft_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  dto.DraftInDTO
+	}
+	type want struct {
+		out dto.DraftOutDTO
+		err error
+	}
+	type testCase struct {
+		bloggingEventServiceClient func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient
+		args                       args
+		want                       want
+		wantErr                    bool
+	}
+	errTestDraft := errors.New("test error")
+	mockBlogAPIContext := func() context.Context {
+		return blogapictx.StoreToContext(
+			context.Background(),
+			blogapictx.New(
+				"1234567890",
+				"0987654321",
+				blogapictx.RequestTypeGRPC,
+				nil,
+				nil))
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			bloggingEventServiceClient: func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					GetDraft(gomock.Any(), gomock.Any()).
+					Return(connect.NewResponse(&grpc.GetDraftResponse{
+						Draft: &grpc.Draft{
+							Id:           "Article1",
+							Title:        "Title1",
+							Body:         "Body1",
+							ThumbnailUrl: "https://example.com/example.png",
+							TagNames:     []string{"Tag1"},
+							LastEventId:  "Event1",
+						},
+					}), nil).
+					Times(1)
+				return bloggingEventServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewDraftInDTO("Article1"),
+			},
+			want: want{
+				out: dto.NewDraftOutDTO(
+					dto.NewDraft(
+						"Article1",
+						"Title1",
+						"Body1",
+						utils.MustURLParse("https://example.com/example.png"),
+						[]string{"Tag1"},
+						"Event1")),
+			},
+		},
+		"unhappy_path/grpc_returns_error": {
+			bloggingEventServiceClient: func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					GetDraft(gomock.Any(), gomock.Any()).
+					Return(nil, errTestDraft).
+					Times(1)
+				return bloggingEventServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewDraftInDTO("Article1"),
+			},
+			want: want{
+				out: dto.DraftOutDTO{},
+				err: errTestDraft,
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			bloggingEventServiceClient := tt.bloggingEventServiceClient(ctrl)
+			u := NewDraft(bloggingEventServiceClient)
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Execute() expected error but got nil")
+					return
+				}
+				if !errors.Is(err, tt.want.err) {
+					t.Errorf("Execute() error = %v, want %v", err, tt.wantErr)
+					return
+				}
+			} else if err != nil {
+				t.Errorf("Execute() expected nil but got error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want.out)
+			}
+		})
+	}
+}
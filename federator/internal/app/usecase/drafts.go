@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"log/slog"
+)
+
+// Drafts is a use-case of listing draft articles.
+type Drafts struct {
+	// bloggingEventServiceClient is a client of blogging event service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute lists draft articles.
+func (u *Drafts) Execute(ctx context.Context) (dto.DraftsOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Drafts#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	response, err := u.bloggingEventServiceClient.ListDrafts(
+		newrelic.NewContext(ctx, nrtx),
+		connect.NewRequest(&grpc.ListDraftsRequest{}))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.DraftsOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.DraftsOutDTO{}, err
+	}
+
+	draftPBs := response.Msg.GetDrafts()
+	drafts := make([]dto.Draft, 0, len(draftPBs))
+	for _, draftPB := range draftPBs {
+		draft, err := draftFromPB(draftPB)
+		if err != nil {
+			err = errors.WithStack(err)
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*dto.DraftsOutDTO", nil),
+					slog.Any("error", err)))
+			return dto.DraftsOutDTO{}, err
+		}
+		drafts = append(drafts, draft)
+	}
+	out := dto.NewDraftsOutDTO(drafts)
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.DraftsOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// NewDrafts is a constructor of Drafts.
+func NewDrafts(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *Drafts {
+	return &Drafts{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
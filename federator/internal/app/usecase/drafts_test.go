@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	blogapictx "blogapi.miyamo.today/core/context"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+	"reflect"
+	"testing"
+)
+
+func TestDrafts_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+	}
+	type want struct {
+		out dto.DraftsOutDTO
+		err error
+	}
+	type testCase struct {
+		bloggingEventServiceClient func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient
+		args                       args
+		want                       want
+		wantErr                    bool
+	}
+	errTestDrafts := errors.New("test error")
+	mockBlogAPIContext := func() context.Context {
+		return blogapictx.StoreToContext(
+			context.Background(),
+			blogapictx.New(
+				"1234567890",
+				"0987654321",
+				blogapictx.RequestTypeGRPC,
+				nil,
+				nil))
+	}
+	tests := map[string]testCase{
+		"happy_path/multiple_drafts": {
+			bloggingEventServiceClient: func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					ListDrafts(gomock.Any(), gomock.Any()).
+					Return(connect.NewResponse(&grpc.ListDraftsResponse{
+						Drafts: []*grpc.Draft{
+							{
+								Id:           "Article2",
+								Title:        "Title2",
+								Body:         "Body2",
+								ThumbnailUrl: "https://example.com/example.png",
+								LastEventId:  "Event2",
+							},
+							{
+								Id:           "Article1",
+								Title:        "Title1",
+								Body:         "Body1",
+								ThumbnailUrl: "https://example.com/example.png",
+								TagNames:     []string{"Tag1"},
+								LastEventId:  "Event1",
+							},
+						},
+					}), nil).
+					Times(1)
+				return bloggingEventServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+			},
+			want: want{
+				out: dto.NewDraftsOutDTO([]dto.Draft{
+					dto.NewDraft(
+						"Article2",
+						"Title2",
+						"Body2",
+						utils.MustURLParse("https://example.com/example.png"),
+						nil,
+						"Event2"),
+					dto.NewDraft(
+						"Article1",
+						"Title1",
+						"Body1",
+						utils.MustURLParse("https://example.com/example.png"),
+						[]string{"Tag1"},
+						"Event1"),
+				}),
+			},
+		},
+		"happy_path/no_drafts": {
+			bloggingEventServiceClient: func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					ListDrafts(gomock.Any(), gomock.Any()).
+					Return(connect.NewResponse(&grpc.ListDraftsResponse{}), nil).
+					Times(1)
+				return bloggingEventServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+			},
+			want: want{
+				out: dto.NewDraftsOutDTO([]dto.Draft{}),
+			},
+		},
+		"unhappy_path/grpc_returns_error": {
+			bloggingEventServiceClient: func(ctrl *gomock.Controller) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					ListDrafts(gomock.Any(), gomock.Any()).
+					Return(nil, errTestDrafts).
+					Times(1)
+				return bloggingEventServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+			},
+			want: want{
+				out: dto.DraftsOutDTO{},
+				err: errTestDrafts,
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			bloggingEventServiceClient := tt.bloggingEventServiceClient(ctrl)
+			u := NewDrafts(bloggingEventServiceClient)
+			got, err := u.Execute(tt.args.ctx)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Execute() expected error but got nil")
+					return
+				}
+				if !errors.Is(err, tt.want.err) {
+					t.Errorf("Execute() error = %v, want %v", err, tt.wantErr)
+					return
+				}
+			} else if err != nil {
+				t.Errorf("Execute() expected nil but got error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want.out)
+			}
+		})
+	}
+}
@@ -14,7 +14,8 @@ var (
 )
 
 type ArticleInDTO struct {
-	id string
+	id   string
+	slug string
 }
 
 // ID returns id.
@@ -22,6 +23,11 @@ func (i ArticleInDTO) ID() string {
 	return i.id
 }
 
+// Slug returns slug.
+func (i ArticleInDTO) Slug() string {
+	return i.slug
+}
+
 // IsInDTO is a marker for in dto.
 func (i ArticleInDTO) IsInDTO() {}
 
@@ -32,6 +38,13 @@ func NewArticleInDTO(id string) ArticleInDTO {
 	}
 }
 
+// NewArticleBySlugInDTO constructor of ArticleInDTO looking up the article by slug.
+func NewArticleBySlugInDTO(slug string) ArticleInDTO {
+	return ArticleInDTO{
+		slug: slug,
+	}
+}
+
 // ArticlesInDTO is a dto for articles.
 type ArticlesInDTO struct {
 	first  int
@@ -212,7 +225,11 @@ func NewTag(id, name string) Tag {
 
 type ArticleTag struct {
 	Article
-	tags []Tag
+	thumbnailVariants []ImageVariant
+	tags              []Tag
+	slug              string
+	createdBy         string
+	updatedBy         string
 }
 
 // Body returns body.
@@ -220,15 +237,42 @@ func (a ArticleTag) Body() string {
 	return a.body
 }
 
+// ThumbnailVariants returns the variants of the thumbnail. It is empty if the thumbnail was given without them.
+func (a ArticleTag) ThumbnailVariants() []ImageVariant {
+	return a.thumbnailVariants
+}
+
 // Tags returns tags.
 func (a ArticleTag) Tags() []Tag {
 	return a.tags
 }
 
-func NewArticleTag(id, title, body string, thumbnailURL url.URL, createdAt, updatedAt synchro.Time[tz.UTC], tags []Tag) ArticleTag {
+// Slug returns the current slug. It is empty if the article has no slug.
+func (a ArticleTag) Slug() string {
+	return a.slug
+}
+
+// CreatedBy returns the name of the user who created the article. It is empty if it is not known.
+func (a ArticleTag) CreatedBy() string {
+	return a.createdBy
+}
+
+// UpdatedBy returns the name of the user who last updated the article. It is empty if it is not known.
+func (a ArticleTag) UpdatedBy() string {
+	return a.updatedBy
+}
+
+func NewArticleTag(
+	id, title, body string, thumbnailURL url.URL, thumbnailVariants []ImageVariant, createdAt, updatedAt synchro.Time[tz.UTC],
+	tags []Tag, slug string, createdBy, updatedBy string,
+) ArticleTag {
 	return ArticleTag{
-		Article: NewArticle(id, title, body, thumbnailURL, createdAt, updatedAt),
-		tags:    tags,
+		Article:           NewArticle(id, title, body, thumbnailURL, createdAt, updatedAt),
+		thumbnailVariants: thumbnailVariants,
+		tags:              tags,
+		slug:              slug,
+		createdBy:         createdBy,
+		updatedBy:         updatedBy,
 	}
 }
 
@@ -249,6 +293,106 @@ func (o ArticleOutDTO) Article() ArticleTag {
 // IsOutDTO is a marker for out dto.
 func (o ArticleOutDTO) IsOutDTO() {}
 
+// SeriesInDTO is a dto for getting a series.
+type SeriesInDTO struct {
+	id        string
+	articleID string
+}
+
+// ID returns series id.
+func (i SeriesInDTO) ID() string {
+	return i.id
+}
+
+// ArticleID returns the id of the article the series is reached from.
+func (i SeriesInDTO) ArticleID() string {
+	return i.articleID
+}
+
+// IsInDTO is a marker for in dto.
+func (i SeriesInDTO) IsInDTO() {}
+
+// NewSeriesInDTO constructor of SeriesInDTO.
+func NewSeriesInDTO(id string) SeriesInDTO {
+	return SeriesInDTO{
+		id: id,
+	}
+}
+
+// NewSeriesByArticleInDTO constructor of SeriesInDTO looking up the series the article is a part of.
+func NewSeriesByArticleInDTO(articleID string) SeriesInDTO {
+	return SeriesInDTO{
+		articleID: articleID,
+	}
+}
+
+// SeriesOutDTO is a dto for a series.
+type SeriesOutDTO struct {
+	id       string
+	title    string
+	articles []ArticleTag
+	previous *ArticleTag
+	next     *ArticleTag
+}
+
+// IsOutDTO is a marker for out dto.
+func (o SeriesOutDTO) IsOutDTO() {}
+
+// ID returns series id. It is empty if the article the series was looked up by is in none.
+func (o SeriesOutDTO) ID() string {
+	return o.id
+}
+
+// Title returns series title.
+func (o SeriesOutDTO) Title() string {
+	return o.title
+}
+
+// Articles returns the published articles of the series in the order of their position.
+func (o SeriesOutDTO) Articles() []ArticleTag {
+	return o.articles
+}
+
+// Previous returns the part right before the article the series was looked up by.
+func (o SeriesOutDTO) Previous() *ArticleTag {
+	return o.previous
+}
+
+// Next returns the part right after the article the series was looked up by.
+func (o SeriesOutDTO) Next() *ArticleTag {
+	return o.next
+}
+
+// SeriesOutDTOOption is an option for SeriesOutDTO.
+type SeriesOutDTOOption func(*SeriesOutDTO)
+
+// SeriesOutDTOWithPrevious sets the part right before the article the series was looked up by.
+func SeriesOutDTOWithPrevious(previous ArticleTag) SeriesOutDTOOption {
+	return func(o *SeriesOutDTO) {
+		o.previous = &previous
+	}
+}
+
+// SeriesOutDTOWithNext sets the part right after the article the series was looked up by.
+func SeriesOutDTOWithNext(next ArticleTag) SeriesOutDTOOption {
+	return func(o *SeriesOutDTO) {
+		o.next = &next
+	}
+}
+
+// NewSeriesOutDTO constructor of SeriesOutDTO.
+func NewSeriesOutDTO(id, title string, articles []ArticleTag, options ...SeriesOutDTOOption) SeriesOutDTO {
+	o := SeriesOutDTO{
+		id:       id,
+		title:    title,
+		articles: articles,
+	}
+	for _, option := range options {
+		option(&o)
+	}
+	return o
+}
+
 // ArticlesOutDTO is a dto for articles.
 type ArticlesOutDTO struct {
 	articles   []ArticleTag
@@ -578,11 +722,15 @@ func NewTagsOutDTO(tags []TagArticle, options ...TagsOutDTOOption) TagsOutDTO {
 
 // CreateArticleInDTO is a dto for creating an article.
 type CreateArticleInDTO struct {
-	title            string
-	body             string
-	thumbnailURL     url.URL
-	tagNames         []string
-	clientMutationID string
+	title             string
+	body              string
+	thumbnailURL      url.URL
+	thumbnailVariants []ImageVariant
+	tagNames          []string
+	publishAt         *synchro.Time[tz.UTC]
+	draft             bool
+	clientMutationID  string
+	slug              *string
 }
 
 // IsInDTO is a marker for in dto.
@@ -603,24 +751,48 @@ func (a CreateArticleInDTO) ThumbnailURL() url.URL {
 	return a.thumbnailURL
 }
 
+// ThumbnailVariants returns the variants of the thumbnail.
+func (a CreateArticleInDTO) ThumbnailVariants() []ImageVariant {
+	return a.thumbnailVariants
+}
+
 // TagNames returns tag names.
 func (a CreateArticleInDTO) TagNames() []string {
 	return a.tagNames
 }
 
+// PublishAt returns the time the article goes live. nil means the article is published immediately.
+func (a CreateArticleInDTO) PublishAt() *synchro.Time[tz.UTC] {
+	return a.publishAt
+}
+
+// Draft returns whether the article is created as a draft.
+func (a CreateArticleInDTO) Draft() bool {
+	return a.draft
+}
+
 // ClientMutationID returns client mutation id.
 func (a CreateArticleInDTO) ClientMutationID() string {
 	return a.clientMutationID
 }
 
+// Slug returns the slug the article is addressed by. nil means the article is created without one.
+func (a CreateArticleInDTO) Slug() *string {
+	return a.slug
+}
+
 // NewCreateArticleInDTO constructor of CreateArticleInDTO.
-func NewCreateArticleInDTO(title, body string, thumbnailURL url.URL, tagNames []string, clientMutationID string) CreateArticleInDTO {
+func NewCreateArticleInDTO(title, body string, thumbnailURL url.URL, thumbnailVariants []ImageVariant, tagNames []string, publishAt *synchro.Time[tz.UTC], draft bool, clientMutationID string, slug *string) CreateArticleInDTO {
 	return CreateArticleInDTO{
-		title:            title,
-		body:             body,
-		thumbnailURL:     thumbnailURL,
-		tagNames:         tagNames,
-		clientMutationID: clientMutationID,
+		title:             title,
+		body:              body,
+		thumbnailURL:      thumbnailURL,
+		thumbnailVariants: thumbnailVariants,
+		tagNames:          tagNames,
+		publishAt:         publishAt,
+		draft:             draft,
+		clientMutationID:  clientMutationID,
+		slug:              slug,
 	}
 }
 
@@ -660,9 +832,10 @@ func NewCreateArticleOutDTO(eventID, articleID, clientMutationID string) CreateA
 
 // UpdateArticleTitleInDTO is a dto for updating an article.
 type UpdateArticleTitleInDTO struct {
-	id               string
-	title            string
-	clientMutationID string
+	id                  string
+	title               string
+	clientMutationID    string
+	expectedLastEventID string
 }
 
 // IsInDTO is a marker for in dto.
@@ -683,12 +856,18 @@ func (u UpdateArticleTitleInDTO) ClientMutationID() string {
 	return u.clientMutationID
 }
 
+// ExpectedLastEventID returns the id of the event expected to be the latest one.
+func (u UpdateArticleTitleInDTO) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
 // NewUpdateArticleTitleInDTO constructor of UpdateArticleTitleInDTO.
-func NewUpdateArticleTitleInDTO(id, title, clientMutationID string) UpdateArticleTitleInDTO {
+func NewUpdateArticleTitleInDTO(id, title, clientMutationID, expectedLastEventID string) UpdateArticleTitleInDTO {
 	return UpdateArticleTitleInDTO{
-		id:               id,
-		title:            title,
-		clientMutationID: clientMutationID,
+		id:                  id,
+		title:               title,
+		clientMutationID:    clientMutationID,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
@@ -726,11 +905,87 @@ func NewUpdateArticleTitleOutDTO(eventID, articleID, clientMutationID string) Up
 	}
 }
 
+// UpdateArticleSlugInDTO is a dto for updating an article.
+type UpdateArticleSlugInDTO struct {
+	id                  string
+	slug                string
+	clientMutationID    string
+	expectedLastEventID string
+}
+
+// IsInDTO is a marker for in dto.
+func (u UpdateArticleSlugInDTO) IsInDTO() {}
+
+// ID returns id.
+func (u UpdateArticleSlugInDTO) ID() string {
+	return u.id
+}
+
+// Slug returns slug.
+func (u UpdateArticleSlugInDTO) Slug() string {
+	return u.slug
+}
+
+// ClientMutationID returns client mutation id.
+func (u UpdateArticleSlugInDTO) ClientMutationID() string {
+	return u.clientMutationID
+}
+
+// ExpectedLastEventID returns the id of the event expected to be the latest one.
+func (u UpdateArticleSlugInDTO) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
+// NewUpdateArticleSlugInDTO constructor of UpdateArticleSlugInDTO.
+func NewUpdateArticleSlugInDTO(id, slug, clientMutationID, expectedLastEventID string) UpdateArticleSlugInDTO {
+	return UpdateArticleSlugInDTO{
+		id:                  id,
+		slug:                slug,
+		clientMutationID:    clientMutationID,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// UpdateArticleSlugOutDTO is a dto for updating an article.
+type UpdateArticleSlugOutDTO struct {
+	eventID          string
+	articleID        string
+	clientMutationID string
+}
+
+// IsOutDTO is a marker for out dto.
+func (u UpdateArticleSlugOutDTO) IsOutDTO() {}
+
+// EventID returns event id.
+func (u UpdateArticleSlugOutDTO) EventID() string {
+	return u.eventID
+}
+
+// ArticleID returns article id.
+func (u UpdateArticleSlugOutDTO) ArticleID() string {
+	return u.articleID
+}
+
+// ClientMutationID returns client mutation id.
+func (u UpdateArticleSlugOutDTO) ClientMutationID() string {
+	return u.clientMutationID
+}
+
+// NewUpdateArticleSlugOutDTO constructor of UpdateArticleSlugOutDTO.
+func NewUpdateArticleSlugOutDTO(eventID, articleID, clientMutationID string) UpdateArticleSlugOutDTO {
+	return UpdateArticleSlugOutDTO{
+		eventID:          eventID,
+		articleID:        articleID,
+		clientMutationID: clientMutationID,
+	}
+}
+
 // UpdateArticleBodyInDTO is a dto for updating an article content.
 type UpdateArticleBodyInDTO struct {
-	id               string
-	content          string
-	clientMutationID string
+	id                  string
+	content             string
+	clientMutationID    string
+	expectedLastEventID string
 }
 
 // IsInDTO is a marker for in dto.
@@ -751,12 +1006,18 @@ func (u UpdateArticleBodyInDTO) ClientMutationID() string {
 	return u.clientMutationID
 }
 
+// ExpectedLastEventID returns the id of the event expected to be the latest one.
+func (u UpdateArticleBodyInDTO) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
 // NewUpdateArticleBodyInDTO constructor of UpdateArticleBodyInDTO.
-func NewUpdateArticleBodyInDTO(id, content, clientMutationID string) UpdateArticleBodyInDTO {
+func NewUpdateArticleBodyInDTO(id, content, clientMutationID, expectedLastEventID string) UpdateArticleBodyInDTO {
 	return UpdateArticleBodyInDTO{
-		id:               id,
-		content:          content,
-		clientMutationID: clientMutationID,
+		id:                  id,
+		content:             content,
+		clientMutationID:    clientMutationID,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
@@ -796,9 +1057,11 @@ func NewUpdateArticleBodyOutDTO(eventID, articleID, clientMutationID string) Upd
 
 // UpdateArticleThumbnailInDTO is a dto for updating an article thumbnail.
 type UpdateArticleThumbnailInDTO struct {
-	id               string
-	thumbnail        url.URL
-	clientMutationID string
+	id                  string
+	thumbnail           url.URL
+	thumbnailVariants   []ImageVariant
+	clientMutationID    string
+	expectedLastEventID string
 }
 
 // ID returns id.
@@ -811,17 +1074,29 @@ func (u UpdateArticleThumbnailInDTO) Thumbnail() url.URL {
 	return u.thumbnail
 }
 
+// ThumbnailVariants returns the variants of the thumbnail.
+func (u UpdateArticleThumbnailInDTO) ThumbnailVariants() []ImageVariant {
+	return u.thumbnailVariants
+}
+
 // ClientMutationID returns client mutation id.
 func (u UpdateArticleThumbnailInDTO) ClientMutationID() string {
 	return u.clientMutationID
 }
 
+// ExpectedLastEventID returns the id of the event expected to be the latest one.
+func (u UpdateArticleThumbnailInDTO) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
 // NewUpdateArticleThumbnailInDTO constructor of UpdateArticleThumbnailInDTO.
-func NewUpdateArticleThumbnailInDTO(id string, thumbnail url.URL, clientMutationID string) UpdateArticleThumbnailInDTO {
+func NewUpdateArticleThumbnailInDTO(id string, thumbnail url.URL, thumbnailVariants []ImageVariant, clientMutationID, expectedLastEventID string) UpdateArticleThumbnailInDTO {
 	return UpdateArticleThumbnailInDTO{
-		id:               id,
-		thumbnail:        thumbnail,
-		clientMutationID: clientMutationID,
+		id:                  id,
+		thumbnail:           thumbnail,
+		thumbnailVariants:   thumbnailVariants,
+		clientMutationID:    clientMutationID,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
@@ -857,9 +1132,10 @@ func NewUpdateArticleThumbnailOutDTO(eventID, articleID, clientMutationID string
 }
 
 type AttachTagsInDTO struct {
-	id               string
-	tagNames         []string
-	clientMutationID string
+	id                  string
+	tagNames            []string
+	clientMutationID    string
+	expectedLastEventID string
 }
 
 // ID returns id.
@@ -877,12 +1153,18 @@ func (a AttachTagsInDTO) ClientMutationID() string {
 	return a.clientMutationID
 }
 
+// ExpectedLastEventID returns the id of the event expected to be the latest one.
+func (u AttachTagsInDTO) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
 // NewAttachTagsInDTO constructor of AttachTagsInDTO.
-func NewAttachTagsInDTO(id string, tagNames []string, clientMutationID string) AttachTagsInDTO {
+func NewAttachTagsInDTO(id string, tagNames []string, clientMutationID, expectedLastEventID string) AttachTagsInDTO {
 	return AttachTagsInDTO{
-		id:               id,
-		tagNames:         tagNames,
-		clientMutationID: clientMutationID,
+		id:                  id,
+		tagNames:            tagNames,
+		clientMutationID:    clientMutationID,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
@@ -918,9 +1200,10 @@ func NewAttachTagsOutDTO(eventID, articleID, clientMutationID string) AttachTags
 }
 
 type DetachTagsInDTO struct {
-	id               string
-	tagNames         []string
-	clientMutationID string
+	id                  string
+	tagNames            []string
+	clientMutationID    string
+	expectedLastEventID string
 }
 
 // ID returns id.
@@ -938,12 +1221,18 @@ func (a DetachTagsInDTO) ClientMutationID() string {
 	return a.clientMutationID
 }
 
+// ExpectedLastEventID returns the id of the event expected to be the latest one.
+func (u DetachTagsInDTO) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
 // NewDetachTagsInDTO constructor of DetachTagsInDTO.
-func NewDetachTagsInDTO(id string, tagNames []string, clientMutationID string) DetachTagsInDTO {
+func NewDetachTagsInDTO(id string, tagNames []string, clientMutationID, expectedLastEventID string) DetachTagsInDTO {
 	return DetachTagsInDTO{
-		id:               id,
-		tagNames:         tagNames,
-		clientMutationID: clientMutationID,
+		id:                  id,
+		tagNames:            tagNames,
+		clientMutationID:    clientMutationID,
+		expectedLastEventID: expectedLastEventID,
 	}
 }
 
@@ -978,53 +1267,1423 @@ func NewDetachTagsOutDTO(eventID, articleID, clientMutationID string) DetachTags
 	}
 }
 
-// UploadImageInDTO is a dto for uploading an image.
-type UploadImageInDTO struct {
-	data             io.ReadSeeker
-	filename         string
-	contentType      string
+// HideArticleInDTO is a dto for hiding an article.
+type HideArticleInDTO struct {
+	id                  string
+	clientMutationID    string
+	expectedLastEventID string
+}
+
+// ID returns id.
+func (a HideArticleInDTO) ID() string {
+	return a.id
+}
+
+// ClientMutationID returns client mutation id.
+func (a HideArticleInDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// ExpectedLastEventID returns the id of the event expected to be the latest one.
+func (u HideArticleInDTO) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
+// NewHideArticleInDTO constructor of HideArticleInDTO.
+func NewHideArticleInDTO(id string, clientMutationID, expectedLastEventID string) HideArticleInDTO {
+	return HideArticleInDTO{
+		id:                  id,
+		clientMutationID:    clientMutationID,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// HideArticleOutDTO is a dto for hiding an article.
+type HideArticleOutDTO struct {
+	eventID          string
+	articleID        string
 	clientMutationID string
 }
 
-// Data returns data.
-func (u UploadImageInDTO) Data() io.ReadSeeker {
-	return u.data
+// EventID returns event id.
+func (a HideArticleOutDTO) EventID() string {
+	return a.eventID
 }
 
-// Filename returns filename.
-func (u UploadImageInDTO) Filename() string {
-	return u.filename
+// ArticleID returns article id.
+func (a HideArticleOutDTO) ArticleID() string {
+	return a.articleID
 }
 
-// ContentType returns content type.
-func (u UploadImageInDTO) ContentType() string {
-	return u.contentType
+// ClientMutationID returns client mutation id.
+func (a HideArticleOutDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewHideArticleOutDTO constructor of HideArticleOutDTO.
+func NewHideArticleOutDTO(eventID, articleID, clientMutationID string) HideArticleOutDTO {
+	return HideArticleOutDTO{
+		eventID:          eventID,
+		articleID:        articleID,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// UnhideArticleInDTO is a dto for unhiding an article.
+type UnhideArticleInDTO struct {
+	id                  string
+	clientMutationID    string
+	expectedLastEventID string
+}
+
+// ID returns id.
+func (a UnhideArticleInDTO) ID() string {
+	return a.id
 }
 
 // ClientMutationID returns client mutation id.
-func (u UploadImageInDTO) ClientMutationID() string {
-	return u.clientMutationID
+func (a UnhideArticleInDTO) ClientMutationID() string {
+	return a.clientMutationID
 }
 
-// NewUploadImageInDTO constructor of UploadImageInDTO.
-func NewUploadImageInDTO(data io.ReadSeeker, filename, contentType, clientMutationID string) UploadImageInDTO {
-	return UploadImageInDTO{
-		data:             data,
-		filename:         filename,
-		contentType:      contentType,
+// ExpectedLastEventID returns the id of the event expected to be the latest one.
+func (u UnhideArticleInDTO) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
+// NewUnhideArticleInDTO constructor of UnhideArticleInDTO.
+func NewUnhideArticleInDTO(id string, clientMutationID, expectedLastEventID string) UnhideArticleInDTO {
+	return UnhideArticleInDTO{
+		id:                  id,
+		clientMutationID:    clientMutationID,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// UnhideArticleOutDTO is a dto for unhiding an article.
+type UnhideArticleOutDTO struct {
+	eventID          string
+	articleID        string
+	clientMutationID string
+}
+
+// EventID returns event id.
+func (a UnhideArticleOutDTO) EventID() string {
+	return a.eventID
+}
+
+// ArticleID returns article id.
+func (a UnhideArticleOutDTO) ArticleID() string {
+	return a.articleID
+}
+
+// ClientMutationID returns client mutation id.
+func (a UnhideArticleOutDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewUnhideArticleOutDTO constructor of UnhideArticleOutDTO.
+func NewUnhideArticleOutDTO(eventID, articleID, clientMutationID string) UnhideArticleOutDTO {
+	return UnhideArticleOutDTO{
+		eventID:          eventID,
+		articleID:        articleID,
 		clientMutationID: clientMutationID,
 	}
 }
 
-// UploadImageOutDTO is a dto for uploading an image.
-type UploadImageOutDTO struct {
-	imageURL         url.URL
+// EditArticleInDTO is a dto for editing an article.
+type EditArticleInDTO struct {
+	id                  string
+	title               *string
+	content             *string
+	thumbnail           *url.URL
+	thumbnailVariants   []ImageVariant
+	attachTagNames      []string
+	detachTagNames      []string
+	clientMutationID    string
+	expectedLastEventID string
+}
+
+// ID returns id.
+func (e EditArticleInDTO) ID() string {
+	return e.id
+}
+
+// Title returns the new title. nil means the title is left unchanged.
+func (e EditArticleInDTO) Title() *string {
+	return e.title
+}
+
+// Content returns the new content. nil means the content is left unchanged.
+func (e EditArticleInDTO) Content() *string {
+	return e.content
+}
+
+// Thumbnail returns the new thumbnail. nil means the thumbnail is left unchanged.
+func (e EditArticleInDTO) Thumbnail() *url.URL {
+	return e.thumbnail
+}
+
+// ThumbnailVariants returns the variants of the new thumbnail.
+func (e EditArticleInDTO) ThumbnailVariants() []ImageVariant {
+	return e.thumbnailVariants
+}
+
+// AttachTagNames returns tag names to attach.
+func (e EditArticleInDTO) AttachTagNames() []string {
+	return e.attachTagNames
+}
+
+// DetachTagNames returns tag names to detach.
+func (e EditArticleInDTO) DetachTagNames() []string {
+	return e.detachTagNames
+}
+
+// ClientMutationID returns client mutation id.
+func (e EditArticleInDTO) ClientMutationID() string {
+	return e.clientMutationID
+}
+
+// ExpectedLastEventID returns the id of the event expected to be the latest one.
+func (e EditArticleInDTO) ExpectedLastEventID() string {
+	return e.expectedLastEventID
+}
+
+// NewEditArticleInDTO constructor of EditArticleInDTO.
+func NewEditArticleInDTO(id string, title, content *string, thumbnail *url.URL, thumbnailVariants []ImageVariant, attachTagNames, detachTagNames []string, clientMutationID, expectedLastEventID string) EditArticleInDTO {
+	return EditArticleInDTO{
+		id:                  id,
+		title:               title,
+		content:             content,
+		thumbnail:           thumbnail,
+		thumbnailVariants:   thumbnailVariants,
+		attachTagNames:      attachTagNames,
+		detachTagNames:      detachTagNames,
+		clientMutationID:    clientMutationID,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// EditArticleOutDTO is a dto for editing an article.
+type EditArticleOutDTO struct {
+	eventID          string
+	articleID        string
 	clientMutationID string
 }
 
-// ImageURL returns image url.
-func (u UploadImageOutDTO) ImageURL() url.URL {
-	return u.imageURL
+// EventID returns event id.
+func (a EditArticleOutDTO) EventID() string {
+	return a.eventID
+}
+
+// ArticleID returns article id.
+func (a EditArticleOutDTO) ArticleID() string {
+	return a.articleID
+}
+
+// ClientMutationID returns client mutation id.
+func (a EditArticleOutDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewEditArticleOutDTO constructor of EditArticleOutDTO.
+func NewEditArticleOutDTO(eventID, articleID, clientMutationID string) EditArticleOutDTO {
+	return EditArticleOutDTO{
+		eventID:          eventID,
+		articleID:        articleID,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// ScheduleArticleInDTO is a dto for scheduling an article.
+type ScheduleArticleInDTO struct {
+	id                  string
+	publishAt           synchro.Time[tz.UTC]
+	clientMutationID    string
+	expectedLastEventID string
+}
+
+// ID returns id.
+func (a ScheduleArticleInDTO) ID() string {
+	return a.id
+}
+
+// PublishAt returns the time the article goes live.
+func (a ScheduleArticleInDTO) PublishAt() synchro.Time[tz.UTC] {
+	return a.publishAt
+}
+
+// ClientMutationID returns client mutation id.
+func (a ScheduleArticleInDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// ExpectedLastEventID returns the id of the event expected to be the latest one.
+func (u ScheduleArticleInDTO) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
+// NewScheduleArticleInDTO constructor of ScheduleArticleInDTO.
+func NewScheduleArticleInDTO(id string, publishAt synchro.Time[tz.UTC], clientMutationID, expectedLastEventID string) ScheduleArticleInDTO {
+	return ScheduleArticleInDTO{
+		id:                  id,
+		publishAt:           publishAt,
+		clientMutationID:    clientMutationID,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// ScheduleArticleOutDTO is a dto for scheduling an article.
+type ScheduleArticleOutDTO struct {
+	eventID          string
+	articleID        string
+	clientMutationID string
+}
+
+// EventID returns event id.
+func (a ScheduleArticleOutDTO) EventID() string {
+	return a.eventID
+}
+
+// ArticleID returns article id.
+func (a ScheduleArticleOutDTO) ArticleID() string {
+	return a.articleID
+}
+
+// ClientMutationID returns client mutation id.
+func (a ScheduleArticleOutDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewScheduleArticleOutDTO constructor of ScheduleArticleOutDTO.
+func NewScheduleArticleOutDTO(eventID, articleID, clientMutationID string) ScheduleArticleOutDTO {
+	return ScheduleArticleOutDTO{
+		eventID:          eventID,
+		articleID:        articleID,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// PublishArticleInDTO is a dto for publishing a draft article.
+type PublishArticleInDTO struct {
+	id                  string
+	clientMutationID    string
+	expectedLastEventID string
+}
+
+// ID returns id.
+func (a PublishArticleInDTO) ID() string {
+	return a.id
+}
+
+// ClientMutationID returns client mutation id.
+func (a PublishArticleInDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// ExpectedLastEventID returns the id of the event expected to be the latest one.
+func (u PublishArticleInDTO) ExpectedLastEventID() string {
+	return u.expectedLastEventID
+}
+
+// NewPublishArticleInDTO constructor of PublishArticleInDTO.
+func NewPublishArticleInDTO(id string, clientMutationID, expectedLastEventID string) PublishArticleInDTO {
+	return PublishArticleInDTO{
+		id:                  id,
+		clientMutationID:    clientMutationID,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// PublishArticleOutDTO is a dto for publishing a draft article.
+type PublishArticleOutDTO struct {
+	eventID          string
+	articleID        string
+	clientMutationID string
+}
+
+// EventID returns event id.
+func (a PublishArticleOutDTO) EventID() string {
+	return a.eventID
+}
+
+// ArticleID returns article id.
+func (a PublishArticleOutDTO) ArticleID() string {
+	return a.articleID
+}
+
+// ClientMutationID returns client mutation id.
+func (a PublishArticleOutDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewPublishArticleOutDTO constructor of PublishArticleOutDTO.
+func NewPublishArticleOutDTO(eventID, articleID, clientMutationID string) PublishArticleOutDTO {
+	return PublishArticleOutDTO{
+		eventID:          eventID,
+		articleID:        articleID,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// RevertArticleInDTO is a dto for restoring an article as it was right after an earlier event.
+type RevertArticleInDTO struct {
+	id                  string
+	toEventID           string
+	clientMutationID    string
+	expectedLastEventID string
+}
+
+// ID returns id.
+func (a RevertArticleInDTO) ID() string {
+	return a.id
+}
+
+// ToEventID returns the id of the event to restore the article to.
+func (a RevertArticleInDTO) ToEventID() string {
+	return a.toEventID
+}
+
+// ClientMutationID returns client mutation id.
+func (a RevertArticleInDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// ExpectedLastEventID returns the id of the event expected to be the latest one.
+func (a RevertArticleInDTO) ExpectedLastEventID() string {
+	return a.expectedLastEventID
+}
+
+// NewRevertArticleInDTO constructor of RevertArticleInDTO.
+func NewRevertArticleInDTO(id, toEventID, clientMutationID, expectedLastEventID string) RevertArticleInDTO {
+	return RevertArticleInDTO{
+		id:                  id,
+		toEventID:           toEventID,
+		clientMutationID:    clientMutationID,
+		expectedLastEventID: expectedLastEventID,
+	}
+}
+
+// RevertArticleOutDTO is a dto for restoring an article as it was right after an earlier event.
+type RevertArticleOutDTO struct {
+	eventID          string
+	articleID        string
+	clientMutationID string
+}
+
+// EventID returns event id.
+func (a RevertArticleOutDTO) EventID() string {
+	return a.eventID
+}
+
+// ArticleID returns article id.
+func (a RevertArticleOutDTO) ArticleID() string {
+	return a.articleID
+}
+
+// ClientMutationID returns client mutation id.
+func (a RevertArticleOutDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewRevertArticleOutDTO constructor of RevertArticleOutDTO.
+func NewRevertArticleOutDTO(eventID, articleID, clientMutationID string) RevertArticleOutDTO {
+	return RevertArticleOutDTO{
+		eventID:          eventID,
+		articleID:        articleID,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// RetaggedArticle is a dto for an article whose tags were rewritten by renaming or merging tags.
+type RetaggedArticle struct {
+	eventID   string
+	articleID string
+}
+
+// EventID returns event id.
+func (a RetaggedArticle) EventID() string {
+	return a.eventID
+}
+
+// ArticleID returns article id.
+func (a RetaggedArticle) ArticleID() string {
+	return a.articleID
+}
+
+// NewRetaggedArticle constructor of RetaggedArticle.
+func NewRetaggedArticle(eventID, articleID string) RetaggedArticle {
+	return RetaggedArticle{
+		eventID:   eventID,
+		articleID: articleID,
+	}
+}
+
+// SeriesArticle is a dto for an article whose position in a series was written by a series mutation.
+type SeriesArticle struct {
+	eventID   string
+	articleID string
+}
+
+// EventID returns event id.
+func (a SeriesArticle) EventID() string {
+	return a.eventID
+}
+
+// ArticleID returns article id.
+func (a SeriesArticle) ArticleID() string {
+	return a.articleID
+}
+
+// NewSeriesArticle constructor of SeriesArticle.
+func NewSeriesArticle(eventID, articleID string) SeriesArticle {
+	return SeriesArticle{
+		eventID:   eventID,
+		articleID: articleID,
+	}
+}
+
+// CreateSeriesInDTO is a dto for creating a series.
+type CreateSeriesInDTO struct {
+	title            string
+	articleIDs       []string
+	clientMutationID string
+}
+
+// Title returns the title of the series.
+func (a CreateSeriesInDTO) Title() string {
+	return a.title
+}
+
+// ArticleIDs returns the ids of the articles in the order of the series.
+func (a CreateSeriesInDTO) ArticleIDs() []string {
+	return a.articleIDs
+}
+
+// ClientMutationID returns client mutation id.
+func (a CreateSeriesInDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewCreateSeriesInDTO constructor of CreateSeriesInDTO.
+func NewCreateSeriesInDTO(title string, articleIDs []string, clientMutationID string) CreateSeriesInDTO {
+	return CreateSeriesInDTO{
+		title:            title,
+		articleIDs:       articleIDs,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// CreateSeriesOutDTO is a dto for creating a series.
+type CreateSeriesOutDTO struct {
+	seriesID         string
+	articles         []SeriesArticle
+	clientMutationID string
+}
+
+// SeriesID returns the id of the created series.
+func (a CreateSeriesOutDTO) SeriesID() string {
+	return a.seriesID
+}
+
+// Articles returns the articles put in the series.
+func (a CreateSeriesOutDTO) Articles() []SeriesArticle {
+	return a.articles
+}
+
+// ClientMutationID returns client mutation id.
+func (a CreateSeriesOutDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewCreateSeriesOutDTO constructor of CreateSeriesOutDTO.
+func NewCreateSeriesOutDTO(seriesID string, articles []SeriesArticle, clientMutationID string) CreateSeriesOutDTO {
+	return CreateSeriesOutDTO{
+		seriesID:         seriesID,
+		articles:         articles,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// AddArticleToSeriesInDTO is a dto for adding an article to a series.
+type AddArticleToSeriesInDTO struct {
+	seriesID         string
+	articleID        string
+	position         int
+	clientMutationID string
+}
+
+// SeriesID returns series id.
+func (a AddArticleToSeriesInDTO) SeriesID() string {
+	return a.seriesID
+}
+
+// ArticleID returns article id.
+func (a AddArticleToSeriesInDTO) ArticleID() string {
+	return a.articleID
+}
+
+// Position returns the 1-based position to add the article at. It is 0 to append the article.
+func (a AddArticleToSeriesInDTO) Position() int {
+	return a.position
+}
+
+// ClientMutationID returns client mutation id.
+func (a AddArticleToSeriesInDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewAddArticleToSeriesInDTO constructor of AddArticleToSeriesInDTO.
+func NewAddArticleToSeriesInDTO(seriesID, articleID string, position int, clientMutationID string) AddArticleToSeriesInDTO {
+	return AddArticleToSeriesInDTO{
+		seriesID:         seriesID,
+		articleID:        articleID,
+		position:         position,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// AddArticleToSeriesOutDTO is a dto for adding an article to a series.
+type AddArticleToSeriesOutDTO struct {
+	seriesID         string
+	articles         []SeriesArticle
+	clientMutationID string
+}
+
+// SeriesID returns series id.
+func (a AddArticleToSeriesOutDTO) SeriesID() string {
+	return a.seriesID
+}
+
+// Articles returns the added article and the later parts shifted back.
+func (a AddArticleToSeriesOutDTO) Articles() []SeriesArticle {
+	return a.articles
+}
+
+// ClientMutationID returns client mutation id.
+func (a AddArticleToSeriesOutDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewAddArticleToSeriesOutDTO constructor of AddArticleToSeriesOutDTO.
+func NewAddArticleToSeriesOutDTO(seriesID string, articles []SeriesArticle, clientMutationID string) AddArticleToSeriesOutDTO {
+	return AddArticleToSeriesOutDTO{
+		seriesID:         seriesID,
+		articles:         articles,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// ReorderSeriesInDTO is a dto for reordering a series.
+type ReorderSeriesInDTO struct {
+	seriesID         string
+	articleIDs       []string
+	clientMutationID string
+}
+
+// SeriesID returns series id.
+func (a ReorderSeriesInDTO) SeriesID() string {
+	return a.seriesID
+}
+
+// ArticleIDs returns the ids of every article of the series in the new order.
+func (a ReorderSeriesInDTO) ArticleIDs() []string {
+	return a.articleIDs
+}
+
+// ClientMutationID returns client mutation id.
+func (a ReorderSeriesInDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewReorderSeriesInDTO constructor of ReorderSeriesInDTO.
+func NewReorderSeriesInDTO(seriesID string, articleIDs []string, clientMutationID string) ReorderSeriesInDTO {
+	return ReorderSeriesInDTO{
+		seriesID:         seriesID,
+		articleIDs:       articleIDs,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// ReorderSeriesOutDTO is a dto for reordering a series.
+type ReorderSeriesOutDTO struct {
+	seriesID         string
+	articles         []SeriesArticle
+	clientMutationID string
+}
+
+// SeriesID returns series id.
+func (a ReorderSeriesOutDTO) SeriesID() string {
+	return a.seriesID
+}
+
+// Articles returns the articles whose position changed.
+func (a ReorderSeriesOutDTO) Articles() []SeriesArticle {
+	return a.articles
+}
+
+// ClientMutationID returns client mutation id.
+func (a ReorderSeriesOutDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewReorderSeriesOutDTO constructor of ReorderSeriesOutDTO.
+func NewReorderSeriesOutDTO(seriesID string, articles []SeriesArticle, clientMutationID string) ReorderSeriesOutDTO {
+	return ReorderSeriesOutDTO{
+		seriesID:         seriesID,
+		articles:         articles,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// RenameTagInDTO is a dto for renaming a tag on every article it is attached to.
+type RenameTagInDTO struct {
+	from             string
+	to               string
+	clientMutationID string
+}
+
+// From returns the current name of the tag.
+func (a RenameTagInDTO) From() string {
+	return a.from
+}
+
+// To returns the new name of the tag.
+func (a RenameTagInDTO) To() string {
+	return a.to
+}
+
+// ClientMutationID returns client mutation id.
+func (a RenameTagInDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewRenameTagInDTO constructor of RenameTagInDTO.
+func NewRenameTagInDTO(from, to, clientMutationID string) RenameTagInDTO {
+	return RenameTagInDTO{
+		from:             from,
+		to:               to,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// RenameTagOutDTO is a dto for renaming a tag on every article it is attached to.
+type RenameTagOutDTO struct {
+	articles         []RetaggedArticle
+	clientMutationID string
+}
+
+// Articles returns the articles the tag was renamed on.
+func (a RenameTagOutDTO) Articles() []RetaggedArticle {
+	return a.articles
+}
+
+// ClientMutationID returns client mutation id.
+func (a RenameTagOutDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewRenameTagOutDTO constructor of RenameTagOutDTO.
+func NewRenameTagOutDTO(articles []RetaggedArticle, clientMutationID string) RenameTagOutDTO {
+	return RenameTagOutDTO{
+		articles:         articles,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// MergeTagsInDTO is a dto for merging tags into another one on every article they are attached to.
+type MergeTagsInDTO struct {
+	sources          []string
+	into             string
+	clientMutationID string
+}
+
+// Sources returns the names of the tags to merge away.
+func (a MergeTagsInDTO) Sources() []string {
+	return a.sources
+}
+
+// Into returns the name of the tag to merge the sources into.
+func (a MergeTagsInDTO) Into() string {
+	return a.into
+}
+
+// ClientMutationID returns client mutation id.
+func (a MergeTagsInDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewMergeTagsInDTO constructor of MergeTagsInDTO.
+func NewMergeTagsInDTO(sources []string, into, clientMutationID string) MergeTagsInDTO {
+	return MergeTagsInDTO{
+		sources:          sources,
+		into:             into,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// MergeTagsOutDTO is a dto for merging tags into another one on every article they are attached to.
+type MergeTagsOutDTO struct {
+	articles         []RetaggedArticle
+	clientMutationID string
+}
+
+// Articles returns the articles the tags were merged on.
+func (a MergeTagsOutDTO) Articles() []RetaggedArticle {
+	return a.articles
+}
+
+// ClientMutationID returns client mutation id.
+func (a MergeTagsOutDTO) ClientMutationID() string {
+	return a.clientMutationID
+}
+
+// NewMergeTagsOutDTO constructor of MergeTagsOutDTO.
+func NewMergeTagsOutDTO(articles []RetaggedArticle, clientMutationID string) MergeTagsOutDTO {
+	return MergeTagsOutDTO{
+		articles:         articles,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// Draft is a dto for a draft article.
+type Draft struct {
+	id           string
+	title        string
+	body         string
+	thumbnailURL url.URL
+	tagNames     []string
+	lastEventID  string
+}
+
+// ID returns id.
+func (d Draft) ID() string {
+	return d.id
+}
+
+// Title returns title.
+func (d Draft) Title() string {
+	return d.title
+}
+
+// Body returns body.
+func (d Draft) Body() string {
+	return d.body
+}
+
+// ThumbnailURL returns thumbnail url.
+func (d Draft) ThumbnailURL() url.URL {
+	return d.thumbnailURL
+}
+
+// TagNames returns tag names.
+func (d Draft) TagNames() []string {
+	return d.tagNames
+}
+
+// LastEventID returns the id of the latest event of the draft.
+func (d Draft) LastEventID() string {
+	return d.lastEventID
+}
+
+// NewDraft constructor of Draft.
+func NewDraft(id, title, body string, thumbnailURL url.URL, tagNames []string, lastEventID string) Draft {
+	return Draft{
+		id:           id,
+		title:        title,
+		body:         body,
+		thumbnailURL: thumbnailURL,
+		tagNames:     tagNames,
+		lastEventID:  lastEventID,
+	}
+}
+
+// DraftInDTO is a dto for getting a draft article.
+type DraftInDTO struct {
+	id string
+}
+
+// IsInDTO is a marker for in dto.
+func (i DraftInDTO) IsInDTO() {}
+
+// ID returns id.
+func (i DraftInDTO) ID() string {
+	return i.id
+}
+
+// NewDraftInDTO constructor of DraftInDTO.
+func NewDraftInDTO(id string) DraftInDTO {
+	return DraftInDTO{
+		id: id,
+	}
+}
+
+// DraftOutDTO is a dto for getting a draft article.
+type DraftOutDTO struct {
+	draft Draft
+}
+
+// IsOutDTO is a marker for out dto.
+func (o DraftOutDTO) IsOutDTO() {}
+
+// Draft returns draft.
+func (o DraftOutDTO) Draft() Draft {
+	return o.draft
+}
+
+// NewDraftOutDTO constructor of DraftOutDTO.
+func NewDraftOutDTO(draft Draft) DraftOutDTO {
+	return DraftOutDTO{
+		draft: draft,
+	}
+}
+
+// DraftsOutDTO is a dto for listing draft articles.
+type DraftsOutDTO struct {
+	drafts []Draft
+}
+
+// IsOutDTO is a marker for out dto.
+func (o DraftsOutDTO) IsOutDTO() {}
+
+// Drafts returns drafts.
+func (o DraftsOutDTO) Drafts() []Draft {
+	return o.drafts
+}
+
+// NewDraftsOutDTO constructor of DraftsOutDTO.
+func NewDraftsOutDTO(drafts []Draft) DraftsOutDTO {
+	return DraftsOutDTO{
+		drafts: drafts,
+	}
+}
+
+// ArticleEvent is a dto for an entry of the history of an article.
+// Fields the event did not change are nil or empty.
+type ArticleEvent struct {
+	id             string
+	eventType      string
+	occurredAt     synchro.Time[tz.UTC]
+	title          *string
+	body           *string
+	thumbnailURL   *url.URL
+	tagNames       []string
+	attachTagNames []string
+	detachTagNames []string
+	invisible      *bool
+	publishAt      *synchro.Time[tz.UTC]
+	draft          *bool
+	actor          *string
+	revertedTo     *string
+	slug           *string
+	seriesID       *string
+	seriesTitle    *string
+	seriesPosition *int
+}
+
+// ID returns event id.
+func (e ArticleEvent) ID() string {
+	return e.id
+}
+
+// EventType returns the kind of the event.
+func (e ArticleEvent) EventType() string {
+	return e.eventType
+}
+
+// OccurredAt returns the time the event was written.
+func (e ArticleEvent) OccurredAt() synchro.Time[tz.UTC] {
+	return e.occurredAt
+}
+
+// Title returns the title set by the event.
+func (e ArticleEvent) Title() *string {
+	return e.title
+}
+
+// Body returns the body set by the event.
+func (e ArticleEvent) Body() *string {
+	return e.body
+}
+
+// ThumbnailURL returns the thumbnail url set by the event.
+func (e ArticleEvent) ThumbnailURL() *url.URL {
+	return e.thumbnailURL
+}
+
+// TagNames returns the tag names the article was created with.
+func (e ArticleEvent) TagNames() []string {
+	return e.tagNames
+}
+
+// AttachTagNames returns the tag names attached by the event.
+func (e ArticleEvent) AttachTagNames() []string {
+	return e.attachTagNames
+}
+
+// DetachTagNames returns the tag names detached by the event.
+func (e ArticleEvent) DetachTagNames() []string {
+	return e.detachTagNames
+}
+
+// Invisible returns the visibility set by the event.
+func (e ArticleEvent) Invisible() *bool {
+	return e.invisible
+}
+
+// PublishAt returns the publication time set by the event.
+func (e ArticleEvent) PublishAt() *synchro.Time[tz.UTC] {
+	return e.publishAt
+}
+
+// Draft returns the draft state set by the event.
+func (e ArticleEvent) Draft() *bool {
+	return e.draft
+}
+
+// Actor returns who wrote the event.
+func (e ArticleEvent) Actor() *string {
+	return e.actor
+}
+
+// RevertedTo returns the id of the event the article was restored to.
+func (e ArticleEvent) RevertedTo() *string {
+	return e.revertedTo
+}
+
+// Slug returns the slug set by the event.
+func (e ArticleEvent) Slug() *string {
+	return e.slug
+}
+
+// SeriesID returns the id of the series the event put the article in.
+func (e ArticleEvent) SeriesID() *string {
+	return e.seriesID
+}
+
+// SeriesTitle returns the title of the series the event put the article in.
+func (e ArticleEvent) SeriesTitle() *string {
+	return e.seriesTitle
+}
+
+// SeriesPosition returns the position within the series set by the event.
+func (e ArticleEvent) SeriesPosition() *int {
+	return e.seriesPosition
+}
+
+// ArticleEventOption is an option for ArticleEvent.
+type ArticleEventOption func(*ArticleEvent)
+
+// ArticleEventWithTitle sets the title set by the event.
+func ArticleEventWithTitle(title string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.title = &title
+	}
+}
+
+// ArticleEventWithBody sets the body set by the event.
+func ArticleEventWithBody(body string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.body = &body
+	}
+}
+
+// ArticleEventWithThumbnailURL sets the thumbnail url set by the event.
+func ArticleEventWithThumbnailURL(thumbnailURL url.URL) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.thumbnailURL = &thumbnailURL
+	}
+}
+
+// ArticleEventWithTagNames sets the tag names the article was created with.
+func ArticleEventWithTagNames(tagNames ...string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.tagNames = tagNames
+	}
+}
+
+// ArticleEventWithAttachTagNames sets the tag names attached by the event.
+func ArticleEventWithAttachTagNames(attachTagNames ...string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.attachTagNames = attachTagNames
+	}
+}
+
+// ArticleEventWithDetachTagNames sets the tag names detached by the event.
+func ArticleEventWithDetachTagNames(detachTagNames ...string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.detachTagNames = detachTagNames
+	}
+}
+
+// ArticleEventWithInvisible sets the visibility set by the event.
+func ArticleEventWithInvisible(invisible bool) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.invisible = &invisible
+	}
+}
+
+// ArticleEventWithPublishAt sets the publication time set by the event.
+func ArticleEventWithPublishAt(publishAt synchro.Time[tz.UTC]) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.publishAt = &publishAt
+	}
+}
+
+// ArticleEventWithDraft sets the draft state set by the event.
+func ArticleEventWithDraft(draft bool) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.draft = &draft
+	}
+}
+
+// ArticleEventWithActor sets who wrote the event.
+func ArticleEventWithActor(actor string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.actor = &actor
+	}
+}
+
+// ArticleEventWithRevertedTo sets the id of the event the article was restored to.
+func ArticleEventWithRevertedTo(revertedTo string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.revertedTo = &revertedTo
+	}
+}
+
+// ArticleEventWithSlug sets the slug set by the event.
+func ArticleEventWithSlug(slug string) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.slug = &slug
+	}
+}
+
+// ArticleEventWithSeries sets the series the event put the article in, and the position within it.
+func ArticleEventWithSeries(id, title string, position int) ArticleEventOption {
+	return func(e *ArticleEvent) {
+		e.seriesID = &id
+		e.seriesTitle = &title
+		e.seriesPosition = &position
+	}
+}
+
+// NewArticleEvent constructor of ArticleEvent.
+func NewArticleEvent(id, eventType string, occurredAt synchro.Time[tz.UTC], options ...ArticleEventOption) ArticleEvent {
+	e := ArticleEvent{
+		id:         id,
+		eventType:  eventType,
+		occurredAt: occurredAt,
+	}
+	for _, option := range options {
+		option(&e)
+	}
+	return e
+}
+
+// ArticleHistoryInDTO is a dto for listing the history of an article.
+type ArticleHistoryInDTO struct {
+	id    string
+	first int
+	after string
+}
+
+// IsInDTO is a marker for in dto.
+func (i ArticleHistoryInDTO) IsInDTO() {}
+
+// ID returns article id.
+func (i ArticleHistoryInDTO) ID() string {
+	return i.id
+}
+
+// First returns how many events to retrieve from the beginning. Zero means all.
+func (i ArticleHistoryInDTO) First() int {
+	return i.first
+}
+
+// After returns the cursor to retrieve events after.
+func (i ArticleHistoryInDTO) After() string {
+	return i.after
+}
+
+// NewArticleHistoryInDTO constructor of ArticleHistoryInDTO.
+func NewArticleHistoryInDTO(id string, first int, after string) ArticleHistoryInDTO {
+	return ArticleHistoryInDTO{
+		id:    id,
+		first: first,
+		after: after,
+	}
+}
+
+// ArticleHistoryOutDTO is a dto for listing the history of an article.
+type ArticleHistoryOutDTO struct {
+	events     []ArticleEvent
+	hasNext    bool
+	totalCount int
+}
+
+// IsOutDTO is a marker for out dto.
+func (o ArticleHistoryOutDTO) IsOutDTO() {}
+
+// Events returns events, oldest first.
+func (o ArticleHistoryOutDTO) Events() []ArticleEvent {
+	return o.events
+}
+
+// HasNext returns true if next page exists otherwise false.
+func (o ArticleHistoryOutDTO) HasNext() bool {
+	return o.hasNext
+}
+
+// TotalCount returns the number of all events of the article.
+func (o ArticleHistoryOutDTO) TotalCount() int {
+	return o.totalCount
+}
+
+// NewArticleHistoryOutDTO constructor of ArticleHistoryOutDTO.
+func NewArticleHistoryOutDTO(events []ArticleEvent, hasNext bool, totalCount int) ArticleHistoryOutDTO {
+	return ArticleHistoryOutDTO{
+		events:     events,
+		hasNext:    hasNext,
+		totalCount: totalCount,
+	}
+}
+
+// ArticleSnapshot is a dto for an article as it was at a point of its history.
+type ArticleSnapshot struct {
+	id           string
+	title        string
+	body         string
+	thumbnailURL url.URL
+	tagNames     []string
+	eventID      string
+	eventAt      synchro.Time[tz.UTC]
+}
+
+// ID returns article id.
+func (a ArticleSnapshot) ID() string {
+	return a.id
+}
+
+// Title returns title.
+func (a ArticleSnapshot) Title() string {
+	return a.title
+}
+
+// Body returns body.
+func (a ArticleSnapshot) Body() string {
+	return a.body
+}
+
+// ThumbnailURL returns thumbnail url.
+func (a ArticleSnapshot) ThumbnailURL() url.URL {
+	return a.thumbnailURL
+}
+
+// TagNames returns tag names.
+func (a ArticleSnapshot) TagNames() []string {
+	return a.tagNames
+}
+
+// EventID returns the id of the last event applied to the snapshot.
+func (a ArticleSnapshot) EventID() string {
+	return a.eventID
+}
+
+// EventAt returns the time of the last event applied to the snapshot.
+func (a ArticleSnapshot) EventAt() synchro.Time[tz.UTC] {
+	return a.eventAt
+}
+
+// NewArticleSnapshot constructor of ArticleSnapshot.
+func NewArticleSnapshot(id, title, body string, thumbnailURL url.URL, tagNames []string, eventID string, eventAt synchro.Time[tz.UTC]) ArticleSnapshot {
+	return ArticleSnapshot{
+		id:           id,
+		title:        title,
+		body:         body,
+		thumbnailURL: thumbnailURL,
+		tagNames:     tagNames,
+		eventID:      eventID,
+		eventAt:      eventAt,
+	}
+}
+
+// ArticleAtInDTO is a dto for getting an article as it was at a point of its history.
+type ArticleAtInDTO struct {
+	id        string
+	eventID   string
+	timestamp *synchro.Time[tz.UTC]
+}
+
+// IsInDTO is a marker for in dto.
+func (i ArticleAtInDTO) IsInDTO() {}
+
+// ID returns article id.
+func (i ArticleAtInDTO) ID() string {
+	return i.id
+}
+
+// EventID returns the id of the last event to replay.
+func (i ArticleAtInDTO) EventID() string {
+	return i.eventID
+}
+
+// Timestamp returns the time up to which events are replayed.
+func (i ArticleAtInDTO) Timestamp() *synchro.Time[tz.UTC] {
+	return i.timestamp
+}
+
+// ArticleAtInDTOOption is an option for ArticleAtInDTO.
+type ArticleAtInDTOOption func(*ArticleAtInDTO)
+
+// ArticleAtInWithEventID replays events up to the event.
+func ArticleAtInWithEventID(eventID string) ArticleAtInDTOOption {
+	return func(i *ArticleAtInDTO) {
+		i.eventID = eventID
+		i.timestamp = nil
+	}
+}
+
+// ArticleAtInWithTimestamp replays events up to the time.
+func ArticleAtInWithTimestamp(timestamp synchro.Time[tz.UTC]) ArticleAtInDTOOption {
+	return func(i *ArticleAtInDTO) {
+		i.eventID = ""
+		i.timestamp = &timestamp
+	}
+}
+
+// NewArticleAtInDTO constructor of ArticleAtInDTO.
+func NewArticleAtInDTO(id string, option ArticleAtInDTOOption) ArticleAtInDTO {
+	i := ArticleAtInDTO{
+		id: id,
+	}
+	option(&i)
+	return i
+}
+
+// ArticleAtOutDTO is a dto for getting an article as it was at a point of its history.
+type ArticleAtOutDTO struct {
+	article ArticleSnapshot
+}
+
+// IsOutDTO is a marker for out dto.
+func (o ArticleAtOutDTO) IsOutDTO() {}
+
+// Article returns article.
+func (o ArticleAtOutDTO) Article() ArticleSnapshot {
+	return o.article
+}
+
+// NewArticleAtOutDTO constructor of ArticleAtOutDTO.
+func NewArticleAtOutDTO(article ArticleSnapshot) ArticleAtOutDTO {
+	return ArticleAtOutDTO{
+		article: article,
+	}
+}
+
+// UploadImageInDTO is a dto for uploading an image.
+type UploadImageInDTO struct {
+	data             io.ReadSeeker
+	filename         string
+	contentType      string
+	keepMetadata     bool
+	clientMutationID string
+}
+
+// Data returns data.
+func (u UploadImageInDTO) Data() io.ReadSeeker {
+	return u.data
+}
+
+// Filename returns filename.
+func (u UploadImageInDTO) Filename() string {
+	return u.filename
+}
+
+// ContentType returns content type.
+func (u UploadImageInDTO) ContentType() string {
+	return u.contentType
+}
+
+// KeepMetadata returns whether the metadata of the image is kept instead of stripped.
+func (u UploadImageInDTO) KeepMetadata() bool {
+	return u.keepMetadata
+}
+
+// ClientMutationID returns client mutation id.
+func (u UploadImageInDTO) ClientMutationID() string {
+	return u.clientMutationID
+}
+
+// NewUploadImageInDTO constructor of UploadImageInDTO.
+func NewUploadImageInDTO(data io.ReadSeeker, filename, contentType string, keepMetadata bool, clientMutationID string) UploadImageInDTO {
+	return UploadImageInDTO{
+		data:             data,
+		filename:         filename,
+		contentType:      contentType,
+		keepMetadata:     keepMetadata,
+		clientMutationID: clientMutationID,
+	}
+}
+
+// ImageVariant is a dto for a resized or re-encoded variant of an uploaded image.
+type ImageVariant struct {
+	url         url.URL
+	width       int
+	height      int
+	contentType string
+}
+
+// URL returns url.
+func (v ImageVariant) URL() url.URL {
+	return v.url
+}
+
+// Width returns width in pixels.
+func (v ImageVariant) Width() int {
+	return v.width
+}
+
+// Height returns height in pixels.
+func (v ImageVariant) Height() int {
+	return v.height
+}
+
+// ContentType returns content type.
+func (v ImageVariant) ContentType() string {
+	return v.contentType
+}
+
+// NewImageVariant constructor of ImageVariant.
+func NewImageVariant(url url.URL, width, height int, contentType string) ImageVariant {
+	return ImageVariant{
+		url:         url,
+		width:       width,
+		height:      height,
+		contentType: contentType,
+	}
+}
+
+// UploadImageOutDTO is a dto for uploading an image.
+type UploadImageOutDTO struct {
+	imageURL         url.URL
+	variants         []ImageVariant
+	deduplicated     bool
+	clientMutationID string
+}
+
+// ImageURL returns image url.
+func (u UploadImageOutDTO) ImageURL() url.URL {
+	return u.imageURL
+}
+
+// Variants returns the variants stored next to the image.
+func (u UploadImageOutDTO) Variants() []ImageVariant {
+	return u.variants
+}
+
+// Deduplicated returns whether the same image had already been uploaded.
+func (u UploadImageOutDTO) Deduplicated() bool {
+	return u.deduplicated
 }
 
 // ClientMutationID returns client mutation id.
@@ -1033,9 +2692,11 @@ func (u UploadImageOutDTO) ClientMutationID() string {
 }
 
 // NewUploadImageOutDTO constructor of UploadImageOutDTO.
-func NewUploadImageOutDTO(imageURL url.URL, clientMutationID string) UploadImageOutDTO {
+func NewUploadImageOutDTO(imageURL url.URL, variants []ImageVariant, deduplicated bool, clientMutationID string) UploadImageOutDTO {
 	return UploadImageOutDTO{
 		imageURL:         imageURL,
+		variants:         variants,
+		deduplicated:     deduplicated,
 		clientMutationID: clientMutationID,
 	}
 }
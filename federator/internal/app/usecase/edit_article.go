@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"log/slog"
+
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// EditArticle is a use-case for editing several fields of an article at once.
+type EditArticle struct {
+	// bloggingEventServiceClient is a client of article service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute edits an article.
+func (u *EditArticle) Execute(ctx context.Context, in dto.EditArticleInDTO) (dto.EditArticleOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("EditArticle#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+
+	var thumbnailURL *string
+	if thumbnail := in.Thumbnail(); thumbnail != nil {
+		thumbnailURL = utils.PtrFromString(thumbnail.String())
+	}
+	response, err := u.bloggingEventServiceClient.EditArticle(
+		newrelic.NewContext(ctx, nrtx),
+		withActor(ctx, withIdempotencyKey(ctx, connect.NewRequest(&grpc.EditArticleRequest{
+			Id:                  in.ID(),
+			Title:               in.Title(),
+			Body:                in.Content(),
+			ThumbnailUrl:        thumbnailURL,
+			ThumbnailVariants:   imageVariantPBs(in.ThumbnailVariants()),
+			AttachTagNames:      in.AttachTagNames(),
+			DetachTagNames:      in.DetachTagNames(),
+			ExpectedLastEventId: utils.PtrFromString(in.ExpectedLastEventID()),
+		}), in.ClientMutationID())))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.EditArticleOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.EditArticleOutDTO{}, err
+	}
+
+	message := response.Msg
+	out := dto.NewEditArticleOutDTO(message.EventId, message.ArticleId, in.ClientMutationID())
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.EditArticleOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// NewEditArticle is a constructor of EditArticle.
+func NewEditArticle(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *EditArticle {
+	return &EditArticle{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
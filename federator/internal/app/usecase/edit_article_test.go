@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	blogapictx "blogapi.miyamo.today/core/context"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"net/url"
+	"testing"
+)
+
+func TestEditArticle_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  dto.EditArticleInDTO
+	}
+	type want struct {
+		out dto.EditArticleOutDTO
+		err error
+	}
+	type testCase struct {
+		bloggingEventServiceClient func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.EditArticleRequest]) blogging_eventconnect.BloggingEventServiceClient
+		args                       args
+		expectedReq                *connect.Request[grpc.EditArticleRequest]
+		want                       want
+	}
+	errTestEditArticle := errors.New("test error")
+	mockBlogAPIContext := func() context.Context {
+		return blogapictx.StoreToContext(
+			context.Background(),
+			blogapictx.New(
+				"1234567890",
+				"0987654321",
+				blogapictx.RequestTypeGRPC,
+				nil,
+				nil))
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.EditArticleRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					EditArticle(gomock.Any(), NewEditArticleRequestMatcher(t, req)).
+					Return(connect.NewResponse(&grpc.BloggingEventResponse{EventId: "Event1", ArticleId: "Article1"}), nil).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.EditArticleRequest{
+				Id:                  "Article1",
+				Title:               utils.PtrFromString("Title1"),
+				ThumbnailUrl:        utils.PtrFromString("https://example.com/thumbnail.png"),
+				AttachTagNames:      []string{"Tag1"},
+				DetachTagNames:      []string{"Tag2"},
+				ExpectedLastEventId: utils.PtrFromString("Event0"),
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in: dto.NewEditArticleInDTO(
+					"Article1",
+					utils.PtrFromString("Title1"),
+					nil,
+					func() *url.URL {
+						u := utils.MustURLParse("https://example.com/thumbnail.png")
+						return &u
+					}(),
+					nil,
+					[]string{"Tag1"},
+					[]string{"Tag2"},
+					"ClientMutationID1",
+					"Event0"),
+			},
+			want: want{
+				out: dto.NewEditArticleOutDTO("Event1", "Article1", "ClientMutationID1"),
+			},
+		},
+		"unhappy_path:grpc-return-error": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.EditArticleRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					EditArticle(gomock.Any(), NewEditArticleRequestMatcher(t, req)).
+					Return(nil, errTestEditArticle).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.EditArticleRequest{
+				Id:   "Article1",
+				Body: utils.PtrFromString("Content1"),
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewEditArticleInDTO("Article1", nil, utils.PtrFromString("Content1"), nil, nil, nil, nil, "ClientMutationID1", ""),
+			},
+			want: want{
+				err: errTestEditArticle,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := NewEditArticle(tt.bloggingEventServiceClient(t, ctrl, tt.expectedReq))
+			out, err := uc.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() err = %v, want %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(tt.want.out, out, cmp.AllowUnexported((dto.EditArticleOutDTO{}))); diff != "" {
+				t.Errorf("Execute() out = %v, want %v", out, tt.want.out)
+			}
+		})
+	}
+}
+
+func NewEditArticleRequestMatcher(t *testing.T, expect *connect.Request[grpc.EditArticleRequest]) gomock.Matcher {
+	return &EditArticleRequestMatcher{
+		expect: expect,
+		t:      t,
+	}
+}
+
+type EditArticleRequestMatcher struct {
+	gomock.Matcher
+	expect *connect.Request[grpc.EditArticleRequest]
+	t      *testing.T
+}
+
+func (m *EditArticleRequestMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case *connect.Request[grpc.EditArticleRequest]:
+		if x == nil {
+			return m.expect == nil
+		}
+		diff := cmp.Diff(x.Msg, m.expect.Msg, protocmp.Transform())
+		if diff != "" {
+			m.t.Errorf("EditArticleRequest mismatch (-want +got):\n%s", diff)
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+func (m *EditArticleRequestMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
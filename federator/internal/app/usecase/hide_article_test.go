@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	blogapictx "blogapi.miyamo.today/core/context"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"testing"
+)
+
+func TestHideArticle_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  dto.HideArticleInDTO
+	}
+	type want struct {
+		out dto.HideArticleOutDTO
+		err error
+	}
+	type testCase struct {
+		bloggingEventServiceClient func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.HideArticleRequest]) blogging_eventconnect.BloggingEventServiceClient
+		args                       args
+		expectedReq                *connect.Request[grpc.HideArticleRequest]
+		want                       want
+	}
+	errTestHideArticle := errors.New("test error")
+	mockBlogAPIContext := func() context.Context {
+		return blogapictx.StoreToContext(
+			context.Background(),
+			blogapictx.New(
+				"1234567890",
+				"0987654321",
+				blogapictx.RequestTypeGRPC,
+				nil,
+				nil))
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.HideArticleRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					HideArticle(gomock.Any(), NewHideArticleRequestMatcher(t, req)).
+					Return(connect.NewResponse(&grpc.BloggingEventResponse{EventId: "Event1", ArticleId: "Article1"}), nil).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.HideArticleRequest{
+				Id:                  "Article1",
+				ExpectedLastEventId: utils.PtrFromString("Event0"),
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewHideArticleInDTO("Article1", "ClientMutationID1", "Event0"),
+			},
+			want: want{
+				out: dto.NewHideArticleOutDTO("Event1", "Article1", "ClientMutationID1"),
+			},
+		},
+		"unhappy_path:grpc-return-error": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.HideArticleRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					HideArticle(gomock.Any(), NewHideArticleRequestMatcher(t, req)).
+					Return(nil, errTestHideArticle).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.HideArticleRequest{
+				Id: "Article1",
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewHideArticleInDTO("Article1", "ClientMutationID1", ""),
+			},
+			want: want{
+				err: errTestHideArticle,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := NewHideArticle(tt.bloggingEventServiceClient(t, ctrl, tt.expectedReq))
+			out, err := uc.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() err = %v, want %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(tt.want.out, out, cmp.AllowUnexported((dto.HideArticleOutDTO{}))); diff != "" {
+				t.Errorf("Execute() out = %v, want %v", out, tt.want.out)
+			}
+		})
+	}
+}
+
+func NewHideArticleRequestMatcher(t *testing.T, expect *connect.Request[grpc.HideArticleRequest]) gomock.Matcher {
+	return &HideArticleRequestMatcher{
+		expect: expect,
+		t:      t,
+	}
+}
+
+type HideArticleRequestMatcher struct {
+	gomock.Matcher
+	expect *connect.Request[grpc.HideArticleRequest]
+	t      *testing.T
+}
+
+func (m *HideArticleRequestMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case *connect.Request[grpc.HideArticleRequest]:
+		if x == nil {
+			return m.expect == nil
+		}
+		diff := cmp.Diff(x.Msg, m.expect.Msg, protocmp.Transform())
+		if diff != "" {
+			m.t.Errorf("HideArticleRequest mismatch (-want +got):\n%s", diff)
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+func (m *HideArticleRequestMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/echo/middlewares"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+)
+
+const (
+	// headerIdempotencyKey is the request header blogging-event-service deduplicates retried mutations with.
+	headerIdempotencyKey = "Idempotency-Key"
+	// headerCallerID is the request header blogging-event-service scopes idempotency keys to.
+	headerCallerID = "X-Caller-Id"
+)
+
+// withIdempotencyKey sets the client mutation id and the subject of the caller's token to the request headers,
+// so that a retried mutation returns the result of the first one instead of writing a new event.
+func withIdempotencyKey[T any](ctx context.Context, req *connect.Request[T], clientMutationID string) *connect.Request[T] {
+	if clientMutationID == "" {
+		return req
+	}
+	req.Header().Set(headerIdempotencyKey, clientMutationID)
+	if token, ok := ctx.Value(middlewares.JWTContextKey{}).(jwt.Token); ok {
+		if subject, ok := token.Subject(); ok {
+			req.Header().Set(headerCallerID, subject)
+		}
+	}
+	return req
+}
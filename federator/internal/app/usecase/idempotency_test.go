@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/echo/middlewares"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"testing"
+)
+
+func Test_withIdempotencyKey(t *testing.T) {
+	type want struct {
+		idempotencyKey string
+		callerID       string
+	}
+	type testCase struct {
+		ctx              func(t *testing.T) context.Context
+		clientMutationID string
+		want             want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			ctx: func(t *testing.T) context.Context {
+				token, err := jwt.NewBuilder().Subject("Caller1").Build()
+				if err != nil {
+					t.Fatal(err)
+				}
+				return context.WithValue(context.Background(), middlewares.JWTContextKey{}, token)
+			},
+			clientMutationID: "Mutation1",
+			want: want{
+				idempotencyKey: "Mutation1",
+				callerID:       "Caller1",
+			},
+		},
+		"happy_path/without-token": {
+			ctx: func(t *testing.T) context.Context {
+				return context.Background()
+			},
+			clientMutationID: "Mutation1",
+			want: want{
+				idempotencyKey: "Mutation1",
+			},
+		},
+		"happy_path/without-client-mutation-id": {
+			ctx: func(t *testing.T) context.Context {
+				token, err := jwt.NewBuilder().Subject("Caller1").Build()
+				if err != nil {
+					t.Fatal(err)
+				}
+				return context.WithValue(context.Background(), middlewares.JWTContextKey{}, token)
+			},
+			want: want{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := withIdempotencyKey(tt.ctx(t), connect.NewRequest(&grpc.HideArticleRequest{Id: "Article1"}), tt.clientMutationID)
+			if got := req.Header().Get(headerIdempotencyKey); got != tt.want.idempotencyKey {
+				t.Errorf("withIdempotencyKey() %s = %v, want %v", headerIdempotencyKey, got, tt.want.idempotencyKey)
+			}
+			if got := req.Header().Get(headerCallerID); got != tt.want.callerID {
+				t.Errorf("withIdempotencyKey() %s = %v, want %v", headerCallerID, got, tt.want.callerID)
+			}
+		})
+	}
+}
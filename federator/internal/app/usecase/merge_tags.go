@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"log/slog"
+
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// MergeTags is a use-case for merging tags into another one on every article they are attached to.
+type MergeTags struct {
+	// bloggingEventServiceClient is a client of article service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute merges tags into another one on every article they are attached to.
+//
+// It sends no idempotency key, since the blogging event service writes an event to each article and cannot replay them as one.
+func (u *MergeTags) Execute(ctx context.Context, in dto.MergeTagsInDTO) (dto.MergeTagsOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("MergeTags#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+
+	response, err := u.bloggingEventServiceClient.MergeTags(
+		newrelic.NewContext(ctx, nrtx),
+		withActor(ctx, connect.NewRequest(&grpc.MergeTagsRequest{
+			Sources: in.Sources(),
+			Into:    in.Into(),
+		})))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.MergeTagsOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.MergeTagsOutDTO{}, err
+	}
+
+	events := response.Msg.GetEvents()
+	articles := make([]dto.RetaggedArticle, 0, len(events))
+	for _, e := range events {
+		articles = append(articles, dto.NewRetaggedArticle(e.EventId, e.ArticleId))
+	}
+	out := dto.NewMergeTagsOutDTO(articles, in.ClientMutationID())
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.MergeTagsOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// NewMergeTags is a constructor of MergeTags.
+func NewMergeTags(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *MergeTags {
+	return &MergeTags{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	blogapictx "blogapi.miyamo.today/core/context"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"testing"
+)
+
+func TestMergeTags_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  dto.MergeTagsInDTO
+	}
+	type want struct {
+		out dto.MergeTagsOutDTO
+		err error
+	}
+	type testCase struct {
+		bloggingEventServiceClient func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.MergeTagsRequest]) blogging_eventconnect.BloggingEventServiceClient
+		args                       args
+		expectedReq                *connect.Request[grpc.MergeTagsRequest]
+		want                       want
+	}
+	errTestMergeTags := errors.New("test error")
+	mockBlogAPIContext := func() context.Context {
+		return blogapictx.StoreToContext(
+			context.Background(),
+			blogapictx.New(
+				"1234567890",
+				"0987654321",
+				blogapictx.RequestTypeGRPC,
+				nil,
+				nil))
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.MergeTagsRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					MergeTags(gomock.Any(), NewMergeTagsRequestMatcher(t, req)).
+					Return(connect.NewResponse(&grpc.MergeTagsResponse{
+						Events: []*grpc.BloggingEventResponse{
+							{EventId: "Event1", ArticleId: "Article1"},
+							{EventId: "Event2", ArticleId: "Article2"},
+						},
+					}), nil).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.MergeTagsRequest{Sources: []string{"golang", "go"}, Into: "Go"}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewMergeTagsInDTO([]string{"golang", "go"}, "Go", "ClientMutationID1"),
+			},
+			want: want{
+				out: dto.NewMergeTagsOutDTO([]dto.RetaggedArticle{
+					dto.NewRetaggedArticle("Event1", "Article1"),
+					dto.NewRetaggedArticle("Event2", "Article2"),
+				}, "ClientMutationID1"),
+			},
+		},
+		"unhappy_path:grpc-return-error": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.MergeTagsRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					MergeTags(gomock.Any(), NewMergeTagsRequestMatcher(t, req)).
+					Return(nil, errTestMergeTags).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.MergeTagsRequest{Sources: []string{"golang", "go"}, Into: "Go"}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewMergeTagsInDTO([]string{"golang", "go"}, "Go", "ClientMutationID1"),
+			},
+			want: want{
+				err: errTestMergeTags,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := NewMergeTags(tt.bloggingEventServiceClient(t, ctrl, tt.expectedReq))
+			out, err := uc.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() err = %v, want %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(tt.want.out, out, cmp.AllowUnexported(dto.MergeTagsOutDTO{}, dto.RetaggedArticle{})); diff != "" {
+				t.Errorf("Execute() out = %v, want %v", out, tt.want.out)
+			}
+		})
+	}
+}
+
+func NewMergeTagsRequestMatcher(t *testing.T, expect *connect.Request[grpc.MergeTagsRequest]) gomock.Matcher {
+	return &MergeTagsRequestMatcher{
+		expect: expect,
+		t:      t,
+	}
+}
+
+type MergeTagsRequestMatcher struct {
+	gomock.Matcher
+	expect *connect.Request[grpc.MergeTagsRequest]
+	t      *testing.T
+}
+
+func (m *MergeTagsRequestMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case *connect.Request[grpc.MergeTagsRequest]:
+		if x == nil {
+			return m.expect == nil
+		}
+		diff := cmp.Diff(x.Msg, m.expect.Msg, protocmp.Transform())
+		if diff != "" {
+			m.t.Errorf("MergeTagsRequest mismatch (-want +got):\n%s", diff)
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+func (m *MergeTagsRequestMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"log/slog"
+
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// PublishArticle is a use-case for publishing a draft article.
+type PublishArticle struct {
+	// bloggingEventServiceClient is a client of article service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute publishes a draft article.
+func (u *PublishArticle) Execute(ctx context.Context, in dto.PublishArticleInDTO) (dto.PublishArticleOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("PublishArticle#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+
+	response, err := u.bloggingEventServiceClient.PublishArticle(
+		newrelic.NewContext(ctx, nrtx),
+		withActor(ctx, withIdempotencyKey(ctx, connect.NewRequest(&grpc.PublishArticleRequest{
+			Id:                  in.ID(),
+			ExpectedLastEventId: utils.PtrFromString(in.ExpectedLastEventID()),
+		}), in.ClientMutationID())))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.PublishArticleOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.PublishArticleOutDTO{}, err
+	}
+
+	message := response.Msg
+	out := dto.NewPublishArticleOutDTO(message.EventId, message.ArticleId, in.ClientMutationID())
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.PublishArticleOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// NewPublishArticle is a constructor of PublishArticle.
+func NewPublishArticle(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *PublishArticle {
+	return &PublishArticle{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"log/slog"
+
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// RenameTag is a use-case for renaming a tag on every article it is attached to.
+type RenameTag struct {
+	// bloggingEventServiceClient is a client of article service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute renames a tag on every article it is attached to.
+//
+// It sends no idempotency key, since the blogging event service writes an event to each article and cannot replay them as one.
+func (u *RenameTag) Execute(ctx context.Context, in dto.RenameTagInDTO) (dto.RenameTagOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("RenameTag#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+
+	response, err := u.bloggingEventServiceClient.RenameTag(
+		newrelic.NewContext(ctx, nrtx),
+		withActor(ctx, connect.NewRequest(&grpc.RenameTagRequest{
+			From: in.From(),
+			To:   in.To(),
+		})))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.RenameTagOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.RenameTagOutDTO{}, err
+	}
+
+	events := response.Msg.GetEvents()
+	articles := make([]dto.RetaggedArticle, 0, len(events))
+	for _, e := range events {
+		articles = append(articles, dto.NewRetaggedArticle(e.EventId, e.ArticleId))
+	}
+	out := dto.NewRenameTagOutDTO(articles, in.ClientMutationID())
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.RenameTagOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// NewRenameTag is a constructor of RenameTag.
+func NewRenameTag(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *RenameTag {
+	return &RenameTag{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"log/slog"
+
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// ReorderSeries is a use-case for reordering a series.
+type ReorderSeries struct {
+	// bloggingEventServiceClient is a client of blogging event service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute reorders a series to the order the articles are given.
+//
+// It sends no idempotency key, since the blogging event service writes an event to each article and cannot replay them as one.
+func (u *ReorderSeries) Execute(ctx context.Context, in dto.ReorderSeriesInDTO) (dto.ReorderSeriesOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ReorderSeries#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+
+	response, err := u.bloggingEventServiceClient.ReorderSeries(
+		newrelic.NewContext(ctx, nrtx),
+		withActor(ctx, connect.NewRequest(&grpc.ReorderSeriesRequest{
+			SeriesId:   in.SeriesID(),
+			ArticleIds: in.ArticleIDs(),
+		})))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.ReorderSeriesOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.ReorderSeriesOutDTO{}, err
+	}
+
+	out := dto.NewReorderSeriesOutDTO(response.Msg.GetSeriesId(), seriesArticlesFromPB(response.Msg.GetEvents()), in.ClientMutationID())
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.ReorderSeriesOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// NewReorderSeries is a constructor of ReorderSeries.
+func NewReorderSeries(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *ReorderSeries {
+	return &ReorderSeries{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
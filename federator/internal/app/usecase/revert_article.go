@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"log/slog"
+
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// RevertArticle is a use-case for restoring an article as it was right after an earlier event.
+type RevertArticle struct {
+	// bloggingEventServiceClient is a client of article service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute restores an article as it was right after an earlier event.
+func (u *RevertArticle) Execute(ctx context.Context, in dto.RevertArticleInDTO) (dto.RevertArticleOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("RevertArticle#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+
+	response, err := u.bloggingEventServiceClient.RevertArticle(
+		newrelic.NewContext(ctx, nrtx),
+		withActor(ctx, withIdempotencyKey(ctx, connect.NewRequest(&grpc.RevertArticleRequest{
+			Id:                  in.ID(),
+			ToEventId:           in.ToEventID(),
+			ExpectedLastEventId: utils.PtrFromString(in.ExpectedLastEventID()),
+		}), in.ClientMutationID())))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.RevertArticleOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.RevertArticleOutDTO{}, err
+	}
+
+	message := response.Msg
+	out := dto.NewRevertArticleOutDTO(message.EventId, message.ArticleId, in.ClientMutationID())
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.RevertArticleOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// NewRevertArticle is a constructor of RevertArticle.
+func NewRevertArticle(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *RevertArticle {
+	return &RevertArticle{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	blogapictx "blogapi.miyamo.today/core/context"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"testing"
+)
+
+func TestRevertArticle_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  dto.RevertArticleInDTO
+	}
+	type want struct {
+		out dto.RevertArticleOutDTO
+		err error
+	}
+	type testCase struct {
+		bloggingEventServiceClient func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.RevertArticleRequest]) blogging_eventconnect.BloggingEventServiceClient
+		args                       args
+		expectedReq                *connect.Request[grpc.RevertArticleRequest]
+		want                       want
+	}
+	errTestRevertArticle := errors.New("test error")
+	mockBlogAPIContext := func() context.Context {
+		return blogapictx.StoreToContext(
+			context.Background(),
+			blogapictx.New(
+				"1234567890",
+				"0987654321",
+				blogapictx.RequestTypeGRPC,
+				nil,
+				nil))
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.RevertArticleRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					RevertArticle(gomock.Any(), NewRevertArticleRequestMatcher(t, req)).
+					Return(connect.NewResponse(&grpc.BloggingEventResponse{EventId: "Event1", ArticleId: "Article1"}), nil).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.RevertArticleRequest{
+				Id:                  "Article1",
+				ToEventId:           "Event-1",
+				ExpectedLastEventId: utils.PtrFromString("Event0"),
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewRevertArticleInDTO("Article1", "Event-1", "ClientMutationID1", "Event0"),
+			},
+			want: want{
+				out: dto.NewRevertArticleOutDTO("Event1", "Article1", "ClientMutationID1"),
+			},
+		},
+		"unhappy_path:grpc-return-error": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.RevertArticleRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					RevertArticle(gomock.Any(), NewRevertArticleRequestMatcher(t, req)).
+					Return(nil, errTestRevertArticle).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.RevertArticleRequest{
+				Id:        "Article1",
+				ToEventId: "Event-1",
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewRevertArticleInDTO("Article1", "Event-1", "ClientMutationID1", ""),
+			},
+			want: want{
+				err: errTestRevertArticle,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := NewRevertArticle(tt.bloggingEventServiceClient(t, ctrl, tt.expectedReq))
+			out, err := uc.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() err = %v, want %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(tt.want.out, out, cmp.AllowUnexported((dto.RevertArticleOutDTO{}))); diff != "" {
+				t.Errorf("Execute() out = %v, want %v", out, tt.want.out)
+			}
+		})
+	}
+}
+
+func NewRevertArticleRequestMatcher(t *testing.T, expect *connect.Request[grpc.RevertArticleRequest]) gomock.Matcher {
+	return &RevertArticleRequestMatcher{
+		expect: expect,
+		t:      t,
+	}
+}
+
+type RevertArticleRequestMatcher struct {
+	gomock.Matcher
+	expect *connect.Request[grpc.RevertArticleRequest]
+	t      *testing.T
+}
+
+func (m *RevertArticleRequestMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case *connect.Request[grpc.RevertArticleRequest]:
+		if x == nil {
+			return m.expect == nil
+		}
+		diff := cmp.Diff(x.Msg, m.expect.Msg, protocmp.Transform())
+		if diff != "" {
+			m.t.Errorf("RevertArticleRequest mismatch (-want +got):\n%s", diff)
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+func (m *RevertArticleRequestMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"log/slog"
+
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ScheduleArticle is a use-case for scheduling the publication of an article.
+type ScheduleArticle struct {
+	// bloggingEventServiceClient is a client of article service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute sets the time an article goes live.
+func (u *ScheduleArticle) Execute(ctx context.Context, in dto.ScheduleArticleInDTO) (dto.ScheduleArticleOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ScheduleArticle#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+
+	response, err := u.bloggingEventServiceClient.ScheduleArticle(
+		newrelic.NewContext(ctx, nrtx),
+		withActor(ctx, withIdempotencyKey(ctx, connect.NewRequest(&grpc.ScheduleArticleRequest{
+			Id:                  in.ID(),
+			PublishAt:           timestamppb.New(in.PublishAt().StdTime()),
+			ExpectedLastEventId: utils.PtrFromString(in.ExpectedLastEventID()),
+		}), in.ClientMutationID())))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.ScheduleArticleOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.ScheduleArticleOutDTO{}, err
+	}
+
+	message := response.Msg
+	out := dto.NewScheduleArticleOutDTO(message.EventId, message.ArticleId, in.ClientMutationID())
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.ScheduleArticleOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// NewScheduleArticle is a constructor of ScheduleArticle.
+func NewScheduleArticle(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *ScheduleArticle {
+	return &ScheduleArticle{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	blogapictx "blogapi.miyamo.today/core/context"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"testing"
+)
+
+func TestScheduleArticle_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  dto.ScheduleArticleInDTO
+	}
+	type want struct {
+		out dto.ScheduleArticleOutDTO
+		err error
+	}
+	type testCase struct {
+		bloggingEventServiceClient func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.ScheduleArticleRequest]) blogging_eventconnect.BloggingEventServiceClient
+		args                       args
+		expectedReq                *connect.Request[grpc.ScheduleArticleRequest]
+		want                       want
+	}
+	errTestScheduleArticle := errors.New("test error")
+	mockBlogAPIContext := func() context.Context {
+		return blogapictx.StoreToContext(
+			context.Background(),
+			blogapictx.New(
+				"1234567890",
+				"0987654321",
+				blogapictx.RequestTypeGRPC,
+				nil,
+				nil))
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.ScheduleArticleRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					ScheduleArticle(gomock.Any(), NewScheduleArticleRequestMatcher(t, req)).
+					Return(connect.NewResponse(&grpc.BloggingEventResponse{EventId: "Event1", ArticleId: "Article1"}), nil).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.ScheduleArticleRequest{
+				Id:                  "Article1",
+				PublishAt:           timestamppb.New(synchro.New[tz.UTC](2026, 1, 1, 0, 0, 0, 0).StdTime()),
+				ExpectedLastEventId: utils.PtrFromString("Event0"),
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewScheduleArticleInDTO("Article1", synchro.New[tz.UTC](2026, 1, 1, 0, 0, 0, 0), "ClientMutationID1", "Event0"),
+			},
+			want: want{
+				out: dto.NewScheduleArticleOutDTO("Event1", "Article1", "ClientMutationID1"),
+			},
+		},
+		"unhappy_path:grpc-return-error": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.ScheduleArticleRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					ScheduleArticle(gomock.Any(), NewScheduleArticleRequestMatcher(t, req)).
+					Return(nil, errTestScheduleArticle).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.ScheduleArticleRequest{
+				Id:        "Article1",
+				PublishAt: timestamppb.New(synchro.New[tz.UTC](2026, 1, 1, 0, 0, 0, 0).StdTime()),
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewScheduleArticleInDTO("Article1", synchro.New[tz.UTC](2026, 1, 1, 0, 0, 0, 0), "ClientMutationID1", ""),
+			},
+			want: want{
+				err: errTestScheduleArticle,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := NewScheduleArticle(tt.bloggingEventServiceClient(t, ctrl, tt.expectedReq))
+			out, err := uc.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() err = %v, want %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(tt.want.out, out, cmp.AllowUnexported((dto.ScheduleArticleOutDTO{}))); diff != "" {
+				t.Errorf("Execute() out = %v, want %v", out, tt.want.out)
+			}
+		})
+	}
+}
+
+func NewScheduleArticleRequestMatcher(t *testing.T, expect *connect.Request[grpc.ScheduleArticleRequest]) gomock.Matcher {
+	return &ScheduleArticleRequestMatcher{
+		expect: expect,
+		t:      t,
+	}
+}
+
+type ScheduleArticleRequestMatcher struct {
+	gomock.Matcher
+	expect *connect.Request[grpc.ScheduleArticleRequest]
+	t      *testing.T
+}
+
+func (m *ScheduleArticleRequestMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case *connect.Request[grpc.ScheduleArticleRequest]:
+		if x == nil {
+			return m.expect == nil
+		}
+		diff := cmp.Diff(x.Msg, m.expect.Msg, protocmp.Transform())
+		if diff != "" {
+			m.t.Errorf("ScheduleArticleRequest mismatch (-want +got):\n%s", diff)
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+func (m *ScheduleArticleRequestMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
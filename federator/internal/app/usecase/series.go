@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/article"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/article/articleconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"log/slog"
+	"net/url"
+
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// Series is a use-case of getting a series by its id or by an article in it.
+type Series struct {
+	// articleServiceClient is a client of article service.
+	articleServiceClient articleconnect.ArticleServiceClient
+}
+
+// seriesID gets the id of the series the article is a part of. It is empty if the article is in none.
+func (u *Series) seriesID(ctx context.Context, articleID string) (string, error) {
+	response, err := u.articleServiceClient.GetArticleById(
+		ctx,
+		connect.NewRequest(
+			&grpc.GetArticleByIdRequest{
+				Id: articleID,
+			}))
+	if err != nil {
+		return "", err
+	}
+	return response.Msg.GetArticle().GetSeriesId(), nil
+}
+
+// Execute gets a series by its id or by an article in it.
+// The series got by an article has the parts right before and after the article as well.
+func (u *Series) Execute(ctx context.Context, in dto.SeriesInDTO) (dto.SeriesOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Series#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+
+	seriesID := in.ID()
+	if in.ArticleID() != "" {
+		seriesID, err = u.seriesID(newrelic.NewContext(ctx, nrtx), in.ArticleID())
+		if err != nil {
+			err = errors.WithStack(err)
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*dto.SeriesOutDTO", nil),
+					slog.Any("error", err)))
+			return dto.SeriesOutDTO{}, err
+		}
+		if seriesID == "" {
+			logger.InfoContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*dto.SeriesOutDTO", nil),
+					slog.Any("error", nil)))
+			return dto.SeriesOutDTO{}, nil
+		}
+	}
+
+	response, err := u.articleServiceClient.GetSeries(
+		newrelic.NewContext(ctx, nrtx),
+		connect.NewRequest(
+			&grpc.GetSeriesRequest{
+				Id: seriesID,
+			}))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.SeriesOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.SeriesOutDTO{}, err
+	}
+
+	articlePBs := response.Msg.GetArticles()
+	articleDTOs := make([]dto.ArticleTag, 0, len(articlePBs))
+	var title string
+	for _, articlePB := range articlePBs {
+		title = articlePB.GetSeriesTitle()
+		articleDTO, err := articleTagFromPB(articlePB)
+		if err != nil {
+			err = errors.WithStack(err)
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*dto.SeriesOutDTO", nil),
+					slog.Any("error", err)))
+			return dto.SeriesOutDTO{}, err
+		}
+		articleDTOs = append(articleDTOs, articleDTO)
+	}
+
+	var opts []dto.SeriesOutDTOOption
+	for i, articleDTO := range articleDTOs {
+		if articleDTO.ID() != in.ArticleID() {
+			continue
+		}
+		if i > 0 {
+			opts = append(opts, dto.SeriesOutDTOWithPrevious(articleDTOs[i-1]))
+		}
+		if i < len(articleDTOs)-1 {
+			opts = append(opts, dto.SeriesOutDTOWithNext(articleDTOs[i+1]))
+		}
+		break
+	}
+	out := dto.NewSeriesOutDTO(seriesID, title, articleDTOs, opts...)
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.SeriesOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// articleTagFromPB converts grpc.Article to dto.ArticleTag.
+func articleTagFromPB(from *grpc.Article) (dto.ArticleTag, error) {
+	tagPBs := from.GetTags()
+	tagDTOs := make([]dto.Tag, 0, len(tagPBs))
+	for _, tag := range tagPBs {
+		tagDTOs = append(tagDTOs, dto.NewTag(
+			tag.Id,
+			tag.Name))
+	}
+	thumbnailURL, err := url.Parse(from.ThumbnailUrl)
+	if err != nil {
+		return dto.ArticleTag{}, err
+	}
+	thumbnailVariants, err := imageVariantsFromPB(from.GetThumbnailVariants())
+	if err != nil {
+		return dto.ArticleTag{}, err
+	}
+	return dto.NewArticleTag(
+		from.Id,
+		from.Title,
+		from.Body,
+		*thumbnailURL,
+		thumbnailVariants,
+		synchro.In[tz.UTC](from.CreatedAt.AsTime()),
+		synchro.In[tz.UTC](from.UpdatedAt.AsTime()),
+		tagDTOs,
+		from.Slug,
+		from.CreatedBy,
+		from.UpdatedBy), nil
+}
+
+// NewSeries is a constructor of Series.
+func NewSeries(articleServiceClient articleconnect.ArticleServiceClient) *Series {
+	return &Series{
+		articleServiceClient: articleServiceClient,
+	}
+}
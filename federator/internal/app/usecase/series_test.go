@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/article"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/article/articleconnect"
+	marticleconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/article/articleconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"reflect"
+	"testing"
+
+	blogapictx "blogapi.miyamo.today/core/context"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"github.com/cockroachdb/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSeries_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  dto.SeriesInDTO
+	}
+	type want struct {
+		out dto.SeriesOutDTO
+		err error
+	}
+	type testCase struct {
+		articleServiceClient func(ctrl *gomock.Controller) articleconnect.ArticleServiceClient
+		args                 args
+		want                 want
+		wantErr              bool
+	}
+	errTestSeries := errors.New("test error")
+	mockBlogAPIContext := func() context.Context {
+		return blogapictx.StoreToContext(
+			context.Background(),
+			blogapictx.New(
+				"1234567890",
+				"0987654321",
+				blogapictx.RequestTypeGRPC,
+				nil,
+				nil))
+	}
+	articlePB := func(id string, position int32) *grpc.Article {
+		return &grpc.Article{
+			Id:             id,
+			Title:          id,
+			Body:           "## " + id,
+			ThumbnailUrl:   "example.com/example.png",
+			CreatedAt:      timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+			UpdatedAt:      timestamppb.New(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0).StdTime()),
+			Tags:           []*grpc.Tag{{Id: "Tag1", Name: "Tag1"}},
+			SeriesId:       "Series1",
+			SeriesTitle:    "Go Tutorial",
+			SeriesPosition: position,
+		}
+	}
+	articleDTO := func(id string) dto.ArticleTag {
+		return dto.NewArticleTag(
+			id,
+			id,
+			"## "+id,
+			utils.MustURLParse("example.com/example.png"),
+			nil,
+			synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+			synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+			[]dto.Tag{
+				dto.NewTag("Tag1", "Tag1"),
+			},
+			"",
+			"",
+			"")
+	}
+	seriesResponse := connect.NewResponse(&grpc.GetSeriesResponse{
+		Articles: []*grpc.Article{
+			articlePB("Article1", 1),
+			articlePB("Article2", 2),
+			articlePB("Article3", 3),
+		},
+	})
+	tests := map[string]testCase{
+		"happy_path/by_id": {
+			articleServiceClient: func(ctrl *gomock.Controller) articleconnect.ArticleServiceClient {
+				articleServiceClient := marticleconnect.NewMockArticleServiceClient(ctrl)
+				articleServiceClient.EXPECT().
+					GetArticleById(gomock.Any(), gomock.Any()).
+					Times(0)
+				articleServiceClient.EXPECT().
+					GetSeries(gomock.Any(), gomock.Any()).
+					Return(seriesResponse, nil).
+					Times(1)
+				return articleServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewSeriesInDTO("Series1"),
+			},
+			want: want{
+				out: dto.NewSeriesOutDTO(
+					"Series1",
+					"Go Tutorial",
+					[]dto.ArticleTag{
+						articleDTO("Article1"),
+						articleDTO("Article2"),
+						articleDTO("Article3"),
+					}),
+			},
+		},
+		"happy_path/by_article": {
+			articleServiceClient: func(ctrl *gomock.Controller) articleconnect.ArticleServiceClient {
+				articleServiceClient := marticleconnect.NewMockArticleServiceClient(ctrl)
+				articleServiceClient.EXPECT().
+					GetArticleById(gomock.Any(), gomock.Any()).
+					Return(connect.NewResponse(&grpc.GetArticleByIdResponse{
+						Article: articlePB("Article2", 2),
+					}), nil).
+					Times(1)
+				articleServiceClient.EXPECT().
+					GetSeries(gomock.Any(), gomock.Any()).
+					Return(seriesResponse, nil).
+					Times(1)
+				return articleServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewSeriesByArticleInDTO("Article2"),
+			},
+			want: want{
+				out: dto.NewSeriesOutDTO(
+					"Series1",
+					"Go Tutorial",
+					[]dto.ArticleTag{
+						articleDTO("Article1"),
+						articleDTO("Article2"),
+						articleDTO("Article3"),
+					},
+					dto.SeriesOutDTOWithPrevious(articleDTO("Article1")),
+					dto.SeriesOutDTOWithNext(articleDTO("Article3"))),
+			},
+		},
+		"happy_path/by_first_article": {
+			articleServiceClient: func(ctrl *gomock.Controller) articleconnect.ArticleServiceClient {
+				articleServiceClient := marticleconnect.NewMockArticleServiceClient(ctrl)
+				articleServiceClient.EXPECT().
+					GetArticleById(gomock.Any(), gomock.Any()).
+					Return(connect.NewResponse(&grpc.GetArticleByIdResponse{
+						Article: articlePB("Article1", 1),
+					}), nil).
+					Times(1)
+				articleServiceClient.EXPECT().
+					GetSeries(gomock.Any(), gomock.Any()).
+					Return(seriesResponse, nil).
+					Times(1)
+				return articleServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewSeriesByArticleInDTO("Article1"),
+			},
+			want: want{
+				out: dto.NewSeriesOutDTO(
+					"Series1",
+					"Go Tutorial",
+					[]dto.ArticleTag{
+						articleDTO("Article1"),
+						articleDTO("Article2"),
+						articleDTO("Article3"),
+					},
+					dto.SeriesOutDTOWithNext(articleDTO("Article2"))),
+			},
+		},
+		"happy_path/article_not_in_series": {
+			articleServiceClient: func(ctrl *gomock.Controller) articleconnect.ArticleServiceClient {
+				articleServiceClient := marticleconnect.NewMockArticleServiceClient(ctrl)
+				articleServiceClient.EXPECT().
+					GetArticleById(gomock.Any(), gomock.Any()).
+					Return(connect.NewResponse(&grpc.GetArticleByIdResponse{
+						Article: &grpc.Article{Id: "Article1"},
+					}), nil).
+					Times(1)
+				articleServiceClient.EXPECT().
+					GetSeries(gomock.Any(), gomock.Any()).
+					Times(0)
+				return articleServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewSeriesByArticleInDTO("Article1"),
+			},
+			want: want{
+				out: dto.SeriesOutDTO{},
+			},
+		},
+		"unhappy_path/get_article_by_id_returns_error": {
+			articleServiceClient: func(ctrl *gomock.Controller) articleconnect.ArticleServiceClient {
+				articleServiceClient := marticleconnect.NewMockArticleServiceClient(ctrl)
+				articleServiceClient.EXPECT().
+					GetArticleById(gomock.Any(), gomock.Any()).
+					Return(nil, errTestSeries).
+					Times(1)
+				articleServiceClient.EXPECT().
+					GetSeries(gomock.Any(), gomock.Any()).
+					Times(0)
+				return articleServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewSeriesByArticleInDTO("Article1"),
+			},
+			want: want{
+				err: errTestSeries,
+			},
+			wantErr: true,
+		},
+		"unhappy_path/get_series_returns_error": {
+			articleServiceClient: func(ctrl *gomock.Controller) articleconnect.ArticleServiceClient {
+				articleServiceClient := marticleconnect.NewMockArticleServiceClient(ctrl)
+				articleServiceClient.EXPECT().
+					GetSeries(gomock.Any(), gomock.Any()).
+					Return(nil, errTestSeries).
+					Times(1)
+				return articleServiceClient
+			},
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewSeriesInDTO("Series1"),
+			},
+			want: want{
+				err: errTestSeries,
+			},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			articleServiceClient := tt.articleServiceClient(ctrl)
+			u := NewSeries(articleServiceClient)
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Execute() expected error but got nil")
+					return
+				}
+				if !errors.Is(err, tt.want.err) {
+					t.Errorf("Execute() error = %v, want %v", err, tt.want.err)
+					return
+				}
+			} else if err != nil {
+				t.Errorf("Execute() expected nil but got error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want.out) {
+				t.Errorf("Execute() got = %v, want %v", got, tt.want.out)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"log/slog"
+
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// UnhideArticle is a use-case for unhiding an article.
+type UnhideArticle struct {
+	// bloggingEventServiceClient is a client of article service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute makes a hidden article visible again.
+func (u *UnhideArticle) Execute(ctx context.Context, in dto.UnhideArticleInDTO) (dto.UnhideArticleOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("UnhideArticle#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+
+	response, err := u.bloggingEventServiceClient.UnhideArticle(
+		newrelic.NewContext(ctx, nrtx),
+		withActor(ctx, withIdempotencyKey(ctx, connect.NewRequest(&grpc.UnhideArticleRequest{
+			Id:                  in.ID(),
+			ExpectedLastEventId: utils.PtrFromString(in.ExpectedLastEventID()),
+		}), in.ClientMutationID())))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.UnhideArticleOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.UnhideArticleOutDTO{}, err
+	}
+
+	message := response.Msg
+	out := dto.NewUnhideArticleOutDTO(message.EventId, message.ArticleId, in.ClientMutationID())
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.UnhideArticleOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// NewUnhideArticle is a constructor of UnhideArticle.
+func NewUnhideArticle(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *UnhideArticle {
+	return &UnhideArticle{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
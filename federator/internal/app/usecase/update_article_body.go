@@ -11,6 +11,7 @@ import (
 	"log/slog"
 
 	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"blogapi.miyamo.today/federator/internal/utils"
 	"github.com/cockroachdb/errors"
 	"github.com/newrelic/go-agent/v3/newrelic"
 )
@@ -37,10 +38,11 @@ func (u *UpdateArticleBody) Execute(ctx context.Context, in dto.UpdateArticleBod
 
 	response, err := u.bloggingEventServiceClient.UpdateArticleBody(
 		newrelic.NewContext(ctx, nrtx),
-		connect.NewRequest(&grpc.UpdateArticleBodyRequest{
-			Id:   in.ID(),
-			Body: in.Content(),
-		}))
+		withActor(ctx, withIdempotencyKey(ctx, connect.NewRequest(&grpc.UpdateArticleBodyRequest{
+			Id:                  in.ID(),
+			Body:                in.Content(),
+			ExpectedLastEventId: utils.PtrFromString(in.ExpectedLastEventID()),
+		}), in.ClientMutationID())))
 	if err != nil {
 		err = errors.WithStack(err)
 		logger.WarnContext(ctx, "END",
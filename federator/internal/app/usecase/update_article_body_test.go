@@ -6,6 +6,7 @@ import (
 	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
 	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
 	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
 	"connectrpc.com/connect"
 	"context"
 	"fmt"
@@ -52,12 +53,13 @@ func TestUpdateArticleBody_Execute(t *testing.T) {
 				return bloggingEventServiceClient
 			},
 			expectedReq: connect.NewRequest(&grpc.UpdateArticleBodyRequest{
-				Id:   "Article1",
-				Body: "happy_path",
+				Id:                  "Article1",
+				Body:                "happy_path",
+				ExpectedLastEventId: utils.PtrFromString("Event0"),
 			}),
 			args: args{
 				ctx: mockBlogAPIContext(),
-				in:  dto.NewUpdateArticleBodyInDTO("Article1", "happy_path", "Mutation1"),
+				in:  dto.NewUpdateArticleBodyInDTO("Article1", "happy_path", "Mutation1", "Event0"),
 			},
 			want: want{
 				out: dto.NewUpdateArticleBodyOutDTO("Event1", "Article1", "Mutation1"),
@@ -77,7 +79,7 @@ func TestUpdateArticleBody_Execute(t *testing.T) {
 			}),
 			args: args{
 				ctx: mockBlogAPIContext(),
-				in:  dto.NewUpdateArticleBodyInDTO("Article1", "happy_path", "Mutation1"),
+				in:  dto.NewUpdateArticleBodyInDTO("Article1", "happy_path", "Mutation1", ""),
 			},
 			want: want{
 				out: dto.UpdateArticleBodyOutDTO{},
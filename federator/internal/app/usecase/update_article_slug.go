@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"blogapi.miyamo.today/core/log"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"log/slog"
+
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// UpdateArticleSlug is a use-case for updating an article slug.
+type UpdateArticleSlug struct {
+	// bloggingEventServiceClient is a client of article service.
+	bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient
+}
+
+// Execute updates an article slug.
+func (u *UpdateArticleSlug) Execute(ctx context.Context, in dto.UpdateArticleSlugInDTO) (dto.UpdateArticleSlugOutDTO, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("UpdateArticleSlug#Execute").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("in", in)))
+
+	response, err := u.bloggingEventServiceClient.UpdateArticleSlug(
+		newrelic.NewContext(ctx, nrtx),
+		withActor(ctx, withIdempotencyKey(ctx, connect.NewRequest(&grpc.UpdateArticleSlugRequest{
+			Id:                  in.ID(),
+			Slug:                in.Slug(),
+			ExpectedLastEventId: utils.PtrFromString(in.ExpectedLastEventID()),
+		}), in.ClientMutationID())))
+	if err != nil {
+		err = errors.WithStack(err)
+		logger.WarnContext(ctx, "END",
+			slog.Group("return",
+				slog.Any("*dto.UpdateArticleSlugOutDTO", nil),
+				slog.Any("error", err)))
+		return dto.UpdateArticleSlugOutDTO{}, err
+	}
+
+	message := response.Msg
+	out := dto.NewUpdateArticleSlugOutDTO(message.EventId, message.ArticleId, in.ClientMutationID())
+	logger.InfoContext(ctx, "END",
+		slog.Group("return",
+			slog.Any("*dto.UpdateArticleSlugOutDTO", out),
+			slog.Any("error", nil)))
+	return out, nil
+}
+
+// NewUpdateArticleSlug is a constructor of UpdateArticleSlug.
+func NewUpdateArticleSlug(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *UpdateArticleSlug {
+	return &UpdateArticleSlug{
+		bloggingEventServiceClient: bloggingEventServiceClient,
+	}
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	blogapictx "blogapi.miyamo.today/core/context"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
+	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	mbloggingeventconnect "blogapi.miyamo.today/federator/internal/mock/infra/grpc/blogging_event/blogging_eventconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"connectrpc.com/connect"
+	"context"
+	"fmt"
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/testing/protocmp"
+	"testing"
+)
+
+func TestUpdateArticleSlug_Execute(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		in  dto.UpdateArticleSlugInDTO
+	}
+	type want struct {
+		out dto.UpdateArticleSlugOutDTO
+		err error
+	}
+	type testCase struct {
+		bloggingEventServiceClient func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.UpdateArticleSlugRequest]) blogging_eventconnect.BloggingEventServiceClient
+		args                       args
+		expectedReq                *connect.Request[grpc.UpdateArticleSlugRequest]
+		want                       want
+	}
+	errTestUpdateArticleSlug := errors.New("test error")
+	mockBlogAPIContext := func() context.Context {
+		return blogapictx.StoreToContext(
+			context.Background(),
+			blogapictx.New(
+				"1234567890",
+				"0987654321",
+				blogapictx.RequestTypeGRPC,
+				nil,
+				nil))
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.UpdateArticleSlugRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					UpdateArticleSlug(gomock.Any(), NewUpdateArticleSlugRequestMatcher(t, req)).
+					Return(connect.NewResponse(&grpc.BloggingEventResponse{EventId: "Event1", ArticleId: "Article1"}), nil).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.UpdateArticleSlugRequest{
+				Id:                  "Article1",
+				Slug:                "hello-world",
+				ExpectedLastEventId: utils.PtrFromString("Event0"),
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewUpdateArticleSlugInDTO("Article1", "hello-world", "Mutation1", "Event0"),
+			},
+			want: want{
+				out: dto.NewUpdateArticleSlugOutDTO("Event1", "Article1", "Mutation1"),
+			},
+		},
+		"unhappy_path:grpc-return-error": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.UpdateArticleSlugRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					UpdateArticleSlug(gomock.Any(), NewUpdateArticleSlugRequestMatcher(t, req)).
+					Return(nil, errTestUpdateArticleSlug).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.UpdateArticleSlugRequest{
+				Id:   "Article1",
+				Slug: "hello-world",
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewUpdateArticleSlugInDTO("Article1", "hello-world", "Mutation1", ""),
+			},
+			want: want{
+				out: dto.UpdateArticleSlugOutDTO{},
+				err: errTestUpdateArticleSlug,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			bloggingEventServiceClient := tt.bloggingEventServiceClient(t, ctrl, tt.expectedReq)
+			u := NewUpdateArticleSlug(bloggingEventServiceClient)
+			got, err := u.Execute(tt.args.ctx, tt.args.in)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Execute() error = %v, wantErr %v", err, tt.want.err)
+			}
+			if diff := cmp.Diff(tt.want.out, got, cmp.AllowUnexported(dto.UpdateArticleSlugOutDTO{})); diff != "" {
+				t.Errorf("Execute() result mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func NewUpdateArticleSlugRequestMatcher(t *testing.T, expect *connect.Request[grpc.UpdateArticleSlugRequest]) gomock.Matcher {
+	return &UpdateArticleSlugRequestMatcher{
+		expect: expect,
+		t:      t,
+	}
+}
+
+type UpdateArticleSlugRequestMatcher struct {
+	gomock.Matcher
+	expect *connect.Request[grpc.UpdateArticleSlugRequest]
+	t      *testing.T
+}
+
+func (m *UpdateArticleSlugRequestMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case *connect.Request[grpc.UpdateArticleSlugRequest]:
+		if x == nil {
+			return m.expect == nil
+		}
+		diff := cmp.Diff(x.Msg, m.expect.Msg, protocmp.Transform())
+		if diff != "" {
+			m.t.Errorf("UpdateArticleSlugRequest mismatch (-want +got):\n%s", diff)
+			return false
+		}
+		return true
+	}
+	return false
+}
+func (m *UpdateArticleSlugRequestMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
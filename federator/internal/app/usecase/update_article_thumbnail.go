@@ -5,6 +5,7 @@ import (
 	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
 	grpc "blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event"
 	"blogapi.miyamo.today/federator/internal/infra/grpc/blogging_event/blogging_eventconnect"
+	"blogapi.miyamo.today/federator/internal/utils"
 	"connectrpc.com/connect"
 	"context"
 	"github.com/cockroachdb/errors"
@@ -37,10 +38,12 @@ func (u *UpdateArticleThumbnail) Execute(ctx context.Context, in dto.UpdateArtic
 	thumbnail := in.Thumbnail()
 	response, err := u.bloggingEventServiceClient.UpdateArticleThumbnail(
 		newrelic.NewContext(ctx, nrtx),
-		connect.NewRequest(&grpc.UpdateArticleThumbnailRequest{
-			Id:           in.ID(),
-			ThumbnailUrl: thumbnail.String(),
-		}))
+		withActor(ctx, withIdempotencyKey(ctx, connect.NewRequest(&grpc.UpdateArticleThumbnailRequest{
+			Id:                  in.ID(),
+			ThumbnailUrl:        thumbnail.String(),
+			ThumbnailVariants:   imageVariantPBs(in.ThumbnailVariants()),
+			ExpectedLastEventId: utils.PtrFromString(in.ExpectedLastEventID()),
+		}), in.ClientMutationID())))
 	if err != nil {
 		err = errors.WithStack(err)
 		logger.WarnContext(ctx, "END",
@@ -59,6 +62,24 @@ func (u *UpdateArticleThumbnail) Execute(ctx context.Context, in dto.UpdateArtic
 	return out, nil
 }
 
+// imageVariantPBs converts dto.ImageVariant to grpc.ImageVariant.
+func imageVariantPBs(from []dto.ImageVariant) []*grpc.ImageVariant {
+	if len(from) == 0 {
+		return nil
+	}
+	variants := make([]*grpc.ImageVariant, 0, len(from))
+	for _, v := range from {
+		variantURL := v.URL()
+		variants = append(variants, &grpc.ImageVariant{
+			Url:         variantURL.String(),
+			Width:       int32(v.Width()),
+			Height:      int32(v.Height()),
+			ContentType: v.ContentType(),
+		})
+	}
+	return variants
+}
+
 // NewUpdateArticleThumbnail is a constructor of UpdateArticleThumbnail.
 func NewUpdateArticleThumbnail(bloggingEventServiceClient blogging_eventconnect.BloggingEventServiceClient) *UpdateArticleThumbnail {
 	return &UpdateArticleThumbnail{
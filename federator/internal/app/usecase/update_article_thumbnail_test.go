@@ -45,6 +45,27 @@ func TestUpdateArticleThumbnail_Execute(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"happy_path": {
+			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.UpdateArticleThumbnailRequest]) blogging_eventconnect.BloggingEventServiceClient {
+				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
+				bloggingEventServiceClient.EXPECT().
+					UpdateArticleThumbnail(gomock.Any(), NewUpdateArticleThumbnailRequestMatcher(t, req)).
+					Return(connect.NewResponse(&grpc.BloggingEventResponse{EventId: "Event1", ArticleId: "Article1"}), nil).Times(1)
+				return bloggingEventServiceClient
+			},
+			expectedReq: connect.NewRequest(&grpc.UpdateArticleThumbnailRequest{
+				Id:                  "Article1",
+				ThumbnailUrl:        "https://example.com/example.png",
+				ExpectedLastEventId: utils.PtrFromString("Event0"),
+			}),
+			args: args{
+				ctx: mockBlogAPIContext(),
+				in:  dto.NewUpdateArticleThumbnailInDTO("Article1", utils.MustURLParse("https://example.com/example.png"), nil, "Mutation1", "Event0"),
+			},
+			want: want{
+				out: dto.NewUpdateArticleThumbnailOutDTO("Event1", "Article1", "Mutation1"),
+			},
+		},
+		"happy_path:thumbnail-variants": {
 			bloggingEventServiceClient: func(t *testing.T, ctrl *gomock.Controller, req *connect.Request[grpc.UpdateArticleThumbnailRequest]) blogging_eventconnect.BloggingEventServiceClient {
 				bloggingEventServiceClient := mbloggingeventconnect.NewMockBloggingEventServiceClient(ctrl)
 				bloggingEventServiceClient.EXPECT().
@@ -55,10 +76,18 @@ func TestUpdateArticleThumbnail_Execute(t *testing.T) {
 			expectedReq: connect.NewRequest(&grpc.UpdateArticleThumbnailRequest{
 				Id:           "Article1",
 				ThumbnailUrl: "https://example.com/example.png",
+				ThumbnailVariants: []*grpc.ImageVariant{
+					{Url: "https://example.com/example-640w.png", Width: 640, Height: 480, ContentType: "image/png"},
+				},
 			}),
 			args: args{
 				ctx: mockBlogAPIContext(),
-				in:  dto.NewUpdateArticleThumbnailInDTO("Article1", utils.MustURLParse("https://example.com/example.png"), "Mutation1"),
+				in: dto.NewUpdateArticleThumbnailInDTO(
+					"Article1",
+					utils.MustURLParse("https://example.com/example.png"),
+					[]dto.ImageVariant{dto.NewImageVariant(utils.MustURLParse("https://example.com/example-640w.png"), 640, 480, "image/png")},
+					"Mutation1",
+					""),
 			},
 			want: want{
 				out: dto.NewUpdateArticleThumbnailOutDTO("Event1", "Article1", "Mutation1"),
@@ -78,7 +107,7 @@ func TestUpdateArticleThumbnail_Execute(t *testing.T) {
 			}),
 			args: args{
 				ctx: mockBlogAPIContext(),
-				in:  dto.NewUpdateArticleThumbnailInDTO("Article1", utils.MustURLParse("https://example.com/example.png"), "Mutation1"),
+				in:  dto.NewUpdateArticleThumbnailInDTO("Article1", utils.MustURLParse("https://example.com/example.png"), nil, "Mutation1", ""),
 			},
 			want: want{
 				out: dto.UpdateArticleThumbnailOutDTO{},
@@ -36,42 +36,47 @@ func (u *UploadImage) Execute(ctx context.Context, in dto.UploadImageInDTO) (dto
 	logger.InfoContext(ctx, "BEGIN",
 		slog.Group("parameters", slog.Any("in", in)))
 
+	// the stream is canceled if reading the upload fails, so that the server does not take a partial image.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 	stream := u.bloggingEventServiceClient.UploadImage(ctx)
-	stream.Send(&grpc.UploadImageRequest{
+	err = stream.Send(&grpc.UploadImageRequest{
 		Value: &grpc.UploadImageRequest_Meta{
 			Meta: &grpc.Meta{
-				Name:        in.Filename(),
-				ContentType: in.ContentType(),
+				Name:         in.Filename(),
+				ContentType:  in.ContentType(),
+				KeepMetadata: in.KeepMetadata(),
 			},
 		},
 	})
-
-	data, err := io.ReadAll(in.Data())
-	if err != nil {
-		err = errors.WithStack(err)
-		logger.WarnContext(ctx, "END",
-			slog.Group("return",
-				slog.Any("*dto.UploadImageOutDTO", nil),
-				slog.Any("error", err)))
-		return dto.UploadImageOutDTO{}, err
-	}
-	var read int
-	reqOngoing := true
-	for reqOngoing {
-		end := read + chunkSize
-		if end > len(data) {
-			end = len(data)
-			reqOngoing = false
+	// the upload is sent a chunk at a time as it is read, so that it is never held in memory as a whole.
+	chunk := make([]byte, chunkSize)
+	for err == nil {
+		n, readErr := io.ReadFull(in.Data(), chunk)
+		if n > 0 {
+			if err := stream.Send(&grpc.UploadImageRequest{
+				Value: &grpc.UploadImageRequest_Data{
+					Data: chunk[:n],
+				},
+			}); err != nil {
+				// the server rejected the image before receiving all of it, e.g. it is too large.
+				// CloseAndReceive returns the error of the server.
+				break
+			}
 		}
-		err := stream.Send(&grpc.UploadImageRequest{
-			Value: &grpc.UploadImageRequest_Data{
-				Data: data[read:end],
-			},
-		})
-		if err != nil {
+		if errors.Is(readErr, io.EOF) || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			cancel()
+			stream.CloseAndReceive()
+			err = errors.WithStack(readErr)
+			logger.WarnContext(ctx, "END",
+				slog.Group("return",
+					slog.Any("*dto.UploadImageOutDTO", nil),
+					slog.Any("error", err)))
 			return dto.UploadImageOutDTO{}, err
 		}
-		read += chunkSize
 	}
 
 	response, err := stream.CloseAndReceive()
@@ -91,7 +96,16 @@ func (u *UploadImage) Execute(ctx context.Context, in dto.UploadImageInDTO) (dto
 		return dto.UploadImageOutDTO{}, errors.WithStack(err)
 	}
 
-	out := dto.NewUploadImageOutDTO(*uri, in.ClientMutationID())
+	variants := make([]dto.ImageVariant, 0, len(message.Variants))
+	for _, v := range message.Variants {
+		variantURI, err := url.Parse(v.Url)
+		if err != nil {
+			return dto.UploadImageOutDTO{}, errors.WithStack(err)
+		}
+		variants = append(variants, dto.NewImageVariant(*variantURI, int(v.Width), int(v.Height), v.ContentType))
+	}
+
+	out := dto.NewUploadImageOutDTO(*uri, variants, message.Deduplicated, in.ClientMutationID())
 	logger.InfoContext(ctx, "END",
 		slog.Group("return",
 			slog.Any("*dto.UploadImageOutDTO", out),
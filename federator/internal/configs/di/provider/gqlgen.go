@@ -14,66 +14,139 @@ import (
 	"github.com/google/wire"
 	"github.com/newrelic/go-agent/v3/newrelic"
 	"github.com/vektah/gqlparser/v2/ast"
+	"os"
+	"strconv"
 	"time"
 )
 
 func Usecases(
 	article usecase.Article,
 	articles usecase.Articles,
+	series usecase.Series,
 	tag usecase.Tag,
 	tags usecase.Tags,
 	createArticle usecase.CreateArticle,
 	updateArticleTiele usecase.UpdateArticleTitle,
+	updateArticleSlug usecase.UpdateArticleSlug,
 	updateArticleBody usecase.UpdateArticleBody,
 	updateArticleThumbnail usecase.UpdateArticleThumbnail,
 	attachTags usecase.AttachTags,
 	detachTags usecase.DetachTags,
+	hideArticle usecase.HideArticle,
+	unhideArticle usecase.UnhideArticle,
+	editArticle usecase.EditArticle,
+	scheduleArticle usecase.ScheduleArticle,
+	publishArticle usecase.PublishArticle,
+	revertArticle usecase.RevertArticle,
+	renameTag usecase.RenameTag,
+	mergeTags usecase.MergeTags,
+	createSeries usecase.CreateSeries,
+	addArticleToSeries usecase.AddArticleToSeries,
+	reorderSeries usecase.ReorderSeries,
+	draft usecase.Draft,
+	drafts usecase.Drafts,
+	articleHistory usecase.ArticleHistory,
+	articleAt usecase.ArticleAt,
 	uploadImage usecase.UploadImage,
 ) *resolver.Usecases {
 	return resolver.NewUsecases(
 		resolver.WithArticlesUsecase(articles),
 		resolver.WithArticleUsecase(article),
+		resolver.WithSeriesUsecase(series),
 		resolver.WithTagUsecase(tag),
 		resolver.WithTagsUsecase(tags),
 		resolver.WithCreateArticleUsecase(createArticle),
 		resolver.WithUpdateArticleTitleUsecase(updateArticleTiele),
+		resolver.WithUpdateArticleSlugUsecase(updateArticleSlug),
 		resolver.WithUpdateArticleBodyUsecase(updateArticleBody),
 		resolver.WithUpdateArticleThumbnailUsecase(updateArticleThumbnail),
 		resolver.WithAttachTagsUsecase(attachTags),
 		resolver.WithDetachTagsUsecase(detachTags),
+		resolver.WithHideArticleUsecase(hideArticle),
+		resolver.WithUnhideArticleUsecase(unhideArticle),
+		resolver.WithEditArticleUsecase(editArticle),
+		resolver.WithScheduleArticleUsecase(scheduleArticle),
+		resolver.WithPublishArticleUsecase(publishArticle),
+		resolver.WithRevertArticleUsecase(revertArticle),
+		resolver.WithRenameTagUsecase(renameTag),
+		resolver.WithMergeTagsUsecase(mergeTags),
+		resolver.WithCreateSeriesUsecase(createSeries),
+		resolver.WithAddArticleToSeriesUsecase(addArticleToSeries),
+		resolver.WithReorderSeriesUsecase(reorderSeries),
+		resolver.WithDraftUsecase(draft),
+		resolver.WithDraftsUsecase(drafts),
+		resolver.WithArticleHistoryUsecase(articleHistory),
+		resolver.WithArticleAtUsecase(articleAt),
 		resolver.WithUploadImageUsecase(uploadImage))
 }
 
 func Converters(
 	article converters.ArticleConverter,
 	articles converters.ArticlesConverter,
+	series converters.SeriesConverter,
 	tag converters.TagConverter,
 	tags converters.TagsConverter,
 	createArticle converters.CreateArticleConverter,
 	updateArticleTitle converters.UpdateArticleTitleConverter,
+	updateArticleSlug converters.UpdateArticleSlugConverter,
 	updateArticleBodyConverter converters.UpdateArticleBodyConverter,
 	updateArticleThumbnail converters.UpdateArticleThumbnailConverter,
 	attachTags converters.AttachTagsConverter,
 	detachTags converters.DetachTagsConverter,
+	hideArticle converters.HideArticleConverter,
+	unhideArticle converters.UnhideArticleConverter,
+	editArticle converters.EditArticleConverter,
+	scheduleArticle converters.ScheduleArticleConverter,
+	publishArticle converters.PublishArticleConverter,
+	revertArticle converters.RevertArticleConverter,
+	renameTag converters.RenameTagConverter,
+	mergeTags converters.MergeTagsConverter,
+	createSeries converters.CreateSeriesConverter,
+	addArticleToSeries converters.AddArticleToSeriesConverter,
+	reorderSeries converters.ReorderSeriesConverter,
+	draft converters.DraftConverter,
+	drafts converters.DraftsConverter,
+	articleHistory converters.ArticleHistoryConverter,
+	articleAt converters.ArticleAtConverter,
 	uploadImage converters.UploadImageConverter,
 ) *resolver.Converters {
 	return resolver.NewConverters(
 		resolver.WithArticleConverter(article),
 		resolver.WithArticlesConverter(articles),
+		resolver.WithSeriesConverter(series),
 		resolver.WithTagConverter(tag),
 		resolver.WithTagsConverter(tags),
 		resolver.WithCreateArticleConverter(createArticle),
 		resolver.WithUpdateArticleTitleConverter(updateArticleTitle),
+		resolver.WithUpdateArticleSlugConverter(updateArticleSlug),
 		resolver.WithUpdateArticleBodyConverter(updateArticleBodyConverter),
 		resolver.WithUpdateArticleThumbnailConverter(updateArticleThumbnail),
 		resolver.WithAttachTagsConverter(attachTags),
 		resolver.WithDetachTagsConverter(detachTags),
+		resolver.WithHideArticleConverter(hideArticle),
+		resolver.WithUnhideArticleConverter(unhideArticle),
+		resolver.WithEditArticleConverter(editArticle),
+		resolver.WithScheduleArticleConverter(scheduleArticle),
+		resolver.WithPublishArticleConverter(publishArticle),
+		resolver.WithRevertArticleConverter(revertArticle),
+		resolver.WithRenameTagConverter(renameTag),
+		resolver.WithMergeTagsConverter(mergeTags),
+		resolver.WithCreateSeriesConverter(createSeries),
+		resolver.WithAddArticleToSeriesConverter(addArticleToSeries),
+		resolver.WithReorderSeriesConverter(reorderSeries),
+		resolver.WithDraftConverter(draft),
+		resolver.WithDraftsConverter(drafts),
+		resolver.WithArticleHistoryConverter(articleHistory),
+		resolver.WithArticleAtConverter(articleAt),
 		resolver.WithUploadImageConverter(uploadImage))
 }
 
-func GqlgenConfig(resolver *resolver.Resolver) *gqlgen.Config {
+func GqlgenConfig(r *resolver.Resolver) *gqlgen.Config {
 	return &gqlgen.Config{
-		Resolvers: resolver,
+		Resolvers: r,
+		Directives: gqlgen.DirectiveRoot{
+			IsAuthenticated: resolver.IsAuthenticated,
+		},
 	}
 }
 
@@ -95,8 +168,8 @@ func GqlgenServer(schema *graphql.ExecutableSchema, nr *newrelic.Application) *h
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
 	srv.AddTransport(transport.MultipartForm{
-		MaxUploadSize: 3 << 30,
-		MaxMemory:     3 << 30,
+		MaxUploadSize: maxUploadSize(),
+		MaxMemory:     maxUploadMemory,
 	})
 
 	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
@@ -112,6 +185,23 @@ func GqlgenServer(schema *graphql.ExecutableSchema, nr *newrelic.Application) *h
 	return srv
 }
 
+// maxUploadMemory is the size of the uploads kept in memory. Larger ones are spooled to temporary files.
+const maxUploadMemory = 1 << 20
+
+// maxUploadSize returns MAX_UPLOAD_SIZE, the maximum size of a multipart request in bytes, or 32 MiB if it is not set.
+// The blogging-event-service enforces its own, usually smaller, limit on the images.
+func maxUploadSize() int64 {
+	v := os.Getenv("MAX_UPLOAD_SIZE")
+	if v == "" {
+		return 32 << 20
+	}
+	size, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		panic(err)
+	}
+	return size
+}
+
 var GqlgenSet = wire.NewSet(
 	Usecases,
 	Converters,
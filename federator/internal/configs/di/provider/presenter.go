@@ -10,14 +10,31 @@ import (
 var (
 	_ abstract.ArticleConverter                = (*converters.Converter)(nil)
 	_ abstract.ArticlesConverter               = (*converters.Converter)(nil)
+	_ abstract.SeriesConverter                 = (*converters.Converter)(nil)
 	_ abstract.TagConverter                    = (*converters.Converter)(nil)
 	_ abstract.TagsConverter                   = (*converters.Converter)(nil)
 	_ abstract.CreateArticleConverter          = (*converters.Converter)(nil)
 	_ abstract.UpdateArticleTitleConverter     = (*converters.Converter)(nil)
+	_ abstract.UpdateArticleSlugConverter      = (*converters.Converter)(nil)
 	_ abstract.UpdateArticleBodyConverter      = (*converters.Converter)(nil)
 	_ abstract.UpdateArticleThumbnailConverter = (*converters.Converter)(nil)
 	_ abstract.AttachTagsConverter             = (*converters.Converter)(nil)
 	_ abstract.DetachTagsConverter             = (*converters.Converter)(nil)
+	_ abstract.HideArticleConverter            = (*converters.Converter)(nil)
+	_ abstract.UnhideArticleConverter          = (*converters.Converter)(nil)
+	_ abstract.EditArticleConverter            = (*converters.Converter)(nil)
+	_ abstract.ScheduleArticleConverter        = (*converters.Converter)(nil)
+	_ abstract.PublishArticleConverter         = (*converters.Converter)(nil)
+	_ abstract.RevertArticleConverter          = (*converters.Converter)(nil)
+	_ abstract.RenameTagConverter              = (*converters.Converter)(nil)
+	_ abstract.MergeTagsConverter              = (*converters.Converter)(nil)
+	_ abstract.CreateSeriesConverter           = (*converters.Converter)(nil)
+	_ abstract.AddArticleToSeriesConverter     = (*converters.Converter)(nil)
+	_ abstract.ReorderSeriesConverter          = (*converters.Converter)(nil)
+	_ abstract.DraftConverter                  = (*converters.Converter)(nil)
+	_ abstract.DraftsConverter                 = (*converters.Converter)(nil)
+	_ abstract.ArticleHistoryConverter         = (*converters.Converter)(nil)
+	_ abstract.ArticleAtConverter              = (*converters.Converter)(nil)
 	_ abstract.UploadImageConverter            = (*converters.Converter)(nil)
 )
 
@@ -25,13 +42,30 @@ var PresenterSet = wire.NewSet(
 	converters.NewConverter,
 	wire.Bind(new(abstract.ArticleConverter), new(*converters.Converter)),
 	wire.Bind(new(abstract.ArticlesConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.SeriesConverter), new(*converters.Converter)),
 	wire.Bind(new(abstract.TagConverter), new(*converters.Converter)),
 	wire.Bind(new(abstract.TagsConverter), new(*converters.Converter)),
 	wire.Bind(new(abstract.CreateArticleConverter), new(*converters.Converter)),
 	wire.Bind(new(abstract.UpdateArticleTitleConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.UpdateArticleSlugConverter), new(*converters.Converter)),
 	wire.Bind(new(abstract.UpdateArticleBodyConverter), new(*converters.Converter)),
 	wire.Bind(new(abstract.UpdateArticleThumbnailConverter), new(*converters.Converter)),
 	wire.Bind(new(abstract.AttachTagsConverter), new(*converters.Converter)),
 	wire.Bind(new(abstract.DetachTagsConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.HideArticleConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.UnhideArticleConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.EditArticleConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.ScheduleArticleConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.PublishArticleConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.RevertArticleConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.RenameTagConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.MergeTagsConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.CreateSeriesConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.AddArticleToSeriesConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.ReorderSeriesConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.DraftConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.DraftsConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.ArticleHistoryConverter), new(*converters.Converter)),
+	wire.Bind(new(abstract.ArticleAtConverter), new(*converters.Converter)),
 	wire.Bind(new(abstract.UploadImageConverter), new(*converters.Converter)),
 )
@@ -10,14 +10,31 @@ import (
 var (
 	_ abstract.Article                = (*usecase.Article)(nil)
 	_ abstract.Articles               = (*usecase.Articles)(nil)
+	_ abstract.Series                 = (*usecase.Series)(nil)
 	_ abstract.Tag                    = (*usecase.Tag)(nil)
 	_ abstract.Tags                   = (*usecase.Tags)(nil)
 	_ abstract.CreateArticle          = (*usecase.CreateArticle)(nil)
 	_ abstract.UpdateArticleTitle     = (*usecase.UpdateArticleTitle)(nil)
+	_ abstract.UpdateArticleSlug      = (*usecase.UpdateArticleSlug)(nil)
 	_ abstract.UpdateArticleBody      = (*usecase.UpdateArticleBody)(nil)
 	_ abstract.UpdateArticleThumbnail = (*usecase.UpdateArticleThumbnail)(nil)
 	_ abstract.AttachTags             = (*usecase.AttachTags)(nil)
 	_ abstract.DetachTags             = (*usecase.DetachTags)(nil)
+	_ abstract.HideArticle            = (*usecase.HideArticle)(nil)
+	_ abstract.UnhideArticle          = (*usecase.UnhideArticle)(nil)
+	_ abstract.EditArticle            = (*usecase.EditArticle)(nil)
+	_ abstract.ScheduleArticle        = (*usecase.ScheduleArticle)(nil)
+	_ abstract.PublishArticle         = (*usecase.PublishArticle)(nil)
+	_ abstract.RevertArticle          = (*usecase.RevertArticle)(nil)
+	_ abstract.RenameTag              = (*usecase.RenameTag)(nil)
+	_ abstract.MergeTags              = (*usecase.MergeTags)(nil)
+	_ abstract.CreateSeries           = (*usecase.CreateSeries)(nil)
+	_ abstract.AddArticleToSeries     = (*usecase.AddArticleToSeries)(nil)
+	_ abstract.ReorderSeries          = (*usecase.ReorderSeries)(nil)
+	_ abstract.Draft                  = (*usecase.Draft)(nil)
+	_ abstract.Drafts                 = (*usecase.Drafts)(nil)
+	_ abstract.ArticleHistory         = (*usecase.ArticleHistory)(nil)
+	_ abstract.ArticleAt              = (*usecase.ArticleAt)(nil)
 	_ abstract.UploadImage            = (*usecase.UploadImage)(nil)
 )
 
@@ -26,6 +43,8 @@ var UsecaseSet = wire.NewSet(
 	wire.Bind(new(abstract.Article), new(*usecase.Article)),
 	usecase.NewArticles,
 	wire.Bind(new(abstract.Articles), new(*usecase.Articles)),
+	usecase.NewSeries,
+	wire.Bind(new(abstract.Series), new(*usecase.Series)),
 	usecase.NewTag,
 	wire.Bind(new(abstract.Tag), new(*usecase.Tag)),
 	usecase.NewTags,
@@ -34,6 +53,8 @@ var UsecaseSet = wire.NewSet(
 	wire.Bind(new(abstract.CreateArticle), new(*usecase.CreateArticle)),
 	usecase.NewUpdateArticleTitle,
 	wire.Bind(new(abstract.UpdateArticleTitle), new(*usecase.UpdateArticleTitle)),
+	usecase.NewUpdateArticleSlug,
+	wire.Bind(new(abstract.UpdateArticleSlug), new(*usecase.UpdateArticleSlug)),
 	usecase.NewUpdateArticleBody,
 	wire.Bind(new(abstract.UpdateArticleBody), new(*usecase.UpdateArticleBody)),
 	usecase.NewUpdateArticleThumbnail,
@@ -42,6 +63,36 @@ var UsecaseSet = wire.NewSet(
 	wire.Bind(new(abstract.AttachTags), new(*usecase.AttachTags)),
 	usecase.NewDetachTags,
 	wire.Bind(new(abstract.DetachTags), new(*usecase.DetachTags)),
+	usecase.NewHideArticle,
+	wire.Bind(new(abstract.HideArticle), new(*usecase.HideArticle)),
+	usecase.NewUnhideArticle,
+	wire.Bind(new(abstract.UnhideArticle), new(*usecase.UnhideArticle)),
+	usecase.NewEditArticle,
+	wire.Bind(new(abstract.EditArticle), new(*usecase.EditArticle)),
+	usecase.NewScheduleArticle,
+	wire.Bind(new(abstract.ScheduleArticle), new(*usecase.ScheduleArticle)),
+	usecase.NewPublishArticle,
+	wire.Bind(new(abstract.PublishArticle), new(*usecase.PublishArticle)),
+	usecase.NewRevertArticle,
+	wire.Bind(new(abstract.RevertArticle), new(*usecase.RevertArticle)),
+	usecase.NewRenameTag,
+	wire.Bind(new(abstract.RenameTag), new(*usecase.RenameTag)),
+	usecase.NewMergeTags,
+	wire.Bind(new(abstract.MergeTags), new(*usecase.MergeTags)),
+	usecase.NewCreateSeries,
+	wire.Bind(new(abstract.CreateSeries), new(*usecase.CreateSeries)),
+	usecase.NewAddArticleToSeries,
+	wire.Bind(new(abstract.AddArticleToSeries), new(*usecase.AddArticleToSeries)),
+	usecase.NewReorderSeries,
+	wire.Bind(new(abstract.ReorderSeries), new(*usecase.ReorderSeries)),
+	usecase.NewDraft,
+	wire.Bind(new(abstract.Draft), new(*usecase.Draft)),
+	usecase.NewDrafts,
+	wire.Bind(new(abstract.Drafts), new(*usecase.Drafts)),
+	usecase.NewArticleHistory,
+	wire.Bind(new(abstract.ArticleHistory), new(*usecase.ArticleHistory)),
+	usecase.NewArticleAt,
+	wire.Bind(new(abstract.ArticleAt), new(*usecase.ArticleAt)),
 	usecase.NewUploadImage,
 	wire.Bind(new(abstract.UploadImage), new(*usecase.UploadImage)),
 )
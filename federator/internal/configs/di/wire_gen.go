@@ -20,20 +20,37 @@ func GetDependencies() *Dependencies {
 	articleServiceClient := provider.ArticleClient(client)
 	article := usecase.NewArticle(articleServiceClient)
 	articles := usecase.NewArticles(articleServiceClient)
+	series := usecase.NewSeries(articleServiceClient)
 	tagServiceClient := provider.TagClient(client)
 	tag := usecase.NewTag(tagServiceClient)
 	tags := usecase.NewTags(tagServiceClient)
 	bloggingEventServiceClient := provider.BloggingEventClient(client)
 	createArticle := usecase.NewCreateArticle(bloggingEventServiceClient)
 	updateArticleTitle := usecase.NewUpdateArticleTitle(bloggingEventServiceClient)
+	updateArticleSlug := usecase.NewUpdateArticleSlug(bloggingEventServiceClient)
 	updateArticleBody := usecase.NewUpdateArticleBody(bloggingEventServiceClient)
 	updateArticleThumbnail := usecase.NewUpdateArticleThumbnail(bloggingEventServiceClient)
 	attachTags := usecase.NewAttachTags(bloggingEventServiceClient)
 	detachTags := usecase.NewDetachTags(bloggingEventServiceClient)
+	hideArticle := usecase.NewHideArticle(bloggingEventServiceClient)
+	unhideArticle := usecase.NewUnhideArticle(bloggingEventServiceClient)
+	editArticle := usecase.NewEditArticle(bloggingEventServiceClient)
+	scheduleArticle := usecase.NewScheduleArticle(bloggingEventServiceClient)
+	publishArticle := usecase.NewPublishArticle(bloggingEventServiceClient)
+	revertArticle := usecase.NewRevertArticle(bloggingEventServiceClient)
+	renameTag := usecase.NewRenameTag(bloggingEventServiceClient)
+	mergeTags := usecase.NewMergeTags(bloggingEventServiceClient)
+	createSeries := usecase.NewCreateSeries(bloggingEventServiceClient)
+	addArticleToSeries := usecase.NewAddArticleToSeries(bloggingEventServiceClient)
+	reorderSeries := usecase.NewReorderSeries(bloggingEventServiceClient)
+	draft := usecase.NewDraft(bloggingEventServiceClient)
+	drafts := usecase.NewDrafts(bloggingEventServiceClient)
+	articleHistory := usecase.NewArticleHistory(bloggingEventServiceClient)
+	articleAt := usecase.NewArticleAt(bloggingEventServiceClient)
 	uploadImage := usecase.NewUploadImage(bloggingEventServiceClient)
-	usecases := provider.Usecases(article, articles, tag, tags, createArticle, updateArticleTitle, updateArticleBody, updateArticleThumbnail, attachTags, detachTags, uploadImage)
+	usecases := provider.Usecases(article, articles, series, tag, tags, createArticle, updateArticleTitle, updateArticleSlug, updateArticleBody, updateArticleThumbnail, attachTags, detachTags, hideArticle, unhideArticle, editArticle, scheduleArticle, publishArticle, revertArticle, renameTag, mergeTags, createSeries, addArticleToSeries, reorderSeries, draft, drafts, articleHistory, articleAt, uploadImage)
 	converter := converters.NewConverter()
-	resolverConverters := provider.Converters(converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter)
+	resolverConverters := provider.Converters(converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter, converter)
 	resolverResolver := resolver.NewResolver(usecases, resolverConverters)
 	config := provider.GqlgenConfig(resolverResolver)
 	executableSchema := provider.GqlgenExecutableSchema(config)
@@ -0,0 +1,64 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.70
+
+import (
+	"context"
+	"log/slog"
+
+	"blogapi.miyamo.today/core/log"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"blogapi.miyamo.today/federator/internal/if-adapter/presenters/graphql/model"
+	"blogapi.miyamo.today/federator/internal/infra/fw/gqlgen"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// Series is the resolver for the series field.
+func (r *articleNodeResolver) Series(ctx context.Context, obj *model.ArticleNode) (*model.ArticleSeries, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Series").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters",
+			slog.String("id", obj.ID)))
+	oDTO, err := r.usecases.series.Execute(ctx, dto.NewSeriesByArticleInDTO(obj.ID))
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("*model.ArticleSeries", nil),
+				slog.Any("error", err)))
+		return nil, err
+	}
+	series, ok := r.converters.series.ToSeries(ctx, oDTO)
+	if !ok {
+		err := ErrFailedToConvertToArticleSeries
+		logger.InfoContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("*model.ArticleSeries", nil),
+				slog.Any("error", err)))
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, err
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.ArticleSeries", series),
+			slog.Any("error", nil)))
+	return series, nil
+}
+
+// ArticleNode returns gqlgen.ArticleNodeResolver implementation.
+func (r *Resolver) ArticleNode() gqlgen.ArticleNodeResolver { return &articleNodeResolver{r} }
+
+type articleNodeResolver struct{ *Resolver }
@@ -2,7 +2,7 @@ package resolver
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.60
+// Code generated by github.com/99designs/gqlgen version v0.17.70
 
 import (
 	"context"
@@ -84,7 +84,7 @@ func (r *queryResolver) Articles(ctx context.Context, first *int, last *int, aft
 }
 
 // Article is the resolver for the article field.
-func (r *queryResolver) Article(ctx context.Context, id string) (*model.ArticleNode, error) {
+func (r *queryResolver) Article(ctx context.Context, id *string, slug *string) (*model.ArticleNode, error) {
 	nrtx := newrelic.FromContext(ctx)
 	defer nrtx.StartSegment("Article").End()
 
@@ -95,8 +95,24 @@ func (r *queryResolver) Article(ctx context.Context, id string) (*model.ArticleN
 		logger = log.DefaultLogger()
 	}
 	logger.InfoContext(ctx, "BEGIN",
-		slog.Group("parameters", slog.String("id", id)))
-	oDTO, err := r.usecases.article.Execute(ctx, dto.NewArticleInDTO(id))
+		slog.Group("parameters",
+			slog.Any("id", id),
+			slog.Any("slug", slug)))
+	var inDTO dto.ArticleInDTO
+	switch {
+	case id != nil && slug == nil:
+		inDTO = dto.NewArticleInDTO(*id)
+	case id == nil && slug != nil:
+		inDTO = dto.NewArticleBySlugInDTO(*slug)
+	default:
+		err := newGraphQLError(ctx, ErrArticleIDOrSlugRequired, ErrorCodeBadUserInput, ErrArticleIDOrSlugRequired.Error())
+		logger.InfoContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("*model.ArticleNode", nil),
+				slog.Any("error", err)))
+		return nil, err
+	}
+	oDTO, err := r.usecases.article.Execute(ctx, inDTO)
 	if err != nil {
 		err = ErrorWithStack(err)
 		nrtx.NoticeError(nrpkgerrors.Wrap(err))
@@ -122,3 +138,44 @@ func (r *queryResolver) Article(ctx context.Context, id string) (*model.ArticleN
 			slog.Any("error", nil)))
 	return node, nil
 }
+
+// Series is the resolver for the series field.
+func (r *queryResolver) Series(ctx context.Context, id string) (*model.ArticleSeries, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Series").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters",
+			slog.String("id", id)))
+	oDTO, err := r.usecases.series.Execute(ctx, dto.NewSeriesInDTO(id))
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("*model.ArticleSeries", nil),
+				slog.Any("error", err)))
+		return nil, err
+	}
+	series, ok := r.converters.series.ToSeries(ctx, oDTO)
+	if !ok {
+		err := ErrFailedToConvertToArticleSeries
+		logger.InfoContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("*model.ArticleSeries", nil),
+				slog.Any("error", err)))
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, err
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.ArticleSeries", series),
+			slog.Any("error", nil)))
+	return series, nil
+}
@@ -24,8 +24,9 @@ var cmpOpts = []cmp.Option{
 
 func Test_queryResolver_Article(t *testing.T) {
 	type args struct {
-		ctx context.Context
-		id  string
+		ctx  context.Context
+		id   *string
+		slug *string
 	}
 	type want struct {
 		out *model.ArticleNode
@@ -68,11 +69,15 @@ func Test_queryResolver_Article(t *testing.T) {
 						"Article1",
 						"## Article1",
 						utils.MustURLParse("example.com/example.png"),
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						[]dto.Tag{
 							dto.NewTag("Tag1", "Tag1"),
-						})),
+						},
+						"",
+						"",
+						"")),
 				err: nil,
 			},
 			setupMockConverter: func(converter *mconverter.MockArticleConverter, from dto.ArticleOutDTO, converterResult converterResult) {
@@ -94,7 +99,67 @@ func Test_queryResolver_Article(t *testing.T) {
 			},
 			args: args{
 				ctx: context.Background(),
-				id:  "Article1",
+				id:  utils.PtrFromString("Article1"),
+			},
+			want: want{
+				out: &model.ArticleNode{
+					ID:           "Article1",
+					Title:        "Article1",
+					Content:      "## Article1",
+					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+				},
+				err: nil,
+			},
+		},
+		"happy_path/slug": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockArticle, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), dto.NewArticleBySlugInDTO("hello-world")).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewArticleOutDTO(
+					dto.NewArticleTag(
+						"Article1",
+						"Article1",
+						"## Article1",
+						utils.MustURLParse("example.com/example.png"),
+						nil,
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						[]dto.Tag{},
+						"hello-world",
+						"",
+						"")),
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockArticleConverter, from dto.ArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToArticle(gomock.Any(), from).
+					Return(converterResult.out, converterResult.ok).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.ArticleNode{
+					ID:           "Article1",
+					Title:        "Article1",
+					Content:      "## Article1",
+					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					Slug:         utils.PtrFromString("hello-world"),
+				},
+				ok: true,
+			},
+			args: args{
+				ctx:  context.Background(),
+				slug: utils.PtrFromString("hello-world"),
 			},
 			want: want{
 				out: &model.ArticleNode{
@@ -104,10 +169,59 @@ func Test_queryResolver_Article(t *testing.T) {
 					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
 					CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 					UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					Slug:         utils.PtrFromString("hello-world"),
 				},
 				err: nil,
 			},
 		},
+		"unhappy_path/neither_id_nor_slug": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockArticle, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupMockConverter: func(converter *mconverter.MockArticleConverter, from dto.ArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToArticle(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				out: nil,
+				err: ErrArticleIDOrSlugRequired,
+			},
+			wantErr: true,
+		},
+		"unhappy_path/both_id_and_slug": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockArticle, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			setupMockConverter: func(converter *mconverter.MockArticleConverter, from dto.ArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToArticle(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   utils.PtrFromString("Article1"),
+				slug: utils.PtrFromString("hello-world"),
+			},
+			want: want{
+				out: nil,
+				err: ErrArticleIDOrSlugRequired,
+			},
+			wantErr: true,
+		},
 		"unhappy_path/usecase_returned_error": {
 			sut: func(resolver *Resolver) *queryResolver {
 				return &queryResolver{resolver}
@@ -128,7 +242,7 @@ func Test_queryResolver_Article(t *testing.T) {
 			},
 			args: args{
 				ctx: context.Background(),
-				id:  "Article1",
+				id:  utils.PtrFromString("Article1"),
 			},
 			want: want{
 				out: nil,
@@ -153,9 +267,13 @@ func Test_queryResolver_Article(t *testing.T) {
 						"Article1",
 						"## Article1",
 						utils.MustURLParse("example.com/example.png"),
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						[]dto.Tag{},
+						"",
+						"",
+						"",
 					),
 				),
 				err: nil,
@@ -172,7 +290,7 @@ func Test_queryResolver_Article(t *testing.T) {
 			},
 			args: args{
 				ctx: context.Background(),
-				id:  "Article1",
+				id:  utils.PtrFromString("Article1"),
 			},
 			want: want{
 				out: nil,
@@ -190,7 +308,7 @@ func Test_queryResolver_Article(t *testing.T) {
 			converter := mconverter.NewMockArticleConverter(ctrl)
 			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
 			sut := tt.sut(NewResolver(NewUsecases(WithArticleUsecase(uc)), NewConverters(WithArticleConverter(converter))))
-			got, err := sut.Article(tt.args.ctx, tt.args.id)
+			got, err := sut.Article(tt.args.ctx, tt.args.id, tt.args.slug)
 			if !errors.Is(err, tt.want.err) {
 				t.Errorf("Article() got = %v, want %v", err, tt.want.err)
 				return
@@ -252,11 +370,15 @@ func Test_queryResolver_Articles(t *testing.T) {
 						"Article1",
 						"## Article1",
 						utils.MustURLParse("example.com/example.png"),
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						[]dto.Tag{
 							dto.NewTag("Tag1", "Tag1"),
-						}),
+						},
+						"",
+						"",
+						""),
 				}),
 				err: nil,
 			},
@@ -661,3 +783,185 @@ func Test_queryResolver_Articles(t *testing.T) {
 		})
 	}
 }
+
+func Test_queryResolver_Series(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type want struct {
+		out *model.ArticleSeries
+		err error
+	}
+	type usecaseResult struct {
+		out dto.SeriesOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.ArticleSeries
+		ok  bool
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *queryResolver
+		setupMockUsecase   func(uc *musecase.MockSeries, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockSeriesConverter, from dto.SeriesOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errFailedToUsecase := errors.New("failed to usecase")
+	seriesOutDTO := dto.NewSeriesOutDTO(
+		"Series1",
+		"Go Tutorial",
+		[]dto.ArticleTag{
+			dto.NewArticleTag(
+				"Article1",
+				"Article1",
+				"## Article1",
+				utils.MustURLParse("example.com/example.png"),
+				nil,
+				synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+				synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+				[]dto.Tag{},
+				"",
+				"",
+				"",
+			),
+		})
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockSeries, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), dto.NewSeriesInDTO("Series1")).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: seriesOutDTO,
+			},
+			setupMockConverter: func(converter *mconverter.MockSeriesConverter, from dto.SeriesOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToSeries(gomock.Any(), gomock.Any()).
+					Return(converterResult.out, converterResult.ok).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.ArticleSeries{
+					ID:    "Series1",
+					Title: "Go Tutorial",
+					Articles: []*model.ArticleNode{
+						{
+							ID:           "Article1",
+							Title:        "Article1",
+							Content:      "## Article1",
+							ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+							CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+							UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+						},
+					},
+				},
+				ok: true,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "Series1",
+			},
+			want: want{
+				out: &model.ArticleSeries{
+					ID:    "Series1",
+					Title: "Go Tutorial",
+					Articles: []*model.ArticleNode{
+						{
+							ID:           "Article1",
+							Title:        "Article1",
+							Content:      "## Article1",
+							ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+							CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+							UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+						},
+					},
+				},
+			},
+		},
+		"unhappy_path/usecase_returns_error": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockSeries, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), dto.NewSeriesInDTO("Series1")).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockSeriesConverter, from dto.SeriesOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToSeries(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "Series1",
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path/converter_returns_false": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockSeries, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), dto.NewSeriesInDTO("Series1")).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: seriesOutDTO,
+			},
+			setupMockConverter: func(converter *mconverter.MockSeriesConverter, from dto.SeriesOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToSeries(gomock.Any(), gomock.Any()).
+					Return(converterResult.out, converterResult.ok).
+					Times(1)
+			},
+			converterResult: converterResult{
+				ok: false,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "Series1",
+			},
+			want: want{
+				err: ErrFailedToConvertToArticleSeries,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			uc := musecase.NewMockSeries(ctrl)
+			tt.setupMockUsecase(uc, tt.usecaseResult)
+			converter := mconverter.NewMockSeriesConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+			sut := tt.sut(NewResolver(NewUsecases(WithSeriesUsecase(uc)), NewConverters(WithSeriesConverter(converter))))
+			got, err := sut.Series(tt.args.ctx, tt.args.id)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Series() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@ package resolver
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.60
+// Code generated by github.com/99designs/gqlgen version v0.17.70
 
 import (
 	"context"
@@ -13,6 +13,8 @@ import (
 	"blogapi.miyamo.today/core/log"
 	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
 	"blogapi.miyamo.today/federator/internal/if-adapter/presenters/graphql/model"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
 	"github.com/miyamo2/altnrslog"
 	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
 	"github.com/newrelic/go-agent/v3/newrelic"
@@ -36,9 +38,17 @@ func (r *mutationResolver) CreateArticle(ctx context.Context, input model.Create
 	if input.ClientMutationID != nil {
 		clientMutationID = *input.ClientMutationID
 	}
-	outDTO, err := r.usecases.createArticle.Execute(ctx, dto.NewCreateArticleInDTO(input.Title, input.Content, url.URL(input.ThumbnailURL), input.TagNames, clientMutationID))
+	var publishAt *synchro.Time[tz.UTC]
+	if input.PublishAt != nil {
+		v := synchro.Time[tz.UTC](*input.PublishAt)
+		publishAt = &v
+	}
+	outDTO, err := r.usecases.createArticle.Execute(ctx, dto.NewCreateArticleInDTO(input.Title, input.Content, url.URL(input.ThumbnailURL), imageVariantDTOsFromInput(input.ThumbnailVariants), input.TagNames, publishAt, input.Draft != nil && *input.Draft, clientMutationID, input.Slug))
 	if err != nil {
-		return nil, err
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.CreateArticlePayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
 	}
 
 	payload, err := r.converters.createArticle.ToCreateArticle(ctx, outDTO)
@@ -67,14 +77,57 @@ func (r *mutationResolver) UpdateArticleTitle(ctx context.Context, input model.U
 		clientMutationID = *input.ClientMutationID
 	}
 
-	outDTO, err := r.usecases.updateArticleTitle.Execute(ctx, dto.NewUpdateArticleTitleInDTO(input.ArticleID, input.Title, clientMutationID))
+	var expectedLastEventID string
+	if input.ExpectedLastEventID != nil {
+		expectedLastEventID = *input.ExpectedLastEventID
+	}
+
+	outDTO, err := r.usecases.updateArticleTitle.Execute(ctx, dto.NewUpdateArticleTitleInDTO(input.ArticleID, input.Title, clientMutationID, expectedLastEventID))
 	if err != nil {
-		return nil, err
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.UpdateArticleTitlePayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
 	}
 
 	return r.converters.updateArticleTitle.ToUpdateArticleTitle(ctx, outDTO)
 }
 
+// UpdateArticleSlug is the resolver for the updateArticleSlug field.
+func (r *mutationResolver) UpdateArticleSlug(ctx context.Context, input model.UpdateArticleSlugInput) (*model.UpdateArticleSlugPayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("UpdateArticleSlug").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("input", fmt.Sprintf("%+v", input))))
+
+	var clientMutationID string
+	if input.ClientMutationID != nil {
+		clientMutationID = *input.ClientMutationID
+	}
+
+	var expectedLastEventID string
+	if input.ExpectedLastEventID != nil {
+		expectedLastEventID = *input.ExpectedLastEventID
+	}
+
+	outDTO, err := r.usecases.updateArticleSlug.Execute(ctx, dto.NewUpdateArticleSlugInDTO(input.ArticleID, input.Slug, clientMutationID, expectedLastEventID))
+	if err != nil {
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.UpdateArticleSlugPayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
+	}
+
+	return r.converters.updateArticleSlug.ToUpdateArticleSlug(ctx, outDTO)
+}
+
 // UpdateArticleBody is the resolver for the updateArticleBody field.
 func (r *mutationResolver) UpdateArticleBody(ctx context.Context, input model.UpdateArticleBodyInput) (*model.UpdateArticleBodyPayload, error) {
 	nrtx := newrelic.FromContext(ctx)
@@ -94,9 +147,17 @@ func (r *mutationResolver) UpdateArticleBody(ctx context.Context, input model.Up
 		clientMutationID = *input.ClientMutationID
 	}
 
-	outDTO, err := r.usecases.updateArticleBody.Execute(ctx, dto.NewUpdateArticleBodyInDTO(input.ArticleID, input.Content, clientMutationID))
+	var expectedLastEventID string
+	if input.ExpectedLastEventID != nil {
+		expectedLastEventID = *input.ExpectedLastEventID
+	}
+
+	outDTO, err := r.usecases.updateArticleBody.Execute(ctx, dto.NewUpdateArticleBodyInDTO(input.ArticleID, input.Content, clientMutationID, expectedLastEventID))
 	if err != nil {
-		return nil, err
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.UpdateArticleBodyPayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
 	}
 
 	return r.converters.updateArticleBody.ToUpdateArticleBody(ctx, outDTO)
@@ -121,9 +182,17 @@ func (r *mutationResolver) UpdateArticleThumbnail(ctx context.Context, input mod
 		clientMutationID = *input.ClientMutationID
 	}
 
-	outDTO, err := r.usecases.updateArticleThumbnail.Execute(ctx, dto.NewUpdateArticleThumbnailInDTO(input.ArticleID, url.URL(input.ThumbnailURL), clientMutationID))
+	var expectedLastEventID string
+	if input.ExpectedLastEventID != nil {
+		expectedLastEventID = *input.ExpectedLastEventID
+	}
+
+	outDTO, err := r.usecases.updateArticleThumbnail.Execute(ctx, dto.NewUpdateArticleThumbnailInDTO(input.ArticleID, url.URL(input.ThumbnailURL), imageVariantDTOsFromInput(input.ThumbnailVariants), clientMutationID, expectedLastEventID))
 	if err != nil {
-		return nil, err
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.UpdateArticleThumbnailPayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
 	}
 
 	return r.converters.updateArticleThumbnail.ToUpdateArticleThumbnail(ctx, outDTO)
@@ -148,9 +217,17 @@ func (r *mutationResolver) AttachTags(ctx context.Context, input model.AttachTag
 		clientMutationID = *input.ClientMutationID
 	}
 
-	outDTO, err := r.usecases.attachTags.Execute(ctx, dto.NewAttachTagsInDTO(input.ArticleID, input.TagNames, clientMutationID))
+	var expectedLastEventID string
+	if input.ExpectedLastEventID != nil {
+		expectedLastEventID = *input.ExpectedLastEventID
+	}
+
+	outDTO, err := r.usecases.attachTags.Execute(ctx, dto.NewAttachTagsInDTO(input.ArticleID, input.TagNames, clientMutationID, expectedLastEventID))
 	if err != nil {
-		return nil, err
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.AttachTagsPayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
 	}
 
 	return r.converters.attachTags.ToAttachTags(ctx, outDTO)
@@ -175,14 +252,394 @@ func (r *mutationResolver) DetachTags(ctx context.Context, input model.DetachTag
 		clientMutationID = *input.ClientMutationID
 	}
 
-	outDTO, err := r.usecases.detachTags.Execute(ctx, dto.NewDetachTagsInDTO(input.ArticleID, input.TagNames, clientMutationID))
+	var expectedLastEventID string
+	if input.ExpectedLastEventID != nil {
+		expectedLastEventID = *input.ExpectedLastEventID
+	}
+
+	outDTO, err := r.usecases.detachTags.Execute(ctx, dto.NewDetachTagsInDTO(input.ArticleID, input.TagNames, clientMutationID, expectedLastEventID))
 	if err != nil {
-		return nil, err
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.DetachTagsPayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
 	}
 
 	return r.converters.detachTags.ToDetachTags(ctx, outDTO)
 }
 
+// HideArticle is the resolver for the hideArticle field.
+func (r *mutationResolver) HideArticle(ctx context.Context, input model.HideArticleInput) (*model.HideArticlePayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("HideArticle").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("input", fmt.Sprintf("%+v", input))))
+
+	var clientMutationID string
+	if input.ClientMutationID != nil {
+		clientMutationID = *input.ClientMutationID
+	}
+
+	var expectedLastEventID string
+	if input.ExpectedLastEventID != nil {
+		expectedLastEventID = *input.ExpectedLastEventID
+	}
+
+	outDTO, err := r.usecases.hideArticle.Execute(ctx, dto.NewHideArticleInDTO(input.ArticleID, clientMutationID, expectedLastEventID))
+	if err != nil {
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.HideArticlePayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
+	}
+
+	return r.converters.hideArticle.ToHideArticle(ctx, outDTO)
+}
+
+// UnhideArticle is the resolver for the unhideArticle field.
+func (r *mutationResolver) UnhideArticle(ctx context.Context, input model.UnhideArticleInput) (*model.UnhideArticlePayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("UnhideArticle").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("input", fmt.Sprintf("%+v", input))))
+
+	var clientMutationID string
+	if input.ClientMutationID != nil {
+		clientMutationID = *input.ClientMutationID
+	}
+
+	var expectedLastEventID string
+	if input.ExpectedLastEventID != nil {
+		expectedLastEventID = *input.ExpectedLastEventID
+	}
+
+	outDTO, err := r.usecases.unhideArticle.Execute(ctx, dto.NewUnhideArticleInDTO(input.ArticleID, clientMutationID, expectedLastEventID))
+	if err != nil {
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.UnhideArticlePayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
+	}
+
+	return r.converters.unhideArticle.ToUnhideArticle(ctx, outDTO)
+}
+
+// EditArticle is the resolver for the editArticle field.
+func (r *mutationResolver) EditArticle(ctx context.Context, input model.EditArticleInput) (*model.EditArticlePayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("EditArticle").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("input", fmt.Sprintf("%+v", input))))
+
+	var clientMutationID string
+	if input.ClientMutationID != nil {
+		clientMutationID = *input.ClientMutationID
+	}
+
+	var expectedLastEventID string
+	if input.ExpectedLastEventID != nil {
+		expectedLastEventID = *input.ExpectedLastEventID
+	}
+
+	var thumbnail *url.URL
+	if input.ThumbnailURL != nil {
+		v := url.URL(*input.ThumbnailURL)
+		thumbnail = &v
+	}
+
+	outDTO, err := r.usecases.editArticle.Execute(ctx, dto.NewEditArticleInDTO(input.ArticleID, input.Title, input.Content, thumbnail, imageVariantDTOsFromInput(input.ThumbnailVariants), input.AttachTagNames, input.DetachTagNames, clientMutationID, expectedLastEventID))
+	if err != nil {
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.EditArticlePayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
+	}
+
+	return r.converters.editArticle.ToEditArticle(ctx, outDTO)
+}
+
+// ScheduleArticle is the resolver for the scheduleArticle field.
+func (r *mutationResolver) ScheduleArticle(ctx context.Context, input model.ScheduleArticleInput) (*model.ScheduleArticlePayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ScheduleArticle").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("input", fmt.Sprintf("%+v", input))))
+
+	var clientMutationID string
+	if input.ClientMutationID != nil {
+		clientMutationID = *input.ClientMutationID
+	}
+
+	var expectedLastEventID string
+	if input.ExpectedLastEventID != nil {
+		expectedLastEventID = *input.ExpectedLastEventID
+	}
+
+	outDTO, err := r.usecases.scheduleArticle.Execute(ctx, dto.NewScheduleArticleInDTO(input.ArticleID, synchro.Time[tz.UTC](input.PublishAt), clientMutationID, expectedLastEventID))
+	if err != nil {
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.ScheduleArticlePayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
+	}
+
+	return r.converters.scheduleArticle.ToScheduleArticle(ctx, outDTO)
+}
+
+// PublishArticle is the resolver for the publishArticle field.
+func (r *mutationResolver) PublishArticle(ctx context.Context, input model.PublishArticleInput) (*model.PublishArticlePayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("PublishArticle").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("input", fmt.Sprintf("%+v", input))))
+
+	var clientMutationID string
+	if input.ClientMutationID != nil {
+		clientMutationID = *input.ClientMutationID
+	}
+
+	var expectedLastEventID string
+	if input.ExpectedLastEventID != nil {
+		expectedLastEventID = *input.ExpectedLastEventID
+	}
+
+	outDTO, err := r.usecases.publishArticle.Execute(ctx, dto.NewPublishArticleInDTO(input.ArticleID, clientMutationID, expectedLastEventID))
+	if err != nil {
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.PublishArticlePayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
+	}
+
+	return r.converters.publishArticle.ToPublishArticle(ctx, outDTO)
+}
+
+// RevertArticle is the resolver for the revertArticle field.
+func (r *mutationResolver) RevertArticle(ctx context.Context, input model.RevertArticleInput) (*model.RevertArticlePayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("RevertArticle").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("input", fmt.Sprintf("%+v", input))))
+
+	var clientMutationID string
+	if input.ClientMutationID != nil {
+		clientMutationID = *input.ClientMutationID
+	}
+
+	var expectedLastEventID string
+	if input.ExpectedLastEventID != nil {
+		expectedLastEventID = *input.ExpectedLastEventID
+	}
+
+	outDTO, err := r.usecases.revertArticle.Execute(ctx, dto.NewRevertArticleInDTO(input.ArticleID, input.ToEventID, clientMutationID, expectedLastEventID))
+	if err != nil {
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.RevertArticlePayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
+	}
+
+	return r.converters.revertArticle.ToRevertArticle(ctx, outDTO)
+}
+
+// RenameTag is the resolver for the renameTag field.
+func (r *mutationResolver) RenameTag(ctx context.Context, input model.RenameTagInput) (*model.RenameTagPayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("RenameTag").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("input", fmt.Sprintf("%+v", input))))
+
+	var clientMutationID string
+	if input.ClientMutationID != nil {
+		clientMutationID = *input.ClientMutationID
+	}
+
+	outDTO, err := r.usecases.renameTag.Execute(ctx, dto.NewRenameTagInDTO(input.From, input.To, clientMutationID))
+	if err != nil {
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.RenameTagPayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
+	}
+
+	return r.converters.renameTag.ToRenameTag(ctx, outDTO)
+}
+
+// MergeTags is the resolver for the mergeTags field.
+func (r *mutationResolver) MergeTags(ctx context.Context, input model.MergeTagsInput) (*model.MergeTagsPayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("MergeTags").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("input", fmt.Sprintf("%+v", input))))
+
+	var clientMutationID string
+	if input.ClientMutationID != nil {
+		clientMutationID = *input.ClientMutationID
+	}
+
+	outDTO, err := r.usecases.mergeTags.Execute(ctx, dto.NewMergeTagsInDTO(input.Sources, input.Into, clientMutationID))
+	if err != nil {
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.MergeTagsPayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
+	}
+
+	return r.converters.mergeTags.ToMergeTags(ctx, outDTO)
+}
+
+// CreateSeries is the resolver for the createSeries field.
+func (r *mutationResolver) CreateSeries(ctx context.Context, input model.CreateSeriesInput) (*model.CreateSeriesPayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("CreateSeries").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("input", fmt.Sprintf("%+v", input))))
+
+	var clientMutationID string
+	if input.ClientMutationID != nil {
+		clientMutationID = *input.ClientMutationID
+	}
+	inDTO := dto.NewCreateSeriesInDTO(input.Title, input.ArticleIds, clientMutationID)
+	outDTO, err := r.usecases.createSeries.Execute(ctx, inDTO)
+	if err != nil {
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.CreateSeriesPayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
+	}
+
+	return r.converters.createSeries.ToCreateSeries(ctx, outDTO)
+}
+
+// AddArticleToSeries is the resolver for the addArticleToSeries field.
+func (r *mutationResolver) AddArticleToSeries(ctx context.Context, input model.AddArticleToSeriesInput) (*model.AddArticleToSeriesPayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("AddArticleToSeries").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("input", fmt.Sprintf("%+v", input))))
+
+	var clientMutationID string
+	if input.ClientMutationID != nil {
+		clientMutationID = *input.ClientMutationID
+	}
+
+	var position int
+	if input.Position != nil {
+		position = *input.Position
+	}
+
+	inDTO := dto.NewAddArticleToSeriesInDTO(input.SeriesID, input.ArticleID, position, clientMutationID)
+	outDTO, err := r.usecases.addArticleToSeries.Execute(ctx, inDTO)
+	if err != nil {
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.AddArticleToSeriesPayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
+	}
+
+	return r.converters.addArticleToSeries.ToAddArticleToSeries(ctx, outDTO)
+}
+
+// ReorderSeries is the resolver for the reorderSeries field.
+func (r *mutationResolver) ReorderSeries(ctx context.Context, input model.ReorderSeriesInput) (*model.ReorderSeriesPayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ReorderSeries").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("input", fmt.Sprintf("%+v", input))))
+
+	var clientMutationID string
+	if input.ClientMutationID != nil {
+		clientMutationID = *input.ClientMutationID
+	}
+	inDTO := dto.NewReorderSeriesInDTO(input.SeriesID, input.ArticleIds, clientMutationID)
+	outDTO, err := r.usecases.reorderSeries.Execute(ctx, inDTO)
+	if err != nil {
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.ReorderSeriesPayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
+	}
+
+	return r.converters.reorderSeries.ToReorderSeries(ctx, outDTO)
+}
+
 // UploadImage is the resolver for the uploadImage field.
 func (r *mutationResolver) UploadImage(ctx context.Context, input model.UploadImageInput) (*model.UploadImagePayload, error) {
 	nrtx := newrelic.FromContext(ctx)
@@ -202,9 +659,15 @@ func (r *mutationResolver) UploadImage(ctx context.Context, input model.UploadIm
 		clientMutationID = *input.ClientMutationID
 	}
 
-	outDTO, err := r.usecases.uploadImage.Execute(ctx, dto.NewUploadImageInDTO(input.Image.File, input.Image.Filename, input.Image.ContentType, clientMutationID))
+	// the metadata is stripped unless it is asked to be kept.
+	keepMetadata := input.KeepMetadata != nil && *input.KeepMetadata
+
+	outDTO, err := r.usecases.uploadImage.Execute(ctx, dto.NewUploadImageInDTO(input.Image.File, input.Image.Filename, input.Image.ContentType, keepMetadata, clientMutationID))
 	if err != nil {
-		return nil, err
+		if userErrors, ok := userErrorsOf(err); ok {
+			return &model.UploadImagePayload{ClientMutationID: input.ClientMutationID, UserErrors: userErrors}, nil
+		}
+		return nil, mutationError(ctx, err)
 	}
 
 	return r.converters.uploadImage.ToUploadImage(ctx, outDTO)
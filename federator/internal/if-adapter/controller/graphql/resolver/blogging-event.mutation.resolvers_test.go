@@ -8,9 +8,12 @@ import (
 	"blogapi.miyamo.today/federator/internal/pkg/gqlscalar"
 	"blogapi.miyamo.today/federator/internal/utils"
 	"bytes"
+	"connectrpc.com/connect"
 	"context"
 	"fmt"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
 	"github.com/cockroachdb/errors"
 	"github.com/google/go-cmp/cmp"
 	"go.uber.org/mock/gomock"
@@ -53,7 +56,7 @@ func Test_mutationResolver_CreateArticle(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			createArticleInDTO: dto.NewCreateArticleInDTO("Title1", "Content1", utils.MustURLParse("https://example.com/example.jpg"), []string{"Tag1", "Tag2"}, "Mutation1"),
+			createArticleInDTO: dto.NewCreateArticleInDTO("Title1", "Content1", utils.MustURLParse("https://example.com/example.jpg"), nil, []string{"Tag1", "Tag2"}, nil, false, "Mutation1", nil),
 			setupMockUsecase: func(uc *musecase.MockCreateArticle, input dto.CreateArticleInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewCreateArticleInputMatcher(input)).
@@ -76,8 +79,8 @@ func Test_mutationResolver_CreateArticle(t *testing.T) {
 			},
 			converterResult: converterResult{
 				out: &model.CreateArticlePayload{
-					EventID:          "Event1",
-					ArticleID:        "Article1",
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
@@ -93,8 +96,8 @@ func Test_mutationResolver_CreateArticle(t *testing.T) {
 			},
 			want: want{
 				out: &model.CreateArticlePayload{
-					EventID:          "Event1",
-					ArticleID:        "Article1",
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
@@ -103,7 +106,7 @@ func Test_mutationResolver_CreateArticle(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			createArticleInDTO: dto.NewCreateArticleInDTO("Title1", "Content1", utils.MustURLParse("https://example.com/example.jpg"), []string{"Tag1", "Tag2"}, "Mutation1"),
+			createArticleInDTO: dto.NewCreateArticleInDTO("Title1", "Content1", utils.MustURLParse("https://example.com/example.jpg"), nil, []string{"Tag1", "Tag2"}, nil, false, "Mutation1", nil),
 			setupMockUsecase: func(uc *musecase.MockCreateArticle, input dto.CreateArticleInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewCreateArticleInputMatcher(input)).
@@ -132,11 +135,49 @@ func Test_mutationResolver_CreateArticle(t *testing.T) {
 				err: errFailedToUsecase,
 			},
 		},
+		"unhappy_path:usecase-returns-invalid-argument": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			createArticleInDTO: dto.NewCreateArticleInDTO("Title1", "Content1", utils.MustURLParse("https://example.com/example.jpg"), nil, []string{"Tag1", "Tag2"}, nil, false, "Mutation1", nil),
+			setupMockUsecase: func(uc *musecase.MockCreateArticle, input dto.CreateArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewCreateArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errors.WithStack(connect.NewError(connect.CodeInvalidArgument, errors.New("title must not be empty"))),
+			},
+			setupMockConverter: func(converter *mconverter.MockCreateArticleConverter, from dto.CreateArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToCreateArticle(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.CreateArticleInput{
+					Title:            "Title1",
+					Content:          "Content1",
+					TagNames:         []string{"Tag1", "Tag2"},
+					ThumbnailURL:     gqlscalar.URL(utils.MustURLParse("https://example.com/example.jpg")),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				out: &model.CreateArticlePayload{
+					ClientMutationID: toPointerString("Mutation1"),
+					UserErrors: []*model.UserError{
+						{Message: "title must not be empty"},
+					},
+				},
+			},
+		},
 		"unhappy_path:converter-returns-error": {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			createArticleInDTO: dto.NewCreateArticleInDTO("Title1", "Content1", utils.MustURLParse("https://example.com/example.jpg"), []string{"Tag1", "Tag2"}, "Mutation1"),
+			createArticleInDTO: dto.NewCreateArticleInDTO("Title1", "Content1", utils.MustURLParse("https://example.com/example.jpg"), nil, []string{"Tag1", "Tag2"}, nil, false, "Mutation1", nil),
 			setupMockUsecase: func(uc *musecase.MockCreateArticle, input dto.CreateArticleInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewCreateArticleInputMatcher(input)).
@@ -204,6 +245,14 @@ func toPointerString(s string) *string {
 	return &s
 }
 
+func toPointerBool(b bool) *bool {
+	return &b
+}
+
+func toPointerInt(i int) *int {
+	return &i
+}
+
 func NewCreateArticleInputMatcher(expect dto.CreateArticleInDTO) gomock.Matcher {
 	return &CreateArticleInDTOMatcher{
 		expect: expect,
@@ -221,7 +270,8 @@ func (m *CreateArticleInDTOMatcher) Matches(x interface{}) bool {
 		return m.expect.ClientMutationID() == x.ClientMutationID() &&
 			m.expect.Body() == x.Body() &&
 			cmp.Diff(x.TagNames(), m.expect.TagNames(), cmpOpts...) == "" &&
-			cmp.Diff(x.ThumbnailURL(), m.expect.ThumbnailURL(), cmpOpts...) == ""
+			cmp.Diff(x.ThumbnailURL(), m.expect.ThumbnailURL(), cmpOpts...) == "" &&
+			cmp.Diff(x.PublishAt(), m.expect.PublishAt(), cmpOpts...) == ""
 	}
 	return false
 }
@@ -264,7 +314,7 @@ func Test_mutationResolver_UpdateArticleTitle(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewUpdateArticleTitleInDTO("Article1", "Title1", "Mutation1"),
+			updateArticleInDTO: dto.NewUpdateArticleTitleInDTO("Article1", "Title1", "Mutation1", "Event0"),
 			setupMockUsecase: func(uc *musecase.MockUpdateArticleTitle, input dto.UpdateArticleTitleInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewUpdateArticleTitleInputMatcher(input)).
@@ -287,23 +337,24 @@ func Test_mutationResolver_UpdateArticleTitle(t *testing.T) {
 			},
 			converterResult: converterResult{
 				out: &model.UpdateArticleTitlePayload{
-					EventID:          "Event1",
-					ArticleID:        "Article1",
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
 			args: args{
 				ctx: context.Background(),
 				input: model.UpdateArticleTitleInput{
-					ArticleID:        "Article1",
-					Title:            "Title1",
-					ClientMutationID: toPointerString("Mutation1"),
+					ArticleID:           "Article1",
+					Title:               "Title1",
+					ClientMutationID:    toPointerString("Mutation1"),
+					ExpectedLastEventID: toPointerString("Event0"),
 				},
 			},
 			want: want{
 				out: &model.UpdateArticleTitlePayload{
-					EventID:          "Event1",
-					ArticleID:        "Article1",
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
@@ -312,7 +363,7 @@ func Test_mutationResolver_UpdateArticleTitle(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewUpdateArticleTitleInDTO("Article1", "Title1", "Mutation1"),
+			updateArticleInDTO: dto.NewUpdateArticleTitleInDTO("Article1", "Title1", "Mutation1", ""),
 			setupMockUsecase: func(uc *musecase.MockUpdateArticleTitle, input dto.UpdateArticleTitleInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewUpdateArticleTitleInputMatcher(input)).
@@ -343,7 +394,7 @@ func Test_mutationResolver_UpdateArticleTitle(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewUpdateArticleTitleInDTO("Article1", "Title1", "Mutation1"),
+			updateArticleInDTO: dto.NewUpdateArticleTitleInDTO("Article1", "Title1", "Mutation1", ""),
 			setupMockUsecase: func(uc *musecase.MockUpdateArticleTitle, input dto.UpdateArticleTitleInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewUpdateArticleTitleInputMatcher(input)).
@@ -421,7 +472,206 @@ func (m *UpdateArticleTitleInDTOMatcher) Matches(x interface{}) bool {
 	case dto.UpdateArticleTitleInDTO:
 		return cmp.Diff(x.ID(), m.expect.ID(), cmpOpts...) == "" &&
 			cmp.Diff(x.Title(), m.expect.Title(), cmpOpts...) == "" &&
-			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == ""
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ExpectedLastEventID(), m.expect.ExpectedLastEventID(), cmpOpts...) == ""
+	}
+	return false
+}
+
+func Test_mutationResolver_UpdateArticleSlug(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input model.UpdateArticleSlugInput
+	}
+	type want struct {
+		out *model.UpdateArticleSlugPayload
+		err error
+	}
+	type usecaseResult struct {
+		out dto.UpdateArticleSlugOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.UpdateArticleSlugPayload
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *mutationResolver
+		updateArticleInDTO dto.UpdateArticleSlugInDTO
+		setupMockUsecase   func(uc *musecase.MockUpdateArticleSlug, input dto.UpdateArticleSlugInDTO, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockUpdateArticleSlugConverter, from dto.UpdateArticleSlugOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errFailedToUsecase := errors.New("failed to usecase")
+	errFailedToConverter := errors.New("failed to converter")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewUpdateArticleSlugInDTO("Article1", "hello-world", "Mutation1", "Event0"),
+			setupMockUsecase: func(uc *musecase.MockUpdateArticleSlug, input dto.UpdateArticleSlugInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewUpdateArticleSlugInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewUpdateArticleSlugOutDTO(
+					"Event1",
+					"Article1",
+					"Mutation1",
+				),
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockUpdateArticleSlugConverter, from dto.UpdateArticleSlugOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToUpdateArticleSlug(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.UpdateArticleSlugPayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.UpdateArticleSlugInput{
+					ArticleID:           "Article1",
+					Slug:                "hello-world",
+					ClientMutationID:    toPointerString("Mutation1"),
+					ExpectedLastEventID: toPointerString("Event0"),
+				},
+			},
+			want: want{
+				out: &model.UpdateArticleSlugPayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"unhappy_path:usecase-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewUpdateArticleSlugInDTO("Article1", "hello-world", "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockUpdateArticleSlug, input dto.UpdateArticleSlugInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewUpdateArticleSlugInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockUpdateArticleSlugConverter, from dto.UpdateArticleSlugOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToUpdateArticleSlug(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.UpdateArticleSlugInput{
+					ArticleID:        "Article1",
+					Slug:             "hello-world",
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path:converter-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewUpdateArticleSlugInDTO("Article1", "hello-world", "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockUpdateArticleSlug, input dto.UpdateArticleSlugInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewUpdateArticleSlugInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewUpdateArticleSlugOutDTO(
+					"Event1",
+					"Article1",
+					"Mutation1",
+				),
+			},
+			setupMockConverter: func(converter *mconverter.MockUpdateArticleSlugConverter, from dto.UpdateArticleSlugOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToUpdateArticleSlug(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errFailedToConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.UpdateArticleSlugInput{
+					ArticleID:        "Article1",
+					Slug:             "hello-world",
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := musecase.NewMockUpdateArticleSlug(ctrl)
+			tt.setupMockUsecase(uc, tt.updateArticleInDTO, tt.usecaseResult)
+
+			converter := mconverter.NewMockUpdateArticleSlugConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+
+			sut := tt.sut(NewResolver(NewUsecases(WithUpdateArticleSlugUsecase(uc)), NewConverters(WithUpdateArticleSlugConverter(converter))))
+			got, err := sut.UpdateArticleSlug(tt.args.ctx, tt.args.input)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("UpdateArticleSlug() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func NewUpdateArticleSlugInputMatcher(expect dto.UpdateArticleSlugInDTO) gomock.Matcher {
+	return &UpdateArticleSlugInDTOMatcher{
+		expect: expect,
+	}
+}
+
+type UpdateArticleSlugInDTOMatcher struct {
+	gomock.Matcher
+	expect dto.UpdateArticleSlugInDTO
+}
+
+func (m *UpdateArticleSlugInDTOMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case dto.UpdateArticleSlugInDTO:
+		return cmp.Diff(x.ID(), m.expect.ID(), cmpOpts...) == "" &&
+			cmp.Diff(x.Slug(), m.expect.Slug(), cmpOpts...) == "" &&
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ExpectedLastEventID(), m.expect.ExpectedLastEventID(), cmpOpts...) == ""
 	}
 	return false
 }
@@ -460,7 +710,7 @@ func Test_mutationResolver_UpdateArticleBody(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewUpdateArticleBodyInDTO("Article1", "Content1", "Mutation1"),
+			updateArticleInDTO: dto.NewUpdateArticleBodyInDTO("Article1", "Content1", "Mutation1", "Event0"),
 			setupMockUsecase: func(uc *musecase.MockUpdateArticleBody, input dto.UpdateArticleBodyInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewUpdateArticleBodyInputMatcher(input)).
@@ -482,23 +732,24 @@ func Test_mutationResolver_UpdateArticleBody(t *testing.T) {
 			},
 			converterResult: converterResult{
 				out: &model.UpdateArticleBodyPayload{
-					EventID:          "Event1",
-					ArticleID:        "Article1",
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
 			args: args{
 				ctx: context.Background(),
 				input: model.UpdateArticleBodyInput{
-					ArticleID:        "Article1",
-					Content:          "Content1",
-					ClientMutationID: toPointerString("Mutation1"),
+					ArticleID:           "Article1",
+					Content:             "Content1",
+					ClientMutationID:    toPointerString("Mutation1"),
+					ExpectedLastEventID: toPointerString("Event0"),
 				},
 			},
 			want: want{
 				out: &model.UpdateArticleBodyPayload{
-					EventID:          "Event1",
-					ArticleID:        "Article1",
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
@@ -507,7 +758,7 @@ func Test_mutationResolver_UpdateArticleBody(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewUpdateArticleBodyInDTO("Article1", "Content1", "Mutation1"),
+			updateArticleInDTO: dto.NewUpdateArticleBodyInDTO("Article1", "Content1", "Mutation1", ""),
 			setupMockUsecase: func(uc *musecase.MockUpdateArticleBody, input dto.UpdateArticleBodyInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewUpdateArticleBodyInputMatcher(input)).
@@ -538,7 +789,7 @@ func Test_mutationResolver_UpdateArticleBody(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewUpdateArticleBodyInDTO("Article1", "Content1", "Mutation1"),
+			updateArticleInDTO: dto.NewUpdateArticleBodyInDTO("Article1", "Content1", "Mutation1", ""),
 			setupMockUsecase: func(uc *musecase.MockUpdateArticleBody, input dto.UpdateArticleBodyInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewUpdateArticleBodyInputMatcher(input)).
@@ -616,7 +867,8 @@ func (m *UpdateArticleBodyInputMatcher) Matches(x interface{}) bool {
 	case dto.UpdateArticleBodyInDTO:
 		return cmp.Diff(x.ID(), m.expect.ID(), cmpOpts...) == "" &&
 			cmp.Diff(x.Content(), m.expect.Content(), cmpOpts...) == "" &&
-			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == ""
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ExpectedLastEventID(), m.expect.ExpectedLastEventID(), cmpOpts...) == ""
 	}
 	return false
 }
@@ -659,7 +911,7 @@ func Test_mutationResolver_UpdateArticleThumbnail(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewUpdateArticleThumbnailInDTO("Article1", utils.MustURLParse("https://example.com/example.png"), "Mutation1"),
+			updateArticleInDTO: dto.NewUpdateArticleThumbnailInDTO("Article1", utils.MustURLParse("https://example.com/example.png"), nil, "Mutation1", "Event0"),
 			setupMockUsecase: func(uc *musecase.MockUpdateArticleThumbnail, input dto.UpdateArticleThumbnailInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewUpdateArticleThumbnailInputMatcher(input)).
@@ -677,23 +929,24 @@ func Test_mutationResolver_UpdateArticleThumbnail(t *testing.T) {
 			},
 			converterResult: converterResult{
 				out: &model.UpdateArticleThumbnailPayload{
-					EventID:          "Event1",
-					ArticleID:        "Article1",
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
 			args: args{
 				ctx: context.Background(),
 				input: model.UpdateArticleThumbnailInput{
-					ArticleID:        "Article1",
-					ThumbnailURL:     gqlscalar.URL(utils.MustURLParse("https://example.com/example.png")),
-					ClientMutationID: toPointerString("Mutation1"),
+					ArticleID:           "Article1",
+					ThumbnailURL:        gqlscalar.URL(utils.MustURLParse("https://example.com/example.png")),
+					ClientMutationID:    toPointerString("Mutation1"),
+					ExpectedLastEventID: toPointerString("Event0"),
 				},
 			},
 			want: want{
 				out: &model.UpdateArticleThumbnailPayload{
-					EventID:          "Event1",
-					ArticleID:        "Article1",
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
@@ -702,7 +955,7 @@ func Test_mutationResolver_UpdateArticleThumbnail(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewUpdateArticleThumbnailInDTO("Article1", utils.MustURLParse("https://example.com/example.png"), "Mutation1"),
+			updateArticleInDTO: dto.NewUpdateArticleThumbnailInDTO("Article1", utils.MustURLParse("https://example.com/example.png"), nil, "Mutation1", ""),
 			setupMockUsecase: func(uc *musecase.MockUpdateArticleThumbnail, input dto.UpdateArticleThumbnailInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewUpdateArticleThumbnailInputMatcher(input)).
@@ -733,7 +986,7 @@ func Test_mutationResolver_UpdateArticleThumbnail(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewUpdateArticleThumbnailInDTO("Article1", utils.MustURLParse("https://example.com/example.png"), "Mutation1"),
+			updateArticleInDTO: dto.NewUpdateArticleThumbnailInDTO("Article1", utils.MustURLParse("https://example.com/example.png"), nil, "Mutation1", ""),
 			setupMockUsecase: func(uc *musecase.MockUpdateArticleThumbnail, input dto.UpdateArticleThumbnailInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewUpdateArticleThumbnailInputMatcher(input)).
@@ -808,7 +1061,8 @@ func (m *UpdateArticleThumbnailInputMatcher) Matches(x interface{}) bool {
 	case dto.UpdateArticleThumbnailInDTO:
 		return cmp.Diff(x.ID(), m.expect.ID(), cmpOpts...) == "" &&
 			cmp.Diff(x.Thumbnail(), m.expect.Thumbnail(), cmpOpts...) == "" &&
-			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == ""
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ExpectedLastEventID(), m.expect.ExpectedLastEventID(), cmpOpts...) == ""
 	}
 	return false
 }
@@ -846,12 +1100,13 @@ func Test_mutationResolver_AttachTags(t *testing.T) {
 	}
 	errFailedToUsecase := errors.New("failed to usecase")
 	errFailedToConverter := errors.New("failed to converter")
+	errArticleNotFound := errors.New("article not found")
 	tests := map[string]testCase{
 		"happy_path": {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewAttachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1"),
+			updateArticleInDTO: dto.NewAttachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1", "Event0"),
 			setupMockUsecase: func(uc *musecase.MockAttachTags, input dto.AttachTagsInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewAttachTagsInputMatcher(input)).
@@ -869,23 +1124,24 @@ func Test_mutationResolver_AttachTags(t *testing.T) {
 			},
 			converterResult: converterResult{
 				out: &model.AttachTagsPayload{
-					EventID:          "Event1",
-					ArticleID:        "Article1",
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
 			args: args{
 				ctx: context.Background(),
 				input: model.AttachTagsInput{
-					ArticleID:        "Article1",
-					TagNames:         []string{"Tag1", "Tag2"},
-					ClientMutationID: toPointerString("Mutation1"),
+					ArticleID:           "Article1",
+					TagNames:            []string{"Tag1", "Tag2"},
+					ClientMutationID:    toPointerString("Mutation1"),
+					ExpectedLastEventID: toPointerString("Event0"),
 				},
 			},
 			want: want{
 				out: &model.AttachTagsPayload{
-					EventID:          "Event1",
-					ArticleID:        "Article1",
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
@@ -894,7 +1150,7 @@ func Test_mutationResolver_AttachTags(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewAttachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1"),
+			updateArticleInDTO: dto.NewAttachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1", ""),
 			setupMockUsecase: func(uc *musecase.MockAttachTags, input dto.AttachTagsInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewAttachTagsInputMatcher(input)).
@@ -921,11 +1177,42 @@ func Test_mutationResolver_AttachTags(t *testing.T) {
 				err: errFailedToUsecase,
 			},
 		},
+		"unhappy_path:usecase-returns-not-found": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewAttachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockAttachTags, input dto.AttachTagsInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewAttachTagsInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: connect.NewError(connect.CodeNotFound, errArticleNotFound),
+			},
+			setupMockConverter: func(converter *mconverter.MockAttachTagsConverter, from dto.AttachTagsOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToAttachTags(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.AttachTagsInput{
+					ArticleID:        "Article1",
+					TagNames:         []string{"Tag1", "Tag2"},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errArticleNotFound,
+			},
+		},
 		"unhappy_path:converter-returns-error": {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewAttachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1"),
+			updateArticleInDTO: dto.NewAttachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1", ""),
 			setupMockUsecase: func(uc *musecase.MockAttachTags, input dto.AttachTagsInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewAttachTagsInputMatcher(input)).
@@ -1000,7 +1287,8 @@ func (m *AttachTagsInputMatcher) Matches(x interface{}) bool {
 	case dto.AttachTagsInDTO:
 		return cmp.Diff(x.ID(), m.expect.ID(), cmpOpts...) == "" &&
 			cmp.Diff(x.TagNames(), m.expect.TagNames(), cmpOpts...) == "" &&
-			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == ""
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ExpectedLastEventID(), m.expect.ExpectedLastEventID(), cmpOpts...) == ""
 	}
 	return false
 }
@@ -1043,7 +1331,7 @@ func Test_mutationResolver_DetachTags(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewDetachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1"),
+			updateArticleInDTO: dto.NewDetachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1", "Event0"),
 			setupMockUsecase: func(uc *musecase.MockDetachTags, input dto.DetachTagsInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewDetachTagsInputMatcher(input)).
@@ -1061,23 +1349,24 @@ func Test_mutationResolver_DetachTags(t *testing.T) {
 			},
 			converterResult: converterResult{
 				out: &model.DetachTagsPayload{
-					EventID:          "Event1",
-					ArticleID:        "Article1",
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
 			args: args{
 				ctx: context.Background(),
 				input: model.DetachTagsInput{
-					ArticleID:        "Article1",
-					TagNames:         []string{"Tag1", "Tag2"},
-					ClientMutationID: toPointerString("Mutation1"),
+					ArticleID:           "Article1",
+					TagNames:            []string{"Tag1", "Tag2"},
+					ClientMutationID:    toPointerString("Mutation1"),
+					ExpectedLastEventID: toPointerString("Event0"),
 				},
 			},
 			want: want{
 				out: &model.DetachTagsPayload{
-					EventID:          "Event1",
-					ArticleID:        "Article1",
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
@@ -1086,7 +1375,7 @@ func Test_mutationResolver_DetachTags(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewDetachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1"),
+			updateArticleInDTO: dto.NewDetachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1", ""),
 			setupMockUsecase: func(uc *musecase.MockDetachTags, input dto.DetachTagsInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewDetachTagsInputMatcher(input)).
@@ -1117,7 +1406,7 @@ func Test_mutationResolver_DetachTags(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewDetachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1"),
+			updateArticleInDTO: dto.NewDetachTagsInDTO("Article1", []string{"Tag1", "Tag2"}, "Mutation1", ""),
 			setupMockUsecase: func(uc *musecase.MockDetachTags, input dto.DetachTagsInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewDetachTagsInputMatcher(input)).
@@ -1192,7 +1481,8 @@ func (m *DetachTagsInputMatcher) Matches(x interface{}) bool {
 	case dto.DetachTagsInDTO:
 		return cmp.Diff(x.ID(), m.expect.ID(), cmpOpts...) == "" &&
 			cmp.Diff(x.TagNames(), m.expect.TagNames(), cmpOpts...) == "" &&
-			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == ""
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ExpectedLastEventID(), m.expect.ExpectedLastEventID(), cmpOpts...) == ""
 	}
 	return false
 }
@@ -1201,34 +1491,33 @@ func (m *DetachTagsInputMatcher) String() string {
 	return fmt.Sprintf("is equal to %+v", m.expect)
 }
 
-func Test_mutationResolver_UploadImage(t *testing.T) {
+func Test_mutationResolver_HideArticle(t *testing.T) {
 	type args struct {
 		ctx   context.Context
-		input model.UploadImageInput
+		input model.HideArticleInput
 	}
 	type want struct {
-		out *model.UploadImagePayload
+		out *model.HideArticlePayload
 		err error
 	}
 	type usecaseResult struct {
-		out dto.UploadImageOutDTO
+		out dto.HideArticleOutDTO
 		err error
 	}
 	type converterResult struct {
-		out *model.UploadImagePayload
+		out *model.HideArticlePayload
 		err error
 	}
 	type testCase struct {
 		sut                func(resolver *Resolver) *mutationResolver
-		updateArticleInDTO dto.UploadImageInDTO
-		setupMockUsecase   func(uc *musecase.MockUploadImage, input dto.UploadImageInDTO, usecaseResult usecaseResult)
+		updateArticleInDTO dto.HideArticleInDTO
+		setupMockUsecase   func(uc *musecase.MockHideArticle, input dto.HideArticleInDTO, usecaseResult usecaseResult)
 		usecaseResult      usecaseResult
-		setupMockConverter func(converter *mconverter.MockUploadImageConverter, from dto.UploadImageOutDTO, converterResult converterResult)
+		setupMockConverter func(converter *mconverter.MockHideArticleConverter, from dto.HideArticleOutDTO, converterResult converterResult)
 		converterResult    converterResult
 		args               args
 		want               want
 	}
-
 	errFailedToUsecase := errors.New("failed to usecase")
 	errFailedToConverter := errors.New("failed to converter")
 	tests := map[string]testCase{
@@ -1236,41 +1525,2223 @@ func Test_mutationResolver_UploadImage(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewUploadImageInDTO(bytes.NewReader([]byte("abc")), "example.png", "image/png", "Mutation1"),
-			setupMockUsecase: func(uc *musecase.MockUploadImage, input dto.UploadImageInDTO, usecaseResult usecaseResult) {
+			updateArticleInDTO: dto.NewHideArticleInDTO("Article1", "Mutation1", "Event0"),
+			setupMockUsecase: func(uc *musecase.MockHideArticle, input dto.HideArticleInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
-					Execute(gomock.Any(), NewUploadImageInputMatcher(input)).
+					Execute(gomock.Any(), NewHideArticleInputMatcher(input)).
 					Return(usecaseResult.out, usecaseResult.err).
 					Times(1)
 			},
 			usecaseResult: usecaseResult{
-				out: dto.NewUploadImageOutDTO(utils.MustURLParse("https://example.com/example.png"), "Mutation1"),
+				out: dto.NewHideArticleOutDTO("Event1", "Article1", "Mutation1"),
 			},
-			setupMockConverter: func(converter *mconverter.MockUploadImageConverter, from dto.UploadImageOutDTO, converterResult converterResult) {
+			setupMockConverter: func(converter *mconverter.MockHideArticleConverter, from dto.HideArticleOutDTO, converterResult converterResult) {
 				converter.EXPECT().
-					ToUploadImage(gomock.Any(), from).
+					ToHideArticle(gomock.Any(), from).
 					Return(converterResult.out, converterResult.err).
 					Times(1)
 			},
 			converterResult: converterResult{
-				out: &model.UploadImagePayload{
-					ImageURL:         gqlscalar.URL(utils.MustURLParse("https://example.com/example.png")),
+				out: &model.HideArticlePayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
 			args: args{
 				ctx: context.Background(),
-				input: model.UploadImageInput{
-					Image: graphql.Upload{
+				input: model.HideArticleInput{
+					ArticleID:           "Article1",
+					ClientMutationID:    toPointerString("Mutation1"),
+					ExpectedLastEventID: toPointerString("Event0"),
+				},
+			},
+			want: want{
+				out: &model.HideArticlePayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"unhappy_path:usecase-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewHideArticleInDTO("Article1", "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockHideArticle, input dto.HideArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewHideArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockHideArticleConverter, from dto.HideArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToHideArticle(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.HideArticleInput{
+					ArticleID:        "Article1",
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path:converter-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewHideArticleInDTO("Article1", "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockHideArticle, input dto.HideArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewHideArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.HideArticleOutDTO{},
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockHideArticleConverter, from dto.HideArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToHideArticle(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errFailedToConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.HideArticleInput{
+					ArticleID:        "Article1",
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := musecase.NewMockHideArticle(ctrl)
+			tt.setupMockUsecase(uc, tt.updateArticleInDTO, tt.usecaseResult)
+
+			converter := mconverter.NewMockHideArticleConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+
+			sut := tt.sut(NewResolver(NewUsecases(WithHideArticleUsecase(uc)), NewConverters(WithHideArticleConverter(converter))))
+			got, err := sut.HideArticle(tt.args.ctx, tt.args.input)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("HideArticle() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+type HideArticleInputMatcher struct {
+	gomock.Matcher
+	expect dto.HideArticleInDTO
+}
+
+func NewHideArticleInputMatcher(expect dto.HideArticleInDTO) gomock.Matcher {
+	return &HideArticleInputMatcher{
+		expect: expect,
+	}
+}
+
+func (m *HideArticleInputMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case dto.HideArticleInDTO:
+		return cmp.Diff(x.ID(), m.expect.ID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ExpectedLastEventID(), m.expect.ExpectedLastEventID(), cmpOpts...) == ""
+	}
+	return false
+}
+
+func (m *HideArticleInputMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
+
+func Test_mutationResolver_UnhideArticle(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input model.UnhideArticleInput
+	}
+	type want struct {
+		out *model.UnhideArticlePayload
+		err error
+	}
+	type usecaseResult struct {
+		out dto.UnhideArticleOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.UnhideArticlePayload
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *mutationResolver
+		updateArticleInDTO dto.UnhideArticleInDTO
+		setupMockUsecase   func(uc *musecase.MockUnhideArticle, input dto.UnhideArticleInDTO, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockUnhideArticleConverter, from dto.UnhideArticleOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errFailedToUsecase := errors.New("failed to usecase")
+	errFailedToConverter := errors.New("failed to converter")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewUnhideArticleInDTO("Article1", "Mutation1", "Event0"),
+			setupMockUsecase: func(uc *musecase.MockUnhideArticle, input dto.UnhideArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewUnhideArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewUnhideArticleOutDTO("Event1", "Article1", "Mutation1"),
+			},
+			setupMockConverter: func(converter *mconverter.MockUnhideArticleConverter, from dto.UnhideArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToUnhideArticle(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.UnhideArticlePayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.UnhideArticleInput{
+					ArticleID:           "Article1",
+					ClientMutationID:    toPointerString("Mutation1"),
+					ExpectedLastEventID: toPointerString("Event0"),
+				},
+			},
+			want: want{
+				out: &model.UnhideArticlePayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"unhappy_path:usecase-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewUnhideArticleInDTO("Article1", "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockUnhideArticle, input dto.UnhideArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewUnhideArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockUnhideArticleConverter, from dto.UnhideArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToUnhideArticle(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.UnhideArticleInput{
+					ArticleID:        "Article1",
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path:converter-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewUnhideArticleInDTO("Article1", "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockUnhideArticle, input dto.UnhideArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewUnhideArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.UnhideArticleOutDTO{},
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockUnhideArticleConverter, from dto.UnhideArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToUnhideArticle(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errFailedToConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.UnhideArticleInput{
+					ArticleID:        "Article1",
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := musecase.NewMockUnhideArticle(ctrl)
+			tt.setupMockUsecase(uc, tt.updateArticleInDTO, tt.usecaseResult)
+
+			converter := mconverter.NewMockUnhideArticleConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+
+			sut := tt.sut(NewResolver(NewUsecases(WithUnhideArticleUsecase(uc)), NewConverters(WithUnhideArticleConverter(converter))))
+			got, err := sut.UnhideArticle(tt.args.ctx, tt.args.input)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("UnhideArticle() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+type UnhideArticleInputMatcher struct {
+	gomock.Matcher
+	expect dto.UnhideArticleInDTO
+}
+
+func NewUnhideArticleInputMatcher(expect dto.UnhideArticleInDTO) gomock.Matcher {
+	return &UnhideArticleInputMatcher{
+		expect: expect,
+	}
+}
+
+func (m *UnhideArticleInputMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case dto.UnhideArticleInDTO:
+		return cmp.Diff(x.ID(), m.expect.ID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ExpectedLastEventID(), m.expect.ExpectedLastEventID(), cmpOpts...) == ""
+	}
+	return false
+}
+
+func (m *UnhideArticleInputMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
+
+func Test_mutationResolver_EditArticle(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input model.EditArticleInput
+	}
+	type want struct {
+		out *model.EditArticlePayload
+		err error
+	}
+	type usecaseResult struct {
+		out dto.EditArticleOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.EditArticlePayload
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *mutationResolver
+		updateArticleInDTO dto.EditArticleInDTO
+		setupMockUsecase   func(uc *musecase.MockEditArticle, input dto.EditArticleInDTO, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockEditArticleConverter, from dto.EditArticleOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errFailedToUsecase := errors.New("failed to usecase")
+	errFailedToConverter := errors.New("failed to converter")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewEditArticleInDTO("Article1", toPointerString("Title1"), nil, nil, nil, []string{"Tag1"}, nil, "Mutation1", "Event0"),
+			setupMockUsecase: func(uc *musecase.MockEditArticle, input dto.EditArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewEditArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewEditArticleOutDTO("Event1", "Article1", "Mutation1"),
+			},
+			setupMockConverter: func(converter *mconverter.MockEditArticleConverter, from dto.EditArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToEditArticle(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.EditArticlePayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.EditArticleInput{
+					ArticleID:           "Article1",
+					Title:               toPointerString("Title1"),
+					AttachTagNames:      []string{"Tag1"},
+					ClientMutationID:    toPointerString("Mutation1"),
+					ExpectedLastEventID: toPointerString("Event0"),
+				},
+			},
+			want: want{
+				out: &model.EditArticlePayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"unhappy_path:usecase-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewEditArticleInDTO("Article1", toPointerString("Title1"), nil, nil, nil, nil, nil, "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockEditArticle, input dto.EditArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewEditArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockEditArticleConverter, from dto.EditArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToEditArticle(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.EditArticleInput{
+					ArticleID:        "Article1",
+					Title:            toPointerString("Title1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path:converter-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewEditArticleInDTO("Article1", toPointerString("Title1"), nil, nil, nil, nil, nil, "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockEditArticle, input dto.EditArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewEditArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.EditArticleOutDTO{},
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockEditArticleConverter, from dto.EditArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToEditArticle(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errFailedToConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.EditArticleInput{
+					ArticleID:        "Article1",
+					Title:            toPointerString("Title1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := musecase.NewMockEditArticle(ctrl)
+			tt.setupMockUsecase(uc, tt.updateArticleInDTO, tt.usecaseResult)
+
+			converter := mconverter.NewMockEditArticleConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+
+			sut := tt.sut(NewResolver(NewUsecases(WithEditArticleUsecase(uc)), NewConverters(WithEditArticleConverter(converter))))
+			got, err := sut.EditArticle(tt.args.ctx, tt.args.input)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("EditArticle() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+type EditArticleInputMatcher struct {
+	gomock.Matcher
+	expect dto.EditArticleInDTO
+}
+
+func NewEditArticleInputMatcher(expect dto.EditArticleInDTO) gomock.Matcher {
+	return &EditArticleInputMatcher{
+		expect: expect,
+	}
+}
+
+func (m *EditArticleInputMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case dto.EditArticleInDTO:
+		return cmp.Diff(x.ID(), m.expect.ID(), cmpOpts...) == "" &&
+			cmp.Diff(x.Title(), m.expect.Title(), cmpOpts...) == "" &&
+			cmp.Diff(x.Content(), m.expect.Content(), cmpOpts...) == "" &&
+			cmp.Diff(x.Thumbnail(), m.expect.Thumbnail(), cmpOpts...) == "" &&
+			cmp.Diff(x.AttachTagNames(), m.expect.AttachTagNames(), cmpOpts...) == "" &&
+			cmp.Diff(x.DetachTagNames(), m.expect.DetachTagNames(), cmpOpts...) == "" &&
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ExpectedLastEventID(), m.expect.ExpectedLastEventID(), cmpOpts...) == ""
+	}
+	return false
+}
+
+func (m *EditArticleInputMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
+
+func Test_mutationResolver_ScheduleArticle(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input model.ScheduleArticleInput
+	}
+	type want struct {
+		out *model.ScheduleArticlePayload
+		err error
+	}
+	type usecaseResult struct {
+		out dto.ScheduleArticleOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.ScheduleArticlePayload
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *mutationResolver
+		updateArticleInDTO dto.ScheduleArticleInDTO
+		setupMockUsecase   func(uc *musecase.MockScheduleArticle, input dto.ScheduleArticleInDTO, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockScheduleArticleConverter, from dto.ScheduleArticleOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errFailedToUsecase := errors.New("failed to usecase")
+	errFailedToConverter := errors.New("failed to converter")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewScheduleArticleInDTO("Article1", synchro.New[tz.UTC](2026, 1, 1, 0, 0, 0, 0), "Mutation1", "Event0"),
+			setupMockUsecase: func(uc *musecase.MockScheduleArticle, input dto.ScheduleArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewScheduleArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewScheduleArticleOutDTO("Event1", "Article1", "Mutation1"),
+			},
+			setupMockConverter: func(converter *mconverter.MockScheduleArticleConverter, from dto.ScheduleArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToScheduleArticle(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.ScheduleArticlePayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.ScheduleArticleInput{
+					ArticleID:           "Article1",
+					PublishAt:           gqlscalar.UTC(synchro.New[tz.UTC](2026, 1, 1, 0, 0, 0, 0)),
+					ClientMutationID:    toPointerString("Mutation1"),
+					ExpectedLastEventID: toPointerString("Event0"),
+				},
+			},
+			want: want{
+				out: &model.ScheduleArticlePayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"unhappy_path:usecase-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewScheduleArticleInDTO("Article1", synchro.New[tz.UTC](2026, 1, 1, 0, 0, 0, 0), "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockScheduleArticle, input dto.ScheduleArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewScheduleArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockScheduleArticleConverter, from dto.ScheduleArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToScheduleArticle(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.ScheduleArticleInput{
+					ArticleID:        "Article1",
+					PublishAt:        gqlscalar.UTC(synchro.New[tz.UTC](2026, 1, 1, 0, 0, 0, 0)),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path:converter-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewScheduleArticleInDTO("Article1", synchro.New[tz.UTC](2026, 1, 1, 0, 0, 0, 0), "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockScheduleArticle, input dto.ScheduleArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewScheduleArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.ScheduleArticleOutDTO{},
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockScheduleArticleConverter, from dto.ScheduleArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToScheduleArticle(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errFailedToConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.ScheduleArticleInput{
+					ArticleID:        "Article1",
+					PublishAt:        gqlscalar.UTC(synchro.New[tz.UTC](2026, 1, 1, 0, 0, 0, 0)),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := musecase.NewMockScheduleArticle(ctrl)
+			tt.setupMockUsecase(uc, tt.updateArticleInDTO, tt.usecaseResult)
+
+			converter := mconverter.NewMockScheduleArticleConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+
+			sut := tt.sut(NewResolver(NewUsecases(WithScheduleArticleUsecase(uc)), NewConverters(WithScheduleArticleConverter(converter))))
+			got, err := sut.ScheduleArticle(tt.args.ctx, tt.args.input)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ScheduleArticle() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+type ScheduleArticleInputMatcher struct {
+	gomock.Matcher
+	expect dto.ScheduleArticleInDTO
+}
+
+func NewScheduleArticleInputMatcher(expect dto.ScheduleArticleInDTO) gomock.Matcher {
+	return &ScheduleArticleInputMatcher{
+		expect: expect,
+	}
+}
+
+func (m *ScheduleArticleInputMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case dto.ScheduleArticleInDTO:
+		return cmp.Diff(x.ID(), m.expect.ID(), cmpOpts...) == "" &&
+			cmp.Diff(x.PublishAt(), m.expect.PublishAt(), cmpOpts...) == "" &&
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ExpectedLastEventID(), m.expect.ExpectedLastEventID(), cmpOpts...) == ""
+	}
+	return false
+}
+
+func (m *ScheduleArticleInputMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
+
+func Test_mutationResolver_PublishArticle(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input model.PublishArticleInput
+	}
+	type want struct {
+		out *model.PublishArticlePayload
+		err error
+	}
+	type usecaseResult struct {
+		out dto.PublishArticleOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.PublishArticlePayload
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *mutationResolver
+		updateArticleInDTO dto.PublishArticleInDTO
+		setupMockUsecase   func(uc *musecase.MockPublishArticle, input dto.PublishArticleInDTO, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockPublishArticleConverter, from dto.PublishArticleOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errFailedToUsecase := errors.New("failed to usecase")
+	errFailedToConverter := errors.New("failed to converter")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewPublishArticleInDTO("Article1", "Mutation1", "Event0"),
+			setupMockUsecase: func(uc *musecase.MockPublishArticle, input dto.PublishArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewPublishArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewPublishArticleOutDTO("Event1", "Article1", "Mutation1"),
+			},
+			setupMockConverter: func(converter *mconverter.MockPublishArticleConverter, from dto.PublishArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToPublishArticle(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.PublishArticlePayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.PublishArticleInput{
+					ArticleID:           "Article1",
+					ClientMutationID:    toPointerString("Mutation1"),
+					ExpectedLastEventID: toPointerString("Event0"),
+				},
+			},
+			want: want{
+				out: &model.PublishArticlePayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"unhappy_path:usecase-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewPublishArticleInDTO("Article1", "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockPublishArticle, input dto.PublishArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewPublishArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockPublishArticleConverter, from dto.PublishArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToPublishArticle(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.PublishArticleInput{
+					ArticleID:        "Article1",
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path:converter-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewPublishArticleInDTO("Article1", "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockPublishArticle, input dto.PublishArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewPublishArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.PublishArticleOutDTO{},
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockPublishArticleConverter, from dto.PublishArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToPublishArticle(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errFailedToConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.PublishArticleInput{
+					ArticleID:        "Article1",
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := musecase.NewMockPublishArticle(ctrl)
+			tt.setupMockUsecase(uc, tt.updateArticleInDTO, tt.usecaseResult)
+
+			converter := mconverter.NewMockPublishArticleConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+
+			sut := tt.sut(NewResolver(NewUsecases(WithPublishArticleUsecase(uc)), NewConverters(WithPublishArticleConverter(converter))))
+			got, err := sut.PublishArticle(tt.args.ctx, tt.args.input)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("PublishArticle() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+type PublishArticleInputMatcher struct {
+	gomock.Matcher
+	expect dto.PublishArticleInDTO
+}
+
+func NewPublishArticleInputMatcher(expect dto.PublishArticleInDTO) gomock.Matcher {
+	return &PublishArticleInputMatcher{
+		expect: expect,
+	}
+}
+
+func (m *PublishArticleInputMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case dto.PublishArticleInDTO:
+		return cmp.Diff(x.ID(), m.expect.ID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ExpectedLastEventID(), m.expect.ExpectedLastEventID(), cmpOpts...) == ""
+	}
+	return false
+}
+
+func (m *PublishArticleInputMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
+
+func Test_mutationResolver_RevertArticle(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input model.RevertArticleInput
+	}
+	type want struct {
+		out *model.RevertArticlePayload
+		err error
+	}
+	type usecaseResult struct {
+		out dto.RevertArticleOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.RevertArticlePayload
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *mutationResolver
+		updateArticleInDTO dto.RevertArticleInDTO
+		setupMockUsecase   func(uc *musecase.MockRevertArticle, input dto.RevertArticleInDTO, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockRevertArticleConverter, from dto.RevertArticleOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errFailedToUsecase := errors.New("failed to usecase")
+	errFailedToConverter := errors.New("failed to converter")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewRevertArticleInDTO("Article1", "Event-1", "Mutation1", "Event0"),
+			setupMockUsecase: func(uc *musecase.MockRevertArticle, input dto.RevertArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewRevertArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewRevertArticleOutDTO("Event1", "Article1", "Mutation1"),
+			},
+			setupMockConverter: func(converter *mconverter.MockRevertArticleConverter, from dto.RevertArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToRevertArticle(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.RevertArticlePayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.RevertArticleInput{
+					ArticleID:           "Article1",
+					ToEventID:           "Event-1",
+					ClientMutationID:    toPointerString("Mutation1"),
+					ExpectedLastEventID: toPointerString("Event0"),
+				},
+			},
+			want: want{
+				out: &model.RevertArticlePayload{
+					EventID:          toPointerString("Event1"),
+					ArticleID:        toPointerString("Article1"),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"unhappy_path:usecase-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewRevertArticleInDTO("Article1", "Event-1", "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockRevertArticle, input dto.RevertArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewRevertArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockRevertArticleConverter, from dto.RevertArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToRevertArticle(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.RevertArticleInput{
+					ArticleID:        "Article1",
+					ToEventID:        "Event-1",
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path:converter-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewRevertArticleInDTO("Article1", "Event-1", "Mutation1", ""),
+			setupMockUsecase: func(uc *musecase.MockRevertArticle, input dto.RevertArticleInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewRevertArticleInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.RevertArticleOutDTO{},
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockRevertArticleConverter, from dto.RevertArticleOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToRevertArticle(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errFailedToConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.RevertArticleInput{
+					ArticleID:        "Article1",
+					ToEventID:        "Event-1",
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				err: errFailedToConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := musecase.NewMockRevertArticle(ctrl)
+			tt.setupMockUsecase(uc, tt.updateArticleInDTO, tt.usecaseResult)
+
+			converter := mconverter.NewMockRevertArticleConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+
+			sut := tt.sut(NewResolver(NewUsecases(WithRevertArticleUsecase(uc)), NewConverters(WithRevertArticleConverter(converter))))
+			got, err := sut.RevertArticle(tt.args.ctx, tt.args.input)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("RevertArticle() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+type RevertArticleInputMatcher struct {
+	gomock.Matcher
+	expect dto.RevertArticleInDTO
+}
+
+func NewRevertArticleInputMatcher(expect dto.RevertArticleInDTO) gomock.Matcher {
+	return &RevertArticleInputMatcher{
+		expect: expect,
+	}
+}
+
+func (m *RevertArticleInputMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case dto.RevertArticleInDTO:
+		return cmp.Diff(x.ID(), m.expect.ID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ToEventID(), m.expect.ToEventID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ExpectedLastEventID(), m.expect.ExpectedLastEventID(), cmpOpts...) == ""
+	}
+	return false
+}
+
+func (m *RevertArticleInputMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
+
+func Test_mutationResolver_RenameTag(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input model.RenameTagInput
+	}
+	type want struct {
+		out *model.RenameTagPayload
+		err error
+	}
+	type usecaseResult struct {
+		out dto.RenameTagOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.RenameTagPayload
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *mutationResolver
+		updateArticleInDTO dto.RenameTagInDTO
+		setupMockUsecase   func(uc *musecase.MockRenameTag, input dto.RenameTagInDTO, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockRenameTagConverter, from dto.RenameTagOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errFailedToUsecase := errors.New("failed to usecase")
+	errFailedToConverter := errors.New("failed to converter")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewRenameTagInDTO("golang", "Go", "Mutation1"),
+			setupMockUsecase: func(uc *musecase.MockRenameTag, input dto.RenameTagInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewRenameTagInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewRenameTagOutDTO([]dto.RetaggedArticle{dto.NewRetaggedArticle("Event1", "Article1")}, "Mutation1"),
+			},
+			setupMockConverter: func(converter *mconverter.MockRenameTagConverter, from dto.RenameTagOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToRenameTag(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.RenameTagPayload{
+					Articles: []*model.RetaggedArticle{
+						{EventID: "Event1", ArticleID: "Article1"},
+					},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.RenameTagInput{
+					From:             "golang",
+					To:               "Go",
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				out: &model.RenameTagPayload{
+					Articles: []*model.RetaggedArticle{
+						{EventID: "Event1", ArticleID: "Article1"},
+					},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"unhappy_path:usecase-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewRenameTagInDTO("golang", "Go", ""),
+			setupMockUsecase: func(uc *musecase.MockRenameTag, input dto.RenameTagInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewRenameTagInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockRenameTagConverter, from dto.RenameTagOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToRenameTag(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.RenameTagInput{
+					From: "golang",
+					To:   "Go",
+				},
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path:converter-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewRenameTagInDTO("golang", "Go", ""),
+			setupMockUsecase: func(uc *musecase.MockRenameTag, input dto.RenameTagInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewRenameTagInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.RenameTagOutDTO{},
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockRenameTagConverter, from dto.RenameTagOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToRenameTag(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errFailedToConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.RenameTagInput{
+					From: "golang",
+					To:   "Go",
+				},
+			},
+			want: want{
+				err: errFailedToConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := musecase.NewMockRenameTag(ctrl)
+			tt.setupMockUsecase(uc, tt.updateArticleInDTO, tt.usecaseResult)
+
+			converter := mconverter.NewMockRenameTagConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+
+			sut := tt.sut(NewResolver(NewUsecases(WithRenameTagUsecase(uc)), NewConverters(WithRenameTagConverter(converter))))
+			got, err := sut.RenameTag(tt.args.ctx, tt.args.input)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("RenameTag() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+type RenameTagInputMatcher struct {
+	gomock.Matcher
+	expect dto.RenameTagInDTO
+}
+
+func NewRenameTagInputMatcher(expect dto.RenameTagInDTO) gomock.Matcher {
+	return &RenameTagInputMatcher{
+		expect: expect,
+	}
+}
+
+func (m *RenameTagInputMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case dto.RenameTagInDTO:
+		return cmp.Diff(x.From(), m.expect.From(), cmpOpts...) == "" &&
+			cmp.Diff(x.To(), m.expect.To(), cmpOpts...) == "" &&
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == ""
+	}
+	return false
+}
+
+func (m *RenameTagInputMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
+
+func Test_mutationResolver_MergeTags(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input model.MergeTagsInput
+	}
+	type want struct {
+		out *model.MergeTagsPayload
+		err error
+	}
+	type usecaseResult struct {
+		out dto.MergeTagsOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.MergeTagsPayload
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *mutationResolver
+		updateArticleInDTO dto.MergeTagsInDTO
+		setupMockUsecase   func(uc *musecase.MockMergeTags, input dto.MergeTagsInDTO, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockMergeTagsConverter, from dto.MergeTagsOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errFailedToUsecase := errors.New("failed to usecase")
+	errFailedToConverter := errors.New("failed to converter")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewMergeTagsInDTO([]string{"golang", "go"}, "Go", "Mutation1"),
+			setupMockUsecase: func(uc *musecase.MockMergeTags, input dto.MergeTagsInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewMergeTagsInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewMergeTagsOutDTO([]dto.RetaggedArticle{dto.NewRetaggedArticle("Event1", "Article1")}, "Mutation1"),
+			},
+			setupMockConverter: func(converter *mconverter.MockMergeTagsConverter, from dto.MergeTagsOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToMergeTags(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.MergeTagsPayload{
+					Articles: []*model.RetaggedArticle{
+						{EventID: "Event1", ArticleID: "Article1"},
+					},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.MergeTagsInput{
+					Sources:          []string{"golang", "go"},
+					Into:             "Go",
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				out: &model.MergeTagsPayload{
+					Articles: []*model.RetaggedArticle{
+						{EventID: "Event1", ArticleID: "Article1"},
+					},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"unhappy_path:usecase-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewMergeTagsInDTO([]string{"golang", "go"}, "Go", ""),
+			setupMockUsecase: func(uc *musecase.MockMergeTags, input dto.MergeTagsInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewMergeTagsInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockMergeTagsConverter, from dto.MergeTagsOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToMergeTags(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.MergeTagsInput{
+					Sources: []string{"golang", "go"},
+					Into:    "Go",
+				},
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path:converter-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewMergeTagsInDTO([]string{"golang", "go"}, "Go", ""),
+			setupMockUsecase: func(uc *musecase.MockMergeTags, input dto.MergeTagsInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewMergeTagsInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.MergeTagsOutDTO{},
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockMergeTagsConverter, from dto.MergeTagsOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToMergeTags(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errFailedToConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.MergeTagsInput{
+					Sources: []string{"golang", "go"},
+					Into:    "Go",
+				},
+			},
+			want: want{
+				err: errFailedToConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := musecase.NewMockMergeTags(ctrl)
+			tt.setupMockUsecase(uc, tt.updateArticleInDTO, tt.usecaseResult)
+
+			converter := mconverter.NewMockMergeTagsConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+
+			sut := tt.sut(NewResolver(NewUsecases(WithMergeTagsUsecase(uc)), NewConverters(WithMergeTagsConverter(converter))))
+			got, err := sut.MergeTags(tt.args.ctx, tt.args.input)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("MergeTags() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+type MergeTagsInputMatcher struct {
+	gomock.Matcher
+	expect dto.MergeTagsInDTO
+}
+
+func NewMergeTagsInputMatcher(expect dto.MergeTagsInDTO) gomock.Matcher {
+	return &MergeTagsInputMatcher{
+		expect: expect,
+	}
+}
+
+func (m *MergeTagsInputMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case dto.MergeTagsInDTO:
+		return cmp.Diff(x.Sources(), m.expect.Sources(), cmpOpts...) == "" &&
+			cmp.Diff(x.Into(), m.expect.Into(), cmpOpts...) == "" &&
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == ""
+	}
+	return false
+}
+
+func (m *MergeTagsInputMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
+
+func Test_mutationResolver_CreateSeries(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input model.CreateSeriesInput
+	}
+	type want struct {
+		out *model.CreateSeriesPayload
+		err error
+	}
+	type usecaseResult struct {
+		out dto.CreateSeriesOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.CreateSeriesPayload
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *mutationResolver
+		updateArticleInDTO dto.CreateSeriesInDTO
+		setupMockUsecase   func(uc *musecase.MockCreateSeries, input dto.CreateSeriesInDTO, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockCreateSeriesConverter, from dto.CreateSeriesOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errFailedToUsecase := errors.New("failed to usecase")
+	errFailedToConverter := errors.New("failed to converter")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewCreateSeriesInDTO("Go Tutorial", []string{"Article1", "Article2"}, "Mutation1"),
+			setupMockUsecase: func(uc *musecase.MockCreateSeries, input dto.CreateSeriesInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewCreateSeriesInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewCreateSeriesOutDTO("Series1", []dto.SeriesArticle{dto.NewSeriesArticle("Event1", "Article1")}, "Mutation1"),
+			},
+			setupMockConverter: func(converter *mconverter.MockCreateSeriesConverter, from dto.CreateSeriesOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToCreateSeries(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.CreateSeriesPayload{
+					SeriesID: toPointerString("Series1"),
+					Articles: []*model.SeriesArticle{
+						{EventID: "Event1", ArticleID: "Article1"},
+					},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.CreateSeriesInput{
+					Title:            "Go Tutorial",
+					ArticleIds:       []string{"Article1", "Article2"},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				out: &model.CreateSeriesPayload{
+					SeriesID: toPointerString("Series1"),
+					Articles: []*model.SeriesArticle{
+						{EventID: "Event1", ArticleID: "Article1"},
+					},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"unhappy_path:usecase-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewCreateSeriesInDTO("Go Tutorial", []string{"Article1", "Article2"}, ""),
+			setupMockUsecase: func(uc *musecase.MockCreateSeries, input dto.CreateSeriesInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewCreateSeriesInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockCreateSeriesConverter, from dto.CreateSeriesOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToCreateSeries(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.CreateSeriesInput{
+					Title:      "Go Tutorial",
+					ArticleIds: []string{"Article1", "Article2"},
+				},
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path:converter-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewCreateSeriesInDTO("Go Tutorial", []string{"Article1", "Article2"}, ""),
+			setupMockUsecase: func(uc *musecase.MockCreateSeries, input dto.CreateSeriesInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewCreateSeriesInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.CreateSeriesOutDTO{},
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockCreateSeriesConverter, from dto.CreateSeriesOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToCreateSeries(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errFailedToConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.CreateSeriesInput{
+					Title:      "Go Tutorial",
+					ArticleIds: []string{"Article1", "Article2"},
+				},
+			},
+			want: want{
+				err: errFailedToConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := musecase.NewMockCreateSeries(ctrl)
+			tt.setupMockUsecase(uc, tt.updateArticleInDTO, tt.usecaseResult)
+
+			converter := mconverter.NewMockCreateSeriesConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+
+			sut := tt.sut(NewResolver(NewUsecases(WithCreateSeriesUsecase(uc)), NewConverters(WithCreateSeriesConverter(converter))))
+			got, err := sut.CreateSeries(tt.args.ctx, tt.args.input)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("CreateSeries() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+type CreateSeriesInputMatcher struct {
+	gomock.Matcher
+	expect dto.CreateSeriesInDTO
+}
+
+func NewCreateSeriesInputMatcher(expect dto.CreateSeriesInDTO) gomock.Matcher {
+	return &CreateSeriesInputMatcher{
+		expect: expect,
+	}
+}
+
+func (m *CreateSeriesInputMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case dto.CreateSeriesInDTO:
+		return cmp.Diff(x.Title(), m.expect.Title(), cmpOpts...) == "" &&
+			cmp.Diff(x.ArticleIDs(), m.expect.ArticleIDs(), cmpOpts...) == "" &&
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == ""
+	}
+	return false
+}
+
+func (m *CreateSeriesInputMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
+
+func Test_mutationResolver_AddArticleToSeries(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input model.AddArticleToSeriesInput
+	}
+	type want struct {
+		out *model.AddArticleToSeriesPayload
+		err error
+	}
+	type usecaseResult struct {
+		out dto.AddArticleToSeriesOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.AddArticleToSeriesPayload
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *mutationResolver
+		updateArticleInDTO dto.AddArticleToSeriesInDTO
+		setupMockUsecase   func(uc *musecase.MockAddArticleToSeries, input dto.AddArticleToSeriesInDTO, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockAddArticleToSeriesConverter, from dto.AddArticleToSeriesOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errFailedToUsecase := errors.New("failed to usecase")
+	errFailedToConverter := errors.New("failed to converter")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewAddArticleToSeriesInDTO("Series1", "Article1", 1, "Mutation1"),
+			setupMockUsecase: func(uc *musecase.MockAddArticleToSeries, input dto.AddArticleToSeriesInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewAddArticleToSeriesInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewAddArticleToSeriesOutDTO("Series1", []dto.SeriesArticle{dto.NewSeriesArticle("Event1", "Article1")}, "Mutation1"),
+			},
+			setupMockConverter: func(converter *mconverter.MockAddArticleToSeriesConverter, from dto.AddArticleToSeriesOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToAddArticleToSeries(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.AddArticleToSeriesPayload{
+					SeriesID: toPointerString("Series1"),
+					Articles: []*model.SeriesArticle{
+						{EventID: "Event1", ArticleID: "Article1"},
+					},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.AddArticleToSeriesInput{
+					SeriesID:         "Series1",
+					ArticleID:        "Article1",
+					Position:         toPointerInt(1),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				out: &model.AddArticleToSeriesPayload{
+					SeriesID: toPointerString("Series1"),
+					Articles: []*model.SeriesArticle{
+						{EventID: "Event1", ArticleID: "Article1"},
+					},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"unhappy_path:usecase-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewAddArticleToSeriesInDTO("Series1", "Article1", 0, ""),
+			setupMockUsecase: func(uc *musecase.MockAddArticleToSeries, input dto.AddArticleToSeriesInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewAddArticleToSeriesInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockAddArticleToSeriesConverter, from dto.AddArticleToSeriesOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToAddArticleToSeries(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.AddArticleToSeriesInput{
+					SeriesID:  "Series1",
+					ArticleID: "Article1",
+				},
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path:converter-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewAddArticleToSeriesInDTO("Series1", "Article1", 0, ""),
+			setupMockUsecase: func(uc *musecase.MockAddArticleToSeries, input dto.AddArticleToSeriesInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewAddArticleToSeriesInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.AddArticleToSeriesOutDTO{},
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockAddArticleToSeriesConverter, from dto.AddArticleToSeriesOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToAddArticleToSeries(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errFailedToConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.AddArticleToSeriesInput{
+					SeriesID:  "Series1",
+					ArticleID: "Article1",
+				},
+			},
+			want: want{
+				err: errFailedToConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := musecase.NewMockAddArticleToSeries(ctrl)
+			tt.setupMockUsecase(uc, tt.updateArticleInDTO, tt.usecaseResult)
+
+			converter := mconverter.NewMockAddArticleToSeriesConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+
+			sut := tt.sut(NewResolver(NewUsecases(WithAddArticleToSeriesUsecase(uc)), NewConverters(WithAddArticleToSeriesConverter(converter))))
+			got, err := sut.AddArticleToSeries(tt.args.ctx, tt.args.input)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("AddArticleToSeries() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+type AddArticleToSeriesInputMatcher struct {
+	gomock.Matcher
+	expect dto.AddArticleToSeriesInDTO
+}
+
+func NewAddArticleToSeriesInputMatcher(expect dto.AddArticleToSeriesInDTO) gomock.Matcher {
+	return &AddArticleToSeriesInputMatcher{
+		expect: expect,
+	}
+}
+
+func (m *AddArticleToSeriesInputMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case dto.AddArticleToSeriesInDTO:
+		return cmp.Diff(x.SeriesID(), m.expect.SeriesID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ArticleID(), m.expect.ArticleID(), cmpOpts...) == "" &&
+			cmp.Diff(x.Position(), m.expect.Position(), cmpOpts...) == "" &&
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == ""
+	}
+	return false
+}
+
+func (m *AddArticleToSeriesInputMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
+
+func Test_mutationResolver_ReorderSeries(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input model.ReorderSeriesInput
+	}
+	type want struct {
+		out *model.ReorderSeriesPayload
+		err error
+	}
+	type usecaseResult struct {
+		out dto.ReorderSeriesOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.ReorderSeriesPayload
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *mutationResolver
+		updateArticleInDTO dto.ReorderSeriesInDTO
+		setupMockUsecase   func(uc *musecase.MockReorderSeries, input dto.ReorderSeriesInDTO, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockReorderSeriesConverter, from dto.ReorderSeriesOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errFailedToUsecase := errors.New("failed to usecase")
+	errFailedToConverter := errors.New("failed to converter")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewReorderSeriesInDTO("Series1", []string{"Article2", "Article1"}, "Mutation1"),
+			setupMockUsecase: func(uc *musecase.MockReorderSeries, input dto.ReorderSeriesInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewReorderSeriesInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewReorderSeriesOutDTO("Series1", []dto.SeriesArticle{dto.NewSeriesArticle("Event1", "Article1")}, "Mutation1"),
+			},
+			setupMockConverter: func(converter *mconverter.MockReorderSeriesConverter, from dto.ReorderSeriesOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToReorderSeries(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.ReorderSeriesPayload{
+					SeriesID: toPointerString("Series1"),
+					Articles: []*model.SeriesArticle{
+						{EventID: "Event1", ArticleID: "Article1"},
+					},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.ReorderSeriesInput{
+					SeriesID:         "Series1",
+					ArticleIds:       []string{"Article2", "Article1"},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				out: &model.ReorderSeriesPayload{
+					SeriesID: toPointerString("Series1"),
+					Articles: []*model.SeriesArticle{
+						{EventID: "Event1", ArticleID: "Article1"},
+					},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"unhappy_path:usecase-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewReorderSeriesInDTO("Series1", []string{"Article2", "Article1"}, ""),
+			setupMockUsecase: func(uc *musecase.MockReorderSeries, input dto.ReorderSeriesInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewReorderSeriesInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errFailedToUsecase,
+			},
+			setupMockConverter: func(converter *mconverter.MockReorderSeriesConverter, from dto.ReorderSeriesOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToReorderSeries(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.ReorderSeriesInput{
+					SeriesID:   "Series1",
+					ArticleIds: []string{"Article2", "Article1"},
+				},
+			},
+			want: want{
+				err: errFailedToUsecase,
+			},
+		},
+		"unhappy_path:converter-returns-error": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewReorderSeriesInDTO("Series1", []string{"Article2", "Article1"}, ""),
+			setupMockUsecase: func(uc *musecase.MockReorderSeries, input dto.ReorderSeriesInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewReorderSeriesInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.ReorderSeriesOutDTO{},
+				err: nil,
+			},
+			setupMockConverter: func(converter *mconverter.MockReorderSeriesConverter, from dto.ReorderSeriesOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToReorderSeries(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errFailedToConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.ReorderSeriesInput{
+					SeriesID:   "Series1",
+					ArticleIds: []string{"Article2", "Article1"},
+				},
+			},
+			want: want{
+				err: errFailedToConverter,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := musecase.NewMockReorderSeries(ctrl)
+			tt.setupMockUsecase(uc, tt.updateArticleInDTO, tt.usecaseResult)
+
+			converter := mconverter.NewMockReorderSeriesConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+
+			sut := tt.sut(NewResolver(NewUsecases(WithReorderSeriesUsecase(uc)), NewConverters(WithReorderSeriesConverter(converter))))
+			got, err := sut.ReorderSeries(tt.args.ctx, tt.args.input)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ReorderSeries() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+type ReorderSeriesInputMatcher struct {
+	gomock.Matcher
+	expect dto.ReorderSeriesInDTO
+}
+
+func NewReorderSeriesInputMatcher(expect dto.ReorderSeriesInDTO) gomock.Matcher {
+	return &ReorderSeriesInputMatcher{
+		expect: expect,
+	}
+}
+
+func (m *ReorderSeriesInputMatcher) Matches(x interface{}) bool {
+	switch x := x.(type) {
+	case dto.ReorderSeriesInDTO:
+		return cmp.Diff(x.SeriesID(), m.expect.SeriesID(), cmpOpts...) == "" &&
+			cmp.Diff(x.ArticleIDs(), m.expect.ArticleIDs(), cmpOpts...) == "" &&
+			cmp.Diff(x.ClientMutationID(), m.expect.ClientMutationID(), cmpOpts...) == ""
+	}
+	return false
+}
+
+func (m *ReorderSeriesInputMatcher) String() string {
+	return fmt.Sprintf("is equal to %+v", m.expect)
+}
+
+func Test_mutationResolver_UploadImage(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		input model.UploadImageInput
+	}
+	type want struct {
+		out *model.UploadImagePayload
+		err error
+	}
+	type usecaseResult struct {
+		out dto.UploadImageOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.UploadImagePayload
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *mutationResolver
+		updateArticleInDTO dto.UploadImageInDTO
+		setupMockUsecase   func(uc *musecase.MockUploadImage, input dto.UploadImageInDTO, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockUploadImageConverter, from dto.UploadImageOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+
+	errFailedToUsecase := errors.New("failed to usecase")
+	errFailedToConverter := errors.New("failed to converter")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewUploadImageInDTO(bytes.NewReader([]byte("abc")), "example.png", "image/png", false, "Mutation1"),
+			setupMockUsecase: func(uc *musecase.MockUploadImage, input dto.UploadImageInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewUploadImageInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewUploadImageOutDTO(utils.MustURLParse("https://example.com/example.png"), nil, false, "Mutation1"),
+			},
+			setupMockConverter: func(converter *mconverter.MockUploadImageConverter, from dto.UploadImageOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToUploadImage(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.UploadImagePayload{
+					ImageURL: func() *gqlscalar.URL {
+						v := gqlscalar.URL(utils.MustURLParse("https://example.com/example.png"))
+						return &v
+					}(),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.UploadImageInput{
+					Image: graphql.Upload{
+						Filename: "example.png",
+						File:     bytes.NewReader([]byte("abc")),
+					},
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			want: want{
+				out: &model.UploadImagePayload{
+					ImageURL: func() *gqlscalar.URL {
+						v := gqlscalar.URL(utils.MustURLParse("https://example.com/example.png"))
+						return &v
+					}(),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+		},
+		"happy_path:keep-metadata": {
+			sut: func(resolver *Resolver) *mutationResolver {
+				return &mutationResolver{resolver}
+			},
+			updateArticleInDTO: dto.NewUploadImageInDTO(bytes.NewReader([]byte("abc")), "example.png", "image/png", true, "Mutation1"),
+			setupMockUsecase: func(uc *musecase.MockUploadImage, input dto.UploadImageInDTO, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), NewUploadImageInputMatcher(input)).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewUploadImageOutDTO(utils.MustURLParse("https://example.com/example.png"), nil, false, "Mutation1"),
+			},
+			setupMockConverter: func(converter *mconverter.MockUploadImageConverter, from dto.UploadImageOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToUploadImage(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.UploadImagePayload{
+					ImageURL: func() *gqlscalar.URL {
+						v := gqlscalar.URL(utils.MustURLParse("https://example.com/example.png"))
+						return &v
+					}(),
+					ClientMutationID: toPointerString("Mutation1"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: model.UploadImageInput{
+					Image: graphql.Upload{
 						Filename: "example.png",
 						File:     bytes.NewReader([]byte("abc")),
 					},
+					KeepMetadata:     toPointerBool(true),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
 			want: want{
 				out: &model.UploadImagePayload{
-					ImageURL:         gqlscalar.URL(utils.MustURLParse("https://example.com/example.png")),
+					ImageURL: func() *gqlscalar.URL {
+						v := gqlscalar.URL(utils.MustURLParse("https://example.com/example.png"))
+						return &v
+					}(),
 					ClientMutationID: toPointerString("Mutation1"),
 				},
 			},
@@ -1279,7 +3750,7 @@ func Test_mutationResolver_UploadImage(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewUploadImageInDTO(bytes.NewReader([]byte("abc")), "example.png", "image/png", "Mutation1"),
+			updateArticleInDTO: dto.NewUploadImageInDTO(bytes.NewReader([]byte("abc")), "example.png", "image/png", false, "Mutation1"),
 			setupMockUsecase: func(uc *musecase.MockUploadImage, input dto.UploadImageInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewUploadImageInputMatcher(input)).
@@ -1312,7 +3783,7 @@ func Test_mutationResolver_UploadImage(t *testing.T) {
 			sut: func(resolver *Resolver) *mutationResolver {
 				return &mutationResolver{resolver}
 			},
-			updateArticleInDTO: dto.NewUploadImageInDTO(bytes.NewReader([]byte("abc")), "example.png", "image/png", "Mutation1"),
+			updateArticleInDTO: dto.NewUploadImageInDTO(bytes.NewReader([]byte("abc")), "example.png", "image/png", false, "Mutation1"),
 			setupMockUsecase: func(uc *musecase.MockUploadImage, input dto.UploadImageInDTO, usecaseResult usecaseResult) {
 				uc.EXPECT().
 					Execute(gomock.Any(), NewUploadImageInputMatcher(input)).
@@ -1393,6 +3864,9 @@ func (m *UploadImageInputMatcher) Matches(x interface{}) bool {
 		if x.ClientMutationID() != m.expect.ClientMutationID() {
 			return false
 		}
+		if x.KeepMetadata() != m.expect.KeepMetadata() {
+			return false
+		}
 		expectBody, err := io.ReadAll(m.expect.Data())
 		if err != nil {
 			return false
@@ -0,0 +1,210 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.70
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"blogapi.miyamo.today/core/log"
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"blogapi.miyamo.today/federator/internal/if-adapter/presenters/graphql/model"
+	"connectrpc.com/connect"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/miyamo2/altnrslog"
+	"github.com/newrelic/go-agent/v3/integrations/nrpkgerrors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+// History is the resolver for the history field.
+func (r *articleNodeResolver) History(ctx context.Context, obj *model.ArticleNode, first *int, after *string) (*model.ArticleHistoryConnection, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("History").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters",
+			slog.String("id", obj.ID),
+			slog.Any("first", first),
+			slog.Any("after", after)))
+	var (
+		f int
+		a string
+	)
+	if first != nil {
+		f = *first
+	}
+	if after != nil {
+		a = *after
+	}
+	oDTO, err := r.usecases.articleHistory.Execute(ctx, dto.NewArticleHistoryInDTO(obj.ID, f, a))
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("*model.ArticleHistoryConnection", nil),
+				slog.Any("error", err)))
+		return nil, err
+	}
+	connection, err := r.converters.articleHistory.ToArticleHistory(ctx, oDTO)
+	if err != nil {
+		logger.InfoContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("*model.ArticleHistoryConnection", nil),
+				slog.Any("error", err)))
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, err
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.ArticleHistoryConnection", connection),
+			slog.Any("error", nil)))
+	return connection, nil
+}
+
+// Drafts is the resolver for the drafts field.
+func (r *queryResolver) Drafts(ctx context.Context) ([]*model.DraftNode, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Drafts").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN")
+	oDTO, err := r.usecases.drafts.Execute(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("[]*model.DraftNode", nil),
+				slog.Any("error", err)))
+		return nil, err
+	}
+	nodes, err := r.converters.drafts.ToDrafts(ctx, oDTO)
+	if err != nil {
+		logger.InfoContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("[]*model.DraftNode", nil),
+				slog.Any("error", err)))
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, err
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("[]*model.DraftNode", nodes),
+			slog.Any("error", nil)))
+	return nodes, nil
+}
+
+// Draft is the resolver for the draft field.
+func (r *queryResolver) Draft(ctx context.Context, id string) (*model.DraftNode, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Draft").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("id", id)))
+	oDTO, err := r.usecases.draft.Execute(ctx, dto.NewDraftInDTO(id))
+	if err != nil {
+		// published or unknown articles are not drafts, so the field resolves to null.
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			logger.InfoContext(ctx, "END",
+				slog.Group("returns",
+					slog.Any("*model.DraftNode", nil),
+					slog.Any("error", nil)))
+			return nil, nil
+		}
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("*model.DraftNode", nil),
+				slog.Any("error", err)))
+		return nil, err
+	}
+	node, err := r.converters.draft.ToDraft(ctx, oDTO)
+	if err != nil {
+		logger.InfoContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("*model.DraftNode", nil),
+				slog.Any("error", err)))
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, err
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.DraftNode", node),
+			slog.Any("error", nil)))
+	return node, nil
+}
+
+// ArticleAt is the resolver for the articleAt field.
+func (r *queryResolver) ArticleAt(ctx context.Context, id string, asOf string) (*model.ArticleSnapshotNode, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ArticleAt").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.String("id", id), slog.String("asOf", asOf)))
+	// asOf is a timestamp if it can be parsed as such, otherwise an event id.
+	option := dto.ArticleAtInWithEventID(asOf)
+	if t, err := time.Parse(time.RFC3339Nano, asOf); err == nil {
+		option = dto.ArticleAtInWithTimestamp(synchro.In[tz.UTC](t))
+	}
+	oDTO, err := r.usecases.articleAt.Execute(ctx, dto.NewArticleAtInDTO(id, option))
+	if err != nil {
+		// the article or the point of its history does not exist, so the field resolves to null.
+		if connect.CodeOf(err) == connect.CodeNotFound {
+			logger.InfoContext(ctx, "END",
+				slog.Group("returns",
+					slog.Any("*model.ArticleSnapshotNode", nil),
+					slog.Any("error", nil)))
+			return nil, nil
+		}
+		err = ErrorWithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger.WarnContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("*model.ArticleSnapshotNode", nil),
+				slog.Any("error", err)))
+		return nil, err
+	}
+	node, err := r.converters.articleAt.ToArticleAt(ctx, oDTO)
+	if err != nil {
+		logger.InfoContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("*model.ArticleSnapshotNode", nil),
+				slog.Any("error", err)))
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		return nil, err
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.ArticleSnapshotNode", node),
+			slog.Any("error", nil)))
+	return node, nil
+}
@@ -0,0 +1,792 @@
+package resolver
+
+import (
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"blogapi.miyamo.today/federator/internal/if-adapter/presenters/graphql/model"
+	mconverter "blogapi.miyamo.today/federator/internal/mock/if-adapter/controller/graphql/resolver/presenter/converter"
+	musecase "blogapi.miyamo.today/federator/internal/mock/if-adapter/controller/graphql/resolver/usecase"
+	"blogapi.miyamo.today/federator/internal/pkg/gqlscalar"
+	"blogapi.miyamo.today/federator/internal/utils"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func Test_queryResolver_Draft(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+	type want struct {
+		out *model.DraftNode
+		err error
+	}
+	type usecaseResult struct {
+		out dto.DraftOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.DraftNode
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *queryResolver
+		setupMockUsecase   func(uc *musecase.MockDraft, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockDraftConverter, from dto.DraftOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errAtConverter := errors.New("error at converter")
+	errAtUseCase := errors.New("error at usecase")
+	errNotFound := connect.NewError(connect.CodeNotFound, errors.New("not found"))
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockDraft, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), dto.NewDraftInDTO("Article1")).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewDraftOutDTO(
+					dto.NewDraft("Article1", "Title1", "Content1", utils.MustURLParse("example.com/example.png"), []string{"Tag1"}, "Event1")),
+			},
+			setupMockConverter: func(converter *mconverter.MockDraftConverter, from dto.DraftOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToDraft(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.DraftNode{
+					ID:           "Article1",
+					Title:        "Title1",
+					Content:      "Content1",
+					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					TagNames:     []string{"Tag1"},
+					LastEventID:  "Event1",
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "Article1",
+			},
+			want: want{
+				out: &model.DraftNode{
+					ID:           "Article1",
+					Title:        "Title1",
+					Content:      "Content1",
+					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					TagNames:     []string{"Tag1"},
+					LastEventID:  "Event1",
+				},
+			},
+		},
+		"happy_path/not_found": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockDraft, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errors.WithStack(errNotFound),
+			},
+			setupMockConverter: func(converter *mconverter.MockDraftConverter, from dto.DraftOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToDraft(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "Article1",
+			},
+			want: want{
+				out: nil,
+			},
+		},
+		"error_at_usecase": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockDraft, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errAtUseCase,
+			},
+			setupMockConverter: func(converter *mconverter.MockDraftConverter, from dto.DraftOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToDraft(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "Article1",
+			},
+			want: want{
+				out: nil,
+				err: errAtUseCase,
+			},
+		},
+		"error_at_converter": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockDraft, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewDraftOutDTO(
+					dto.NewDraft("Article1", "Title1", "Content1", utils.MustURLParse("example.com/example.png"), []string{"Tag1"}, "Event1")),
+			},
+			setupMockConverter: func(converter *mconverter.MockDraftConverter, from dto.DraftOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToDraft(gomock.Any(), gomock.Any()).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errAtConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  "Article1",
+			},
+			want: want{
+				out: nil,
+				err: errAtConverter,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			uc := musecase.NewMockDraft(ctrl)
+			tt.setupMockUsecase(uc, tt.usecaseResult)
+			converter := mconverter.NewMockDraftConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+			sut := tt.sut(NewResolver(NewUsecases(WithDraftUsecase(uc)), NewConverters(WithDraftConverter(converter))))
+			got, err := sut.Draft(tt.args.ctx, tt.args.id)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Draft() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func Test_queryResolver_ArticleAt(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		id   string
+		asOf string
+	}
+	type want struct {
+		out *model.ArticleSnapshotNode
+		err error
+	}
+	type usecaseResult struct {
+		out dto.ArticleAtOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.ArticleSnapshotNode
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *queryResolver
+		setupMockUsecase   func(uc *musecase.MockArticleAt, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockArticleAtConverter, from dto.ArticleAtOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errAtConverter := errors.New("error at converter")
+	errAtUseCase := errors.New("error at usecase")
+	errNotFound := connect.NewError(connect.CodeNotFound, errors.New("not found"))
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockArticleAt, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), dto.NewArticleAtInDTO("Article1", dto.ArticleAtInWithEventID("Event1"))).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewArticleAtOutDTO(
+					dto.NewArticleSnapshot("Article1", "Title1", "Content1", utils.MustURLParse("example.com/example.png"), []string{"Tag1"}, "Event1", synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0))),
+			},
+			setupMockConverter: func(converter *mconverter.MockArticleAtConverter, from dto.ArticleAtOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToArticleAt(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.ArticleSnapshotNode{
+					ID:           "Article1",
+					Title:        "Title1",
+					Content:      "Content1",
+					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					TagNames:     []string{"Tag1"},
+					EventID:      "Event1",
+					EventAt:      gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   "Article1",
+				asOf: "Event1",
+			},
+			want: want{
+				out: &model.ArticleSnapshotNode{
+					ID:           "Article1",
+					Title:        "Title1",
+					Content:      "Content1",
+					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					TagNames:     []string{"Tag1"},
+					EventID:      "Event1",
+					EventAt:      gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+				},
+			},
+		},
+		"happy_path/by_timestamp": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockArticleAt, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), dto.NewArticleAtInDTO("Article1", dto.ArticleAtInWithTimestamp(synchro.New[tz.UTC](2020, 1, 1, 9, 0, 0, 0)))).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewArticleAtOutDTO(
+					dto.NewArticleSnapshot("Article1", "Title1", "Content1", utils.MustURLParse("example.com/example.png"), []string{"Tag1"}, "Event1", synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0))),
+			},
+			setupMockConverter: func(converter *mconverter.MockArticleAtConverter, from dto.ArticleAtOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToArticleAt(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: &model.ArticleSnapshotNode{
+					ID:           "Article1",
+					Title:        "Title1",
+					Content:      "Content1",
+					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					TagNames:     []string{"Tag1"},
+					EventID:      "Event1",
+					EventAt:      gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+				},
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   "Article1",
+				asOf: "2020-01-01T18:00:00+09:00",
+			},
+			want: want{
+				out: &model.ArticleSnapshotNode{
+					ID:           "Article1",
+					Title:        "Title1",
+					Content:      "Content1",
+					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					TagNames:     []string{"Tag1"},
+					EventID:      "Event1",
+					EventAt:      gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+				},
+			},
+		},
+		"happy_path/not_found": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockArticleAt, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errors.WithStack(errNotFound),
+			},
+			setupMockConverter: func(converter *mconverter.MockArticleAtConverter, from dto.ArticleAtOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToArticleAt(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   "Article1",
+				asOf: "Event1",
+			},
+			want: want{
+				out: nil,
+			},
+		},
+		"error_at_usecase": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockArticleAt, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errAtUseCase,
+			},
+			setupMockConverter: func(converter *mconverter.MockArticleAtConverter, from dto.ArticleAtOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToArticleAt(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   "Article1",
+				asOf: "Event1",
+			},
+			want: want{
+				out: nil,
+				err: errAtUseCase,
+			},
+		},
+		"error_at_converter": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockArticleAt, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewArticleAtOutDTO(
+					dto.NewArticleSnapshot("Article1", "Title1", "Content1", utils.MustURLParse("example.com/example.png"), []string{"Tag1"}, "Event1", synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0))),
+			},
+			setupMockConverter: func(converter *mconverter.MockArticleAtConverter, from dto.ArticleAtOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToArticleAt(gomock.Any(), gomock.Any()).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errAtConverter,
+			},
+			args: args{
+				ctx:  context.Background(),
+				id:   "Article1",
+				asOf: "Event1",
+			},
+			want: want{
+				out: nil,
+				err: errAtConverter,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			uc := musecase.NewMockArticleAt(ctrl)
+			tt.setupMockUsecase(uc, tt.usecaseResult)
+			converter := mconverter.NewMockArticleAtConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+			sut := tt.sut(NewResolver(NewUsecases(WithArticleAtUsecase(uc)), NewConverters(WithArticleAtConverter(converter))))
+			got, err := sut.ArticleAt(tt.args.ctx, tt.args.id, tt.args.asOf)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ArticleAt() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func Test_queryResolver_Drafts(t *testing.T) {
+	type args struct {
+		ctx context.Context
+	}
+	type want struct {
+		out []*model.DraftNode
+		err error
+	}
+	type usecaseResult struct {
+		out dto.DraftsOutDTO
+		err error
+	}
+	type converterResult struct {
+		out []*model.DraftNode
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *queryResolver
+		setupMockUsecase   func(uc *musecase.MockDrafts, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockDraftsConverter, from dto.DraftsOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errAtConverter := errors.New("error at converter")
+	errAtUseCase := errors.New("error at usecase")
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockDrafts, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any()).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewDraftsOutDTO([]dto.Draft{
+					dto.NewDraft("Article1", "Title1", "Content1", utils.MustURLParse("example.com/example.png"), []string{"Tag1"}, "Event1"),
+				}),
+			},
+			setupMockConverter: func(converter *mconverter.MockDraftsConverter, from dto.DraftsOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToDrafts(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: []*model.DraftNode{
+					{
+						ID:           "Article1",
+						Title:        "Title1",
+						Content:      "Content1",
+						ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+						TagNames:     []string{"Tag1"},
+						LastEventID:  "Event1",
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				out: []*model.DraftNode{
+					{
+						ID:           "Article1",
+						Title:        "Title1",
+						Content:      "Content1",
+						ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+						TagNames:     []string{"Tag1"},
+						LastEventID:  "Event1",
+					},
+				},
+			},
+		},
+		"error_at_usecase": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockDrafts, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any()).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errAtUseCase,
+			},
+			setupMockConverter: func(converter *mconverter.MockDraftsConverter, from dto.DraftsOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToDrafts(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				out: nil,
+				err: errAtUseCase,
+			},
+		},
+		"error_at_converter": {
+			sut: func(resolver *Resolver) *queryResolver {
+				return &queryResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockDrafts, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any()).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: dto.NewDraftsOutDTO([]dto.Draft{}),
+			},
+			setupMockConverter: func(converter *mconverter.MockDraftsConverter, from dto.DraftsOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToDrafts(gomock.Any(), gomock.Any()).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errAtConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want: want{
+				out: nil,
+				err: errAtConverter,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			uc := musecase.NewMockDrafts(ctrl)
+			tt.setupMockUsecase(uc, tt.usecaseResult)
+			converter := mconverter.NewMockDraftsConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+			sut := tt.sut(NewResolver(NewUsecases(WithDraftsUsecase(uc)), NewConverters(WithDraftsConverter(converter))))
+			got, err := sut.Drafts(tt.args.ctx)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("Drafts() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func Test_articleNodeResolver_History(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		obj   *model.ArticleNode
+		first *int
+		after *string
+	}
+	type want struct {
+		out *model.ArticleHistoryConnection
+		err error
+	}
+	type usecaseResult struct {
+		out dto.ArticleHistoryOutDTO
+		err error
+	}
+	type converterResult struct {
+		out *model.ArticleHistoryConnection
+		err error
+	}
+	type testCase struct {
+		sut                func(resolver *Resolver) *articleNodeResolver
+		setupMockUsecase   func(uc *musecase.MockArticleHistory, usecaseResult usecaseResult)
+		usecaseResult      usecaseResult
+		setupMockConverter func(converter *mconverter.MockArticleHistoryConverter, from dto.ArticleHistoryOutDTO, converterResult converterResult)
+		converterResult    converterResult
+		args               args
+		want               want
+	}
+	errAtConverter := errors.New("error at converter")
+	errAtUseCase := errors.New("error at usecase")
+	first := 1
+	after := "Event1"
+	connection := &model.ArticleHistoryConnection{
+		Edges: []*model.ArticleEventEdge{
+			{
+				Cursor: "Event2",
+				Node: &model.ArticleEventNode{
+					ID:         "Event2",
+					Type:       model.ArticleEventTypeHideArticle,
+					OccurredAt: gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					Changes:    &model.ArticleEventChanges{},
+				},
+			},
+		},
+		PageInfo: &model.PageInfo{
+			StartCursor: "Event2",
+			EndCursor:   "Event2",
+		},
+		TotalCount: 2,
+	}
+	usecaseOut := dto.NewArticleHistoryOutDTO([]dto.ArticleEvent{
+		dto.NewArticleEvent("Event2", "HIDE_ARTICLE", synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+	}, false, 2)
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: func(resolver *Resolver) *articleNodeResolver {
+				return &articleNodeResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockArticleHistory, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), dto.NewArticleHistoryInDTO("Article1", 1, "Event1")).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: usecaseOut,
+			},
+			setupMockConverter: func(converter *mconverter.MockArticleHistoryConverter, from dto.ArticleHistoryOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToArticleHistory(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: connection,
+			},
+			args: args{
+				ctx:   context.Background(),
+				obj:   &model.ArticleNode{ID: "Article1"},
+				first: &first,
+				after: &after,
+			},
+			want: want{
+				out: connection,
+			},
+		},
+		"happy_path/without_paging": {
+			sut: func(resolver *Resolver) *articleNodeResolver {
+				return &articleNodeResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockArticleHistory, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), dto.NewArticleHistoryInDTO("Article1", 0, "")).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: usecaseOut,
+			},
+			setupMockConverter: func(converter *mconverter.MockArticleHistoryConverter, from dto.ArticleHistoryOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToArticleHistory(gomock.Any(), from).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				out: connection,
+			},
+			args: args{
+				ctx: context.Background(),
+				obj: &model.ArticleNode{ID: "Article1"},
+			},
+			want: want{
+				out: connection,
+			},
+		},
+		"error_at_usecase": {
+			sut: func(resolver *Resolver) *articleNodeResolver {
+				return &articleNodeResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockArticleHistory, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				err: errAtUseCase,
+			},
+			setupMockConverter: func(converter *mconverter.MockArticleHistoryConverter, from dto.ArticleHistoryOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToArticleHistory(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			args: args{
+				ctx: context.Background(),
+				obj: &model.ArticleNode{ID: "Article1"},
+			},
+			want: want{
+				out: nil,
+				err: errAtUseCase,
+			},
+		},
+		"error_at_converter": {
+			sut: func(resolver *Resolver) *articleNodeResolver {
+				return &articleNodeResolver{resolver}
+			},
+			setupMockUsecase: func(uc *musecase.MockArticleHistory, usecaseResult usecaseResult) {
+				uc.EXPECT().
+					Execute(gomock.Any(), gomock.Any()).
+					Return(usecaseResult.out, usecaseResult.err).
+					Times(1)
+			},
+			usecaseResult: usecaseResult{
+				out: usecaseOut,
+			},
+			setupMockConverter: func(converter *mconverter.MockArticleHistoryConverter, from dto.ArticleHistoryOutDTO, converterResult converterResult) {
+				converter.EXPECT().
+					ToArticleHistory(gomock.Any(), gomock.Any()).
+					Return(converterResult.out, converterResult.err).
+					Times(1)
+			},
+			converterResult: converterResult{
+				err: errAtConverter,
+			},
+			args: args{
+				ctx: context.Background(),
+				obj: &model.ArticleNode{ID: "Article1"},
+			},
+			want: want{
+				out: nil,
+				err: errAtConverter,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			uc := musecase.NewMockArticleHistory(ctrl)
+			tt.setupMockUsecase(uc, tt.usecaseResult)
+			converter := mconverter.NewMockArticleHistoryConverter(ctrl)
+			tt.setupMockConverter(converter, tt.usecaseResult.out, tt.converterResult)
+			sut := tt.sut(NewResolver(NewUsecases(WithArticleHistoryUsecase(uc)), NewConverters(WithArticleHistoryConverter(converter))))
+			got, err := sut.History(tt.args.ctx, tt.args.obj, tt.args.first, tt.args.after)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("History() got = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
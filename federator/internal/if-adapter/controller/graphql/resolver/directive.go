@@ -0,0 +1,21 @@
+package resolver
+
+import (
+	"blogapi.miyamo.today/core/echo/middlewares"
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cockroachdb/errors"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+)
+
+// ErrUnauthenticated is returned when a field marked with @isAuthenticated is requested without a verified token.
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// IsAuthenticated implements the @isAuthenticated directive.
+// It resolves the field only if the request carries a token verified by the auth middleware.
+func IsAuthenticated(ctx context.Context, _ any, next graphql.Resolver) (any, error) {
+	if _, ok := ctx.Value(middlewares.JWTContextKey{}).(jwt.Token); !ok {
+		return nil, newGraphQLError(ctx, ErrUnauthenticated, ErrorCodeUnauthenticated, "unauthenticated")
+	}
+	return next(ctx)
+}
@@ -0,0 +1,55 @@
+package resolver
+
+import (
+	"blogapi.miyamo.today/core/echo/middlewares"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"testing"
+)
+
+func TestIsAuthenticated(t *testing.T) {
+	tests := map[string]struct {
+		ctx          context.Context
+		wantResolved bool
+		wantCode     string
+	}{
+		"happy_path": {
+			ctx:          context.WithValue(context.Background(), middlewares.JWTContextKey{}, jwt.New()),
+			wantResolved: true,
+		},
+		"unhappy_path/no-token": {
+			ctx:      context.Background(),
+			wantCode: ErrorCodeUnauthenticated,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resolved := false
+			got, err := IsAuthenticated(tt.ctx, nil, func(ctx context.Context) (any, error) {
+				resolved = true
+				return "resolved", nil
+			})
+			if resolved != tt.wantResolved {
+				t.Fatalf("IsAuthenticated() resolved = %v, want %v", resolved, tt.wantResolved)
+			}
+			if tt.wantResolved {
+				if err != nil || got != "resolved" {
+					t.Errorf("IsAuthenticated() = (%v, %v), want (resolved, nil)", got, err)
+				}
+				return
+			}
+			var gqlErr *gqlerror.Error
+			if !errors.As(err, &gqlErr) {
+				t.Fatalf("IsAuthenticated() error = %T, want *gqlerror.Error", err)
+			}
+			if gqlErr.Extensions["code"] != tt.wantCode {
+				t.Errorf("IsAuthenticated() code = %v, want %v", gqlErr.Extensions["code"], tt.wantCode)
+			}
+			if !errors.Is(err, ErrUnauthenticated) {
+				t.Errorf("IsAuthenticated() error = %v, want %v", err, ErrUnauthenticated)
+			}
+		})
+	}
+}
@@ -1,12 +1,104 @@
 package resolver
 
-import "github.com/cockroachdb/errors"
+import (
+	"blogapi.miyamo.today/federator/internal/if-adapter/presenters/graphql/model"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/cockroachdb/errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
 
 var (
 	ErrFailedToConvertToArticleNode       = errors.New("failed to convert to article node")
 	ErrFailedToConvertToArticleConnection = errors.New("failed to convert to article connection")
+	ErrFailedToConvertToArticleSeries     = errors.New("failed to convert to article series")
+	ErrArticleIDOrSlugRequired            = errors.New("exactly one of id and slug is required")
 )
 
 func ErrorWithStack(err error) error {
 	return errors.WithStack(err)
 }
+
+// Values of extensions.code of the GraphQL errors returned from the resolvers.
+const (
+	ErrorCodeUnauthenticated      = "UNAUTHENTICATED"
+	ErrorCodeBadUserInput         = "BAD_USER_INPUT"
+	ErrorCodeNotFound             = "NOT_FOUND"
+	ErrorCodeConflict             = "CONFLICT"
+	ErrorCodeIdempotencyKeyReused = "IDEMPOTENCY_KEY_REUSED"
+	ErrorCodeUnavailable          = "UNAVAILABLE"
+	ErrorCodeInternal             = "INTERNAL_SERVER_ERROR"
+)
+
+// mutationError converts the error returned from the blogging event service to a GraphQL error with extensions.code.
+// Messages of unavailable and internal errors are not exposed to the client.
+// Invalid arguments should be returned as userErrors of the payload instead. See userErrorsOf.
+func mutationError(ctx context.Context, err error) error {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return newGraphQLError(ctx, err, ErrorCodeInternal, "internal server error")
+	}
+	switch connectErr.Code() {
+	case connect.CodeInvalidArgument:
+		return newGraphQLError(ctx, err, ErrorCodeBadUserInput, connectErr.Message())
+	case connect.CodeNotFound:
+		return newGraphQLError(ctx, err, ErrorCodeNotFound, "article not found")
+	case connect.CodeAborted:
+		return newGraphQLError(ctx, err, ErrorCodeConflict, connectErr.Message())
+	case connect.CodeAlreadyExists:
+		return newGraphQLError(ctx, err, ErrorCodeIdempotencyKeyReused, "clientMutationId was already used for a different input")
+	case connect.CodeUnavailable, connect.CodeDeadlineExceeded, connect.CodeResourceExhausted:
+		return newGraphQLError(ctx, err, ErrorCodeUnavailable, "service unavailable")
+	}
+	return newGraphQLError(ctx, err, ErrorCodeInternal, "internal server error")
+}
+
+// userErrorsOf returns the userErrors of the payload if the blogging event service rejected the input as invalid.
+// Each field violation becomes a userError of its field.
+// Without field violations, the input is rejected as a whole by a userError without field.
+func userErrorsOf(err error) ([]*model.UserError, bool) {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+		return nil, false
+	}
+	if violations := fieldViolations(connectErr); len(violations) > 0 {
+		return violations, true
+	}
+	return []*model.UserError{{Message: connectErr.Message()}}, true
+}
+
+// fieldViolations returns the field violations in the BadRequest details of the error.
+func fieldViolations(connectErr *connect.Error) []*model.UserError {
+	var userErrors []*model.UserError
+	for _, detail := range connectErr.Details() {
+		value, err := detail.Value()
+		if err != nil {
+			continue
+		}
+		badRequest, ok := value.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, v := range badRequest.GetFieldViolations() {
+			field := v.GetField()
+			userErrors = append(userErrors, &model.UserError{
+				Field:   &field,
+				Message: v.GetDescription(),
+			})
+		}
+	}
+	return userErrors
+}
+
+func newGraphQLError(ctx context.Context, err error, code, message string) *gqlerror.Error {
+	return &gqlerror.Error{
+		Err:     err,
+		Message: message,
+		Path:    graphql.GetPath(ctx),
+		Extensions: map[string]any{
+			"code": code,
+		},
+	}
+}
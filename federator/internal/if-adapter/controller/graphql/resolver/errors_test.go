@@ -0,0 +1,126 @@
+package resolver
+
+import (
+	"blogapi.miyamo.today/federator/internal/if-adapter/presenters/graphql/model"
+	"connectrpc.com/connect"
+	"context"
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"testing"
+)
+
+func Test_mutationError(t *testing.T) {
+	errCause := errors.New("cause")
+	tests := map[string]struct {
+		err         error
+		wantCode    string
+		wantMessage string
+	}{
+		"invalid-argument": {
+			err:         errors.WithStack(connect.NewError(connect.CodeInvalidArgument, errCause)),
+			wantCode:    ErrorCodeBadUserInput,
+			wantMessage: "cause",
+		},
+		"not-found": {
+			err:         connect.NewError(connect.CodeNotFound, errCause),
+			wantCode:    ErrorCodeNotFound,
+			wantMessage: "article not found",
+		},
+		"aborted": {
+			err:         connect.NewError(connect.CodeAborted, errCause),
+			wantCode:    ErrorCodeConflict,
+			wantMessage: "cause",
+		},
+		"already-exists": {
+			err:         connect.NewError(connect.CodeAlreadyExists, errCause),
+			wantCode:    ErrorCodeIdempotencyKeyReused,
+			wantMessage: "clientMutationId was already used for a different input",
+		},
+		"unavailable": {
+			err:         connect.NewError(connect.CodeUnavailable, errCause),
+			wantCode:    ErrorCodeUnavailable,
+			wantMessage: "service unavailable",
+		},
+		"internal": {
+			err:         connect.NewError(connect.CodeInternal, errCause),
+			wantCode:    ErrorCodeInternal,
+			wantMessage: "internal server error",
+		},
+		"not-connect-error": {
+			err:         errCause,
+			wantCode:    ErrorCodeInternal,
+			wantMessage: "internal server error",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := mutationError(context.Background(), tt.err)
+			var gqlErr *gqlerror.Error
+			if !errors.As(err, &gqlErr) {
+				t.Fatalf("mutationError() = %T, want *gqlerror.Error", err)
+			}
+			if got := gqlErr.Extensions["code"]; got != tt.wantCode {
+				t.Errorf("mutationError() code = %v, want %v", got, tt.wantCode)
+			}
+			if gqlErr.Message != tt.wantMessage {
+				t.Errorf("mutationError() message = %v, want %v", gqlErr.Message, tt.wantMessage)
+			}
+			if !errors.Is(err, errCause) {
+				t.Errorf("mutationError() = %v, want to wrap %v", err, errCause)
+			}
+		})
+	}
+}
+
+func Test_userErrorsOf(t *testing.T) {
+	errCause := errors.New("cause")
+	withFieldViolations := connect.NewError(connect.CodeInvalidArgument, errCause)
+	detail, err := connect.NewErrorDetail(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "tagNames[1]", Description: "tag name must not be empty"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create the error detail: %v", err)
+	}
+	withFieldViolations.AddDetail(detail)
+	tests := map[string]struct {
+		err            error
+		wantUserErrors []*model.UserError
+		wantOK         bool
+	}{
+		"invalid-argument": {
+			err: errors.WithStack(connect.NewError(connect.CodeInvalidArgument, errCause)),
+			wantUserErrors: []*model.UserError{
+				{Message: "cause"},
+			},
+			wantOK: true,
+		},
+		"invalid-argument-with-field-violations": {
+			err: errors.WithStack(withFieldViolations),
+			wantUserErrors: []*model.UserError{
+				{Field: toPointerString("tagNames[1]"), Message: "tag name must not be empty"},
+			},
+			wantOK: true,
+		},
+		"not-found": {
+			err: connect.NewError(connect.CodeNotFound, errCause),
+		},
+		"not-connect-error": {
+			err: errCause,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := userErrorsOf(tt.err)
+			if ok != tt.wantOK {
+				t.Errorf("userErrorsOf() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if diff := cmp.Diff(tt.wantUserErrors, got); diff != "" {
+				t.Errorf("userErrorsOf() (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package resolver
+
+import (
+	"blogapi.miyamo.today/federator/internal/app/usecase/dto"
+	"blogapi.miyamo.today/federator/internal/if-adapter/presenters/graphql/model"
+	"net/url"
+)
+
+// imageVariantDTOsFromInput converts model.ImageVariantInput to dto.ImageVariant.
+func imageVariantDTOsFromInput(from []*model.ImageVariantInput) []dto.ImageVariant {
+	if len(from) == 0 {
+		return nil
+	}
+	variants := make([]dto.ImageVariant, 0, len(from))
+	for _, v := range from {
+		variants = append(variants, dto.NewImageVariant(url.URL(v.URL), v.Width, v.Height, v.ContentType))
+	}
+	return variants
+}
@@ -20,6 +20,12 @@ type ArticlesConverter interface {
 	ToArticles(ctx context.Context, from dto.ArticlesOutDTO) (*model.ArticleConnection, bool)
 }
 
+// SeriesConverter is the converter for a series.
+type SeriesConverter interface {
+	// ToSeries converts a series. It returns nil if the article the series was looked up by is in none.
+	ToSeries(ctx context.Context, from dto.SeriesOutDTO) (*model.ArticleSeries, bool)
+}
+
 // TagConverter is the converter for a tag.
 type TagConverter interface {
 	// ToTag converts a tag.
@@ -44,6 +50,12 @@ type UpdateArticleTitleConverter interface {
 	ToUpdateArticleTitle(ctx context.Context, from dto.UpdateArticleTitleOutDTO) (*model.UpdateArticleTitlePayload, error)
 }
 
+// UpdateArticleSlugConverter is the converter for updating an article slug.
+type UpdateArticleSlugConverter interface {
+	// ToUpdateArticleSlug converts updating an article slug.
+	ToUpdateArticleSlug(ctx context.Context, from dto.UpdateArticleSlugOutDTO) (*model.UpdateArticleSlugPayload, error)
+}
+
 // UpdateArticleBodyConverter is the converter for updating an article body.
 type UpdateArticleBodyConverter interface {
 	// ToUpdateArticleBody converts updating an article body.
@@ -68,6 +80,96 @@ type DetachTagsConverter interface {
 	ToDetachTags(ctx context.Context, from dto.DetachTagsOutDTO) (*model.DetachTagsPayload, error)
 }
 
+// HideArticleConverter is the converter for hiding an article.
+type HideArticleConverter interface {
+	// ToHideArticle converts hiding an article.
+	ToHideArticle(ctx context.Context, from dto.HideArticleOutDTO) (*model.HideArticlePayload, error)
+}
+
+// UnhideArticleConverter is the converter for unhiding an article.
+type UnhideArticleConverter interface {
+	// ToUnhideArticle converts unhiding an article.
+	ToUnhideArticle(ctx context.Context, from dto.UnhideArticleOutDTO) (*model.UnhideArticlePayload, error)
+}
+
+// EditArticleConverter is the converter for editing an article.
+type EditArticleConverter interface {
+	// ToEditArticle converts editing an article.
+	ToEditArticle(ctx context.Context, from dto.EditArticleOutDTO) (*model.EditArticlePayload, error)
+}
+
+// ScheduleArticleConverter is the converter for scheduling an article.
+type ScheduleArticleConverter interface {
+	// ToScheduleArticle converts scheduling an article.
+	ToScheduleArticle(ctx context.Context, from dto.ScheduleArticleOutDTO) (*model.ScheduleArticlePayload, error)
+}
+
+// PublishArticleConverter is the converter for publishing a draft article.
+type PublishArticleConverter interface {
+	// ToPublishArticle converts publishing a draft article.
+	ToPublishArticle(ctx context.Context, from dto.PublishArticleOutDTO) (*model.PublishArticlePayload, error)
+}
+
+// RevertArticleConverter is the converter for restoring an article as it was right after an earlier event.
+type RevertArticleConverter interface {
+	// ToRevertArticle converts restoring an article as it was right after an earlier event.
+	ToRevertArticle(ctx context.Context, from dto.RevertArticleOutDTO) (*model.RevertArticlePayload, error)
+}
+
+// RenameTagConverter is the converter for renaming a tag on every article it is attached to.
+type RenameTagConverter interface {
+	// ToRenameTag converts renaming a tag on every article it is attached to.
+	ToRenameTag(ctx context.Context, from dto.RenameTagOutDTO) (*model.RenameTagPayload, error)
+}
+
+// MergeTagsConverter is the converter for merging tags into another one on every article they are attached to.
+type MergeTagsConverter interface {
+	// ToMergeTags converts merging tags into another one on every article they are attached to.
+	ToMergeTags(ctx context.Context, from dto.MergeTagsOutDTO) (*model.MergeTagsPayload, error)
+}
+
+// CreateSeriesConverter is the converter for creating a series of articles.
+type CreateSeriesConverter interface {
+	// ToCreateSeries converts creating a series of articles.
+	ToCreateSeries(ctx context.Context, from dto.CreateSeriesOutDTO) (*model.CreateSeriesPayload, error)
+}
+
+// AddArticleToSeriesConverter is the converter for adding an article to a series.
+type AddArticleToSeriesConverter interface {
+	// ToAddArticleToSeries converts adding an article to a series.
+	ToAddArticleToSeries(ctx context.Context, from dto.AddArticleToSeriesOutDTO) (*model.AddArticleToSeriesPayload, error)
+}
+
+// ReorderSeriesConverter is the converter for reordering a series.
+type ReorderSeriesConverter interface {
+	// ToReorderSeries converts reordering a series.
+	ToReorderSeries(ctx context.Context, from dto.ReorderSeriesOutDTO) (*model.ReorderSeriesPayload, error)
+}
+
+// DraftConverter is the converter for a draft article.
+type DraftConverter interface {
+	// ToDraft converts a draft article.
+	ToDraft(ctx context.Context, from dto.DraftOutDTO) (*model.DraftNode, error)
+}
+
+// DraftsConverter is the converter for draft articles.
+type DraftsConverter interface {
+	// ToDrafts converts draft articles.
+	ToDrafts(ctx context.Context, from dto.DraftsOutDTO) ([]*model.DraftNode, error)
+}
+
+// ArticleHistoryConverter is the converter for the events of an article.
+type ArticleHistoryConverter interface {
+	// ToArticleHistory converts the events of an article.
+	ToArticleHistory(ctx context.Context, from dto.ArticleHistoryOutDTO) (*model.ArticleHistoryConnection, error)
+}
+
+// ArticleAtConverter is the converter for an article as it was at a point of its history.
+type ArticleAtConverter interface {
+	// ToArticleAt converts an article as it was at a point of its history.
+	ToArticleAt(ctx context.Context, from dto.ArticleAtOutDTO) (*model.ArticleSnapshotNode, error)
+}
+
 // UploadImageConverter is the converter for uploading an image.
 type UploadImageConverter interface {
 	// ToUploadImage converts uploading an image.
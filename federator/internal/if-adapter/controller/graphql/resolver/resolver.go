@@ -17,14 +17,31 @@ type Resolver struct {
 type Usecases struct {
 	article                usecase.Article
 	articles               usecase.Articles
+	series                 usecase.Series
 	tag                    usecase.Tag
 	tags                   usecase.Tags
 	createArticle          usecase.CreateArticle
 	updateArticleTitle     usecase.UpdateArticleTitle
+	updateArticleSlug      usecase.UpdateArticleSlug
 	updateArticleBody      usecase.UpdateArticleBody
 	updateArticleThumbnail usecase.UpdateArticleThumbnail
 	attachTags             usecase.AttachTags
 	detachTags             usecase.DetachTags
+	hideArticle            usecase.HideArticle
+	unhideArticle          usecase.UnhideArticle
+	editArticle            usecase.EditArticle
+	scheduleArticle        usecase.ScheduleArticle
+	publishArticle         usecase.PublishArticle
+	revertArticle          usecase.RevertArticle
+	renameTag              usecase.RenameTag
+	mergeTags              usecase.MergeTags
+	createSeries           usecase.CreateSeries
+	addArticleToSeries     usecase.AddArticleToSeries
+	reorderSeries          usecase.ReorderSeries
+	draft                  usecase.Draft
+	drafts                 usecase.Drafts
+	articleHistory         usecase.ArticleHistory
+	articleAt              usecase.ArticleAt
 	uploadImage            usecase.UploadImage
 }
 
@@ -44,6 +61,13 @@ func WithArticlesUsecase(articles usecase.Articles) UsecasesOption {
 	}
 }
 
+// WithSeriesUsecase option for Usecases.
+func WithSeriesUsecase(series usecase.Series) UsecasesOption {
+	return func(u *Usecases) {
+		u.series = series
+	}
+}
+
 // WithTagUsecase option for Usecases.
 func WithTagUsecase(tag usecase.Tag) UsecasesOption {
 	return func(u *Usecases) {
@@ -72,6 +96,13 @@ func WithUpdateArticleTitleUsecase(updateArticleTitle usecase.UpdateArticleTitle
 	}
 }
 
+// WithUpdateArticleSlugUsecase option for Usecases.
+func WithUpdateArticleSlugUsecase(updateArticleSlug usecase.UpdateArticleSlug) UsecasesOption {
+	return func(u *Usecases) {
+		u.updateArticleSlug = updateArticleSlug
+	}
+}
+
 // WithUpdateArticleBodyUsecase option for Usecases.
 func WithUpdateArticleBodyUsecase(updateArticleBody usecase.UpdateArticleBody) UsecasesOption {
 	return func(u *Usecases) {
@@ -100,6 +131,111 @@ func WithDetachTagsUsecase(detachTags usecase.DetachTags) UsecasesOption {
 	}
 }
 
+// WithHideArticleUsecase option for Usecases.
+func WithHideArticleUsecase(hideArticle usecase.HideArticle) UsecasesOption {
+	return func(u *Usecases) {
+		u.hideArticle = hideArticle
+	}
+}
+
+// WithUnhideArticleUsecase option for Usecases.
+func WithUnhideArticleUsecase(unhideArticle usecase.UnhideArticle) UsecasesOption {
+	return func(u *Usecases) {
+		u.unhideArticle = unhideArticle
+	}
+}
+
+// WithEditArticleUsecase option for Usecases.
+func WithEditArticleUsecase(editArticle usecase.EditArticle) UsecasesOption {
+	return func(u *Usecases) {
+		u.editArticle = editArticle
+	}
+}
+
+// WithScheduleArticleUsecase option for Usecases.
+func WithScheduleArticleUsecase(scheduleArticle usecase.ScheduleArticle) UsecasesOption {
+	return func(u *Usecases) {
+		u.scheduleArticle = scheduleArticle
+	}
+}
+
+// WithPublishArticleUsecase option for Usecases.
+func WithPublishArticleUsecase(publishArticle usecase.PublishArticle) UsecasesOption {
+	return func(u *Usecases) {
+		u.publishArticle = publishArticle
+	}
+}
+
+// WithRevertArticleUsecase option for Usecases.
+func WithRevertArticleUsecase(revertArticle usecase.RevertArticle) UsecasesOption {
+	return func(u *Usecases) {
+		u.revertArticle = revertArticle
+	}
+}
+
+// WithRenameTagUsecase option for Usecases.
+func WithRenameTagUsecase(renameTag usecase.RenameTag) UsecasesOption {
+	return func(u *Usecases) {
+		u.renameTag = renameTag
+	}
+}
+
+// WithMergeTagsUsecase option for Usecases.
+func WithMergeTagsUsecase(mergeTags usecase.MergeTags) UsecasesOption {
+	return func(u *Usecases) {
+		u.mergeTags = mergeTags
+	}
+}
+
+// WithCreateSeriesUsecase option for Usecases.
+func WithCreateSeriesUsecase(createSeries usecase.CreateSeries) UsecasesOption {
+	return func(u *Usecases) {
+		u.createSeries = createSeries
+	}
+}
+
+// WithAddArticleToSeriesUsecase option for Usecases.
+func WithAddArticleToSeriesUsecase(addArticleToSeries usecase.AddArticleToSeries) UsecasesOption {
+	return func(u *Usecases) {
+		u.addArticleToSeries = addArticleToSeries
+	}
+}
+
+// WithReorderSeriesUsecase option for Usecases.
+func WithReorderSeriesUsecase(reorderSeries usecase.ReorderSeries) UsecasesOption {
+	return func(u *Usecases) {
+		u.reorderSeries = reorderSeries
+	}
+}
+
+// WithDraftUsecase option for Usecases.
+func WithDraftUsecase(draft usecase.Draft) UsecasesOption {
+	return func(u *Usecases) {
+		u.draft = draft
+	}
+}
+
+// WithDraftsUsecase option for Usecases.
+func WithDraftsUsecase(drafts usecase.Drafts) UsecasesOption {
+	return func(u *Usecases) {
+		u.drafts = drafts
+	}
+}
+
+// WithArticleHistoryUsecase option for Usecases.
+func WithArticleHistoryUsecase(articleHistory usecase.ArticleHistory) UsecasesOption {
+	return func(u *Usecases) {
+		u.articleHistory = articleHistory
+	}
+}
+
+// WithArticleAtUsecase option for Usecases.
+func WithArticleAtUsecase(articleAt usecase.ArticleAt) UsecasesOption {
+	return func(u *Usecases) {
+		u.articleAt = articleAt
+	}
+}
+
 // WithUploadImageUsecase option for Usecases.
 func WithUploadImageUsecase(uploadImage usecase.UploadImage) UsecasesOption {
 	return func(u *Usecases) {
@@ -119,14 +255,31 @@ func NewUsecases(options ...UsecasesOption) *Usecases {
 type Converters struct {
 	article                converters.ArticleConverter
 	articles               converters.ArticlesConverter
+	series                 converters.SeriesConverter
 	tag                    converters.TagConverter
 	tags                   converters.TagsConverter
 	createArticle          converters.CreateArticleConverter
 	updateArticleTitle     converters.UpdateArticleTitleConverter
+	updateArticleSlug      converters.UpdateArticleSlugConverter
 	updateArticleBody      converters.UpdateArticleBodyConverter
 	updateArticleThumbnail converters.UpdateArticleThumbnailConverter
 	attachTags             converters.AttachTagsConverter
 	detachTags             converters.DetachTagsConverter
+	hideArticle            converters.HideArticleConverter
+	unhideArticle          converters.UnhideArticleConverter
+	editArticle            converters.EditArticleConverter
+	scheduleArticle        converters.ScheduleArticleConverter
+	publishArticle         converters.PublishArticleConverter
+	revertArticle          converters.RevertArticleConverter
+	renameTag              converters.RenameTagConverter
+	mergeTags              converters.MergeTagsConverter
+	createSeries           converters.CreateSeriesConverter
+	addArticleToSeries     converters.AddArticleToSeriesConverter
+	reorderSeries          converters.ReorderSeriesConverter
+	draft                  converters.DraftConverter
+	drafts                 converters.DraftsConverter
+	articleHistory         converters.ArticleHistoryConverter
+	articleAt              converters.ArticleAtConverter
 	uploadImage            converters.UploadImageConverter
 }
 
@@ -146,6 +299,13 @@ func WithArticlesConverter(articles converters.ArticlesConverter) ConvertersOpti
 	}
 }
 
+// WithSeriesConverter option for Converters.
+func WithSeriesConverter(series converters.SeriesConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.series = series
+	}
+}
+
 // WithTagConverter option for Converters.
 func WithTagConverter(tag converters.TagConverter) ConvertersOption {
 	return func(c *Converters) {
@@ -174,6 +334,13 @@ func WithUpdateArticleTitleConverter(updateArticleTitle converters.UpdateArticle
 	}
 }
 
+// WithUpdateArticleSlugConverter option for Converters.
+func WithUpdateArticleSlugConverter(updateArticleSlug converters.UpdateArticleSlugConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.updateArticleSlug = updateArticleSlug
+	}
+}
+
 // WithUpdateArticleBodyConverter option for Converters.
 func WithUpdateArticleBodyConverter(updateArticleBody converters.UpdateArticleBodyConverter) ConvertersOption {
 	return func(c *Converters) {
@@ -202,6 +369,111 @@ func WithDetachTagsConverter(detachTags converters.DetachTagsConverter) Converte
 	}
 }
 
+// WithHideArticleConverter option for Converters.
+func WithHideArticleConverter(hideArticle converters.HideArticleConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.hideArticle = hideArticle
+	}
+}
+
+// WithUnhideArticleConverter option for Converters.
+func WithUnhideArticleConverter(unhideArticle converters.UnhideArticleConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.unhideArticle = unhideArticle
+	}
+}
+
+// WithEditArticleConverter option for Converters.
+func WithEditArticleConverter(editArticle converters.EditArticleConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.editArticle = editArticle
+	}
+}
+
+// WithScheduleArticleConverter option for Converters.
+func WithScheduleArticleConverter(scheduleArticle converters.ScheduleArticleConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.scheduleArticle = scheduleArticle
+	}
+}
+
+// WithPublishArticleConverter option for Converters.
+func WithPublishArticleConverter(publishArticle converters.PublishArticleConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.publishArticle = publishArticle
+	}
+}
+
+// WithRevertArticleConverter option for Converters.
+func WithRevertArticleConverter(revertArticle converters.RevertArticleConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.revertArticle = revertArticle
+	}
+}
+
+// WithRenameTagConverter option for Converters.
+func WithRenameTagConverter(renameTag converters.RenameTagConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.renameTag = renameTag
+	}
+}
+
+// WithMergeTagsConverter option for Converters.
+func WithMergeTagsConverter(mergeTags converters.MergeTagsConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.mergeTags = mergeTags
+	}
+}
+
+// WithCreateSeriesConverter option for Converters.
+func WithCreateSeriesConverter(createSeries converters.CreateSeriesConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.createSeries = createSeries
+	}
+}
+
+// WithAddArticleToSeriesConverter option for Converters.
+func WithAddArticleToSeriesConverter(addArticleToSeries converters.AddArticleToSeriesConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.addArticleToSeries = addArticleToSeries
+	}
+}
+
+// WithReorderSeriesConverter option for Converters.
+func WithReorderSeriesConverter(reorderSeries converters.ReorderSeriesConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.reorderSeries = reorderSeries
+	}
+}
+
+// WithDraftConverter option for Converters.
+func WithDraftConverter(draft converters.DraftConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.draft = draft
+	}
+}
+
+// WithDraftsConverter option for Converters.
+func WithDraftsConverter(drafts converters.DraftsConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.drafts = drafts
+	}
+}
+
+// WithArticleHistoryConverter option for Converters.
+func WithArticleHistoryConverter(articleHistory converters.ArticleHistoryConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.articleHistory = articleHistory
+	}
+}
+
+// WithArticleAtConverter option for Converters.
+func WithArticleAtConverter(articleAt converters.ArticleAtConverter) ConvertersOption {
+	return func(c *Converters) {
+		c.articleAt = articleAt
+	}
+}
+
 // WithUploadImageConverter option for Converters.
 func WithUploadImageConverter(uploadImage converters.UploadImageConverter) ConvertersOption {
 	return func(c *Converters) {
@@ -17,3 +17,9 @@ type Articles interface {
 	// Execute gets articles.
 	Execute(ctx context.Context, in dto.ArticlesInDTO) (dto.ArticlesOutDTO, error)
 }
+
+// Series is a use-case of getting a series by its id or by an article in it.
+type Series interface {
+	// Execute gets a series by its id or by an article in it.
+	Execute(ctx context.Context, in dto.SeriesInDTO) (dto.SeriesOutDTO, error)
+}
@@ -18,6 +18,12 @@ type UpdateArticleTitle interface {
 	Execute(ctx context.Context, in dto.UpdateArticleTitleInDTO) (dto.UpdateArticleTitleOutDTO, error)
 }
 
+// UpdateArticleSlug is the usecase for updating an article slug.
+type UpdateArticleSlug interface {
+	// Execute updates an article slug.
+	Execute(ctx context.Context, in dto.UpdateArticleSlugInDTO) (dto.UpdateArticleSlugOutDTO, error)
+}
+
 // UpdateArticleBody is the usecase for updating an article body.
 type UpdateArticleBody interface {
 	// Execute updates an article body.
@@ -42,6 +48,96 @@ type DetachTags interface {
 	Execute(ctx context.Context, in dto.DetachTagsInDTO) (dto.DetachTagsOutDTO, error)
 }
 
+// HideArticle is a use-case for hiding an article.
+type HideArticle interface {
+	// Execute hides an article.
+	Execute(ctx context.Context, in dto.HideArticleInDTO) (dto.HideArticleOutDTO, error)
+}
+
+// UnhideArticle is a use-case for unhiding an article.
+type UnhideArticle interface {
+	// Execute makes a hidden article visible again.
+	Execute(ctx context.Context, in dto.UnhideArticleInDTO) (dto.UnhideArticleOutDTO, error)
+}
+
+// EditArticle is a use-case for editing several fields of an article at once.
+type EditArticle interface {
+	// Execute edits an article.
+	Execute(ctx context.Context, in dto.EditArticleInDTO) (dto.EditArticleOutDTO, error)
+}
+
+// ScheduleArticle is a use-case for scheduling the publication of an article.
+type ScheduleArticle interface {
+	// Execute sets the time an article goes live.
+	Execute(ctx context.Context, in dto.ScheduleArticleInDTO) (dto.ScheduleArticleOutDTO, error)
+}
+
+// PublishArticle is a use-case for publishing a draft article.
+type PublishArticle interface {
+	// Execute publishes a draft article.
+	Execute(ctx context.Context, in dto.PublishArticleInDTO) (dto.PublishArticleOutDTO, error)
+}
+
+// RevertArticle is a use-case for restoring an article as it was right after an earlier event.
+type RevertArticle interface {
+	// Execute restores an article as it was right after an earlier event.
+	Execute(ctx context.Context, in dto.RevertArticleInDTO) (dto.RevertArticleOutDTO, error)
+}
+
+// RenameTag is a use-case for renaming a tag on every article it is attached to.
+type RenameTag interface {
+	// Execute renames a tag on every article it is attached to.
+	Execute(ctx context.Context, in dto.RenameTagInDTO) (dto.RenameTagOutDTO, error)
+}
+
+// MergeTags is a use-case for merging tags into another one on every article they are attached to.
+type MergeTags interface {
+	// Execute merges tags into another one on every article they are attached to.
+	Execute(ctx context.Context, in dto.MergeTagsInDTO) (dto.MergeTagsOutDTO, error)
+}
+
+// CreateSeries is a use-case for creating a series of articles.
+type CreateSeries interface {
+	// Execute creates a series of the articles, in the order they are given.
+	Execute(ctx context.Context, in dto.CreateSeriesInDTO) (dto.CreateSeriesOutDTO, error)
+}
+
+// AddArticleToSeries is a use-case for adding an article to a series.
+type AddArticleToSeries interface {
+	// Execute adds an article to a series, shifting the later parts back.
+	Execute(ctx context.Context, in dto.AddArticleToSeriesInDTO) (dto.AddArticleToSeriesOutDTO, error)
+}
+
+// ReorderSeries is a use-case for reordering a series.
+type ReorderSeries interface {
+	// Execute reorders a series to the order the articles are given.
+	Execute(ctx context.Context, in dto.ReorderSeriesInDTO) (dto.ReorderSeriesOutDTO, error)
+}
+
+// Draft is a use-case of getting a draft article by id.
+type Draft interface {
+	// Execute gets a draft article by id.
+	Execute(ctx context.Context, in dto.DraftInDTO) (dto.DraftOutDTO, error)
+}
+
+// Drafts is a use-case of listing draft articles.
+type Drafts interface {
+	// Execute lists draft articles.
+	Execute(ctx context.Context) (dto.DraftsOutDTO, error)
+}
+
+// ArticleHistory is a use-case of listing the events of an article.
+type ArticleHistory interface {
+	// Execute lists the events of an article.
+	Execute(ctx context.Context, in dto.ArticleHistoryInDTO) (dto.ArticleHistoryOutDTO, error)
+}
+
+// ArticleAt is a use-case of getting an article as it was at a point of its history.
+type ArticleAt interface {
+	// Execute gets an article as it was at a point of its history.
+	Execute(ctx context.Context, in dto.ArticleAtInDTO) (dto.ArticleAtOutDTO, error)
+}
+
 // UploadImage is a use-case for uploading an image.
 type UploadImage interface {
 	// Execute uploads an image.
@@ -16,6 +16,7 @@ import (
 
 var (
 	ErrFailedToConvertToTagNode = errors.New("failed to convert to tag node")
+	ErrUnknownArticleEventType  = errors.New("unknown article event type")
 )
 
 type Converter struct{}
@@ -37,10 +38,13 @@ func (c Converter) ToCreateArticle(ctx context.Context, from dto.CreateArticleOu
 	if v := from.ClientMutationID(); len(v) > 0 {
 		clientMutationID = &v
 	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
 	payload := model.CreateArticlePayload{
 		ClientMutationID: clientMutationID,
-		EventID:          from.EventID(),
-		ArticleID:        from.ArticleID(),
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("returns",
@@ -114,13 +118,23 @@ func (c Converter) articleNodeFromArticleTagDTO(ctx context.Context, from dto.Ar
 		TotalCount: len(tegs),
 	}
 	articleNode := model.ArticleNode{
-		ID:           from.ID(),
-		Title:        from.Title(),
-		Content:      from.Body(),
-		ThumbnailURL: gqlscalar.URL(from.ThumbnailURL()),
-		CreatedAt:    gqlscalar.UTC(from.CreatedAt()),
-		UpdatedAt:    gqlscalar.UTC(from.UpdatedAt()),
-		Tags:         &tagConnection,
+		ID:                from.ID(),
+		Title:             from.Title(),
+		Content:           from.Body(),
+		ThumbnailURL:      gqlscalar.URL(from.ThumbnailURL()),
+		ThumbnailVariants: imageVariantsFromDTO(from.ThumbnailVariants()),
+		CreatedAt:         gqlscalar.UTC(from.CreatedAt()),
+		UpdatedAt:         gqlscalar.UTC(from.UpdatedAt()),
+		Tags:              &tagConnection,
+	}
+	if slug := from.Slug(); slug != "" {
+		articleNode.Slug = &slug
+	}
+	if createdBy := from.CreatedBy(); createdBy != "" {
+		articleNode.CreatedBy = &createdBy
+	}
+	if updatedBy := from.UpdatedBy(); updatedBy != "" {
+		articleNode.UpdatedBy = &updatedBy
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("parameters",
@@ -129,6 +143,75 @@ func (c Converter) articleNodeFromArticleTagDTO(ctx context.Context, from dto.Ar
 	return &articleNode, nil
 }
 
+// ToSeries converts a series. It returns nil if the article the series was looked up by is in none.
+func (c Converter) ToSeries(ctx context.Context, from dto.SeriesOutDTO) (*model.ArticleSeries, bool) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToSeries").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	if from.ID() == "" {
+		logger.InfoContext(ctx, "END",
+			slog.Group("returns",
+				slog.Any("*model.ArticleSeries", nil),
+				slog.Any("error", nil)))
+		return nil, true
+	}
+	series := model.ArticleSeries{
+		ID:       from.ID(),
+		Title:    from.Title(),
+		Articles: make([]*model.ArticleNode, 0, len(from.Articles())),
+	}
+	for _, article := range from.Articles() {
+		node, err := c.articleNodeFromArticleTagDTO(ctx, article)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			logger.WarnContext(ctx, "END",
+				slog.Group("returns",
+					slog.Any("*model.ArticleSeries", nil),
+					slog.Any("error", err)))
+			return nil, false
+		}
+		series.Articles = append(series.Articles, node)
+	}
+	if previous := from.Previous(); previous != nil {
+		series.Previous, err = c.articleNodeFromArticleTagDTO(ctx, *previous)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			logger.WarnContext(ctx, "END",
+				slog.Group("returns",
+					slog.Any("*model.ArticleSeries", nil),
+					slog.Any("error", err)))
+			return nil, false
+		}
+	}
+	if next := from.Next(); next != nil {
+		series.Next, err = c.articleNodeFromArticleTagDTO(ctx, *next)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			logger.WarnContext(ctx, "END",
+				slog.Group("returns",
+					slog.Any("*model.ArticleSeries", nil),
+					slog.Any("error", err)))
+			return nil, false
+		}
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.ArticleSeries", series),
+			slog.Any("error", nil)))
+	return &series, true
+}
+
 func (c Converter) ToArticles(ctx context.Context, from dto.ArticlesOutDTO) (*model.ArticleConnection, bool) {
 	nrtx := newrelic.FromContext(ctx)
 	defer nrtx.StartSegment("ToArticles").End()
@@ -359,10 +442,13 @@ func (c Converter) ToUpdateArticleTitle(ctx context.Context, from dto.UpdateArti
 	if v := from.ClientMutationID(); len(v) > 0 {
 		clientMutationID = &v
 	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
 	payload := model.UpdateArticleTitlePayload{
 		ClientMutationID: clientMutationID,
-		EventID:          from.EventID(),
-		ArticleID:        from.ArticleID(),
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("returns",
@@ -371,6 +457,37 @@ func (c Converter) ToUpdateArticleTitle(ctx context.Context, from dto.UpdateArti
 	return &payload, nil
 }
 
+func (c Converter) ToUpdateArticleSlug(ctx context.Context, from dto.UpdateArticleSlugOutDTO) (*model.UpdateArticleSlugPayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToUpdateArticleSlug").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	var clientMutationID *string
+	if v := from.ClientMutationID(); len(v) > 0 {
+		clientMutationID = &v
+	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
+	payload := model.UpdateArticleSlugPayload{
+		ClientMutationID: clientMutationID,
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.UpdateArticleSlugPayload", payload),
+			slog.Any("error", nil)))
+	return &payload, nil
+}
+
 func (c Converter) ToUpdateArticleBody(ctx context.Context, from dto.UpdateArticleBodyOutDTO) (*model.UpdateArticleBodyPayload, error) {
 	nrtx := newrelic.FromContext(ctx)
 	defer nrtx.StartSegment("ToUpdateArticleBody").End()
@@ -387,10 +504,13 @@ func (c Converter) ToUpdateArticleBody(ctx context.Context, from dto.UpdateArtic
 	if v := from.ClientMutationID(); len(v) > 0 {
 		clientMutationID = &v
 	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
 	payload := model.UpdateArticleBodyPayload{
 		ClientMutationID: clientMutationID,
-		EventID:          from.EventID(),
-		ArticleID:        from.ArticleID(),
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("returns",
@@ -415,10 +535,13 @@ func (c Converter) ToUpdateArticleThumbnail(ctx context.Context, from dto.Update
 	if v := from.ClientMutationID(); len(v) > 0 {
 		clientMutationID = &v
 	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
 	payload := model.UpdateArticleThumbnailPayload{
 		ClientMutationID: clientMutationID,
-		EventID:          from.EventID(),
-		ArticleID:        from.ArticleID(),
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("returns",
@@ -443,10 +566,13 @@ func (c Converter) ToAttachTags(ctx context.Context, from dto.AttachTagsOutDTO)
 	if v := from.ClientMutationID(); len(v) > 0 {
 		clientMutationID = &v
 	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
 	payload := model.AttachTagsPayload{
 		ClientMutationID: clientMutationID,
-		EventID:          from.EventID(),
-		ArticleID:        from.ArticleID(),
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("returns",
@@ -471,10 +597,13 @@ func (c Converter) ToDetachTags(ctx context.Context, from dto.DetachTagsOutDTO)
 	if v := from.ClientMutationID(); len(v) > 0 {
 		clientMutationID = &v
 	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
 	payload := model.DetachTagsPayload{
 		ClientMutationID: clientMutationID,
-		EventID:          from.EventID(),
-		ArticleID:        from.ArticleID(),
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("returns",
@@ -483,6 +612,540 @@ func (c Converter) ToDetachTags(ctx context.Context, from dto.DetachTagsOutDTO)
 	return &payload, nil
 }
 
+func (c Converter) ToHideArticle(ctx context.Context, from dto.HideArticleOutDTO) (*model.HideArticlePayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToHideArticle").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	var clientMutationID *string
+	if v := from.ClientMutationID(); len(v) > 0 {
+		clientMutationID = &v
+	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
+	payload := model.HideArticlePayload{
+		ClientMutationID: clientMutationID,
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.HideArticlePayload", payload),
+			slog.Any("error", nil)))
+	return &payload, nil
+}
+
+func (c Converter) ToUnhideArticle(ctx context.Context, from dto.UnhideArticleOutDTO) (*model.UnhideArticlePayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToUnhideArticle").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	var clientMutationID *string
+	if v := from.ClientMutationID(); len(v) > 0 {
+		clientMutationID = &v
+	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
+	payload := model.UnhideArticlePayload{
+		ClientMutationID: clientMutationID,
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.UnhideArticlePayload", payload),
+			slog.Any("error", nil)))
+	return &payload, nil
+}
+
+func (c Converter) ToEditArticle(ctx context.Context, from dto.EditArticleOutDTO) (*model.EditArticlePayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToEditArticle").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	var clientMutationID *string
+	if v := from.ClientMutationID(); len(v) > 0 {
+		clientMutationID = &v
+	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
+	payload := model.EditArticlePayload{
+		ClientMutationID: clientMutationID,
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.EditArticlePayload", payload),
+			slog.Any("error", nil)))
+	return &payload, nil
+}
+
+func (c Converter) ToScheduleArticle(ctx context.Context, from dto.ScheduleArticleOutDTO) (*model.ScheduleArticlePayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToScheduleArticle").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	var clientMutationID *string
+	if v := from.ClientMutationID(); len(v) > 0 {
+		clientMutationID = &v
+	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
+	payload := model.ScheduleArticlePayload{
+		ClientMutationID: clientMutationID,
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.ScheduleArticlePayload", payload),
+			slog.Any("error", nil)))
+	return &payload, nil
+}
+
+func (c Converter) ToPublishArticle(ctx context.Context, from dto.PublishArticleOutDTO) (*model.PublishArticlePayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToPublishArticle").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	var clientMutationID *string
+	if v := from.ClientMutationID(); len(v) > 0 {
+		clientMutationID = &v
+	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
+	payload := model.PublishArticlePayload{
+		ClientMutationID: clientMutationID,
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.PublishArticlePayload", payload),
+			slog.Any("error", nil)))
+	return &payload, nil
+}
+
+func (c Converter) ToRevertArticle(ctx context.Context, from dto.RevertArticleOutDTO) (*model.RevertArticlePayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToRevertArticle").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	var clientMutationID *string
+	if v := from.ClientMutationID(); len(v) > 0 {
+		clientMutationID = &v
+	}
+	eventID := from.EventID()
+	articleID := from.ArticleID()
+	payload := model.RevertArticlePayload{
+		ClientMutationID: clientMutationID,
+		EventID:          &eventID,
+		ArticleID:        &articleID,
+		UserErrors:       []*model.UserError{},
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.RevertArticlePayload", payload),
+			slog.Any("error", nil)))
+	return &payload, nil
+}
+
+func (c Converter) ToRenameTag(ctx context.Context, from dto.RenameTagOutDTO) (*model.RenameTagPayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToRenameTag").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	var clientMutationID *string
+	if v := from.ClientMutationID(); len(v) > 0 {
+		clientMutationID = &v
+	}
+	payload := model.RenameTagPayload{
+		Articles:         retaggedArticlesFromDTO(from.Articles()),
+		ClientMutationID: clientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.RenameTagPayload", payload),
+			slog.Any("error", nil)))
+	return &payload, nil
+}
+
+func (c Converter) ToMergeTags(ctx context.Context, from dto.MergeTagsOutDTO) (*model.MergeTagsPayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToMergeTags").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	var clientMutationID *string
+	if v := from.ClientMutationID(); len(v) > 0 {
+		clientMutationID = &v
+	}
+	payload := model.MergeTagsPayload{
+		Articles:         retaggedArticlesFromDTO(from.Articles()),
+		ClientMutationID: clientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.MergeTagsPayload", payload),
+			slog.Any("error", nil)))
+	return &payload, nil
+}
+
+func (c Converter) ToCreateSeries(ctx context.Context, from dto.CreateSeriesOutDTO) (*model.CreateSeriesPayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToCreateSeries").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	var clientMutationID *string
+	if v := from.ClientMutationID(); len(v) > 0 {
+		clientMutationID = &v
+	}
+	seriesID := from.SeriesID()
+	payload := model.CreateSeriesPayload{
+		SeriesID:         &seriesID,
+		Articles:         seriesArticlesFromDTO(from.Articles()),
+		ClientMutationID: clientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.CreateSeriesPayload", payload),
+			slog.Any("error", nil)))
+	return &payload, nil
+}
+
+func (c Converter) ToAddArticleToSeries(ctx context.Context, from dto.AddArticleToSeriesOutDTO) (*model.AddArticleToSeriesPayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToAddArticleToSeries").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	var clientMutationID *string
+	if v := from.ClientMutationID(); len(v) > 0 {
+		clientMutationID = &v
+	}
+	seriesID := from.SeriesID()
+	payload := model.AddArticleToSeriesPayload{
+		SeriesID:         &seriesID,
+		Articles:         seriesArticlesFromDTO(from.Articles()),
+		ClientMutationID: clientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.AddArticleToSeriesPayload", payload),
+			slog.Any("error", nil)))
+	return &payload, nil
+}
+
+func (c Converter) ToReorderSeries(ctx context.Context, from dto.ReorderSeriesOutDTO) (*model.ReorderSeriesPayload, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToReorderSeries").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	var clientMutationID *string
+	if v := from.ClientMutationID(); len(v) > 0 {
+		clientMutationID = &v
+	}
+	seriesID := from.SeriesID()
+	payload := model.ReorderSeriesPayload{
+		SeriesID:         &seriesID,
+		Articles:         seriesArticlesFromDTO(from.Articles()),
+		ClientMutationID: clientMutationID,
+		UserErrors:       []*model.UserError{},
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.ReorderSeriesPayload", payload),
+			slog.Any("error", nil)))
+	return &payload, nil
+}
+
+// seriesArticlesFromDTO converts the articles whose position in a series was written.
+func seriesArticlesFromDTO(from []dto.SeriesArticle) []*model.SeriesArticle {
+	articles := make([]*model.SeriesArticle, 0, len(from))
+	for _, a := range from {
+		articles = append(articles, &model.SeriesArticle{
+			ArticleID: a.ArticleID(),
+			EventID:   a.EventID(),
+		})
+	}
+	return articles
+}
+
+// retaggedArticlesFromDTO converts the articles whose tags were rewritten.
+func retaggedArticlesFromDTO(from []dto.RetaggedArticle) []*model.RetaggedArticle {
+	articles := make([]*model.RetaggedArticle, 0, len(from))
+	for _, a := range from {
+		articles = append(articles, &model.RetaggedArticle{
+			ArticleID: a.ArticleID(),
+			EventID:   a.EventID(),
+		})
+	}
+	return articles
+}
+
+func (c Converter) ToDraft(ctx context.Context, from dto.DraftOutDTO) (*model.DraftNode, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToDraft").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	node := draftNodeFromDraftDTO(from.Draft())
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.DraftNode", node),
+			slog.Any("error", nil)))
+	return node, nil
+}
+
+func (c Converter) ToDrafts(ctx context.Context, from dto.DraftsOutDTO) ([]*model.DraftNode, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToDrafts").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	nodes := make([]*model.DraftNode, 0, len(from.Drafts()))
+	for _, draft := range from.Drafts() {
+		nodes = append(nodes, draftNodeFromDraftDTO(draft))
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("[]*model.DraftNode", nodes),
+			slog.Any("error", nil)))
+	return nodes, nil
+}
+
+// draftNodeFromDraftDTO converts dto.Draft to model.DraftNode.
+func draftNodeFromDraftDTO(from dto.Draft) *model.DraftNode {
+	tagNames := from.TagNames()
+	if tagNames == nil {
+		tagNames = []string{}
+	}
+	return &model.DraftNode{
+		ID:           from.ID(),
+		Title:        from.Title(),
+		Content:      from.Body(),
+		ThumbnailURL: gqlscalar.URL(from.ThumbnailURL()),
+		TagNames:     tagNames,
+		LastEventID:  from.LastEventID(),
+	}
+}
+
+func (c Converter) ToArticleHistory(ctx context.Context, from dto.ArticleHistoryOutDTO) (*model.ArticleHistoryConnection, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToArticleHistory").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	edges := make([]*model.ArticleEventEdge, 0, len(from.Events()))
+	for _, event := range from.Events() {
+		node, err := articleEventNodeFromArticleEventDTO(event)
+		if err != nil {
+			err = errors.WithStack(err)
+			nrtx.NoticeError(nrpkgerrors.Wrap(err))
+			logger.WarnContext(ctx, "END",
+				slog.Group("returns",
+					slog.Any("*model.ArticleHistoryConnection", nil),
+					slog.Any("error", err)))
+			return nil, err
+		}
+		edges = append(edges, &model.ArticleEventEdge{
+			Cursor: node.ID,
+			Node:   node,
+		})
+	}
+	hasNext := from.HasNext()
+	pageInfo := model.PageInfo{
+		HasNextPage: &hasNext,
+	}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = edges[0].Cursor
+		pageInfo.EndCursor = edges[len(edges)-1].Cursor
+	}
+	connection := model.ArticleHistoryConnection{
+		Edges:      edges,
+		PageInfo:   &pageInfo,
+		TotalCount: from.TotalCount(),
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.ArticleHistoryConnection", connection),
+			slog.Any("error", nil)))
+	return &connection, nil
+}
+
+// articleEventNodeFromArticleEventDTO converts dto.ArticleEvent to model.ArticleEventNode.
+func articleEventNodeFromArticleEventDTO(from dto.ArticleEvent) (*model.ArticleEventNode, error) {
+	eventType := model.ArticleEventType(from.EventType())
+	if !eventType.IsValid() {
+		return nil, errors.WithDetail(ErrUnknownArticleEventType, from.EventType())
+	}
+	changes := model.ArticleEventChanges{
+		Title:          from.Title(),
+		Content:        from.Body(),
+		TagNames:       from.TagNames(),
+		AttachTagNames: from.AttachTagNames(),
+		DetachTagNames: from.DetachTagNames(),
+		Invisible:      from.Invisible(),
+		Draft:          from.Draft(),
+		RevertedTo:     from.RevertedTo(),
+		Slug:           from.Slug(),
+		SeriesID:       from.SeriesID(),
+		SeriesTitle:    from.SeriesTitle(),
+		SeriesPosition: from.SeriesPosition(),
+	}
+	if v := from.ThumbnailURL(); v != nil {
+		thumbnailURL := gqlscalar.URL(*v)
+		changes.ThumbnailURL = &thumbnailURL
+	}
+	if v := from.PublishAt(); v != nil {
+		publishAt := gqlscalar.UTC(*v)
+		changes.PublishAt = &publishAt
+	}
+	return &model.ArticleEventNode{
+		ID:         from.ID(),
+		Type:       eventType,
+		OccurredAt: gqlscalar.UTC(from.OccurredAt()),
+		Changes:    &changes,
+		Actor:      from.Actor(),
+	}, nil
+}
+
+func (c Converter) ToArticleAt(ctx context.Context, from dto.ArticleAtOutDTO) (*model.ArticleSnapshotNode, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ToArticleAt").End()
+
+	logger, err := altnrslog.FromContext(ctx)
+	if err != nil {
+		err = errors.WithStack(err)
+		nrtx.NoticeError(nrpkgerrors.Wrap(err))
+		logger = log.DefaultLogger()
+	}
+	logger.InfoContext(ctx, "BEGIN",
+		slog.Group("parameters", slog.Any("from", from)))
+	article := from.Article()
+	tagNames := article.TagNames()
+	if tagNames == nil {
+		tagNames = []string{}
+	}
+	node := model.ArticleSnapshotNode{
+		ID:           article.ID(),
+		Title:        article.Title(),
+		Content:      article.Body(),
+		ThumbnailURL: gqlscalar.URL(article.ThumbnailURL()),
+		TagNames:     tagNames,
+		EventID:      article.EventID(),
+		EventAt:      gqlscalar.UTC(article.EventAt()),
+	}
+	logger.InfoContext(ctx, "END",
+		slog.Group("returns",
+			slog.Any("*model.ArticleSnapshotNode", node),
+			slog.Any("error", nil)))
+	return &node, nil
+}
+
 func (c Converter) ToUploadImage(ctx context.Context, from dto.UploadImageOutDTO) (*model.UploadImagePayload, error) {
 	nrtx := newrelic.FromContext(ctx)
 	defer nrtx.StartSegment("ToUploadImage").End()
@@ -499,9 +1162,15 @@ func (c Converter) ToUploadImage(ctx context.Context, from dto.UploadImageOutDTO
 	if v := from.ClientMutationID(); len(v) > 0 {
 		clientMutationID = &v
 	}
+	variants := imageVariantsFromDTO(from.Variants())
+	imageURL := gqlscalar.URL(from.ImageURL())
+	deduplicated := from.Deduplicated()
 	payload := model.UploadImagePayload{
-		ImageURL:         gqlscalar.URL(from.ImageURL()),
+		ImageURL:         &imageURL,
+		Variants:         variants,
+		Deduplicated:     &deduplicated,
 		ClientMutationID: clientMutationID,
+		UserErrors:       []*model.UserError{},
 	}
 	logger.InfoContext(ctx, "END",
 		slog.Group("returns",
@@ -510,6 +1179,20 @@ func (c Converter) ToUploadImage(ctx context.Context, from dto.UploadImageOutDTO
 	return &payload, nil
 }
 
+// imageVariantsFromDTO converts dto.ImageVariant to model.ImageVariant.
+func imageVariantsFromDTO(from []dto.ImageVariant) []*model.ImageVariant {
+	variants := make([]*model.ImageVariant, 0, len(from))
+	for _, v := range from {
+		variants = append(variants, &model.ImageVariant{
+			URL:         gqlscalar.URL(v.URL()),
+			Width:       v.Width(),
+			Height:      v.Height(),
+			ContentType: v.ContentType(),
+		})
+	}
+	return variants
+}
+
 func NewConverter() *Converter {
 	return &Converter{}
 }
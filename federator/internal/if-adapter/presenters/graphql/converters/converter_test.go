@@ -43,21 +43,26 @@ func TestConverter_ToArticle(t *testing.T) {
 						"happy_path/single_tag",
 						"## happy_path/single_tag",
 						utils.MustURLParse("example.com/example.png"),
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						[]dto.Tag{
 							dto.NewTag("Tag1", "Tag1"),
-						}),
+						},
+						"",
+						"",
+						""),
 				),
 			},
 			want: want{
 				&model.ArticleNode{
-					ID:           "Article1",
-					Title:        "happy_path/single_tag",
-					Content:      "## happy_path/single_tag",
-					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-					CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-					UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					ID:                "Article1",
+					Title:             "happy_path/single_tag",
+					Content:           "## happy_path/single_tag",
+					ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					ThumbnailVariants: []*model.ImageVariant{},
+					CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 					Tags: &model.ArticleTagConnection{
 						Edges: []*model.ArticleTagEdge{
 							{
@@ -88,21 +93,26 @@ func TestConverter_ToArticle(t *testing.T) {
 						"happy_path/multi_tag",
 						"## happy_path/multi_tag",
 						utils.MustURLParse("example.com/example.png"),
+						nil,
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						[]dto.Tag{
 							dto.NewTag("Tag1", "Tag1"),
 							dto.NewTag("Tag2", "Tag2"),
-						})),
+						},
+						"",
+						"",
+						"")),
 			},
 			want: want{
 				&model.ArticleNode{
-					ID:           "Article1",
-					Title:        "happy_path/multi_tag",
-					Content:      "## happy_path/multi_tag",
-					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-					CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-					UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					ID:                "Article1",
+					Title:             "happy_path/multi_tag",
+					Content:           "## happy_path/multi_tag",
+					ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					ThumbnailVariants: []*model.ImageVariant{},
+					CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 					Tags: &model.ArticleTagConnection{
 						Edges: []*model.ArticleTagEdge{
 							{
@@ -140,23 +150,148 @@ func TestConverter_ToArticle(t *testing.T) {
 						"happy_path/no_tag",
 						"## happy_path/no_tag",
 						utils.MustURLParse("example.com/example.png"),
+						nil,
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						[]dto.Tag{},
+						"",
+						"",
+						""),
+				),
+			},
+			want: want{
+				&model.ArticleNode{
+					ID:                "Article1",
+					Title:             "happy_path/no_tag",
+					Content:           "## happy_path/no_tag",
+					ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					ThumbnailVariants: []*model.ImageVariant{},
+					CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					Tags: &model.ArticleTagConnection{
+						Edges:    []*model.ArticleTagEdge{},
+						PageInfo: &model.PageInfo{},
+					},
+				},
+				true,
+			},
+		},
+		"happy_path/thumbnail_variants": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewArticleOutDTO(
+					dto.NewArticleTag(
+						"Article1",
+						"happy_path/thumbnail_variants",
+						"## happy_path/thumbnail_variants",
+						utils.MustURLParse("example.com/example.png"),
+						[]dto.ImageVariant{
+							dto.NewImageVariant(utils.MustURLParse("example.com/example-640w.webp"), 640, 480, "image/webp"),
+						},
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
-						[]dto.Tag{}),
+						[]dto.Tag{},
+						"",
+						"",
+						""),
 				),
 			},
 			want: want{
 				&model.ArticleNode{
 					ID:           "Article1",
-					Title:        "happy_path/no_tag",
-					Content:      "## happy_path/no_tag",
+					Title:        "happy_path/thumbnail_variants",
+					Content:      "## happy_path/thumbnail_variants",
 					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-					CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-					UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					ThumbnailVariants: []*model.ImageVariant{
+						{
+							URL:         gqlscalar.URL(utils.MustURLParse("example.com/example-640w.webp")),
+							Width:       640,
+							Height:      480,
+							ContentType: "image/webp",
+						},
+					},
+					CreatedAt: gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					UpdatedAt: gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					Tags: &model.ArticleTagConnection{
+						Edges:    []*model.ArticleTagEdge{},
+						PageInfo: &model.PageInfo{},
+					},
+				},
+				true,
+			},
+		},
+		"happy_path/slug": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewArticleOutDTO(
+					dto.NewArticleTag(
+						"Article1",
+						"happy_path/slug",
+						"## happy_path/slug",
+						utils.MustURLParse("example.com/example.png"),
+						nil,
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						[]dto.Tag{},
+						"hello-world",
+						"",
+						""),
+				),
+			},
+			want: want{
+				&model.ArticleNode{
+					ID:                "Article1",
+					Title:             "happy_path/slug",
+					Content:           "## happy_path/slug",
+					ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					ThumbnailVariants: []*model.ImageVariant{},
+					CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					Tags: &model.ArticleTagConnection{
+						Edges:    []*model.ArticleTagEdge{},
+						PageInfo: &model.PageInfo{},
+					},
+					Slug: utils.PtrFromString("hello-world"),
+				},
+				true,
+			},
+		},
+		"happy_path/actors": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewArticleOutDTO(
+					dto.NewArticleTag(
+						"Article1",
+						"happy_path/actors",
+						"## happy_path/actors",
+						utils.MustURLParse("example.com/example.png"),
+						nil,
+						synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+						synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0),
+						[]dto.Tag{},
+						"",
+						"author",
+						"editor"),
+				),
+			},
+			want: want{
+				&model.ArticleNode{
+					ID:                "Article1",
+					Title:             "happy_path/actors",
+					Content:           "## happy_path/actors",
+					ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					ThumbnailVariants: []*model.ImageVariant{},
+					CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0)),
 					Tags: &model.ArticleTagConnection{
 						Edges:    []*model.ArticleTagEdge{},
 						PageInfo: &model.PageInfo{},
 					},
+					CreatedBy: utils.PtrFromString("author"),
+					UpdatedBy: utils.PtrFromString("editor"),
 				},
 				true,
 			},
@@ -202,20 +337,25 @@ func TestConverter_articleNodeFromArticleTagDTO(t *testing.T) {
 					"happy_path/single_tag",
 					"## happy_path/single_tag",
 					utils.MustURLParse("example.com/example.png"),
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					[]dto.Tag{
 						dto.NewTag("Tag1", "Tag1"),
-					}),
+					},
+					"",
+					"",
+					""),
 			},
 			want: want{
 				out: &model.ArticleNode{
-					ID:           "Article1",
-					Title:        "happy_path/single_tag",
-					Content:      "## happy_path/single_tag",
-					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-					CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-					UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					ID:                "Article1",
+					Title:             "happy_path/single_tag",
+					Content:           "## happy_path/single_tag",
+					ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					ThumbnailVariants: []*model.ImageVariant{},
+					CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 					Tags: &model.ArticleTagConnection{
 						Edges: []*model.ArticleTagEdge{
 							{
@@ -244,21 +384,26 @@ func TestConverter_articleNodeFromArticleTagDTO(t *testing.T) {
 					"happy_path/multi_tag",
 					"## happy_path/multi_tag",
 					utils.MustURLParse("example.com/example.png"),
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					[]dto.Tag{
 						dto.NewTag("Tag1", "Tag1"),
 						dto.NewTag("Tag2", "Tag2"),
-					}),
+					},
+					"",
+					"",
+					""),
 			},
 			want: want{
 				out: &model.ArticleNode{
-					ID:           "Article1",
-					Title:        "happy_path/multi_tag",
-					Content:      "## happy_path/multi_tag",
-					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-					CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-					UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					ID:                "Article1",
+					Title:             "happy_path/multi_tag",
+					Content:           "## happy_path/multi_tag",
+					ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					ThumbnailVariants: []*model.ImageVariant{},
+					CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 					Tags: &model.ArticleTagConnection{
 						Edges: []*model.ArticleTagEdge{
 							{
@@ -294,18 +439,23 @@ func TestConverter_articleNodeFromArticleTagDTO(t *testing.T) {
 					"happy_path/no_tag",
 					"## happy_path/no_tag",
 					utils.MustURLParse("example.com/example.png"),
+					nil,
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 					synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
-					[]dto.Tag{}),
+					[]dto.Tag{},
+					"",
+					"",
+					""),
 			},
 			want: want{
 				out: &model.ArticleNode{
-					ID:           "Article1",
-					Title:        "happy_path/no_tag",
-					Content:      "## happy_path/no_tag",
-					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-					CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-					UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					ID:                "Article1",
+					Title:             "happy_path/no_tag",
+					Content:           "## happy_path/no_tag",
+					ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					ThumbnailVariants: []*model.ImageVariant{},
+					CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+					UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 					Tags: &model.ArticleTagConnection{
 						Edges:    []*model.ArticleTagEdge{},
 						PageInfo: &model.PageInfo{},
@@ -365,11 +515,15 @@ func TestConverter_ToArticles(t *testing.T) {
 							"happy_path/single_article/single_tag",
 							"## happy_path/single_article/single_tag",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
 							},
+							"",
+							"",
+							"",
 						),
 					},
 				),
@@ -381,12 +535,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article1",
 							Node: &model.ArticleNode{
-								ID:           "Article1",
-								Title:        "happy_path/single_article/single_tag",
-								Content:      "## happy_path/single_article/single_tag",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article1",
+								Title:             "happy_path/single_article/single_tag",
+								Content:           "## happy_path/single_article/single_tag",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -425,12 +580,16 @@ func TestConverter_ToArticles(t *testing.T) {
 							"happy_path/single_article/multi_tag",
 							"## happy_path/single_article/multi_tag",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
 								dto.NewTag("Tag2", "Tag2"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 				)},
 			want: want{
@@ -440,12 +599,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article1",
 							Node: &model.ArticleNode{
-								ID:           "Article1",
-								Title:        "happy_path/single_article/multi_tag",
-								Content:      "## happy_path/single_article/multi_tag",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article1",
+								Title:             "happy_path/single_article/multi_tag",
+								Content:           "## happy_path/single_article/multi_tag",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -491,21 +651,29 @@ func TestConverter_ToArticles(t *testing.T) {
 							"happy_path/multi_article/single_tag",
 							"## happy_path/multi_article/single_tag",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
-							}),
+							},
+							"",
+							"",
+							""),
 						dto.NewArticleTag(
 							"Article2",
 							"happy_path/multi_article/single_tag",
 							"## happy_path/multi_article/single_tag",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 				)},
 			want: want{
@@ -515,12 +683,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article1",
 							Node: &model.ArticleNode{
-								ID:           "Article1",
-								Title:        "happy_path/multi_article/single_tag",
-								Content:      "## happy_path/multi_article/single_tag",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article1",
+								Title:             "happy_path/multi_article/single_tag",
+								Content:           "## happy_path/multi_article/single_tag",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -542,12 +711,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article2",
 							Node: &model.ArticleNode{
-								ID:           "Article2",
-								Title:        "happy_path/multi_article/single_tag",
-								Content:      "## happy_path/multi_article/single_tag",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article2",
+								Title:             "happy_path/multi_article/single_tag",
+								Content:           "## happy_path/multi_article/single_tag",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -586,23 +756,31 @@ func TestConverter_ToArticles(t *testing.T) {
 							"happy_path/multi_article/multi_tag",
 							"## happy_path/multi_article/multi_tag",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
 								dto.NewTag("Tag2", "Tag2"),
-							}),
+							},
+							"",
+							"",
+							""),
 						dto.NewArticleTag(
 							"Article2",
 							"happy_path/multi_article/multi_tag",
 							"## happy_path/multi_article/multi_tag",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
 								dto.NewTag("Tag2", "Tag2"),
-							}),
+							},
+							"",
+							"",
+							""),
 					}),
 			},
 			want: want{
@@ -612,12 +790,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article1",
 							Node: &model.ArticleNode{
-								ID:           "Article1",
-								Title:        "happy_path/multi_article/multi_tag",
-								Content:      "## happy_path/multi_article/multi_tag",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article1",
+								Title:             "happy_path/multi_article/multi_tag",
+								Content:           "## happy_path/multi_article/multi_tag",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -646,12 +825,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article2",
 							Node: &model.ArticleNode{
-								ID:           "Article2",
-								Title:        "happy_path/multi_article/multi_tag",
-								Content:      "## happy_path/multi_article/multi_tag",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article2",
+								Title:             "happy_path/multi_article/multi_tag",
+								Content:           "## happy_path/multi_article/multi_tag",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -697,11 +877,15 @@ func TestConverter_ToArticles(t *testing.T) {
 							"happy_path/single_article/single_tag/with_next_paging",
 							"## happy_path/single_article/single_tag/with_next_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
 							},
+							"",
+							"",
+							"",
 						),
 					},
 					dto.ArticlesOutDTOWithHasNext(true),
@@ -714,12 +898,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article1",
 							Node: &model.ArticleNode{
-								ID:           "Article1",
-								Title:        "happy_path/single_article/single_tag/with_next_paging",
-								Content:      "## happy_path/single_article/single_tag/with_next_paging",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article1",
+								Title:             "happy_path/single_article/single_tag/with_next_paging",
+								Content:           "## happy_path/single_article/single_tag/with_next_paging",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -759,12 +944,16 @@ func TestConverter_ToArticles(t *testing.T) {
 							"happy_path/single_article/multi_tag/with_next_paging",
 							"## happy_path/single_article/multi_tag/with_next_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
 								dto.NewTag("Tag2", "Tag2"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 					dto.ArticlesOutDTOWithHasNext(true),
 				)},
@@ -775,12 +964,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article1",
 							Node: &model.ArticleNode{
-								ID:           "Article1",
-								Title:        "happy_path/single_article/multi_tag/with_next_paging",
-								Content:      "## happy_path/single_article/multi_tag/with_next_paging",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article1",
+								Title:             "happy_path/single_article/multi_tag/with_next_paging",
+								Content:           "## happy_path/single_article/multi_tag/with_next_paging",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -827,21 +1017,29 @@ func TestConverter_ToArticles(t *testing.T) {
 							"happy_path/multi_article/single_tag/with_next_paging",
 							"## happy_path/multi_article/single_tag/with_next_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
-							}),
+							},
+							"",
+							"",
+							""),
 						dto.NewArticleTag(
 							"Article2",
 							"happy_path/multi_article/single_tag/with_next_paging",
 							"## happy_path/multi_article/single_tag/with_next_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 					dto.ArticlesOutDTOWithHasNext(true),
 				)},
@@ -852,12 +1050,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article1",
 							Node: &model.ArticleNode{
-								ID:           "Article1",
-								Title:        "happy_path/multi_article/single_tag/with_next_paging",
-								Content:      "## happy_path/multi_article/single_tag/with_next_paging",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article1",
+								Title:             "happy_path/multi_article/single_tag/with_next_paging",
+								Content:           "## happy_path/multi_article/single_tag/with_next_paging",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -879,12 +1078,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article2",
 							Node: &model.ArticleNode{
-								ID:           "Article2",
-								Title:        "happy_path/multi_article/single_tag/with_next_paging",
-								Content:      "## happy_path/multi_article/single_tag/with_next_paging",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article2",
+								Title:             "happy_path/multi_article/single_tag/with_next_paging",
+								Content:           "## happy_path/multi_article/single_tag/with_next_paging",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -924,23 +1124,31 @@ func TestConverter_ToArticles(t *testing.T) {
 							"happy_path/multi_article/multi_tag/with_next_paging",
 							"## happy_path/multi_article/multi_tag/with_next_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
 								dto.NewTag("Tag2", "Tag2"),
-							}),
+							},
+							"",
+							"",
+							""),
 						dto.NewArticleTag(
 							"Article2",
 							"happy_path/multi_article/multi_tag/with_next_paging",
 							"## happy_path/multi_article/multi_tag/with_next_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
 								dto.NewTag("Tag2", "Tag2"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 					dto.ArticlesOutDTOWithHasNext(true)),
 			},
@@ -951,12 +1159,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article1",
 							Node: &model.ArticleNode{
-								ID:           "Article1",
-								Title:        "happy_path/multi_article/multi_tag/with_next_paging",
-								Content:      "## happy_path/multi_article/multi_tag/with_next_paging",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article1",
+								Title:             "happy_path/multi_article/multi_tag/with_next_paging",
+								Content:           "## happy_path/multi_article/multi_tag/with_next_paging",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -985,12 +1194,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article2",
 							Node: &model.ArticleNode{
-								ID:           "Article2",
-								Title:        "happy_path/multi_article/multi_tag/with_next_paging",
-								Content:      "## happy_path/multi_article/multi_tag/with_next_paging",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article2",
+								Title:             "happy_path/multi_article/multi_tag/with_next_paging",
+								Content:           "## happy_path/multi_article/multi_tag/with_next_paging",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -1037,11 +1247,15 @@ func TestConverter_ToArticles(t *testing.T) {
 							"happy_path/single_article/single_tag/with_prev_paging",
 							"## happy_path/single_article/single_tag/with_prev_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
 							},
+							"",
+							"",
+							"",
 						),
 					},
 					dto.ArticlesOutDTOWithHasPrev(true),
@@ -1054,12 +1268,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article1",
 							Node: &model.ArticleNode{
-								ID:           "Article1",
-								Title:        "happy_path/single_article/single_tag/with_prev_paging",
-								Content:      "## happy_path/single_article/single_tag/with_prev_paging",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article1",
+								Title:             "happy_path/single_article/single_tag/with_prev_paging",
+								Content:           "## happy_path/single_article/single_tag/with_prev_paging",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -1099,12 +1314,16 @@ func TestConverter_ToArticles(t *testing.T) {
 							"happy_path/single_article/multi_tag/with_prev_paging",
 							"## happy_path/single_article/multi_tag/with_prev_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
 								dto.NewTag("Tag2", "Tag2"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 					dto.ArticlesOutDTOWithHasPrev(true),
 				)},
@@ -1115,12 +1334,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article1",
 							Node: &model.ArticleNode{
-								ID:           "Article1",
-								Title:        "happy_path/single_article/multi_tag/with_prev_paging",
-								Content:      "## happy_path/single_article/multi_tag/with_prev_paging",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article1",
+								Title:             "happy_path/single_article/multi_tag/with_prev_paging",
+								Content:           "## happy_path/single_article/multi_tag/with_prev_paging",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -1167,21 +1387,29 @@ func TestConverter_ToArticles(t *testing.T) {
 							"happy_path/multi_article/single_tag/with_prev_paging",
 							"## happy_path/multi_article/single_tag/with_prev_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
-							}),
+							},
+							"",
+							"",
+							""),
 						dto.NewArticleTag(
 							"Article2",
 							"happy_path/multi_article/single_tag/with_prev_paging",
 							"## happy_path/multi_article/single_tag/with_prev_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 					dto.ArticlesOutDTOWithHasPrev(true),
 				)},
@@ -1192,12 +1420,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article1",
 							Node: &model.ArticleNode{
-								ID:           "Article1",
-								Title:        "happy_path/multi_article/single_tag/with_prev_paging",
-								Content:      "## happy_path/multi_article/single_tag/with_prev_paging",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article1",
+								Title:             "happy_path/multi_article/single_tag/with_prev_paging",
+								Content:           "## happy_path/multi_article/single_tag/with_prev_paging",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -1219,12 +1448,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article2",
 							Node: &model.ArticleNode{
-								ID:           "Article2",
-								Title:        "happy_path/multi_article/single_tag/with_prev_paging",
-								Content:      "## happy_path/multi_article/single_tag/with_prev_paging",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article2",
+								Title:             "happy_path/multi_article/single_tag/with_prev_paging",
+								Content:           "## happy_path/multi_article/single_tag/with_prev_paging",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -1264,23 +1494,31 @@ func TestConverter_ToArticles(t *testing.T) {
 							"happy_path/multi_article/multi_tag/with_prev_paging",
 							"## happy_path/multi_article/multi_tag/with_prev_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
 								dto.NewTag("Tag2", "Tag2"),
-							}),
+							},
+							"",
+							"",
+							""),
 						dto.NewArticleTag(
 							"Article2",
 							"happy_path/multi_article/multi_tag/with_prev_paging",
 							"## happy_path/multi_article/multi_tag/with_prev_paging",
 							utils.MustURLParse("example.com/example.png"),
+							nil,
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
 							[]dto.Tag{
 								dto.NewTag("Tag1", "Tag1"),
 								dto.NewTag("Tag2", "Tag2"),
-							}),
+							},
+							"",
+							"",
+							""),
 					},
 					dto.ArticlesOutDTOWithHasPrev(true)),
 			},
@@ -1291,12 +1529,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article1",
 							Node: &model.ArticleNode{
-								ID:           "Article1",
-								Title:        "happy_path/multi_article/multi_tag/with_prev_paging",
-								Content:      "## happy_path/multi_article/multi_tag/with_prev_paging",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article1",
+								Title:             "happy_path/multi_article/multi_tag/with_prev_paging",
+								Content:           "## happy_path/multi_article/multi_tag/with_prev_paging",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -1325,12 +1564,13 @@ func TestConverter_ToArticles(t *testing.T) {
 						{
 							Cursor: "Article2",
 							Node: &model.ArticleNode{
-								ID:           "Article2",
-								Title:        "happy_path/multi_article/multi_tag/with_prev_paging",
-								Content:      "## happy_path/multi_article/multi_tag/with_prev_paging",
-								ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
-								CreatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
-								UpdatedAt:    gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								ID:                "Article2",
+								Title:             "happy_path/multi_article/multi_tag/with_prev_paging",
+								Content:           "## happy_path/multi_article/multi_tag/with_prev_paging",
+								ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+								ThumbnailVariants: []*model.ImageVariant{},
+								CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
 								Tags: &model.ArticleTagConnection{
 									Edges: []*model.ArticleTagEdge{
 										{
@@ -1398,6 +1638,146 @@ func TestConverter_ToArticles(t *testing.T) {
 	}
 }
 
+func TestConverter_ToSeries(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.SeriesOutDTO
+	}
+	type want struct {
+		out *model.ArticleSeries
+		ok  bool
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	articleDTO := func(id string) dto.ArticleTag {
+		return dto.NewArticleTag(
+			id,
+			id,
+			"## "+id,
+			utils.MustURLParse("example.com/example.png"),
+			nil,
+			synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+			synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0),
+			[]dto.Tag{
+				dto.NewTag("Tag1", "Tag1"),
+			},
+			"",
+			"",
+			"")
+	}
+	articleNode := func(id string) *model.ArticleNode {
+		return &model.ArticleNode{
+			ID:                id,
+			Title:             id,
+			Content:           "## " + id,
+			ThumbnailURL:      gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+			ThumbnailVariants: []*model.ImageVariant{},
+			CreatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+			UpdatedAt:         gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+			Tags: &model.ArticleTagConnection{
+				Edges: []*model.ArticleTagEdge{
+					{
+						Cursor: "Tag1",
+						Node: &model.ArticleTagNode{
+							ID:   "Tag1",
+							Name: "Tag1",
+						},
+					},
+				},
+				PageInfo: &model.PageInfo{
+					StartCursor: "Tag1",
+					EndCursor:   "Tag1",
+				},
+				TotalCount: 1,
+			},
+		}
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewSeriesOutDTO(
+					"Series1",
+					"Go Tutorial",
+					[]dto.ArticleTag{
+						articleDTO("Article1"),
+						articleDTO("Article2"),
+					}),
+			},
+			want: want{
+				&model.ArticleSeries{
+					ID:    "Series1",
+					Title: "Go Tutorial",
+					Articles: []*model.ArticleNode{
+						articleNode("Article1"),
+						articleNode("Article2"),
+					},
+				},
+				true,
+			},
+		},
+		"happy_path/with_previous_and_next": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewSeriesOutDTO(
+					"Series1",
+					"Go Tutorial",
+					[]dto.ArticleTag{
+						articleDTO("Article1"),
+						articleDTO("Article2"),
+						articleDTO("Article3"),
+					},
+					dto.SeriesOutDTOWithPrevious(articleDTO("Article1")),
+					dto.SeriesOutDTOWithNext(articleDTO("Article3"))),
+			},
+			want: want{
+				&model.ArticleSeries{
+					ID:    "Series1",
+					Title: "Go Tutorial",
+					Articles: []*model.ArticleNode{
+						articleNode("Article1"),
+						articleNode("Article2"),
+						articleNode("Article3"),
+					},
+					Previous: articleNode("Article1"),
+					Next:     articleNode("Article3"),
+				},
+				true,
+			},
+		},
+		"happy_path/not_in_series": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.SeriesOutDTO{},
+			},
+			want: want{
+				nil,
+				true,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, ok := c.ToSeries(tt.args.ctx, tt.args.from)
+			if ok != tt.want.ok {
+				t.Errorf("ToSeries() ok = %v, want %v", ok, tt.want.ok)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
 func TestConverter_ToTag(t *testing.T) {
 	type args struct {
 		ctx  context.Context
@@ -2500,12 +2880,19 @@ func TestConverter_ToCreateArticle(t *testing.T) {
 			},
 			want: want{
 				out: &model.CreateArticlePayload{
-					EventID:   "event_id",
-					ArticleID: "article_id",
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
 					ClientMutationID: func() *string {
 						v := "client_mutation_id"
 						return &v
 					}(),
+					UserErrors: []*model.UserError{},
 				},
 			},
 		},
@@ -2549,12 +2936,19 @@ func TestConverter_ToUpdateArticle(t *testing.T) {
 			},
 			want: want{
 				out: &model.UpdateArticleTitlePayload{
-					ArticleID: "article_id",
-					EventID:   "event_id",
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
 					ClientMutationID: func() *string {
 						v := "client_mutation_id"
 						return &v
 					}(),
+					UserErrors: []*model.UserError{},
 				},
 			},
 		},
@@ -2598,12 +2992,19 @@ func TestConverter_ToUpdateArticleBody(t *testing.T) {
 			},
 			want: want{
 				out: &model.UpdateArticleBodyPayload{
-					ArticleID: "article_id",
-					EventID:   "event_id",
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
 					ClientMutationID: func() *string {
 						v := "client_mutation_id"
 						return &v
 					}(),
+					UserErrors: []*model.UserError{},
 				},
 			},
 		},
@@ -2624,6 +3025,62 @@ func TestConverter_ToUpdateArticleBody(t *testing.T) {
 	}
 }
 
+func TestConverter_ToUpdateArticleSlug(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.UpdateArticleSlugOutDTO
+	}
+	type want struct {
+		out *model.UpdateArticleSlugPayload
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewUpdateArticleSlugOutDTO("event_id", "article_id", "client_mutation_id"),
+			},
+			want: want{
+				out: &model.UpdateArticleSlugPayload{
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
+					ClientMutationID: func() *string {
+						v := "client_mutation_id"
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToUpdateArticleSlug(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToUpdateArticleSlug() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
 func TestConverter_ToUpdateArticleThumbnail(t *testing.T) {
 	type args struct {
 		ctx  context.Context
@@ -2647,12 +3104,19 @@ func TestConverter_ToUpdateArticleThumbnail(t *testing.T) {
 			},
 			want: want{
 				out: &model.UpdateArticleThumbnailPayload{
-					ArticleID: "article_id",
-					EventID:   "event_id",
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
 					ClientMutationID: func() *string {
 						v := "client_mutation_id"
 						return &v
 					}(),
+					UserErrors: []*model.UserError{},
 				},
 			},
 		},
@@ -2696,12 +3160,19 @@ func TestConverter_ToAttachTags(t *testing.T) {
 			},
 			want: want{
 				out: &model.AttachTagsPayload{
-					ArticleID: "article_id",
-					EventID:   "event_id",
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
 					ClientMutationID: func() *string {
 						v := "client_mutation_id"
 						return &v
 					}(),
+					UserErrors: []*model.UserError{},
 				},
 			},
 		},
@@ -2745,12 +3216,19 @@ func TestConverter_ToDetachTags(t *testing.T) {
 			},
 			want: want{
 				out: &model.DetachTagsPayload{
-					ArticleID: "article_id",
-					EventID:   "event_id",
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
 					ClientMutationID: func() *string {
 						v := "client_mutation_id"
 						return &v
 					}(),
+					UserErrors: []*model.UserError{},
 				},
 			},
 		},
@@ -2771,13 +3249,13 @@ func TestConverter_ToDetachTags(t *testing.T) {
 	}
 }
 
-func TestConverter_ToUploadImage(t *testing.T) {
+func TestConverter_ToHideArticle(t *testing.T) {
 	type args struct {
 		ctx  context.Context
-		from dto.UploadImageOutDTO
+		from dto.HideArticleOutDTO
 	}
 	type want struct {
-		out *model.UploadImagePayload
+		out *model.HideArticlePayload
 		err error
 	}
 	type testCase struct {
@@ -2790,15 +3268,1201 @@ func TestConverter_ToUploadImage(t *testing.T) {
 			sut: NewConverter,
 			args: args{
 				ctx:  context.Background(),
-				from: dto.NewUploadImageOutDTO(utils.MustURLParse("example.com/example.png"), "client_mutation_id"),
+				from: dto.NewHideArticleOutDTO("event_id", "article_id", "client_mutation_id"),
 			},
 			want: want{
-				out: &model.UploadImagePayload{
-					ImageURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+				out: &model.HideArticlePayload{
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
 					ClientMutationID: func() *string {
 						v := "client_mutation_id"
 						return &v
 					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToHideArticle(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToHideArticle() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToUnhideArticle(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.UnhideArticleOutDTO
+	}
+	type want struct {
+		out *model.UnhideArticlePayload
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewUnhideArticleOutDTO("event_id", "article_id", "client_mutation_id"),
+			},
+			want: want{
+				out: &model.UnhideArticlePayload{
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
+					ClientMutationID: func() *string {
+						v := "client_mutation_id"
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToUnhideArticle(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToUnhideArticle() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToEditArticle(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.EditArticleOutDTO
+	}
+	type want struct {
+		out *model.EditArticlePayload
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewEditArticleOutDTO("event_id", "article_id", "client_mutation_id"),
+			},
+			want: want{
+				out: &model.EditArticlePayload{
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
+					ClientMutationID: func() *string {
+						v := "client_mutation_id"
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToEditArticle(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToEditArticle() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToScheduleArticle(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.ScheduleArticleOutDTO
+	}
+	type want struct {
+		out *model.ScheduleArticlePayload
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewScheduleArticleOutDTO("event_id", "article_id", "client_mutation_id"),
+			},
+			want: want{
+				out: &model.ScheduleArticlePayload{
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
+					ClientMutationID: func() *string {
+						v := "client_mutation_id"
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToScheduleArticle(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToScheduleArticle() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToPublishArticle(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.PublishArticleOutDTO
+	}
+	type want struct {
+		out *model.PublishArticlePayload
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewPublishArticleOutDTO("event_id", "article_id", "client_mutation_id"),
+			},
+			want: want{
+				out: &model.PublishArticlePayload{
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
+					ClientMutationID: func() *string {
+						v := "client_mutation_id"
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToPublishArticle(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToPublishArticle() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToRevertArticle(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.RevertArticleOutDTO
+	}
+	type want struct {
+		out *model.RevertArticlePayload
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewRevertArticleOutDTO("event_id", "article_id", "client_mutation_id"),
+			},
+			want: want{
+				out: &model.RevertArticlePayload{
+					ArticleID: func() *string {
+						v := "article_id"
+						return &v
+					}(),
+					EventID: func() *string {
+						v := "event_id"
+						return &v
+					}(),
+					ClientMutationID: func() *string {
+						v := "client_mutation_id"
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToRevertArticle(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToRevertArticle() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToRenameTag(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.RenameTagOutDTO
+	}
+	type want struct {
+		out *model.RenameTagPayload
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewRenameTagOutDTO([]dto.RetaggedArticle{
+					dto.NewRetaggedArticle("event_id1", "article_id1"),
+					dto.NewRetaggedArticle("event_id2", "article_id2"),
+				}, "client_mutation_id"),
+			},
+			want: want{
+				out: &model.RenameTagPayload{
+					Articles: []*model.RetaggedArticle{
+						{ArticleID: "article_id1", EventID: "event_id1"},
+						{ArticleID: "article_id2", EventID: "event_id2"},
+					},
+					ClientMutationID: func() *string {
+						v := "client_mutation_id"
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+		"happy_path/without_client_mutation_id": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewRenameTagOutDTO([]dto.RetaggedArticle{dto.NewRetaggedArticle("event_id1", "article_id1")}, ""),
+			},
+			want: want{
+				out: &model.RenameTagPayload{
+					Articles: []*model.RetaggedArticle{
+						{ArticleID: "article_id1", EventID: "event_id1"},
+					},
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToRenameTag(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToRenameTag() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToMergeTags(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.MergeTagsOutDTO
+	}
+	type want struct {
+		out *model.MergeTagsPayload
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewMergeTagsOutDTO([]dto.RetaggedArticle{
+					dto.NewRetaggedArticle("event_id1", "article_id1"),
+					dto.NewRetaggedArticle("event_id2", "article_id2"),
+				}, "client_mutation_id"),
+			},
+			want: want{
+				out: &model.MergeTagsPayload{
+					Articles: []*model.RetaggedArticle{
+						{ArticleID: "article_id1", EventID: "event_id1"},
+						{ArticleID: "article_id2", EventID: "event_id2"},
+					},
+					ClientMutationID: func() *string {
+						v := "client_mutation_id"
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+		"happy_path/without_client_mutation_id": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewMergeTagsOutDTO([]dto.RetaggedArticle{dto.NewRetaggedArticle("event_id1", "article_id1")}, ""),
+			},
+			want: want{
+				out: &model.MergeTagsPayload{
+					Articles: []*model.RetaggedArticle{
+						{ArticleID: "article_id1", EventID: "event_id1"},
+					},
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToMergeTags(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToMergeTags() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToCreateSeries(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.CreateSeriesOutDTO
+	}
+	type want struct {
+		out *model.CreateSeriesPayload
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewCreateSeriesOutDTO("series_id", []dto.SeriesArticle{
+					dto.NewSeriesArticle("event_id1", "article_id1"),
+					dto.NewSeriesArticle("event_id2", "article_id2"),
+				}, "client_mutation_id"),
+			},
+			want: want{
+				out: &model.CreateSeriesPayload{
+					SeriesID: func() *string {
+						v := "series_id"
+						return &v
+					}(),
+					Articles: []*model.SeriesArticle{
+						{ArticleID: "article_id1", EventID: "event_id1"},
+						{ArticleID: "article_id2", EventID: "event_id2"},
+					},
+					ClientMutationID: func() *string {
+						v := "client_mutation_id"
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+		"happy_path/without_client_mutation_id": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewCreateSeriesOutDTO("series_id", []dto.SeriesArticle{dto.NewSeriesArticle("event_id1", "article_id1")}, ""),
+			},
+			want: want{
+				out: &model.CreateSeriesPayload{
+					SeriesID: func() *string {
+						v := "series_id"
+						return &v
+					}(),
+					Articles: []*model.SeriesArticle{
+						{ArticleID: "article_id1", EventID: "event_id1"},
+					},
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToCreateSeries(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToCreateSeries() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToAddArticleToSeries(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.AddArticleToSeriesOutDTO
+	}
+	type want struct {
+		out *model.AddArticleToSeriesPayload
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewAddArticleToSeriesOutDTO("series_id", []dto.SeriesArticle{
+					dto.NewSeriesArticle("event_id1", "article_id1"),
+					dto.NewSeriesArticle("event_id2", "article_id2"),
+				}, "client_mutation_id"),
+			},
+			want: want{
+				out: &model.AddArticleToSeriesPayload{
+					SeriesID: func() *string {
+						v := "series_id"
+						return &v
+					}(),
+					Articles: []*model.SeriesArticle{
+						{ArticleID: "article_id1", EventID: "event_id1"},
+						{ArticleID: "article_id2", EventID: "event_id2"},
+					},
+					ClientMutationID: func() *string {
+						v := "client_mutation_id"
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+		"happy_path/without_client_mutation_id": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewAddArticleToSeriesOutDTO("series_id", []dto.SeriesArticle{dto.NewSeriesArticle("event_id1", "article_id1")}, ""),
+			},
+			want: want{
+				out: &model.AddArticleToSeriesPayload{
+					SeriesID: func() *string {
+						v := "series_id"
+						return &v
+					}(),
+					Articles: []*model.SeriesArticle{
+						{ArticleID: "article_id1", EventID: "event_id1"},
+					},
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToAddArticleToSeries(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToAddArticleToSeries() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToReorderSeries(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.ReorderSeriesOutDTO
+	}
+	type want struct {
+		out *model.ReorderSeriesPayload
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewReorderSeriesOutDTO("series_id", []dto.SeriesArticle{
+					dto.NewSeriesArticle("event_id1", "article_id1"),
+					dto.NewSeriesArticle("event_id2", "article_id2"),
+				}, "client_mutation_id"),
+			},
+			want: want{
+				out: &model.ReorderSeriesPayload{
+					SeriesID: func() *string {
+						v := "series_id"
+						return &v
+					}(),
+					Articles: []*model.SeriesArticle{
+						{ArticleID: "article_id1", EventID: "event_id1"},
+						{ArticleID: "article_id2", EventID: "event_id2"},
+					},
+					ClientMutationID: func() *string {
+						v := "client_mutation_id"
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+		"happy_path/without_client_mutation_id": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewReorderSeriesOutDTO("series_id", []dto.SeriesArticle{dto.NewSeriesArticle("event_id1", "article_id1")}, ""),
+			},
+			want: want{
+				out: &model.ReorderSeriesPayload{
+					SeriesID: func() *string {
+						v := "series_id"
+						return &v
+					}(),
+					Articles: []*model.SeriesArticle{
+						{ArticleID: "article_id1", EventID: "event_id1"},
+					},
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToReorderSeries(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToReorderSeries() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToDraft(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.DraftOutDTO
+	}
+	type want struct {
+		out *model.DraftNode
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewDraftOutDTO(
+					dto.NewDraft("article_id", "title", "body", utils.MustURLParse("example.com/example.png"), []string{"tag"}, "event_id")),
+			},
+			want: want{
+				out: &model.DraftNode{
+					ID:           "article_id",
+					Title:        "title",
+					Content:      "body",
+					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					TagNames:     []string{"tag"},
+					LastEventID:  "event_id",
+				},
+			},
+		},
+		"happy_path/no-tags": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewDraftOutDTO(
+					dto.NewDraft("article_id", "title", "body", utils.MustURLParse("example.com/example.png"), nil, "event_id")),
+			},
+			want: want{
+				out: &model.DraftNode{
+					ID:           "article_id",
+					Title:        "title",
+					Content:      "body",
+					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					TagNames:     []string{},
+					LastEventID:  "event_id",
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToDraft(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToDraft() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToDrafts(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.DraftsOutDTO
+	}
+	type want struct {
+		out []*model.DraftNode
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewDraftsOutDTO([]dto.Draft{
+					dto.NewDraft("article_id2", "title2", "body2", utils.MustURLParse("example.com/example.png"), nil, "event_id2"),
+					dto.NewDraft("article_id1", "title1", "body1", utils.MustURLParse("example.com/example.png"), []string{"tag"}, "event_id1"),
+				}),
+			},
+			want: want{
+				out: []*model.DraftNode{
+					{
+						ID:           "article_id2",
+						Title:        "title2",
+						Content:      "body2",
+						ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+						TagNames:     []string{},
+						LastEventID:  "event_id2",
+					},
+					{
+						ID:           "article_id1",
+						Title:        "title1",
+						Content:      "body1",
+						ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+						TagNames:     []string{"tag"},
+						LastEventID:  "event_id1",
+					},
+				},
+			},
+		},
+		"happy_path/no-drafts": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewDraftsOutDTO(nil),
+			},
+			want: want{
+				out: []*model.DraftNode{},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToDrafts(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToDrafts() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToArticleHistory(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.ArticleHistoryOutDTO
+	}
+	type want struct {
+		out *model.ArticleHistoryConnection
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	title := "title"
+	invisible := true
+	actor := "editor"
+	revertedTo := "event_id1"
+	thumbnailURL := utils.MustURLParse("example.com/example.png")
+	publishAt := synchro.New[tz.UTC](2020, 1, 2, 0, 0, 0, 0)
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewArticleHistoryOutDTO([]dto.ArticleEvent{
+					dto.NewArticleEvent("event_id1", "CREATE_ARTICLE", synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0), dto.ArticleEventWithTitle(title), dto.ArticleEventWithThumbnailURL(thumbnailURL), dto.ArticleEventWithTagNames("tag")),
+					dto.NewArticleEvent("event_id2", "HIDE_ARTICLE", synchro.New[tz.UTC](2020, 1, 1, 1, 0, 0, 0), dto.ArticleEventWithInvisible(invisible), dto.ArticleEventWithActor(actor)),
+					dto.NewArticleEvent("event_id3", "SCHEDULE_ARTICLE", synchro.New[tz.UTC](2020, 1, 1, 2, 0, 0, 0), dto.ArticleEventWithPublishAt(publishAt)),
+					dto.NewArticleEvent("event_id4", "REVERT_ARTICLE", synchro.New[tz.UTC](2020, 1, 1, 3, 0, 0, 0), dto.ArticleEventWithTitle(title), dto.ArticleEventWithRevertedTo(revertedTo)),
+				}, true, 5),
+			},
+			want: want{
+				out: &model.ArticleHistoryConnection{
+					Edges: []*model.ArticleEventEdge{
+						{
+							Cursor: "event_id1",
+							Node: &model.ArticleEventNode{
+								ID:         "event_id1",
+								Type:       model.ArticleEventTypeCreateArticle,
+								OccurredAt: gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								Changes: &model.ArticleEventChanges{
+									Title: &title,
+									ThumbnailURL: func() *gqlscalar.URL {
+										v := gqlscalar.URL(thumbnailURL)
+										return &v
+									}(),
+									TagNames: []string{"tag"},
+								},
+							},
+						},
+						{
+							Cursor: "event_id2",
+							Node: &model.ArticleEventNode{
+								ID:         "event_id2",
+								Type:       model.ArticleEventTypeHideArticle,
+								OccurredAt: gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 1, 0, 0, 0)),
+								Changes: &model.ArticleEventChanges{
+									Invisible: &invisible,
+								},
+								Actor: &actor,
+							},
+						},
+						{
+							Cursor: "event_id3",
+							Node: &model.ArticleEventNode{
+								ID:         "event_id3",
+								Type:       model.ArticleEventTypeScheduleArticle,
+								OccurredAt: gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 2, 0, 0, 0)),
+								Changes: &model.ArticleEventChanges{
+									PublishAt: func() *gqlscalar.UTC {
+										v := gqlscalar.UTC(publishAt)
+										return &v
+									}(),
+								},
+							},
+						},
+						{
+							Cursor: "event_id4",
+							Node: &model.ArticleEventNode{
+								ID:         "event_id4",
+								Type:       model.ArticleEventTypeRevertArticle,
+								OccurredAt: gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 3, 0, 0, 0)),
+								Changes: &model.ArticleEventChanges{
+									Title:      &title,
+									RevertedTo: &revertedTo,
+								},
+							},
+						},
+					},
+					PageInfo: &model.PageInfo{
+						StartCursor: "event_id1",
+						EndCursor:   "event_id4",
+						HasNextPage: func() *bool {
+							v := true
+							return &v
+						}(),
+					},
+					TotalCount: 5,
+				},
+			},
+		},
+		"happy_path/retag-events": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewArticleHistoryOutDTO([]dto.ArticleEvent{
+					dto.NewArticleEvent("event_id1", "RENAME_TAG", synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0), dto.ArticleEventWithAttachTagNames("go"), dto.ArticleEventWithDetachTagNames("golang")),
+					dto.NewArticleEvent("event_id2", "MERGE_TAGS", synchro.New[tz.UTC](2020, 1, 1, 1, 0, 0, 0), dto.ArticleEventWithAttachTagNames("go"), dto.ArticleEventWithDetachTagNames("go-lang", "gopher")),
+				}, false, 2),
+			},
+			want: want{
+				out: &model.ArticleHistoryConnection{
+					Edges: []*model.ArticleEventEdge{
+						{
+							Cursor: "event_id1",
+							Node: &model.ArticleEventNode{
+								ID:         "event_id1",
+								Type:       model.ArticleEventTypeRenameTag,
+								OccurredAt: gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+								Changes: &model.ArticleEventChanges{
+									AttachTagNames: []string{"go"},
+									DetachTagNames: []string{"golang"},
+								},
+							},
+						},
+						{
+							Cursor: "event_id2",
+							Node: &model.ArticleEventNode{
+								ID:         "event_id2",
+								Type:       model.ArticleEventTypeMergeTags,
+								OccurredAt: gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 1, 0, 0, 0)),
+								Changes: &model.ArticleEventChanges{
+									AttachTagNames: []string{"go"},
+									DetachTagNames: []string{"go-lang", "gopher"},
+								},
+							},
+						},
+					},
+					PageInfo: &model.PageInfo{
+						StartCursor: "event_id1",
+						EndCursor:   "event_id2",
+						HasNextPage: func() *bool {
+							v := false
+							return &v
+						}(),
+					},
+					TotalCount: 2,
+				},
+			},
+		},
+		"happy_path/no-events": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewArticleHistoryOutDTO(nil, false, 1),
+			},
+			want: want{
+				out: &model.ArticleHistoryConnection{
+					Edges: []*model.ArticleEventEdge{},
+					PageInfo: &model.PageInfo{
+						HasNextPage: func() *bool {
+							v := false
+							return &v
+						}(),
+					},
+					TotalCount: 1,
+				},
+			},
+		},
+		"unhappy_path/unknown-event-type": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewArticleHistoryOutDTO([]dto.ArticleEvent{
+					dto.NewArticleEvent("event_id1", "UNKNOWN", synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+				}, false, 1),
+			},
+			want: want{
+				err: ErrUnknownArticleEventType,
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToArticleHistory(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToArticleHistory() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToArticleAt(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.ArticleAtOutDTO
+	}
+	type want struct {
+		out *model.ArticleSnapshotNode
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewArticleAtOutDTO(
+					dto.NewArticleSnapshot("article_id", "title", "body", utils.MustURLParse("example.com/example.png"), []string{"tag"}, "event_id", synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0))),
+			},
+			want: want{
+				out: &model.ArticleSnapshotNode{
+					ID:           "article_id",
+					Title:        "title",
+					Content:      "body",
+					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					TagNames:     []string{"tag"},
+					EventID:      "event_id",
+					EventAt:      gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+				},
+			},
+		},
+		"happy_path/no-tags": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewArticleAtOutDTO(
+					dto.NewArticleSnapshot("article_id", "title", "body", utils.MustURLParse("example.com/example.png"), nil, "event_id", synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0))),
+			},
+			want: want{
+				out: &model.ArticleSnapshotNode{
+					ID:           "article_id",
+					Title:        "title",
+					Content:      "body",
+					ThumbnailURL: gqlscalar.URL(utils.MustURLParse("example.com/example.png")),
+					TagNames:     []string{},
+					EventID:      "event_id",
+					EventAt:      gqlscalar.UTC(synchro.New[tz.UTC](2020, 1, 1, 0, 0, 0, 0)),
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := tt.sut()
+			got, err := c.ToArticleAt(tt.args.ctx, tt.args.from)
+			if !errors.Is(err, tt.want.err) {
+				t.Errorf("ToArticleAt() error = %v, want %v", err, tt.want.err)
+				return
+			}
+			if diff := cmp.Diff(got, tt.want.out, cmpOpts...); diff != "" {
+				t.Error(diff)
+				return
+			}
+		})
+	}
+}
+
+func TestConverter_ToUploadImage(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		from dto.UploadImageOutDTO
+	}
+	type want struct {
+		out *model.UploadImagePayload
+		err error
+	}
+	type testCase struct {
+		sut  func() *Converter
+		args args
+		want want
+	}
+	tests := map[string]testCase{
+		"happy_path": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewUploadImageOutDTO(utils.MustURLParse("example.com/example.png"), nil, false, "client_mutation_id"),
+			},
+			want: want{
+				out: &model.UploadImagePayload{
+					ImageURL: func() *gqlscalar.URL {
+						v := gqlscalar.URL(utils.MustURLParse("example.com/example.png"))
+						return &v
+					}(),
+					Variants: []*model.ImageVariant{},
+					ClientMutationID: func() *string {
+						v := "client_mutation_id"
+						return &v
+					}(),
+					Deduplicated: func() *bool {
+						v := false
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+		"happy_path/deduplicated": {
+			sut: NewConverter,
+			args: args{
+				ctx:  context.Background(),
+				from: dto.NewUploadImageOutDTO(utils.MustURLParse("example.com/example.png"), nil, true, ""),
+			},
+			want: want{
+				out: &model.UploadImagePayload{
+					ImageURL: func() *gqlscalar.URL {
+						v := gqlscalar.URL(utils.MustURLParse("example.com/example.png"))
+						return &v
+					}(),
+					Variants: []*model.ImageVariant{},
+					Deduplicated: func() *bool {
+						v := true
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
+				},
+			},
+		},
+		"happy_path/with_variants": {
+			sut: NewConverter,
+			args: args{
+				ctx: context.Background(),
+				from: dto.NewUploadImageOutDTO(utils.MustURLParse("example.com/example.png"), []dto.ImageVariant{
+					dto.NewImageVariant(utils.MustURLParse("example.com/example-640w.png"), 640, 480, "image/png"),
+					dto.NewImageVariant(utils.MustURLParse("example.com/example-640w.webp"), 640, 480, "image/webp"),
+				}, false, ""),
+			},
+			want: want{
+				out: &model.UploadImagePayload{
+					ImageURL: func() *gqlscalar.URL {
+						v := gqlscalar.URL(utils.MustURLParse("example.com/example.png"))
+						return &v
+					}(),
+					Variants: []*model.ImageVariant{
+						{
+							URL:         gqlscalar.URL(utils.MustURLParse("example.com/example-640w.png")),
+							Width:       640,
+							Height:      480,
+							ContentType: "image/png",
+						},
+						{
+							URL:         gqlscalar.URL(utils.MustURLParse("example.com/example-640w.webp")),
+							Width:       640,
+							Height:      480,
+							ContentType: "image/webp",
+						},
+					},
+					Deduplicated: func() *bool {
+						v := false
+						return &v
+					}(),
+					UserErrors: []*model.UserError{},
 				},
 			},
 		},
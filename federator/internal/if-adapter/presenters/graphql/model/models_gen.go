@@ -3,6 +3,10 @@
 package model
 
 import (
+	"fmt"
+	"io"
+	"strconv"
+
 	"blogapi.miyamo.today/federator/internal/pkg/gqlscalar"
 	"github.com/99designs/gqlgen/graphql"
 )
@@ -12,6 +16,24 @@ type Node interface {
 	GetID() string
 }
 
+// AddArticleToSeriesInput adds the article to the series at the 1-based position.
+// The article is appended if position is omitted or beyond the last part.
+type AddArticleToSeriesInput struct {
+	SeriesID         string  `json:"seriesId"`
+	ArticleID        string  `json:"articleId"`
+	Position         *int    `json:"position,omitempty"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+}
+
+// AddArticleToSeriesPayload has the added article and the later parts, which are shifted back.
+type AddArticleToSeriesPayload struct {
+	SeriesID         *string          `json:"seriesId,omitempty"`
+	Articles         []*SeriesArticle `json:"articles"`
+	ClientMutationID *string          `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
 type ArticleConnection struct {
 	Edges      []*ArticleEdge `json:"edges"`
 	PageInfo   *PageInfo      `json:"pageInfo"`
@@ -23,19 +45,90 @@ type ArticleEdge struct {
 	Node   *ArticleNode `json:"node"`
 }
 
+type ArticleEventChanges struct {
+	Title          *string        `json:"title,omitempty"`
+	Content        *string        `json:"content,omitempty"`
+	ThumbnailURL   *gqlscalar.URL `json:"thumbnailUrl,omitempty"`
+	TagNames       []string       `json:"tagNames,omitempty"`
+	AttachTagNames []string       `json:"attachTagNames,omitempty"`
+	DetachTagNames []string       `json:"detachTagNames,omitempty"`
+	Invisible      *bool          `json:"invisible,omitempty"`
+	PublishAt      *gqlscalar.UTC `json:"publishAt,omitempty"`
+	Draft          *bool          `json:"draft,omitempty"`
+	RevertedTo     *string        `json:"revertedTo,omitempty"`
+	Slug           *string        `json:"slug,omitempty"`
+	SeriesID       *string        `json:"seriesId,omitempty"`
+	SeriesTitle    *string        `json:"seriesTitle,omitempty"`
+	SeriesPosition *int           `json:"seriesPosition,omitempty"`
+}
+
+type ArticleEventEdge struct {
+	Cursor string            `json:"cursor"`
+	Node   *ArticleEventNode `json:"node"`
+}
+
+type ArticleEventNode struct {
+	ID         string               `json:"id"`
+	Type       ArticleEventType     `json:"type"`
+	OccurredAt gqlscalar.UTC        `json:"occurredAt"`
+	Changes    *ArticleEventChanges `json:"changes"`
+	Actor      *string              `json:"actor,omitempty"`
+}
+
+type ArticleHistoryConnection struct {
+	Edges      []*ArticleEventEdge `json:"edges"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
 type ArticleNode struct {
-	ID           string                `json:"id"`
-	Title        string                `json:"title"`
-	Content      string                `json:"content"`
-	ThumbnailURL gqlscalar.URL         `json:"thumbnailUrl"`
-	CreatedAt    gqlscalar.UTC         `json:"createdAt"`
-	UpdatedAt    gqlscalar.UTC         `json:"updatedAt"`
-	Tags         *ArticleTagConnection `json:"tags"`
+	ID           string        `json:"id"`
+	Title        string        `json:"title"`
+	Content      string        `json:"content"`
+	ThumbnailURL gqlscalar.URL `json:"thumbnailUrl"`
+	// thumbnailVariants are the resized and re-encoded variants of the thumbnail, e.g. for a srcset. It is empty if the thumbnail was given without them.
+	ThumbnailVariants []*ImageVariant `json:"thumbnailVariants"`
+	CreatedAt         gqlscalar.UTC   `json:"createdAt"`
+	UpdatedAt         gqlscalar.UTC   `json:"updatedAt"`
+	// slug is the current slug of the article. It is null if the article has none.
+	Slug *string `json:"slug,omitempty"`
+	// createdBy is the name of the user who created the article. It is null if it is not known.
+	CreatedBy *string `json:"createdBy,omitempty"`
+	// updatedBy is the name of the user who last updated the article. It is null if it is not known.
+	UpdatedBy *string `json:"updatedBy,omitempty"`
+	// series is the series the article is a part of. It is null if the article is in none.
+	Series  *ArticleSeries            `json:"series,omitempty"`
+	Tags    *ArticleTagConnection     `json:"tags"`
+	History *ArticleHistoryConnection `json:"history"`
 }
 
 func (ArticleNode) IsNode()            {}
 func (this ArticleNode) GetID() string { return this.ID }
 
+// ArticleSeries is an ordered group of articles, such as the parts of a multi-part tutorial.
+type ArticleSeries struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	// articles are the published articles of the series in the order of their position.
+	Articles []*ArticleNode `json:"articles"`
+	// previous is the part right before the article the series was reached from.
+	// It is null for the first part, and if the series was got by its id.
+	Previous *ArticleNode `json:"previous,omitempty"`
+	// next is the part right after the article the series was reached from.
+	// It is null for the last part, and if the series was got by its id.
+	Next *ArticleNode `json:"next,omitempty"`
+}
+
+type ArticleSnapshotNode struct {
+	ID           string        `json:"id"`
+	Title        string        `json:"title"`
+	Content      string        `json:"content"`
+	ThumbnailURL gqlscalar.URL `json:"thumbnailUrl"`
+	TagNames     []string      `json:"tagNames"`
+	EventID      string        `json:"eventId"`
+	EventAt      gqlscalar.UTC `json:"eventAt"`
+}
+
 type ArticleTagConnection struct {
 	Edges      []*ArticleTagEdge `json:"edges"`
 	PageInfo   *PageInfo         `json:"pageInfo"`
@@ -53,41 +146,140 @@ type ArticleTagNode struct {
 }
 
 type AttachTagsInput struct {
-	ArticleID        string   `json:"articleId"`
-	TagNames         []string `json:"tagNames"`
-	ClientMutationID *string  `json:"clientMutationId,omitempty"`
+	ArticleID           string   `json:"articleId"`
+	TagNames            []string `json:"tagNames"`
+	ExpectedLastEventID *string  `json:"expectedLastEventId,omitempty"`
+	ClientMutationID    *string  `json:"clientMutationId,omitempty"`
 }
 
 type AttachTagsPayload struct {
-	ArticleID        string  `json:"articleId"`
-	EventID          string  `json:"eventID"`
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
 }
 
 type CreateArticleInput struct {
-	Title            string        `json:"title"`
-	Content          string        `json:"content"`
-	ThumbnailURL     gqlscalar.URL `json:"thumbnailURL"`
-	TagNames         []string      `json:"tagNames"`
-	ClientMutationID *string       `json:"clientMutationId,omitempty"`
+	Title        string        `json:"title"`
+	Content      string        `json:"content"`
+	ThumbnailURL gqlscalar.URL `json:"thumbnailURL"`
+	// thumbnailVariants are the variants of the thumbnail, as returned by uploadImage.
+	ThumbnailVariants []*ImageVariantInput `json:"thumbnailVariants,omitempty"`
+	TagNames          []string             `json:"tagNames"`
+	PublishAt         *gqlscalar.UTC       `json:"publishAt,omitempty"`
+	Draft             *bool                `json:"draft,omitempty"`
+	Slug              *string              `json:"slug,omitempty"`
+	ClientMutationID  *string              `json:"clientMutationId,omitempty"`
 }
 
 type CreateArticlePayload struct {
-	ArticleID        string  `json:"articleId"`
-	EventID          string  `json:"eventID"`
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
 }
 
-type DetachTagsInput struct {
-	ArticleID        string   `json:"articleId"`
-	TagNames         []string `json:"tagNames"`
+// CreateSeriesInput creates a series of the articles, in the order they are given.
+type CreateSeriesInput struct {
+	Title            string   `json:"title"`
+	ArticleIds       []string `json:"articleIds"`
 	ClientMutationID *string  `json:"clientMutationId,omitempty"`
 }
 
+type CreateSeriesPayload struct {
+	SeriesID         *string          `json:"seriesId,omitempty"`
+	Articles         []*SeriesArticle `json:"articles"`
+	ClientMutationID *string          `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type DetachTagsInput struct {
+	ArticleID           string   `json:"articleId"`
+	TagNames            []string `json:"tagNames"`
+	ExpectedLastEventID *string  `json:"expectedLastEventId,omitempty"`
+	ClientMutationID    *string  `json:"clientMutationId,omitempty"`
+}
+
 type DetachTagsPayload struct {
-	ArticleID        string  `json:"articleId"`
-	EventID          string  `json:"eventID"`
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type DraftNode struct {
+	ID           string        `json:"id"`
+	Title        string        `json:"title"`
+	Content      string        `json:"content"`
+	ThumbnailURL gqlscalar.URL `json:"thumbnailUrl"`
+	TagNames     []string      `json:"tagNames"`
+	LastEventID  string        `json:"lastEventId"`
+}
+
+type EditArticleInput struct {
+	ArticleID    string         `json:"articleId"`
+	Title        *string        `json:"title,omitempty"`
+	Content      *string        `json:"content,omitempty"`
+	ThumbnailURL *gqlscalar.URL `json:"thumbnailURL,omitempty"`
+	// thumbnailVariants are the variants of the new thumbnail, as returned by uploadImage. They require thumbnailURL.
+	ThumbnailVariants   []*ImageVariantInput `json:"thumbnailVariants,omitempty"`
+	AttachTagNames      []string             `json:"attachTagNames,omitempty"`
+	DetachTagNames      []string             `json:"detachTagNames,omitempty"`
+	ExpectedLastEventID *string              `json:"expectedLastEventId,omitempty"`
+	ClientMutationID    *string              `json:"clientMutationId,omitempty"`
+}
+
+type EditArticlePayload struct {
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type HideArticleInput struct {
+	ArticleID           string  `json:"articleId"`
+	ExpectedLastEventID *string `json:"expectedLastEventId,omitempty"`
+	ClientMutationID    *string `json:"clientMutationId,omitempty"`
+}
+
+type HideArticlePayload struct {
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type ImageVariant struct {
+	URL         gqlscalar.URL `json:"url"`
+	Width       int           `json:"width"`
+	Height      int           `json:"height"`
+	ContentType string        `json:"contentType"`
+}
+
+type ImageVariantInput struct {
+	URL         gqlscalar.URL `json:"url"`
+	Width       int           `json:"width"`
+	Height      int           `json:"height"`
+	ContentType string        `json:"contentType"`
+}
+
+type MergeTagsInput struct {
+	Sources          []string `json:"sources"`
+	Into             string   `json:"into"`
+	ClientMutationID *string  `json:"clientMutationId,omitempty"`
+}
+
+type MergeTagsPayload struct {
+	Articles         []*RetaggedArticle `json:"articles"`
+	ClientMutationID *string            `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
 }
 
 type Mutation struct {
@@ -108,9 +300,93 @@ type PageInfo struct {
 	EndCursor       string `json:"endCursor"`
 }
 
+type PublishArticleInput struct {
+	ArticleID           string  `json:"articleId"`
+	ExpectedLastEventID *string `json:"expectedLastEventId,omitempty"`
+	ClientMutationID    *string `json:"clientMutationId,omitempty"`
+}
+
+type PublishArticlePayload struct {
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
 type Query struct {
 }
 
+type RenameTagInput struct {
+	From             string  `json:"from"`
+	To               string  `json:"to"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+}
+
+type RenameTagPayload struct {
+	Articles         []*RetaggedArticle `json:"articles"`
+	ClientMutationID *string            `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+// ReorderSeriesInput reorders the series to the order the articles are given.
+// articleIds must be every article of the series.
+type ReorderSeriesInput struct {
+	SeriesID         string   `json:"seriesId"`
+	ArticleIds       []string `json:"articleIds"`
+	ClientMutationID *string  `json:"clientMutationId,omitempty"`
+}
+
+// ReorderSeriesPayload has only the articles whose position changed.
+type ReorderSeriesPayload struct {
+	SeriesID         *string          `json:"seriesId,omitempty"`
+	Articles         []*SeriesArticle `json:"articles"`
+	ClientMutationID *string          `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type RetaggedArticle struct {
+	ArticleID string `json:"articleId"`
+	EventID   string `json:"eventID"`
+}
+
+type RevertArticleInput struct {
+	ArticleID           string  `json:"articleId"`
+	ToEventID           string  `json:"toEventId"`
+	ExpectedLastEventID *string `json:"expectedLastEventId,omitempty"`
+	ClientMutationID    *string `json:"clientMutationId,omitempty"`
+}
+
+type RevertArticlePayload struct {
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type ScheduleArticleInput struct {
+	ArticleID           string        `json:"articleId"`
+	PublishAt           gqlscalar.UTC `json:"publishAt"`
+	ExpectedLastEventID *string       `json:"expectedLastEventId,omitempty"`
+	ClientMutationID    *string       `json:"clientMutationId,omitempty"`
+}
+
+type ScheduleArticlePayload struct {
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type SeriesArticle struct {
+	ArticleID string `json:"articleId"`
+	EventID   string `json:"eventID"`
+}
+
 type TagArticleConnection struct {
 	Edges      []*TagArticleEdge `json:"edges"`
 	PageInfo   *PageInfo         `json:"pageInfo"`
@@ -150,48 +426,171 @@ type TagNode struct {
 	Articles *TagArticleConnection `json:"articles"`
 }
 
-type UpdateArticleBodyInput struct {
-	ArticleID        string  `json:"articleId"`
-	Content          string  `json:"content"`
+type UnhideArticleInput struct {
+	ArticleID           string  `json:"articleId"`
+	ExpectedLastEventID *string `json:"expectedLastEventId,omitempty"`
+	ClientMutationID    *string `json:"clientMutationId,omitempty"`
+}
+
+type UnhideArticlePayload struct {
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type UpdateArticleBodyInput struct {
+	ArticleID           string  `json:"articleId"`
+	Content             string  `json:"content"`
+	ExpectedLastEventID *string `json:"expectedLastEventId,omitempty"`
+	ClientMutationID    *string `json:"clientMutationId,omitempty"`
 }
 
 type UpdateArticleBodyPayload struct {
-	ArticleID        string  `json:"articleId"`
-	EventID          string  `json:"eventID"`
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
+	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+type UpdateArticleSlugInput struct {
+	ArticleID           string  `json:"articleId"`
+	Slug                string  `json:"slug"`
+	ExpectedLastEventID *string `json:"expectedLastEventId,omitempty"`
+	ClientMutationID    *string `json:"clientMutationId,omitempty"`
+}
+
+type UpdateArticleSlugPayload struct {
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
 }
 
 type UpdateArticleThumbnailInput struct {
-	ArticleID        string        `json:"articleId"`
-	ThumbnailURL     gqlscalar.URL `json:"thumbnailURL"`
-	ClientMutationID *string       `json:"clientMutationId,omitempty"`
+	ArticleID    string        `json:"articleId"`
+	ThumbnailURL gqlscalar.URL `json:"thumbnailURL"`
+	// thumbnailVariants are the variants of the thumbnail, as returned by uploadImage.
+	ThumbnailVariants   []*ImageVariantInput `json:"thumbnailVariants,omitempty"`
+	ExpectedLastEventID *string              `json:"expectedLastEventId,omitempty"`
+	ClientMutationID    *string              `json:"clientMutationId,omitempty"`
 }
 
 type UpdateArticleThumbnailPayload struct {
-	ArticleID        string  `json:"articleId"`
-	EventID          string  `json:"eventID"`
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
 }
 
 type UpdateArticleTitleInput struct {
-	ArticleID        string  `json:"articleId"`
-	Title            string  `json:"title"`
-	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	ArticleID           string  `json:"articleId"`
+	Title               string  `json:"title"`
+	ExpectedLastEventID *string `json:"expectedLastEventId,omitempty"`
+	ClientMutationID    *string `json:"clientMutationId,omitempty"`
 }
 
 type UpdateArticleTitlePayload struct {
-	ArticleID        string  `json:"articleId"`
-	EventID          string  `json:"eventID"`
+	ArticleID        *string `json:"articleId,omitempty"`
+	EventID          *string `json:"eventID,omitempty"`
 	ClientMutationID *string `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
 }
 
 type UploadImageInput struct {
 	Image            graphql.Upload `json:"image"`
+	KeepMetadata     *bool          `json:"keepMetadata,omitempty"`
 	ClientMutationID *string        `json:"clientMutationId,omitempty"`
 }
 
 type UploadImagePayload struct {
-	ImageURL         gqlscalar.URL `json:"imageURL"`
-	ClientMutationID *string       `json:"clientMutationId,omitempty"`
+	ImageURL         *gqlscalar.URL  `json:"imageURL,omitempty"`
+	Variants         []*ImageVariant `json:"variants"`
+	Deduplicated     *bool           `json:"deduplicated,omitempty"`
+	ClientMutationID *string         `json:"clientMutationId,omitempty"`
+	// userErrors are the parts of the input that were rejected. If there are any, nothing is changed and the other fields are null or empty.
+	UserErrors []*UserError `json:"userErrors"`
+}
+
+// UserError is a part of the input of a mutation that was rejected, such as a tag name that is too long.
+type UserError struct {
+	// field is the path to the input field that was rejected, such as tagNames[0], or null if the input is rejected as a whole.
+	Field   *string `json:"field,omitempty"`
+	Message string  `json:"message"`
+}
+
+type ArticleEventType string
+
+const (
+	ArticleEventTypeCreateArticle   ArticleEventType = "CREATE_ARTICLE"
+	ArticleEventTypeUpdateTitle     ArticleEventType = "UPDATE_TITLE"
+	ArticleEventTypeUpdateBody      ArticleEventType = "UPDATE_BODY"
+	ArticleEventTypeUpdateThumbnail ArticleEventType = "UPDATE_THUMBNAIL"
+	ArticleEventTypeAttachTags      ArticleEventType = "ATTACH_TAGS"
+	ArticleEventTypeDetachTags      ArticleEventType = "DETACH_TAGS"
+	ArticleEventTypeHideArticle     ArticleEventType = "HIDE_ARTICLE"
+	ArticleEventTypeUnhideArticle   ArticleEventType = "UNHIDE_ARTICLE"
+	ArticleEventTypeEditArticle     ArticleEventType = "EDIT_ARTICLE"
+	ArticleEventTypeScheduleArticle ArticleEventType = "SCHEDULE_ARTICLE"
+	ArticleEventTypePublishArticle  ArticleEventType = "PUBLISH_ARTICLE"
+	ArticleEventTypeRevertArticle   ArticleEventType = "REVERT_ARTICLE"
+	ArticleEventTypeRenameTag       ArticleEventType = "RENAME_TAG"
+	ArticleEventTypeMergeTags       ArticleEventType = "MERGE_TAGS"
+	ArticleEventTypeUpdateSlug      ArticleEventType = "UPDATE_SLUG"
+	ArticleEventTypeAddToSeries     ArticleEventType = "ADD_TO_SERIES"
+	ArticleEventTypeReorderSeries   ArticleEventType = "REORDER_SERIES"
+)
+
+var AllArticleEventType = []ArticleEventType{
+	ArticleEventTypeCreateArticle,
+	ArticleEventTypeUpdateTitle,
+	ArticleEventTypeUpdateBody,
+	ArticleEventTypeUpdateThumbnail,
+	ArticleEventTypeAttachTags,
+	ArticleEventTypeDetachTags,
+	ArticleEventTypeHideArticle,
+	ArticleEventTypeUnhideArticle,
+	ArticleEventTypeEditArticle,
+	ArticleEventTypeScheduleArticle,
+	ArticleEventTypePublishArticle,
+	ArticleEventTypeRevertArticle,
+	ArticleEventTypeRenameTag,
+	ArticleEventTypeMergeTags,
+	ArticleEventTypeUpdateSlug,
+	ArticleEventTypeAddToSeries,
+	ArticleEventTypeReorderSeries,
+}
+
+func (e ArticleEventType) IsValid() bool {
+	switch e {
+	case ArticleEventTypeCreateArticle, ArticleEventTypeUpdateTitle, ArticleEventTypeUpdateBody, ArticleEventTypeUpdateThumbnail, ArticleEventTypeAttachTags, ArticleEventTypeDetachTags, ArticleEventTypeHideArticle, ArticleEventTypeUnhideArticle, ArticleEventTypeEditArticle, ArticleEventTypeScheduleArticle, ArticleEventTypePublishArticle, ArticleEventTypeRevertArticle, ArticleEventTypeRenameTag, ArticleEventTypeMergeTags, ArticleEventTypeUpdateSlug, ArticleEventTypeAddToSeries, ArticleEventTypeReorderSeries:
+		return true
+	}
+	return false
+}
+
+func (e ArticleEventType) String() string {
+	return string(e)
+}
+
+func (e *ArticleEventType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ArticleEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ArticleEventType", str)
+	}
+	return nil
+}
+
+func (e ArticleEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
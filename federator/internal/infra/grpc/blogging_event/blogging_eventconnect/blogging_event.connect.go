@@ -42,6 +42,9 @@ const (
 	// BloggingEventServiceUpdateArticleBodyProcedure is the fully-qualified name of the
 	// BloggingEventService's UpdateArticleBody RPC.
 	BloggingEventServiceUpdateArticleBodyProcedure = "/blogging_event.BloggingEventService/UpdateArticleBody"
+	// BloggingEventServiceUpdateArticleSlugProcedure is the fully-qualified name of the
+	// BloggingEventService's UpdateArticleSlug RPC.
+	BloggingEventServiceUpdateArticleSlugProcedure = "/blogging_event.BloggingEventService/UpdateArticleSlug"
 	// BloggingEventServiceUpdateArticleThumbnailProcedure is the fully-qualified name of the
 	// BloggingEventService's UpdateArticleThumbnail RPC.
 	BloggingEventServiceUpdateArticleThumbnailProcedure = "/blogging_event.BloggingEventService/UpdateArticleThumbnail"
@@ -51,6 +54,51 @@ const (
 	// BloggingEventServiceDetachTagsProcedure is the fully-qualified name of the BloggingEventService's
 	// DetachTags RPC.
 	BloggingEventServiceDetachTagsProcedure = "/blogging_event.BloggingEventService/DetachTags"
+	// BloggingEventServiceHideArticleProcedure is the fully-qualified name of the
+	// BloggingEventService's HideArticle RPC.
+	BloggingEventServiceHideArticleProcedure = "/blogging_event.BloggingEventService/HideArticle"
+	// BloggingEventServiceUnhideArticleProcedure is the fully-qualified name of the
+	// BloggingEventService's UnhideArticle RPC.
+	BloggingEventServiceUnhideArticleProcedure = "/blogging_event.BloggingEventService/UnhideArticle"
+	// BloggingEventServiceEditArticleProcedure is the fully-qualified name of the
+	// BloggingEventService's EditArticle RPC.
+	BloggingEventServiceEditArticleProcedure = "/blogging_event.BloggingEventService/EditArticle"
+	// BloggingEventServiceScheduleArticleProcedure is the fully-qualified name of the
+	// BloggingEventService's ScheduleArticle RPC.
+	BloggingEventServiceScheduleArticleProcedure = "/blogging_event.BloggingEventService/ScheduleArticle"
+	// BloggingEventServicePublishArticleProcedure is the fully-qualified name of the
+	// BloggingEventService's PublishArticle RPC.
+	BloggingEventServicePublishArticleProcedure = "/blogging_event.BloggingEventService/PublishArticle"
+	// BloggingEventServiceRevertArticleProcedure is the fully-qualified name of the
+	// BloggingEventService's RevertArticle RPC.
+	BloggingEventServiceRevertArticleProcedure = "/blogging_event.BloggingEventService/RevertArticle"
+	// BloggingEventServiceRenameTagProcedure is the fully-qualified name of the BloggingEventService's
+	// RenameTag RPC.
+	BloggingEventServiceRenameTagProcedure = "/blogging_event.BloggingEventService/RenameTag"
+	// BloggingEventServiceMergeTagsProcedure is the fully-qualified name of the BloggingEventService's
+	// MergeTags RPC.
+	BloggingEventServiceMergeTagsProcedure = "/blogging_event.BloggingEventService/MergeTags"
+	// BloggingEventServiceCreateSeriesProcedure is the fully-qualified name of the
+	// BloggingEventService's CreateSeries RPC.
+	BloggingEventServiceCreateSeriesProcedure = "/blogging_event.BloggingEventService/CreateSeries"
+	// BloggingEventServiceAddArticleToSeriesProcedure is the fully-qualified name of the
+	// BloggingEventService's AddArticleToSeries RPC.
+	BloggingEventServiceAddArticleToSeriesProcedure = "/blogging_event.BloggingEventService/AddArticleToSeries"
+	// BloggingEventServiceReorderSeriesProcedure is the fully-qualified name of the
+	// BloggingEventService's ReorderSeries RPC.
+	BloggingEventServiceReorderSeriesProcedure = "/blogging_event.BloggingEventService/ReorderSeries"
+	// BloggingEventServiceGetDraftProcedure is the fully-qualified name of the BloggingEventService's
+	// GetDraft RPC.
+	BloggingEventServiceGetDraftProcedure = "/blogging_event.BloggingEventService/GetDraft"
+	// BloggingEventServiceListDraftsProcedure is the fully-qualified name of the BloggingEventService's
+	// ListDrafts RPC.
+	BloggingEventServiceListDraftsProcedure = "/blogging_event.BloggingEventService/ListDrafts"
+	// BloggingEventServiceListArticleEventsProcedure is the fully-qualified name of the
+	// BloggingEventService's ListArticleEvents RPC.
+	BloggingEventServiceListArticleEventsProcedure = "/blogging_event.BloggingEventService/ListArticleEvents"
+	// BloggingEventServiceGetArticleAtProcedure is the fully-qualified name of the
+	// BloggingEventService's GetArticleAt RPC.
+	BloggingEventServiceGetArticleAtProcedure = "/blogging_event.BloggingEventService/GetArticleAt"
 	// BloggingEventServiceUploadImageProcedure is the fully-qualified name of the
 	// BloggingEventService's UploadImage RPC.
 	BloggingEventServiceUploadImageProcedure = "/blogging_event.BloggingEventService/UploadImage"
@@ -61,9 +109,25 @@ type BloggingEventServiceClient interface {
 	CreateArticle(context.Context, *connect.Request[blogging_event.CreateArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
 	UpdateArticleTitle(context.Context, *connect.Request[blogging_event.UpdateArticleTitleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
 	UpdateArticleBody(context.Context, *connect.Request[blogging_event.UpdateArticleBodyRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	UpdateArticleSlug(context.Context, *connect.Request[blogging_event.UpdateArticleSlugRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
 	UpdateArticleThumbnail(context.Context, *connect.Request[blogging_event.UpdateArticleThumbnailRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
 	AttachTags(context.Context, *connect.Request[blogging_event.AttachTagsRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
 	DetachTags(context.Context, *connect.Request[blogging_event.DetachTagsRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	HideArticle(context.Context, *connect.Request[blogging_event.HideArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	UnhideArticle(context.Context, *connect.Request[blogging_event.UnhideArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	EditArticle(context.Context, *connect.Request[blogging_event.EditArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	ScheduleArticle(context.Context, *connect.Request[blogging_event.ScheduleArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	PublishArticle(context.Context, *connect.Request[blogging_event.PublishArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	RevertArticle(context.Context, *connect.Request[blogging_event.RevertArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	RenameTag(context.Context, *connect.Request[blogging_event.RenameTagRequest]) (*connect.Response[blogging_event.RenameTagResponse], error)
+	MergeTags(context.Context, *connect.Request[blogging_event.MergeTagsRequest]) (*connect.Response[blogging_event.MergeTagsResponse], error)
+	CreateSeries(context.Context, *connect.Request[blogging_event.CreateSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error)
+	AddArticleToSeries(context.Context, *connect.Request[blogging_event.AddArticleToSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error)
+	ReorderSeries(context.Context, *connect.Request[blogging_event.ReorderSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error)
+	GetDraft(context.Context, *connect.Request[blogging_event.GetDraftRequest]) (*connect.Response[blogging_event.GetDraftResponse], error)
+	ListDrafts(context.Context, *connect.Request[blogging_event.ListDraftsRequest]) (*connect.Response[blogging_event.ListDraftsResponse], error)
+	ListArticleEvents(context.Context, *connect.Request[blogging_event.ListArticleEventsRequest]) (*connect.Response[blogging_event.ListArticleEventsResponse], error)
+	GetArticleAt(context.Context, *connect.Request[blogging_event.GetArticleAtRequest]) (*connect.Response[blogging_event.GetArticleAtResponse], error)
 	UploadImage(context.Context) *connect.ClientStreamForClient[blogging_event.UploadImageRequest, blogging_event.UploadImageResponse]
 }
 
@@ -96,6 +160,12 @@ func NewBloggingEventServiceClient(httpClient connect.HTTPClient, baseURL string
 			connect.WithSchema(bloggingEventServiceMethods.ByName("UpdateArticleBody")),
 			connect.WithClientOptions(opts...),
 		),
+		updateArticleSlug: connect.NewClient[blogging_event.UpdateArticleSlugRequest, blogging_event.BloggingEventResponse](
+			httpClient,
+			baseURL+BloggingEventServiceUpdateArticleSlugProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("UpdateArticleSlug")),
+			connect.WithClientOptions(opts...),
+		),
 		updateArticleThumbnail: connect.NewClient[blogging_event.UpdateArticleThumbnailRequest, blogging_event.BloggingEventResponse](
 			httpClient,
 			baseURL+BloggingEventServiceUpdateArticleThumbnailProcedure,
@@ -114,6 +184,96 @@ func NewBloggingEventServiceClient(httpClient connect.HTTPClient, baseURL string
 			connect.WithSchema(bloggingEventServiceMethods.ByName("DetachTags")),
 			connect.WithClientOptions(opts...),
 		),
+		hideArticle: connect.NewClient[blogging_event.HideArticleRequest, blogging_event.BloggingEventResponse](
+			httpClient,
+			baseURL+BloggingEventServiceHideArticleProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("HideArticle")),
+			connect.WithClientOptions(opts...),
+		),
+		unhideArticle: connect.NewClient[blogging_event.UnhideArticleRequest, blogging_event.BloggingEventResponse](
+			httpClient,
+			baseURL+BloggingEventServiceUnhideArticleProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("UnhideArticle")),
+			connect.WithClientOptions(opts...),
+		),
+		editArticle: connect.NewClient[blogging_event.EditArticleRequest, blogging_event.BloggingEventResponse](
+			httpClient,
+			baseURL+BloggingEventServiceEditArticleProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("EditArticle")),
+			connect.WithClientOptions(opts...),
+		),
+		scheduleArticle: connect.NewClient[blogging_event.ScheduleArticleRequest, blogging_event.BloggingEventResponse](
+			httpClient,
+			baseURL+BloggingEventServiceScheduleArticleProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("ScheduleArticle")),
+			connect.WithClientOptions(opts...),
+		),
+		publishArticle: connect.NewClient[blogging_event.PublishArticleRequest, blogging_event.BloggingEventResponse](
+			httpClient,
+			baseURL+BloggingEventServicePublishArticleProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("PublishArticle")),
+			connect.WithClientOptions(opts...),
+		),
+		revertArticle: connect.NewClient[blogging_event.RevertArticleRequest, blogging_event.BloggingEventResponse](
+			httpClient,
+			baseURL+BloggingEventServiceRevertArticleProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("RevertArticle")),
+			connect.WithClientOptions(opts...),
+		),
+		renameTag: connect.NewClient[blogging_event.RenameTagRequest, blogging_event.RenameTagResponse](
+			httpClient,
+			baseURL+BloggingEventServiceRenameTagProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("RenameTag")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeTags: connect.NewClient[blogging_event.MergeTagsRequest, blogging_event.MergeTagsResponse](
+			httpClient,
+			baseURL+BloggingEventServiceMergeTagsProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("MergeTags")),
+			connect.WithClientOptions(opts...),
+		),
+		createSeries: connect.NewClient[blogging_event.CreateSeriesRequest, blogging_event.SeriesResponse](
+			httpClient,
+			baseURL+BloggingEventServiceCreateSeriesProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("CreateSeries")),
+			connect.WithClientOptions(opts...),
+		),
+		addArticleToSeries: connect.NewClient[blogging_event.AddArticleToSeriesRequest, blogging_event.SeriesResponse](
+			httpClient,
+			baseURL+BloggingEventServiceAddArticleToSeriesProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("AddArticleToSeries")),
+			connect.WithClientOptions(opts...),
+		),
+		reorderSeries: connect.NewClient[blogging_event.ReorderSeriesRequest, blogging_event.SeriesResponse](
+			httpClient,
+			baseURL+BloggingEventServiceReorderSeriesProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("ReorderSeries")),
+			connect.WithClientOptions(opts...),
+		),
+		getDraft: connect.NewClient[blogging_event.GetDraftRequest, blogging_event.GetDraftResponse](
+			httpClient,
+			baseURL+BloggingEventServiceGetDraftProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("GetDraft")),
+			connect.WithClientOptions(opts...),
+		),
+		listDrafts: connect.NewClient[blogging_event.ListDraftsRequest, blogging_event.ListDraftsResponse](
+			httpClient,
+			baseURL+BloggingEventServiceListDraftsProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("ListDrafts")),
+			connect.WithClientOptions(opts...),
+		),
+		listArticleEvents: connect.NewClient[blogging_event.ListArticleEventsRequest, blogging_event.ListArticleEventsResponse](
+			httpClient,
+			baseURL+BloggingEventServiceListArticleEventsProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("ListArticleEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		getArticleAt: connect.NewClient[blogging_event.GetArticleAtRequest, blogging_event.GetArticleAtResponse](
+			httpClient,
+			baseURL+BloggingEventServiceGetArticleAtProcedure,
+			connect.WithSchema(bloggingEventServiceMethods.ByName("GetArticleAt")),
+			connect.WithClientOptions(opts...),
+		),
 		uploadImage: connect.NewClient[blogging_event.UploadImageRequest, blogging_event.UploadImageResponse](
 			httpClient,
 			baseURL+BloggingEventServiceUploadImageProcedure,
@@ -128,9 +288,25 @@ type bloggingEventServiceClient struct {
 	createArticle          *connect.Client[blogging_event.CreateArticleRequest, blogging_event.BloggingEventResponse]
 	updateArticleTitle     *connect.Client[blogging_event.UpdateArticleTitleRequest, blogging_event.BloggingEventResponse]
 	updateArticleBody      *connect.Client[blogging_event.UpdateArticleBodyRequest, blogging_event.BloggingEventResponse]
+	updateArticleSlug      *connect.Client[blogging_event.UpdateArticleSlugRequest, blogging_event.BloggingEventResponse]
 	updateArticleThumbnail *connect.Client[blogging_event.UpdateArticleThumbnailRequest, blogging_event.BloggingEventResponse]
 	attachTags             *connect.Client[blogging_event.AttachTagsRequest, blogging_event.BloggingEventResponse]
 	detachTags             *connect.Client[blogging_event.DetachTagsRequest, blogging_event.BloggingEventResponse]
+	hideArticle            *connect.Client[blogging_event.HideArticleRequest, blogging_event.BloggingEventResponse]
+	unhideArticle          *connect.Client[blogging_event.UnhideArticleRequest, blogging_event.BloggingEventResponse]
+	editArticle            *connect.Client[blogging_event.EditArticleRequest, blogging_event.BloggingEventResponse]
+	scheduleArticle        *connect.Client[blogging_event.ScheduleArticleRequest, blogging_event.BloggingEventResponse]
+	publishArticle         *connect.Client[blogging_event.PublishArticleRequest, blogging_event.BloggingEventResponse]
+	revertArticle          *connect.Client[blogging_event.RevertArticleRequest, blogging_event.BloggingEventResponse]
+	renameTag              *connect.Client[blogging_event.RenameTagRequest, blogging_event.RenameTagResponse]
+	mergeTags              *connect.Client[blogging_event.MergeTagsRequest, blogging_event.MergeTagsResponse]
+	createSeries           *connect.Client[blogging_event.CreateSeriesRequest, blogging_event.SeriesResponse]
+	addArticleToSeries     *connect.Client[blogging_event.AddArticleToSeriesRequest, blogging_event.SeriesResponse]
+	reorderSeries          *connect.Client[blogging_event.ReorderSeriesRequest, blogging_event.SeriesResponse]
+	getDraft               *connect.Client[blogging_event.GetDraftRequest, blogging_event.GetDraftResponse]
+	listDrafts             *connect.Client[blogging_event.ListDraftsRequest, blogging_event.ListDraftsResponse]
+	listArticleEvents      *connect.Client[blogging_event.ListArticleEventsRequest, blogging_event.ListArticleEventsResponse]
+	getArticleAt           *connect.Client[blogging_event.GetArticleAtRequest, blogging_event.GetArticleAtResponse]
 	uploadImage            *connect.Client[blogging_event.UploadImageRequest, blogging_event.UploadImageResponse]
 }
 
@@ -149,6 +325,11 @@ func (c *bloggingEventServiceClient) UpdateArticleBody(ctx context.Context, req
 	return c.updateArticleBody.CallUnary(ctx, req)
 }
 
+// UpdateArticleSlug calls blogging_event.BloggingEventService.UpdateArticleSlug.
+func (c *bloggingEventServiceClient) UpdateArticleSlug(ctx context.Context, req *connect.Request[blogging_event.UpdateArticleSlugRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return c.updateArticleSlug.CallUnary(ctx, req)
+}
+
 // UpdateArticleThumbnail calls blogging_event.BloggingEventService.UpdateArticleThumbnail.
 func (c *bloggingEventServiceClient) UpdateArticleThumbnail(ctx context.Context, req *connect.Request[blogging_event.UpdateArticleThumbnailRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
 	return c.updateArticleThumbnail.CallUnary(ctx, req)
@@ -164,6 +345,81 @@ func (c *bloggingEventServiceClient) DetachTags(ctx context.Context, req *connec
 	return c.detachTags.CallUnary(ctx, req)
 }
 
+// HideArticle calls blogging_event.BloggingEventService.HideArticle.
+func (c *bloggingEventServiceClient) HideArticle(ctx context.Context, req *connect.Request[blogging_event.HideArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return c.hideArticle.CallUnary(ctx, req)
+}
+
+// UnhideArticle calls blogging_event.BloggingEventService.UnhideArticle.
+func (c *bloggingEventServiceClient) UnhideArticle(ctx context.Context, req *connect.Request[blogging_event.UnhideArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return c.unhideArticle.CallUnary(ctx, req)
+}
+
+// EditArticle calls blogging_event.BloggingEventService.EditArticle.
+func (c *bloggingEventServiceClient) EditArticle(ctx context.Context, req *connect.Request[blogging_event.EditArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return c.editArticle.CallUnary(ctx, req)
+}
+
+// ScheduleArticle calls blogging_event.BloggingEventService.ScheduleArticle.
+func (c *bloggingEventServiceClient) ScheduleArticle(ctx context.Context, req *connect.Request[blogging_event.ScheduleArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return c.scheduleArticle.CallUnary(ctx, req)
+}
+
+// PublishArticle calls blogging_event.BloggingEventService.PublishArticle.
+func (c *bloggingEventServiceClient) PublishArticle(ctx context.Context, req *connect.Request[blogging_event.PublishArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return c.publishArticle.CallUnary(ctx, req)
+}
+
+// RevertArticle calls blogging_event.BloggingEventService.RevertArticle.
+func (c *bloggingEventServiceClient) RevertArticle(ctx context.Context, req *connect.Request[blogging_event.RevertArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return c.revertArticle.CallUnary(ctx, req)
+}
+
+// RenameTag calls blogging_event.BloggingEventService.RenameTag.
+func (c *bloggingEventServiceClient) RenameTag(ctx context.Context, req *connect.Request[blogging_event.RenameTagRequest]) (*connect.Response[blogging_event.RenameTagResponse], error) {
+	return c.renameTag.CallUnary(ctx, req)
+}
+
+// MergeTags calls blogging_event.BloggingEventService.MergeTags.
+func (c *bloggingEventServiceClient) MergeTags(ctx context.Context, req *connect.Request[blogging_event.MergeTagsRequest]) (*connect.Response[blogging_event.MergeTagsResponse], error) {
+	return c.mergeTags.CallUnary(ctx, req)
+}
+
+// CreateSeries calls blogging_event.BloggingEventService.CreateSeries.
+func (c *bloggingEventServiceClient) CreateSeries(ctx context.Context, req *connect.Request[blogging_event.CreateSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error) {
+	return c.createSeries.CallUnary(ctx, req)
+}
+
+// AddArticleToSeries calls blogging_event.BloggingEventService.AddArticleToSeries.
+func (c *bloggingEventServiceClient) AddArticleToSeries(ctx context.Context, req *connect.Request[blogging_event.AddArticleToSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error) {
+	return c.addArticleToSeries.CallUnary(ctx, req)
+}
+
+// ReorderSeries calls blogging_event.BloggingEventService.ReorderSeries.
+func (c *bloggingEventServiceClient) ReorderSeries(ctx context.Context, req *connect.Request[blogging_event.ReorderSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error) {
+	return c.reorderSeries.CallUnary(ctx, req)
+}
+
+// GetDraft calls blogging_event.BloggingEventService.GetDraft.
+func (c *bloggingEventServiceClient) GetDraft(ctx context.Context, req *connect.Request[blogging_event.GetDraftRequest]) (*connect.Response[blogging_event.GetDraftResponse], error) {
+	return c.getDraft.CallUnary(ctx, req)
+}
+
+// ListDrafts calls blogging_event.BloggingEventService.ListDrafts.
+func (c *bloggingEventServiceClient) ListDrafts(ctx context.Context, req *connect.Request[blogging_event.ListDraftsRequest]) (*connect.Response[blogging_event.ListDraftsResponse], error) {
+	return c.listDrafts.CallUnary(ctx, req)
+}
+
+// ListArticleEvents calls blogging_event.BloggingEventService.ListArticleEvents.
+func (c *bloggingEventServiceClient) ListArticleEvents(ctx context.Context, req *connect.Request[blogging_event.ListArticleEventsRequest]) (*connect.Response[blogging_event.ListArticleEventsResponse], error) {
+	return c.listArticleEvents.CallUnary(ctx, req)
+}
+
+// GetArticleAt calls blogging_event.BloggingEventService.GetArticleAt.
+func (c *bloggingEventServiceClient) GetArticleAt(ctx context.Context, req *connect.Request[blogging_event.GetArticleAtRequest]) (*connect.Response[blogging_event.GetArticleAtResponse], error) {
+	return c.getArticleAt.CallUnary(ctx, req)
+}
+
 // UploadImage calls blogging_event.BloggingEventService.UploadImage.
 func (c *bloggingEventServiceClient) UploadImage(ctx context.Context) *connect.ClientStreamForClient[blogging_event.UploadImageRequest, blogging_event.UploadImageResponse] {
 	return c.uploadImage.CallClientStream(ctx)
@@ -175,9 +431,25 @@ type BloggingEventServiceHandler interface {
 	CreateArticle(context.Context, *connect.Request[blogging_event.CreateArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
 	UpdateArticleTitle(context.Context, *connect.Request[blogging_event.UpdateArticleTitleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
 	UpdateArticleBody(context.Context, *connect.Request[blogging_event.UpdateArticleBodyRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	UpdateArticleSlug(context.Context, *connect.Request[blogging_event.UpdateArticleSlugRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
 	UpdateArticleThumbnail(context.Context, *connect.Request[blogging_event.UpdateArticleThumbnailRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
 	AttachTags(context.Context, *connect.Request[blogging_event.AttachTagsRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
 	DetachTags(context.Context, *connect.Request[blogging_event.DetachTagsRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	HideArticle(context.Context, *connect.Request[blogging_event.HideArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	UnhideArticle(context.Context, *connect.Request[blogging_event.UnhideArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	EditArticle(context.Context, *connect.Request[blogging_event.EditArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	ScheduleArticle(context.Context, *connect.Request[blogging_event.ScheduleArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	PublishArticle(context.Context, *connect.Request[blogging_event.PublishArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	RevertArticle(context.Context, *connect.Request[blogging_event.RevertArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error)
+	RenameTag(context.Context, *connect.Request[blogging_event.RenameTagRequest]) (*connect.Response[blogging_event.RenameTagResponse], error)
+	MergeTags(context.Context, *connect.Request[blogging_event.MergeTagsRequest]) (*connect.Response[blogging_event.MergeTagsResponse], error)
+	CreateSeries(context.Context, *connect.Request[blogging_event.CreateSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error)
+	AddArticleToSeries(context.Context, *connect.Request[blogging_event.AddArticleToSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error)
+	ReorderSeries(context.Context, *connect.Request[blogging_event.ReorderSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error)
+	GetDraft(context.Context, *connect.Request[blogging_event.GetDraftRequest]) (*connect.Response[blogging_event.GetDraftResponse], error)
+	ListDrafts(context.Context, *connect.Request[blogging_event.ListDraftsRequest]) (*connect.Response[blogging_event.ListDraftsResponse], error)
+	ListArticleEvents(context.Context, *connect.Request[blogging_event.ListArticleEventsRequest]) (*connect.Response[blogging_event.ListArticleEventsResponse], error)
+	GetArticleAt(context.Context, *connect.Request[blogging_event.GetArticleAtRequest]) (*connect.Response[blogging_event.GetArticleAtResponse], error)
 	UploadImage(context.Context, *connect.ClientStream[blogging_event.UploadImageRequest]) (*connect.Response[blogging_event.UploadImageResponse], error)
 }
 
@@ -206,6 +478,12 @@ func NewBloggingEventServiceHandler(svc BloggingEventServiceHandler, opts ...con
 		connect.WithSchema(bloggingEventServiceMethods.ByName("UpdateArticleBody")),
 		connect.WithHandlerOptions(opts...),
 	)
+	bloggingEventServiceUpdateArticleSlugHandler := connect.NewUnaryHandler(
+		BloggingEventServiceUpdateArticleSlugProcedure,
+		svc.UpdateArticleSlug,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("UpdateArticleSlug")),
+		connect.WithHandlerOptions(opts...),
+	)
 	bloggingEventServiceUpdateArticleThumbnailHandler := connect.NewUnaryHandler(
 		BloggingEventServiceUpdateArticleThumbnailProcedure,
 		svc.UpdateArticleThumbnail,
@@ -224,6 +502,96 @@ func NewBloggingEventServiceHandler(svc BloggingEventServiceHandler, opts ...con
 		connect.WithSchema(bloggingEventServiceMethods.ByName("DetachTags")),
 		connect.WithHandlerOptions(opts...),
 	)
+	bloggingEventServiceHideArticleHandler := connect.NewUnaryHandler(
+		BloggingEventServiceHideArticleProcedure,
+		svc.HideArticle,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("HideArticle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceUnhideArticleHandler := connect.NewUnaryHandler(
+		BloggingEventServiceUnhideArticleProcedure,
+		svc.UnhideArticle,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("UnhideArticle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceEditArticleHandler := connect.NewUnaryHandler(
+		BloggingEventServiceEditArticleProcedure,
+		svc.EditArticle,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("EditArticle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceScheduleArticleHandler := connect.NewUnaryHandler(
+		BloggingEventServiceScheduleArticleProcedure,
+		svc.ScheduleArticle,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("ScheduleArticle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServicePublishArticleHandler := connect.NewUnaryHandler(
+		BloggingEventServicePublishArticleProcedure,
+		svc.PublishArticle,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("PublishArticle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceRevertArticleHandler := connect.NewUnaryHandler(
+		BloggingEventServiceRevertArticleProcedure,
+		svc.RevertArticle,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("RevertArticle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceRenameTagHandler := connect.NewUnaryHandler(
+		BloggingEventServiceRenameTagProcedure,
+		svc.RenameTag,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("RenameTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceMergeTagsHandler := connect.NewUnaryHandler(
+		BloggingEventServiceMergeTagsProcedure,
+		svc.MergeTags,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("MergeTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceCreateSeriesHandler := connect.NewUnaryHandler(
+		BloggingEventServiceCreateSeriesProcedure,
+		svc.CreateSeries,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("CreateSeries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceAddArticleToSeriesHandler := connect.NewUnaryHandler(
+		BloggingEventServiceAddArticleToSeriesProcedure,
+		svc.AddArticleToSeries,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("AddArticleToSeries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceReorderSeriesHandler := connect.NewUnaryHandler(
+		BloggingEventServiceReorderSeriesProcedure,
+		svc.ReorderSeries,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("ReorderSeries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceGetDraftHandler := connect.NewUnaryHandler(
+		BloggingEventServiceGetDraftProcedure,
+		svc.GetDraft,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("GetDraft")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceListDraftsHandler := connect.NewUnaryHandler(
+		BloggingEventServiceListDraftsProcedure,
+		svc.ListDrafts,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("ListDrafts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceListArticleEventsHandler := connect.NewUnaryHandler(
+		BloggingEventServiceListArticleEventsProcedure,
+		svc.ListArticleEvents,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("ListArticleEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	bloggingEventServiceGetArticleAtHandler := connect.NewUnaryHandler(
+		BloggingEventServiceGetArticleAtProcedure,
+		svc.GetArticleAt,
+		connect.WithSchema(bloggingEventServiceMethods.ByName("GetArticleAt")),
+		connect.WithHandlerOptions(opts...),
+	)
 	bloggingEventServiceUploadImageHandler := connect.NewClientStreamHandler(
 		BloggingEventServiceUploadImageProcedure,
 		svc.UploadImage,
@@ -238,12 +606,44 @@ func NewBloggingEventServiceHandler(svc BloggingEventServiceHandler, opts ...con
 			bloggingEventServiceUpdateArticleTitleHandler.ServeHTTP(w, r)
 		case BloggingEventServiceUpdateArticleBodyProcedure:
 			bloggingEventServiceUpdateArticleBodyHandler.ServeHTTP(w, r)
+		case BloggingEventServiceUpdateArticleSlugProcedure:
+			bloggingEventServiceUpdateArticleSlugHandler.ServeHTTP(w, r)
 		case BloggingEventServiceUpdateArticleThumbnailProcedure:
 			bloggingEventServiceUpdateArticleThumbnailHandler.ServeHTTP(w, r)
 		case BloggingEventServiceAttachTagsProcedure:
 			bloggingEventServiceAttachTagsHandler.ServeHTTP(w, r)
 		case BloggingEventServiceDetachTagsProcedure:
 			bloggingEventServiceDetachTagsHandler.ServeHTTP(w, r)
+		case BloggingEventServiceHideArticleProcedure:
+			bloggingEventServiceHideArticleHandler.ServeHTTP(w, r)
+		case BloggingEventServiceUnhideArticleProcedure:
+			bloggingEventServiceUnhideArticleHandler.ServeHTTP(w, r)
+		case BloggingEventServiceEditArticleProcedure:
+			bloggingEventServiceEditArticleHandler.ServeHTTP(w, r)
+		case BloggingEventServiceScheduleArticleProcedure:
+			bloggingEventServiceScheduleArticleHandler.ServeHTTP(w, r)
+		case BloggingEventServicePublishArticleProcedure:
+			bloggingEventServicePublishArticleHandler.ServeHTTP(w, r)
+		case BloggingEventServiceRevertArticleProcedure:
+			bloggingEventServiceRevertArticleHandler.ServeHTTP(w, r)
+		case BloggingEventServiceRenameTagProcedure:
+			bloggingEventServiceRenameTagHandler.ServeHTTP(w, r)
+		case BloggingEventServiceMergeTagsProcedure:
+			bloggingEventServiceMergeTagsHandler.ServeHTTP(w, r)
+		case BloggingEventServiceCreateSeriesProcedure:
+			bloggingEventServiceCreateSeriesHandler.ServeHTTP(w, r)
+		case BloggingEventServiceAddArticleToSeriesProcedure:
+			bloggingEventServiceAddArticleToSeriesHandler.ServeHTTP(w, r)
+		case BloggingEventServiceReorderSeriesProcedure:
+			bloggingEventServiceReorderSeriesHandler.ServeHTTP(w, r)
+		case BloggingEventServiceGetDraftProcedure:
+			bloggingEventServiceGetDraftHandler.ServeHTTP(w, r)
+		case BloggingEventServiceListDraftsProcedure:
+			bloggingEventServiceListDraftsHandler.ServeHTTP(w, r)
+		case BloggingEventServiceListArticleEventsProcedure:
+			bloggingEventServiceListArticleEventsHandler.ServeHTTP(w, r)
+		case BloggingEventServiceGetArticleAtProcedure:
+			bloggingEventServiceGetArticleAtHandler.ServeHTTP(w, r)
 		case BloggingEventServiceUploadImageProcedure:
 			bloggingEventServiceUploadImageHandler.ServeHTTP(w, r)
 		default:
@@ -267,6 +667,10 @@ func (UnimplementedBloggingEventServiceHandler) UpdateArticleBody(context.Contex
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.UpdateArticleBody is not implemented"))
 }
 
+func (UnimplementedBloggingEventServiceHandler) UpdateArticleSlug(context.Context, *connect.Request[blogging_event.UpdateArticleSlugRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.UpdateArticleSlug is not implemented"))
+}
+
 func (UnimplementedBloggingEventServiceHandler) UpdateArticleThumbnail(context.Context, *connect.Request[blogging_event.UpdateArticleThumbnailRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.UpdateArticleThumbnail is not implemented"))
 }
@@ -279,6 +683,66 @@ func (UnimplementedBloggingEventServiceHandler) DetachTags(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.DetachTags is not implemented"))
 }
 
+func (UnimplementedBloggingEventServiceHandler) HideArticle(context.Context, *connect.Request[blogging_event.HideArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.HideArticle is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) UnhideArticle(context.Context, *connect.Request[blogging_event.UnhideArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.UnhideArticle is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) EditArticle(context.Context, *connect.Request[blogging_event.EditArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.EditArticle is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) ScheduleArticle(context.Context, *connect.Request[blogging_event.ScheduleArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.ScheduleArticle is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) PublishArticle(context.Context, *connect.Request[blogging_event.PublishArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.PublishArticle is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) RevertArticle(context.Context, *connect.Request[blogging_event.RevertArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.RevertArticle is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) RenameTag(context.Context, *connect.Request[blogging_event.RenameTagRequest]) (*connect.Response[blogging_event.RenameTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.RenameTag is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) MergeTags(context.Context, *connect.Request[blogging_event.MergeTagsRequest]) (*connect.Response[blogging_event.MergeTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.MergeTags is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) CreateSeries(context.Context, *connect.Request[blogging_event.CreateSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.CreateSeries is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) AddArticleToSeries(context.Context, *connect.Request[blogging_event.AddArticleToSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.AddArticleToSeries is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) ReorderSeries(context.Context, *connect.Request[blogging_event.ReorderSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.ReorderSeries is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) GetDraft(context.Context, *connect.Request[blogging_event.GetDraftRequest]) (*connect.Response[blogging_event.GetDraftResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.GetDraft is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) ListDrafts(context.Context, *connect.Request[blogging_event.ListDraftsRequest]) (*connect.Response[blogging_event.ListDraftsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.ListDrafts is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) ListArticleEvents(context.Context, *connect.Request[blogging_event.ListArticleEventsRequest]) (*connect.Response[blogging_event.ListArticleEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.ListArticleEvents is not implemented"))
+}
+
+func (UnimplementedBloggingEventServiceHandler) GetArticleAt(context.Context, *connect.Request[blogging_event.GetArticleAtRequest]) (*connect.Response[blogging_event.GetArticleAtResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.GetArticleAt is not implemented"))
+}
+
 func (UnimplementedBloggingEventServiceHandler) UploadImage(context.Context, *connect.ClientStream[blogging_event.UploadImageRequest]) (*connect.Response[blogging_event.UploadImageResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blogging_event.BloggingEventService.UploadImage is not implemented"))
 }
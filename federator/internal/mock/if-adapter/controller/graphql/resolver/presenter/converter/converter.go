@@ -96,6 +96,45 @@ func (mr *MockArticlesConverterMockRecorder) ToArticles(ctx, from any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToArticles", reflect.TypeOf((*MockArticlesConverter)(nil).ToArticles), ctx, from)
 }
 
+// MockSeriesConverter is a mock of SeriesConverter interface.
+type MockSeriesConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockSeriesConverterMockRecorder is the mock recorder for MockSeriesConverter.
+type MockSeriesConverterMockRecorder struct {
+	mock *MockSeriesConverter
+}
+
+// NewMockSeriesConverter creates a new mock instance.
+func NewMockSeriesConverter(ctrl *gomock.Controller) *MockSeriesConverter {
+	mock := &MockSeriesConverter{ctrl: ctrl}
+	mock.recorder = &MockSeriesConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesConverter) EXPECT() *MockSeriesConverterMockRecorder {
+	return m.recorder
+}
+
+// ToSeries mocks base method.
+func (m *MockSeriesConverter) ToSeries(ctx context.Context, from dto.SeriesOutDTO) (*model.ArticleSeries, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToSeries", ctx, from)
+	ret0, _ := ret[0].(*model.ArticleSeries)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// ToSeries indicates an expected call of ToSeries.
+func (mr *MockSeriesConverterMockRecorder) ToSeries(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToSeries", reflect.TypeOf((*MockSeriesConverter)(nil).ToSeries), ctx, from)
+}
+
 // MockTagConverter is a mock of TagConverter interface.
 type MockTagConverter struct {
 	ctrl     *gomock.Controller
@@ -252,6 +291,45 @@ func (mr *MockUpdateArticleTitleConverterMockRecorder) ToUpdateArticleTitle(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToUpdateArticleTitle", reflect.TypeOf((*MockUpdateArticleTitleConverter)(nil).ToUpdateArticleTitle), ctx, from)
 }
 
+// MockUpdateArticleSlugConverter is a mock of UpdateArticleSlugConverter interface.
+type MockUpdateArticleSlugConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateArticleSlugConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateArticleSlugConverterMockRecorder is the mock recorder for MockUpdateArticleSlugConverter.
+type MockUpdateArticleSlugConverterMockRecorder struct {
+	mock *MockUpdateArticleSlugConverter
+}
+
+// NewMockUpdateArticleSlugConverter creates a new mock instance.
+func NewMockUpdateArticleSlugConverter(ctrl *gomock.Controller) *MockUpdateArticleSlugConverter {
+	mock := &MockUpdateArticleSlugConverter{ctrl: ctrl}
+	mock.recorder = &MockUpdateArticleSlugConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateArticleSlugConverter) EXPECT() *MockUpdateArticleSlugConverterMockRecorder {
+	return m.recorder
+}
+
+// ToUpdateArticleSlug mocks base method.
+func (m *MockUpdateArticleSlugConverter) ToUpdateArticleSlug(ctx context.Context, from dto.UpdateArticleSlugOutDTO) (*model.UpdateArticleSlugPayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToUpdateArticleSlug", ctx, from)
+	ret0, _ := ret[0].(*model.UpdateArticleSlugPayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToUpdateArticleSlug indicates an expected call of ToUpdateArticleSlug.
+func (mr *MockUpdateArticleSlugConverterMockRecorder) ToUpdateArticleSlug(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToUpdateArticleSlug", reflect.TypeOf((*MockUpdateArticleSlugConverter)(nil).ToUpdateArticleSlug), ctx, from)
+}
+
 // MockUpdateArticleBodyConverter is a mock of UpdateArticleBodyConverter interface.
 type MockUpdateArticleBodyConverter struct {
 	ctrl     *gomock.Controller
@@ -408,6 +486,591 @@ func (mr *MockDetachTagsConverterMockRecorder) ToDetachTags(ctx, from any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToDetachTags", reflect.TypeOf((*MockDetachTagsConverter)(nil).ToDetachTags), ctx, from)
 }
 
+// MockHideArticleConverter is a mock of HideArticleConverter interface.
+type MockHideArticleConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockHideArticleConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockHideArticleConverterMockRecorder is the mock recorder for MockHideArticleConverter.
+type MockHideArticleConverterMockRecorder struct {
+	mock *MockHideArticleConverter
+}
+
+// NewMockHideArticleConverter creates a new mock instance.
+func NewMockHideArticleConverter(ctrl *gomock.Controller) *MockHideArticleConverter {
+	mock := &MockHideArticleConverter{ctrl: ctrl}
+	mock.recorder = &MockHideArticleConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHideArticleConverter) EXPECT() *MockHideArticleConverterMockRecorder {
+	return m.recorder
+}
+
+// ToHideArticle mocks base method.
+func (m *MockHideArticleConverter) ToHideArticle(ctx context.Context, from dto.HideArticleOutDTO) (*model.HideArticlePayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToHideArticle", ctx, from)
+	ret0, _ := ret[0].(*model.HideArticlePayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToHideArticle indicates an expected call of ToHideArticle.
+func (mr *MockHideArticleConverterMockRecorder) ToHideArticle(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToHideArticle", reflect.TypeOf((*MockHideArticleConverter)(nil).ToHideArticle), ctx, from)
+}
+
+// MockUnhideArticleConverter is a mock of UnhideArticleConverter interface.
+type MockUnhideArticleConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnhideArticleConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockUnhideArticleConverterMockRecorder is the mock recorder for MockUnhideArticleConverter.
+type MockUnhideArticleConverterMockRecorder struct {
+	mock *MockUnhideArticleConverter
+}
+
+// NewMockUnhideArticleConverter creates a new mock instance.
+func NewMockUnhideArticleConverter(ctrl *gomock.Controller) *MockUnhideArticleConverter {
+	mock := &MockUnhideArticleConverter{ctrl: ctrl}
+	mock.recorder = &MockUnhideArticleConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnhideArticleConverter) EXPECT() *MockUnhideArticleConverterMockRecorder {
+	return m.recorder
+}
+
+// ToUnhideArticle mocks base method.
+func (m *MockUnhideArticleConverter) ToUnhideArticle(ctx context.Context, from dto.UnhideArticleOutDTO) (*model.UnhideArticlePayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToUnhideArticle", ctx, from)
+	ret0, _ := ret[0].(*model.UnhideArticlePayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToUnhideArticle indicates an expected call of ToUnhideArticle.
+func (mr *MockUnhideArticleConverterMockRecorder) ToUnhideArticle(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToUnhideArticle", reflect.TypeOf((*MockUnhideArticleConverter)(nil).ToUnhideArticle), ctx, from)
+}
+
+// MockEditArticleConverter is a mock of EditArticleConverter interface.
+type MockEditArticleConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockEditArticleConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockEditArticleConverterMockRecorder is the mock recorder for MockEditArticleConverter.
+type MockEditArticleConverterMockRecorder struct {
+	mock *MockEditArticleConverter
+}
+
+// NewMockEditArticleConverter creates a new mock instance.
+func NewMockEditArticleConverter(ctrl *gomock.Controller) *MockEditArticleConverter {
+	mock := &MockEditArticleConverter{ctrl: ctrl}
+	mock.recorder = &MockEditArticleConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEditArticleConverter) EXPECT() *MockEditArticleConverterMockRecorder {
+	return m.recorder
+}
+
+// ToEditArticle mocks base method.
+func (m *MockEditArticleConverter) ToEditArticle(ctx context.Context, from dto.EditArticleOutDTO) (*model.EditArticlePayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToEditArticle", ctx, from)
+	ret0, _ := ret[0].(*model.EditArticlePayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToEditArticle indicates an expected call of ToEditArticle.
+func (mr *MockEditArticleConverterMockRecorder) ToEditArticle(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToEditArticle", reflect.TypeOf((*MockEditArticleConverter)(nil).ToEditArticle), ctx, from)
+}
+
+// MockScheduleArticleConverter is a mock of ScheduleArticleConverter interface.
+type MockScheduleArticleConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleArticleConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduleArticleConverterMockRecorder is the mock recorder for MockScheduleArticleConverter.
+type MockScheduleArticleConverterMockRecorder struct {
+	mock *MockScheduleArticleConverter
+}
+
+// NewMockScheduleArticleConverter creates a new mock instance.
+func NewMockScheduleArticleConverter(ctrl *gomock.Controller) *MockScheduleArticleConverter {
+	mock := &MockScheduleArticleConverter{ctrl: ctrl}
+	mock.recorder = &MockScheduleArticleConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleArticleConverter) EXPECT() *MockScheduleArticleConverterMockRecorder {
+	return m.recorder
+}
+
+// ToScheduleArticle mocks base method.
+func (m *MockScheduleArticleConverter) ToScheduleArticle(ctx context.Context, from dto.ScheduleArticleOutDTO) (*model.ScheduleArticlePayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToScheduleArticle", ctx, from)
+	ret0, _ := ret[0].(*model.ScheduleArticlePayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToScheduleArticle indicates an expected call of ToScheduleArticle.
+func (mr *MockScheduleArticleConverterMockRecorder) ToScheduleArticle(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToScheduleArticle", reflect.TypeOf((*MockScheduleArticleConverter)(nil).ToScheduleArticle), ctx, from)
+}
+
+// MockPublishArticleConverter is a mock of PublishArticleConverter interface.
+type MockPublishArticleConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublishArticleConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockPublishArticleConverterMockRecorder is the mock recorder for MockPublishArticleConverter.
+type MockPublishArticleConverterMockRecorder struct {
+	mock *MockPublishArticleConverter
+}
+
+// NewMockPublishArticleConverter creates a new mock instance.
+func NewMockPublishArticleConverter(ctrl *gomock.Controller) *MockPublishArticleConverter {
+	mock := &MockPublishArticleConverter{ctrl: ctrl}
+	mock.recorder = &MockPublishArticleConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublishArticleConverter) EXPECT() *MockPublishArticleConverterMockRecorder {
+	return m.recorder
+}
+
+// ToPublishArticle mocks base method.
+func (m *MockPublishArticleConverter) ToPublishArticle(ctx context.Context, from dto.PublishArticleOutDTO) (*model.PublishArticlePayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToPublishArticle", ctx, from)
+	ret0, _ := ret[0].(*model.PublishArticlePayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToPublishArticle indicates an expected call of ToPublishArticle.
+func (mr *MockPublishArticleConverterMockRecorder) ToPublishArticle(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToPublishArticle", reflect.TypeOf((*MockPublishArticleConverter)(nil).ToPublishArticle), ctx, from)
+}
+
+// MockRevertArticleConverter is a mock of RevertArticleConverter interface.
+type MockRevertArticleConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevertArticleConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockRevertArticleConverterMockRecorder is the mock recorder for MockRevertArticleConverter.
+type MockRevertArticleConverterMockRecorder struct {
+	mock *MockRevertArticleConverter
+}
+
+// NewMockRevertArticleConverter creates a new mock instance.
+func NewMockRevertArticleConverter(ctrl *gomock.Controller) *MockRevertArticleConverter {
+	mock := &MockRevertArticleConverter{ctrl: ctrl}
+	mock.recorder = &MockRevertArticleConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevertArticleConverter) EXPECT() *MockRevertArticleConverterMockRecorder {
+	return m.recorder
+}
+
+// ToRevertArticle mocks base method.
+func (m *MockRevertArticleConverter) ToRevertArticle(ctx context.Context, from dto.RevertArticleOutDTO) (*model.RevertArticlePayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToRevertArticle", ctx, from)
+	ret0, _ := ret[0].(*model.RevertArticlePayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToRevertArticle indicates an expected call of ToRevertArticle.
+func (mr *MockRevertArticleConverterMockRecorder) ToRevertArticle(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToRevertArticle", reflect.TypeOf((*MockRevertArticleConverter)(nil).ToRevertArticle), ctx, from)
+}
+
+// MockRenameTagConverter is a mock of RenameTagConverter interface.
+type MockRenameTagConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRenameTagConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockRenameTagConverterMockRecorder is the mock recorder for MockRenameTagConverter.
+type MockRenameTagConverterMockRecorder struct {
+	mock *MockRenameTagConverter
+}
+
+// NewMockRenameTagConverter creates a new mock instance.
+func NewMockRenameTagConverter(ctrl *gomock.Controller) *MockRenameTagConverter {
+	mock := &MockRenameTagConverter{ctrl: ctrl}
+	mock.recorder = &MockRenameTagConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRenameTagConverter) EXPECT() *MockRenameTagConverterMockRecorder {
+	return m.recorder
+}
+
+// ToRenameTag mocks base method.
+func (m *MockRenameTagConverter) ToRenameTag(ctx context.Context, from dto.RenameTagOutDTO) (*model.RenameTagPayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToRenameTag", ctx, from)
+	ret0, _ := ret[0].(*model.RenameTagPayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToRenameTag indicates an expected call of ToRenameTag.
+func (mr *MockRenameTagConverterMockRecorder) ToRenameTag(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToRenameTag", reflect.TypeOf((*MockRenameTagConverter)(nil).ToRenameTag), ctx, from)
+}
+
+// MockMergeTagsConverter is a mock of MergeTagsConverter interface.
+type MockMergeTagsConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockMergeTagsConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockMergeTagsConverterMockRecorder is the mock recorder for MockMergeTagsConverter.
+type MockMergeTagsConverterMockRecorder struct {
+	mock *MockMergeTagsConverter
+}
+
+// NewMockMergeTagsConverter creates a new mock instance.
+func NewMockMergeTagsConverter(ctrl *gomock.Controller) *MockMergeTagsConverter {
+	mock := &MockMergeTagsConverter{ctrl: ctrl}
+	mock.recorder = &MockMergeTagsConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMergeTagsConverter) EXPECT() *MockMergeTagsConverterMockRecorder {
+	return m.recorder
+}
+
+// ToMergeTags mocks base method.
+func (m *MockMergeTagsConverter) ToMergeTags(ctx context.Context, from dto.MergeTagsOutDTO) (*model.MergeTagsPayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToMergeTags", ctx, from)
+	ret0, _ := ret[0].(*model.MergeTagsPayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToMergeTags indicates an expected call of ToMergeTags.
+func (mr *MockMergeTagsConverterMockRecorder) ToMergeTags(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMergeTags", reflect.TypeOf((*MockMergeTagsConverter)(nil).ToMergeTags), ctx, from)
+}
+
+// MockCreateSeriesConverter is a mock of CreateSeriesConverter interface.
+type MockCreateSeriesConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSeriesConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateSeriesConverterMockRecorder is the mock recorder for MockCreateSeriesConverter.
+type MockCreateSeriesConverterMockRecorder struct {
+	mock *MockCreateSeriesConverter
+}
+
+// NewMockCreateSeriesConverter creates a new mock instance.
+func NewMockCreateSeriesConverter(ctrl *gomock.Controller) *MockCreateSeriesConverter {
+	mock := &MockCreateSeriesConverter{ctrl: ctrl}
+	mock.recorder = &MockCreateSeriesConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSeriesConverter) EXPECT() *MockCreateSeriesConverterMockRecorder {
+	return m.recorder
+}
+
+// ToCreateSeries mocks base method.
+func (m *MockCreateSeriesConverter) ToCreateSeries(ctx context.Context, from dto.CreateSeriesOutDTO) (*model.CreateSeriesPayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToCreateSeries", ctx, from)
+	ret0, _ := ret[0].(*model.CreateSeriesPayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToCreateSeries indicates an expected call of ToCreateSeries.
+func (mr *MockCreateSeriesConverterMockRecorder) ToCreateSeries(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToCreateSeries", reflect.TypeOf((*MockCreateSeriesConverter)(nil).ToCreateSeries), ctx, from)
+}
+
+// MockAddArticleToSeriesConverter is a mock of AddArticleToSeriesConverter interface.
+type MockAddArticleToSeriesConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddArticleToSeriesConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockAddArticleToSeriesConverterMockRecorder is the mock recorder for MockAddArticleToSeriesConverter.
+type MockAddArticleToSeriesConverterMockRecorder struct {
+	mock *MockAddArticleToSeriesConverter
+}
+
+// NewMockAddArticleToSeriesConverter creates a new mock instance.
+func NewMockAddArticleToSeriesConverter(ctrl *gomock.Controller) *MockAddArticleToSeriesConverter {
+	mock := &MockAddArticleToSeriesConverter{ctrl: ctrl}
+	mock.recorder = &MockAddArticleToSeriesConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddArticleToSeriesConverter) EXPECT() *MockAddArticleToSeriesConverterMockRecorder {
+	return m.recorder
+}
+
+// ToAddArticleToSeries mocks base method.
+func (m *MockAddArticleToSeriesConverter) ToAddArticleToSeries(ctx context.Context, from dto.AddArticleToSeriesOutDTO) (*model.AddArticleToSeriesPayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToAddArticleToSeries", ctx, from)
+	ret0, _ := ret[0].(*model.AddArticleToSeriesPayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToAddArticleToSeries indicates an expected call of ToAddArticleToSeries.
+func (mr *MockAddArticleToSeriesConverterMockRecorder) ToAddArticleToSeries(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToAddArticleToSeries", reflect.TypeOf((*MockAddArticleToSeriesConverter)(nil).ToAddArticleToSeries), ctx, from)
+}
+
+// MockReorderSeriesConverter is a mock of ReorderSeriesConverter interface.
+type MockReorderSeriesConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockReorderSeriesConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockReorderSeriesConverterMockRecorder is the mock recorder for MockReorderSeriesConverter.
+type MockReorderSeriesConverterMockRecorder struct {
+	mock *MockReorderSeriesConverter
+}
+
+// NewMockReorderSeriesConverter creates a new mock instance.
+func NewMockReorderSeriesConverter(ctrl *gomock.Controller) *MockReorderSeriesConverter {
+	mock := &MockReorderSeriesConverter{ctrl: ctrl}
+	mock.recorder = &MockReorderSeriesConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReorderSeriesConverter) EXPECT() *MockReorderSeriesConverterMockRecorder {
+	return m.recorder
+}
+
+// ToReorderSeries mocks base method.
+func (m *MockReorderSeriesConverter) ToReorderSeries(ctx context.Context, from dto.ReorderSeriesOutDTO) (*model.ReorderSeriesPayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToReorderSeries", ctx, from)
+	ret0, _ := ret[0].(*model.ReorderSeriesPayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToReorderSeries indicates an expected call of ToReorderSeries.
+func (mr *MockReorderSeriesConverterMockRecorder) ToReorderSeries(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToReorderSeries", reflect.TypeOf((*MockReorderSeriesConverter)(nil).ToReorderSeries), ctx, from)
+}
+
+// MockDraftConverter is a mock of DraftConverter interface.
+type MockDraftConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockDraftConverterMockRecorder is the mock recorder for MockDraftConverter.
+type MockDraftConverterMockRecorder struct {
+	mock *MockDraftConverter
+}
+
+// NewMockDraftConverter creates a new mock instance.
+func NewMockDraftConverter(ctrl *gomock.Controller) *MockDraftConverter {
+	mock := &MockDraftConverter{ctrl: ctrl}
+	mock.recorder = &MockDraftConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDraftConverter) EXPECT() *MockDraftConverterMockRecorder {
+	return m.recorder
+}
+
+// ToDraft mocks base method.
+func (m *MockDraftConverter) ToDraft(ctx context.Context, from dto.DraftOutDTO) (*model.DraftNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToDraft", ctx, from)
+	ret0, _ := ret[0].(*model.DraftNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToDraft indicates an expected call of ToDraft.
+func (mr *MockDraftConverterMockRecorder) ToDraft(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToDraft", reflect.TypeOf((*MockDraftConverter)(nil).ToDraft), ctx, from)
+}
+
+// MockDraftsConverter is a mock of DraftsConverter interface.
+type MockDraftsConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftsConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockDraftsConverterMockRecorder is the mock recorder for MockDraftsConverter.
+type MockDraftsConverterMockRecorder struct {
+	mock *MockDraftsConverter
+}
+
+// NewMockDraftsConverter creates a new mock instance.
+func NewMockDraftsConverter(ctrl *gomock.Controller) *MockDraftsConverter {
+	mock := &MockDraftsConverter{ctrl: ctrl}
+	mock.recorder = &MockDraftsConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDraftsConverter) EXPECT() *MockDraftsConverterMockRecorder {
+	return m.recorder
+}
+
+// ToDrafts mocks base method.
+func (m *MockDraftsConverter) ToDrafts(ctx context.Context, from dto.DraftsOutDTO) ([]*model.DraftNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToDrafts", ctx, from)
+	ret0, _ := ret[0].([]*model.DraftNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToDrafts indicates an expected call of ToDrafts.
+func (mr *MockDraftsConverterMockRecorder) ToDrafts(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToDrafts", reflect.TypeOf((*MockDraftsConverter)(nil).ToDrafts), ctx, from)
+}
+
+// MockArticleHistoryConverter is a mock of ArticleHistoryConverter interface.
+type MockArticleHistoryConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleHistoryConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockArticleHistoryConverterMockRecorder is the mock recorder for MockArticleHistoryConverter.
+type MockArticleHistoryConverterMockRecorder struct {
+	mock *MockArticleHistoryConverter
+}
+
+// NewMockArticleHistoryConverter creates a new mock instance.
+func NewMockArticleHistoryConverter(ctrl *gomock.Controller) *MockArticleHistoryConverter {
+	mock := &MockArticleHistoryConverter{ctrl: ctrl}
+	mock.recorder = &MockArticleHistoryConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleHistoryConverter) EXPECT() *MockArticleHistoryConverterMockRecorder {
+	return m.recorder
+}
+
+// ToArticleHistory mocks base method.
+func (m *MockArticleHistoryConverter) ToArticleHistory(ctx context.Context, from dto.ArticleHistoryOutDTO) (*model.ArticleHistoryConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToArticleHistory", ctx, from)
+	ret0, _ := ret[0].(*model.ArticleHistoryConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToArticleHistory indicates an expected call of ToArticleHistory.
+func (mr *MockArticleHistoryConverterMockRecorder) ToArticleHistory(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToArticleHistory", reflect.TypeOf((*MockArticleHistoryConverter)(nil).ToArticleHistory), ctx, from)
+}
+
+// MockArticleAtConverter is a mock of ArticleAtConverter interface.
+type MockArticleAtConverter struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleAtConverterMockRecorder
+	isgomock struct{}
+}
+
+// MockArticleAtConverterMockRecorder is the mock recorder for MockArticleAtConverter.
+type MockArticleAtConverterMockRecorder struct {
+	mock *MockArticleAtConverter
+}
+
+// NewMockArticleAtConverter creates a new mock instance.
+func NewMockArticleAtConverter(ctrl *gomock.Controller) *MockArticleAtConverter {
+	mock := &MockArticleAtConverter{ctrl: ctrl}
+	mock.recorder = &MockArticleAtConverterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleAtConverter) EXPECT() *MockArticleAtConverterMockRecorder {
+	return m.recorder
+}
+
+// ToArticleAt mocks base method.
+func (m *MockArticleAtConverter) ToArticleAt(ctx context.Context, from dto.ArticleAtOutDTO) (*model.ArticleSnapshotNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToArticleAt", ctx, from)
+	ret0, _ := ret[0].(*model.ArticleSnapshotNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ToArticleAt indicates an expected call of ToArticleAt.
+func (mr *MockArticleAtConverterMockRecorder) ToArticleAt(ctx, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToArticleAt", reflect.TypeOf((*MockArticleAtConverter)(nil).ToArticleAt), ctx, from)
+}
+
 // MockUploadImageConverter is a mock of UploadImageConverter interface.
 type MockUploadImageConverter struct {
 	ctrl     *gomock.Controller
@@ -94,3 +94,42 @@ func (mr *MockArticlesMockRecorder) Execute(ctx, in any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockArticles)(nil).Execute), ctx, in)
 }
+
+// MockSeries is a mock of Series interface.
+type MockSeries struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesMockRecorder
+	isgomock struct{}
+}
+
+// MockSeriesMockRecorder is the mock recorder for MockSeries.
+type MockSeriesMockRecorder struct {
+	mock *MockSeries
+}
+
+// NewMockSeries creates a new mock instance.
+func NewMockSeries(ctrl *gomock.Controller) *MockSeries {
+	mock := &MockSeries{ctrl: ctrl}
+	mock.recorder = &MockSeriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeries) EXPECT() *MockSeriesMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSeries) Execute(ctx context.Context, in dto.SeriesInDTO) (dto.SeriesOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.SeriesOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSeriesMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSeries)(nil).Execute), ctx, in)
+}
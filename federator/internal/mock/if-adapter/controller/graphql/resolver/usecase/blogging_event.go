@@ -95,6 +95,45 @@ func (mr *MockUpdateArticleTitleMockRecorder) Execute(ctx, in any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateArticleTitle)(nil).Execute), ctx, in)
 }
 
+// MockUpdateArticleSlug is a mock of UpdateArticleSlug interface.
+type MockUpdateArticleSlug struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateArticleSlugMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateArticleSlugMockRecorder is the mock recorder for MockUpdateArticleSlug.
+type MockUpdateArticleSlugMockRecorder struct {
+	mock *MockUpdateArticleSlug
+}
+
+// NewMockUpdateArticleSlug creates a new mock instance.
+func NewMockUpdateArticleSlug(ctrl *gomock.Controller) *MockUpdateArticleSlug {
+	mock := &MockUpdateArticleSlug{ctrl: ctrl}
+	mock.recorder = &MockUpdateArticleSlugMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateArticleSlug) EXPECT() *MockUpdateArticleSlugMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUpdateArticleSlug) Execute(ctx context.Context, in dto.UpdateArticleSlugInDTO) (dto.UpdateArticleSlugOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.UpdateArticleSlugOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUpdateArticleSlugMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateArticleSlug)(nil).Execute), ctx, in)
+}
+
 // MockUpdateArticleBody is a mock of UpdateArticleBody interface.
 type MockUpdateArticleBody struct {
 	ctrl     *gomock.Controller
@@ -251,6 +290,591 @@ func (mr *MockDetachTagsMockRecorder) Execute(ctx, in any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDetachTags)(nil).Execute), ctx, in)
 }
 
+// MockHideArticle is a mock of HideArticle interface.
+type MockHideArticle struct {
+	ctrl     *gomock.Controller
+	recorder *MockHideArticleMockRecorder
+	isgomock struct{}
+}
+
+// MockHideArticleMockRecorder is the mock recorder for MockHideArticle.
+type MockHideArticleMockRecorder struct {
+	mock *MockHideArticle
+}
+
+// NewMockHideArticle creates a new mock instance.
+func NewMockHideArticle(ctrl *gomock.Controller) *MockHideArticle {
+	mock := &MockHideArticle{ctrl: ctrl}
+	mock.recorder = &MockHideArticleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHideArticle) EXPECT() *MockHideArticleMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockHideArticle) Execute(ctx context.Context, in dto.HideArticleInDTO) (dto.HideArticleOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.HideArticleOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockHideArticleMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockHideArticle)(nil).Execute), ctx, in)
+}
+
+// MockUnhideArticle is a mock of UnhideArticle interface.
+type MockUnhideArticle struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnhideArticleMockRecorder
+	isgomock struct{}
+}
+
+// MockUnhideArticleMockRecorder is the mock recorder for MockUnhideArticle.
+type MockUnhideArticleMockRecorder struct {
+	mock *MockUnhideArticle
+}
+
+// NewMockUnhideArticle creates a new mock instance.
+func NewMockUnhideArticle(ctrl *gomock.Controller) *MockUnhideArticle {
+	mock := &MockUnhideArticle{ctrl: ctrl}
+	mock.recorder = &MockUnhideArticleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnhideArticle) EXPECT() *MockUnhideArticleMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUnhideArticle) Execute(ctx context.Context, in dto.UnhideArticleInDTO) (dto.UnhideArticleOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.UnhideArticleOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUnhideArticleMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUnhideArticle)(nil).Execute), ctx, in)
+}
+
+// MockEditArticle is a mock of EditArticle interface.
+type MockEditArticle struct {
+	ctrl     *gomock.Controller
+	recorder *MockEditArticleMockRecorder
+	isgomock struct{}
+}
+
+// MockEditArticleMockRecorder is the mock recorder for MockEditArticle.
+type MockEditArticleMockRecorder struct {
+	mock *MockEditArticle
+}
+
+// NewMockEditArticle creates a new mock instance.
+func NewMockEditArticle(ctrl *gomock.Controller) *MockEditArticle {
+	mock := &MockEditArticle{ctrl: ctrl}
+	mock.recorder = &MockEditArticleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEditArticle) EXPECT() *MockEditArticleMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockEditArticle) Execute(ctx context.Context, in dto.EditArticleInDTO) (dto.EditArticleOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.EditArticleOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockEditArticleMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEditArticle)(nil).Execute), ctx, in)
+}
+
+// MockScheduleArticle is a mock of ScheduleArticle interface.
+type MockScheduleArticle struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleArticleMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduleArticleMockRecorder is the mock recorder for MockScheduleArticle.
+type MockScheduleArticleMockRecorder struct {
+	mock *MockScheduleArticle
+}
+
+// NewMockScheduleArticle creates a new mock instance.
+func NewMockScheduleArticle(ctrl *gomock.Controller) *MockScheduleArticle {
+	mock := &MockScheduleArticle{ctrl: ctrl}
+	mock.recorder = &MockScheduleArticleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleArticle) EXPECT() *MockScheduleArticleMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockScheduleArticle) Execute(ctx context.Context, in dto.ScheduleArticleInDTO) (dto.ScheduleArticleOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.ScheduleArticleOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockScheduleArticleMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockScheduleArticle)(nil).Execute), ctx, in)
+}
+
+// MockPublishArticle is a mock of PublishArticle interface.
+type MockPublishArticle struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublishArticleMockRecorder
+	isgomock struct{}
+}
+
+// MockPublishArticleMockRecorder is the mock recorder for MockPublishArticle.
+type MockPublishArticleMockRecorder struct {
+	mock *MockPublishArticle
+}
+
+// NewMockPublishArticle creates a new mock instance.
+func NewMockPublishArticle(ctrl *gomock.Controller) *MockPublishArticle {
+	mock := &MockPublishArticle{ctrl: ctrl}
+	mock.recorder = &MockPublishArticleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublishArticle) EXPECT() *MockPublishArticleMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockPublishArticle) Execute(ctx context.Context, in dto.PublishArticleInDTO) (dto.PublishArticleOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.PublishArticleOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockPublishArticleMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockPublishArticle)(nil).Execute), ctx, in)
+}
+
+// MockRevertArticle is a mock of RevertArticle interface.
+type MockRevertArticle struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevertArticleMockRecorder
+	isgomock struct{}
+}
+
+// MockRevertArticleMockRecorder is the mock recorder for MockRevertArticle.
+type MockRevertArticleMockRecorder struct {
+	mock *MockRevertArticle
+}
+
+// NewMockRevertArticle creates a new mock instance.
+func NewMockRevertArticle(ctrl *gomock.Controller) *MockRevertArticle {
+	mock := &MockRevertArticle{ctrl: ctrl}
+	mock.recorder = &MockRevertArticleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevertArticle) EXPECT() *MockRevertArticleMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRevertArticle) Execute(ctx context.Context, in dto.RevertArticleInDTO) (dto.RevertArticleOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.RevertArticleOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRevertArticleMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRevertArticle)(nil).Execute), ctx, in)
+}
+
+// MockRenameTag is a mock of RenameTag interface.
+type MockRenameTag struct {
+	ctrl     *gomock.Controller
+	recorder *MockRenameTagMockRecorder
+	isgomock struct{}
+}
+
+// MockRenameTagMockRecorder is the mock recorder for MockRenameTag.
+type MockRenameTagMockRecorder struct {
+	mock *MockRenameTag
+}
+
+// NewMockRenameTag creates a new mock instance.
+func NewMockRenameTag(ctrl *gomock.Controller) *MockRenameTag {
+	mock := &MockRenameTag{ctrl: ctrl}
+	mock.recorder = &MockRenameTagMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRenameTag) EXPECT() *MockRenameTagMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRenameTag) Execute(ctx context.Context, in dto.RenameTagInDTO) (dto.RenameTagOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.RenameTagOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRenameTagMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRenameTag)(nil).Execute), ctx, in)
+}
+
+// MockMergeTags is a mock of MergeTags interface.
+type MockMergeTags struct {
+	ctrl     *gomock.Controller
+	recorder *MockMergeTagsMockRecorder
+	isgomock struct{}
+}
+
+// MockMergeTagsMockRecorder is the mock recorder for MockMergeTags.
+type MockMergeTagsMockRecorder struct {
+	mock *MockMergeTags
+}
+
+// NewMockMergeTags creates a new mock instance.
+func NewMockMergeTags(ctrl *gomock.Controller) *MockMergeTags {
+	mock := &MockMergeTags{ctrl: ctrl}
+	mock.recorder = &MockMergeTagsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMergeTags) EXPECT() *MockMergeTagsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockMergeTags) Execute(ctx context.Context, in dto.MergeTagsInDTO) (dto.MergeTagsOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.MergeTagsOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockMergeTagsMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockMergeTags)(nil).Execute), ctx, in)
+}
+
+// MockCreateSeries is a mock of CreateSeries interface.
+type MockCreateSeries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSeriesMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateSeriesMockRecorder is the mock recorder for MockCreateSeries.
+type MockCreateSeriesMockRecorder struct {
+	mock *MockCreateSeries
+}
+
+// NewMockCreateSeries creates a new mock instance.
+func NewMockCreateSeries(ctrl *gomock.Controller) *MockCreateSeries {
+	mock := &MockCreateSeries{ctrl: ctrl}
+	mock.recorder = &MockCreateSeriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSeries) EXPECT() *MockCreateSeriesMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateSeries) Execute(ctx context.Context, in dto.CreateSeriesInDTO) (dto.CreateSeriesOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.CreateSeriesOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateSeriesMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateSeries)(nil).Execute), ctx, in)
+}
+
+// MockAddArticleToSeries is a mock of AddArticleToSeries interface.
+type MockAddArticleToSeries struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddArticleToSeriesMockRecorder
+	isgomock struct{}
+}
+
+// MockAddArticleToSeriesMockRecorder is the mock recorder for MockAddArticleToSeries.
+type MockAddArticleToSeriesMockRecorder struct {
+	mock *MockAddArticleToSeries
+}
+
+// NewMockAddArticleToSeries creates a new mock instance.
+func NewMockAddArticleToSeries(ctrl *gomock.Controller) *MockAddArticleToSeries {
+	mock := &MockAddArticleToSeries{ctrl: ctrl}
+	mock.recorder = &MockAddArticleToSeriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddArticleToSeries) EXPECT() *MockAddArticleToSeriesMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAddArticleToSeries) Execute(ctx context.Context, in dto.AddArticleToSeriesInDTO) (dto.AddArticleToSeriesOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.AddArticleToSeriesOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAddArticleToSeriesMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAddArticleToSeries)(nil).Execute), ctx, in)
+}
+
+// MockReorderSeries is a mock of ReorderSeries interface.
+type MockReorderSeries struct {
+	ctrl     *gomock.Controller
+	recorder *MockReorderSeriesMockRecorder
+	isgomock struct{}
+}
+
+// MockReorderSeriesMockRecorder is the mock recorder for MockReorderSeries.
+type MockReorderSeriesMockRecorder struct {
+	mock *MockReorderSeries
+}
+
+// NewMockReorderSeries creates a new mock instance.
+func NewMockReorderSeries(ctrl *gomock.Controller) *MockReorderSeries {
+	mock := &MockReorderSeries{ctrl: ctrl}
+	mock.recorder = &MockReorderSeriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReorderSeries) EXPECT() *MockReorderSeriesMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockReorderSeries) Execute(ctx context.Context, in dto.ReorderSeriesInDTO) (dto.ReorderSeriesOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.ReorderSeriesOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockReorderSeriesMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockReorderSeries)(nil).Execute), ctx, in)
+}
+
+// MockDraft is a mock of Draft interface.
+type MockDraft struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftMockRecorder
+	isgomock struct{}
+}
+
+// MockDraftMockRecorder is the mock recorder for MockDraft.
+type MockDraftMockRecorder struct {
+	mock *MockDraft
+}
+
+// NewMockDraft creates a new mock instance.
+func NewMockDraft(ctrl *gomock.Controller) *MockDraft {
+	mock := &MockDraft{ctrl: ctrl}
+	mock.recorder = &MockDraftMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDraft) EXPECT() *MockDraftMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDraft) Execute(ctx context.Context, in dto.DraftInDTO) (dto.DraftOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.DraftOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDraftMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDraft)(nil).Execute), ctx, in)
+}
+
+// MockDrafts is a mock of Drafts interface.
+type MockDrafts struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftsMockRecorder
+	isgomock struct{}
+}
+
+// MockDraftsMockRecorder is the mock recorder for MockDrafts.
+type MockDraftsMockRecorder struct {
+	mock *MockDrafts
+}
+
+// NewMockDrafts creates a new mock instance.
+func NewMockDrafts(ctrl *gomock.Controller) *MockDrafts {
+	mock := &MockDrafts{ctrl: ctrl}
+	mock.recorder = &MockDraftsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDrafts) EXPECT() *MockDraftsMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDrafts) Execute(ctx context.Context) (dto.DraftsOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx)
+	ret0, _ := ret[0].(dto.DraftsOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDraftsMockRecorder) Execute(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDrafts)(nil).Execute), ctx)
+}
+
+// MockArticleHistory is a mock of ArticleHistory interface.
+type MockArticleHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleHistoryMockRecorder
+	isgomock struct{}
+}
+
+// MockArticleHistoryMockRecorder is the mock recorder for MockArticleHistory.
+type MockArticleHistoryMockRecorder struct {
+	mock *MockArticleHistory
+}
+
+// NewMockArticleHistory creates a new mock instance.
+func NewMockArticleHistory(ctrl *gomock.Controller) *MockArticleHistory {
+	mock := &MockArticleHistory{ctrl: ctrl}
+	mock.recorder = &MockArticleHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleHistory) EXPECT() *MockArticleHistoryMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockArticleHistory) Execute(ctx context.Context, in dto.ArticleHistoryInDTO) (dto.ArticleHistoryOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.ArticleHistoryOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockArticleHistoryMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockArticleHistory)(nil).Execute), ctx, in)
+}
+
+// MockArticleAt is a mock of ArticleAt interface.
+type MockArticleAt struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleAtMockRecorder
+	isgomock struct{}
+}
+
+// MockArticleAtMockRecorder is the mock recorder for MockArticleAt.
+type MockArticleAtMockRecorder struct {
+	mock *MockArticleAt
+}
+
+// NewMockArticleAt creates a new mock instance.
+func NewMockArticleAt(ctrl *gomock.Controller) *MockArticleAt {
+	mock := &MockArticleAt{ctrl: ctrl}
+	mock.recorder = &MockArticleAtMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleAt) EXPECT() *MockArticleAtMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockArticleAt) Execute(ctx context.Context, in dto.ArticleAtInDTO) (dto.ArticleAtOutDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(dto.ArticleAtOutDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockArticleAtMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockArticleAt)(nil).Execute), ctx, in)
+}
+
 // MockUploadImage is a mock of UploadImage interface.
 type MockUploadImage struct {
 	ctrl     *gomock.Controller
@@ -73,6 +73,21 @@ func (mr *MockArticleServiceClientMockRecorder) GetArticleById(arg0, arg1 any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleById", reflect.TypeOf((*MockArticleServiceClient)(nil).GetArticleById), arg0, arg1)
 }
 
+// GetArticleBySlug mocks base method.
+func (m *MockArticleServiceClient) GetArticleBySlug(arg0 context.Context, arg1 *connect.Request[article.GetArticleBySlugRequest]) (*connect.Response[article.GetArticleBySlugResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleBySlug", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[article.GetArticleBySlugResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleBySlug indicates an expected call of GetArticleBySlug.
+func (mr *MockArticleServiceClientMockRecorder) GetArticleBySlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleBySlug", reflect.TypeOf((*MockArticleServiceClient)(nil).GetArticleBySlug), arg0, arg1)
+}
+
 // GetNextArticles mocks base method.
 func (m *MockArticleServiceClient) GetNextArticles(arg0 context.Context, arg1 *connect.Request[article.GetNextArticlesRequest]) (*connect.Response[article.GetNextArticlesResponse], error) {
 	m.ctrl.T.Helper()
@@ -103,6 +118,21 @@ func (mr *MockArticleServiceClientMockRecorder) GetPrevArticles(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrevArticles", reflect.TypeOf((*MockArticleServiceClient)(nil).GetPrevArticles), arg0, arg1)
 }
 
+// GetSeries mocks base method.
+func (m *MockArticleServiceClient) GetSeries(arg0 context.Context, arg1 *connect.Request[article.GetSeriesRequest]) (*connect.Response[article.GetSeriesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeries", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[article.GetSeriesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeries indicates an expected call of GetSeries.
+func (mr *MockArticleServiceClientMockRecorder) GetSeries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeries", reflect.TypeOf((*MockArticleServiceClient)(nil).GetSeries), arg0, arg1)
+}
+
 // MockArticleServiceHandler is a mock of ArticleServiceHandler interface.
 type MockArticleServiceHandler struct {
 	ctrl     *gomock.Controller
@@ -157,6 +187,21 @@ func (mr *MockArticleServiceHandlerMockRecorder) GetArticleById(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleById", reflect.TypeOf((*MockArticleServiceHandler)(nil).GetArticleById), arg0, arg1)
 }
 
+// GetArticleBySlug mocks base method.
+func (m *MockArticleServiceHandler) GetArticleBySlug(arg0 context.Context, arg1 *connect.Request[article.GetArticleBySlugRequest]) (*connect.Response[article.GetArticleBySlugResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleBySlug", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[article.GetArticleBySlugResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleBySlug indicates an expected call of GetArticleBySlug.
+func (mr *MockArticleServiceHandlerMockRecorder) GetArticleBySlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleBySlug", reflect.TypeOf((*MockArticleServiceHandler)(nil).GetArticleBySlug), arg0, arg1)
+}
+
 // GetNextArticles mocks base method.
 func (m *MockArticleServiceHandler) GetNextArticles(arg0 context.Context, arg1 *connect.Request[article.GetNextArticlesRequest]) (*connect.Response[article.GetNextArticlesResponse], error) {
 	m.ctrl.T.Helper()
@@ -186,3 +231,18 @@ func (mr *MockArticleServiceHandlerMockRecorder) GetPrevArticles(arg0, arg1 any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrevArticles", reflect.TypeOf((*MockArticleServiceHandler)(nil).GetPrevArticles), arg0, arg1)
 }
+
+// GetSeries mocks base method.
+func (m *MockArticleServiceHandler) GetSeries(arg0 context.Context, arg1 *connect.Request[article.GetSeriesRequest]) (*connect.Response[article.GetSeriesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeries", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[article.GetSeriesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeries indicates an expected call of GetSeries.
+func (mr *MockArticleServiceHandlerMockRecorder) GetSeries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeries", reflect.TypeOf((*MockArticleServiceHandler)(nil).GetSeries), arg0, arg1)
+}
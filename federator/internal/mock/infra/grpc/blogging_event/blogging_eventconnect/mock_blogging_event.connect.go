@@ -42,6 +42,21 @@ func (m *MockBloggingEventServiceClient) EXPECT() *MockBloggingEventServiceClien
 	return m.recorder
 }
 
+// AddArticleToSeries mocks base method.
+func (m *MockBloggingEventServiceClient) AddArticleToSeries(arg0 context.Context, arg1 *connect.Request[blogging_event.AddArticleToSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddArticleToSeries", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.SeriesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddArticleToSeries indicates an expected call of AddArticleToSeries.
+func (mr *MockBloggingEventServiceClientMockRecorder) AddArticleToSeries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddArticleToSeries", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).AddArticleToSeries), arg0, arg1)
+}
+
 // AttachTags mocks base method.
 func (m *MockBloggingEventServiceClient) AttachTags(arg0 context.Context, arg1 *connect.Request[blogging_event.AttachTagsRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
 	m.ctrl.T.Helper()
@@ -72,6 +87,21 @@ func (mr *MockBloggingEventServiceClientMockRecorder) CreateArticle(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticle", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).CreateArticle), arg0, arg1)
 }
 
+// CreateSeries mocks base method.
+func (m *MockBloggingEventServiceClient) CreateSeries(arg0 context.Context, arg1 *connect.Request[blogging_event.CreateSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSeries", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.SeriesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSeries indicates an expected call of CreateSeries.
+func (mr *MockBloggingEventServiceClientMockRecorder) CreateSeries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeries", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).CreateSeries), arg0, arg1)
+}
+
 // DetachTags mocks base method.
 func (m *MockBloggingEventServiceClient) DetachTags(arg0 context.Context, arg1 *connect.Request[blogging_event.DetachTagsRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
 	m.ctrl.T.Helper()
@@ -87,6 +117,201 @@ func (mr *MockBloggingEventServiceClientMockRecorder) DetachTags(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachTags", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).DetachTags), arg0, arg1)
 }
 
+// EditArticle mocks base method.
+func (m *MockBloggingEventServiceClient) EditArticle(arg0 context.Context, arg1 *connect.Request[blogging_event.EditArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditArticle", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditArticle indicates an expected call of EditArticle.
+func (mr *MockBloggingEventServiceClientMockRecorder) EditArticle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditArticle", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).EditArticle), arg0, arg1)
+}
+
+// GetArticleAt mocks base method.
+func (m *MockBloggingEventServiceClient) GetArticleAt(arg0 context.Context, arg1 *connect.Request[blogging_event.GetArticleAtRequest]) (*connect.Response[blogging_event.GetArticleAtResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleAt", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.GetArticleAtResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleAt indicates an expected call of GetArticleAt.
+func (mr *MockBloggingEventServiceClientMockRecorder) GetArticleAt(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleAt", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).GetArticleAt), arg0, arg1)
+}
+
+// GetDraft mocks base method.
+func (m *MockBloggingEventServiceClient) GetDraft(arg0 context.Context, arg1 *connect.Request[blogging_event.GetDraftRequest]) (*connect.Response[blogging_event.GetDraftResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDraft", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.GetDraftResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDraft indicates an expected call of GetDraft.
+func (mr *MockBloggingEventServiceClientMockRecorder) GetDraft(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraft", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).GetDraft), arg0, arg1)
+}
+
+// HideArticle mocks base method.
+func (m *MockBloggingEventServiceClient) HideArticle(arg0 context.Context, arg1 *connect.Request[blogging_event.HideArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideArticle", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HideArticle indicates an expected call of HideArticle.
+func (mr *MockBloggingEventServiceClientMockRecorder) HideArticle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideArticle", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).HideArticle), arg0, arg1)
+}
+
+// ListArticleEvents mocks base method.
+func (m *MockBloggingEventServiceClient) ListArticleEvents(arg0 context.Context, arg1 *connect.Request[blogging_event.ListArticleEventsRequest]) (*connect.Response[blogging_event.ListArticleEventsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticleEvents", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.ListArticleEventsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArticleEvents indicates an expected call of ListArticleEvents.
+func (mr *MockBloggingEventServiceClientMockRecorder) ListArticleEvents(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticleEvents", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).ListArticleEvents), arg0, arg1)
+}
+
+// ListDrafts mocks base method.
+func (m *MockBloggingEventServiceClient) ListDrafts(arg0 context.Context, arg1 *connect.Request[blogging_event.ListDraftsRequest]) (*connect.Response[blogging_event.ListDraftsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDrafts", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.ListDraftsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDrafts indicates an expected call of ListDrafts.
+func (mr *MockBloggingEventServiceClientMockRecorder) ListDrafts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDrafts", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).ListDrafts), arg0, arg1)
+}
+
+// MergeTags mocks base method.
+func (m *MockBloggingEventServiceClient) MergeTags(arg0 context.Context, arg1 *connect.Request[blogging_event.MergeTagsRequest]) (*connect.Response[blogging_event.MergeTagsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.MergeTagsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockBloggingEventServiceClientMockRecorder) MergeTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).MergeTags), arg0, arg1)
+}
+
+// PublishArticle mocks base method.
+func (m *MockBloggingEventServiceClient) PublishArticle(arg0 context.Context, arg1 *connect.Request[blogging_event.PublishArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishArticle", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishArticle indicates an expected call of PublishArticle.
+func (mr *MockBloggingEventServiceClientMockRecorder) PublishArticle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishArticle", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).PublishArticle), arg0, arg1)
+}
+
+// RenameTag mocks base method.
+func (m *MockBloggingEventServiceClient) RenameTag(arg0 context.Context, arg1 *connect.Request[blogging_event.RenameTagRequest]) (*connect.Response[blogging_event.RenameTagResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.RenameTagResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockBloggingEventServiceClientMockRecorder) RenameTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).RenameTag), arg0, arg1)
+}
+
+// ReorderSeries mocks base method.
+func (m *MockBloggingEventServiceClient) ReorderSeries(arg0 context.Context, arg1 *connect.Request[blogging_event.ReorderSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderSeries", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.SeriesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderSeries indicates an expected call of ReorderSeries.
+func (mr *MockBloggingEventServiceClientMockRecorder) ReorderSeries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderSeries", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).ReorderSeries), arg0, arg1)
+}
+
+// RevertArticle mocks base method.
+func (m *MockBloggingEventServiceClient) RevertArticle(arg0 context.Context, arg1 *connect.Request[blogging_event.RevertArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertArticle", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertArticle indicates an expected call of RevertArticle.
+func (mr *MockBloggingEventServiceClientMockRecorder) RevertArticle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertArticle", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).RevertArticle), arg0, arg1)
+}
+
+// ScheduleArticle mocks base method.
+func (m *MockBloggingEventServiceClient) ScheduleArticle(arg0 context.Context, arg1 *connect.Request[blogging_event.ScheduleArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleArticle", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleArticle indicates an expected call of ScheduleArticle.
+func (mr *MockBloggingEventServiceClientMockRecorder) ScheduleArticle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleArticle", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).ScheduleArticle), arg0, arg1)
+}
+
+// UnhideArticle mocks base method.
+func (m *MockBloggingEventServiceClient) UnhideArticle(arg0 context.Context, arg1 *connect.Request[blogging_event.UnhideArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnhideArticle", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnhideArticle indicates an expected call of UnhideArticle.
+func (mr *MockBloggingEventServiceClientMockRecorder) UnhideArticle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnhideArticle", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).UnhideArticle), arg0, arg1)
+}
+
 // UpdateArticleBody mocks base method.
 func (m *MockBloggingEventServiceClient) UpdateArticleBody(arg0 context.Context, arg1 *connect.Request[blogging_event.UpdateArticleBodyRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
 	m.ctrl.T.Helper()
@@ -102,6 +327,21 @@ func (mr *MockBloggingEventServiceClientMockRecorder) UpdateArticleBody(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticleBody", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).UpdateArticleBody), arg0, arg1)
 }
 
+// UpdateArticleSlug mocks base method.
+func (m *MockBloggingEventServiceClient) UpdateArticleSlug(arg0 context.Context, arg1 *connect.Request[blogging_event.UpdateArticleSlugRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArticleSlug", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateArticleSlug indicates an expected call of UpdateArticleSlug.
+func (mr *MockBloggingEventServiceClientMockRecorder) UpdateArticleSlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticleSlug", reflect.TypeOf((*MockBloggingEventServiceClient)(nil).UpdateArticleSlug), arg0, arg1)
+}
+
 // UpdateArticleThumbnail mocks base method.
 func (m *MockBloggingEventServiceClient) UpdateArticleThumbnail(arg0 context.Context, arg1 *connect.Request[blogging_event.UpdateArticleThumbnailRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
 	m.ctrl.T.Helper()
@@ -170,6 +410,21 @@ func (m *MockBloggingEventServiceHandler) EXPECT() *MockBloggingEventServiceHand
 	return m.recorder
 }
 
+// AddArticleToSeries mocks base method.
+func (m *MockBloggingEventServiceHandler) AddArticleToSeries(arg0 context.Context, arg1 *connect.Request[blogging_event.AddArticleToSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddArticleToSeries", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.SeriesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddArticleToSeries indicates an expected call of AddArticleToSeries.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) AddArticleToSeries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddArticleToSeries", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).AddArticleToSeries), arg0, arg1)
+}
+
 // AttachTags mocks base method.
 func (m *MockBloggingEventServiceHandler) AttachTags(arg0 context.Context, arg1 *connect.Request[blogging_event.AttachTagsRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
 	m.ctrl.T.Helper()
@@ -200,6 +455,21 @@ func (mr *MockBloggingEventServiceHandlerMockRecorder) CreateArticle(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArticle", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).CreateArticle), arg0, arg1)
 }
 
+// CreateSeries mocks base method.
+func (m *MockBloggingEventServiceHandler) CreateSeries(arg0 context.Context, arg1 *connect.Request[blogging_event.CreateSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSeries", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.SeriesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSeries indicates an expected call of CreateSeries.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) CreateSeries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeries", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).CreateSeries), arg0, arg1)
+}
+
 // DetachTags mocks base method.
 func (m *MockBloggingEventServiceHandler) DetachTags(arg0 context.Context, arg1 *connect.Request[blogging_event.DetachTagsRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
 	m.ctrl.T.Helper()
@@ -215,6 +485,201 @@ func (mr *MockBloggingEventServiceHandlerMockRecorder) DetachTags(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachTags", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).DetachTags), arg0, arg1)
 }
 
+// EditArticle mocks base method.
+func (m *MockBloggingEventServiceHandler) EditArticle(arg0 context.Context, arg1 *connect.Request[blogging_event.EditArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditArticle", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditArticle indicates an expected call of EditArticle.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) EditArticle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditArticle", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).EditArticle), arg0, arg1)
+}
+
+// GetArticleAt mocks base method.
+func (m *MockBloggingEventServiceHandler) GetArticleAt(arg0 context.Context, arg1 *connect.Request[blogging_event.GetArticleAtRequest]) (*connect.Response[blogging_event.GetArticleAtResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArticleAt", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.GetArticleAtResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArticleAt indicates an expected call of GetArticleAt.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) GetArticleAt(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArticleAt", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).GetArticleAt), arg0, arg1)
+}
+
+// GetDraft mocks base method.
+func (m *MockBloggingEventServiceHandler) GetDraft(arg0 context.Context, arg1 *connect.Request[blogging_event.GetDraftRequest]) (*connect.Response[blogging_event.GetDraftResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDraft", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.GetDraftResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDraft indicates an expected call of GetDraft.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) GetDraft(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraft", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).GetDraft), arg0, arg1)
+}
+
+// HideArticle mocks base method.
+func (m *MockBloggingEventServiceHandler) HideArticle(arg0 context.Context, arg1 *connect.Request[blogging_event.HideArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideArticle", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HideArticle indicates an expected call of HideArticle.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) HideArticle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideArticle", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).HideArticle), arg0, arg1)
+}
+
+// ListArticleEvents mocks base method.
+func (m *MockBloggingEventServiceHandler) ListArticleEvents(arg0 context.Context, arg1 *connect.Request[blogging_event.ListArticleEventsRequest]) (*connect.Response[blogging_event.ListArticleEventsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListArticleEvents", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.ListArticleEventsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListArticleEvents indicates an expected call of ListArticleEvents.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) ListArticleEvents(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListArticleEvents", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).ListArticleEvents), arg0, arg1)
+}
+
+// ListDrafts mocks base method.
+func (m *MockBloggingEventServiceHandler) ListDrafts(arg0 context.Context, arg1 *connect.Request[blogging_event.ListDraftsRequest]) (*connect.Response[blogging_event.ListDraftsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDrafts", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.ListDraftsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDrafts indicates an expected call of ListDrafts.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) ListDrafts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDrafts", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).ListDrafts), arg0, arg1)
+}
+
+// MergeTags mocks base method.
+func (m *MockBloggingEventServiceHandler) MergeTags(arg0 context.Context, arg1 *connect.Request[blogging_event.MergeTagsRequest]) (*connect.Response[blogging_event.MergeTagsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.MergeTagsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) MergeTags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).MergeTags), arg0, arg1)
+}
+
+// PublishArticle mocks base method.
+func (m *MockBloggingEventServiceHandler) PublishArticle(arg0 context.Context, arg1 *connect.Request[blogging_event.PublishArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishArticle", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishArticle indicates an expected call of PublishArticle.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) PublishArticle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishArticle", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).PublishArticle), arg0, arg1)
+}
+
+// RenameTag mocks base method.
+func (m *MockBloggingEventServiceHandler) RenameTag(arg0 context.Context, arg1 *connect.Request[blogging_event.RenameTagRequest]) (*connect.Response[blogging_event.RenameTagResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.RenameTagResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) RenameTag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).RenameTag), arg0, arg1)
+}
+
+// ReorderSeries mocks base method.
+func (m *MockBloggingEventServiceHandler) ReorderSeries(arg0 context.Context, arg1 *connect.Request[blogging_event.ReorderSeriesRequest]) (*connect.Response[blogging_event.SeriesResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderSeries", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.SeriesResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReorderSeries indicates an expected call of ReorderSeries.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) ReorderSeries(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderSeries", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).ReorderSeries), arg0, arg1)
+}
+
+// RevertArticle mocks base method.
+func (m *MockBloggingEventServiceHandler) RevertArticle(arg0 context.Context, arg1 *connect.Request[blogging_event.RevertArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertArticle", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertArticle indicates an expected call of RevertArticle.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) RevertArticle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertArticle", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).RevertArticle), arg0, arg1)
+}
+
+// ScheduleArticle mocks base method.
+func (m *MockBloggingEventServiceHandler) ScheduleArticle(arg0 context.Context, arg1 *connect.Request[blogging_event.ScheduleArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleArticle", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleArticle indicates an expected call of ScheduleArticle.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) ScheduleArticle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleArticle", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).ScheduleArticle), arg0, arg1)
+}
+
+// UnhideArticle mocks base method.
+func (m *MockBloggingEventServiceHandler) UnhideArticle(arg0 context.Context, arg1 *connect.Request[blogging_event.UnhideArticleRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnhideArticle", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnhideArticle indicates an expected call of UnhideArticle.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) UnhideArticle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnhideArticle", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).UnhideArticle), arg0, arg1)
+}
+
 // UpdateArticleBody mocks base method.
 func (m *MockBloggingEventServiceHandler) UpdateArticleBody(arg0 context.Context, arg1 *connect.Request[blogging_event.UpdateArticleBodyRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
 	m.ctrl.T.Helper()
@@ -230,6 +695,21 @@ func (mr *MockBloggingEventServiceHandlerMockRecorder) UpdateArticleBody(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticleBody", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).UpdateArticleBody), arg0, arg1)
 }
 
+// UpdateArticleSlug mocks base method.
+func (m *MockBloggingEventServiceHandler) UpdateArticleSlug(arg0 context.Context, arg1 *connect.Request[blogging_event.UpdateArticleSlugRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateArticleSlug", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[blogging_event.BloggingEventResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateArticleSlug indicates an expected call of UpdateArticleSlug.
+func (mr *MockBloggingEventServiceHandlerMockRecorder) UpdateArticleSlug(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateArticleSlug", reflect.TypeOf((*MockBloggingEventServiceHandler)(nil).UpdateArticleSlug), arg0, arg1)
+}
+
 // UpdateArticleThumbnail mocks base method.
 func (m *MockBloggingEventServiceHandler) UpdateArticleThumbnail(arg0 context.Context, arg1 *connect.Request[blogging_event.UpdateArticleThumbnailRequest]) (*connect.Response[blogging_event.BloggingEventResponse], error) {
 	m.ctrl.T.Helper()
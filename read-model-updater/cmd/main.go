@@ -35,6 +35,7 @@ func main() {
 
 	go polling(ctx, messageCh, errCh, dependencies.QueueClient, dependencies.QueueURL)
 	go work(ctx, workerQueue, dependencies.NewRelicApp, dependencies.QueueClient, dependencies.QueueURL, dependencies.SyncHandler)
+	go schedule(ctx, dependencies.NewRelicApp, dependencies.ScheduleHandler)
 
 	for {
 		select {
@@ -177,3 +178,40 @@ func work(
 		}
 	}
 }
+
+// schedule periodically projects the scheduled articles whose publish time has passed.
+func schedule(
+	ctx context.Context,
+	nr *newrelic.Application,
+	scheduleHandler *handler.ScheduleHandler,
+) {
+	c := time.Tick(time.Minute)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case t := <-c:
+			func() {
+				tx := nr.StartTransaction("schedule-worker")
+				defer tx.End()
+
+				now := synchro.In[tz.UTC](t)
+				blogAPICtx := blogapictx.New(now.Format(time.RFC3339), "", "schedule", nil, nil)
+				ctx := newrelic.NewContext(blogapictx.StoreToContext(ctx, blogAPICtx), tx)
+
+				err := retry.Do(
+					func() error {
+						err := scheduleHandler.Invoke(ctx, now)
+						if err != nil {
+							return errors.WithStack(err)
+						}
+						return nil
+					},
+				)
+				if err != nil {
+					tx.NoticeError(nrpkgerrors.Wrap(errors.Wrap(err, "failed to publish scheduled articles")))
+				}
+			}()
+		}
+	}
+}
@@ -3,7 +3,7 @@ module blogapi.miyamo.today/read-model-updater
 go 1.25.1
 
 require (
-	blogapi.miyamo.today/core v0.24.0
+	blogapi.miyamo.today/core v0.29.0
 	github.com/Code-Hex/synchro v0.5.4
 	github.com/avast/retry-go v3.0.0+incompatible
 	github.com/aws/aws-sdk-go-v2 v1.40.0
@@ -3,8 +3,10 @@ package command
 import (
 	"context"
 
+	"blogapi.miyamo.today/read-model-updater/internal/domain/model"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/rdb/sqlc/article"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/rdb/sqlc/tag"
+	"blogapi.miyamo.today/read-model-updater/internal/infra/rdb/types"
 	"github.com/jackc/pgx/v5"
 )
 
@@ -12,7 +14,14 @@ import (
 type Article interface {
 	AttachTags(ctx context.Context, articleID string) error
 	CreateTempTagsTable(ctx context.Context) error
+	DeleteArticle(ctx context.Context, id string) error
+	DeleteArticleSchedule(ctx context.Context, articleID string) error
+	DeleteArticleSlugs(ctx context.Context, articleID string) error
+	DetachAllTags(ctx context.Context, articleID string) error
+	ListDueArticleSchedules(ctx context.Context, publishAt types.UTCTime) ([]string, error)
 	PutArticle(ctx context.Context, arg article.PutArticleParams) error
+	PutArticleSchedule(ctx context.Context, arg article.PutArticleScheduleParams) error
+	PutArticleSlugs(ctx context.Context, arg article.PutArticleSlugsParams) error
 	PreAttachTags(ctx context.Context, arg []article.PreAttachTagsParams) (int64, error)
 }
 
@@ -20,12 +29,21 @@ type Article interface {
 type Tag interface {
 	CreateTempArticlesTable(ctx context.Context) error
 	CreateTempTagsTable(ctx context.Context) error
+	DeleteArticle(ctx context.Context, id string) error
+	DeleteArticleSchedule(ctx context.Context, articleID string) error
+	DeleteTagsWithoutArticles(ctx context.Context, tagIDs []string) error
 	PutArticle(ctx context.Context, id string) error
+	PutArticleSchedule(ctx context.Context, arg tag.PutArticleScheduleParams) error
 	PutTags(ctx context.Context, tagIDs []string) error
 	PrePutArticle(ctx context.Context, arg []tag.PrePutArticleParams) (int64, error)
 	PrePutTags(ctx context.Context, arg []tag.PrePutTagsParams) (int64, error)
 }
 
+// ArticleSnapshot provides commands for snapshots of the blogging event stream.
+type ArticleSnapshot interface {
+	PutSnapshot(ctx context.Context, snapshot model.ArticleSnapshot) error
+}
+
 // ArticleTx provides transaction for Article.
 type ArticleTx interface {
 	Begin(tx pgx.Tx) Article
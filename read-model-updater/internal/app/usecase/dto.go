@@ -16,5 +16,7 @@ type SyncUsecaseInDto struct {
 	AttachTags []string             `dynamodbav:"attach_tags"`
 	DetachTags []string             `dynamodbav:"detach_tags"`
 	Invisible  *bool                `dynamodbav:"invisible"`
+	PublishAt  *string              `dynamodbav:"publish_at"`
+	Draft      *bool                `dynamodbav:"draft"`
 	EventAt    synchro.Time[tz.UTC] `dynamodbav:"-"`
 }
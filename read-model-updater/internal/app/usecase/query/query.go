@@ -8,6 +8,9 @@ import (
 
 // BloggingEventService is a query service interface for the BloggingEvent.
 type BloggingEventService interface {
-	// ListEventsByArticleID returns all blogging events by article id.
-	ListEventsByArticleID(ctx context.Context, articleID string) ([]model.BloggingEvent, error)
+	// ListEventsByArticleID returns the blogging events of the article written after afterEventID, oldest first.
+	// An empty afterEventID returns all of them.
+	ListEventsByArticleID(ctx context.Context, articleID, afterEventID string) ([]model.BloggingEvent, error)
+	// LatestSnapshotByArticleID returns the latest snapshot of the article, or nil if none has been taken.
+	LatestSnapshotByArticleID(ctx context.Context, articleID string) (*model.ArticleSnapshot, error)
 }
@@ -6,12 +6,14 @@ import (
 	"slices"
 	"time"
 
+	coreArticle "blogapi.miyamo.today/core/article"
 	"blogapi.miyamo.today/read-model-updater/internal/app/usecase/command"
 	"blogapi.miyamo.today/read-model-updater/internal/app/usecase/externalapi"
 	"blogapi.miyamo.today/read-model-updater/internal/app/usecase/query"
 	"blogapi.miyamo.today/read-model-updater/internal/domain/model"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/rdb/sqlc/article"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/rdb/sqlc/tag"
+	"blogapi.miyamo.today/read-model-updater/internal/infra/rdb/types"
 	"github.com/Code-Hex/synchro"
 	"github.com/Code-Hex/synchro/tz"
 	"github.com/cockroachdb/errors"
@@ -22,9 +24,13 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// snapshotInterval is the number of events replayed on a sync above which a new snapshot is taken.
+const snapshotInterval = 50
+
 // Sync is an usecese of sync
 type Sync struct {
 	bloggingEventQueryService query.BloggingEventService
+	articleSnapshotCommand    command.ArticleSnapshot
 	articleTx                 command.ArticleTx
 	tagTx                     command.TagTx
 	blogAPIPublisher          externalapi.BlogPublisher
@@ -49,6 +55,41 @@ func (u *Sync) SyncBlogSnapshotWithEvents(ctx context.Context, dto *SyncUsecaseI
 	return nil
 }
 
+// PublishScheduledArticles projects the scheduled articles whose publish time has passed and publishes the blog.
+func (u *Sync) PublishScheduledArticles(ctx context.Context, now synchro.Time[tz.UTC]) error {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Sync#PublishScheduledArticles").End()
+
+	articleTx, err := u.articleDBPool.BeginTx(
+		ctx, pgx.TxOptions{
+			IsoLevel:   pgx.ReadCommitted,
+			AccessMode: pgx.ReadOnly,
+		},
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	articleIDs, err := u.articleTx.Begin(articleTx).ListDueArticleSchedules(ctx, now)
+	_ = articleTx.Rollback(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if len(articleIDs) == 0 {
+		return nil
+	}
+
+	for _, articleID := range articleIDs {
+		err := u.execute(ctx, &SyncUsecaseInDto{ArticleID: articleID, EventAt: now})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	if err := u.blogAPIPublisher.Publish(ctx); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
 func (u *Sync) execute(ctx context.Context, dto *SyncUsecaseInDto) error {
 	nrtx := newrelic.FromContext(ctx)
 	defer nrtx.StartSegment("Sync#execute").End()
@@ -66,12 +107,33 @@ func (u *Sync) execute(ctx context.Context, dto *SyncUsecaseInDto) error {
 		slog.Time("event_at", dto.EventAt.StdTime()),
 	)
 
-	bloggingEvents, err := u.bloggingEventQueryService.ListEventsByArticleID(ctx, dto.ArticleID)
+	snapshot, err := u.bloggingEventQueryService.LatestSnapshotByArticleID(ctx, dto.ArticleID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	var afterEventID string
+	if snapshot != nil {
+		afterEventID = snapshot.EventID()
+	}
+	bloggingEvents, err := u.bloggingEventQueryService.ListEventsByArticleID(ctx, dto.ArticleID, afterEventID)
 	if err != nil {
 		return errors.WithStack(err)
 	}
 
-	articleCommand := model.ArticleCommandFromBloggingEvents(bloggingEvents)
+	articleCommand := model.ArticleCommandFromSnapshot(snapshot, bloggingEvents)
+	retiredTagIDs := model.RetiredTagIDs(bloggingEvents)
+	if len(bloggingEvents) >= snapshotInterval {
+		latest := model.NewArticleSnapshot(bloggingEvents[len(bloggingEvents)-1].EventID(), *articleCommand)
+		// the snapshot only saves work on later syncs, so failing to take it does not fail this one.
+		if err := u.articleSnapshotCommand.PutSnapshot(ctx, latest); err != nil {
+			slog.Default().WarnContext(
+				ctx,
+				"Failed to take a snapshot",
+				slog.String("article_id", dto.ArticleID),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
 
 	articleTx, err := u.articleDBPool.BeginTx(
 		ctx, pgx.TxOptions{
@@ -102,6 +164,13 @@ func (u *Sync) execute(ctx context.Context, dto *SyncUsecaseInDto) error {
 	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
+	if articleCommand.Invisible() || articleCommand.Draft() {
+		return u.remove(ctx, articleCommand.ID(), nil, retiredTagIDs, articleTx, tagTx)
+	}
+	if publishAt := articleCommand.PublishAt(); publishAt.After(synchro.Now[tz.UTC]()) {
+		return u.remove(ctx, articleCommand.ID(), &publishAt, retiredTagIDs, articleTx, tagTx)
+	}
+
 	articleULID, err := ulid.Parse(articleCommand.ID())
 	if err != nil {
 		return errors.WithStack(err)
@@ -121,12 +190,29 @@ func (u *Sync) execute(ctx context.Context, dto *SyncUsecaseInDto) error {
 			}
 			err = q.PutArticle(
 				ctx, article.PutArticleParams{
-					ID:        articleCommand.ID(),
-					Title:     articleCommand.Title(),
-					Body:      articleCommand.Body(),
-					Thumbnail: articleCommand.Thumbnail(),
-					CreatedAt: synchro.In[tz.UTC](articleULID.Timestamp()),
-					UpdatedAt: dto.EventAt,
+					ID:                articleCommand.ID(),
+					Title:             articleCommand.Title(),
+					Body:              articleCommand.Body(),
+					Thumbnail:         articleCommand.Thumbnail(),
+					ThumbnailVariants: thumbnailVariantsOf(articleCommand.ThumbnailVariants()),
+					Slug:              articleCommand.Slug(),
+					CreatedBy:         articleCommand.CreatedBy(),
+					UpdatedBy:         articleCommand.UpdatedBy(),
+					SeriesID:          articleCommand.Series().ID(),
+					SeriesTitle:       articleCommand.Series().Title(),
+					SeriesPosition:    int32(articleCommand.Series().Position()),
+					CreatedAt:         synchro.In[tz.UTC](articleULID.Timestamp()),
+					UpdatedAt:         dto.EventAt,
+				},
+			)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			// former slugs are kept, so that they can be redirected to the article.
+			err = q.PutArticleSlugs(
+				ctx, article.PutArticleSlugsParams{
+					ArticleID: articleCommand.ID(),
+					Slugs:     articleCommand.Slugs(),
 				},
 			)
 			if err != nil {
@@ -159,6 +245,10 @@ func (u *Sync) execute(ctx context.Context, dto *SyncUsecaseInDto) error {
 			if err != nil {
 				return errors.WithStack(err)
 			}
+			err = q.DeleteArticleSchedule(ctx, articleCommand.ID())
+			if err != nil {
+				return errors.WithStack(err)
+			}
 			return articleTx.Commit(ctx)
 		},
 	)
@@ -239,6 +329,109 @@ func (u *Sync) execute(ctx context.Context, dto *SyncUsecaseInDto) error {
 			if err != nil {
 				return errors.WithStack(err)
 			}
+			if len(retiredTagIDs) > 0 {
+				err = q.DeleteTagsWithoutArticles(ctx, retiredTagIDs)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+			}
+			err = q.DeleteArticleSchedule(ctx, articleCommand.ID())
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return tagTx.Commit(ctx)
+		},
+	)
+	if err := errGroup.Wait(); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// remove deletes the hidden, draft or scheduled article from both the article and tag read models.
+// If publishAt is given, the article is scheduled to be projected at that time. Otherwise, any pending schedule is cleared.
+// The retired tags are deleted from the tag read model if no article is left with them.
+func (u *Sync) remove(ctx context.Context, articleID string, publishAt *synchro.Time[tz.UTC], retiredTagIDs []string, articleTx, tagTx pgx.Tx) error {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("Sync#remove").End()
+
+	if publishAt != nil {
+		slog.Default().InfoContext(
+			ctx,
+			"Removing scheduled article",
+			slog.String("article_id", articleID),
+			slog.Time("publish_at", publishAt.StdTime()),
+		)
+	} else {
+		slog.Default().InfoContext(ctx, "Removing unpublished article", slog.String("article_id", articleID))
+	}
+
+	errGroup, egCtx := errgroup.WithContext(ctx)
+	errGroup.Go(
+		func() error {
+			nrtx := nrtx.NewGoroutine()
+			ctx := newrelic.NewContext(egCtx, nrtx)
+			defer nrtx.StartSegment("ArticleTx").End()
+
+			q := u.articleTx.Begin(articleTx)
+			err := q.DetachAllTags(ctx, articleID)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			err = q.DeleteArticleSlugs(ctx, articleID)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			err = q.DeleteArticle(ctx, articleID)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if publishAt != nil {
+				err = q.PutArticleSchedule(
+					ctx, article.PutArticleScheduleParams{
+						ArticleID: articleID,
+						PublishAt: *publishAt,
+					},
+				)
+			} else {
+				err = q.DeleteArticleSchedule(ctx, articleID)
+			}
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			return articleTx.Commit(ctx)
+		},
+	)
+	errGroup.Go(
+		func() error {
+			nrtx := nrtx.NewGoroutine()
+			ctx := newrelic.NewContext(egCtx, nrtx)
+			defer nrtx.StartSegment("TagTx").End()
+
+			q := u.tagTx.Begin(tagTx)
+			err := q.DeleteArticle(ctx, articleID)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if len(retiredTagIDs) > 0 {
+				err = q.DeleteTagsWithoutArticles(ctx, retiredTagIDs)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+			}
+			if publishAt != nil {
+				err = q.PutArticleSchedule(
+					ctx, tag.PutArticleScheduleParams{
+						ArticleID: articleID,
+						PublishAt: *publishAt,
+					},
+				)
+			} else {
+				err = q.DeleteArticleSchedule(ctx, articleID)
+			}
+			if err != nil {
+				return errors.WithStack(err)
+			}
 			return tagTx.Commit(ctx)
 		},
 	)
@@ -251,6 +444,7 @@ func (u *Sync) execute(ctx context.Context, dto *SyncUsecaseInDto) error {
 // NewSync returns new Sync
 func NewSync(
 	bloggingEventQueryService query.BloggingEventService,
+	articleSnapshotCommand command.ArticleSnapshot,
 	articleTx command.ArticleTx,
 	tagTx command.TagTx,
 	articleDBPool ArticleDBPool,
@@ -260,9 +454,24 @@ func NewSync(
 	return &Sync{
 		articleTx:                 articleTx,
 		bloggingEventQueryService: bloggingEventQueryService,
+		articleSnapshotCommand:    articleSnapshotCommand,
 		tagTx:                     tagTx,
 		blogAPIPublisher:          blogAPIPublisher,
 		articleDBPool:             articleDBPool,
 		tagDBPool:                 tagDBPool,
 	}
 }
+
+// thumbnailVariantsOf converts the variants of the thumbnail to the thumbnail_variants column.
+func thumbnailVariantsOf(variants []coreArticle.ImageVariant) types.ImageVariants {
+	result := make(types.ImageVariants, 0, len(variants))
+	for _, v := range variants {
+		result = append(result, types.ImageVariant{
+			URL:         v.URL(),
+			Width:       v.Width(),
+			Height:      v.Height(),
+			ContentType: v.ContentType(),
+		})
+	}
+	return result
+}
@@ -8,11 +8,12 @@ import (
 )
 
 type Dependencies struct {
-	AWSConfig   *aws.Config
-	NewRelicApp *newrelic.Application
-	SyncHandler *handler.SyncHandler
-	QueueClient queue.Client
-	QueueURL    QueueURL
+	AWSConfig       *aws.Config
+	NewRelicApp     *newrelic.Application
+	SyncHandler     *handler.SyncHandler
+	ScheduleHandler *handler.ScheduleHandler
+	QueueClient     queue.Client
+	QueueURL        QueueURL
 }
 
 type QueueURL *string
@@ -21,14 +22,16 @@ func newDependencies(
 	awsConfig *aws.Config,
 	newrelicApp *newrelic.Application,
 	syncHandler *handler.SyncHandler,
+	scheduleHandler *handler.ScheduleHandler,
 	queueClient queue.Client,
 	queueURL QueueURL,
 ) *Dependencies {
 	return &Dependencies{
-		AWSConfig:   awsConfig,
-		NewRelicApp: newrelicApp,
-		SyncHandler: syncHandler,
-		QueueClient: queueClient,
-		QueueURL:    queueURL,
+		AWSConfig:       awsConfig,
+		NewRelicApp:     newrelicApp,
+		SyncHandler:     syncHandler,
+		ScheduleHandler: scheduleHandler,
+		QueueClient:     queueClient,
+		QueueURL:        queueURL,
 	}
 }
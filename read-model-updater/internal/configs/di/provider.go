@@ -12,6 +12,8 @@ import (
 	"blogapi.miyamo.today/read-model-updater/internal/app/usecase/query"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/dynamo"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/githubactions"
+	"blogapi.miyamo.today/read-model-updater/internal/infra/local"
+	"blogapi.miyamo.today/read-model-updater/internal/infra/queue"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/rdb/sqlc/article"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/rdb/sqlc/tag"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -86,7 +88,24 @@ func provideTagQuery(pool usecase.TagDBPool) *tag.Queries {
 	return tag.New((*pgxpool.Pool)(pool))
 }
 
-func provideDynamoDB(awsConfig *aws.Config) dynamo.DB {
+// provideLocalEventStore opens the local event store if BLOGGING_EVENT_STORE is "local", and returns nil otherwise.
+// The store is kept in BLOGGING_EVENT_LOG_DIR, which must be the directory the blogging-event-service writes to.
+func provideLocalEventStore() *local.Store {
+	if os.Getenv("BLOGGING_EVENT_STORE") != "local" {
+		return nil
+	}
+	store, err := local.Open(os.Getenv("BLOGGING_EVENT_LOG_DIR"))
+	if err != nil {
+		panic(err) // because they are critical errors
+	}
+	return store
+}
+
+func provideDynamoDB(awsConfig *aws.Config, localStore *local.Store) dynamo.DB {
+	if localStore != nil {
+		// DynamoDB is not used with the local event store.
+		return nil
+	}
 	db := sql.OpenDB(pqxd.NewConnector(*awsConfig))
 	err := db.Ping()
 	if err != nil {
@@ -106,12 +125,30 @@ func provideQueueURL() QueueURL {
 	return &v
 }
 
-func provideSQSClient(awsConfig *aws.Config) *sqs.Client {
+func provideQueueClient(awsConfig *aws.Config, localStore *local.Store) queue.Client {
+	if localStore != nil {
+		return local.NewQueue(localStore)
+	}
 	return sqs.NewFromConfig(*awsConfig)
 }
 
+func provideBloggingEventQueryService(db dynamo.DB, localStore *local.Store) query.BloggingEventService {
+	if localStore != nil {
+		return local.NewBloggingEventQueryService(localStore)
+	}
+	return dynamo.NewBloggingEventQueryService(db)
+}
+
+func provideArticleSnapshotCommand(db dynamo.DB, localStore *local.Store) command.ArticleSnapshot {
+	if localStore != nil {
+		return local.NewArticleSnapshotCommandService(localStore)
+	}
+	return dynamo.NewArticleSnapshotCommandService(db)
+}
+
 func provideSynUsecaseSet(
 	bloggingEventQueryService query.BloggingEventService,
+	articleSnapshotCommand command.ArticleSnapshot,
 	articleTx command.ArticleTx,
 	tagTx command.TagTx,
 	articleDBPool usecase.ArticleDBPool,
@@ -120,6 +157,7 @@ func provideSynUsecaseSet(
 ) *usecase.Sync {
 	return usecase.NewSync(
 		bloggingEventQueryService,
+		articleSnapshotCommand,
 		articleTx,
 		tagTx,
 		articleDBPool,
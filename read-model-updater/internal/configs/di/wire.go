@@ -6,15 +6,11 @@ import (
 	"blogapi.miyamo.today/read-model-updater/internal/app/usecase"
 	"blogapi.miyamo.today/read-model-updater/internal/app/usecase/command"
 	"blogapi.miyamo.today/read-model-updater/internal/app/usecase/externalapi"
-	"blogapi.miyamo.today/read-model-updater/internal/app/usecase/query"
 	"blogapi.miyamo.today/read-model-updater/internal/if-adapters/converter"
 	"blogapi.miyamo.today/read-model-updater/internal/if-adapters/handler"
-	"blogapi.miyamo.today/read-model-updater/internal/infra/dynamo"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/githubactions"
-	"blogapi.miyamo.today/read-model-updater/internal/infra/queue"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/rdb/sqlc/article"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/rdb/sqlc/tag"
-	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/google/wire"
 )
 
@@ -26,12 +22,12 @@ var rdbSet = wire.NewSet(provideArticleDBPool, provideTagDBPool)
 
 var dynamodbSet = wire.NewSet(provideDynamoDB)
 
-var queryServiceSet = wire.NewSet(
-	dynamo.NewBloggingEventQueryService,
-	wire.Bind(new(query.BloggingEventService), new(*dynamo.BloggingEventQueryService)),
-)
+var localSet = wire.NewSet(provideLocalEventStore)
+
+var queryServiceSet = wire.NewSet(provideBloggingEventQueryService)
 
 var commandSet = wire.NewSet(
+	provideArticleSnapshotCommand,
 	provideArticleQuery,
 	wire.Bind(new(command.Article), new(*article.Queries)),
 	provideTagQuery,
@@ -51,6 +47,7 @@ var externalAPISet = wire.NewSet(
 var usecaseSet = wire.NewSet(
 	provideSynUsecaseSet,
 	wire.Bind(new(handler.SyncUsecase), new(*usecase.Sync)),
+	wire.Bind(new(handler.PublishScheduledArticlesUsecase), new(*usecase.Sync)),
 )
 
 var converterSet = wire.NewSet(
@@ -60,12 +57,10 @@ var converterSet = wire.NewSet(
 
 var handlerSet = wire.NewSet(
 	handler.NewSyncHandler,
+	handler.NewScheduleHandler,
 )
 
-var queueSet = wire.NewSet(
-	provideSQSClient,
-	wire.Bind(new(queue.Client), new(*sqs.Client)),
-)
+var queueSet = wire.NewSet(provideQueueClient)
 
 var queueURLSet = wire.NewSet(provideQueueURL)
 
@@ -75,6 +70,7 @@ func GetDependecies() *Dependencies {
 	wire.Build(
 		awsConfigSet,
 		dynamodbSet,
+		localSet,
 		queueSet,
 		queueURLSet,
 		rdbSet,
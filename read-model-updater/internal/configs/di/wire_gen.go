@@ -10,15 +10,11 @@ import (
 	"blogapi.miyamo.today/read-model-updater/internal/app/usecase"
 	"blogapi.miyamo.today/read-model-updater/internal/app/usecase/command"
 	"blogapi.miyamo.today/read-model-updater/internal/app/usecase/externalapi"
-	"blogapi.miyamo.today/read-model-updater/internal/app/usecase/query"
 	"blogapi.miyamo.today/read-model-updater/internal/if-adapters/converter"
 	"blogapi.miyamo.today/read-model-updater/internal/if-adapters/handler"
-	"blogapi.miyamo.today/read-model-updater/internal/infra/dynamo"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/githubactions"
-	"blogapi.miyamo.today/read-model-updater/internal/infra/queue"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/rdb/sqlc/article"
 	"blogapi.miyamo.today/read-model-updater/internal/infra/rdb/sqlc/tag"
-	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/google/wire"
 )
 
@@ -28,8 +24,10 @@ func GetDependecies() *Dependencies {
 	config := provideAWSConfig()
 	application := provideNewRelicApp()
 	converterConverter := converter.NewConverter()
-	db := provideDynamoDB(config)
-	bloggingEventQueryService := dynamo.NewBloggingEventQueryService(db)
+	store := provideLocalEventStore()
+	db := provideDynamoDB(config, store)
+	bloggingEventService := provideBloggingEventQueryService(db, store)
+	articleSnapshot := provideArticleSnapshotCommand(db, store)
 	articleDBPool := provideArticleDBPool()
 	queries := provideArticleQuery(articleDBPool)
 	articleTx := command.NewArticleTx(queries)
@@ -37,11 +35,12 @@ func GetDependecies() *Dependencies {
 	tagQueries := provideTagQuery(tagDBPool)
 	tagTx := command.NewTagTx(tagQueries)
 	blogPublisher := provideBlogPublisher()
-	sync := provideSynUsecaseSet(bloggingEventQueryService, articleTx, tagTx, articleDBPool, tagDBPool, blogPublisher)
+	sync := provideSynUsecaseSet(bloggingEventService, articleSnapshot, articleTx, tagTx, articleDBPool, tagDBPool, blogPublisher)
 	syncHandler := handler.NewSyncHandler(converterConverter, sync)
-	client := provideSQSClient(config)
+	scheduleHandler := handler.NewScheduleHandler(sync)
+	client := provideQueueClient(config, store)
 	queueURL := provideQueueURL()
-	dependencies := newDependencies(config, application, syncHandler, client, queueURL)
+	dependencies := newDependencies(config, application, syncHandler, scheduleHandler, client, queueURL)
 	return dependencies
 }
 
@@ -55,10 +54,12 @@ var rdbSet = wire.NewSet(provideArticleDBPool, provideTagDBPool)
 
 var dynamodbSet = wire.NewSet(provideDynamoDB)
 
-var queryServiceSet = wire.NewSet(dynamo.NewBloggingEventQueryService, wire.Bind(new(query.BloggingEventService), new(*dynamo.BloggingEventQueryService)))
+var localSet = wire.NewSet(provideLocalEventStore)
+
+var queryServiceSet = wire.NewSet(provideBloggingEventQueryService)
 
 var commandSet = wire.NewSet(
-	provideArticleQuery, wire.Bind(new(command.Article), new(*article.Queries)), provideTagQuery, wire.Bind(new(command.Tag), new(*tag.Queries)),
+	provideArticleSnapshotCommand, provideArticleQuery, wire.Bind(new(command.Article), new(*article.Queries)), provideTagQuery, wire.Bind(new(command.Tag), new(*tag.Queries)),
 )
 
 var txSet = wire.NewSet(command.NewArticleTx, command.NewTagTx)
@@ -68,16 +69,14 @@ var externalAPISet = wire.NewSet(
 )
 
 var usecaseSet = wire.NewSet(
-	provideSynUsecaseSet, wire.Bind(new(handler.SyncUsecase), new(*usecase.Sync)),
+	provideSynUsecaseSet, wire.Bind(new(handler.SyncUsecase), new(*usecase.Sync)), wire.Bind(new(handler.PublishScheduledArticlesUsecase), new(*usecase.Sync)),
 )
 
 var converterSet = wire.NewSet(converter.NewConverter, wire.Bind(new(handler.ToSyncUsecaseInDtoConverter), new(*converter.Converter)))
 
-var handlerSet = wire.NewSet(handler.NewSyncHandler)
+var handlerSet = wire.NewSet(handler.NewSyncHandler, handler.NewScheduleHandler)
 
-var queueSet = wire.NewSet(
-	provideSQSClient, wire.Bind(new(queue.Client), new(*sqs.Client)),
-)
+var queueSet = wire.NewSet(provideQueueClient)
 
 var queueURLSet = wire.NewSet(provideQueueURL)
 
@@ -3,19 +3,28 @@ package model
 import (
 	"encoding/base64"
 	"fmt"
-	"slices"
 
+	"blogapi.miyamo.today/core/article"
 	"github.com/Code-Hex/synchro"
 	"github.com/Code-Hex/synchro/tz"
 )
 
 type ArticleCommand struct {
-	id        string
-	title     string
-	body      string
-	thumbnail string
-	tags      []ArticleTagCommand
-	eventAt   synchro.Time[tz.UTC]
+	id                string
+	title             string
+	body              string
+	thumbnail         string
+	thumbnailVariants []article.ImageVariant
+	tags              []ArticleTagCommand
+	invisible         bool
+	publishAt         synchro.Time[tz.UTC]
+	draft             bool
+	slug              string
+	slugs             []string
+	createdBy         string
+	updatedBy         string
+	series            ArticleSeriesCommand
+	eventAt           synchro.Time[tz.UTC]
 }
 
 // IsCommandModel is a marker method for CommandModel.
@@ -37,14 +46,87 @@ func (a ArticleCommand) Thumbnail() string {
 	return a.thumbnail
 }
 
+// ThumbnailVariants returns the variants of the thumbnail. It is empty if the thumbnail was given without them.
+func (a ArticleCommand) ThumbnailVariants() []article.ImageVariant {
+	return a.thumbnailVariants
+}
+
 func (a ArticleCommand) Tags() []ArticleTagCommand {
 	return a.tags
 }
 
+// Invisible reports whether the article is hidden from readers.
+func (a ArticleCommand) Invisible() bool {
+	return a.invisible
+}
+
+// PublishAt returns the time the article goes live. The zero value means the article is published immediately.
+func (a ArticleCommand) PublishAt() synchro.Time[tz.UTC] {
+	return a.publishAt
+}
+
+// Draft reports whether the article has not been published yet.
+func (a ArticleCommand) Draft() bool {
+	return a.draft
+}
+
+// Slug returns the current slug. It is empty if the article has none.
+func (a ArticleCommand) Slug() string {
+	return a.slug
+}
+
+// Slugs returns every slug the article has had, including the current one.
+func (a ArticleCommand) Slugs() []string {
+	return a.slugs
+}
+
+// CreatedBy returns the name of the user who created the article. It is empty if no event recorded it.
+func (a ArticleCommand) CreatedBy() string {
+	return a.createdBy
+}
+
+// UpdatedBy returns the name of the user who last changed the article. It is empty if no event recorded it.
+func (a ArticleCommand) UpdatedBy() string {
+	return a.updatedBy
+}
+
+// Series returns the series the article is a part of. Its id is empty if the article is not a part of any series.
+func (a ArticleCommand) Series() ArticleSeriesCommand {
+	return a.series
+}
+
 func (a ArticleCommand) EventAt() synchro.Time[tz.UTC] {
 	return a.eventAt
 }
 
+// ArticleSeriesCommand is the series an article is a part of, and the position of the article in it.
+type ArticleSeriesCommand struct {
+	id       string
+	title    string
+	position int
+}
+
+func (a ArticleSeriesCommand) ID() string {
+	return a.id
+}
+
+func (a ArticleSeriesCommand) Title() string {
+	return a.title
+}
+
+// Position returns the position of the article in the series, starting at 1.
+func (a ArticleSeriesCommand) Position() int {
+	return a.position
+}
+
+func NewArticleSeriesCommand(id, title string, position int) ArticleSeriesCommand {
+	return ArticleSeriesCommand{
+		id:       id,
+		title:    title,
+		position: position,
+	}
+}
+
 type ArticleTagCommand struct {
 	id   string
 	name string
@@ -74,31 +156,86 @@ func newArticleTagCommands(names ...string) []ArticleTagCommand {
 	return result
 }
 
+// RetiredTagIDs returns the ids of the tags the events renamed or merged away.
+// They may be left without any article once the article the events belong to is synced.
+func RetiredTagIDs(events []BloggingEvent) []string {
+	var result []string
+	for _, e := range events {
+		if e.eventType != BloggingEventTypeRenameTag && e.eventType != BloggingEventTypeMergeTags {
+			continue
+		}
+		for _, t := range newArticleTagCommands(e.detachTags...) {
+			result = append(result, t.ID())
+		}
+	}
+	return result
+}
+
+func NewArticleCommand(
+	id, title, body, thumbnail string, thumbnailVariants []article.ImageVariant, tagNames []string, invisible bool, publishAt synchro.Time[tz.UTC], draft bool,
+	slug string, slugs []string, createdBy, updatedBy string, series ArticleSeriesCommand,
+) ArticleCommand {
+	return ArticleCommand{
+		id:                id,
+		title:             title,
+		body:              body,
+		thumbnail:         thumbnail,
+		thumbnailVariants: thumbnailVariants,
+		tags:              newArticleTagCommands(tagNames...),
+		invisible:         invisible,
+		publishAt:         publishAt,
+		draft:             draft,
+		slug:              slug,
+		slugs:             slugs,
+		createdBy:         createdBy,
+		updatedBy:         updatedBy,
+		series:            series,
+	}
+}
+
 func ArticleCommandFromBloggingEvents(events []BloggingEvent) *ArticleCommand {
 	if len(events) == 0 {
 		return nil
 	}
+	projection := article.Project(events)
 	result := ArticleCommand{
-		id: events[0].ArticleID(),
+		id:                events[0].ArticleID(),
+		title:             projection.Title(),
+		body:              projection.Body(),
+		thumbnail:         projection.Thumbnail(),
+		thumbnailVariants: projection.ThumbnailVariants(),
+		tags:              newArticleTagCommands(projection.TagNames()...),
+		invisible:         projection.Invisible(),
+		draft:             projection.Draft(),
+		slug:              projection.Slug(),
+		slugs:             projection.Slugs(),
+		series:            NewArticleSeriesCommand(projection.Series().ID(), projection.Series().Title(), projection.Series().Position()),
+	}
+	if a := events[0].actor; a != nil {
+		result.createdBy = *a
 	}
-	var tagNames []string
 	for _, e := range events {
-		if e.title != nil {
-			result.title = *e.title
-		}
-		if e.content != nil {
-			result.body = *e.content
+		if e.publishAt != nil {
+			result.publishAt = *e.publishAt
 		}
-		if e.thumbnail != nil {
-			result.thumbnail = *e.thumbnail
+		if e.actor != nil && *e.actor != "" {
+			result.updatedBy = *e.actor
 		}
-		tagNames = slices.DeleteFunc(
-			append(tagNames, append(e.Tags(), e.AttachTags()...)...), func(v string) bool {
-				return slices.Contains(e.DetachTags(), v)
-			},
-		)
 	}
-	tags := newArticleTagCommands(tagNames...)
-	result.tags = tags
 	return &result
 }
+
+// ArticleCommandFromSnapshot folds the events written after the snapshot onto it.
+// A nil snapshot folds the events from the beginning.
+func ArticleCommandFromSnapshot(snapshot *ArticleSnapshot, events []BloggingEvent) *ArticleCommand {
+	if snapshot == nil {
+		return ArticleCommandFromBloggingEvents(events)
+	}
+	result := ArticleCommandFromBloggingEvents(append(snapshot.events(), events...))
+	// The events of the snapshot do not record actors, so the snapshot tells who created and last changed the article.
+	result.createdBy = snapshot.article.createdBy
+	if result.updatedBy == "" {
+		result.updatedBy = snapshot.article.updatedBy
+	}
+	return result
+}
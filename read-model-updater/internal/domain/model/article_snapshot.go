@@ -0,0 +1,57 @@
+package model
+
+import "slices"
+
+// ArticleSnapshot is the state of an article folded up to one of its blogging events.
+// Folding the snapshot and then the events written after it gives the same ArticleCommand as replaying every event.
+type ArticleSnapshot struct {
+	eventID string
+	article ArticleCommand
+}
+
+// EventID returns the id of the last event folded into the snapshot.
+func (s ArticleSnapshot) EventID() string {
+	return s.eventID
+}
+
+// Article returns the folded state of the article.
+func (s ArticleSnapshot) Article() ArticleCommand {
+	return s.article
+}
+
+// events returns the snapshot as a blogging event that sets every field of the article,
+// followed by an event for each slug the article has had, so that its former slugs are kept.
+func (s ArticleSnapshot) events() []BloggingEvent {
+	a := s.article
+	tagNames := make([]string, 0, len(a.tags))
+	for _, t := range a.tags {
+		tagNames = append(tagNames, t.Name())
+	}
+	options := []BloggingEventOption{
+		BloggingEventWithTitle(a.title),
+		BloggingEventWithContent(a.body),
+		BloggingEventWithThumbnail(a.thumbnail),
+		BloggingEventWithThumbnailVariants(a.thumbnailVariants...),
+		BloggingEventWithTags(tagNames...),
+		BloggingEventWithInvisible(a.invisible),
+		BloggingEventWithDraft(a.draft),
+	}
+	if !a.publishAt.IsZero() {
+		options = append(options, BloggingEventWithPublishAt(a.publishAt))
+	}
+	if a.series.id != "" {
+		options = append(options, BloggingEventWithSeries(a.series.id, a.series.title, a.series.position))
+	}
+	result := []BloggingEvent{NewBloggingEvent(s.eventID, BloggingEventTypeCreateArticle, a.id, options...)}
+	for _, slug := range append(slices.Clone(a.slugs), a.slug) {
+		result = append(result, NewBloggingEvent(s.eventID, BloggingEventTypeUpdateSlug, a.id, BloggingEventWithSlug(slug)))
+	}
+	return result
+}
+
+func NewArticleSnapshot(eventID string, article ArticleCommand) ArticleSnapshot {
+	return ArticleSnapshot{
+		eventID: eventID,
+		article: article,
+	}
+}
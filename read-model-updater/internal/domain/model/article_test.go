@@ -0,0 +1,108 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"blogapi.miyamo.today/core/article"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestArticleCommandFromSnapshot(t *testing.T) {
+	type testCase struct {
+		events []BloggingEvent
+		want   ArticleCommand
+	}
+	publishAt := synchro.New[tz.UTC](2025, 1, 1, 9, 0, 0, 0)
+	variants := []article.ImageVariant{article.NewImageVariant("https://example.com/thumbnail-640w.webp", 640, 480, "image/webp")}
+	tests := map[string]testCase{
+		"happy_path/created_and_updated_by": {
+			events: []BloggingEvent{
+				{eventID: "1", eventType: BloggingEventTypeCreateArticle, articleID: "a", title: ptr("title"), content: ptr("body"), thumbnail: ptr("thumbnail"), tags: []string{"go"}, draft: ptr(true), actor: ptr("alice")},
+				{eventID: "2", eventType: BloggingEventTypeUpdateTitle, articleID: "a", title: ptr("title2"), actor: ptr("bob")},
+				{eventID: "3", eventType: BloggingEventTypeUpdateBody, articleID: "a", content: ptr("body2")},
+				{eventID: "4", eventType: BloggingEventTypeScheduleArticle, articleID: "a", publishAt: &publishAt, actor: ptr("")},
+				{eventID: "5", eventType: BloggingEventTypePublishArticle, articleID: "a", draft: ptr(false)},
+			},
+			want: NewArticleCommand(
+				"a", "title2", "body2", "thumbnail", nil, []string{"go"}, false, publishAt, false, "", nil, "alice", "bob", ArticleSeriesCommand{},
+			),
+		},
+		"happy_path/slug_history": {
+			events: []BloggingEvent{
+				{eventID: "1", eventType: BloggingEventTypeCreateArticle, articleID: "a", title: ptr("title"), content: ptr("body"), thumbnail: ptr("thumbnail"), slug: ptr("first"), actor: ptr("alice")},
+				{eventID: "2", eventType: BloggingEventTypeUpdateSlug, articleID: "a", slug: ptr("second"), actor: ptr("alice")},
+				{eventID: "3", eventType: BloggingEventTypeUpdateSlug, articleID: "a", slug: ptr("third"), actor: ptr("bob")},
+				{eventID: "4", eventType: BloggingEventTypeUpdateSlug, articleID: "a", slug: ptr("first"), actor: ptr("carol")},
+			},
+			want: NewArticleCommand(
+				"a", "title", "body", "thumbnail", nil, []string{}, false, synchro.Time[tz.UTC]{}, false, "first", []string{"first", "second", "third"}, "alice", "carol", ArticleSeriesCommand{},
+			),
+		},
+		"happy_path/tags": {
+			events: []BloggingEvent{
+				{eventID: "1", eventType: BloggingEventTypeCreateArticle, articleID: "a", title: ptr("title"), content: ptr("body"), thumbnail: ptr("thumbnail"), tags: []string{"go", "aws"}, actor: ptr("alice")},
+				{eventID: "2", eventType: BloggingEventTypeAttachTags, articleID: "a", attachTags: []string{"gorm", "graphql"}, actor: ptr("alice")},
+				{eventID: "3", eventType: BloggingEventTypeDetachTags, articleID: "a", detachTags: []string{"aws"}, actor: ptr("bob")},
+				{eventID: "4", eventType: BloggingEventTypeRenameTag, articleID: "a", attachTags: []string{"golang"}, detachTags: []string{"go"}},
+				{eventID: "5", eventType: BloggingEventTypeMergeTags, articleID: "a", attachTags: []string{"api"}, detachTags: []string{"gorm", "graphql"}},
+			},
+			want: NewArticleCommand(
+				"a", "title", "body", "thumbnail", nil, []string{"golang", "api"}, false, synchro.Time[tz.UTC]{}, false, "", nil, "alice", "bob", ArticleSeriesCommand{},
+			),
+		},
+		"happy_path/hidden_in_series": {
+			events: []BloggingEvent{
+				{eventID: "1", eventType: BloggingEventTypeCreateArticle, articleID: "a", title: ptr("title"), content: ptr("body"), thumbnail: ptr("thumbnail")},
+				{eventID: "2", eventType: BloggingEventTypeAddToSeries, articleID: "a", seriesID: ptr("s"), seriesTitle: ptr("series"), seriesPosition: ptr(1), actor: ptr("alice")},
+				{eventID: "3", eventType: BloggingEventTypeHideArticle, articleID: "a", invisible: ptr(true)},
+				{eventID: "4", eventType: BloggingEventTypeReorderSeries, articleID: "a", seriesID: ptr("s"), seriesTitle: ptr("series"), seriesPosition: ptr(2), actor: ptr("bob")},
+			},
+			want: NewArticleCommand(
+				"a", "title", "body", "thumbnail", nil, []string{}, true, synchro.Time[tz.UTC]{}, false, "", nil, "", "bob", NewArticleSeriesCommand("s", "series", 2),
+			),
+		},
+		"happy_path/thumbnail_variants": {
+			events: []BloggingEvent{
+				{eventID: "1", eventType: BloggingEventTypeCreateArticle, articleID: "a", title: ptr("title"), content: ptr("body"), thumbnail: ptr("thumbnail"), thumbnailVariants: variants},
+				{eventID: "2", eventType: BloggingEventTypeUpdateTitle, articleID: "a", title: ptr("title2")},
+			},
+			want: NewArticleCommand(
+				"a", "title2", "body", "thumbnail", variants, []string{}, false, synchro.Time[tz.UTC]{}, false, "", nil, "", "", ArticleSeriesCommand{},
+			),
+		},
+		"happy_path/thumbnail_variants_cleared": {
+			events: []BloggingEvent{
+				{eventID: "1", eventType: BloggingEventTypeCreateArticle, articleID: "a", title: ptr("title"), content: ptr("body"), thumbnail: ptr("thumbnail"), thumbnailVariants: variants},
+				{eventID: "2", eventType: BloggingEventTypeUpdateThumbnail, articleID: "a", thumbnail: ptr("thumbnail2")},
+			},
+			want: NewArticleCommand(
+				"a", "title", "body", "thumbnail2", nil, []string{}, false, synchro.Time[tz.UTC]{}, false, "", nil, "", "", ArticleSeriesCommand{},
+			),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := ArticleCommandFromBloggingEvents(tt.events)
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Fatalf("ArticleCommandFromBloggingEvents() got = %+v, want %+v", *got, tt.want)
+			}
+			for i := 1; i <= len(tt.events); i++ {
+				t.Run(fmt.Sprintf("snapshot_at_%d", i), func(t *testing.T) {
+					folded := ArticleCommandFromBloggingEvents(tt.events[:i])
+					snapshot := NewArticleSnapshot(tt.events[i-1].EventID(), *folded)
+					got := ArticleCommandFromSnapshot(&snapshot, tt.events[i:])
+					if !reflect.DeepEqual(*got, tt.want) {
+						t.Errorf("ArticleCommandFromSnapshot() got = %+v, want %+v", *got, tt.want)
+					}
+				})
+			}
+		})
+	}
+}
@@ -1,21 +1,63 @@
 package model
 
+import (
+	"blogapi.miyamo.today/core/article"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+)
+
+// BloggingEventType is the kind of change a blogging event made to an article.
+type BloggingEventType string
+
+const (
+	BloggingEventTypeCreateArticle   BloggingEventType = "CREATE_ARTICLE"
+	BloggingEventTypeUpdateTitle     BloggingEventType = "UPDATE_TITLE"
+	BloggingEventTypeUpdateBody      BloggingEventType = "UPDATE_BODY"
+	BloggingEventTypeUpdateThumbnail BloggingEventType = "UPDATE_THUMBNAIL"
+	BloggingEventTypeAttachTags      BloggingEventType = "ATTACH_TAGS"
+	BloggingEventTypeDetachTags      BloggingEventType = "DETACH_TAGS"
+	BloggingEventTypeHideArticle     BloggingEventType = "HIDE_ARTICLE"
+	BloggingEventTypeUnhideArticle   BloggingEventType = "UNHIDE_ARTICLE"
+	BloggingEventTypeEditArticle     BloggingEventType = "EDIT_ARTICLE"
+	BloggingEventTypeScheduleArticle BloggingEventType = "SCHEDULE_ARTICLE"
+	BloggingEventTypePublishArticle  BloggingEventType = "PUBLISH_ARTICLE"
+	BloggingEventTypeRevertArticle   BloggingEventType = "REVERT_ARTICLE"
+	BloggingEventTypeRenameTag       BloggingEventType = "RENAME_TAG"
+	BloggingEventTypeMergeTags       BloggingEventType = "MERGE_TAGS"
+	BloggingEventTypeUpdateSlug      BloggingEventType = "UPDATE_SLUG"
+	BloggingEventTypeAddToSeries     BloggingEventType = "ADD_TO_SERIES"
+	BloggingEventTypeReorderSeries   BloggingEventType = "REORDER_SERIES"
+)
+
 type BloggingEvent struct {
-	eventID    string
-	articleID  string
-	title      *string
-	content    *string
-	thumbnail  *string
-	tags       []string
-	attachTags []string
-	detachTags []string
-	invisible  *bool
+	eventID           string
+	eventType         BloggingEventType
+	articleID         string
+	title             *string
+	content           *string
+	thumbnail         *string
+	thumbnailVariants []article.ImageVariant
+	tags              []string
+	attachTags        []string
+	detachTags        []string
+	invisible         *bool
+	publishAt         *synchro.Time[tz.UTC]
+	draft             *bool
+	slug              *string
+	actor             *string
+	seriesID          *string
+	seriesTitle       *string
+	seriesPosition    *int
 }
 
 func (b BloggingEvent) EventID() string {
 	return b.eventID
 }
 
+func (b BloggingEvent) EventType() BloggingEventType {
+	return b.eventType
+}
+
 func (b BloggingEvent) ArticleID() string {
 	return b.articleID
 }
@@ -32,6 +74,11 @@ func (b BloggingEvent) Thumbnail() *string {
 	return b.thumbnail
 }
 
+// ThumbnailVariants returns the variants of the thumbnail the event gave to the article.
+func (b BloggingEvent) ThumbnailVariants() []article.ImageVariant {
+	return b.thumbnailVariants
+}
+
 func (b BloggingEvent) AttachTags() []string {
 	return b.attachTags
 }
@@ -48,18 +95,145 @@ func (b BloggingEvent) Invisible() *bool {
 	return b.invisible
 }
 
-func NewBloggingEvent(
-	eventID, articleID string, title, content, thumbnail *string, tags, attachTag, detacheTag []string, invisible *bool,
-) BloggingEvent {
-	return BloggingEvent{
-		eventID:    eventID,
-		articleID:  articleID,
-		title:      title,
-		content:    content,
-		thumbnail:  thumbnail,
-		tags:       tags,
-		attachTags: attachTag,
-		detachTags: detacheTag,
-		invisible:  invisible,
+func (b BloggingEvent) PublishAt() *synchro.Time[tz.UTC] {
+	return b.publishAt
+}
+
+func (b BloggingEvent) Draft() *bool {
+	return b.draft
+}
+
+// Slug returns the slug the event gave to the article.
+func (b BloggingEvent) Slug() *string {
+	return b.slug
+}
+
+// Actor returns the name of the user who wrote the event. It is nil if the event does not record one.
+func (b BloggingEvent) Actor() *string {
+	return b.actor
+}
+
+// SeriesID returns the id of the series the event put the article in.
+func (b BloggingEvent) SeriesID() *string {
+	return b.seriesID
+}
+
+// SeriesTitle returns the title of the series the event put the article in.
+func (b BloggingEvent) SeriesTitle() *string {
+	return b.seriesTitle
+}
+
+// SeriesPosition returns the position in the series the event put the article at.
+func (b BloggingEvent) SeriesPosition() *int {
+	return b.seriesPosition
+}
+
+// BloggingEventOption is an option of NewBloggingEvent, setting an attribute the event wrote.
+type BloggingEventOption func(*BloggingEvent)
+
+// BloggingEventWithTitle sets the title the event gave to the article.
+func BloggingEventWithTitle(title string) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.title = &title
+	}
+}
+
+// BloggingEventWithContent sets the body the event gave to the article.
+func BloggingEventWithContent(content string) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.content = &content
+	}
+}
+
+// BloggingEventWithThumbnail sets the thumbnail the event gave to the article.
+func BloggingEventWithThumbnail(thumbnail string) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.thumbnail = &thumbnail
+	}
+}
+
+// BloggingEventWithThumbnailVariants sets the variants of the thumbnail the event gave to the article.
+func BloggingEventWithThumbnailVariants(thumbnailVariants ...article.ImageVariant) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.thumbnailVariants = thumbnailVariants
+	}
+}
+
+// BloggingEventWithTags sets the tags the article was created with.
+func BloggingEventWithTags(tags ...string) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.tags = tags
+	}
+}
+
+// BloggingEventWithAttachTags sets the tags the event attached to the article.
+func BloggingEventWithAttachTags(tags ...string) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.attachTags = tags
+	}
+}
+
+// BloggingEventWithDetachTags sets the tags the event detached from the article.
+func BloggingEventWithDetachTags(tags ...string) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.detachTags = tags
+	}
+}
+
+// BloggingEventWithInvisible sets whether the event hid the article.
+func BloggingEventWithInvisible(invisible bool) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.invisible = &invisible
+	}
+}
+
+// BloggingEventWithPublishAt sets the time the event scheduled the article at.
+func BloggingEventWithPublishAt(publishAt synchro.Time[tz.UTC]) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.publishAt = &publishAt
+	}
+}
+
+// BloggingEventWithDraft sets whether the event left the article a draft.
+func BloggingEventWithDraft(draft bool) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.draft = &draft
+	}
+}
+
+// BloggingEventWithSlug sets the slug the event gave to the article.
+func BloggingEventWithSlug(slug string) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.slug = &slug
+	}
+}
+
+// BloggingEventWithActor sets the name of the user who wrote the event.
+func BloggingEventWithActor(actor string) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.actor = &actor
+	}
+}
+
+// BloggingEventWithSeries sets the series the event put the article in, and its position in the series.
+func BloggingEventWithSeries(id, title string, position int) BloggingEventOption {
+	return func(b *BloggingEvent) {
+		b.seriesID = &id
+		b.seriesTitle = &title
+		b.seriesPosition = &position
+	}
+}
+
+// NewBloggingEvent is constructor of BloggingEvent.
+// The attributes the event wrote are set by the options, and the others are left nil or empty.
+func NewBloggingEvent(eventID string, eventType BloggingEventType, articleID string, options ...BloggingEventOption) BloggingEvent {
+	e := BloggingEvent{
+		eventID:   eventID,
+		eventType: eventType,
+		articleID: articleID,
+	}
+	for _, option := range options {
+		option(&e)
 	}
+	return e
 }
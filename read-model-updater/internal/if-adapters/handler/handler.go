@@ -36,3 +36,23 @@ func NewSyncHandler(
 		syncUsecase:          syncUsecase,
 	}
 }
+
+// ScheduleHandler is a handler for publishing scheduled articles.
+type ScheduleHandler struct {
+	publishScheduledArticlesUsecase PublishScheduledArticlesUsecase
+}
+
+// Invoke invokes the PublishScheduledArticles usecase.
+func (h *ScheduleHandler) Invoke(ctx context.Context, now synchro.Time[tz.UTC]) error {
+	tx := newrelic.FromContext(ctx)
+	defer tx.StartSegment("ScheduleHandler#Invoke").End()
+
+	return h.publishScheduledArticlesUsecase.PublishScheduledArticles(ctx, now)
+}
+
+// NewScheduleHandler creates a new ScheduleHandler.
+func NewScheduleHandler(publishScheduledArticlesUsecase PublishScheduledArticlesUsecase) *ScheduleHandler {
+	return &ScheduleHandler{
+		publishScheduledArticlesUsecase: publishScheduledArticlesUsecase,
+	}
+}
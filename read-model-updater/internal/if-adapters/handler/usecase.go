@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"blogapi.miyamo.today/read-model-updater/internal/app/usecase"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
 )
 
 // SyncUsecase is an interface for the usecase of synchronize blog snapshot with events.
@@ -11,3 +13,9 @@ type SyncUsecase interface {
 	// SyncBlogSnapshotWithEvents synchronizes blog snapshot with events.
 	SyncBlogSnapshotWithEvents(ctx context.Context, dto *usecase.SyncUsecaseInDto) error
 }
+
+// PublishScheduledArticlesUsecase is an interface for the usecase of publishing scheduled articles.
+type PublishScheduledArticlesUsecase interface {
+	// PublishScheduledArticles projects the scheduled articles whose publish time has passed.
+	PublishScheduledArticles(ctx context.Context, now synchro.Time[tz.UTC]) error
+}
@@ -0,0 +1,153 @@
+package dynamo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"blogapi.miyamo.today/read-model-updater/internal/domain/model"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/cockroachdb/errors"
+	"github.com/miyamo2/sqldav"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type articleSnapshot struct {
+	EventID           string                   `db:"event_id"`
+	ArticleID         string                   `db:"article_id"`
+	Title             string                   `db:"title"`
+	Content           string                   `db:"content"`
+	Thumbnail         string                   `db:"thumbnail"`
+	ThumbnailVariants imageVariants            `db:"thumbnail_variants"`
+	TagNames          sqldav.TypedList[string] `db:"tag_names"`
+	Invisible         bool                     `db:"invisible"`
+	PublishAt         string                   `db:"publish_at"`
+	Draft             bool                     `db:"draft"`
+	Slug              string                   `db:"slug"`
+	Slugs             sqldav.TypedList[string] `db:"slugs"`
+	CreatedBy         string                   `db:"created_by"`
+	UpdatedBy         string                   `db:"updated_by"`
+	SeriesID          string                   `db:"series_id"`
+	SeriesTitle       string                   `db:"series_title"`
+	SeriesPosition    int                      `db:"series_position"`
+}
+
+var listSnapshotsByArticleID = fmt.Sprintf(
+	`SELECT 
+    event_id, article_id, title, content, thumbnail, thumbnail_variants, tag_names, invisible, publish_at, draft, slug, slugs,
+    created_by, updated_by, series_id, series_title, series_position
+FROM "%s" 
+WHERE "article_id" = ?
+`, os.Getenv("BLOGGING_EVENT_SNAPSHOTS_TABLE_NAME"),
+)
+
+var insertSnapshot = fmt.Sprintf(
+	`INSERT INTO "%s" 
+VALUE {'article_id': ?, 'event_id': ?, 'title': ?, 'content': ?, 'thumbnail': ?, 'thumbnail_variants': ?, 'tag_names': ?, 'invisible': ?, 'publish_at': ?, 'draft': ?, 'slug': ?, 'slugs': ?, 'created_by': ?, 'updated_by': ?, 'series_id': ?, 'series_title': ?, 'series_position': ?}
+`, os.Getenv("BLOGGING_EVENT_SNAPSHOTS_TABLE_NAME"),
+)
+
+func (s *BloggingEventQueryService) LatestSnapshotByArticleID(
+	ctx context.Context,
+	articleID string,
+) (*model.ArticleSnapshot, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventQueryService#LatestSnapshotByArticleID").End()
+
+	rows := make([]articleSnapshot, 0)
+	err := s.db.SelectContext(ctx, &rows, listSnapshotsByArticleID, articleID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	// ULIDs are lexicographically sortable.
+	r := slices.MaxFunc(
+		rows, func(i, j articleSnapshot) int {
+			return strings.Compare(i.EventID, j.EventID)
+		},
+	)
+
+	var publishAt synchro.Time[tz.UTC]
+	if r.PublishAt != "" {
+		publishAt, err = synchro.Parse[tz.UTC](time.RFC3339, r.PublishAt)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	snapshot := model.NewArticleSnapshot(
+		r.EventID,
+		model.NewArticleCommand(
+			r.ArticleID,
+			r.Title,
+			r.Content,
+			r.Thumbnail,
+			r.ThumbnailVariants.toModel(),
+			r.TagNames,
+			r.Invisible,
+			publishAt,
+			r.Draft,
+			r.Slug,
+			r.Slugs,
+			r.CreatedBy,
+			r.UpdatedBy,
+			model.NewArticleSeriesCommand(r.SeriesID, r.SeriesTitle, r.SeriesPosition),
+		),
+	)
+	return &snapshot, nil
+}
+
+type ArticleSnapshotCommandService struct {
+	db DB
+}
+
+func (s *ArticleSnapshotCommandService) PutSnapshot(ctx context.Context, snapshot model.ArticleSnapshot) error {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ArticleSnapshotCommandService#PutSnapshot").End()
+
+	a := snapshot.Article()
+	tagNames := make(sqldav.TypedList[string], 0, len(a.Tags()))
+	for _, t := range a.Tags() {
+		tagNames = append(tagNames, t.Name())
+	}
+	var publishAt string
+	if v := a.PublishAt(); !v.IsZero() {
+		publishAt = v.StdTime().Format(time.RFC3339)
+	}
+	_, err := s.db.ExecContext(
+		ctx,
+		insertSnapshot,
+		a.ID(),
+		snapshot.EventID(),
+		a.Title(),
+		a.Body(),
+		a.Thumbnail(),
+		newImageVariants(a.ThumbnailVariants()),
+		tagNames,
+		a.Invisible(),
+		publishAt,
+		a.Draft(),
+		a.Slug(),
+		append(sqldav.TypedList[string]{}, a.Slugs()...),
+		a.CreatedBy(),
+		a.UpdatedBy(),
+		a.Series().ID(),
+		a.Series().Title(),
+		a.Series().Position(),
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func NewArticleSnapshotCommandService(db DB) *ArticleSnapshotCommandService {
+	return &ArticleSnapshotCommandService{
+		db: db,
+	}
+}
@@ -2,11 +2,17 @@ package dynamo
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"os"
 	"slices"
+	"time"
 
+	"blogapi.miyamo.today/core/article"
 	"blogapi.miyamo.today/read-model-updater/internal/domain/model"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
 	"github.com/cockroachdb/errors"
 	"github.com/miyamo2/sqldav"
 	"github.com/newrelic/go-agent/v3/newrelic"
@@ -14,27 +20,100 @@ import (
 )
 
 type bloggingEvent struct {
-	EventID    string             `db:"event_id"`
-	ArticleID  string             `db:"article_id"`
-	Title      *string            `db:"title"`
-	Content    *string            `db:"content"`
-	Thumbnail  *string            `db:"thumbnail"`
-	Tags       sqldav.Set[string] `db:"tags"`
-	AttachTags sqldav.Set[string] `db:"attach_tags"`
-	DetachTags sqldav.Set[string] `db:"detach_tags"`
-	Invisible  *bool              `db:"invisible"`
+	EventID           string             `db:"event_id"`
+	EventType         *string            `db:"event_type"`
+	SchemaVersion     *int               `db:"schema_version"`
+	ArticleID         string             `db:"article_id"`
+	Title             *string            `db:"title"`
+	Content           *string            `db:"content"`
+	Thumbnail         *string            `db:"thumbnail"`
+	ThumbnailVariants imageVariants      `db:"thumbnail_variants"`
+	Tags              sqldav.Set[string] `db:"tags"`
+	AttachTags        sqldav.Set[string] `db:"attach_tags"`
+	DetachTags        sqldav.Set[string] `db:"detach_tags"`
+	Invisible         *bool              `db:"invisible"`
+	PublishAt         *string            `db:"publish_at"`
+	Draft             *bool              `db:"draft"`
+	RevertedTo        *string            `db:"reverted_to"`
+	Slug              *string            `db:"slug"`
+	Actor             *string            `db:"actor"`
+	SeriesID          *string            `db:"series_id"`
+	SeriesTitle       *string            `db:"series_title"`
+	SeriesPosition    *int               `db:"series_position"`
 }
 
 var listEventsByArticleID = fmt.Sprintf(
 	`SELECT 
-    event_id, article_id, title, content, thumbnail, tags, attach_tags, detach_tags, invisible
+    event_id, event_type, schema_version, article_id, title, content, thumbnail, thumbnail_variants, tags, attach_tags, detach_tags, invisible, publish_at, draft,
+    reverted_to, slug, actor, series_id, series_title, series_position
 FROM "%s"."article_id_event_id-Index" 
 WHERE "article_id" = ?
 `, os.Getenv("BLOGGING_EVENTS_TABLE_NAME"),
 )
 
+var listEventsByArticleIDAfter = fmt.Sprintf(
+	`SELECT 
+    event_id, event_type, schema_version, article_id, title, content, thumbnail, thumbnail_variants, tags, attach_tags, detach_tags, invisible, publish_at, draft,
+    reverted_to, slug, actor, series_id, series_title, series_position
+FROM "%s"."article_id_event_id-Index" 
+WHERE "article_id" = ? AND "event_id" > ?
+`, os.Getenv("BLOGGING_EVENTS_TABLE_NAME"),
+)
+
+// imageVariant is a variant of the thumbnail, as an element of the thumbnail_variants attribute.
+type imageVariant struct {
+	URL         string `db:"url"`
+	Width       int    `db:"width"`
+	Height      int    `db:"height"`
+	ContentType string `db:"content_type"`
+}
+
+// imageVariants is the thumbnail_variants attribute of the events and the snapshots.
+// Unlike sqldav.TypedList, it scans the attribute missing on items written before it was recorded as empty.
+type imageVariants sqldav.TypedList[imageVariant]
+
+// newImageVariants converts the variants of the thumbnail to the attribute.
+func newImageVariants(variants []article.ImageVariant) imageVariants {
+	result := make(imageVariants, 0, len(variants))
+	for _, v := range variants {
+		result = append(result, imageVariant{
+			URL:         v.URL(),
+			Width:       v.Width(),
+			Height:      v.Height(),
+			ContentType: v.ContentType(),
+		})
+	}
+	return result
+}
+
+// toModel converts the attribute to the variants of the thumbnail.
+func (v imageVariants) toModel() []article.ImageVariant {
+	if len(v) == 0 {
+		return nil
+	}
+	result := make([]article.ImageVariant, 0, len(v))
+	for _, e := range v {
+		result = append(result, article.NewImageVariant(e.URL, e.Width, e.Height, e.ContentType))
+	}
+	return result
+}
+
+// Scan implements the sql.Scanner interface.
+func (v *imageVariants) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	return (*sqldav.TypedList[imageVariant])(v).Scan(value)
+}
+
+// Value implements the driver.Valuer interface.
+func (v imageVariants) Value() (driver.Value, error) {
+	return sqldav.TypedList[imageVariant](v).Value()
+}
+
 type DB interface {
 	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
 }
 
 type BloggingEventQueryService struct {
@@ -43,13 +122,18 @@ type BloggingEventQueryService struct {
 
 func (s *BloggingEventQueryService) ListEventsByArticleID(
 	ctx context.Context,
-	articleID string,
+	articleID, afterEventID string,
 ) ([]model.BloggingEvent, error) {
 	nrtx := newrelic.FromContext(ctx)
 	defer nrtx.StartSegment("BloggingEventQueryService#AllEventsWithArticleID").End()
 
 	rows := make([]bloggingEvent, 0)
-	err := s.db.SelectContext(ctx, &rows, listEventsByArticleID, articleID)
+	var err error
+	if afterEventID == "" {
+		err = s.db.SelectContext(ctx, &rows, listEventsByArticleID, articleID)
+	} else {
+		err = s.db.SelectContext(ctx, &rows, listEventsByArticleIDAfter, articleID, afterEventID)
+	}
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -74,21 +158,48 @@ func (s *BloggingEventQueryService) ListEventsByArticleID(
 	}
 
 	result := make([]model.BloggingEvent, 0, len(rows))
-	for _, r := range rows {
-		result = append(
-			result,
-			model.NewBloggingEvent(
-				r.EventID,
-				r.ArticleID,
-				r.Title,
-				r.Content,
-				r.Thumbnail,
-				r.Tags,
-				r.AttachTags,
-				r.DetachTags,
-				r.Invisible,
-			),
-		)
+	for i, r := range rows {
+		r, err := upcast(r, i == 0 && afterEventID == "")
+		if err != nil {
+			return nil, err
+		}
+		options := []model.BloggingEventOption{
+			model.BloggingEventWithTags(r.Tags...),
+			model.BloggingEventWithAttachTags(r.AttachTags...),
+			model.BloggingEventWithDetachTags(r.DetachTags...),
+		}
+		if r.Title != nil {
+			options = append(options, model.BloggingEventWithTitle(*r.Title))
+		}
+		if r.Content != nil {
+			options = append(options, model.BloggingEventWithContent(*r.Content))
+		}
+		if r.Thumbnail != nil {
+			options = append(options, model.BloggingEventWithThumbnail(*r.Thumbnail), model.BloggingEventWithThumbnailVariants(r.ThumbnailVariants.toModel()...))
+		}
+		if r.Invisible != nil {
+			options = append(options, model.BloggingEventWithInvisible(*r.Invisible))
+		}
+		if r.Draft != nil {
+			options = append(options, model.BloggingEventWithDraft(*r.Draft))
+		}
+		if r.Slug != nil {
+			options = append(options, model.BloggingEventWithSlug(*r.Slug))
+		}
+		if r.Actor != nil {
+			options = append(options, model.BloggingEventWithActor(*r.Actor))
+		}
+		if r.SeriesID != nil && r.SeriesTitle != nil && r.SeriesPosition != nil {
+			options = append(options, model.BloggingEventWithSeries(*r.SeriesID, *r.SeriesTitle, *r.SeriesPosition))
+		}
+		if r.PublishAt != nil && *r.PublishAt != "" {
+			publishAt, err := synchro.Parse[tz.UTC](time.RFC3339, *r.PublishAt)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			options = append(options, model.BloggingEventWithPublishAt(publishAt))
+		}
+		result = append(result, model.NewBloggingEvent(r.EventID, model.BloggingEventType(*r.EventType), r.ArticleID, options...))
 	}
 	return result, nil
 }
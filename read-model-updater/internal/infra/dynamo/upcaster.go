@@ -0,0 +1,59 @@
+package dynamo
+
+import (
+	"fmt"
+
+	"blogapi.miyamo.today/core/article"
+	"github.com/cockroachdb/errors"
+)
+
+// currentSchemaVersion is the schema version of the events the blogging-event-service writes.
+const currentSchemaVersion = 2
+
+// upcaster turns an event of one schema version into the shape of the next version.
+// first tells whether the event is the oldest one of the article.
+type upcaster func(e bloggingEvent, first bool) bloggingEvent
+
+// upcasters holds an upcaster for every schema version older than currentSchemaVersion, keyed by the version it reads.
+var upcasters = map[int]upcaster{
+	1: upcastV1,
+}
+
+// upcast brings the event up to currentSchemaVersion.
+// Events without schema_version are version 1.
+func upcast(e bloggingEvent, first bool) (bloggingEvent, error) {
+	version := 1
+	if e.SchemaVersion != nil {
+		version = *e.SchemaVersion
+	}
+	if version > currentSchemaVersion {
+		return bloggingEvent{}, errors.WithStack(fmt.Errorf("event %s has unknown schema version %d", e.EventID, version))
+	}
+	for version < currentSchemaVersion {
+		u, ok := upcasters[version]
+		if !ok {
+			return bloggingEvent{}, errors.WithStack(fmt.Errorf("no upcaster for schema version %d", version))
+		}
+		e = u(e, first)
+		version++
+	}
+	e.SchemaVersion = &version
+	return e, nil
+}
+
+// upcastV1 stores the event_type, which version 1 did not write, telling it from the attributes the event wrote.
+func upcastV1(e bloggingEvent, first bool) bloggingEvent {
+	eventType := string(article.EventTypeOfV1(article.V1Attributes{
+		Title:      e.Title,
+		Content:    e.Content,
+		Thumbnail:  e.Thumbnail,
+		AttachTags: e.AttachTags,
+		DetachTags: e.DetachTags,
+		Invisible:  e.Invisible,
+		Draft:      e.Draft,
+		PublishAt:  e.PublishAt,
+		RevertedTo: e.RevertedTo,
+	}, first))
+	e.EventType = &eventType
+	return e
+}
@@ -0,0 +1,73 @@
+package dynamo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestUpcastV1(t *testing.T) {
+	type testCase struct {
+		event bloggingEvent
+		first bool
+		want  bloggingEvent
+	}
+	tests := map[string]testCase{
+		"happy_path/create_article": {
+			event: bloggingEvent{EventID: "1", ArticleID: "a", Title: ptr("title"), Content: ptr("body")},
+			first: true,
+			want:  bloggingEvent{EventID: "1", EventType: ptr("CREATE_ARTICLE"), ArticleID: "a", Title: ptr("title"), Content: ptr("body")},
+		},
+		"happy_path/hide_article": {
+			event: bloggingEvent{EventID: "2", ArticleID: "a", Invisible: ptr(true)},
+			want:  bloggingEvent{EventID: "2", EventType: ptr("HIDE_ARTICLE"), ArticleID: "a", Invisible: ptr(true)},
+		},
+		"happy_path/overwrites_event_type": {
+			event: bloggingEvent{EventID: "3", EventType: ptr("UPDATE_BODY"), ArticleID: "a", Title: ptr("title")},
+			want:  bloggingEvent{EventID: "3", EventType: ptr("UPDATE_TITLE"), ArticleID: "a", Title: ptr("title")},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := upcastV1(tt.event, tt.first); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("upcastV1() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpcast(t *testing.T) {
+	type testCase struct {
+		event   bloggingEvent
+		want    bloggingEvent
+		wantErr bool
+	}
+	tests := map[string]testCase{
+		"happy_path/without_schema_version": {
+			event: bloggingEvent{EventID: "1", Title: ptr("title")},
+			want:  bloggingEvent{EventID: "1", EventType: ptr("UPDATE_TITLE"), SchemaVersion: ptr(currentSchemaVersion), Title: ptr("title")},
+		},
+		"happy_path/current_schema_version": {
+			event: bloggingEvent{EventID: "1", EventType: ptr("UPDATE_BODY"), SchemaVersion: ptr(currentSchemaVersion), Title: ptr("title")},
+			want:  bloggingEvent{EventID: "1", EventType: ptr("UPDATE_BODY"), SchemaVersion: ptr(currentSchemaVersion), Title: ptr("title")},
+		},
+		"unhappy_path/unknown_schema_version": {
+			event:   bloggingEvent{EventID: "1", SchemaVersion: ptr(currentSchemaVersion + 1)},
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := upcast(tt.event, false)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("upcast() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("upcast() got = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
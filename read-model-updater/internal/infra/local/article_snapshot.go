@@ -0,0 +1,134 @@
+package local
+
+import (
+	"context"
+	"time"
+
+	"blogapi.miyamo.today/read-model-updater/internal/domain/model"
+	"blogapi.miyamo.today/read-model-updater/internal/infra/local/eventlog"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type articleSnapshot struct {
+	EventID           string                  `json:"event_id"`
+	ArticleID         string                  `json:"article_id"`
+	Title             string                  `json:"title"`
+	Content           string                  `json:"content"`
+	Thumbnail         string                  `json:"thumbnail"`
+	ThumbnailVariants []eventlog.ImageVariant `json:"thumbnail_variants,omitempty"`
+	TagNames          []string                `json:"tag_names"`
+	Invisible         bool                    `json:"invisible"`
+	PublishAt         string                  `json:"publish_at"`
+	Draft             bool                    `json:"draft"`
+	Slug              string                  `json:"slug,omitempty"`
+	Slugs             []string                `json:"slugs,omitempty"`
+	CreatedBy         string                  `json:"created_by,omitempty"`
+	UpdatedBy         string                  `json:"updated_by,omitempty"`
+	SeriesID          string                  `json:"series_id,omitempty"`
+	SeriesTitle       string                  `json:"series_title,omitempty"`
+	SeriesPosition    int                     `json:"series_position,omitempty"`
+}
+
+func (s *BloggingEventQueryService) LatestSnapshotByArticleID(
+	ctx context.Context,
+	articleID string,
+) (*model.ArticleSnapshot, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventQueryService#LatestSnapshotByArticleID").End()
+
+	entries, err := s.store.snapshots.Entries()
+	if err != nil {
+		return nil, err
+	}
+	var latest *articleSnapshot
+	for i, e := range entries {
+		// ULIDs are lexicographically sortable.
+		if e.ArticleID == articleID && (latest == nil || e.EventID > latest.EventID) {
+			latest = &entries[i]
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+
+	var publishAt synchro.Time[tz.UTC]
+	if latest.PublishAt != "" {
+		publishAt, err = synchro.Parse[tz.UTC](time.RFC3339, latest.PublishAt)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	snapshot := model.NewArticleSnapshot(
+		latest.EventID,
+		model.NewArticleCommand(
+			latest.ArticleID,
+			latest.Title,
+			latest.Content,
+			latest.Thumbnail,
+			imageVariantsFrom(latest.ThumbnailVariants),
+			latest.TagNames,
+			latest.Invisible,
+			publishAt,
+			latest.Draft,
+			latest.Slug,
+			latest.Slugs,
+			latest.CreatedBy,
+			latest.UpdatedBy,
+			model.NewArticleSeriesCommand(latest.SeriesID, latest.SeriesTitle, latest.SeriesPosition),
+		),
+	)
+	return &snapshot, nil
+}
+
+type ArticleSnapshotCommandService struct {
+	store *Store
+}
+
+func (s *ArticleSnapshotCommandService) PutSnapshot(ctx context.Context, snapshot model.ArticleSnapshot) error {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("ArticleSnapshotCommandService#PutSnapshot").End()
+
+	a := snapshot.Article()
+	tagNames := make([]string, 0, len(a.Tags()))
+	for _, t := range a.Tags() {
+		tagNames = append(tagNames, t.Name())
+	}
+	var publishAt string
+	if v := a.PublishAt(); !v.IsZero() {
+		publishAt = v.StdTime().Format(time.RFC3339)
+	}
+	return s.store.snapshots.Append(
+		func([]articleSnapshot) ([]articleSnapshot, error) {
+			return []articleSnapshot{
+				{
+					EventID:           snapshot.EventID(),
+					ArticleID:         a.ID(),
+					Title:             a.Title(),
+					Content:           a.Body(),
+					Thumbnail:         a.Thumbnail(),
+					ThumbnailVariants: imageVariantsOf(a.ThumbnailVariants()),
+					TagNames:          tagNames,
+					Invisible:         a.Invisible(),
+					PublishAt:         publishAt,
+					Draft:             a.Draft(),
+					Slug:              a.Slug(),
+					Slugs:             a.Slugs(),
+					CreatedBy:         a.CreatedBy(),
+					UpdatedBy:         a.UpdatedBy(),
+					SeriesID:          a.Series().ID(),
+					SeriesTitle:       a.Series().Title(),
+					SeriesPosition:    a.Series().Position(),
+				},
+			}, nil
+		},
+	)
+}
+
+func NewArticleSnapshotCommandService(store *Store) *ArticleSnapshotCommandService {
+	return &ArticleSnapshotCommandService{
+		store: store,
+	}
+}
@@ -0,0 +1,116 @@
+package local
+
+import (
+	"context"
+	"time"
+
+	"blogapi.miyamo.today/core/article"
+	"blogapi.miyamo.today/read-model-updater/internal/domain/model"
+	"blogapi.miyamo.today/read-model-updater/internal/infra/local/eventlog"
+	"github.com/Code-Hex/synchro"
+	"github.com/Code-Hex/synchro/tz"
+	"github.com/cockroachdb/errors"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type BloggingEventQueryService struct {
+	store *Store
+}
+
+func (s *BloggingEventQueryService) ListEventsByArticleID(
+	ctx context.Context,
+	articleID, afterEventID string,
+) ([]model.BloggingEvent, error) {
+	nrtx := newrelic.FromContext(ctx)
+	defer nrtx.StartSegment("BloggingEventQueryService#ListEventsByArticleID").End()
+
+	entries, err := s.store.events.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	// entries are in the order they were written, which is the order of their ULIDs.
+	result := make([]model.BloggingEvent, 0)
+	for _, e := range entries {
+		if e.ArticleID != articleID || e.EventID <= afterEventID {
+			continue
+		}
+		event, err := bloggingEventFromEntry(e)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, event)
+	}
+	return result, nil
+}
+
+// bloggingEventFromEntry converts the entry of the log to model.BloggingEvent.
+func bloggingEventFromEntry(e eventlog.BloggingEvent) (model.BloggingEvent, error) {
+	options := []model.BloggingEventOption{
+		model.BloggingEventWithTags(e.Tags...),
+		model.BloggingEventWithAttachTags(e.AttachTags...),
+		model.BloggingEventWithDetachTags(e.DetachTags...),
+	}
+	if e.Title != nil {
+		options = append(options, model.BloggingEventWithTitle(*e.Title))
+	}
+	if e.Content != nil {
+		options = append(options, model.BloggingEventWithContent(*e.Content))
+	}
+	if e.Thumbnail != nil {
+		options = append(options, model.BloggingEventWithThumbnail(*e.Thumbnail), model.BloggingEventWithThumbnailVariants(imageVariantsFrom(e.ThumbnailVariants)...))
+	}
+	if e.Invisible != nil {
+		options = append(options, model.BloggingEventWithInvisible(*e.Invisible))
+	}
+	if e.Draft != nil {
+		options = append(options, model.BloggingEventWithDraft(*e.Draft))
+	}
+	if e.Slug != nil {
+		options = append(options, model.BloggingEventWithSlug(*e.Slug))
+	}
+	if e.Actor != nil {
+		options = append(options, model.BloggingEventWithActor(*e.Actor))
+	}
+	if e.SeriesID != nil && e.SeriesTitle != nil && e.SeriesPosition != nil {
+		options = append(options, model.BloggingEventWithSeries(*e.SeriesID, *e.SeriesTitle, *e.SeriesPosition))
+	}
+	if e.PublishAt != nil && *e.PublishAt != "" {
+		publishAt, err := synchro.Parse[tz.UTC](time.RFC3339, *e.PublishAt)
+		if err != nil {
+			return model.BloggingEvent{}, errors.WithStack(err)
+		}
+		options = append(options, model.BloggingEventWithPublishAt(publishAt))
+	}
+	return model.NewBloggingEvent(e.EventID, model.BloggingEventType(e.EventType), e.ArticleID, options...), nil
+}
+
+// imageVariantsOf converts the variants of the thumbnail to the ones stored in the log.
+func imageVariantsOf(variants []article.ImageVariant) []eventlog.ImageVariant {
+	if len(variants) == 0 {
+		return nil
+	}
+	result := make([]eventlog.ImageVariant, 0, len(variants))
+	for _, v := range variants {
+		result = append(result, eventlog.ImageVariant{URL: v.URL(), Width: v.Width(), Height: v.Height(), ContentType: v.ContentType()})
+	}
+	return result
+}
+
+// imageVariantsFrom converts the variants of the thumbnail stored in the log to the ones of the model.
+func imageVariantsFrom(variants []eventlog.ImageVariant) []article.ImageVariant {
+	if len(variants) == 0 {
+		return nil
+	}
+	result := make([]article.ImageVariant, 0, len(variants))
+	for _, v := range variants {
+		result = append(result, article.NewImageVariant(v.URL, v.Width, v.Height, v.ContentType))
+	}
+	return result
+}
+
+func NewBloggingEventQueryService(store *Store) *BloggingEventQueryService {
+	return &BloggingEventQueryService{
+		store: store,
+	}
+}
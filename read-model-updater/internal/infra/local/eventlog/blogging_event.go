@@ -0,0 +1,39 @@
+package eventlog
+
+// BloggingEventsFile is the name of the file of the blogging events log.
+const BloggingEventsFile = "blogging_events.jsonl"
+
+// BloggingEvent is a blogging event as stored in the log.
+// It is a copy of the type the blogging-event-service writes the log with, and must be kept in step with it.
+// Its fields are the attributes of the blogging events table; those the event did not write are nil or empty.
+type BloggingEvent struct {
+	EventID           string         `json:"event_id"`
+	ArticleID         string         `json:"article_id"`
+	EventType         string         `json:"event_type"`
+	SchemaVersion     int            `json:"schema_version"`
+	Title             *string        `json:"title,omitempty"`
+	Content           *string        `json:"content,omitempty"`
+	Thumbnail         *string        `json:"thumbnail,omitempty"`
+	ThumbnailVariants []ImageVariant `json:"thumbnail_variants,omitempty"`
+	Tags              []string       `json:"tags,omitempty"`
+	AttachTags        []string       `json:"attach_tags,omitempty"`
+	DetachTags        []string       `json:"detach_tags,omitempty"`
+	Invisible         *bool          `json:"invisible,omitempty"`
+	PublishAt         *string        `json:"publish_at,omitempty"`
+	Draft             *bool          `json:"draft,omitempty"`
+	RevertedTo        *string        `json:"reverted_to,omitempty"`
+	Slug              *string        `json:"slug,omitempty"`
+	SeriesID          *string        `json:"series_id,omitempty"`
+	SeriesTitle       *string        `json:"series_title,omitempty"`
+	SeriesPosition    *int           `json:"series_position,omitempty"`
+	Actor             *string        `json:"actor,omitempty"`
+	ActorID           *string        `json:"actor_id,omitempty"`
+}
+
+// ImageVariant is a variant of the thumbnail of a blogging event as stored in the log.
+type ImageVariant struct {
+	URL         string `json:"url"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	ContentType string `json:"content_type"`
+}
@@ -0,0 +1,140 @@
+// Package eventlog provides an append-only log of JSON lines in a local file.
+// It stands in for the blogging events table when developing without AWS.
+package eventlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Log is an append-only log of entries of type T, stored one JSON object per line.
+// Other processes may read the file while it is appended to, and see the new entries on their next read.
+// Appends are serialized within a process, so a file must have a single writing process.
+type Log[T any] struct {
+	mu      sync.Mutex
+	path    string
+	offset  int64
+	entries []T
+}
+
+// Open opens the log stored at path, creating the file and its directory if they do not exist.
+func Open[T any](path string) (*Log[T], error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDONLY, 0o644)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := f.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	l := &Log[T]{path: path}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.catchUp(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Entries returns every entry in the order they were appended.
+func (l *Log[T]) Entries() ([]T, error) {
+	return l.EntriesFrom(0)
+}
+
+// EntriesFrom returns the entries from position on, in the order they were appended.
+// The position counts entries, so that a reader can resume from the number of entries it has seen.
+func (l *Log[T]) EntriesFrom(position int) ([]T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.catchUp(); err != nil {
+		return nil, err
+	}
+	if position >= len(l.entries) {
+		return nil, nil
+	}
+	return slices.Clone(l.entries[position:]), nil
+}
+
+// Append appends the entries returned by fn.
+// fn receives every entry appended so far and must not modify them.
+// Nothing is appended if fn returns an error, which is returned as is.
+// No other append of the process runs until fn returns, so a check made by fn holds for the entries it returns.
+func (l *Log[T]) Append(fn func(entries []T) ([]T, error)) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := l.catchUp(); err != nil {
+		return err
+	}
+	appended, err := fn(l.entries)
+	if err != nil {
+		return err
+	}
+	if len(appended) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for _, v := range appended {
+		line, err := json.Marshal(v)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := f.Sync(); err != nil {
+		return errors.WithStack(err)
+	}
+	return l.catchUp()
+}
+
+// catchUp reads the entries appended since the last read.
+// A trailing line without a line break is still being written, and is left for the next read.
+func (l *Log[T]) catchUp() error {
+	f, err := os.Open(l.path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer f.Close()
+	if _, err := f.Seek(l.offset, io.SeekStart); err != nil {
+		return errors.WithStack(err)
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			return nil
+		}
+		line := bytes.TrimSpace(data[:i])
+		if len(line) > 0 {
+			var v T
+			if err := json.Unmarshal(line, &v); err != nil {
+				return errors.Wrapf(err, "failed to decode the entry at offset %d of %s", l.offset, l.path)
+			}
+			l.entries = append(l.entries, v)
+		}
+		data = data[i+1:]
+		l.offset += int64(i + 1)
+	}
+}
@@ -0,0 +1,102 @@
+package local
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/cockroachdb/errors"
+	"github.com/oklog/ulid/v2"
+)
+
+// streamMessage is the shape of the messages the DynamoDB stream of the blogging events table sends to the queue.
+type streamMessage struct {
+	DynamoDB struct {
+		NewImage json.RawMessage `json:"NewImage"`
+	} `json:"dynamodb"`
+}
+
+// Queue delivers the events appended to the local event store as messages of the DynamoDB stream queue,
+// so that the read-model-updater follows the local store the same way it follows DynamoDB.
+// The position of the queue is kept in memory, so every event is delivered again after a restart.
+// That is harmless, since syncing an article is idempotent.
+type Queue struct {
+	store    *Store
+	mu       sync.Mutex
+	position int
+}
+
+// ReceiveMessage returns the events appended since the previous call, in the order they were written.
+func (q *Queue) ReceiveMessage(
+	ctx context.Context,
+	params *sqs.ReceiveMessageInput,
+	_ ...func(*sqs.Options),
+) (*sqs.ReceiveMessageOutput, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	entries, err := q.store.events.EntriesFrom(q.position)
+	if err != nil {
+		return nil, err
+	}
+	if limit := int(params.MaxNumberOfMessages); limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	messages := make([]types.Message, 0, len(entries))
+	for _, e := range entries {
+		image, err := attributevalue.MarshalMapWithOptions(
+			e, func(o *attributevalue.EncoderOptions) {
+				o.TagKey = "json"
+			},
+		)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		var message streamMessage
+		message.DynamoDB.NewImage, err = attributevalue.MarshalMapJSON(image)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		body, err := json.Marshal(message)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		id, err := ulid.Parse(e.EventID)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		messages = append(
+			messages, types.Message{
+				MessageId:     aws.String(e.EventID),
+				ReceiptHandle: aws.String(e.EventID),
+				Body:          aws.String(string(body)),
+				Attributes: map[string]string{
+					string(types.MessageSystemAttributeNameSentTimestamp): strconv.FormatUint(id.Time(), 10),
+				},
+			},
+		)
+	}
+	q.position += len(entries)
+	return &sqs.ReceiveMessageOutput{Messages: messages}, nil
+}
+
+// DeleteMessage acknowledges the message. Received messages are never delivered again, so there is nothing to delete.
+func (q *Queue) DeleteMessage(
+	ctx context.Context,
+	params *sqs.DeleteMessageInput,
+	_ ...func(*sqs.Options),
+) (*sqs.DeleteMessageOutput, error) {
+	return &sqs.DeleteMessageOutput{}, nil
+}
+
+func NewQueue(store *Store) *Queue {
+	return &Queue{
+		store: store,
+	}
+}
@@ -0,0 +1,29 @@
+package local
+
+import (
+	"path/filepath"
+
+	"blogapi.miyamo.today/read-model-updater/internal/infra/local/eventlog"
+)
+
+// Store holds the logs of the local event store, which stands in for DynamoDB when developing without AWS.
+type Store struct {
+	events    *eventlog.Log[eventlog.BloggingEvent]
+	snapshots *eventlog.Log[articleSnapshot]
+}
+
+// Open opens the local event store kept in dir, the same directory the blogging-event-service writes to.
+func Open(dir string) (*Store, error) {
+	events, err := eventlog.Open[eventlog.BloggingEvent](filepath.Join(dir, eventlog.BloggingEventsFile))
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := eventlog.Open[articleSnapshot](filepath.Join(dir, "blogging_event_snapshots.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+	return &Store{
+		events:    events,
+		snapshots: snapshots,
+	}, nil
+}
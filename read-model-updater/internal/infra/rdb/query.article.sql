@@ -4,6 +4,13 @@ INSERT INTO "articles" (
     ,"title"
     ,"body"
     ,"thumbnail"
+    ,"slug"
+    ,"created_by"
+    ,"updated_by"
+    ,"series_id"
+    ,"series_title"
+    ,"series_position"
+    ,"thumbnail_variants"
     ,"created_at"
     ,"updated_at"
 )
@@ -14,13 +21,47 @@ VALUES (
     ,$4
     ,$5
     ,$6
+    ,$7
+    ,$8
+    ,$9
+    ,$10
+    ,$11
+    ,$12
+    ,$13
 )
 ON CONFLICT ("id") DO UPDATE
 SET "title" = EXCLUDED.title
     ,"body" = EXCLUDED.body
     ,"thumbnail" = EXCLUDED.thumbnail
+    ,"thumbnail_variants" = EXCLUDED.thumbnail_variants
+    ,"slug" = EXCLUDED.slug
+    ,"created_by" = EXCLUDED.created_by
+    ,"updated_by" = EXCLUDED.updated_by
+    ,"series_id" = EXCLUDED.series_id
+    ,"series_title" = EXCLUDED.series_title
+    ,"series_position" = EXCLUDED.series_position
     ,"updated_at" = EXCLUDED.updated_at;
 
+-- name: PutArticleSlugs :exec
+INSERT INTO "article_slugs" (
+    "slug"
+    ,"article_id"
+)
+SELECT
+    "slug"
+    ,@article_id:: varchar
+FROM
+    unnest(@slugs:: varchar []) AS "slug"
+ON CONFLICT ("slug") DO UPDATE
+SET "article_id" = EXCLUDED.article_id;
+
+-- name: DeleteArticleSlugs :exec
+DELETE
+FROM
+    "article_slugs"
+WHERE
+    "article_slugs"."article_id" = $1;
+
 -- name: CreateTempTagsTable :exec
 CREATE TEMP TABLE IF NOT EXISTS tmp_tags (
     id VARCHAR(144),
@@ -76,4 +117,47 @@ FROM
 WHERE
     "tags"."article_id" = $1
 AND
-    "tags"."id" NOT IN (SELECT id FROM "inserted");
\ No newline at end of file
+    "tags"."id" NOT IN (SELECT id FROM "inserted");
+
+-- name: DetachAllTags :exec
+DELETE
+FROM
+    "tags"
+WHERE
+    "tags"."article_id" = $1;
+
+-- name: DeleteArticle :exec
+DELETE
+FROM
+    "articles"
+WHERE
+    "articles"."id" = $1;
+
+-- name: PutArticleSchedule :exec
+INSERT INTO "article_schedules" (
+    "article_id"
+    ,"publish_at"
+)
+VALUES (
+    $1
+    ,$2
+)
+ON CONFLICT ("article_id") DO UPDATE
+SET "publish_at" = EXCLUDED.publish_at;
+
+-- name: DeleteArticleSchedule :exec
+DELETE
+FROM
+    "article_schedules"
+WHERE
+    "article_schedules"."article_id" = $1;
+
+-- name: ListDueArticleSchedules :many
+SELECT
+    "article_id"
+FROM
+    "article_schedules"
+WHERE
+    "article_schedules"."publish_at" <= $1
+ORDER BY
+    "publish_at";
\ No newline at end of file
@@ -40,6 +40,15 @@ FROM (
 )
 ON CONFLICT DO NOTHING;
 
+-- name: DeleteTagsWithoutArticles :exec
+DELETE
+FROM
+    "tags"
+WHERE
+    "tags"."id" = ANY ($1:: varchar [])
+AND
+    NOT EXISTS (SELECT 1 FROM "articles" WHERE "articles"."tag_id" = "tags"."id");
+
 -- name: CreateTempArticlesTable :exec
 CREATE TEMP TABLE IF NOT EXISTS tmp_articles (
     id VARCHAR(26),
@@ -102,4 +111,30 @@ FROM
 WHERE
     "articles"."id" = $1
 AND
-    "articles"."tag_id" NOT IN (SELECT tag_id FROM "inserted");
\ No newline at end of file
+    "articles"."tag_id" NOT IN (SELECT tag_id FROM "inserted");
+
+-- name: DeleteArticle :exec
+DELETE
+FROM
+    "articles"
+WHERE
+    "articles"."id" = $1;
+
+-- name: PutArticleSchedule :exec
+INSERT INTO "article_schedules" (
+    "article_id"
+    ,"publish_at"
+)
+VALUES (
+    $1
+    ,$2
+)
+ON CONFLICT ("article_id") DO UPDATE
+SET "publish_at" = EXCLUDED.publish_at;
+
+-- name: DeleteArticleSchedule :exec
+DELETE
+FROM
+    "article_schedules"
+WHERE
+    "article_schedules"."article_id" = $1;
\ No newline at end of file
@@ -8,6 +8,27 @@ CREATE TABLE IF NOT EXISTS articles (
     PRIMARY KEY (id)
 );
 
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS slug VARCHAR(100) NOT NULL DEFAULT '';
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS created_by VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS updated_by VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS series_id VARCHAR(26) NOT NULL DEFAULT '';
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS series_title VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS series_position INT NOT NULL DEFAULT 0;
+
+ALTER TABLE articles ADD COLUMN IF NOT EXISTS thumbnail_variants JSONB NOT NULL DEFAULT '[]';
+
+CREATE TABLE IF NOT EXISTS article_slugs (
+    slug VARCHAR(100),
+    article_id VARCHAR(26) NOT NULL,
+    FOREIGN KEY (article_id) REFERENCES articles(id),
+    PRIMARY KEY (slug)
+);
+
 CREATE TABLE IF NOT EXISTS tags (
     id VARCHAR(144),
     article_id VARCHAR(26),
@@ -18,6 +39,12 @@ CREATE TABLE IF NOT EXISTS tags (
     PRIMARY KEY (id, article_id)
 );
 
+CREATE TABLE IF NOT EXISTS article_schedules (
+    article_id VARCHAR(26),
+    publish_at timestamp WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (article_id)
+);
+
 CREATE TEMP TABLE tmp_tags (
     id VARCHAR(144),
     article_id VARCHAR(26),
@@ -17,6 +17,12 @@ CREATE TABLE IF NOT EXISTS articles (
     PRIMARY KEY (id, tag_id)
 );
 
+CREATE TABLE IF NOT EXISTS article_schedules (
+    article_id VARCHAR(26),
+    publish_at timestamp WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (article_id)
+);
+
 CREATE TEMP TABLE tmp_tags (
     id VARCHAR(144),
     name VARCHAR(35) NOT NULL,
@@ -65,6 +65,89 @@ func (q *Queries) CreateTempTagsTable(ctx context.Context) error {
 	return err
 }
 
+const deleteArticle = `-- name: DeleteArticle :exec
+DELETE
+FROM
+    "articles"
+WHERE
+    "articles"."id" = $1
+`
+
+func (q *Queries) DeleteArticle(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteArticle, id)
+	return err
+}
+
+const deleteArticleSchedule = `-- name: DeleteArticleSchedule :exec
+DELETE
+FROM
+    "article_schedules"
+WHERE
+    "article_schedules"."article_id" = $1
+`
+
+func (q *Queries) DeleteArticleSchedule(ctx context.Context, articleID string) error {
+	_, err := q.db.Exec(ctx, deleteArticleSchedule, articleID)
+	return err
+}
+
+const deleteArticleSlugs = `-- name: DeleteArticleSlugs :exec
+DELETE
+FROM
+    "article_slugs"
+WHERE
+    "article_slugs"."article_id" = $1
+`
+
+func (q *Queries) DeleteArticleSlugs(ctx context.Context, articleID string) error {
+	_, err := q.db.Exec(ctx, deleteArticleSlugs, articleID)
+	return err
+}
+
+const detachAllTags = `-- name: DetachAllTags :exec
+DELETE
+FROM
+    "tags"
+WHERE
+    "tags"."article_id" = $1
+`
+
+func (q *Queries) DetachAllTags(ctx context.Context, articleID string) error {
+	_, err := q.db.Exec(ctx, detachAllTags, articleID)
+	return err
+}
+
+const listDueArticleSchedules = `-- name: ListDueArticleSchedules :many
+SELECT
+    "article_id"
+FROM
+    "article_schedules"
+WHERE
+    "article_schedules"."publish_at" <= $1
+ORDER BY
+    "publish_at"
+`
+
+func (q *Queries) ListDueArticleSchedules(ctx context.Context, publishAt types.UTCTime) ([]string, error) {
+	rows, err := q.db.Query(ctx, listDueArticleSchedules, publishAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var article_id string
+		if err := rows.Scan(&article_id); err != nil {
+			return nil, err
+		}
+		items = append(items, article_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 type PreAttachTagsParams struct {
 	ID        string        `db:"id"`
 	ArticleID string        `db:"article_id"`
@@ -79,6 +162,13 @@ INSERT INTO "articles" (
     ,"title"
     ,"body"
     ,"thumbnail"
+    ,"slug"
+    ,"created_by"
+    ,"updated_by"
+    ,"series_id"
+    ,"series_title"
+    ,"series_position"
+    ,"thumbnail_variants"
     ,"created_at"
     ,"updated_at"
 )
@@ -89,21 +179,42 @@ VALUES (
     ,$4
     ,$5
     ,$6
+    ,$7
+    ,$8
+    ,$9
+    ,$10
+    ,$11
+    ,$12
+    ,$13
 )
 ON CONFLICT ("id") DO UPDATE
 SET "title" = EXCLUDED.title
     ,"body" = EXCLUDED.body
     ,"thumbnail" = EXCLUDED.thumbnail
+    ,"thumbnail_variants" = EXCLUDED.thumbnail_variants
+    ,"slug" = EXCLUDED.slug
+    ,"created_by" = EXCLUDED.created_by
+    ,"updated_by" = EXCLUDED.updated_by
+    ,"series_id" = EXCLUDED.series_id
+    ,"series_title" = EXCLUDED.series_title
+    ,"series_position" = EXCLUDED.series_position
     ,"updated_at" = EXCLUDED.updated_at
 `
 
 type PutArticleParams struct {
-	ID        string        `db:"id"`
-	Title     string        `db:"title"`
-	Body      string        `db:"body"`
-	Thumbnail string        `db:"thumbnail"`
-	CreatedAt types.UTCTime `db:"created_at"`
-	UpdatedAt types.UTCTime `db:"updated_at"`
+	ID                string              `db:"id"`
+	Title             string              `db:"title"`
+	Body              string              `db:"body"`
+	Thumbnail         string              `db:"thumbnail"`
+	Slug              string              `db:"slug"`
+	CreatedBy         string              `db:"created_by"`
+	UpdatedBy         string              `db:"updated_by"`
+	SeriesID          string              `db:"series_id"`
+	SeriesTitle       string              `db:"series_title"`
+	SeriesPosition    int32               `db:"series_position"`
+	ThumbnailVariants types.ImageVariants `db:"thumbnail_variants"`
+	CreatedAt         types.UTCTime       `db:"created_at"`
+	UpdatedAt         types.UTCTime       `db:"updated_at"`
 }
 
 func (q *Queries) PutArticle(ctx context.Context, arg PutArticleParams) error {
@@ -112,8 +223,62 @@ func (q *Queries) PutArticle(ctx context.Context, arg PutArticleParams) error {
 		arg.Title,
 		arg.Body,
 		arg.Thumbnail,
+		arg.Slug,
+		arg.CreatedBy,
+		arg.UpdatedBy,
+		arg.SeriesID,
+		arg.SeriesTitle,
+		arg.SeriesPosition,
+		arg.ThumbnailVariants,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
 	return err
 }
+
+const putArticleSchedule = `-- name: PutArticleSchedule :exec
+INSERT INTO "article_schedules" (
+    "article_id"
+    ,"publish_at"
+)
+VALUES (
+    $1
+    ,$2
+)
+ON CONFLICT ("article_id") DO UPDATE
+SET "publish_at" = EXCLUDED.publish_at
+`
+
+type PutArticleScheduleParams struct {
+	ArticleID string        `db:"article_id"`
+	PublishAt types.UTCTime `db:"publish_at"`
+}
+
+func (q *Queries) PutArticleSchedule(ctx context.Context, arg PutArticleScheduleParams) error {
+	_, err := q.db.Exec(ctx, putArticleSchedule, arg.ArticleID, arg.PublishAt)
+	return err
+}
+
+const putArticleSlugs = `-- name: PutArticleSlugs :exec
+INSERT INTO "article_slugs" (
+    "slug"
+    ,"article_id"
+)
+SELECT
+    "slug"
+    ,$1:: varchar
+FROM
+    unnest($2:: varchar []) AS "slug"
+ON CONFLICT ("slug") DO UPDATE
+SET "article_id" = EXCLUDED.article_id
+`
+
+type PutArticleSlugsParams struct {
+	ArticleID string   `db:"article_id"`
+	Slugs     []string `db:"slugs"`
+}
+
+func (q *Queries) PutArticleSlugs(ctx context.Context, arg PutArticleSlugsParams) error {
+	_, err := q.db.Exec(ctx, putArticleSlugs, arg.ArticleID, arg.Slugs)
+	return err
+}
@@ -41,6 +41,47 @@ func (q *Queries) CreateTempTagsTable(ctx context.Context) error {
 	return err
 }
 
+const deleteArticle = `-- name: DeleteArticle :exec
+DELETE
+FROM
+    "articles"
+WHERE
+    "articles"."id" = $1
+`
+
+func (q *Queries) DeleteArticle(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteArticle, id)
+	return err
+}
+
+const deleteArticleSchedule = `-- name: DeleteArticleSchedule :exec
+DELETE
+FROM
+    "article_schedules"
+WHERE
+    "article_schedules"."article_id" = $1
+`
+
+func (q *Queries) DeleteArticleSchedule(ctx context.Context, articleID string) error {
+	_, err := q.db.Exec(ctx, deleteArticleSchedule, articleID)
+	return err
+}
+
+const deleteTagsWithoutArticles = `-- name: DeleteTagsWithoutArticles :exec
+DELETE
+FROM
+    "tags"
+WHERE
+    "tags"."id" = ANY ($1:: varchar [])
+AND
+    NOT EXISTS (SELECT 1 FROM "articles" WHERE "articles"."tag_id" = "tags"."id")
+`
+
+func (q *Queries) DeleteTagsWithoutArticles(ctx context.Context, dollar_1 []string) error {
+	_, err := q.db.Exec(ctx, deleteTagsWithoutArticles, dollar_1)
+	return err
+}
+
 type PrePutArticleParams struct {
 	ID        string        `db:"id"`
 	TagID     string        `db:"tag_id"`
@@ -99,6 +140,29 @@ func (q *Queries) PutArticle(ctx context.Context, id string) error {
 	return err
 }
 
+const putArticleSchedule = `-- name: PutArticleSchedule :exec
+INSERT INTO "article_schedules" (
+    "article_id"
+    ,"publish_at"
+)
+VALUES (
+    $1
+    ,$2
+)
+ON CONFLICT ("article_id") DO UPDATE
+SET "publish_at" = EXCLUDED.publish_at
+`
+
+type PutArticleScheduleParams struct {
+	ArticleID string        `db:"article_id"`
+	PublishAt types.UTCTime `db:"publish_at"`
+}
+
+func (q *Queries) PutArticleSchedule(ctx context.Context, arg PutArticleScheduleParams) error {
+	_, err := q.db.Exec(ctx, putArticleSchedule, arg.ArticleID, arg.PublishAt)
+	return err
+}
+
 const putTags = `-- name: PutTags :exec
 INSERT INTO "tags" (
     "id"
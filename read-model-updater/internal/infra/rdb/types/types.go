@@ -1,9 +1,30 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/Code-Hex/synchro"
 	"github.com/Code-Hex/synchro/tz"
 )
 
 // UTCTime is a type alias for synchro.Time with UTC timezone.
 type UTCTime = synchro.Time[tz.UTC]
+
+// ImageVariant is a variant of the thumbnail of an article, as an element of the thumbnail_variants column.
+type ImageVariant struct {
+	URL         string `json:"url"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	ContentType string `json:"content_type"`
+}
+
+// ImageVariants is the thumbnail_variants column.
+type ImageVariants []ImageVariant
+
+// MarshalJSON encodes nil as an empty array, since the column is not nullable.
+func (v ImageVariants) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]ImageVariant(v))
+}
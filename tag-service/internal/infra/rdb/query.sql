@@ -14,6 +14,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY "t"."id";
 
 -- name: ListAfter :many
@@ -32,6 +34,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY
     "t"."id"
 ORDER BY
@@ -53,6 +57,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY
     "t"."id"
 ORDER BY
@@ -88,6 +94,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY
     "t"."id"
 ORDER BY
@@ -109,6 +117,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY
     "t"."id"
 ORDER BY
@@ -130,6 +140,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY
     "t"."id"
 ORDER BY
@@ -165,6 +177,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY
     "t"."id"
 ORDER BY
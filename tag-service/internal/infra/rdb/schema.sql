@@ -15,4 +15,10 @@ CREATE TABLE IF NOT EXISTS articles (
     updated_at timestamp WITH TIME ZONE NOT NULL,
     FOREIGN KEY (tag_id) REFERENCES tags(id),
     PRIMARY KEY (id, tag_id)
+);
+
+CREATE TABLE IF NOT EXISTS article_schedules (
+    article_id VARCHAR(26),
+    publish_at timestamp WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (article_id)
 );
\ No newline at end of file
@@ -27,6 +27,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY "t"."id"
 `
 
@@ -67,6 +69,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY
     "t"."id"
 ORDER BY
@@ -126,6 +130,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY
     "t"."id"
 ORDER BY
@@ -199,6 +205,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY
     "t"."id"
 ORDER BY
@@ -263,6 +271,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY
     "t"."id"
 ORDER BY
@@ -322,6 +332,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY
     "t"."id"
 ORDER BY
@@ -395,6 +407,8 @@ LEFT OUTER JOIN
     "articles" AS "a"
 ON
     "t"."id" = "a"."tag_id"
+AND
+    NOT EXISTS(SELECT 1 FROM "article_schedules" WHERE "article_schedules"."article_id" = "a"."id" AND "article_schedules"."publish_at" > now())
 GROUP BY
     "t"."id"
 ORDER BY